@@ -0,0 +1,193 @@
+// Command golden runs the CLI against the test server and compares the JSON
+// and human output of each case with its golden files.
+//
+//	go run ./cmd/golden            # check every case
+//	go run ./cmd/golden -run issue # check cases matching a pattern
+//	go run ./cmd/golden -update    # rewrite the golden files
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/testserver"
+)
+
+// now is the server's clock, so created objects get the same timestamps
+// on every run
+var now = time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+// modes are the outputs each case is checked in, by golden file suffix
+var modes = []struct {
+	name string
+	args []string
+}{
+	{"json", nil},
+	{"human", []string{"--human", "--iso", "--utc", "--color", "never"}},
+}
+
+func main() {
+	dir := flag.String("dir", "internal/testserver/testdata", "directory with workspace.json, cases.txt and the golden files")
+	binary := flag.String("binary", "", "CLI binary to test (default: build ./cmd/linear)")
+	update := flag.Bool("update", false, "rewrite the golden files with the current output")
+	run := flag.String("run", "", "only run cases whose name matches this pattern")
+	flag.Parse()
+
+	if err := runCases(*dir, *binary, *run, *update); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func runCases(dir, binary, pattern string, update bool) error {
+	filter, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid -run pattern: %w", err)
+	}
+	ws, err := testserver.LoadWorkspace(filepath.Join(dir, "workspace.json"))
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "cases.txt"))
+	if err != nil {
+		return err
+	}
+	cases, err := testserver.ParseCases(data)
+	if err != nil {
+		return fmt.Errorf("cases.txt: %w", err)
+	}
+
+	tmp, err := os.MkdirTemp("", "linear-golden-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	if binary == "" {
+		binary = filepath.Join(tmp, "linear")
+		build := exec.Command("go", "build", "-o", binary, "./cmd/linear")
+		build.Stdout, build.Stderr = os.Stdout, os.Stderr
+		if err := build.Run(); err != nil {
+			return fmt.Errorf("failed to build the CLI: %w", err)
+		}
+	} else if binary, err = filepath.Abs(binary); err != nil {
+		return err
+	}
+
+	failed, ran := 0, 0
+	for _, c := range cases {
+		if !filter.MatchString(c.Name) {
+			continue
+		}
+		for _, mode := range modes {
+			ran++
+			repo := ""
+			if c.Repo != "" {
+				repo = filepath.Join(dir, "repos", c.Repo)
+			}
+			got, err := runCase(ws, binary, tmp, repo, append(append([]string{}, c.Args...), mode.args...))
+			if err == nil {
+				err = testserver.Compare(filepath.Join(dir, "golden", c.Name+"."+mode.name+".golden"), got, update)
+			}
+			if err != nil {
+				failed++
+				fmt.Printf("FAIL %s (%s): %v\n", c.Name, mode.name, err)
+			}
+		}
+	}
+
+	switch {
+	case ran == 0:
+		return errors.New("no cases matched")
+	case failed > 0:
+		return fmt.Errorf("%d of %d golden checks failed", failed, ran)
+	case update:
+		fmt.Printf("updated %d golden files\n", ran)
+	default:
+		fmt.Printf("ok %d golden checks\n", ran)
+	}
+	return nil
+}
+
+// runCase runs the CLI with args against a fresh server and an empty home
+// directory, returning its normalized stdout, stderr and exit status. A
+// repo directory is copied into the home directory and the CLI run there.
+func runCase(ws *testserver.Workspace, binary, tmp, repo string, args []string) ([]byte, error) {
+	srv := testserver.New(ws, testserver.Options{Now: func() time.Time { return now }})
+	defer srv.Close()
+
+	home, err := os.MkdirTemp(tmp, "home-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+
+	cmd := exec.Command(binary, args...)
+	cmd.Dir = home
+	if repo != "" {
+		cmd.Dir = filepath.Join(home, filepath.Base(repo))
+		if err := os.CopyFS(cmd.Dir, os.DirFS(repo)); err != nil {
+			return nil, err
+		}
+	}
+	cmd.Env = []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + home,
+		"XDG_CONFIG_HOME=" + filepath.Join(home, ".config"),
+		"XDG_CACHE_HOME=" + filepath.Join(home, ".cache"),
+		"TZ=UTC",
+		"NO_COLOR=1",
+		"LINEAR_API_KEY=lin_api_golden",
+		"LINEAR_API_ENDPOINT=" + srv.URL(),
+		"LINEAR_DAEMON=off",
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	cmd.Stdin = strings.NewReader("")
+
+	status := 0
+	if err := cmd.Run(); err != nil {
+		var exit *exec.ExitError
+		if !errors.As(err, &exit) {
+			return nil, err
+		}
+		status = exit.ExitCode()
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "$ linear %s\n", commandLine(args))
+	out.Write(stdout.Bytes())
+	if stderr.Len() > 0 {
+		out.WriteString("--- stderr\n")
+		out.Write(stderr.Bytes())
+	}
+	if status != 0 {
+		fmt.Fprintf(&out, "--- exit %d\n", status)
+	}
+	return testserver.Normalize(out.Bytes(), map[string]string{
+		home:      "$HOME",
+		srv.URL(): "$ENDPOINT",
+		strings.TrimSuffix(srv.URL(), "/graphql"): "$SERVER",
+	}), nil
+}
+
+// commandLine joins args for the golden file's header, quoting those with
+// spaces the way cases.txt does
+func commandLine(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = arg
+		if arg == "" || strings.ContainsAny(arg, " \t'\"#") {
+			quoted[i] = strconv.Quote(arg)
+		}
+	}
+	return strings.Join(quoted, " ")
+}
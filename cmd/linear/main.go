@@ -15,7 +15,5 @@ var (
 
 func main() {
 	rootCmd := cmd.NewRootCmd(version, commit, date)
-	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
-	}
+	os.Exit(cmd.Execute(rootCmd))
 }
@@ -0,0 +1,32 @@
+package api
+
+// Actor is who issues and comments are shown as created by when
+// authenticated with an app token (OAuth actor=app). The zero Actor creates
+// them as the app itself.
+type Actor struct {
+	// Name is shown as the creator, as "Name (via App)"
+	Name string `json:"name,omitempty"`
+	// IconURL is the avatar shown next to Name
+	IconURL string `json:"iconUrl,omitempty"`
+}
+
+var actor Actor
+
+// SetActor sets the actor of issues and comments created afterwards
+func SetActor(a Actor) {
+	actor = a
+}
+
+// CurrentActor returns the actor set with SetActor
+func CurrentActor() Actor {
+	return actor
+}
+
+// onBehalf returns the createAsUser and displayIconUrl inputs for the
+// current actor
+func onBehalf() (name, iconURL string) {
+	if actor.Name == "" {
+		return "", ""
+	}
+	return actor.Name, actor.IconURL
+}
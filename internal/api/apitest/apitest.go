@@ -0,0 +1,178 @@
+// Package apitest replays recorded GraphQL fixtures from a local server, so
+// api.Client can be exercised without the Linear API.
+//
+// Record fixtures by running any command with LINEAR_API_RECORD set to a
+// directory, then serve them:
+//
+//	srv, err := apitest.Load("testdata/issue-view")
+//	defer srv.Close()
+//	issue, err := srv.Client().GetIssue(ctx, "ENG-123", false)
+//
+// The CLI itself can be pointed at the server with LINEAR_API_ENDPOINT.
+package apitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+)
+
+// Server serves fixtures to requests with the same operation and
+// variables
+type Server struct {
+	server    *httptest.Server
+	mu        sync.Mutex
+	fixtures  []api.Fixture
+	used      []bool
+	unmatched []string
+}
+
+// NewServer starts a server replaying fixtures
+func NewServer(fixtures ...api.Fixture) *Server {
+	s := &Server{fixtures: fixtures, used: make([]bool, len(fixtures))}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Load starts a server replaying the fixtures recorded in dir
+func Load(dir string) (*Server, error) {
+	fixtures, err := api.ReadFixtures(dir)
+	if err != nil {
+		return nil, err
+	}
+	return NewServer(fixtures...), nil
+}
+
+// URL returns the GraphQL endpoint of the server
+func (s *Server) URL() string {
+	return s.server.URL + "/graphql"
+}
+
+// Client returns a client sending its requests to the server
+func (s *Server) Client() *api.Client {
+	return api.NewClientWithEndpoint("lin_api_test", s.URL())
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Unused returns the fixtures no request has matched yet
+func (s *Server) Unused() []api.Fixture {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var unused []api.Fixture
+	for i, fixture := range s.fixtures {
+		if !s.used[i] {
+			unused = append(unused, fixture)
+		}
+	}
+	return unused
+}
+
+// Unmatched describes the requests no fixture matched
+func (s *Server) Unmatched() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.unmatched...)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&payload) != nil || payload.Query == "" {
+		writeError(w, http.StatusBadRequest, "expected a GraphQL POST request")
+		return
+	}
+
+	fixture, ok := s.match(api.OperationOf(payload.Query), payload.Variables)
+	if !ok {
+		vars, _ := json.Marshal(payload.Variables)
+		msg := fmt.Sprintf("apitest: no fixture for %s with variables %s", api.OperationOf(payload.Query), vars)
+		s.mu.Lock()
+		s.unmatched = append(s.unmatched, msg)
+		s.mu.Unlock()
+		writeError(w, http.StatusOK, msg)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(fixture.Status)
+	w.Write(fixture.Response)
+}
+
+// match returns the first unused fixture for the request, in recorded
+// order, so repeated requests get successive responses. Once they are
+// used up, the last one is served again.
+func (s *Server) match(operation string, variables map[string]interface{}) (api.Fixture, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last := -1
+	for i, fixture := range s.fixtures {
+		if fixture.Operation != operation || !variablesMatch(fixture.Variables, variables) {
+			continue
+		}
+		if !s.used[i] {
+			s.used[i] = true
+			return fixture, true
+		}
+		last = i
+	}
+	if last < 0 {
+		return api.Fixture{}, false
+	}
+	return s.fixtures[last], true
+}
+
+// variablesMatch compares recorded variables with a request's, treating
+// redacted values as wildcards
+func variablesMatch(recorded, sent interface{}) bool {
+	if recorded == api.RedactedValue {
+		return true
+	}
+	switch r := recorded.(type) {
+	case map[string]interface{}:
+		s, ok := sent.(map[string]interface{})
+		if !ok && sent != nil {
+			return false
+		}
+		if len(r) != len(s) {
+			return false
+		}
+		for key, value := range r {
+			if !variablesMatch(value, s[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		s, ok := sent.([]interface{})
+		if !ok || len(r) != len(s) {
+			return false
+		}
+		for i := range r {
+			if !variablesMatch(r[i], s[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(recorded, sent)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]string{{"message": message}},
+	})
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"sync"
+)
+
+// Parallel runs independent requests concurrently and waits for them all.
+// The context passed to each function is cancelled as soon as one fails,
+// and the first error is returned.
+func Parallel(ctx context.Context, fns ...func(ctx context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for _, fn := range fns {
+		wg.Add(1)
+		go func(fn func(ctx context.Context) error) {
+			defer wg.Done()
+			if err := fn(ctx); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(fn)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// IssueWorkContext is what commands that act on an issue for the viewer
+// need: the viewer, the issue and its team's workflow states
+type IssueWorkContext struct {
+	Viewer Viewer
+	Issue  *IssueDetail
+	States []WorkflowState
+}
+
+// GetIssueWorkContext fetches the viewer, an issue and the workflow states
+// of the issue's team in a single request
+func (c *Client) GetIssueWorkContext(ctx context.Context, issueID string) (*IssueWorkContext, error) {
+	var query struct {
+		Viewer struct {
+			ID          string `graphql:"id"`
+			Name        string `graphql:"name"`
+			DisplayName string `graphql:"displayName"`
+			Email       string `graphql:"email"`
+		} `graphql:"viewer"`
+		Issue     issueDetailNode `graphql:"issue(id: $id)"`
+		IssueTeam struct {
+			Team struct {
+				States struct {
+					Nodes []struct {
+						ID       string  `graphql:"id"`
+						Name     string  `graphql:"name"`
+						Type     string  `graphql:"type"`
+						Position float64 `graphql:"position"`
+						Color    string  `graphql:"color"`
+					} `graphql:"nodes"`
+				} `graphql:"states"`
+			} `graphql:"team"`
+		} `graphql:"issueTeam: issue(id: $id)"`
+	}
+
+	variables := IssueExpand{Children: true, Relations: true}.variables(map[string]interface{}{
+		"id": issueID,
+	})
+
+	if err := c.Query(ctx, &query, variables); err != nil {
+		return nil, err
+	}
+
+	wc := &IssueWorkContext{
+		Viewer: Viewer{
+			ID:          query.Viewer.ID,
+			Name:        query.Viewer.Name,
+			DisplayName: query.Viewer.DisplayName,
+			Email:       query.Viewer.Email,
+		},
+		Issue: query.Issue.detail(),
+	}
+	for _, s := range query.IssueTeam.Team.States.Nodes {
+		wc.States = append(wc.States, WorkflowState{
+			ID:       s.ID,
+			Name:     s.Name,
+			Type:     s.Type,
+			Position: int(s.Position),
+			Color:    s.Color,
+		})
+	}
+	return wc, nil
+}
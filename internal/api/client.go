@@ -1,13 +1,24 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/hasura/go-graphql-client"
 	"github.com/juanbermudez/agent-linear-cli/internal/auth"
+	"github.com/juanbermudez/agent-linear-cli/internal/daemon"
 )
 
 const (
@@ -15,14 +26,56 @@ const (
 	LinearAPIEndpoint = "https://api.linear.app/graphql"
 )
 
+// apiEndpoint is the GraphQL endpoint of clients created by NewClient
+var apiEndpoint = LinearAPIEndpoint
+
+// SetEndpoint sets the GraphQL endpoint of clients created afterwards, such
+// as a mock server or a proxy in front of the API
+func SetEndpoint(endpoint string) error {
+	if err := ValidateEndpoint(endpoint); err != nil {
+		return err
+	}
+	apiEndpoint = endpoint
+	return nil
+}
+
+// Endpoint returns the GraphQL endpoint of clients created by NewClient
+func Endpoint() string {
+	return apiEndpoint
+}
+
+// ValidateEndpoint checks that endpoint is an absolute http or https URL
+func ValidateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("invalid API endpoint '%s': use an http or https URL such as %s", endpoint, LinearAPIEndpoint)
+	}
+	return nil
+}
+
 // Client is the Linear API client
 type Client struct {
 	graphql    *graphql.Client
 	httpClient *http.Client
+	retry      *retryTransport
+	endpoint   string
+
+	// token and base are kept for downloads, which authorize only some
+	// hosts
+	token string
+	base  http.RoundTripper
 }
 
-// NewClient creates a new Linear API client using the auth manager
+// NewClient creates a new Linear API client using the auth manager.
+//
+// When a daemon started with 'linear daemon start' is running, requests go
+// through it and use its credentials, unless LINEAR_API_KEY is set.
 func NewClient(ctx context.Context) (*Client, error) {
+	if t := daemon.Transport(); t != nil {
+		logf("using daemon at %s", daemon.SocketPath())
+		return newClient(os.Getenv("LINEAR_API_KEY"), t), nil
+	}
+
 	manager := auth.NewManager()
 	token, _, err := manager.GetToken(ctx)
 	if err != nil {
@@ -34,17 +87,65 @@ func NewClient(ctx context.Context) (*Client, error) {
 
 // NewClientWithToken creates a new Linear API client with a specific token
 func NewClientWithToken(token string) *Client {
+	return newClient(token, http.DefaultTransport)
+}
+
+// NewClientWithEndpoint creates a client with a specific token that sends
+// requests to endpoint instead of the configured one
+func NewClientWithEndpoint(token, endpoint string) *Client {
+	return newClientAt(token, endpoint, http.DefaultTransport)
+}
+
+// newClient creates a client sending requests over base. Without a token,
+// base is expected to authorize them.
+func newClient(token string, base http.RoundTripper) *Client {
+	return newClientAt(token, apiEndpoint, base)
+}
+
+func newClientAt(token, endpoint string, base http.RoundTripper) *Client {
+	retry := &retryTransport{
+		base: newLogTransport(newRecordTransport(base)),
+		opts: retryOptions,
+	}
+	var cached http.RoundTripper = retry
+	// Responses served from the cache would be missing from a recording
+	if recordDir == "" {
+		cached = newCacheTransport(retry)
+	}
 	httpClient := &http.Client{
 		Transport: &authTransport{
 			token: token,
-			base:  http.DefaultTransport,
+			base:  cached,
 		},
 	}
 
 	return &Client{
-		graphql:    graphql.NewClient(LinearAPIEndpoint, httpClient),
+		graphql:    graphql.NewClient(endpoint, httpClient),
 		httpClient: httpClient,
+		retry:      retry,
+		endpoint:   endpoint,
+		token:      token,
+		base:       base,
+	}
+}
+
+// RateLimit returns the rate limit budget reported by the client's most
+// recent response, or nil if none has reported it yet
+func (c *Client) RateLimit() *RateLimit {
+	return c.retry.RateLimit()
+}
+
+// GetRateLimit makes a minimal request and returns the rate limit budget
+// it reports
+func (c *Client) GetRateLimit(ctx context.Context) (*RateLimit, error) {
+	if _, err := c.RawGraphQL(ctx, "{ viewer { id } }", nil); err != nil {
+		return nil, err
+	}
+	rl := c.RateLimit()
+	if rl == nil {
+		return nil, fmt.Errorf("the API did not report rate limit headers")
 	}
+	return rl, nil
 }
 
 // authTransport adds the Authorization header to all requests
@@ -54,19 +155,59 @@ type authTransport struct {
 }
 
 func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", t.token)
+	if t.token != "" {
+		req.Header.Set("Authorization", t.token)
+	}
 	req.Header.Set("Content-Type", "application/json")
 	return t.base.RoundTrip(req)
 }
 
-// Query executes a GraphQL query
+// Query executes a GraphQL query. API failures are returned as *Error.
 func (c *Client) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
-	return c.graphql.Query(ctx, q, variables)
+	return classifyError(c.graphql.Query(ctx, q, variables))
 }
 
-// Mutate executes a GraphQL mutation
+// Mutate executes a GraphQL mutation. API failures are returned as *Error.
 func (c *Client) Mutate(ctx context.Context, m interface{}, variables map[string]interface{}) error {
-	return c.graphql.Mutate(ctx, m, variables)
+	return classifyError(c.graphql.Mutate(ctx, m, variables))
+}
+
+// exec executes a raw GraphQL document, decoding the data into v
+func (c *Client) exec(ctx context.Context, query string, v interface{}, variables map[string]interface{}) error {
+	return classifyError(c.graphql.Exec(ctx, query, v, variables))
+}
+
+// RawGraphQL sends a query document as-is and returns the undecoded
+// response body, including any "errors" the server reported. Only
+// transport failures and non-JSON responses are returned as errors.
+func (c *Client) RawGraphQL(ctx context.Context, query string, variables map[string]interface{}) (json.RawMessage, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("unexpected response (%s): %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
 }
 
 // Viewer represents the authenticated user
@@ -78,6 +219,8 @@ type Viewer struct {
 	Active      bool   `json:"active"`
 	Admin       bool   `json:"admin"`
 	AvatarUrl   string `json:"avatarUrl,omitempty"`
+	// App is set when authenticated as an OAuth app (actor=app)
+	App bool `json:"app"`
 }
 
 // Organization represents a Linear organization
@@ -105,6 +248,7 @@ func (c *Client) GetViewer(ctx context.Context) (*ViewerResponse, error) {
 			Active      bool   `graphql:"active"`
 			Admin       bool   `graphql:"admin"`
 			AvatarUrl   string `graphql:"avatarUrl"`
+			App         bool   `graphql:"app"`
 		} `graphql:"viewer"`
 		Organization struct {
 			ID      string `graphql:"id"`
@@ -114,7 +258,7 @@ func (c *Client) GetViewer(ctx context.Context) (*ViewerResponse, error) {
 		} `graphql:"organization"`
 	}
 
-	if err := c.Query(ctx, &query, nil); err != nil {
+	if err := c.Query(cacheable(ctx), &query, nil); err != nil {
 		return nil, err
 	}
 
@@ -127,6 +271,7 @@ func (c *Client) GetViewer(ctx context.Context) (*ViewerResponse, error) {
 			Active:      query.Viewer.Active,
 			Admin:       query.Viewer.Admin,
 			AvatarUrl:   query.Viewer.AvatarUrl,
+			App:         query.Viewer.App,
 		},
 		Organization: Organization{
 			ID:      query.Organization.ID,
@@ -216,6 +361,7 @@ type User struct {
 	Email       string `json:"email"`
 	Active      bool   `json:"active"`
 	Admin       bool   `json:"admin"`
+	URL         string `json:"url,omitempty"`
 }
 
 // WorkflowState represents a workflow state
@@ -326,51 +472,102 @@ type Comment struct {
 	Parent *struct {
 		ID string `json:"id"`
 	} `json:"parent,omitempty"`
+	Reactions      []Reaction     `json:"reactions,omitempty"`
+	ReactionCounts map[string]int `json:"reactionCounts,omitempty"`
+}
+
+// Reaction is an emoji reaction on a comment or issue
+type Reaction struct {
+	ID    string `json:"id"`
+	Emoji string `json:"emoji"`
+	User  *struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+	} `json:"user,omitempty"`
 }
 
+// ReactionCreateInput represents input for adding a reaction; set one of
+// CommentID or IssueID
+type ReactionCreateInput struct {
+	Emoji           string `json:"emoji"`
+	CommentID       string `json:"commentId,omitempty"`
+	IssueID         string `json:"issueId,omitempty"`
+	ProjectUpdateID string `json:"projectUpdateId,omitempty"`
+}
+
+
 // IssueDetail represents a full issue with all details
 type IssueDetail struct {
-	ID               string          `json:"id"`
-	Identifier       string          `json:"identifier"`
-	Title            string          `json:"title"`
-	Description      string          `json:"description,omitempty"`
-	URL              string          `json:"url"`
-	BranchName       string          `json:"branchName,omitempty"`
-	Priority         int             `json:"priority"`
-	Estimate         *float64        `json:"estimate,omitempty"`
-	DueDate          string          `json:"dueDate,omitempty"`
-	CreatedAt        string          `json:"createdAt"`
-	UpdatedAt        string          `json:"updatedAt"`
-	State            IssueState      `json:"state"`
-	Assignee         *IssueAssignee  `json:"assignee,omitempty"`
-	Team             IssueTeam       `json:"team"`
-	Project          *IssueProject   `json:"project,omitempty"`
-	ProjectMilestone *IssueMilestone `json:"projectMilestone,omitempty"`
-	Cycle            *IssueCycle     `json:"cycle,omitempty"`
-	Parent           *IssueParent    `json:"parent,omitempty"`
-	Children         []IssueChild    `json:"children,omitempty"`
-	Relations        []IssueRelation `json:"relations,omitempty"`
-	Labels           []IssueLabel    `json:"labels,omitempty"`
-	Comments         []Comment       `json:"comments,omitempty"`
+	ID               string              `json:"id"`
+	Identifier       string              `json:"identifier"`
+	Title            string              `json:"title"`
+	Description      string              `json:"description,omitempty"`
+	URL              string              `json:"url"`
+	BranchName       string              `json:"branchName,omitempty"`
+	Priority         int                 `json:"priority"`
+	Estimate         *float64            `json:"estimate,omitempty"`
+	DueDate          string              `json:"dueDate,omitempty"`
+	CreatedAt        string              `json:"createdAt"`
+	UpdatedAt        string              `json:"updatedAt"`
+	State            IssueState          `json:"state"`
+	Assignee         *IssueAssignee      `json:"assignee,omitempty"`
+	Team             IssueTeam           `json:"team"`
+	Project          *IssueProject       `json:"project,omitempty"`
+	ProjectMilestone *IssueMilestone     `json:"projectMilestone,omitempty"`
+	Cycle            *IssueCycle         `json:"cycle,omitempty"`
+	Parent           *IssueParent        `json:"parent,omitempty"`
+	Children         []IssueChild        `json:"children,omitempty"`
+	Relations        []IssueRelation     `json:"relations,omitempty"`
+	Labels           []IssueLabel        `json:"labels,omitempty"`
+	Comments         []Comment           `json:"comments,omitempty"`
+	Attachments      []Attachment        `json:"attachments,omitempty"`
+	History          []IssueHistoryEntry `json:"history,omitempty"`
+}
+
+// IssueHistoryEntry is one change from an issue's history. Only the
+// fields the change touched are set.
+type IssueHistoryEntry struct {
+	ID                 string   `json:"id"`
+	CreatedAt          string   `json:"createdAt"`
+	Actor              string   `json:"actor,omitempty"`
+	FromState          string   `json:"fromState,omitempty"`
+	ToState            string   `json:"toState,omitempty"`
+	FromAssignee       string   `json:"fromAssignee,omitempty"`
+	ToAssignee         string   `json:"toAssignee,omitempty"`
+	FromPriority       *int     `json:"fromPriority,omitempty"`
+	ToPriority         *int     `json:"toPriority,omitempty"`
+	FromTitle          string   `json:"fromTitle,omitempty"`
+	ToTitle            string   `json:"toTitle,omitempty"`
+	AddedLabels        []string `json:"addedLabels,omitempty"`
+	RemovedLabels      []string `json:"removedLabels,omitempty"`
+	UpdatedDescription bool     `json:"updatedDescription,omitempty"`
 }
 
 // IssueListItem represents an issue in a list
 type IssueListItem struct {
-	ID         string         `json:"id"`
-	Identifier string         `json:"identifier"`
-	Title      string         `json:"title"`
-	Priority   int            `json:"priority"`
-	Estimate   *float64       `json:"estimate,omitempty"`
-	State      IssueState     `json:"state"`
-	Assignee   *IssueAssignee `json:"assignee,omitempty"`
-	Labels     []IssueLabel   `json:"labels,omitempty"`
-	UpdatedAt  string         `json:"updatedAt"`
+	ID            string         `json:"id"`
+	Identifier    string         `json:"identifier"`
+	Title         string         `json:"title"`
+	Priority      int            `json:"priority"`
+	Estimate      *float64       `json:"estimate,omitempty"`
+	State         IssueState     `json:"state"`
+	Assignee      *IssueAssignee `json:"assignee,omitempty"`
+	Labels        []IssueLabel   `json:"labels,omitempty"`
+	DueDate       string         `json:"dueDate,omitempty"`
+	SLABreachesAt string         `json:"slaBreachesAt,omitempty"`
+	CreatedAt     string         `json:"createdAt,omitempty"`
+	UpdatedAt     string         `json:"updatedAt"`
+	// BlockedBy and Blocking are the open issues blocking this one and
+	// those it blocks; lists only fill them in when asked to
+	BlockedBy []GraphIssue `json:"blockedBy,omitempty"`
+	Blocking  []GraphIssue `json:"blocking,omitempty"`
 }
 
 // IssuesResponse is the response for issues list
 type IssuesResponse struct {
-	Issues []IssueListItem `json:"issues"`
-	Count  int             `json:"count"`
+	Issues   []IssueListItem `json:"issues"`
+	Count    int             `json:"count"`
+	PageInfo *PageInfo       `json:"pageInfo,omitempty"`
 }
 
 // IssueCreateInput represents input for creating an issue
@@ -388,12 +585,18 @@ type IssueCreateInput struct {
 	ParentID           string   `json:"parentId,omitempty"`
 	CycleID            string   `json:"cycleId,omitempty"`
 	ProjectMilestoneID string   `json:"projectMilestoneId,omitempty"`
+	// CreateAsUser and DisplayIconURL attribute the issue to a user when
+	// creating it with an app token; they default to the current Actor
+	CreateAsUser   string `json:"createAsUser,omitempty"`
+	DisplayIconURL string `json:"displayIconUrl,omitempty"`
 }
 
 // IssueUpdateInput represents input for updating an issue
 type IssueUpdateInput struct {
-	Title              string   `json:"title,omitempty"`
-	Description        string   `json:"description,omitempty"`
+	Title string `json:"title,omitempty"`
+	// Description is a pointer so that an empty description can be sent
+	// to clear it
+	Description        *string  `json:"description,omitempty"`
 	AssigneeID         string   `json:"assigneeId,omitempty"`
 	Priority           *int     `json:"priority,omitempty"`
 	Estimate           *float64 `json:"estimate,omitempty"`
@@ -404,6 +607,34 @@ type IssueUpdateInput struct {
 	ParentID           string   `json:"parentId,omitempty"`
 	CycleID            string   `json:"cycleId,omitempty"`
 	ProjectMilestoneID string   `json:"projectMilestoneId,omitempty"`
+	// SortOrder is the position in the issue's board column, lowest first
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
+
+// isEmpty reports whether no fields are set on the update input
+func (i IssueUpdateInput) isEmpty() bool {
+	return i.Title == "" && i.Description == nil && i.AssigneeID == "" &&
+		i.Priority == nil && i.Estimate == nil && i.DueDate == "" &&
+		len(i.LabelIDs) == 0 && i.ProjectID == "" && i.StateID == "" &&
+		i.ParentID == "" && i.CycleID == "" && i.ProjectMilestoneID == "" &&
+		i.SortOrder == nil
+}
+
+// CommentCreateInput represents input for creating a comment
+type CommentCreateInput struct {
+	IssueID         string `json:"issueId,omitempty"`
+	ProjectUpdateID string `json:"projectUpdateId,omitempty"`
+	Body            string `json:"body"`
+	ParentID        string `json:"parentId,omitempty"`
+	CreateAsUser    string `json:"createAsUser,omitempty"`
+	DisplayIconURL  string `json:"displayIconUrl,omitempty"`
+}
+
+// IssueRelationCreateInput represents input for relating two issues
+type IssueRelationCreateInput struct {
+	IssueID        string `json:"issueId"`
+	RelatedIssueID string `json:"relatedIssueId"`
+	Type           string `json:"type"`
 }
 
 // IssueCreateResponse is the response for creating an issue
@@ -443,7 +674,7 @@ func (c *Client) GetTeams(ctx context.Context) (*TeamsResponse, error) {
 		} `graphql:"teams"`
 	}
 
-	if err := c.Query(ctx, &query, nil); err != nil {
+	if err := c.Query(cacheable(ctx), &query, nil); err != nil {
 		return nil, err
 	}
 
@@ -497,6 +728,246 @@ func (c *Client) GetTeamByKey(ctx context.Context, key string) (*Team, error) {
 	}, nil
 }
 
+// TeamCreateInput is the input for creating a team
+type TeamCreateInput struct {
+	Name        string `json:"name"`
+	Key         string `json:"key"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateTeam creates a team. Linear gives it the default workflow states.
+func (c *Client) CreateTeam(ctx context.Context, input TeamCreateInput) (*Team, error) {
+	mutation := `mutation($input: TeamCreateInput!) {
+		teamCreate(input: $input) {
+			success
+			team {
+				id
+				key
+				name
+			}
+		}
+	}`
+	variables := map[string]interface{}{"input": input}
+
+	var result struct {
+		TeamCreate struct {
+			Success bool `json:"success"`
+			Team    Team `json:"team"`
+		} `json:"teamCreate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.TeamCreate.Success {
+		return nil, fmt.Errorf("failed to create team")
+	}
+
+	return &result.TeamCreate.Team, nil
+}
+
+// TeamSettings are a team's cycle, estimation and automation settings
+type TeamSettings struct {
+	CyclesEnabled       bool     `json:"cyclesEnabled"`
+	CycleDuration       int      `json:"cycleDuration,omitempty"`
+	CycleStartDay       int      `json:"cycleStartDay"`
+	EstimationType      string   `json:"estimationType"`
+	EstimationAllowZero bool     `json:"estimationAllowZero"`
+	EstimationExtended  bool     `json:"estimationExtended"`
+	DefaultEstimate     float64  `json:"defaultEstimate"`
+	TriageEnabled       bool     `json:"triageEnabled"`
+	AutoArchiveMonths   float64  `json:"autoArchiveMonths"`
+	AutoCloseMonths     *float64 `json:"autoCloseMonths,omitempty"`
+}
+
+// TeamDetail is a team with its settings, members, workflow states and
+// active cycle
+type TeamDetail struct {
+	ID          string          `json:"id"`
+	Key         string          `json:"key"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Color       string          `json:"color,omitempty"`
+	Icon        string          `json:"icon,omitempty"`
+	Timezone    string          `json:"timezone"`
+	Private     bool            `json:"private"`
+	CreatedAt   string          `json:"createdAt"`
+	Settings    TeamSettings    `json:"settings"`
+	ActiveCycle *Cycle          `json:"activeCycle,omitempty"`
+	LabelCount  int             `json:"labelCount"`
+	Members     []User          `json:"members"`
+	States      []WorkflowState `json:"states"`
+}
+
+// GetTeamDetail fetches a team by key with its settings, members, workflow
+// states and active cycle in one request. It returns nil when no team has
+// the key.
+func (c *Client) GetTeamDetail(ctx context.Context, key string) (*TeamDetail, error) {
+	var query struct {
+		Teams struct {
+			Nodes []struct {
+				ID                       string   `graphql:"id"`
+				Key                      string   `graphql:"key"`
+				Name                     string   `graphql:"name"`
+				Description              string   `graphql:"description"`
+				Color                    string   `graphql:"color"`
+				Icon                     string   `graphql:"icon"`
+				Timezone                 string   `graphql:"timezone"`
+				Private                  bool     `graphql:"private"`
+				CreatedAt                string   `graphql:"createdAt"`
+				CyclesEnabled            bool     `graphql:"cyclesEnabled"`
+				CycleDuration            float64  `graphql:"cycleDuration"`
+				CycleStartDay            float64  `graphql:"cycleStartDay"`
+				IssueEstimationType      string   `graphql:"issueEstimationType"`
+				IssueEstimationAllowZero bool     `graphql:"issueEstimationAllowZero"`
+				IssueEstimationExtended  bool     `graphql:"issueEstimationExtended"`
+				DefaultIssueEstimate     float64  `graphql:"defaultIssueEstimate"`
+				TriageEnabled            bool     `graphql:"triageEnabled"`
+				AutoArchivePeriod        float64  `graphql:"autoArchivePeriod"`
+				AutoClosePeriod          *float64 `graphql:"autoClosePeriod"`
+				ActiveCycle              *struct {
+					ID          string  `graphql:"id"`
+					Number      float64 `graphql:"number"`
+					Name        string  `graphql:"name"`
+					StartsAt    string  `graphql:"startsAt"`
+					EndsAt      string  `graphql:"endsAt"`
+					CompletedAt string  `graphql:"completedAt"`
+					Progress    float64 `graphql:"progress"`
+				} `graphql:"activeCycle"`
+				Labels struct {
+					Nodes []struct {
+						ID string `graphql:"id"`
+					} `graphql:"nodes"`
+				} `graphql:"labels(first: 250)"`
+				Members struct {
+					Nodes []struct {
+						ID          string `graphql:"id"`
+						Name        string `graphql:"name"`
+						DisplayName string `graphql:"displayName"`
+						Email       string `graphql:"email"`
+						Active      bool   `graphql:"active"`
+						Admin       bool   `graphql:"admin"`
+					} `graphql:"nodes"`
+				} `graphql:"members(first: 250)"`
+				States struct {
+					Nodes []struct {
+						ID       string  `graphql:"id"`
+						Name     string  `graphql:"name"`
+						Type     string  `graphql:"type"`
+						Position float64 `graphql:"position"`
+						Color    string  `graphql:"color"`
+					} `graphql:"nodes"`
+				} `graphql:"states"`
+			} `graphql:"nodes"`
+		} `graphql:"teams(filter: {key: {eq: $key}})"`
+	}
+
+	variables := map[string]interface{}{
+		"key": key,
+	}
+
+	if err := c.Query(ctx, &query, variables); err != nil {
+		return nil, err
+	}
+
+	if len(query.Teams.Nodes) == 0 {
+		return nil, nil
+	}
+
+	t := query.Teams.Nodes[0]
+	team := &TeamDetail{
+		ID:          t.ID,
+		Key:         t.Key,
+		Name:        t.Name,
+		Description: t.Description,
+		Color:       t.Color,
+		Icon:        t.Icon,
+		Timezone:    t.Timezone,
+		Private:     t.Private,
+		CreatedAt:   t.CreatedAt,
+		Settings: TeamSettings{
+			CyclesEnabled:       t.CyclesEnabled,
+			CycleDuration:       int(t.CycleDuration),
+			CycleStartDay:       int(t.CycleStartDay),
+			EstimationType:      t.IssueEstimationType,
+			EstimationAllowZero: t.IssueEstimationAllowZero,
+			EstimationExtended:  t.IssueEstimationExtended,
+			DefaultEstimate:     t.DefaultIssueEstimate,
+			TriageEnabled:       t.TriageEnabled,
+			AutoArchiveMonths:   t.AutoArchivePeriod,
+			AutoCloseMonths:     t.AutoClosePeriod,
+		},
+		LabelCount: len(t.Labels.Nodes),
+		Members:    make([]User, len(t.Members.Nodes)),
+		States:     make([]WorkflowState, len(t.States.Nodes)),
+	}
+	if c := t.ActiveCycle; c != nil {
+		team.ActiveCycle = &Cycle{
+			ID:          c.ID,
+			Number:      int(c.Number),
+			Name:        c.Name,
+			StartsAt:    c.StartsAt,
+			EndsAt:      c.EndsAt,
+			CompletedAt: c.CompletedAt,
+			Progress:    c.Progress,
+		}
+	}
+	for i, u := range t.Members.Nodes {
+		team.Members[i] = User{
+			ID:          u.ID,
+			Name:        u.Name,
+			DisplayName: u.DisplayName,
+			Email:       u.Email,
+			Active:      u.Active,
+			Admin:       u.Admin,
+		}
+	}
+	for i, s := range t.States.Nodes {
+		team.States[i] = WorkflowState{
+			ID:       s.ID,
+			Name:     s.Name,
+			Type:     s.Type,
+			Position: int(s.Position),
+			Color:    s.Color,
+		}
+	}
+
+	return team, nil
+}
+
+// TeamEstimation is a team's issue estimation scale
+type TeamEstimation struct {
+	Type      string `json:"type"`
+	AllowZero bool   `json:"allowZero"`
+	Extended  bool   `json:"extended"`
+}
+
+// GetTeamEstimation fetches a team's estimation settings
+func (c *Client) GetTeamEstimation(ctx context.Context, teamID string) (*TeamEstimation, error) {
+	var query struct {
+		Team struct {
+			IssueEstimationType      string `graphql:"issueEstimationType"`
+			IssueEstimationAllowZero bool   `graphql:"issueEstimationAllowZero"`
+			IssueEstimationExtended  bool   `graphql:"issueEstimationExtended"`
+		} `graphql:"team(id: $teamId)"`
+	}
+
+	variables := map[string]interface{}{
+		"teamId": teamID,
+	}
+
+	if err := c.Query(ctx, &query, variables); err != nil {
+		return nil, err
+	}
+
+	return &TeamEstimation{
+		Type:      query.Team.IssueEstimationType,
+		AllowZero: query.Team.IssueEstimationAllowZero,
+		Extended:  query.Team.IssueEstimationExtended,
+	}, nil
+}
+
 // UsersResponse is the response for users query
 type UsersResponse struct {
 	Users []User `json:"users"`
@@ -514,11 +985,12 @@ func (c *Client) GetUsers(ctx context.Context) (*UsersResponse, error) {
 				Email       string `graphql:"email"`
 				Active      bool   `graphql:"active"`
 				Admin       bool   `graphql:"admin"`
+				URL         string `graphql:"url"`
 			} `graphql:"nodes"`
 		} `graphql:"users"`
 	}
 
-	if err := c.Query(ctx, &query, nil); err != nil {
+	if err := c.Query(cacheable(ctx), &query, nil); err != nil {
 		return nil, err
 	}
 
@@ -531,6 +1003,7 @@ func (c *Client) GetUsers(ctx context.Context) (*UsersResponse, error) {
 			Email:       u.Email,
 			Active:      u.Active,
 			Admin:       u.Admin,
+			URL:         u.URL,
 		}
 	}
 
@@ -566,7 +1039,7 @@ func (c *Client) GetWorkflowStates(ctx context.Context, teamID string) (*Workflo
 		"teamId": teamID,
 	}
 
-	if err := c.Query(ctx, &query, variables); err != nil {
+	if err := c.Query(cacheable(ctx), &query, variables); err != nil {
 		return nil, err
 	}
 
@@ -587,732 +1060,671 @@ func (c *Client) GetWorkflowStates(ctx context.Context, teamID string) (*Workflo
 	}, nil
 }
 
-// LabelsResponse is the response for labels query
-type LabelsResponse struct {
-	Labels []Label `json:"labels"`
-	Count  int     `json:"count"`
+// WorkflowStateCreateInput is the input for creating a workflow state
+type WorkflowStateCreateInput struct {
+	TeamID      string   `json:"teamId"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Color       string   `json:"color"`
+	Description string   `json:"description,omitempty"`
+	Position    *float64 `json:"position,omitempty"`
 }
 
-// GetLabels fetches labels for a team
-func (c *Client) GetLabels(ctx context.Context, teamID string) (*LabelsResponse, error) {
-	var query struct {
-		Team struct {
-			Labels struct {
-				Nodes []struct {
-					ID          string `graphql:"id"`
-					Name        string `graphql:"name"`
-					Color       string `graphql:"color"`
-					Description string `graphql:"description"`
-					Parent      *struct {
-						ID string `graphql:"id"`
-					} `graphql:"parent"`
-				} `graphql:"nodes"`
-			} `graphql:"labels"`
-		} `graphql:"team(id: $teamId)"`
-	}
+// WorkflowStateUpdateInput is the input for updating a workflow state.
+// Nil fields are left unchanged; a state's type cannot be changed.
+type WorkflowStateUpdateInput struct {
+	Name        *string  `json:"name,omitempty"`
+	Color       *string  `json:"color,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Position    *float64 `json:"position,omitempty"`
+}
 
-	variables := map[string]interface{}{
-		"teamId": teamID,
+// workflowStateNode is the state selection returned by state mutations
+type workflowStateNode struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Position float64 `json:"position"`
+	Color    string  `json:"color"`
+}
+
+func (n workflowStateNode) state() *WorkflowState {
+	return &WorkflowState{ID: n.ID, Name: n.Name, Type: n.Type, Position: int(n.Position), Color: n.Color}
+}
+
+// CreateWorkflowState creates a workflow state for a team
+func (c *Client) CreateWorkflowState(ctx context.Context, input WorkflowStateCreateInput) (*WorkflowState, error) {
+	mutation := `mutation($input: WorkflowStateCreateInput!) {
+		workflowStateCreate(input: $input) {
+			success
+			workflowState {
+				id
+				name
+				type
+				position
+				color
+			}
+		}
+	}`
+	variables := map[string]interface{}{"input": input}
+
+	var result struct {
+		WorkflowStateCreate struct {
+			Success       bool              `json:"success"`
+			WorkflowState workflowStateNode `json:"workflowState"`
+		} `json:"workflowStateCreate"`
 	}
 
-	if err := c.Query(ctx, &query, variables); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return nil, err
 	}
 
-	labels := make([]Label, len(query.Team.Labels.Nodes))
-	for i, l := range query.Team.Labels.Nodes {
-		labels[i] = Label{
-			ID:    l.ID,
-			Name:  l.Name,
-			Color: l.Color,
-		}
-		if l.Parent != nil {
-			labels[i].ParentID = l.Parent.ID
-		}
+	if !result.WorkflowStateCreate.Success {
+		return nil, fmt.Errorf("failed to create workflow state")
 	}
 
-	return &LabelsResponse{
-		Labels: labels,
-		Count:  len(labels),
-	}, nil
-}
-
-// IssueFilter contains filters for listing issues
-type IssueFilter struct {
-	TeamID     string
-	StateTypes []string // triage, backlog, unstarted, started, completed, canceled
-	AssigneeID string
-	Unassigned bool
-	ProjectID  string
+	return result.WorkflowStateCreate.WorkflowState.state(), nil
 }
 
-// GetIssues fetches issues with filters
-func (c *Client) GetIssues(ctx context.Context, filter IssueFilter, limit int, sortBy string) (*IssuesResponse, error) {
-	// Build filter conditions for the query
-	filterParts := []string{}
-
-	if filter.TeamID != "" {
-		filterParts = append(filterParts, fmt.Sprintf(`team: { id: { eq: "%s" } }`, filter.TeamID))
+// UpdateWorkflowState updates a workflow state
+func (c *Client) UpdateWorkflowState(ctx context.Context, stateID string, input WorkflowStateUpdateInput) (*WorkflowState, error) {
+	if input.Name == nil && input.Color == nil && input.Description == nil && input.Position == nil {
+		return nil, fmt.Errorf("no fields to update")
 	}
 
-	if len(filter.StateTypes) > 0 {
-		types := ""
-		for i, t := range filter.StateTypes {
-			if i > 0 {
-				types += ", "
+	mutation := `mutation($id: String!, $input: WorkflowStateUpdateInput!) {
+		workflowStateUpdate(id: $id, input: $input) {
+			success
+			workflowState {
+				id
+				name
+				type
+				position
+				color
 			}
-			types += fmt.Sprintf(`"%s"`, t)
 		}
-		filterParts = append(filterParts, fmt.Sprintf(`state: { type: { in: [%s] } }`, types))
+	}`
+	variables := map[string]interface{}{"id": stateID, "input": input}
+
+	var result struct {
+		WorkflowStateUpdate struct {
+			Success       bool              `json:"success"`
+			WorkflowState workflowStateNode `json:"workflowState"`
+		} `json:"workflowStateUpdate"`
 	}
 
-	if filter.Unassigned {
-		filterParts = append(filterParts, `assignee: { null: true }`)
-	} else if filter.AssigneeID != "" {
-		filterParts = append(filterParts, fmt.Sprintf(`assignee: { id: { eq: "%s" } }`, filter.AssigneeID))
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
 	}
 
-	if filter.ProjectID != "" {
-		filterParts = append(filterParts, fmt.Sprintf(`project: { id: { eq: "%s" } }`, filter.ProjectID))
+	if !result.WorkflowStateUpdate.Success {
+		return nil, fmt.Errorf("failed to update workflow state")
 	}
 
-	// Build the filter string
-	filterStr := ""
-	if len(filterParts) > 0 {
-		filterStr = ", filter: { "
-		for i, part := range filterParts {
-			if i > 0 {
-				filterStr += ", "
-			}
-			filterStr += part
+	return result.WorkflowStateUpdate.WorkflowState.state(), nil
+}
+
+// ArchiveWorkflowState archives a workflow state. Linear refuses to archive
+// a state that still has issues or is a team's last state of its type.
+func (c *Client) ArchiveWorkflowState(ctx context.Context, stateID string) error {
+	mutation := `mutation($id: String!) {
+		workflowStateArchive(id: $id) {
+			success
 		}
-		filterStr += " }"
+	}`
+	variables := map[string]interface{}{"id": stateID}
+
+	var result struct {
+		WorkflowStateArchive struct {
+			Success bool `json:"success"`
+		} `json:"workflowStateArchive"`
 	}
 
-	// Build the raw GraphQL query
-	queryStr := fmt.Sprintf(`query {
-		issues(first: %d%s) {
-			nodes {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.WorkflowStateArchive.Success {
+		return fmt.Errorf("failed to archive workflow state")
+	}
+
+	return nil
+}
+
+// ProjectStatus is a workspace-wide project status
+type ProjectStatus struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Position int    `json:"position"`
+}
+
+// ProjectStatusesResponse is the response for project statuses
+type ProjectStatusesResponse struct {
+	ProjectStatuses []ProjectStatus `json:"projectStatuses"`
+	Count           int             `json:"count"`
+}
+
+// GetProjectStatuses fetches the organization's project statuses
+func (c *Client) GetProjectStatuses(ctx context.Context) (*ProjectStatusesResponse, error) {
+	var query struct {
+		ProjectStatuses []struct {
+			ID       string  `graphql:"id"`
+			Name     string  `graphql:"name"`
+			Type     string  `graphql:"type"`
+			Position float64 `graphql:"position"`
+		} `graphql:"projectStatuses"`
+	}
+
+	if err := c.Query(ctx, &query, nil); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]ProjectStatus, len(query.ProjectStatuses))
+	for i, s := range query.ProjectStatuses {
+		statuses[i] = ProjectStatus{
+			ID:       s.ID,
+			Name:     s.Name,
+			Type:     s.Type,
+			Position: int(s.Position),
+		}
+	}
+
+	return &ProjectStatusesResponse{
+		ProjectStatuses: statuses,
+		Count:           len(statuses),
+	}, nil
+}
+
+// ProjectStatusCreateInput is the input for creating a project status.
+// Type is one of backlog, planned, started, paused, completed or canceled.
+type ProjectStatusCreateInput struct {
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Color       string  `json:"color"`
+	Description string  `json:"description,omitempty"`
+	Position    float64 `json:"position"`
+}
+
+// CreateProjectStatus creates a workspace-wide project status
+func (c *Client) CreateProjectStatus(ctx context.Context, input ProjectStatusCreateInput) (*ProjectStatus, error) {
+	mutation := `mutation($input: ProjectStatusCreateInput!) {
+		projectStatusCreate(input: $input) {
+			success
+			status {
 				id
-				identifier
-				title
-				priority
-				estimate
-				updatedAt
-				state {
-					id
-					name
-					type
-					color
-				}
-				assignee {
-					id
-					name
-					displayName
-				}
-				labels {
-					nodes {
-						id
-						name
-						color
-					}
-				}
+				name
+				type
+				position
 			}
 		}
-	}`, limit, filterStr)
+	}`
+	variables := map[string]interface{}{"input": input}
 
-	// Execute raw query
 	var result struct {
-		Issues struct {
-			Nodes []struct {
-				ID         string  `json:"id"`
-				Identifier string  `json:"identifier"`
-				Title      string  `json:"title"`
-				Priority   int     `json:"priority"`
-				Estimate   float64 `json:"estimate"`
-				UpdatedAt  string  `json:"updatedAt"`
-				State      struct {
-					ID    string `json:"id"`
-					Name  string `json:"name"`
-					Type  string `json:"type"`
-					Color string `json:"color"`
-				} `json:"state"`
-				Assignee *struct {
-					ID          string `json:"id"`
-					Name        string `json:"name"`
-					DisplayName string `json:"displayName"`
-				} `json:"assignee"`
-				Labels struct {
-					Nodes []struct {
-						ID    string `json:"id"`
-						Name  string `json:"name"`
-						Color string `json:"color"`
-					} `json:"nodes"`
-				} `json:"labels"`
-			} `json:"nodes"`
-		} `json:"issues"`
+		ProjectStatusCreate struct {
+			Success bool `json:"success"`
+			Status  struct {
+				ID       string  `json:"id"`
+				Name     string  `json:"name"`
+				Type     string  `json:"type"`
+				Position float64 `json:"position"`
+			} `json:"status"`
+		} `json:"projectStatusCreate"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return nil, err
 	}
 
-	issues := make([]IssueListItem, len(result.Issues.Nodes))
-	for i, issue := range result.Issues.Nodes {
-		issues[i] = IssueListItem{
-			ID:         issue.ID,
-			Identifier: issue.Identifier,
-			Title:      issue.Title,
-			Priority:   issue.Priority,
-			UpdatedAt:  issue.UpdatedAt,
-			State: IssueState{
-				ID:    issue.State.ID,
-				Name:  issue.State.Name,
-				Type:  issue.State.Type,
-				Color: issue.State.Color,
-			},
-		}
-		if issue.Estimate > 0 {
-			est := issue.Estimate
-			issues[i].Estimate = &est
-		}
-		if issue.Assignee != nil {
-			issues[i].Assignee = &IssueAssignee{
-				ID:          issue.Assignee.ID,
-				Name:        issue.Assignee.Name,
-				DisplayName: issue.Assignee.DisplayName,
-			}
-		}
-		labels := make([]IssueLabel, len(issue.Labels.Nodes))
-		for j, label := range issue.Labels.Nodes {
-			labels[j] = IssueLabel{
-				ID:    label.ID,
-				Name:  label.Name,
-				Color: label.Color,
-			}
-		}
-		issues[i].Labels = labels
+	if !result.ProjectStatusCreate.Success {
+		return nil, fmt.Errorf("failed to create project status")
 	}
 
-	return &IssuesResponse{
-		Issues: issues,
-		Count:  len(issues),
-	}, nil
+	status := result.ProjectStatusCreate.Status
+	return &ProjectStatus{ID: status.ID, Name: status.Name, Type: status.Type, Position: int(status.Position)}, nil
 }
 
-// GetIssue fetches a single issue by ID or identifier
-func (c *Client) GetIssue(ctx context.Context, issueID string, includeComments bool) (*IssueDetail, error) {
+// LabelsResponse is the response for labels query
+type LabelsResponse struct {
+	Labels []Label `json:"labels"`
+	Count  int     `json:"count"`
+}
+
+// GetLabels fetches labels for a team
+func (c *Client) GetLabels(ctx context.Context, teamID string) (*LabelsResponse, error) {
 	var query struct {
-		Issue struct {
-			ID          string  `graphql:"id"`
-			Identifier  string  `graphql:"identifier"`
-			Title       string  `graphql:"title"`
-			Description string  `graphql:"description"`
-			URL         string  `graphql:"url"`
-			BranchName  string  `graphql:"branchName"`
-			Priority    int     `graphql:"priority"`
-			Estimate    float64 `graphql:"estimate"`
-			DueDate     string  `graphql:"dueDate"`
-			CreatedAt   string  `graphql:"createdAt"`
-			UpdatedAt   string  `graphql:"updatedAt"`
-			State       struct {
-				ID    string `graphql:"id"`
-				Name  string `graphql:"name"`
-				Type  string `graphql:"type"`
-				Color string `graphql:"color"`
-			} `graphql:"state"`
-			Assignee *struct {
-				ID          string `graphql:"id"`
-				Name        string `graphql:"name"`
-				DisplayName string `graphql:"displayName"`
-			} `graphql:"assignee"`
-			Team struct {
-				ID   string `graphql:"id"`
-				Key  string `graphql:"key"`
-				Name string `graphql:"name"`
-			} `graphql:"team"`
-			Project *struct {
-				ID   string `graphql:"id"`
-				Name string `graphql:"name"`
-			} `graphql:"project"`
-			ProjectMilestone *struct {
-				ID         string `graphql:"id"`
-				Name       string `graphql:"name"`
-				TargetDate string `graphql:"targetDate"`
-			} `graphql:"projectMilestone"`
-			Cycle *struct {
-				ID       string `graphql:"id"`
-				Name     string `graphql:"name"`
-				StartsAt string `graphql:"startsAt"`
-				EndsAt   string `graphql:"endsAt"`
-			} `graphql:"cycle"`
-			Parent *struct {
-				ID         string `graphql:"id"`
-				Identifier string `graphql:"identifier"`
-				Title      string `graphql:"title"`
-			} `graphql:"parent"`
-			Children struct {
-				Nodes []struct {
-					ID         string `graphql:"id"`
-					Identifier string `graphql:"identifier"`
-					Title      string `graphql:"title"`
-					State      struct {
-						Name string `graphql:"name"`
-					} `graphql:"state"`
-				} `graphql:"nodes"`
-			} `graphql:"children"`
-			Relations struct {
-				Nodes []struct {
-					ID   string `graphql:"id"`
-					Type string `graphql:"type"`
-					RelatedIssue struct {
-						ID         string `graphql:"id"`
-						Identifier string `graphql:"identifier"`
-						Title      string `graphql:"title"`
-					} `graphql:"relatedIssue"`
-				} `graphql:"nodes"`
-			} `graphql:"relations"`
+		Team struct {
 			Labels struct {
 				Nodes []struct {
-					ID    string `graphql:"id"`
-					Name  string `graphql:"name"`
-					Color string `graphql:"color"`
+					ID          string `graphql:"id"`
+					Name        string `graphql:"name"`
+					Color       string `graphql:"color"`
+					Description string `graphql:"description"`
+					Parent      *struct {
+						ID string `graphql:"id"`
+					} `graphql:"parent"`
 				} `graphql:"nodes"`
 			} `graphql:"labels"`
-		} `graphql:"issue(id: $id)"`
+		} `graphql:"team(id: $teamId)"`
 	}
 
 	variables := map[string]interface{}{
-		"id": issueID,
+		"teamId": teamID,
 	}
 
-	if err := c.Query(ctx, &query, variables); err != nil {
+	if err := c.Query(cacheable(ctx), &query, variables); err != nil {
 		return nil, err
 	}
 
-	issue := &IssueDetail{
-		ID:          query.Issue.ID,
-		Identifier:  query.Issue.Identifier,
-		Title:       query.Issue.Title,
-		Description: query.Issue.Description,
-		URL:         query.Issue.URL,
-		BranchName:  query.Issue.BranchName,
-		Priority:    query.Issue.Priority,
-		DueDate:     query.Issue.DueDate,
-		CreatedAt:   query.Issue.CreatedAt,
-		UpdatedAt:   query.Issue.UpdatedAt,
-		State: IssueState{
-			ID:    query.Issue.State.ID,
-			Name:  query.Issue.State.Name,
-			Type:  query.Issue.State.Type,
-			Color: query.Issue.State.Color,
-		},
-		Team: IssueTeam{
-			ID:   query.Issue.Team.ID,
-			Key:  query.Issue.Team.Key,
-			Name: query.Issue.Team.Name,
-		},
+	labels := make([]Label, len(query.Team.Labels.Nodes))
+	for i, l := range query.Team.Labels.Nodes {
+		labels[i] = Label{
+			ID:    l.ID,
+			Name:  l.Name,
+			Color: l.Color,
+		}
+		if l.Parent != nil {
+			labels[i].ParentID = l.Parent.ID
+		}
 	}
 
-	if query.Issue.Estimate > 0 {
-		est := query.Issue.Estimate
-		issue.Estimate = &est
-	}
+	return &LabelsResponse{
+		Labels: labels,
+		Count:  len(labels),
+	}, nil
+}
 
-	if query.Issue.Assignee != nil {
-		issue.Assignee = &IssueAssignee{
-			ID:          query.Issue.Assignee.ID,
-			Name:        query.Issue.Assignee.Name,
-			DisplayName: query.Issue.Assignee.DisplayName,
-		}
-	}
+// WorkspaceLabel is a label with the team it belongs to; workspace labels
+// have no team
+type WorkspaceLabel struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Color    string `json:"color"`
+	ParentID string `json:"parentId,omitempty"`
+	TeamID   string `json:"teamId,omitempty"`
+	TeamKey  string `json:"teamKey,omitempty"`
+}
 
-	if query.Issue.Project != nil {
-		issue.Project = &IssueProject{
-			ID:   query.Issue.Project.ID,
-			Name: query.Issue.Project.Name,
-		}
-	}
+// workspaceLabelSelection is the issue label selection for WorkspaceLabel
+const workspaceLabelSelection = `id
+				name
+				color
+				parent { id }
+				team { id key }`
+
+// workspaceLabelNode is an issue label as selected by
+// workspaceLabelSelection
+type workspaceLabelNode struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Color  string `json:"color"`
+	Parent *struct {
+		ID string `json:"id"`
+	} `json:"parent"`
+	Team *struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	} `json:"team"`
+}
 
-	if query.Issue.ProjectMilestone != nil {
-		issue.ProjectMilestone = &IssueMilestone{
-			ID:         query.Issue.ProjectMilestone.ID,
-			Name:       query.Issue.ProjectMilestone.Name,
-			TargetDate: query.Issue.ProjectMilestone.TargetDate,
-		}
+func (n workspaceLabelNode) label() WorkspaceLabel {
+	label := WorkspaceLabel{ID: n.ID, Name: n.Name, Color: n.Color}
+	if n.Parent != nil {
+		label.ParentID = n.Parent.ID
 	}
+	if n.Team != nil {
+		label.TeamID = n.Team.ID
+		label.TeamKey = n.Team.Key
+	}
+	return label
+}
 
-	if query.Issue.Cycle != nil {
-		issue.Cycle = &IssueCycle{
-			ID:       query.Issue.Cycle.ID,
-			Name:     query.Issue.Cycle.Name,
-			StartsAt: query.Issue.Cycle.StartsAt,
-			EndsAt:   query.Issue.Cycle.EndsAt,
+// GetWorkspaceLabels fetches a page of labels across the workspace, or of
+// a team and the workspace labels it can use when teamID is set
+func (c *Client) GetWorkspaceLabels(ctx context.Context, teamID string, limit int, after string) ([]WorkspaceLabel, *PageInfo, error) {
+	queryStr := fmt.Sprintf(`query($filter: IssueLabelFilter) {
+		issueLabels(first: %d%s, filter: $filter) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				%s
+			}
 		}
-	}
+	}`, limit, afterArg(after), workspaceLabelSelection)
 
-	if query.Issue.Parent != nil {
-		issue.Parent = &IssueParent{
-			ID:         query.Issue.Parent.ID,
-			Identifier: query.Issue.Parent.Identifier,
-			Title:      query.Issue.Parent.Title,
+	var filter interface{}
+	if teamID != "" {
+		filter = map[string]interface{}{
+			"or": []interface{}{
+				map[string]interface{}{"team": idFilter(teamID)},
+				map[string]interface{}{"team": map[string]interface{}{"null": true}},
+			},
 		}
 	}
 
-	for _, child := range query.Issue.Children.Nodes {
-		issue.Children = append(issue.Children, IssueChild{
-			ID:         child.ID,
-			Identifier: child.Identifier,
-			Title:      child.Title,
-			State:      struct{ Name string `json:"name"` }{Name: child.State.Name},
-		})
+	var result struct {
+		IssueLabels struct {
+			PageInfo PageInfo             `json:"pageInfo"`
+			Nodes    []workspaceLabelNode `json:"nodes"`
+		} `json:"issueLabels"`
 	}
 
-	for _, rel := range query.Issue.Relations.Nodes {
-		issue.Relations = append(issue.Relations, IssueRelation{
-			ID:   rel.ID,
-			Type: rel.Type,
-			RelatedIssue: struct {
-				ID         string `json:"id"`
-				Identifier string `json:"identifier"`
-				Title      string `json:"title"`
-			}{
-				ID:         rel.RelatedIssue.ID,
-				Identifier: rel.RelatedIssue.Identifier,
-				Title:      rel.RelatedIssue.Title,
-			},
-		})
+	if err := c.exec(ctx, queryStr, &result, map[string]interface{}{"filter": filter}); err != nil {
+		return nil, nil, err
 	}
 
-	for _, label := range query.Issue.Labels.Nodes {
-		issue.Labels = append(issue.Labels, IssueLabel{
-			ID:    label.ID,
-			Name:  label.Name,
-			Color: label.Color,
-		})
+	labels := make([]WorkspaceLabel, len(result.IssueLabels.Nodes))
+	for i, node := range result.IssueLabels.Nodes {
+		labels[i] = node.label()
 	}
+	return labels, &result.IssueLabels.PageInfo, nil
+}
 
-	// Fetch comments separately if requested
-	if includeComments {
-		comments, err := c.GetIssueComments(ctx, issueID, 50)
-		if err == nil {
-			issue.Comments = comments
+// GetLabel fetches a label by ID with its team
+func (c *Client) GetLabel(ctx context.Context, labelID string) (*WorkspaceLabel, error) {
+	queryStr := fmt.Sprintf(`query($id: String!) {
+		issueLabel(id: $id) {
+			%s
 		}
+	}`, workspaceLabelSelection)
+
+	var result struct {
+		IssueLabel *workspaceLabelNode `json:"issueLabel"`
 	}
 
-	return issue, nil
+	if err := c.exec(ctx, queryStr, &result, map[string]interface{}{"id": labelID}); err != nil {
+		return nil, err
+	}
+	if result.IssueLabel == nil {
+		return nil, nil
+	}
+	label := result.IssueLabel.label()
+	return &label, nil
 }
 
-// GetIssueComments fetches comments for an issue
-func (c *Client) GetIssueComments(ctx context.Context, issueID string, limit int) ([]Comment, error) {
-	var query struct {
-		Issue struct {
-			Comments struct {
-				Nodes []struct {
-					ID        string `graphql:"id"`
-					Body      string `graphql:"body"`
-					CreatedAt string `graphql:"createdAt"`
-					User      *struct {
-						ID          string `graphql:"id"`
-						Name        string `graphql:"name"`
-						DisplayName string `graphql:"displayName"`
-					} `graphql:"user"`
-					Parent *struct {
-						ID string `graphql:"id"`
-					} `graphql:"parent"`
-				} `graphql:"nodes"`
-			} `graphql:"comments(first: $limit)"`
-		} `graphql:"issue(id: $id)"`
-	}
-
-	variables := map[string]interface{}{
-		"id":    issueID,
-		"limit": limit,
-	}
+// Cycle represents a team cycle
+type Cycle struct {
+	ID          string  `json:"id"`
+	Number      int     `json:"number"`
+	Name        string  `json:"name,omitempty"`
+	StartsAt    string  `json:"startsAt"`
+	EndsAt      string  `json:"endsAt"`
+	CompletedAt string  `json:"completedAt,omitempty"`
+	Progress    float64 `json:"progress"`
+}
 
-	if err := c.Query(ctx, &query, variables); err != nil {
-		return nil, err
-	}
+// CyclesResponse is the response for cycles query
+type CyclesResponse struct {
+	Cycles []Cycle `json:"cycles"`
+	Count  int     `json:"count"`
+}
 
-	comments := make([]Comment, len(query.Issue.Comments.Nodes))
-	for i, c := range query.Issue.Comments.Nodes {
-		comments[i] = Comment{
-			ID:        c.ID,
-			Body:      c.Body,
-			CreatedAt: c.CreatedAt,
-		}
-		if c.User != nil {
-			comments[i].User = &struct {
-				ID          string `json:"id"`
-				Name        string `json:"name"`
-				DisplayName string `json:"displayName"`
-			}{
-				ID:          c.User.ID,
-				Name:        c.User.Name,
-				DisplayName: c.User.DisplayName,
+// GetCycles fetches the cycles of a team, most recent first
+func (c *Client) GetCycles(ctx context.Context, teamID string, limit int) (*CyclesResponse, error) {
+	queryStr := fmt.Sprintf(`query {
+		team(id: %q) {
+			cycles(first: %d, orderBy: createdAt) {
+				nodes {
+					id
+					number
+					name
+					startsAt
+					endsAt
+					completedAt
+					progress
+				}
 			}
 		}
-		if c.Parent != nil {
-			comments[i].Parent = &struct {
-				ID string `json:"id"`
-			}{ID: c.Parent.ID}
-		}
-	}
-
-	return comments, nil
-}
+	}`, teamID, limit)
 
-// CreateIssue creates a new issue
-func (c *Client) CreateIssue(ctx context.Context, input IssueCreateInput) (*IssueCreateResponse, error) {
-	// Build input fields for the mutation
-	inputParts := []string{
-		fmt.Sprintf(`title: %q`, input.Title),
-		fmt.Sprintf(`teamId: %q`, input.TeamID),
+	var result struct {
+		Team struct {
+			Cycles struct {
+				Nodes []Cycle `json:"nodes"`
+			} `json:"cycles"`
+		} `json:"team"`
 	}
 
-	if input.Description != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`description: %q`, input.Description))
-	}
-	if input.AssigneeID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`assigneeId: %q`, input.AssigneeID))
-	}
-	if input.Priority != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`priority: %d`, *input.Priority))
-	}
-	if input.Estimate != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`estimate: %v`, *input.Estimate))
-	}
-	if input.DueDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`dueDate: %q`, input.DueDate))
-	}
-	if len(input.LabelIDs) > 0 {
-		labels := ""
-		for i, id := range input.LabelIDs {
-			if i > 0 {
-				labels += ", "
-			}
-			labels += fmt.Sprintf(`%q`, id)
-		}
-		inputParts = append(inputParts, fmt.Sprintf(`labelIds: [%s]`, labels))
-	}
-	if input.ProjectID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`projectId: %q`, input.ProjectID))
-	}
-	if input.StateID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`stateId: %q`, input.StateID))
-	}
-	if input.ParentID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`parentId: %q`, input.ParentID))
-	}
-	if input.CycleID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`cycleId: %q`, input.CycleID))
-	}
-	if input.ProjectMilestoneID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`projectMilestoneId: %q`, input.ProjectMilestoneID))
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
 	}
 
-	// Build input string
-	inputStr := ""
-	for i, part := range inputParts {
-		if i > 0 {
-			inputStr += ", "
-		}
-		inputStr += part
-	}
+	return &CyclesResponse{
+		Cycles: result.Team.Cycles.Nodes,
+		Count:  len(result.Team.Cycles.Nodes),
+	}, nil
+}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		issueCreate(input: { %s }) {
-			success
-			issue {
+// GetRelativeCycle fetches a team's current, next or previous cycle. It
+// returns nil when the team has no such cycle.
+func (c *Client) GetRelativeCycle(ctx context.Context, teamID, name string) (*Cycle, error) {
+	field, ok := cycleFilters[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown cycle %q: use current, next or previous", name)
+	}
+	queryStr := fmt.Sprintf(`query {
+		cycles(first: 1, filter: {team: {id: {eq: %q}}, %s: {eq: true}}) {
+			nodes {
 				id
-				identifier
-				url
-				team {
-					key
-				}
+				number
+				name
+				startsAt
+				endsAt
+				completedAt
+				progress
 			}
 		}
-	}`, inputStr)
+	}`, teamID, field)
 
 	var result struct {
-		IssueCreate struct {
-			Success bool `json:"success"`
-			Issue   struct {
-				ID         string `json:"id"`
-				Identifier string `json:"identifier"`
-				URL        string `json:"url"`
-				Team       struct {
-					Key string `json:"key"`
-				} `json:"team"`
-			} `json:"issue"`
-		} `json:"issueCreate"`
+		Cycles struct {
+			Nodes []Cycle `json:"nodes"`
+		} `json:"cycles"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
 		return nil, err
 	}
 
-	if !result.IssueCreate.Success {
-		return nil, fmt.Errorf("failed to create issue")
+	if len(result.Cycles.Nodes) == 0 {
+		return nil, nil
 	}
-
-	return &IssueCreateResponse{
-		Success:    true,
-		ID:         result.IssueCreate.Issue.ID,
-		Identifier: result.IssueCreate.Issue.Identifier,
-		URL:        result.IssueCreate.Issue.URL,
-		TeamKey:    result.IssueCreate.Issue.Team.Key,
-	}, nil
+	return &result.Cycles.Nodes[0], nil
 }
 
-// UpdateIssue updates an existing issue
-func (c *Client) UpdateIssue(ctx context.Context, issueID string, input IssueUpdateInput) (*IssueCreateResponse, error) {
-	// Build input fields for the mutation
-	inputParts := []string{}
+// PageInfo describes the cursor position of a paginated list
+type PageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor,omitempty"`
+}
 
-	if input.Title != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`title: %q`, input.Title))
+// afterArg returns the GraphQL "after" argument for a cursor, if any
+func afterArg(after string) string {
+	if after == "" {
+		return ""
 	}
-	if input.Description != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`description: %q`, input.Description))
-	}
-	if input.AssigneeID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`assigneeId: %q`, input.AssigneeID))
-	}
-	if input.Priority != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`priority: %d`, *input.Priority))
-	}
-	if input.Estimate != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`estimate: %v`, *input.Estimate))
+	return fmt.Sprintf(`, after: %q`, after)
+}
+
+// orderByArg returns the GraphQL "orderBy" argument for a sort order the
+// API supports on every connection: "created" or "updated", newest first.
+// Other orders are applied by the caller.
+func orderByArg(sortBy string) string {
+	switch sortBy {
+	case "created", "createdAt":
+		return ", orderBy: createdAt"
+	case "updated", "updatedAt":
+		return ", orderBy: updatedAt"
+	}
+	return ""
+}
+
+// IssueFilter contains filters for listing issues
+type IssueFilter struct {
+	TeamID     string
+	StateTypes []string // triage, backlog, unstarted, started, completed, canceled
+	// States are workflow state names (case-insensitive); issues may be in any
+	States     []string
+	AssigneeID string
+	Unassigned bool
+	// ProjectID is a project ID or name (case-insensitive)
+	ProjectID string
+	NoProject bool
+	// Labels are label names (case-insensitive) or IDs; issues must have all
+	Labels     []string
+	Priorities []int
+	// Cycle is a cycle ID or one of "current", "next" and "previous"
+	Cycle string
+	// Milestone is a project milestone ID or name
+	Milestone string
+	ParentID  string
+	// CreatedAfter, UpdatedAfter, UpdatedBefore, CompletedAfter,
+	// CompletedBefore and DueBefore are ISO 8601 dates or times
+	CreatedAfter    string
+	UpdatedAfter    string
+	UpdatedBefore   string
+	CompletedAfter  string
+	CompletedBefore string
+	DueBefore       string
+}
+
+// cycleFilters maps relative cycle names to CycleFilter fields
+var cycleFilters = map[string]string{
+	"current":  "isActive",
+	"active":   "isActive",
+	"next":     "isNext",
+	"previous": "isPrevious",
+}
+
+// Input returns the filter as a GraphQL IssueFilter input object, or nil
+// when no filter is set
+func (f IssueFilter) Input() map[string]interface{} {
+	filter := map[string]interface{}{}
+	and := []interface{}{}
+
+	if f.TeamID != "" {
+		filter["team"] = idFilter(f.TeamID)
 	}
-	if input.DueDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`dueDate: %q`, input.DueDate))
+
+	if len(f.StateTypes) > 0 {
+		filter["state"] = map[string]interface{}{"type": map[string]interface{}{"in": f.StateTypes}}
 	}
-	if len(input.LabelIDs) > 0 {
-		labels := ""
-		for i, id := range input.LabelIDs {
-			if i > 0 {
-				labels += ", "
-			}
-			labels += fmt.Sprintf(`%q`, id)
+	if len(f.States) > 0 {
+		names := make([]interface{}, len(f.States))
+		for i, name := range f.States {
+			names[i] = map[string]interface{}{"state": map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": name}}}
 		}
-		inputParts = append(inputParts, fmt.Sprintf(`labelIds: [%s]`, labels))
-	}
-	if input.ProjectID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`projectId: %q`, input.ProjectID))
-	}
-	if input.StateID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`stateId: %q`, input.StateID))
-	}
-	if input.ParentID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`parentId: %q`, input.ParentID))
-	}
-	if input.CycleID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`cycleId: %q`, input.CycleID))
-	}
-	if input.ProjectMilestoneID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`projectMilestoneId: %q`, input.ProjectMilestoneID))
+		and = append(and, map[string]interface{}{"or": names})
 	}
 
-	if len(inputParts) == 0 {
-		return nil, fmt.Errorf("at least one field must be provided to update")
+	if f.Unassigned {
+		filter["assignee"] = map[string]interface{}{"null": true}
+	} else if f.AssigneeID != "" {
+		filter["assignee"] = idFilter(f.AssigneeID)
 	}
 
-	// Build input string
-	inputStr := ""
-	for i, part := range inputParts {
-		if i > 0 {
-			inputStr += ", "
+	if f.NoProject {
+		filter["project"] = map[string]interface{}{"null": true}
+	} else if f.ProjectID != "" {
+		if IsUUID(f.ProjectID) {
+			filter["project"] = idFilter(f.ProjectID)
+		} else {
+			filter["project"] = map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": f.ProjectID}}
 		}
-		inputStr += part
 	}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		issueUpdate(id: %q, input: { %s }) {
-			success
-			issue {
-				id
-				identifier
-				url
-				team {
-					key
-				}
-			}
+	for _, label := range f.Labels {
+		match := map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": label}}
+		if IsUUID(label) {
+			match = idFilter(label)
 		}
-	}`, issueID, inputStr)
-
-	var result struct {
-		IssueUpdate struct {
-			Success bool `json:"success"`
-			Issue   struct {
-				ID         string `json:"id"`
-				Identifier string `json:"identifier"`
-				URL        string `json:"url"`
-				Team       struct {
-					Key string `json:"key"`
-				} `json:"team"`
-			} `json:"issue"`
-		} `json:"issueUpdate"`
+		and = append(and, map[string]interface{}{"labels": map[string]interface{}{"some": match}})
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return nil, err
+	if len(f.Priorities) > 0 {
+		filter["priority"] = map[string]interface{}{"in": f.Priorities}
 	}
 
-	if !result.IssueUpdate.Success {
-		return nil, fmt.Errorf("failed to update issue")
+	if f.Cycle != "" {
+		if field, ok := cycleFilters[strings.ToLower(f.Cycle)]; ok {
+			filter["cycle"] = map[string]interface{}{field: map[string]interface{}{"eq": true}}
+		} else {
+			filter["cycle"] = idFilter(f.Cycle)
+		}
 	}
 
-	return &IssueCreateResponse{
-		Success:    true,
-		ID:         result.IssueUpdate.Issue.ID,
-		Identifier: result.IssueUpdate.Issue.Identifier,
-		URL:        result.IssueUpdate.Issue.URL,
-		TeamKey:    result.IssueUpdate.Issue.Team.Key,
-	}, nil
-}
-
-// DeleteIssue deletes an issue
-func (c *Client) DeleteIssue(ctx context.Context, issueID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		issueDelete(id: %q) {
-			success
+	if f.Milestone != "" {
+		if IsUUID(f.Milestone) {
+			filter["projectMilestone"] = idFilter(f.Milestone)
+		} else {
+			filter["projectMilestone"] = map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": f.Milestone}}
 		}
-	}`, issueID)
+	}
 
-	var result struct {
-		IssueDelete struct {
-			Success bool `json:"success"`
-		} `json:"issueDelete"`
+	if f.ParentID != "" {
+		filter["parent"] = idFilter(f.ParentID)
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+	if f.CreatedAfter != "" {
+		filter["createdAt"] = map[string]interface{}{"gt": f.CreatedAfter}
+	}
+	if f.UpdatedAfter != "" || f.UpdatedBefore != "" {
+		updated := map[string]interface{}{}
+		if f.UpdatedAfter != "" {
+			updated["gt"] = f.UpdatedAfter
+		}
+		if f.UpdatedBefore != "" {
+			updated["lt"] = f.UpdatedBefore
+		}
+		filter["updatedAt"] = updated
+	}
+	if f.CompletedAfter != "" || f.CompletedBefore != "" {
+		completed := map[string]interface{}{}
+		if f.CompletedAfter != "" {
+			completed["gt"] = f.CompletedAfter
+		}
+		if f.CompletedBefore != "" {
+			completed["lt"] = f.CompletedBefore
+		}
+		filter["completedAt"] = completed
+	}
+	if f.DueBefore != "" {
+		filter["dueDate"] = map[string]interface{}{"lt": f.DueBefore}
 	}
 
-	if !result.IssueDelete.Success {
-		return fmt.Errorf("failed to delete issue")
+	if len(and) > 0 {
+		filter["and"] = and
 	}
+	if len(filter) == 0 {
+		return nil
+	}
+	return filter
+}
 
-	return nil
+// idFilter matches an entity by ID
+func idFilter(id string) map[string]interface{} {
+	return map[string]interface{}{"id": map[string]interface{}{"eq": id}}
 }
 
-// SearchIssues searches for issues
-func (c *Client) SearchIssues(ctx context.Context, term string, limit int, includeArchived, includeComments bool, teamID string) (*SearchIssuesResponse, error) {
-	// Build query with optional teamId parameter
-	teamIDClause := ""
-	if teamID != "" {
-		teamIDClause = fmt.Sprintf(`, teamId: %q`, teamID)
-	}
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
 
-	queryStr := fmt.Sprintf(`query {
-		searchIssues(term: %q, first: %d, includeArchived: %t, includeComments: %t%s) {
-			nodes {
-				id
+// IsUUID reports whether s looks like a Linear entity ID rather than a
+// name or identifier
+func IsUUID(s string) bool {
+	return uuidPattern.MatchString(s)
+}
+
+// issueListSelection is the issue selection for IssueListItem
+const issueListSelection = `id
 				identifier
 				title
 				priority
 				estimate
+				dueDate
+				slaBreachesAt
 				createdAt
 				updatedAt
 				state {
@@ -1326,44 +1738,145 @@ func (c *Client) SearchIssues(ctx context.Context, term string, limit int, inclu
 					name
 					displayName
 				}
-				team {
-					key
-					name
-				}
+				labels {
+					nodes {
+						id
+						name
+						color
+					}
+				}`
+
+// issueListNode decodes issueListSelection
+type issueListNode struct {
+	ID            string  `json:"id"`
+	Identifier    string  `json:"identifier"`
+	Title         string  `json:"title"`
+	Priority      int     `json:"priority"`
+	Estimate      float64 `json:"estimate"`
+	DueDate       string  `json:"dueDate"`
+	SLABreachesAt string  `json:"slaBreachesAt"`
+	CreatedAt     string  `json:"createdAt"`
+	UpdatedAt     string  `json:"updatedAt"`
+	State         struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Type  string `json:"type"`
+		Color string `json:"color"`
+	} `json:"state"`
+	Assignee *struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"assignee"`
+	Labels struct {
+		Nodes []struct {
+			ID    string `json:"id"`
+			Name  string `json:"name"`
+			Color string `json:"color"`
+		} `json:"nodes"`
+	} `json:"labels"`
+}
+
+func (issue issueListNode) item() IssueListItem {
+	item := IssueListItem{
+		ID:            issue.ID,
+		Identifier:    issue.Identifier,
+		Title:         issue.Title,
+		Priority:      issue.Priority,
+		DueDate:       issue.DueDate,
+		SLABreachesAt: issue.SLABreachesAt,
+		CreatedAt:     issue.CreatedAt,
+		UpdatedAt:     issue.UpdatedAt,
+		State: IssueState{
+			ID:    issue.State.ID,
+			Name:  issue.State.Name,
+			Type:  issue.State.Type,
+			Color: issue.State.Color,
+		},
+	}
+	if issue.Estimate > 0 {
+		est := issue.Estimate
+		item.Estimate = &est
+	}
+	if issue.Assignee != nil {
+		item.Assignee = &IssueAssignee{
+			ID:          issue.Assignee.ID,
+			Name:        issue.Assignee.Name,
+			DisplayName: issue.Assignee.DisplayName,
+		}
+	}
+	labels := make([]IssueLabel, len(issue.Labels.Nodes))
+	for j, label := range issue.Labels.Nodes {
+		labels[j] = IssueLabel{
+			ID:    label.ID,
+			Name:  label.Name,
+			Color: label.Color,
+		}
+	}
+	item.Labels = labels
+	return item
+}
+
+// GetIssues fetches issues with filters. sortBy "created" or "updated"
+// orders them on the server; other orders are left to the caller.
+func (c *Client) GetIssues(ctx context.Context, filter IssueFilter, limit int, sortBy string, after string) (*IssuesResponse, error) {
+	// Build the raw GraphQL query; the filter is passed as a variable
+	queryStr := fmt.Sprintf(`query($filter: IssueFilter) {
+		issues(first: %d%s%s, filter: $filter) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				%s
+			}
+		}
+	}`, limit, afterArg(after), orderByArg(sortBy), issueListSelection)
+	variables := map[string]interface{}{"filter": filter.Input()}
+
+	// Execute raw query
+	var result struct {
+		Issues struct {
+			PageInfo PageInfo        `json:"pageInfo"`
+			Nodes    []issueListNode `json:"nodes"`
+		} `json:"issues"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
+		return nil, err
+	}
+
+	issues := make([]IssueListItem, len(result.Issues.Nodes))
+	for i, issue := range result.Issues.Nodes {
+		issues[i] = issue.item()
+	}
+
+	return &IssuesResponse{
+		Issues:   issues,
+		Count:    len(issues),
+		PageInfo: &result.Issues.PageInfo,
+	}, nil
+}
+
+// SearchIssuesWithFilter searches issues by text, narrowed by an issue
+// filter. Results are in relevance order.
+func (c *Client) SearchIssuesWithFilter(ctx context.Context, term string, filter IssueFilter, limit int, includeArchived bool) (*SearchIssuesResponse, error) {
+	queryStr := fmt.Sprintf(`query($filter: IssueFilter) {
+		searchIssues(term: %q, first: %d, includeArchived: %t, filter: $filter) {
+			nodes {
+				%s
 			}
 			pageInfo {
 				hasNextPage
 			}
 			totalCount
 		}
-	}`, term, limit, includeArchived, includeComments, teamIDClause)
+	}`, term, limit, includeArchived, issueListSelection)
+	variables := map[string]interface{}{"filter": filter.Input()}
 
 	var result struct {
 		SearchIssues struct {
-			Nodes []struct {
-				ID         string  `json:"id"`
-				Identifier string  `json:"identifier"`
-				Title      string  `json:"title"`
-				Priority   int     `json:"priority"`
-				Estimate   float64 `json:"estimate"`
-				CreatedAt  string  `json:"createdAt"`
-				UpdatedAt  string  `json:"updatedAt"`
-				State      struct {
-					ID    string `json:"id"`
-					Name  string `json:"name"`
-					Type  string `json:"type"`
-					Color string `json:"color"`
-				} `json:"state"`
-				Assignee *struct {
-					ID          string `json:"id"`
-					Name        string `json:"name"`
-					DisplayName string `json:"displayName"`
-				} `json:"assignee"`
-				Team struct {
-					Key  string `json:"key"`
-					Name string `json:"name"`
-				} `json:"team"`
-			} `json:"nodes"`
+			Nodes    []issueListNode `json:"nodes"`
 			PageInfo struct {
 				HasNextPage bool `json:"hasNextPage"`
 			} `json:"pageInfo"`
@@ -1371,36 +1884,13 @@ func (c *Client) SearchIssues(ctx context.Context, term string, limit int, inclu
 		} `json:"searchIssues"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
 		return nil, err
 	}
 
 	issues := make([]IssueListItem, len(result.SearchIssues.Nodes))
 	for i, issue := range result.SearchIssues.Nodes {
-		issues[i] = IssueListItem{
-			ID:         issue.ID,
-			Identifier: issue.Identifier,
-			Title:      issue.Title,
-			Priority:   issue.Priority,
-			UpdatedAt:  issue.UpdatedAt,
-			State: IssueState{
-				ID:    issue.State.ID,
-				Name:  issue.State.Name,
-				Type:  issue.State.Type,
-				Color: issue.State.Color,
-			},
-		}
-		if issue.Estimate > 0 {
-			est := issue.Estimate
-			issues[i].Estimate = &est
-		}
-		if issue.Assignee != nil {
-			issues[i].Assignee = &IssueAssignee{
-				ID:          issue.Assignee.ID,
-				Name:        issue.Assignee.Name,
-				DisplayName: issue.Assignee.DisplayName,
-			}
-		}
+		issues[i] = issue.item()
 	}
 
 	return &SearchIssuesResponse{
@@ -1411,2359 +1901,5971 @@ func (c *Client) SearchIssues(ctx context.Context, term string, limit int, inclu
 	}, nil
 }
 
-// CreateComment creates a comment on an issue
-func (c *Client) CreateComment(ctx context.Context, issueID string, body string) (*Comment, error) {
-	mutationStr := fmt.Sprintf(`mutation {
-		commentCreate(input: { issueId: %q, body: %q }) {
-			success
-			comment {
+// ExportIssue is an issue flattened for export
+type ExportIssue struct {
+	ID          string   `json:"id"`
+	Identifier  string   `json:"identifier"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Team        string   `json:"team"`
+	State       string   `json:"state"`
+	StateType   string   `json:"stateType"`
+	Priority    int      `json:"priority"`
+	Estimate    *float64 `json:"estimate"`
+	Assignee    string   `json:"assignee"`
+	Creator     string   `json:"creator"`
+	Project     string   `json:"project"`
+	Cycle       *int     `json:"cycle"`
+	Parent      string   `json:"parent"`
+	Labels      []string `json:"labels"`
+	DueDate     string   `json:"dueDate"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
+	StartedAt   string   `json:"startedAt"`
+	CompletedAt string   `json:"completedAt"`
+	CanceledAt  string   `json:"canceledAt"`
+	URL         string   `json:"url"`
+}
+
+// GetIssuesForExport fetches a page of issues with every field included in
+// an export
+func (c *Client) GetIssuesForExport(ctx context.Context, filter IssueFilter, limit int, after string) ([]ExportIssue, *PageInfo, error) {
+	queryStr := fmt.Sprintf(`query($filter: IssueFilter) {
+		issues(first: %d%s, filter: $filter) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
 				id
-				body
+				identifier
+				title
+				description
+				priority
+				estimate
+				dueDate
 				createdAt
-				user {
-					id
+				updatedAt
+				startedAt
+				completedAt
+				canceledAt
+				url
+				team {
+					key
+				}
+				state {
 					name
-					displayName
+					type
+				}
+				assignee {
+					name
+				}
+				creator {
+					name
+				}
+				project {
+					name
+				}
+				cycle {
+					number
+				}
+				parent {
+					identifier
+				}
+				labels {
+					nodes {
+						name
+					}
 				}
 			}
 		}
-	}`, issueID, body)
-
-	var result struct {
-		CommentCreate struct {
-			Success bool `json:"success"`
-			Comment struct {
-				ID        string `json:"id"`
-				Body      string `json:"body"`
-				CreatedAt string `json:"createdAt"`
-				User      *struct {
-					ID          string `json:"id"`
-					Name        string `json:"name"`
-					DisplayName string `json:"displayName"`
-				} `json:"user"`
-			} `json:"comment"`
-		} `json:"commentCreate"`
-	}
-
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return nil, err
-	}
+	}`, limit, afterArg(after))
+	variables := map[string]interface{}{"filter": filter.Input()}
 
-	if !result.CommentCreate.Success {
-		return nil, fmt.Errorf("failed to create comment")
+	type named struct {
+		Name string `json:"name"`
 	}
-
-	comment := &Comment{
-		ID:        result.CommentCreate.Comment.ID,
-		Body:      result.CommentCreate.Comment.Body,
-		CreatedAt: result.CommentCreate.Comment.CreatedAt,
+	var result struct {
+		Issues struct {
+			PageInfo PageInfo `json:"pageInfo"`
+			Nodes    []struct {
+				ID          string   `json:"id"`
+				Identifier  string   `json:"identifier"`
+				Title       string   `json:"title"`
+				Description string   `json:"description"`
+				Priority    int      `json:"priority"`
+				Estimate    *float64 `json:"estimate"`
+				DueDate     string   `json:"dueDate"`
+				CreatedAt   string   `json:"createdAt"`
+				UpdatedAt   string   `json:"updatedAt"`
+				StartedAt   string   `json:"startedAt"`
+				CompletedAt string   `json:"completedAt"`
+				CanceledAt  string   `json:"canceledAt"`
+				URL         string   `json:"url"`
+				Team        struct {
+					Key string `json:"key"`
+				} `json:"team"`
+				State struct {
+					Name string `json:"name"`
+					Type string `json:"type"`
+				} `json:"state"`
+				Assignee *named `json:"assignee"`
+				Creator  *named `json:"creator"`
+				Project  *named `json:"project"`
+				Cycle    *struct {
+					Number int `json:"number"`
+				} `json:"cycle"`
+				Parent *struct {
+					Identifier string `json:"identifier"`
+				} `json:"parent"`
+				Labels struct {
+					Nodes []named `json:"nodes"`
+				} `json:"labels"`
+			} `json:"nodes"`
+		} `json:"issues"`
 	}
 
-	if result.CommentCreate.Comment.User != nil {
-		comment.User = &struct {
-			ID          string `json:"id"`
-			Name        string `json:"name"`
-			DisplayName string `json:"displayName"`
-		}{
-			ID:          result.CommentCreate.Comment.User.ID,
-			Name:        result.CommentCreate.Comment.User.Name,
-			DisplayName: result.CommentCreate.Comment.User.DisplayName,
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
+		return nil, nil, err
+	}
+
+	issues := make([]ExportIssue, len(result.Issues.Nodes))
+	for i, node := range result.Issues.Nodes {
+		issue := ExportIssue{
+			ID:          node.ID,
+			Identifier:  node.Identifier,
+			Title:       node.Title,
+			Description: node.Description,
+			Team:        node.Team.Key,
+			State:       node.State.Name,
+			StateType:   node.State.Type,
+			Priority:    node.Priority,
+			Estimate:    node.Estimate,
+			DueDate:     node.DueDate,
+			CreatedAt:   node.CreatedAt,
+			UpdatedAt:   node.UpdatedAt,
+			StartedAt:   node.StartedAt,
+			CompletedAt: node.CompletedAt,
+			CanceledAt:  node.CanceledAt,
+			URL:         node.URL,
+			Labels:      make([]string, len(node.Labels.Nodes)),
 		}
-	}
-
-	return comment, nil
-}
-
-// CreateIssueRelation creates a relationship between issues
-func (c *Client) CreateIssueRelation(ctx context.Context, issueID, relatedIssueID, relationType string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		issueRelationCreate(input: { issueId: %q, relatedIssueId: %q, type: %s }) {
-			success
+		if node.Assignee != nil {
+			issue.Assignee = node.Assignee.Name
 		}
-	}`, issueID, relatedIssueID, relationType)
-
-	var result struct {
-		IssueRelationCreate struct {
-			Success bool `json:"success"`
-		} `json:"issueRelationCreate"`
-	}
-
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+		if node.Creator != nil {
+			issue.Creator = node.Creator.Name
+		}
+		if node.Project != nil {
+			issue.Project = node.Project.Name
+		}
+		if node.Cycle != nil {
+			number := node.Cycle.Number
+			issue.Cycle = &number
+		}
+		if node.Parent != nil {
+			issue.Parent = node.Parent.Identifier
+		}
+		for j, label := range node.Labels.Nodes {
+			issue.Labels[j] = label.Name
+		}
+		issues[i] = issue
 	}
 
-	if !result.IssueRelationCreate.Success {
-		return fmt.Errorf("failed to create issue relation")
-	}
+	return issues, &result.Issues.PageInfo, nil
+}
 
-	return nil
+// IssueEstimate is an issue's estimate and completion, used for point reports
+type IssueEstimate struct {
+	Identifier    string   `json:"identifier"`
+	Estimate      *float64 `json:"estimate"`
+	StateType     string   `json:"stateType"`
+	CreatedAt     string   `json:"createdAt"`
+	CompletedAt   string   `json:"completedAt,omitempty"`
+	CanceledAt    string   `json:"canceledAt,omitempty"`
+	MilestoneID   string   `json:"milestoneId,omitempty"`
+	MilestoneName string   `json:"milestoneName,omitempty"`
 }
 
-// DeleteIssueRelation removes a relationship between issues
-func (c *Client) DeleteIssueRelation(ctx context.Context, relationID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		issueRelationDelete(id: %q) {
-			success
+// GetIssueEstimates fetches a page of issues with their estimates, state
+// types and milestones
+func (c *Client) GetIssueEstimates(ctx context.Context, filter IssueFilter, limit int, after string) ([]IssueEstimate, *PageInfo, error) {
+	queryStr := fmt.Sprintf(`query($filter: IssueFilter) {
+		issues(first: %d%s, filter: $filter) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				identifier
+				estimate
+				createdAt
+				completedAt
+				canceledAt
+				state {
+					type
+				}
+				projectMilestone {
+					id
+					name
+				}
+			}
 		}
-	}`, relationID)
+	}`, limit, afterArg(after))
+	variables := map[string]interface{}{"filter": filter.Input()}
 
 	var result struct {
-		IssueRelationDelete struct {
-			Success bool `json:"success"`
-		} `json:"issueRelationDelete"`
+		Issues struct {
+			PageInfo PageInfo `json:"pageInfo"`
+			Nodes    []struct {
+				Identifier  string   `json:"identifier"`
+				Estimate    *float64 `json:"estimate"`
+				CreatedAt   string   `json:"createdAt"`
+				CompletedAt string   `json:"completedAt"`
+				CanceledAt  string   `json:"canceledAt"`
+				State       struct {
+					Type string `json:"type"`
+				} `json:"state"`
+				ProjectMilestone *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"projectMilestone"`
+			} `json:"nodes"`
+		} `json:"issues"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
+		return nil, nil, err
 	}
 
-	if !result.IssueRelationDelete.Success {
-		return fmt.Errorf("failed to delete issue relation")
+	issues := make([]IssueEstimate, len(result.Issues.Nodes))
+	for i, node := range result.Issues.Nodes {
+		issues[i] = IssueEstimate{
+			Identifier:  node.Identifier,
+			Estimate:    node.Estimate,
+			StateType:   node.State.Type,
+			CreatedAt:   node.CreatedAt,
+			CompletedAt: node.CompletedAt,
+			CanceledAt:  node.CanceledAt,
+		}
+		if node.ProjectMilestone != nil {
+			issues[i].MilestoneID = node.ProjectMilestone.ID
+			issues[i].MilestoneName = node.ProjectMilestone.Name
+		}
 	}
 
-	return nil
+	return issues, &result.Issues.PageInfo, nil
 }
 
-// GetViewer returns the current authenticated user (needed for "self" assignee)
-func (c *Client) GetViewerID(ctx context.Context) (string, error) {
-	viewer, err := c.GetViewer(ctx)
-	if err != nil {
-		return "", err
-	}
-	return viewer.Viewer.ID, nil
+// IssueDescription is an issue with its raw description, used for bulk text edits
+type IssueDescription struct {
+	ID          string `json:"id"`
+	Identifier  string `json:"identifier"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
 }
 
-// Attachment represents an issue attachment
-type Attachment struct {
-	ID        string  `json:"id"`
-	Title     string  `json:"title"`
-	URL       string  `json:"url"`
-	Subtitle  *string `json:"subtitle,omitempty"`
-	CreatedAt string  `json:"createdAt"`
-	UpdatedAt string  `json:"updatedAt"`
-	Creator   *struct {
-		ID          string `json:"id"`
-		Name        string `json:"name"`
-		DisplayName string `json:"displayName"`
-	} `json:"creator,omitempty"`
+// IssueDescriptionsPage is a single page of issue descriptions
+type IssueDescriptionsPage struct {
+	Issues      []IssueDescription `json:"issues"`
+	EndCursor   string             `json:"endCursor,omitempty"`
+	HasNextPage bool               `json:"hasNextPage"`
 }
 
-// AttachmentsResponse is the response for listing attachments
-type AttachmentsResponse struct {
-	Attachments []Attachment `json:"attachments"`
-	Count       int          `json:"count"`
-}
+// GetIssueDescriptions fetches a page of a team's issues whose description
+// contains the given text. An empty contains matches every issue with a description.
+func (c *Client) GetIssueDescriptions(ctx context.Context, teamID, contains string, first int, after string) (*IssueDescriptionsPage, error) {
+	description := map[string]interface{}{"null": false}
+	if contains != "" {
+		description = map[string]interface{}{"contains": contains}
+	}
 
-// GetIssueAttachments fetches attachments for an issue
-func (c *Client) GetIssueAttachments(ctx context.Context, issueID string) (*AttachmentsResponse, error) {
-	queryStr := fmt.Sprintf(`query {
-		issue(id: %q) {
-			attachments {
-				nodes {
-					id
-					title
-					url
-					subtitle
-					createdAt
-					updatedAt
-					creator {
-						id
-						name
-						displayName
-					}
-				}
+	query := `query($first: Int!, $after: String, $filter: IssueFilter) {
+		issues(first: $first, after: $after, filter: $filter) {
+			nodes {
+				id
+				identifier
+				title
+				description
+				url
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
 			}
 		}
-	}`, issueID)
+	}`
+	variables := map[string]interface{}{
+		"first": first,
+		"filter": map[string]interface{}{
+			"team":        map[string]interface{}{"id": map[string]interface{}{"eq": teamID}},
+			"description": description,
+		},
+	}
+	if after != "" {
+		variables["after"] = after
+	}
 
 	var result struct {
-		Issue struct {
-			Attachments struct {
-				Nodes []Attachment `json:"nodes"`
-			} `json:"attachments"`
-		} `json:"issue"`
+		Issues struct {
+			Nodes    []IssueDescription `json:"nodes"`
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+		} `json:"issues"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+	if err := c.exec(ctx, query, &result, variables); err != nil {
 		return nil, err
 	}
 
-	return &AttachmentsResponse{
-		Attachments: result.Issue.Attachments.Nodes,
-		Count:       len(result.Issue.Attachments.Nodes),
+	return &IssueDescriptionsPage{
+		Issues:      result.Issues.Nodes,
+		EndCursor:   result.Issues.PageInfo.EndCursor,
+		HasNextPage: result.Issues.PageInfo.HasNextPage,
 	}, nil
 }
 
-// CreateAttachment creates a new attachment on an issue
-func (c *Client) CreateAttachment(ctx context.Context, issueID, title, url string, subtitle *string) (*Attachment, error) {
-	subtitlePart := ""
-	if subtitle != nil && *subtitle != "" {
-		subtitlePart = fmt.Sprintf(`, subtitle: %q`, *subtitle)
-	}
+// IssueRef is a minimal reference to an issue
+type IssueRef struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	Team       Team   `json:"team"`
+}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		attachmentCreate(input: { issueId: %q, title: %q, url: %q%s }) {
-			success
-			attachment {
+// GetIssuesByNumbers fetches the issues of a team with the given numbers.
+// Numbers with no matching issue are simply absent from the result.
+func (c *Client) GetIssuesByNumbers(ctx context.Context, teamKey string, numbers []int) ([]IssueRef, error) {
+	query := `query($first: Int!, $filter: IssueFilter) {
+		issues(first: $first, filter: $filter) {
+			nodes {
 				id
+				identifier
 				title
-				url
-				subtitle
-				createdAt
-				updatedAt
+				team {
+					id
+					key
+					name
+				}
 			}
 		}
-	}`, issueID, title, url, subtitlePart)
-
-	var result struct {
-		AttachmentCreate struct {
-			Success    bool       `json:"success"`
-			Attachment Attachment `json:"attachment"`
-		} `json:"attachmentCreate"`
-	}
-
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return nil, err
-	}
-
-	if !result.AttachmentCreate.Success {
-		return nil, fmt.Errorf("failed to create attachment")
+	}`
+	variables := map[string]interface{}{
+		"first": len(numbers),
+		"filter": map[string]interface{}{
+			"team":   map[string]interface{}{"key": map[string]interface{}{"eq": teamKey}},
+			"number": map[string]interface{}{"in": numbers},
+		},
 	}
 
-	return &result.AttachmentCreate.Attachment, nil
-}
-
-// DeleteAttachment deletes an attachment
-func (c *Client) DeleteAttachment(ctx context.Context, attachmentID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		attachmentDelete(id: %q) {
-			success
-		}
-	}`, attachmentID)
-
 	var result struct {
-		AttachmentDelete struct {
-			Success bool `json:"success"`
-		} `json:"attachmentDelete"`
-	}
-
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+		Issues struct {
+			Nodes []IssueRef `json:"nodes"`
+		} `json:"issues"`
 	}
 
-	if !result.AttachmentDelete.Success {
-		return fmt.Errorf("failed to delete attachment")
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, err
 	}
 
-	return nil
-}
-
-// ProjectDetail represents a detailed project
-type ProjectDetail struct {
-	ID          string  `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description,omitempty"`
-	Content     string  `json:"content,omitempty"`
-	SlugID      string  `json:"slugId"`
-	Icon        string  `json:"icon,omitempty"`
-	Color       string  `json:"color,omitempty"`
-	State       string  `json:"state"`
-	Progress    float64 `json:"progress"`
-	StartDate   string  `json:"startDate,omitempty"`
-	TargetDate  string  `json:"targetDate,omitempty"`
-	URL         string  `json:"url"`
-	CreatedAt   string  `json:"createdAt"`
-	UpdatedAt   string  `json:"updatedAt"`
-	Status      *struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-		Type string `json:"type"`
-	} `json:"status,omitempty"`
-	Lead *struct {
-		ID          string `json:"id"`
-		Name        string `json:"name"`
-		DisplayName string `json:"displayName"`
-	} `json:"lead,omitempty"`
-	Teams []struct {
-		ID   string `json:"id"`
-		Key  string `json:"key"`
-		Name string `json:"name"`
-	} `json:"teams,omitempty"`
-}
-
-// ProjectListItem represents a project in a list
-type ProjectListItem struct {
-	ID         string  `json:"id"`
-	Name       string  `json:"name"`
-	SlugID     string  `json:"slugId"`
-	State      string  `json:"state"`
-	Progress   float64 `json:"progress"`
-	TargetDate string  `json:"targetDate,omitempty"`
-	URL        string  `json:"url"`
-	UpdatedAt  string  `json:"updatedAt"`
-	Status     *struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-		Type string `json:"type"`
-	} `json:"status,omitempty"`
-	Lead *struct {
-		ID          string `json:"id"`
-		DisplayName string `json:"displayName"`
-	} `json:"lead,omitempty"`
-	Teams []struct {
-		Key string `json:"key"`
-	} `json:"teams,omitempty"`
-}
-
-// ProjectsResponse is the response for listing projects
-type ProjectsResponse struct {
-	Projects []ProjectListItem `json:"projects"`
-	Count    int               `json:"count"`
-}
-
-// SearchProjectsResponse is the response for searching projects
-type SearchProjectsResponse struct {
-	Projects   []ProjectListItem `json:"projects"`
-	TotalCount int               `json:"totalCount"`
-	HasMore    bool              `json:"hasMore"`
-	Query      string            `json:"query"`
+	return result.Issues.Nodes, nil
 }
 
-// ProjectCreateInput is the input for creating a project
-type ProjectCreateInput struct {
-	Name        string   `json:"name"`
-	Description string   `json:"description,omitempty"`
-	Content     string   `json:"content,omitempty"`
-	TeamIDs     []string `json:"teamIds"`
-	StatusID    string   `json:"statusId,omitempty"`
-	LeadID      string   `json:"leadId,omitempty"`
-	Icon        string   `json:"icon,omitempty"`
-	Color       string   `json:"color,omitempty"`
-	StartDate   string   `json:"startDate,omitempty"`
-	TargetDate  string   `json:"targetDate,omitempty"`
-	Priority    *int     `json:"priority,omitempty"`
+// IssueStateAge is an open issue with the time it entered its current state
+type IssueStateAge struct {
+	ID             string         `json:"id"`
+	Identifier     string         `json:"identifier"`
+	Title          string         `json:"title"`
+	URL            string         `json:"url"`
+	Priority       int            `json:"priority"`
+	CreatedAt      string         `json:"createdAt"`
+	StateEnteredAt string         `json:"stateEnteredAt"`
+	State          WorkflowState  `json:"state"`
+	Team           Team           `json:"team"`
+	Labels         []LabelSummary `json:"labels"`
 }
 
-// ProjectUpdateInput is the input for updating a project
-type ProjectUpdateInput struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	Content     string `json:"content,omitempty"`
-	StatusID    string `json:"statusId,omitempty"`
-	LeadID      string `json:"leadId,omitempty"`
-	Icon        string `json:"icon,omitempty"`
-	Color       string `json:"color,omitempty"`
-	StartDate   string `json:"startDate,omitempty"`
-	TargetDate  string `json:"targetDate,omitempty"`
-	Priority    *int   `json:"priority,omitempty"`
+// LabelSummary is a label attached to an issue
+type LabelSummary struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
-// GetProjects fetches projects
-func (c *Client) GetProjects(ctx context.Context, teamID string, limit int) (*ProjectsResponse, error) {
-	filterPart := ""
-	if teamID != "" {
-		filterPart = fmt.Sprintf(`, filter: { teams: { id: { eq: "%s" } } }`, teamID)
-	}
-
-	queryStr := fmt.Sprintf(`query {
-		projects(first: %d%s) {
+// GetIssueStateAges fetches a page of open issues, optionally for one team,
+// with the time each entered its current state. The entry time comes from
+// the latest history event that moved the issue into that state, falling
+// back to the issue's creation time.
+func (c *Client) GetIssueStateAges(ctx context.Context, teamID string, first int, after string) ([]IssueStateAge, *PageInfo, error) {
+	query := `query($first: Int!, $after: String, $filter: IssueFilter) {
+		issues(first: $first, after: $after, filter: $filter) {
 			nodes {
 				id
-				name
-				slugId
-				state
-				progress
-				targetDate
+				identifier
+				title
 				url
-				updatedAt
-				status {
+				priority
+				createdAt
+				state {
 					id
 					name
 					type
+					color
 				}
-				lead {
+				team {
 					id
-					displayName
+					key
+					name
 				}
-				teams {
+				labels {
 					nodes {
-						key
+						id
+						name
 					}
 				}
+				history(first: 50) {
+					nodes {
+						createdAt
+						toState {
+							id
+						}
+					}
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
 			}
 		}
-	}`, limit, filterPart)
+	}`
+	filter := map[string]interface{}{
+		"state": map[string]interface{}{"type": map[string]interface{}{"nin": []string{"completed", "canceled"}}},
+	}
+	if teamID != "" {
+		filter["team"] = map[string]interface{}{"id": map[string]interface{}{"eq": teamID}}
+	}
+	variables := map[string]interface{}{
+		"first":  first,
+		"filter": filter,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
 
 	var result struct {
-		Projects struct {
+		Issues struct {
 			Nodes []struct {
-				ID         string  `json:"id"`
-				Name       string  `json:"name"`
-				SlugID     string  `json:"slugId"`
-				State      string  `json:"state"`
-				Progress   float64 `json:"progress"`
-				TargetDate string  `json:"targetDate"`
-				URL        string  `json:"url"`
-				UpdatedAt  string  `json:"updatedAt"`
-				Status     *struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-					Type string `json:"type"`
-				} `json:"status"`
-				Lead *struct {
-					ID          string `json:"id"`
-					DisplayName string `json:"displayName"`
-				} `json:"lead"`
-				Teams struct {
+				ID         string        `json:"id"`
+				Identifier string        `json:"identifier"`
+				Title      string        `json:"title"`
+				URL        string        `json:"url"`
+				Priority   int           `json:"priority"`
+				CreatedAt  string        `json:"createdAt"`
+				State      WorkflowState `json:"state"`
+				Team       Team          `json:"team"`
+				Labels     struct {
+					Nodes []LabelSummary `json:"nodes"`
+				} `json:"labels"`
+				History struct {
 					Nodes []struct {
-						Key string `json:"key"`
+						CreatedAt string `json:"createdAt"`
+						ToState   *struct {
+							ID string `json:"id"`
+						} `json:"toState"`
 					} `json:"nodes"`
-				} `json:"teams"`
+				} `json:"history"`
 			} `json:"nodes"`
-		} `json:"projects"`
+			PageInfo PageInfo `json:"pageInfo"`
+		} `json:"issues"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
-		return nil, err
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, nil, err
 	}
 
-	projects := make([]ProjectListItem, len(result.Projects.Nodes))
-	for i, p := range result.Projects.Nodes {
-		teams := make([]struct {
-			Key string `json:"key"`
-		}, len(p.Teams.Nodes))
-		for j, t := range p.Teams.Nodes {
-			teams[j] = struct {
-				Key string `json:"key"`
-			}{Key: t.Key}
-		}
-		projects[i] = ProjectListItem{
-			ID:         p.ID,
-			Name:       p.Name,
-			SlugID:     p.SlugID,
-			State:      p.State,
-			Progress:   p.Progress,
-			TargetDate: p.TargetDate,
-			URL:        p.URL,
-			UpdatedAt:  p.UpdatedAt,
-			Status:     p.Status,
-			Lead:       p.Lead,
-			Teams:      teams,
+	issues := make([]IssueStateAge, 0, len(result.Issues.Nodes))
+	for _, node := range result.Issues.Nodes {
+		// RFC 3339 timestamps in UTC compare correctly as strings
+		enteredAt := node.CreatedAt
+		for _, h := range node.History.Nodes {
+			if h.ToState != nil && h.ToState.ID == node.State.ID && h.CreatedAt > enteredAt {
+				enteredAt = h.CreatedAt
+			}
 		}
+		issues = append(issues, IssueStateAge{
+			ID:             node.ID,
+			Identifier:     node.Identifier,
+			Title:          node.Title,
+			URL:            node.URL,
+			Priority:       node.Priority,
+			CreatedAt:      node.CreatedAt,
+			StateEnteredAt: enteredAt,
+			State:          node.State,
+			Team:           node.Team,
+			Labels:         node.Labels.Nodes,
+		})
 	}
 
-	return &ProjectsResponse{
-		Projects: projects,
-		Count:    len(projects),
-	}, nil
+	return issues, &result.Issues.PageInfo, nil
 }
 
-// SearchProjects searches for projects by term
-func (c *Client) SearchProjects(ctx context.Context, term string, limit int, includeArchived, includeComments bool) (*SearchProjectsResponse, error) {
-	queryStr := fmt.Sprintf(`query {
-		searchProjects(term: %q, first: %d, includeArchived: %t, includeComments: %t) {
-			nodes {
+// AddIssueLabel adds a label to an issue, keeping its existing labels
+func (c *Client) AddIssueLabel(ctx context.Context, issueID, labelID string) error {
+	query := `mutation($id: String!, $labelId: String!) {
+		issueAddLabel(id: $id, labelId: $labelId) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{
+		"id":      issueID,
+		"labelId": labelID,
+	}
+
+	var result struct {
+		IssueAddLabel struct {
+			Success bool `json:"success"`
+		} `json:"issueAddLabel"`
+	}
+
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return err
+	}
+	if !result.IssueAddLabel.Success {
+		return fmt.Errorf("failed to add label to issue")
+	}
+	return nil
+}
+
+// RemoveIssueLabel removes a label from an issue, keeping its other labels
+func (c *Client) RemoveIssueLabel(ctx context.Context, issueID, labelID string) error {
+	query := `mutation($id: String!, $labelId: String!) {
+		issueRemoveLabel(id: $id, labelId: $labelId) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{
+		"id":      issueID,
+		"labelId": labelID,
+	}
+
+	var result struct {
+		IssueRemoveLabel struct {
+			Success bool `json:"success"`
+		} `json:"issueRemoveLabel"`
+	}
+
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return err
+	}
+	if !result.IssueRemoveLabel.Success {
+		return fmt.Errorf("failed to remove label from issue")
+	}
+	return nil
+}
+
+// GetIssue fetches a single issue by ID or identifier with its children
+// and relations. Comments, when requested, are fetched in the same request.
+func (c *Client) GetIssue(ctx context.Context, issueID string, includeComments bool) (*IssueDetail, error) {
+	return c.GetIssueExpanded(ctx, issueID, IssueExpand{Children: true, Relations: true, Comments: includeComments})
+}
+
+// IssueExpand selects the connections fetched with an issue. Connections
+// left out are dropped from the query, not just from the output.
+type IssueExpand struct {
+	Children    bool
+	Relations   bool
+	Comments    bool
+	Attachments bool
+	History     bool
+}
+
+// variables sets the @include variables issueDetailNode declares
+func (e IssueExpand) variables(variables map[string]interface{}) map[string]interface{} {
+	variables["includeChildren"] = e.Children
+	variables["includeRelations"] = e.Relations
+	variables["includeComments"] = e.Comments
+	variables["includeAttachments"] = e.Attachments
+	variables["includeHistory"] = e.History
+	return variables
+}
+
+// GetIssueExpanded fetches a single issue by ID or identifier with the
+// connections selected by expand
+func (c *Client) GetIssueExpanded(ctx context.Context, issueID string, expand IssueExpand) (*IssueDetail, error) {
+	var query struct {
+		Issue issueDetailNode `graphql:"issue(id: $id)"`
+	}
+
+	variables := expand.variables(map[string]interface{}{
+		"id": issueID,
+	})
+
+	if err := c.Query(ctx, &query, variables); err != nil {
+		return nil, err
+	}
+
+	return query.Issue.detail(), nil
+}
+
+// issueDetailNode is the issue selection shared by queries that return an
+// IssueDetail. Queries using it must declare the variables set by
+// IssueExpand.variables.
+type issueDetailNode struct {
+	ID          string  `graphql:"id"`
+	Identifier  string  `graphql:"identifier"`
+	Title       string  `graphql:"title"`
+	Description string  `graphql:"description"`
+	URL         string  `graphql:"url"`
+	BranchName  string  `graphql:"branchName"`
+	Priority    int     `graphql:"priority"`
+	Estimate    float64 `graphql:"estimate"`
+	DueDate     string  `graphql:"dueDate"`
+	CreatedAt   string  `graphql:"createdAt"`
+	UpdatedAt   string  `graphql:"updatedAt"`
+	State       struct {
+		ID    string `graphql:"id"`
+		Name  string `graphql:"name"`
+		Type  string `graphql:"type"`
+		Color string `graphql:"color"`
+	} `graphql:"state"`
+	Assignee *struct {
+		ID          string `graphql:"id"`
+		Name        string `graphql:"name"`
+		DisplayName string `graphql:"displayName"`
+	} `graphql:"assignee"`
+	Team struct {
+		ID   string `graphql:"id"`
+		Key  string `graphql:"key"`
+		Name string `graphql:"name"`
+	} `graphql:"team"`
+	Project *struct {
+		ID   string `graphql:"id"`
+		Name string `graphql:"name"`
+	} `graphql:"project"`
+	ProjectMilestone *struct {
+		ID         string `graphql:"id"`
+		Name       string `graphql:"name"`
+		TargetDate string `graphql:"targetDate"`
+	} `graphql:"projectMilestone"`
+	Cycle *struct {
+		ID       string `graphql:"id"`
+		Name     string `graphql:"name"`
+		StartsAt string `graphql:"startsAt"`
+		EndsAt   string `graphql:"endsAt"`
+	} `graphql:"cycle"`
+	Parent *struct {
+		ID         string `graphql:"id"`
+		Identifier string `graphql:"identifier"`
+		Title      string `graphql:"title"`
+	} `graphql:"parent"`
+	Children struct {
+		Nodes []struct {
+			ID         string `graphql:"id"`
+			Identifier string `graphql:"identifier"`
+			Title      string `graphql:"title"`
+			State      struct {
+				Name string `graphql:"name"`
+			} `graphql:"state"`
+		} `graphql:"nodes"`
+	} `graphql:"children @include(if: $includeChildren)"`
+	Relations struct {
+		Nodes []struct {
+			ID   string `graphql:"id"`
+			Type string `graphql:"type"`
+			RelatedIssue struct {
+				ID         string `graphql:"id"`
+				Identifier string `graphql:"identifier"`
+				Title      string `graphql:"title"`
+			} `graphql:"relatedIssue"`
+		} `graphql:"nodes"`
+	} `graphql:"relations @include(if: $includeRelations)"`
+	Labels struct {
+		Nodes []struct {
+			ID    string `graphql:"id"`
+			Name  string `graphql:"name"`
+			Color string `graphql:"color"`
+		} `graphql:"nodes"`
+	} `graphql:"labels"`
+	Comments struct {
+		Nodes []commentNode `graphql:"nodes"`
+	} `graphql:"comments(first: 50) @include(if: $includeComments)"`
+	Attachments struct {
+		Nodes []struct {
+			ID        string  `graphql:"id"`
+			Title     string  `graphql:"title"`
+			URL       string  `graphql:"url"`
+			Subtitle  *string `graphql:"subtitle"`
+			CreatedAt string  `graphql:"createdAt"`
+			UpdatedAt string  `graphql:"updatedAt"`
+		} `graphql:"nodes"`
+	} `graphql:"attachments @include(if: $includeAttachments)"`
+	History struct {
+		Nodes []struct {
+			ID        string `graphql:"id"`
+			CreatedAt string `graphql:"createdAt"`
+			Actor     *struct {
+				Name string `graphql:"name"`
+			} `graphql:"actor"`
+			FromState *struct {
+				Name string `graphql:"name"`
+			} `graphql:"fromState"`
+			ToState *struct {
+				Name string `graphql:"name"`
+			} `graphql:"toState"`
+			FromAssignee *struct {
+				Name string `graphql:"name"`
+			} `graphql:"fromAssignee"`
+			ToAssignee *struct {
+				Name string `graphql:"name"`
+			} `graphql:"toAssignee"`
+			FromPriority *float64 `graphql:"fromPriority"`
+			ToPriority   *float64 `graphql:"toPriority"`
+			FromTitle    string   `graphql:"fromTitle"`
+			ToTitle      string   `graphql:"toTitle"`
+			AddedLabels  []struct {
+				Name string `graphql:"name"`
+			} `graphql:"addedLabels"`
+			RemovedLabels []struct {
+				Name string `graphql:"name"`
+			} `graphql:"removedLabels"`
+			UpdatedDescription bool `graphql:"updatedDescription"`
+		} `graphql:"nodes"`
+	} `graphql:"history(first: 50) @include(if: $includeHistory)"`
+}
+
+// detail converts the query result to an IssueDetail
+func (n *issueDetailNode) detail() *IssueDetail {
+	issue := &IssueDetail{
+		ID:          n.ID,
+		Identifier:  n.Identifier,
+		Title:       n.Title,
+		Description: n.Description,
+		URL:         n.URL,
+		BranchName:  n.BranchName,
+		Priority:    n.Priority,
+		DueDate:     n.DueDate,
+		CreatedAt:   n.CreatedAt,
+		UpdatedAt:   n.UpdatedAt,
+		State: IssueState{
+			ID:    n.State.ID,
+			Name:  n.State.Name,
+			Type:  n.State.Type,
+			Color: n.State.Color,
+		},
+		Team: IssueTeam{
+			ID:   n.Team.ID,
+			Key:  n.Team.Key,
+			Name: n.Team.Name,
+		},
+	}
+
+	if n.Estimate > 0 {
+		est := n.Estimate
+		issue.Estimate = &est
+	}
+
+	if n.Assignee != nil {
+		issue.Assignee = &IssueAssignee{
+			ID:          n.Assignee.ID,
+			Name:        n.Assignee.Name,
+			DisplayName: n.Assignee.DisplayName,
+		}
+	}
+
+	if n.Project != nil {
+		issue.Project = &IssueProject{
+			ID:   n.Project.ID,
+			Name: n.Project.Name,
+		}
+	}
+
+	if n.ProjectMilestone != nil {
+		issue.ProjectMilestone = &IssueMilestone{
+			ID:         n.ProjectMilestone.ID,
+			Name:       n.ProjectMilestone.Name,
+			TargetDate: n.ProjectMilestone.TargetDate,
+		}
+	}
+
+	if n.Cycle != nil {
+		issue.Cycle = &IssueCycle{
+			ID:       n.Cycle.ID,
+			Name:     n.Cycle.Name,
+			StartsAt: n.Cycle.StartsAt,
+			EndsAt:   n.Cycle.EndsAt,
+		}
+	}
+
+	if n.Parent != nil {
+		issue.Parent = &IssueParent{
+			ID:         n.Parent.ID,
+			Identifier: n.Parent.Identifier,
+			Title:      n.Parent.Title,
+		}
+	}
+
+	for _, child := range n.Children.Nodes {
+		issue.Children = append(issue.Children, IssueChild{
+			ID:         child.ID,
+			Identifier: child.Identifier,
+			Title:      child.Title,
+			State:      struct{ Name string `json:"name"` }{Name: child.State.Name},
+		})
+	}
+
+	for _, rel := range n.Relations.Nodes {
+		issue.Relations = append(issue.Relations, IssueRelation{
+			ID:   rel.ID,
+			Type: rel.Type,
+			RelatedIssue: struct {
+				ID         string `json:"id"`
+				Identifier string `json:"identifier"`
+				Title      string `json:"title"`
+			}{
+				ID:         rel.RelatedIssue.ID,
+				Identifier: rel.RelatedIssue.Identifier,
+				Title:      rel.RelatedIssue.Title,
+			},
+		})
+	}
+
+	for _, label := range n.Labels.Nodes {
+		issue.Labels = append(issue.Labels, IssueLabel{
+			ID:    label.ID,
+			Name:  label.Name,
+			Color: label.Color,
+		})
+	}
+
+	for _, comment := range n.Comments.Nodes {
+		issue.Comments = append(issue.Comments, comment.comment())
+	}
+
+	for _, a := range n.Attachments.Nodes {
+		issue.Attachments = append(issue.Attachments, Attachment{
+			ID:        a.ID,
+			Title:     a.Title,
+			URL:       a.URL,
+			Subtitle:  a.Subtitle,
+			CreatedAt: a.CreatedAt,
+			UpdatedAt: a.UpdatedAt,
+		})
+	}
+
+	for _, h := range n.History.Nodes {
+		entry := IssueHistoryEntry{
+			ID:                 h.ID,
+			CreatedAt:          h.CreatedAt,
+			FromTitle:          h.FromTitle,
+			ToTitle:            h.ToTitle,
+			UpdatedDescription: h.UpdatedDescription,
+		}
+		if h.Actor != nil {
+			entry.Actor = h.Actor.Name
+		}
+		if h.FromState != nil {
+			entry.FromState = h.FromState.Name
+		}
+		if h.ToState != nil {
+			entry.ToState = h.ToState.Name
+		}
+		if h.FromAssignee != nil {
+			entry.FromAssignee = h.FromAssignee.Name
+		}
+		if h.ToAssignee != nil {
+			entry.ToAssignee = h.ToAssignee.Name
+		}
+		if h.FromPriority != nil && h.ToPriority != nil && *h.FromPriority != *h.ToPriority {
+			from, to := int(*h.FromPriority), int(*h.ToPriority)
+			entry.FromPriority, entry.ToPriority = &from, &to
+		}
+		for _, l := range h.AddedLabels {
+			entry.AddedLabels = append(entry.AddedLabels, l.Name)
+		}
+		for _, l := range h.RemovedLabels {
+			entry.RemovedLabels = append(entry.RemovedLabels, l.Name)
+		}
+		issue.History = append(issue.History, entry)
+	}
+
+	return issue
+}
+
+// GetIssueComments fetches comments for an issue
+func (c *Client) GetIssueComments(ctx context.Context, issueID string, limit int) ([]Comment, error) {
+	var query struct {
+		Issue struct {
+			Comments struct {
+				Nodes []commentNode `graphql:"nodes"`
+			} `graphql:"comments(first: $limit)"`
+		} `graphql:"issue(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"limit": limit,
+	}
+
+	if err := c.Query(ctx, &query, variables); err != nil {
+		return nil, err
+	}
+
+	comments := make([]Comment, len(query.Issue.Comments.Nodes))
+	for i, n := range query.Issue.Comments.Nodes {
+		comments[i] = n.comment()
+	}
+
+	return comments, nil
+}
+
+// commentNode is the comment selection shared by comment queries
+type commentNode struct {
+	ID        string `graphql:"id"`
+	Body      string `graphql:"body"`
+	CreatedAt string `graphql:"createdAt"`
+	User      *struct {
+		ID          string `graphql:"id"`
+		Name        string `graphql:"name"`
+		DisplayName string `graphql:"displayName"`
+	} `graphql:"user"`
+	Parent *struct {
+		ID string `graphql:"id"`
+	} `graphql:"parent"`
+	Reactions []struct {
+		ID    string `graphql:"id"`
+		Emoji string `graphql:"emoji"`
+		User  *struct {
+			ID          string `graphql:"id"`
+			DisplayName string `graphql:"displayName"`
+		} `graphql:"user"`
+	} `graphql:"reactions"`
+}
+
+// comment converts the query result to a Comment
+func (n *commentNode) comment() Comment {
+	comment := Comment{
+		ID:        n.ID,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+	}
+	if n.User != nil {
+		comment.User = &struct {
+			ID          string `json:"id"`
+			Name        string `json:"name"`
+			DisplayName string `json:"displayName"`
+		}{
+			ID:          n.User.ID,
+			Name:        n.User.Name,
+			DisplayName: n.User.DisplayName,
+		}
+	}
+	if n.Parent != nil {
+		comment.Parent = &struct {
+			ID string `json:"id"`
+		}{ID: n.Parent.ID}
+	}
+	for _, r := range n.Reactions {
+		reaction := Reaction{ID: r.ID, Emoji: r.Emoji}
+		if r.User != nil {
+			reaction.User = &struct {
+				ID          string `json:"id"`
+				DisplayName string `json:"displayName"`
+			}{ID: r.User.ID, DisplayName: r.User.DisplayName}
+		}
+		comment.Reactions = append(comment.Reactions, reaction)
+		if comment.ReactionCounts == nil {
+			comment.ReactionCounts = map[string]int{}
+		}
+		comment.ReactionCounts[r.Emoji]++
+	}
+	return comment
+}
+
+// CreateIssue creates a new issue
+func (c *Client) CreateIssue(ctx context.Context, input IssueCreateInput) (*IssueCreateResponse, error) {
+	mutation := `mutation($input: IssueCreateInput!) {
+		issueCreate(input: $input) {
+			success
+			issue {
+				id
+				identifier
+				url
+				team {
+					key
+				}
+			}
+		}
+	}`
+	if input.CreateAsUser == "" {
+		input.CreateAsUser, input.DisplayIconURL = onBehalf()
+	}
+	variables := map[string]interface{}{"input": input}
+
+	var result struct {
+		IssueCreate struct {
+			Success bool `json:"success"`
+			Issue   struct {
+				ID         string `json:"id"`
+				Identifier string `json:"identifier"`
+				URL        string `json:"url"`
+				Team       struct {
+					Key string `json:"key"`
+				} `json:"team"`
+			} `json:"issue"`
+		} `json:"issueCreate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.IssueCreate.Success {
+		return nil, fmt.Errorf("failed to create issue")
+	}
+
+	return &IssueCreateResponse{
+		Success:    true,
+		ID:         result.IssueCreate.Issue.ID,
+		Identifier: result.IssueCreate.Issue.Identifier,
+		URL:        result.IssueCreate.Issue.URL,
+		TeamKey:    result.IssueCreate.Issue.Team.Key,
+	}, nil
+}
+
+// UpdateIssue updates an existing issue
+func (c *Client) UpdateIssue(ctx context.Context, issueID string, input IssueUpdateInput) (*IssueCreateResponse, error) {
+	if input.isEmpty() {
+		return nil, fmt.Errorf("at least one field must be provided to update")
+	}
+
+	mutation := `mutation($id: String!, $input: IssueUpdateInput!) {
+		issueUpdate(id: $id, input: $input) {
+			success
+			issue {
+				id
+				identifier
+				url
+				team {
+					key
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{"id": issueID, "input": input}
+
+	var result struct {
+		IssueUpdate struct {
+			Success bool `json:"success"`
+			Issue   struct {
+				ID         string `json:"id"`
+				Identifier string `json:"identifier"`
+				URL        string `json:"url"`
+				Team       struct {
+					Key string `json:"key"`
+				} `json:"team"`
+			} `json:"issue"`
+		} `json:"issueUpdate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.IssueUpdate.Success {
+		return nil, fmt.Errorf("failed to update issue")
+	}
+
+	return &IssueCreateResponse{
+		Success:    true,
+		ID:         result.IssueUpdate.Issue.ID,
+		Identifier: result.IssueUpdate.Issue.Identifier,
+		URL:        result.IssueUpdate.Issue.URL,
+		TeamKey:    result.IssueUpdate.Issue.Team.Key,
+	}, nil
+}
+
+// IssueOrder is an issue's position in its workflow state's board column.
+// Issues with a lower SortOrder are shown first.
+type IssueOrder struct {
+	ID         string  `json:"id"`
+	Identifier string  `json:"identifier"`
+	Title      string  `json:"title"`
+	SortOrder  float64 `json:"sortOrder"`
+	State      struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"state"`
+}
+
+const issueOrderSelection = `id
+				identifier
+				title
+				sortOrder
+				state {
+					id
+					name
+				}`
+
+// GetIssueOrder fetches an issue's sort order and state
+func (c *Client) GetIssueOrder(ctx context.Context, issueID string) (*IssueOrder, error) {
+	queryStr := fmt.Sprintf(`query($id: String!) {
+		issue(id: $id) {
+			%s
+		}
+	}`, issueOrderSelection)
+
+	var result struct {
+		Issue *IssueOrder `json:"issue"`
+	}
+	if err := c.exec(ctx, queryStr, &result, map[string]interface{}{"id": issueID}); err != nil {
+		return nil, err
+	}
+	if result.Issue == nil {
+		return nil, fmt.Errorf("issue not found: %s", issueID)
+	}
+	return result.Issue, nil
+}
+
+// GetStateIssueOrders fetches the issues in a workflow state with their
+// sort order, a page at a time and in no particular order
+func (c *Client) GetStateIssueOrders(ctx context.Context, stateID string, limit int, after string) ([]IssueOrder, *PageInfo, error) {
+	queryStr := fmt.Sprintf(`query($filter: IssueFilter) {
+		issues(first: %d%s, filter: $filter) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				%s
+			}
+		}
+	}`, limit, afterArg(after), issueOrderSelection)
+
+	var result struct {
+		Issues struct {
+			PageInfo PageInfo     `json:"pageInfo"`
+			Nodes    []IssueOrder `json:"nodes"`
+		} `json:"issues"`
+	}
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{"state": idFilter(stateID)},
+	}
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
+		return nil, nil, err
+	}
+	return result.Issues.Nodes, &result.Issues.PageInfo, nil
+}
+
+// DeleteIssue deletes an issue
+func (c *Client) DeleteIssue(ctx context.Context, issueID string) error {
+	mutation := `mutation($id: String!) {
+		issueDelete(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": issueID}
+
+	var result struct {
+		IssueDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueDelete"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.IssueDelete.Success {
+		return fmt.Errorf("failed to delete issue")
+	}
+
+	return nil
+}
+
+// SearchIssues searches for issues
+func (c *Client) SearchIssues(ctx context.Context, term string, limit int, includeArchived, includeComments bool, teamID string) (*SearchIssuesResponse, error) {
+	// Build query with optional teamId parameter
+	teamIDClause := ""
+	if teamID != "" {
+		teamIDClause = fmt.Sprintf(`, teamId: %q`, teamID)
+	}
+
+	queryStr := fmt.Sprintf(`query {
+		searchIssues(term: %q, first: %d, includeArchived: %t, includeComments: %t%s) {
+			nodes {
+				id
+				identifier
+				title
+				priority
+				estimate
+				createdAt
+				updatedAt
+				state {
+					id
+					name
+					type
+					color
+				}
+				assignee {
+					id
+					name
+					displayName
+				}
+				team {
+					key
+					name
+				}
+			}
+			pageInfo {
+				hasNextPage
+			}
+			totalCount
+		}
+	}`, term, limit, includeArchived, includeComments, teamIDClause)
+
+	var result struct {
+		SearchIssues struct {
+			Nodes []struct {
+				ID         string  `json:"id"`
+				Identifier string  `json:"identifier"`
+				Title      string  `json:"title"`
+				Priority   int     `json:"priority"`
+				Estimate   float64 `json:"estimate"`
+				CreatedAt  string  `json:"createdAt"`
+				UpdatedAt  string  `json:"updatedAt"`
+				State      struct {
+					ID    string `json:"id"`
+					Name  string `json:"name"`
+					Type  string `json:"type"`
+					Color string `json:"color"`
+				} `json:"state"`
+				Assignee *struct {
+					ID          string `json:"id"`
+					Name        string `json:"name"`
+					DisplayName string `json:"displayName"`
+				} `json:"assignee"`
+				Team struct {
+					Key  string `json:"key"`
+					Name string `json:"name"`
+				} `json:"team"`
+			} `json:"nodes"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+			TotalCount int `json:"totalCount"`
+		} `json:"searchIssues"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	issues := make([]IssueListItem, len(result.SearchIssues.Nodes))
+	for i, issue := range result.SearchIssues.Nodes {
+		issues[i] = IssueListItem{
+			ID:         issue.ID,
+			Identifier: issue.Identifier,
+			Title:      issue.Title,
+			Priority:   issue.Priority,
+			UpdatedAt:  issue.UpdatedAt,
+			State: IssueState{
+				ID:    issue.State.ID,
+				Name:  issue.State.Name,
+				Type:  issue.State.Type,
+				Color: issue.State.Color,
+			},
+		}
+		if issue.Estimate > 0 {
+			est := issue.Estimate
+			issues[i].Estimate = &est
+		}
+		if issue.Assignee != nil {
+			issues[i].Assignee = &IssueAssignee{
+				ID:          issue.Assignee.ID,
+				Name:        issue.Assignee.Name,
+				DisplayName: issue.Assignee.DisplayName,
+			}
+		}
+	}
+
+	return &SearchIssuesResponse{
+		Issues:     issues,
+		TotalCount: result.SearchIssues.TotalCount,
+		HasMore:    result.SearchIssues.PageInfo.HasNextPage,
+		Query:      term,
+	}, nil
+}
+
+// CreateComment creates a comment on an issue
+func (c *Client) CreateComment(ctx context.Context, issueID string, body string) (*Comment, error) {
+	return c.createComment(ctx, CommentCreateInput{IssueID: issueID, Body: body})
+}
+
+// CreateCommentReply adds a threaded reply to an existing comment
+func (c *Client) CreateCommentReply(ctx context.Context, issueID, parentID, body string) (*Comment, error) {
+	return c.createComment(ctx, CommentCreateInput{IssueID: issueID, Body: body, ParentID: parentID})
+}
+
+// CreateProjectUpdateComment comments on a project status update, as a
+// reply to parentID when it is set
+func (c *Client) CreateProjectUpdateComment(ctx context.Context, updateID, parentID, body string) (*Comment, error) {
+	return c.createComment(ctx, CommentCreateInput{ProjectUpdateID: updateID, Body: body, ParentID: parentID})
+}
+
+// commentFields is the selection set for comments returned by mutations
+const commentFields = `
+				id
+				body
+				createdAt
+				user {
+					id
+					name
+					displayName
+				}
+				parent {
+					id
+				}
+				reactions {
+					id
+					emoji
+				}`
+
+func (c *Client) createComment(ctx context.Context, input CommentCreateInput) (*Comment, error) {
+	mutation := `mutation($input: CommentCreateInput!) {
+		commentCreate(input: $input) {
+			success
+			comment {` + commentFields + `
+			}
+		}
+	}`
+	if input.CreateAsUser == "" {
+		input.CreateAsUser, input.DisplayIconURL = onBehalf()
+	}
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var result struct {
+		CommentCreate struct {
+			Success bool    `json:"success"`
+			Comment Comment `json:"comment"`
+		} `json:"commentCreate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.CommentCreate.Success {
+		return nil, fmt.Errorf("failed to create comment")
+	}
+
+	return &result.CommentCreate.Comment, nil
+}
+
+// UpdateComment replaces a comment's body
+func (c *Client) UpdateComment(ctx context.Context, commentID, body string) (*Comment, error) {
+	mutation := `mutation($id: String!, $input: CommentUpdateInput!) {
+		commentUpdate(id: $id, input: $input) {
+			success
+			comment {` + commentFields + `
+			}
+		}
+	}`
+	variables := map[string]interface{}{
+		"id":    commentID,
+		"input": map[string]interface{}{"body": body},
+	}
+
+	var result struct {
+		CommentUpdate struct {
+			Success bool    `json:"success"`
+			Comment Comment `json:"comment"`
+		} `json:"commentUpdate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.CommentUpdate.Success {
+		return nil, fmt.Errorf("failed to update comment")
+	}
+
+	return &result.CommentUpdate.Comment, nil
+}
+
+// DeleteComment deletes a comment
+func (c *Client) DeleteComment(ctx context.Context, commentID string) error {
+	mutation := `mutation($id: String!) {
+		commentDelete(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": commentID}
+
+	var result struct {
+		CommentDelete struct {
+			Success bool `json:"success"`
+		} `json:"commentDelete"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.CommentDelete.Success {
+		return fmt.Errorf("failed to delete comment")
+	}
+
+	return nil
+}
+
+// reactionFields is the selection set for reactions
+const reactionFields = `
+				id
+				emoji
+				user {
+					id
+					displayName
+				}`
+
+// CreateReaction adds an emoji reaction to a comment or issue
+func (c *Client) CreateReaction(ctx context.Context, input ReactionCreateInput) (*Reaction, error) {
+	mutation := `mutation($input: ReactionCreateInput!) {
+		reactionCreate(input: $input) {
+			success
+			reaction {` + reactionFields + `
+			}
+		}
+	}`
+	variables := map[string]interface{}{"input": input}
+
+	var result struct {
+		ReactionCreate struct {
+			Success  bool     `json:"success"`
+			Reaction Reaction `json:"reaction"`
+		} `json:"reactionCreate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.ReactionCreate.Success {
+		return nil, fmt.Errorf("failed to add reaction")
+	}
+
+	return &result.ReactionCreate.Reaction, nil
+}
+
+// DeleteReaction removes a reaction
+func (c *Client) DeleteReaction(ctx context.Context, reactionID string) error {
+	mutation := `mutation($id: String!) {
+		reactionDelete(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": reactionID}
+
+	var result struct {
+		ReactionDelete struct {
+			Success bool `json:"success"`
+		} `json:"reactionDelete"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.ReactionDelete.Success {
+		return fmt.Errorf("failed to remove reaction")
+	}
+
+	return nil
+}
+
+// SetIssueSubscription subscribes a user to an issue's notifications, or
+// unsubscribes them. An empty userID means the viewer.
+func (c *Client) SetIssueSubscription(ctx context.Context, issueID, userID string, subscribe bool) error {
+	field := "issueSubscribe"
+	if !subscribe {
+		field = "issueUnsubscribe"
+	}
+	mutation := fmt.Sprintf(`mutation($id: String!, $userId: String) {
+		%s(id: $id, userId: $userId) {
+			success
+		}
+	}`, field)
+	variables := map[string]interface{}{"id": issueID, "userId": nil}
+	if userID != "" {
+		variables["userId"] = userID
+	}
+
+	var result struct {
+		IssueSubscribe struct {
+			Success bool `json:"success"`
+		} `json:"issueSubscribe"`
+		IssueUnsubscribe struct {
+			Success bool `json:"success"`
+		} `json:"issueUnsubscribe"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.IssueSubscribe.Success && !result.IssueUnsubscribe.Success {
+		if subscribe {
+			return fmt.Errorf("failed to subscribe to issue")
+		}
+		return fmt.Errorf("failed to unsubscribe from issue")
+	}
+
+	return nil
+}
+
+// IssueAssignment is an issue with its current assignee
+type IssueAssignment struct {
+	ID         string         `json:"id"`
+	Identifier string         `json:"identifier"`
+	Title      string         `json:"title"`
+	URL        string         `json:"url"`
+	UpdatedAt  string         `json:"updatedAt"`
+	Assignee   *IssueAssignee `json:"assignee"`
+}
+
+const issueAssignmentSelection = `id
+				identifier
+				title
+				url
+				updatedAt
+				assignee {
+					id
+					name
+					displayName
+				}`
+
+// GetIssueAssignment fetches an issue's current assignee
+func (c *Client) GetIssueAssignment(ctx context.Context, issueID string) (*IssueAssignment, error) {
+	query := `query($id: String!) {
+		issue(id: $id) {
+			` + issueAssignmentSelection + `
+		}
+	}`
+
+	var result struct {
+		Issue *IssueAssignment `json:"issue"`
+	}
+
+	if err := c.exec(ctx, query, &result, map[string]interface{}{"id": issueID}); err != nil {
+		return nil, err
+	}
+	if result.Issue == nil {
+		return nil, &Error{Kind: ErrNotFound, Message: fmt.Sprintf("Issue '%s' not found", issueID)}
+	}
+	return result.Issue, nil
+}
+
+// SetIssueAssignee assigns an issue to a user, or unassigns it when
+// assigneeID is empty, returning the issue as the update left it
+func (c *Client) SetIssueAssignee(ctx context.Context, issueID, assigneeID string) (*IssueAssignment, error) {
+	mutation := `mutation($id: String!, $assigneeId: String) {
+		issueUpdate(id: $id, input: {assigneeId: $assigneeId}) {
+			success
+			issue {
+				` + issueAssignmentSelection + `
+			}
+		}
+	}`
+	variables := map[string]interface{}{"id": issueID, "assigneeId": nil}
+	if assigneeID != "" {
+		variables["assigneeId"] = assigneeID
+	}
+
+	var result struct {
+		IssueUpdate struct {
+			Success bool            `json:"success"`
+			Issue   IssueAssignment `json:"issue"`
+		} `json:"issueUpdate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.IssueUpdate.Success {
+		return nil, fmt.Errorf("failed to update issue assignee")
+	}
+
+	return &result.IssueUpdate.Issue, nil
+}
+
+// GetIssueSubscribers fetches the users subscribed to an issue
+func (c *Client) GetIssueSubscribers(ctx context.Context, issueID string) ([]User, error) {
+	query := `query($id: String!) {
+		issue(id: $id) {
+			subscribers(first: 250) {
+				nodes {
+					id
+					name
+					displayName
+					email
+					active
+					admin
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{"id": issueID}
+
+	var result struct {
+		Issue *struct {
+			Subscribers struct {
+				Nodes []User `json:"nodes"`
+			} `json:"subscribers"`
+		} `json:"issue"`
+	}
+
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if result.Issue == nil {
+		return nil, nil
+	}
+
+	subscribers := result.Issue.Subscribers.Nodes
+	if subscribers == nil {
+		subscribers = []User{}
+	}
+	return subscribers, nil
+}
+
+// GetCommentReactions fetches the reactions on a comment
+func (c *Client) GetCommentReactions(ctx context.Context, commentID string) ([]Reaction, error) {
+	query := `query($id: String!) {
+		comment(id: $id) {
+			reactions {` + reactionFields + `
+			}
+		}
+	}`
+	variables := map[string]interface{}{"id": commentID}
+
+	var result struct {
+		Comment struct {
+			Reactions []Reaction `json:"reactions"`
+		} `json:"comment"`
+	}
+
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, err
+	}
+
+	return result.Comment.Reactions, nil
+}
+
+// GetIssueReactions fetches the reactions on an issue
+func (c *Client) GetIssueReactions(ctx context.Context, issueID string) ([]Reaction, error) {
+	query := `query($id: String!) {
+		issue(id: $id) {
+			reactions {` + reactionFields + `
+			}
+		}
+	}`
+	variables := map[string]interface{}{"id": issueID}
+
+	var result struct {
+		Issue struct {
+			Reactions []Reaction `json:"reactions"`
+		} `json:"issue"`
+	}
+
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, err
+	}
+
+	return result.Issue.Reactions, nil
+}
+
+// GetIssueInverseRelations fetches relations that other issues have to this
+// one, such as the issues blocking it. RelatedIssue is the other issue.
+func (c *Client) GetIssueInverseRelations(ctx context.Context, issueID string) ([]IssueRelation, error) {
+	query := `query($id: String!) {
+		issue(id: $id) {
+			inverseRelations {
+				nodes {
+					id
+					type
+					issue {
+						id
+						identifier
+						title
+					}
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{
+		"id": issueID,
+	}
+
+	var result struct {
+		Issue struct {
+			InverseRelations struct {
+				Nodes []struct {
+					ID    string `json:"id"`
+					Type  string `json:"type"`
+					Issue struct {
+						ID         string `json:"id"`
+						Identifier string `json:"identifier"`
+						Title      string `json:"title"`
+					} `json:"issue"`
+				} `json:"nodes"`
+			} `json:"inverseRelations"`
+		} `json:"issue"`
+	}
+
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, err
+	}
+
+	relations := make([]IssueRelation, len(result.Issue.InverseRelations.Nodes))
+	for i, node := range result.Issue.InverseRelations.Nodes {
+		relations[i].ID = node.ID
+		relations[i].Type = node.Type
+		relations[i].RelatedIssue.ID = node.Issue.ID
+		relations[i].RelatedIssue.Identifier = node.Issue.Identifier
+		relations[i].RelatedIssue.Title = node.Issue.Title
+	}
+	return relations, nil
+}
+
+// GraphIssue is an issue as it appears in a dependency graph
+type GraphIssue struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	State      struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"state"`
+}
+
+// GraphLink is a relation from one issue to another; Issue is the other one
+type GraphLink struct {
+	Type  string     `json:"type"`
+	Issue GraphIssue `json:"issue"`
+}
+
+// IssueLinks is an issue with every issue linked to it: its parent, its
+// sub-issues, its relations to other issues, and their relations to it
+type IssueLinks struct {
+	GraphIssue
+	URL              string       `json:"url"`
+	Parent           *GraphIssue  `json:"parent"`
+	Children         []GraphIssue `json:"children"`
+	Relations        []GraphLink  `json:"relations"`
+	InverseRelations []GraphLink  `json:"inverseRelations"`
+}
+
+// GetIssueLinks fetches an issue (by ID or identifier) with its parent,
+// sub-issues and relations in both directions. It returns nil when the
+// issue does not exist.
+func (c *Client) GetIssueLinks(ctx context.Context, issueID string) (*IssueLinks, error) {
+	issueFields := `id identifier title state { name type }`
+	query := `query($id: String!) {
+		issue(id: $id) {
+			` + issueFields + `
+			url
+			parent { ` + issueFields + ` }
+			children(first: 100) { nodes { ` + issueFields + ` } }
+			relations(first: 100) { nodes { type relatedIssue { ` + issueFields + ` } } }
+			inverseRelations(first: 100) { nodes { type issue { ` + issueFields + ` } } }
+		}
+	}`
+	variables := map[string]interface{}{"id": issueID}
+
+	var result struct {
+		Issue *struct {
+			GraphIssue
+			URL      string      `json:"url"`
+			Parent   *GraphIssue `json:"parent"`
+			Children struct {
+				Nodes []GraphIssue `json:"nodes"`
+			} `json:"children"`
+			Relations struct {
+				Nodes []struct {
+					Type         string     `json:"type"`
+					RelatedIssue GraphIssue `json:"relatedIssue"`
+				} `json:"nodes"`
+			} `json:"relations"`
+			InverseRelations struct {
+				Nodes []GraphLink `json:"nodes"`
+			} `json:"inverseRelations"`
+		} `json:"issue"`
+	}
+
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, err
+	}
+	if result.Issue == nil {
+		return nil, nil
+	}
+
+	links := &IssueLinks{
+		GraphIssue:       result.Issue.GraphIssue,
+		URL:              result.Issue.URL,
+		Parent:           result.Issue.Parent,
+		Children:         result.Issue.Children.Nodes,
+		Relations:        make([]GraphLink, len(result.Issue.Relations.Nodes)),
+		InverseRelations: result.Issue.InverseRelations.Nodes,
+	}
+	for i, rel := range result.Issue.Relations.Nodes {
+		links.Relations[i] = GraphLink{Type: rel.Type, Issue: rel.RelatedIssue}
+	}
+	return links, nil
+}
+
+// IssueBlocking is the open issues blocking an issue, and the open issues
+// it blocks
+type IssueBlocking struct {
+	BlockedBy []GraphIssue
+	Blocking  []GraphIssue
+}
+
+// blockingBatch is how many issues GetIssueBlocking asks for at once,
+// keeping each request's complexity well under Linear's limit
+const blockingBatch = 50
+
+// GetIssueBlocking fetches the blocking relations of issues by ID, keyed
+// by issue ID. Completed and canceled issues no longer block, so relations
+// to them are left out.
+func (c *Client) GetIssueBlocking(ctx context.Context, ids []string) (map[string]IssueBlocking, error) {
+	issueFields := `id identifier title state { name type }`
+	query := `query($first: Int!, $filter: IssueFilter) {
+		issues(first: $first, filter: $filter) {
+			nodes {
+				id
+				relations(first: 25) { nodes { type relatedIssue { ` + issueFields + ` } } }
+				inverseRelations(first: 25) { nodes { type issue { ` + issueFields + ` } } }
+			}
+		}
+	}`
+
+	open := func(issue GraphIssue) bool {
+		return issue.State.Type != "completed" && issue.State.Type != "canceled"
+	}
+
+	blocking := make(map[string]IssueBlocking, len(ids))
+	for start := 0; start < len(ids); start += blockingBatch {
+		batch := ids[start:min(start+blockingBatch, len(ids))]
+		variables := map[string]interface{}{
+			"first":  len(batch),
+			"filter": map[string]interface{}{"id": map[string]interface{}{"in": batch}},
+		}
+
+		var result struct {
+			Issues struct {
+				Nodes []struct {
+					ID        string `json:"id"`
+					Relations struct {
+						Nodes []struct {
+							Type         string     `json:"type"`
+							RelatedIssue GraphIssue `json:"relatedIssue"`
+						} `json:"nodes"`
+					} `json:"relations"`
+					InverseRelations struct {
+						Nodes []GraphLink `json:"nodes"`
+					} `json:"inverseRelations"`
+				} `json:"nodes"`
+			} `json:"issues"`
+		}
+
+		if err := c.exec(ctx, query, &result, variables); err != nil {
+			return nil, err
+		}
+
+		for _, node := range result.Issues.Nodes {
+			var b IssueBlocking
+			for _, rel := range node.Relations.Nodes {
+				if rel.Type == "blocks" && open(rel.RelatedIssue) {
+					b.Blocking = append(b.Blocking, rel.RelatedIssue)
+				}
+			}
+			for _, rel := range node.InverseRelations.Nodes {
+				if rel.Type == "blocks" && open(rel.Issue) {
+					b.BlockedBy = append(b.BlockedBy, rel.Issue)
+				}
+			}
+			blocking[node.ID] = b
+		}
+	}
+	return blocking, nil
+}
+
+// CreateIssueRelation creates a relationship between issues
+func (c *Client) CreateIssueRelation(ctx context.Context, issueID, relatedIssueID, relationType string) error {
+	mutation := `mutation($input: IssueRelationCreateInput!) {
+		issueRelationCreate(input: $input) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{
+		"input": IssueRelationCreateInput{IssueID: issueID, RelatedIssueID: relatedIssueID, Type: relationType},
+	}
+
+	var result struct {
+		IssueRelationCreate struct {
+			Success bool `json:"success"`
+		} `json:"issueRelationCreate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.IssueRelationCreate.Success {
+		return fmt.Errorf("failed to create issue relation")
+	}
+
+	return nil
+}
+
+// DeleteIssueRelation removes a relationship between issues
+func (c *Client) DeleteIssueRelation(ctx context.Context, relationID string) error {
+	mutation := `mutation($id: String!) {
+		issueRelationDelete(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": relationID}
+
+	var result struct {
+		IssueRelationDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueRelationDelete"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.IssueRelationDelete.Success {
+		return fmt.Errorf("failed to delete issue relation")
+	}
+
+	return nil
+}
+
+// GetViewer returns the current authenticated user (needed for "self" assignee)
+func (c *Client) GetViewerID(ctx context.Context) (string, error) {
+	viewer, err := c.GetViewer(ctx)
+	if err != nil {
+		return "", err
+	}
+	return viewer.Viewer.ID, nil
+}
+
+// Attachment represents an issue attachment
+type Attachment struct {
+	ID        string  `json:"id"`
+	Title     string  `json:"title"`
+	URL       string  `json:"url"`
+	Subtitle  *string `json:"subtitle,omitempty"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+	Creator   *struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"creator,omitempty"`
+}
+
+// AttachmentCreateInput represents input for creating an attachment
+type AttachmentCreateInput struct {
+	IssueID  string `json:"issueId"`
+	Title    string `json:"title"`
+	URL      string `json:"url"`
+	Subtitle string `json:"subtitle,omitempty"`
+}
+
+// AttachmentsResponse is the response for listing attachments
+type AttachmentsResponse struct {
+	Attachments []Attachment `json:"attachments"`
+	Count       int          `json:"count"`
+}
+
+// GetIssueAttachments fetches attachments for an issue
+func (c *Client) GetIssueAttachments(ctx context.Context, issueID string) (*AttachmentsResponse, error) {
+	queryStr := fmt.Sprintf(`query {
+		issue(id: %q) {
+			attachments {
+				nodes {
+					id
+					title
+					url
+					subtitle
+					createdAt
+					updatedAt
+					creator {
+						id
+						name
+						displayName
+					}
+				}
+			}
+		}
+	}`, issueID)
+
+	var result struct {
+		Issue struct {
+			Attachments struct {
+				Nodes []Attachment `json:"nodes"`
+			} `json:"attachments"`
+		} `json:"issue"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	return &AttachmentsResponse{
+		Attachments: result.Issue.Attachments.Nodes,
+		Count:       len(result.Issue.Attachments.Nodes),
+	}, nil
+}
+
+// GetAttachment fetches a single attachment by ID
+func (c *Client) GetAttachment(ctx context.Context, attachmentID string) (*Attachment, error) {
+	queryStr := fmt.Sprintf(`query {
+		attachment(id: %q) {
+			id
+			title
+			url
+			subtitle
+			createdAt
+			updatedAt
+			creator {
+				id
+				name
+				displayName
+			}
+		}
+	}`, attachmentID)
+
+	var result struct {
+		Attachment *Attachment `json:"attachment"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	return result.Attachment, nil
+}
+
+// LinearUploadsHost is the host serving files uploaded to Linear
+const LinearUploadsHost = "uploads.linear.app"
+
+// uploadsTransport authorizes requests to Linear's upload host only. It is
+// consulted for every redirect too, so a redirect to another host, such as
+// a CDN, is sent without the token.
+type uploadsTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *uploadsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Del("Authorization")
+	if t.token != "" && req.URL.Scheme == "https" && req.URL.Hostname() == LinearUploadsHost {
+		req.Header.Set("Authorization", t.token)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// Download performs a GET request for an attachment target. Linear-hosted
+// uploads are fetched with the client's credentials; any other host,
+// including one a download redirects to, is fetched anonymously so the
+// API token never leaks to third parties. The caller is responsible for
+// closing the response body.
+func (c *Client) Download(ctx context.Context, rawURL string) (*http.Response, error) {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported URL scheme %q", target.Scheme)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{Transport: &uploadsTransport{token: c.token, base: c.base}}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("download failed: %s", resp.Status)
+	}
+
+	return resp, nil
+}
+
+// UploadedFile is a file uploaded to Linear's storage
+type UploadedFile struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	Size        int    `json:"size"`
+	AssetURL    string `json:"assetUrl"`
+}
+
+// UploadFile uploads a local file to Linear's storage so it can be linked
+// from markdown. The file is sent to the signed upload URL Linear returns,
+// without the API token.
+func (c *Client) UploadFile(ctx context.Context, path string) (*UploadedFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	filename := filepath.Base(path)
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+
+	mutation := `mutation($contentType: String!, $filename: String!, $size: Int!) {
+		fileUpload(contentType: $contentType, filename: $filename, size: $size) {
+			success
+			uploadFile {
+				uploadUrl
+				assetUrl
+				headers {
+					key
+					value
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{
+		"contentType": contentType,
+		"filename":    filename,
+		"size":        len(data),
+	}
+
+	var result struct {
+		FileUpload struct {
+			Success    bool `json:"success"`
+			UploadFile *struct {
+				UploadURL string `json:"uploadUrl"`
+				AssetURL  string `json:"assetUrl"`
+				Headers   []struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"headers"`
+			} `json:"uploadFile"`
+		} `json:"fileUpload"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+	upload := result.FileUpload.UploadFile
+	if !result.FileUpload.Success || upload == nil {
+		return nil, fmt.Errorf("failed to upload %s", filename)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, upload.UploadURL, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", "public, max-age=31536000")
+	for _, h := range upload.Headers {
+		req.Header.Set(h.Key, h.Value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("upload of %s failed: %s", filename, resp.Status)
+	}
+
+	return &UploadedFile{
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        len(data),
+		AssetURL:    upload.AssetURL,
+	}, nil
+}
+
+// CreateAttachment creates a new attachment on an issue
+func (c *Client) CreateAttachment(ctx context.Context, issueID, title, url string, subtitle *string) (*Attachment, error) {
+	input := AttachmentCreateInput{IssueID: issueID, Title: title, URL: url}
+	if subtitle != nil && *subtitle != "" {
+		input.Subtitle = *subtitle
+	}
+
+	mutation := `mutation($input: AttachmentCreateInput!) {
+		attachmentCreate(input: $input) {
+			success
+			attachment {
+				id
+				title
+				url
+				subtitle
+				createdAt
+				updatedAt
+			}
+		}
+	}`
+	variables := map[string]interface{}{"input": input}
+
+	var result struct {
+		AttachmentCreate struct {
+			Success    bool       `json:"success"`
+			Attachment Attachment `json:"attachment"`
+		} `json:"attachmentCreate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.AttachmentCreate.Success {
+		return nil, fmt.Errorf("failed to create attachment")
+	}
+
+	return &result.AttachmentCreate.Attachment, nil
+}
+
+// DeleteAttachment deletes an attachment
+func (c *Client) DeleteAttachment(ctx context.Context, attachmentID string) error {
+	mutation := `mutation($id: String!) {
+		attachmentDelete(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": attachmentID}
+
+	var result struct {
+		AttachmentDelete struct {
+			Success bool `json:"success"`
+		} `json:"attachmentDelete"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.AttachmentDelete.Success {
+		return fmt.Errorf("failed to delete attachment")
+	}
+
+	return nil
+}
+
+// ProjectDetail represents a detailed project
+type ProjectDetail struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Content     string  `json:"content,omitempty"`
+	SlugID      string  `json:"slugId"`
+	Icon        string  `json:"icon,omitempty"`
+	Color       string  `json:"color,omitempty"`
+	State       string  `json:"state"`
+	Progress    float64 `json:"progress"`
+	StartDate   string  `json:"startDate,omitempty"`
+	TargetDate  string  `json:"targetDate,omitempty"`
+	URL         string  `json:"url"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+	Status      *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"status,omitempty"`
+	Lead *struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"lead,omitempty"`
+	Teams []struct {
+		ID   string `json:"id"`
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	} `json:"teams,omitempty"`
+}
+
+// ProjectListItem represents a project in a list
+type ProjectListItem struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	SlugID     string  `json:"slugId"`
+	State      string  `json:"state"`
+	Progress   float64 `json:"progress"`
+	TargetDate string  `json:"targetDate,omitempty"`
+	URL        string  `json:"url"`
+	CreatedAt  string  `json:"createdAt,omitempty"`
+	UpdatedAt  string  `json:"updatedAt"`
+	Status     *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"status,omitempty"`
+	Lead *struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+	} `json:"lead,omitempty"`
+	Teams []struct {
+		Key string `json:"key"`
+	} `json:"teams,omitempty"`
+}
+
+// ProjectsResponse is the response for listing projects
+type ProjectsResponse struct {
+	Projects []ProjectListItem `json:"projects"`
+	Count    int               `json:"count"`
+	PageInfo *PageInfo         `json:"pageInfo,omitempty"`
+}
+
+// SearchProjectsResponse is the response for searching projects
+type SearchProjectsResponse struct {
+	Projects   []ProjectListItem `json:"projects"`
+	TotalCount int               `json:"totalCount"`
+	HasMore    bool              `json:"hasMore"`
+	Query      string            `json:"query"`
+}
+
+// ProjectCreateInput is the input for creating a project
+type ProjectCreateInput struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Content     string   `json:"content,omitempty"`
+	TeamIDs     []string `json:"teamIds"`
+	StatusID    string   `json:"statusId,omitempty"`
+	LeadID      string   `json:"leadId,omitempty"`
+	MemberIDs   []string `json:"memberIds,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+	Color       string   `json:"color,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	TargetDate  string   `json:"targetDate,omitempty"`
+	Priority    *int     `json:"priority,omitempty"`
+}
+
+// ProjectMember is a member of a project. Role is "lead" for the project
+// lead and "member" otherwise.
+type ProjectMember struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Email       string `json:"email"`
+	Active      bool   `json:"active"`
+	Role        string `json:"role"`
+}
+
+// ProjectMembersResponse is the response for the project members query
+type ProjectMembersResponse struct {
+	Project struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"project"`
+	Members []ProjectMember `json:"members"`
+	Count   int             `json:"count"`
+}
+
+// GetProjectMembers fetches a project's lead and members. The lead is
+// listed first, also when Linear does not count them as a member. It
+// returns nil when the project does not exist.
+func (c *Client) GetProjectMembers(ctx context.Context, projectID string) (*ProjectMembersResponse, error) {
+	query := `query($id: String!) {
+		project(id: $id) {
+			id
+			name
+			lead {
+				id
+				name
+				displayName
+				email
+				active
+			}
+			members(first: 250) {
+				nodes {
+					id
+					name
+					displayName
+					email
+					active
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{
+		"id": projectID,
+	}
+
+	var result struct {
+		Project *struct {
+			ID      string         `json:"id"`
+			Name    string         `json:"name"`
+			Lead    *ProjectMember `json:"lead"`
+			Members struct {
+				Nodes []ProjectMember `json:"nodes"`
+			} `json:"members"`
+		} `json:"project"`
+	}
+
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, err
+	}
+	if result.Project == nil {
+		return nil, nil
+	}
+
+	resp := &ProjectMembersResponse{Members: []ProjectMember{}}
+	resp.Project.ID = result.Project.ID
+	resp.Project.Name = result.Project.Name
+
+	leadID := ""
+	if lead := result.Project.Lead; lead != nil {
+		leadID = lead.ID
+		lead.Role = "lead"
+		resp.Members = append(resp.Members, *lead)
+	}
+	for _, m := range result.Project.Members.Nodes {
+		if m.ID == leadID {
+			continue
+		}
+		m.Role = "member"
+		resp.Members = append(resp.Members, m)
+	}
+	resp.Count = len(resp.Members)
+
+	return resp, nil
+}
+
+// ProjectUpdateInput is the input for updating a project
+type ProjectUpdateInput struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Content     string `json:"content,omitempty"`
+	StatusID    string `json:"statusId,omitempty"`
+	LeadID      string `json:"leadId,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	Color       string `json:"color,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+	TargetDate  string `json:"targetDate,omitempty"`
+	Priority    *int   `json:"priority,omitempty"`
+}
+
+// isEmpty reports whether no fields are set on the update input
+func (i ProjectUpdateInput) isEmpty() bool {
+	return i == (ProjectUpdateInput{})
+}
+
+// GetProjects fetches projects. sortBy "created" or "updated" orders them
+// on the server.
+func (c *Client) GetProjects(ctx context.Context, teamID string, limit int, sortBy string, after string) (*ProjectsResponse, error) {
+	filterPart := ""
+	if teamID != "" {
+		filterPart = fmt.Sprintf(`, filter: { teams: { id: { eq: "%s" } } }`, teamID)
+	}
+
+	queryStr := fmt.Sprintf(`query {
+		projects(first: %d%s%s%s) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				id
+				name
+				slugId
+				state
+				progress
+				targetDate
+				url
+				createdAt
+				updatedAt
+				status {
+					id
+					name
+					type
+				}
+				lead {
+					id
+					displayName
+				}
+				teams {
+					nodes {
+						key
+					}
+				}
+			}
+		}
+	}`, limit, afterArg(after), orderByArg(sortBy), filterPart)
+
+	var result struct {
+		Projects struct {
+			PageInfo PageInfo `json:"pageInfo"`
+			Nodes    []struct {
+				ID         string  `json:"id"`
+				Name       string  `json:"name"`
+				SlugID     string  `json:"slugId"`
+				State      string  `json:"state"`
+				Progress   float64 `json:"progress"`
+				TargetDate string  `json:"targetDate"`
+				URL        string  `json:"url"`
+				CreatedAt  string  `json:"createdAt"`
+				UpdatedAt  string  `json:"updatedAt"`
+				Status     *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+					Type string `json:"type"`
+				} `json:"status"`
+				Lead *struct {
+					ID          string `json:"id"`
+					DisplayName string `json:"displayName"`
+				} `json:"lead"`
+				Teams struct {
+					Nodes []struct {
+						Key string `json:"key"`
+					} `json:"nodes"`
+				} `json:"teams"`
+			} `json:"nodes"`
+		} `json:"projects"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	projects := make([]ProjectListItem, len(result.Projects.Nodes))
+	for i, p := range result.Projects.Nodes {
+		teams := make([]struct {
+			Key string `json:"key"`
+		}, len(p.Teams.Nodes))
+		for j, t := range p.Teams.Nodes {
+			teams[j] = struct {
+				Key string `json:"key"`
+			}{Key: t.Key}
+		}
+		projects[i] = ProjectListItem{
+			ID:         p.ID,
+			Name:       p.Name,
+			SlugID:     p.SlugID,
+			State:      p.State,
+			Progress:   p.Progress,
+			TargetDate: p.TargetDate,
+			URL:        p.URL,
+			CreatedAt:  p.CreatedAt,
+			UpdatedAt:  p.UpdatedAt,
+			Status:     p.Status,
+			Lead:       p.Lead,
+			Teams:      teams,
+		}
+	}
+
+	return &ProjectsResponse{
+		Projects: projects,
+		Count:    len(projects),
+		PageInfo: &result.Projects.PageInfo,
+	}, nil
+}
+
+// SearchProjects searches for projects by term
+func (c *Client) SearchProjects(ctx context.Context, term string, limit int, includeArchived, includeComments bool) (*SearchProjectsResponse, error) {
+	queryStr := fmt.Sprintf(`query {
+		searchProjects(term: %q, first: %d, includeArchived: %t, includeComments: %t) {
+			nodes {
+				id
+				name
+				slugId
+				state
+				progress
+				targetDate
+				url
+				updatedAt
+				status {
+					id
+					name
+					type
+				}
+				lead {
+					id
+					displayName
+				}
+				teams {
+					nodes {
+						key
+					}
+				}
+			}
+			pageInfo {
+				hasNextPage
+			}
+			totalCount
+		}
+	}`, term, limit, includeArchived, includeComments)
+
+	var result struct {
+		SearchProjects struct {
+			Nodes []struct {
+				ID         string  `json:"id"`
+				Name       string  `json:"name"`
+				SlugID     string  `json:"slugId"`
+				State      string  `json:"state"`
+				Progress   float64 `json:"progress"`
+				TargetDate string  `json:"targetDate"`
+				URL        string  `json:"url"`
+				UpdatedAt  string  `json:"updatedAt"`
+				Status     *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+					Type string `json:"type"`
+				} `json:"status"`
+				Lead *struct {
+					ID          string `json:"id"`
+					DisplayName string `json:"displayName"`
+				} `json:"lead"`
+				Teams struct {
+					Nodes []struct {
+						Key string `json:"key"`
+					} `json:"nodes"`
+				} `json:"teams"`
+			} `json:"nodes"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+			TotalCount int `json:"totalCount"`
+		} `json:"searchProjects"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	projects := make([]ProjectListItem, len(result.SearchProjects.Nodes))
+	for i, p := range result.SearchProjects.Nodes {
+		teams := make([]struct {
+			Key string `json:"key"`
+		}, len(p.Teams.Nodes))
+		for j, t := range p.Teams.Nodes {
+			teams[j] = struct {
+				Key string `json:"key"`
+			}{Key: t.Key}
+		}
+		projects[i] = ProjectListItem{
+			ID:         p.ID,
+			Name:       p.Name,
+			SlugID:     p.SlugID,
+			State:      p.State,
+			Progress:   p.Progress,
+			TargetDate: p.TargetDate,
+			URL:        p.URL,
+			UpdatedAt:  p.UpdatedAt,
+			Status:     p.Status,
+			Lead:       p.Lead,
+			Teams:      teams,
+		}
+	}
+
+	return &SearchProjectsResponse{
+		Projects:   projects,
+		TotalCount: result.SearchProjects.TotalCount,
+		HasMore:    result.SearchProjects.PageInfo.HasNextPage,
+		Query:      term,
+	}, nil
+}
+
+// GetProject fetches a single project by ID
+func (c *Client) GetProject(ctx context.Context, projectID string) (*ProjectDetail, error) {
+	queryStr := fmt.Sprintf(`query {
+		project(id: %q) {
+			id
+			name
+			description
+			content
+			slugId
+			icon
+			color
+			state
+			progress
+			startDate
+			targetDate
+			url
+			createdAt
+			updatedAt
+			status {
+				id
+				name
+				type
+			}
+			lead {
+				id
+				name
+				displayName
+			}
+			teams {
+				nodes {
+					id
+					key
+					name
+				}
+			}
+		}
+	}`, projectID)
+
+	var result struct {
+		Project struct {
+			ID          string  `json:"id"`
+			Name        string  `json:"name"`
+			Description string  `json:"description"`
+			Content     string  `json:"content"`
+			SlugID      string  `json:"slugId"`
+			Icon        string  `json:"icon"`
+			Color       string  `json:"color"`
+			State       string  `json:"state"`
+			Progress    float64 `json:"progress"`
+			StartDate   string  `json:"startDate"`
+			TargetDate  string  `json:"targetDate"`
+			URL         string  `json:"url"`
+			CreatedAt   string  `json:"createdAt"`
+			UpdatedAt   string  `json:"updatedAt"`
+			Status      *struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+				Type string `json:"type"`
+			} `json:"status"`
+			Lead *struct {
+				ID          string `json:"id"`
+				Name        string `json:"name"`
+				DisplayName string `json:"displayName"`
+			} `json:"lead"`
+			Teams struct {
+				Nodes []struct {
+					ID   string `json:"id"`
+					Key  string `json:"key"`
+					Name string `json:"name"`
+				} `json:"nodes"`
+			} `json:"teams"`
+		} `json:"project"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	project := &ProjectDetail{
+		ID:          result.Project.ID,
+		Name:        result.Project.Name,
+		Description: result.Project.Description,
+		Content:     result.Project.Content,
+		SlugID:      result.Project.SlugID,
+		Icon:        result.Project.Icon,
+		Color:       result.Project.Color,
+		State:       result.Project.State,
+		Progress:    result.Project.Progress,
+		StartDate:   result.Project.StartDate,
+		TargetDate:  result.Project.TargetDate,
+		URL:         result.Project.URL,
+		CreatedAt:   result.Project.CreatedAt,
+		UpdatedAt:   result.Project.UpdatedAt,
+		Status:      result.Project.Status,
+		Lead:        result.Project.Lead,
+	}
+
+	teams := make([]struct {
+		ID   string `json:"id"`
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	}, len(result.Project.Teams.Nodes))
+	for i, t := range result.Project.Teams.Nodes {
+		teams[i] = struct {
+			ID   string `json:"id"`
+			Key  string `json:"key"`
+			Name string `json:"name"`
+		}{ID: t.ID, Key: t.Key, Name: t.Name}
+	}
+	project.Teams = teams
+
+	return project, nil
+}
+
+// CreateProject creates a new project
+func (c *Client) CreateProject(ctx context.Context, input ProjectCreateInput) (*ProjectDetail, error) {
+	mutation := `mutation($input: ProjectCreateInput!) {
+		projectCreate(input: $input) {
+			success
+			project {
+				id
+				name
+				slugId
+				url
+				state
+				status {
+					id
+					name
+					type
+				}
+				lead {
+					id
+					name
+					displayName
+				}
+				teams {
+					nodes {
+						id
+						key
+						name
+					}
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{"input": input}
+
+	var result struct {
+		ProjectCreate struct {
+			Success bool `json:"success"`
+			Project struct {
+				ID     string `json:"id"`
+				Name   string `json:"name"`
+				SlugID string `json:"slugId"`
+				URL    string `json:"url"`
+				State  string `json:"state"`
+				Status *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+					Type string `json:"type"`
+				} `json:"status"`
+				Lead *struct {
+					ID          string `json:"id"`
+					Name        string `json:"name"`
+					DisplayName string `json:"displayName"`
+				} `json:"lead"`
+				Teams struct {
+					Nodes []struct {
+						ID   string `json:"id"`
+						Key  string `json:"key"`
+						Name string `json:"name"`
+					} `json:"nodes"`
+				} `json:"teams"`
+			} `json:"project"`
+		} `json:"projectCreate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.ProjectCreate.Success {
+		return nil, fmt.Errorf("failed to create project")
+	}
+
+	project := &ProjectDetail{
+		ID:     result.ProjectCreate.Project.ID,
+		Name:   result.ProjectCreate.Project.Name,
+		SlugID: result.ProjectCreate.Project.SlugID,
+		URL:    result.ProjectCreate.Project.URL,
+		State:  result.ProjectCreate.Project.State,
+		Status: result.ProjectCreate.Project.Status,
+		Lead:   result.ProjectCreate.Project.Lead,
+	}
+
+	teams := make([]struct {
+		ID   string `json:"id"`
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	}, len(result.ProjectCreate.Project.Teams.Nodes))
+	for i, t := range result.ProjectCreate.Project.Teams.Nodes {
+		teams[i] = struct {
+			ID   string `json:"id"`
+			Key  string `json:"key"`
+			Name string `json:"name"`
+		}{ID: t.ID, Key: t.Key, Name: t.Name}
+	}
+	project.Teams = teams
+
+	return project, nil
+}
+
+// UpdateProject updates an existing project
+func (c *Client) UpdateProject(ctx context.Context, projectID string, input ProjectUpdateInput) (*ProjectDetail, error) {
+	if input.isEmpty() {
+		return nil, fmt.Errorf("at least one field must be provided to update")
+	}
+
+	mutation := `mutation($id: String!, $input: ProjectUpdateInput!) {
+		projectUpdate(id: $id, input: $input) {
+			success
+			project {
+				id
+				name
+				slugId
+				url
+				state
+			}
+		}
+	}`
+	variables := map[string]interface{}{"id": projectID, "input": input}
+
+	var result struct {
+		ProjectUpdate struct {
+			Success bool `json:"success"`
+			Project struct {
+				ID     string `json:"id"`
+				Name   string `json:"name"`
+				SlugID string `json:"slugId"`
+				URL    string `json:"url"`
+				State  string `json:"state"`
+			} `json:"project"`
+		} `json:"projectUpdate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.ProjectUpdate.Success {
+		return nil, fmt.Errorf("failed to update project")
+	}
+
+	return &ProjectDetail{
+		ID:     result.ProjectUpdate.Project.ID,
+		Name:   result.ProjectUpdate.Project.Name,
+		SlugID: result.ProjectUpdate.Project.SlugID,
+		URL:    result.ProjectUpdate.Project.URL,
+		State:  result.ProjectUpdate.Project.State,
+	}, nil
+}
+
+// DeleteProject archives a project
+func (c *Client) DeleteProject(ctx context.Context, projectID string) error {
+	mutation := `mutation($id: String!) {
+		projectArchive(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": projectID}
+
+	var result struct {
+		ProjectArchive struct {
+			Success bool `json:"success"`
+		} `json:"projectArchive"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.ProjectArchive.Success {
+		return fmt.Errorf("failed to archive project")
+	}
+
+	return nil
+}
+
+// RestoreProject unarchives a project
+func (c *Client) RestoreProject(ctx context.Context, projectID string) error {
+	mutation := `mutation($id: String!) {
+		projectUnarchive(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": projectID}
+
+	var result struct {
+		ProjectUnarchive struct {
+			Success bool `json:"success"`
+		} `json:"projectUnarchive"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.ProjectUnarchive.Success {
+		return fmt.Errorf("failed to restore project")
+	}
+
+	return nil
+}
+
+// Milestone represents a project milestone
+type Milestone struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	TargetDate  string  `json:"targetDate,omitempty"`
+	SortOrder   float64 `json:"sortOrder"`
+}
+
+// ProjectMilestoneCreateInput is the input for creating a milestone
+type ProjectMilestoneCreateInput struct {
+	ProjectID   string `json:"projectId"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	TargetDate  string `json:"targetDate,omitempty"`
+	// SortOrder places the milestone; Linear appends it when unset
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+}
+
+// ProjectMilestoneUpdateInput is the input for updating a milestone.
+// Nil fields are left unchanged.
+type ProjectMilestoneUpdateInput struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	TargetDate  *string  `json:"targetDate,omitempty"`
+	SortOrder   *float64 `json:"sortOrder,omitempty"`
+}
+
+// isEmpty reports whether no fields are set on the update input
+func (i ProjectMilestoneUpdateInput) isEmpty() bool {
+	return i.Name == nil && i.Description == nil && i.TargetDate == nil && i.SortOrder == nil
+}
+
+// MilestonesResponse is the response for listing milestones
+type MilestonesResponse struct {
+	Milestones []Milestone `json:"milestones"`
+	Count      int         `json:"count"`
+}
+
+// GetProjectMilestones fetches milestones for a project
+func (c *Client) GetProjectMilestones(ctx context.Context, projectID string) (*MilestonesResponse, error) {
+	queryStr := fmt.Sprintf(`query {
+		project(id: %q) {
+			projectMilestones {
+				nodes {
+					id
+					name
+					description
+					targetDate
+					sortOrder
+				}
+			}
+		}
+	}`, projectID)
+
+	var result struct {
+		Project struct {
+			ProjectMilestones struct {
+				Nodes []struct {
+					ID          string  `json:"id"`
+					Name        string  `json:"name"`
+					Description string  `json:"description"`
+					TargetDate  string  `json:"targetDate"`
+					SortOrder   float64 `json:"sortOrder"`
+				} `json:"nodes"`
+			} `json:"projectMilestones"`
+		} `json:"project"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	milestones := make([]Milestone, len(result.Project.ProjectMilestones.Nodes))
+	for i, m := range result.Project.ProjectMilestones.Nodes {
+		milestones[i] = Milestone{
+			ID:          m.ID,
+			Name:        m.Name,
+			Description: m.Description,
+			TargetDate:  m.TargetDate,
+			SortOrder:   m.SortOrder,
+		}
+	}
+
+	return &MilestonesResponse{
+		Milestones: milestones,
+		Count:      len(milestones),
+	}, nil
+}
+
+// CreateProjectMilestone creates a new milestone for a project
+func (c *Client) CreateProjectMilestone(ctx context.Context, input ProjectMilestoneCreateInput) (*Milestone, error) {
+	mutation := `mutation($input: ProjectMilestoneCreateInput!) {
+		projectMilestoneCreate(input: $input) {
+			success
+			projectMilestone {
+				id
+				name
+				description
+				targetDate
+				sortOrder
+			}
+		}
+	}`
+	variables := map[string]interface{}{"input": input}
+
+	var result struct {
+		ProjectMilestoneCreate struct {
+			Success          bool `json:"success"`
+			ProjectMilestone struct {
+				ID          string  `json:"id"`
+				Name        string  `json:"name"`
+				Description string  `json:"description"`
+				TargetDate  string  `json:"targetDate"`
+				SortOrder   float64 `json:"sortOrder"`
+			} `json:"projectMilestone"`
+		} `json:"projectMilestoneCreate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.ProjectMilestoneCreate.Success {
+		return nil, fmt.Errorf("failed to create milestone")
+	}
+
+	return &Milestone{
+		ID:          result.ProjectMilestoneCreate.ProjectMilestone.ID,
+		Name:        result.ProjectMilestoneCreate.ProjectMilestone.Name,
+		Description: result.ProjectMilestoneCreate.ProjectMilestone.Description,
+		TargetDate:  result.ProjectMilestoneCreate.ProjectMilestone.TargetDate,
+		SortOrder:   result.ProjectMilestoneCreate.ProjectMilestone.SortOrder,
+	}, nil
+}
+
+// UpdateProjectMilestone updates a milestone
+func (c *Client) UpdateProjectMilestone(ctx context.Context, milestoneID string, input ProjectMilestoneUpdateInput) (*Milestone, error) {
+	if input.isEmpty() {
+		return nil, fmt.Errorf("no fields to update")
+	}
+
+	mutation := `mutation($id: String!, $input: ProjectMilestoneUpdateInput!) {
+		projectMilestoneUpdate(id: $id, input: $input) {
+			success
+			projectMilestone {
+				id
+				name
+				description
+				targetDate
+				sortOrder
+			}
+		}
+	}`
+	variables := map[string]interface{}{"id": milestoneID, "input": input}
+
+	var result struct {
+		ProjectMilestoneUpdate struct {
+			Success          bool `json:"success"`
+			ProjectMilestone struct {
+				ID          string  `json:"id"`
+				Name        string  `json:"name"`
+				Description string  `json:"description"`
+				TargetDate  string  `json:"targetDate"`
+				SortOrder   float64 `json:"sortOrder"`
+			} `json:"projectMilestone"`
+		} `json:"projectMilestoneUpdate"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
+	}
+
+	if !result.ProjectMilestoneUpdate.Success {
+		return nil, fmt.Errorf("failed to update milestone")
+	}
+
+	return &Milestone{
+		ID:          result.ProjectMilestoneUpdate.ProjectMilestone.ID,
+		Name:        result.ProjectMilestoneUpdate.ProjectMilestone.Name,
+		Description: result.ProjectMilestoneUpdate.ProjectMilestone.Description,
+		TargetDate:  result.ProjectMilestoneUpdate.ProjectMilestone.TargetDate,
+		SortOrder:   result.ProjectMilestoneUpdate.ProjectMilestone.SortOrder,
+	}, nil
+}
+
+// DeleteProjectMilestone deletes a milestone
+func (c *Client) DeleteProjectMilestone(ctx context.Context, milestoneID string) error {
+	mutation := `mutation($id: String!) {
+		projectMilestoneDelete(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": milestoneID}
+
+	var result struct {
+		ProjectMilestoneDelete struct {
+			Success bool `json:"success"`
+		} `json:"projectMilestoneDelete"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.ProjectMilestoneDelete.Success {
+		return fmt.Errorf("failed to delete milestone")
+	}
+
+	return nil
+}
+
+// ProjectUpdate represents a project status update
+type ProjectUpdate struct {
+	ID        string `json:"id"`
+	Body      string `json:"body"`
+	Health    string `json:"health,omitempty"`
+	CreatedAt string `json:"createdAt"`
+	User      *struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+	} `json:"user,omitempty"`
+}
+
+// ProjectUpdateCreateInput is the input for posting a project status update
+type ProjectUpdateCreateInput struct {
+	ProjectID string `json:"projectId"`
+	Body      string `json:"body"`
+	Health    string `json:"health,omitempty"`
+}
+
+// ProjectUpdatesResponse is the response for listing project updates
+type ProjectUpdatesResponse struct {
+	Updates []ProjectUpdate `json:"updates"`
+	Count   int             `json:"count"`
+}
+
+// GetProjectUpdates fetches status updates for a project
+func (c *Client) GetProjectUpdates(ctx context.Context, projectID string, limit int) (*ProjectUpdatesResponse, error) {
+	queryStr := fmt.Sprintf(`query {
+		project(id: %q) {
+			projectUpdates(first: %d) {
+				nodes {
+					id
+					body
+					health
+					createdAt
+					user {
+						id
+						displayName
+					}
+				}
+			}
+		}
+	}`, projectID, limit)
+
+	var result struct {
+		Project struct {
+			ProjectUpdates struct {
+				Nodes []struct {
+					ID        string `json:"id"`
+					Body      string `json:"body"`
+					Health    string `json:"health"`
+					CreatedAt string `json:"createdAt"`
+					User      *struct {
+						ID          string `json:"id"`
+						DisplayName string `json:"displayName"`
+					} `json:"user"`
+				} `json:"nodes"`
+			} `json:"projectUpdates"`
+		} `json:"project"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	updates := make([]ProjectUpdate, len(result.Project.ProjectUpdates.Nodes))
+	for i, u := range result.Project.ProjectUpdates.Nodes {
+		updates[i] = ProjectUpdate{
+			ID:        u.ID,
+			Body:      u.Body,
+			Health:    u.Health,
+			CreatedAt: u.CreatedAt,
+		}
+		if u.User != nil {
+			updates[i].User = &struct {
+				ID          string `json:"id"`
+				DisplayName string `json:"displayName"`
+			}{
+				ID:          u.User.ID,
+				DisplayName: u.User.DisplayName,
+			}
+		}
+	}
+
+	return &ProjectUpdatesResponse{
+		Updates: updates,
+		Count:   len(updates),
+	}, nil
+}
+
+// CreateProjectUpdate creates a new status update for a project
+func (c *Client) CreateProjectUpdate(ctx context.Context, projectID, body string, health *string) (*ProjectUpdate, error) {
+	input := ProjectUpdateCreateInput{ProjectID: projectID, Body: body}
+	if health != nil {
+		input.Health = *health
+	}
+
+	mutation := `mutation($input: ProjectUpdateCreateInput!) {
+		projectUpdateCreate(input: $input) {
+			success
+			projectUpdate {
 				id
-				name
-				slugId
-				state
-				progress
-				targetDate
-				url
-				updatedAt
-				status {
-					id
-					name
-					type
-				}
-				lead {
+				body
+				health
+				createdAt
+				user {
 					id
 					displayName
 				}
-				teams {
-					nodes {
-						key
-					}
-				}
 			}
-			pageInfo {
-				hasNextPage
-			}
-			totalCount
 		}
-	}`, term, limit, includeArchived, includeComments)
+	}`
+	variables := map[string]interface{}{"input": input}
 
 	var result struct {
-		SearchProjects struct {
-			Nodes []struct {
-				ID         string  `json:"id"`
-				Name       string  `json:"name"`
-				SlugID     string  `json:"slugId"`
-				State      string  `json:"state"`
-				Progress   float64 `json:"progress"`
-				TargetDate string  `json:"targetDate"`
-				URL        string  `json:"url"`
-				UpdatedAt  string  `json:"updatedAt"`
-				Status     *struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-					Type string `json:"type"`
-				} `json:"status"`
-				Lead *struct {
+		ProjectUpdateCreate struct {
+			Success       bool `json:"success"`
+			ProjectUpdate struct {
+				ID        string `json:"id"`
+				Body      string `json:"body"`
+				Health    string `json:"health"`
+				CreatedAt string `json:"createdAt"`
+				User      *struct {
 					ID          string `json:"id"`
 					DisplayName string `json:"displayName"`
-				} `json:"lead"`
-				Teams struct {
-					Nodes []struct {
-						Key string `json:"key"`
-					} `json:"nodes"`
-				} `json:"teams"`
-			} `json:"nodes"`
-			PageInfo struct {
-				HasNextPage bool `json:"hasNextPage"`
-			} `json:"pageInfo"`
-			TotalCount int `json:"totalCount"`
-		} `json:"searchProjects"`
+				} `json:"user"`
+			} `json:"projectUpdate"`
+		} `json:"projectUpdateCreate"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return nil, err
 	}
 
-	projects := make([]ProjectListItem, len(result.SearchProjects.Nodes))
-	for i, p := range result.SearchProjects.Nodes {
-		teams := make([]struct {
-			Key string `json:"key"`
-		}, len(p.Teams.Nodes))
-		for j, t := range p.Teams.Nodes {
-			teams[j] = struct {
-				Key string `json:"key"`
-			}{Key: t.Key}
-		}
-		projects[i] = ProjectListItem{
-			ID:         p.ID,
-			Name:       p.Name,
-			SlugID:     p.SlugID,
-			State:      p.State,
-			Progress:   p.Progress,
-			TargetDate: p.TargetDate,
-			URL:        p.URL,
-			UpdatedAt:  p.UpdatedAt,
-			Status:     p.Status,
-			Lead:       p.Lead,
-			Teams:      teams,
+	if !result.ProjectUpdateCreate.Success {
+		return nil, fmt.Errorf("failed to create project update")
+	}
+
+	update := &ProjectUpdate{
+		ID:        result.ProjectUpdateCreate.ProjectUpdate.ID,
+		Body:      result.ProjectUpdateCreate.ProjectUpdate.Body,
+		Health:    result.ProjectUpdateCreate.ProjectUpdate.Health,
+		CreatedAt: result.ProjectUpdateCreate.ProjectUpdate.CreatedAt,
+	}
+
+	if result.ProjectUpdateCreate.ProjectUpdate.User != nil {
+		update.User = &struct {
+			ID          string `json:"id"`
+			DisplayName string `json:"displayName"`
+		}{
+			ID:          result.ProjectUpdateCreate.ProjectUpdate.User.ID,
+			DisplayName: result.ProjectUpdateCreate.ProjectUpdate.User.DisplayName,
 		}
 	}
 
-	return &SearchProjectsResponse{
-		Projects:   projects,
-		TotalCount: result.SearchProjects.TotalCount,
-		HasMore:    result.SearchProjects.PageInfo.HasNextPage,
-		Query:      term,
-	}, nil
+	return update, nil
 }
 
-// GetProject fetches a single project by ID
-func (c *Client) GetProject(ctx context.Context, projectID string) (*ProjectDetail, error) {
-	queryStr := fmt.Sprintf(`query {
-		project(id: %q) {
+// ProjectUpdateDetail is a project status update with its comments and
+// reactions
+type ProjectUpdateDetail struct {
+	ProjectUpdate
+	URL     string `json:"url"`
+	Project *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"project,omitempty"`
+	Comments  []Comment  `json:"comments"`
+	Reactions []Reaction `json:"reactions"`
+}
+
+// GetProjectUpdate fetches a project status update with its comments and
+// reactions
+func (c *Client) GetProjectUpdate(ctx context.Context, updateID string) (*ProjectUpdateDetail, error) {
+	queryStr := `query($id: String!) {
+		projectUpdate(id: $id) {
 			id
-			name
-			description
-			content
-			slugId
-			icon
-			color
-			state
-			progress
-			startDate
-			targetDate
-			url
+			body
+			health
 			createdAt
-			updatedAt
-			status {
+			url
+			user {
 				id
-				name
-				type
+				displayName
 			}
-			lead {
+			project {
 				id
 				name
-				displayName
 			}
-			teams {
-				nodes {
-					id
-					key
-					name
+			reactions {` + reactionFields + `
+			}
+			comments(first: 250) {
+				nodes {` + commentFields + `
 				}
 			}
 		}
-	}`, projectID)
+	}`
+	variables := map[string]interface{}{"id": updateID}
 
 	var result struct {
-		Project struct {
-			ID          string  `json:"id"`
-			Name        string  `json:"name"`
-			Description string  `json:"description"`
-			Content     string  `json:"content"`
-			SlugID      string  `json:"slugId"`
-			Icon        string  `json:"icon"`
-			Color       string  `json:"color"`
-			State       string  `json:"state"`
-			Progress    float64 `json:"progress"`
-			StartDate   string  `json:"startDate"`
-			TargetDate  string  `json:"targetDate"`
-			URL         string  `json:"url"`
-			CreatedAt   string  `json:"createdAt"`
-			UpdatedAt   string  `json:"updatedAt"`
-			Status      *struct {
-				ID   string `json:"id"`
-				Name string `json:"name"`
-				Type string `json:"type"`
-			} `json:"status"`
-			Lead *struct {
-				ID          string `json:"id"`
-				Name        string `json:"name"`
-				DisplayName string `json:"displayName"`
-			} `json:"lead"`
-			Teams struct {
-				Nodes []struct {
-					ID   string `json:"id"`
-					Key  string `json:"key"`
-					Name string `json:"name"`
-				} `json:"nodes"`
-			} `json:"teams"`
-		} `json:"project"`
+		ProjectUpdate *struct {
+			ProjectUpdateDetail
+			Comments struct {
+				Nodes []Comment `json:"nodes"`
+			} `json:"comments"`
+		} `json:"projectUpdate"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
 		return nil, err
 	}
-
-	project := &ProjectDetail{
-		ID:          result.Project.ID,
-		Name:        result.Project.Name,
-		Description: result.Project.Description,
-		Content:     result.Project.Content,
-		SlugID:      result.Project.SlugID,
-		Icon:        result.Project.Icon,
-		Color:       result.Project.Color,
-		State:       result.Project.State,
-		Progress:    result.Project.Progress,
-		StartDate:   result.Project.StartDate,
-		TargetDate:  result.Project.TargetDate,
-		URL:         result.Project.URL,
-		CreatedAt:   result.Project.CreatedAt,
-		UpdatedAt:   result.Project.UpdatedAt,
-		Status:      result.Project.Status,
-		Lead:        result.Project.Lead,
-	}
-
-	teams := make([]struct {
-		ID   string `json:"id"`
-		Key  string `json:"key"`
-		Name string `json:"name"`
-	}, len(result.Project.Teams.Nodes))
-	for i, t := range result.Project.Teams.Nodes {
-		teams[i] = struct {
-			ID   string `json:"id"`
-			Key  string `json:"key"`
-			Name string `json:"name"`
-		}{ID: t.ID, Key: t.Key, Name: t.Name}
+	if result.ProjectUpdate == nil {
+		return nil, &Error{Kind: ErrNotFound, Message: fmt.Sprintf("Project update '%s' not found", updateID)}
 	}
-	project.Teams = teams
 
-	return project, nil
+	update := result.ProjectUpdate.ProjectUpdateDetail
+	update.Comments = result.ProjectUpdate.Comments.Nodes
+	// Oldest first, so threads read top to bottom
+	sort.SliceStable(update.Comments, func(i, j int) bool {
+		return update.Comments[i].CreatedAt < update.Comments[j].CreatedAt
+	})
+	return &update, nil
 }
 
-// CreateProject creates a new project
-func (c *Client) CreateProject(ctx context.Context, input ProjectCreateInput) (*ProjectDetail, error) {
-	// Build input parts
-	inputParts := []string{
-		fmt.Sprintf(`name: %q`, input.Name),
-	}
-
-	if len(input.TeamIDs) > 0 {
-		teamIDs := ""
-		for i, id := range input.TeamIDs {
-			if i > 0 {
-				teamIDs += ", "
-			}
-			teamIDs += fmt.Sprintf(`%q`, id)
-		}
-		inputParts = append(inputParts, fmt.Sprintf(`teamIds: [%s]`, teamIDs))
-	}
-
-	if input.Description != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`description: %q`, input.Description))
-	}
-	if input.Content != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`content: %q`, input.Content))
+// UpdateProjectUpdate edits a project status update's body or health.
+// Nil fields are left unchanged.
+func (c *Client) UpdateProjectUpdate(ctx context.Context, updateID string, body, health *string) (*ProjectUpdate, error) {
+	input := map[string]interface{}{}
+	if body != nil {
+		input["body"] = *body
 	}
-	if input.StatusID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`statusId: %q`, input.StatusID))
-	}
-	if input.LeadID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`leadId: %q`, input.LeadID))
-	}
-	if input.Icon != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`icon: %q`, input.Icon))
-	}
-	if input.Color != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`color: %q`, input.Color))
-	}
-	if input.StartDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`startDate: %q`, input.StartDate))
-	}
-	if input.TargetDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`targetDate: %q`, input.TargetDate))
-	}
-	if input.Priority != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`priority: %d`, *input.Priority))
+	if health != nil {
+		input["health"] = *health
 	}
-
-	inputStr := ""
-	for i, part := range inputParts {
-		if i > 0 {
-			inputStr += ", "
-		}
-		inputStr += part
+	if len(input) == 0 {
+		return nil, fmt.Errorf("no fields to update")
 	}
-
-	mutationStr := fmt.Sprintf(`mutation {
-		projectCreate(input: { %s }) {
-			success
-			project {
-				id
-				name
-				slugId
-				url
-				state
-				status {
-					id
-					name
-					type
-				}
-				lead {
+
+	mutation := `mutation($id: String!, $input: ProjectUpdateUpdateInput!) {
+		projectUpdateUpdate(id: $id, input: $input) {
+			success
+			projectUpdate {
+				id
+				body
+				health
+				createdAt
+				user {
 					id
-					name
 					displayName
 				}
-				teams {
-					nodes {
-						id
-						key
-						name
-					}
-				}
 			}
 		}
-	}`, inputStr)
+	}`
+	variables := map[string]interface{}{"id": updateID, "input": input}
 
 	var result struct {
-		ProjectCreate struct {
-			Success bool `json:"success"`
-			Project struct {
-				ID     string `json:"id"`
-				Name   string `json:"name"`
-				SlugID string `json:"slugId"`
-				URL    string `json:"url"`
-				State  string `json:"state"`
-				Status *struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-					Type string `json:"type"`
-				} `json:"status"`
-				Lead *struct {
-					ID          string `json:"id"`
-					Name        string `json:"name"`
-					DisplayName string `json:"displayName"`
-				} `json:"lead"`
-				Teams struct {
-					Nodes []struct {
-						ID   string `json:"id"`
-						Key  string `json:"key"`
-						Name string `json:"name"`
-					} `json:"nodes"`
-				} `json:"teams"`
-			} `json:"project"`
-		} `json:"projectCreate"`
+		ProjectUpdateUpdate struct {
+			Success       bool          `json:"success"`
+			ProjectUpdate ProjectUpdate `json:"projectUpdate"`
+		} `json:"projectUpdateUpdate"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return nil, err
 	}
 
-	if !result.ProjectCreate.Success {
-		return nil, fmt.Errorf("failed to create project")
+	if !result.ProjectUpdateUpdate.Success {
+		return nil, fmt.Errorf("failed to update project update")
 	}
 
-	project := &ProjectDetail{
-		ID:     result.ProjectCreate.Project.ID,
-		Name:   result.ProjectCreate.Project.Name,
-		SlugID: result.ProjectCreate.Project.SlugID,
-		URL:    result.ProjectCreate.Project.URL,
-		State:  result.ProjectCreate.Project.State,
-		Status: result.ProjectCreate.Project.Status,
-		Lead:   result.ProjectCreate.Project.Lead,
+	return &result.ProjectUpdateUpdate.ProjectUpdate, nil
+}
+
+// ArchiveProjectUpdate archives (deletes) a project status update
+func (c *Client) ArchiveProjectUpdate(ctx context.Context, updateID string) error {
+	mutation := `mutation($id: String!) {
+		projectUpdateArchive(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": updateID}
+
+	var result struct {
+		ProjectUpdateArchive struct {
+			Success bool `json:"success"`
+		} `json:"projectUpdateArchive"`
 	}
 
-	teams := make([]struct {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.ProjectUpdateArchive.Success {
+		return fmt.Errorf("failed to delete project update")
+	}
+
+	return nil
+}
+
+// DocumentListItem represents a document in a list
+type DocumentListItem struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	SlugID    string `json:"slugId"`
+	Icon      string `json:"icon,omitempty"`
+	URL       string `json:"url"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt"`
+	Creator   *struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+	} `json:"creator,omitempty"`
+	Project *struct {
 		ID   string `json:"id"`
-		Key  string `json:"key"`
 		Name string `json:"name"`
-	}, len(result.ProjectCreate.Project.Teams.Nodes))
-	for i, t := range result.ProjectCreate.Project.Teams.Nodes {
-		teams[i] = struct {
-			ID   string `json:"id"`
-			Key  string `json:"key"`
-			Name string `json:"name"`
-		}{ID: t.ID, Key: t.Key, Name: t.Name}
-	}
-	project.Teams = teams
+	} `json:"project,omitempty"`
+}
 
-	return project, nil
+// DocumentsResponse is the response for listing documents
+type DocumentsResponse struct {
+	Documents []DocumentListItem `json:"documents"`
+	Count     int                `json:"count"`
+	PageInfo  *PageInfo          `json:"pageInfo,omitempty"`
 }
 
-// UpdateProject updates an existing project
-func (c *Client) UpdateProject(ctx context.Context, projectID string, input ProjectUpdateInput) (*ProjectDetail, error) {
-	inputParts := []string{}
+// DocumentSearchResponse is the response for searching documents
+type DocumentSearchResponse struct {
+	Documents  []DocumentListItem `json:"documents"`
+	Count      int                `json:"count"`
+	Query      string             `json:"query"`
+	TotalCount int                `json:"totalCount"`
+}
 
-	if input.Name != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`name: %q`, input.Name))
-	}
-	if input.Description != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`description: %q`, input.Description))
-	}
-	if input.Content != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`content: %q`, input.Content))
-	}
-	if input.StatusID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`statusId: %q`, input.StatusID))
-	}
-	if input.LeadID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`leadId: %q`, input.LeadID))
-	}
-	if input.Icon != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`icon: %q`, input.Icon))
-	}
-	if input.Color != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`color: %q`, input.Color))
-	}
-	if input.StartDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`startDate: %q`, input.StartDate))
-	}
-	if input.TargetDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`targetDate: %q`, input.TargetDate))
-	}
-	if input.Priority != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`priority: %d`, *input.Priority))
-	}
+// DocumentCreateInput is the input for creating a document
+type DocumentCreateInput struct {
+	Title        string `json:"title"`
+	Content      string `json:"content,omitempty"`
+	ProjectID    string `json:"projectId,omitempty"`
+	TeamID       string `json:"teamId,omitempty"`
+	IssueID      string `json:"issueId,omitempty"`
+	InitiativeID string `json:"initiativeId,omitempty"`
+	Icon         string `json:"icon,omitempty"`
+	Color        string `json:"color,omitempty"`
+}
 
-	if len(inputParts) == 0 {
-		return nil, fmt.Errorf("at least one field must be provided to update")
-	}
+// DocumentUpdateInput is the input for updating a document
+type DocumentUpdateInput struct {
+	Title     string `json:"title,omitempty"`
+	Content   string `json:"content,omitempty"`
+	ProjectID string `json:"projectId,omitempty"`
+	Icon      string `json:"icon,omitempty"`
+	Color     string `json:"color,omitempty"`
+}
 
-	inputStr := ""
-	for i, part := range inputParts {
-		if i > 0 {
-			inputStr += ", "
-		}
-		inputStr += part
+// GetDocuments fetches documents. sortBy "created" or "updated" orders them
+// on the server.
+func (c *Client) GetDocuments(ctx context.Context, projectID string, limit int, sortBy string, after string) (*DocumentsResponse, error) {
+	filterPart := ""
+	if projectID != "" {
+		filterPart = fmt.Sprintf(`, filter: { project: { id: { eq: "%s" } } }`, projectID)
 	}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		projectUpdate(id: %q, input: { %s }) {
-			success
-			project {
+	queryStr := fmt.Sprintf(`query {
+		documents(first: %d%s%s%s) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
 				id
-				name
+				title
 				slugId
+				icon
 				url
-				state
+				createdAt
+				updatedAt
+				creator {
+					id
+					displayName
+				}
+				project {
+					id
+					name
+				}
 			}
 		}
-	}`, projectID, inputStr)
+	}`, limit, afterArg(after), orderByArg(sortBy), filterPart)
 
 	var result struct {
-		ProjectUpdate struct {
-			Success bool `json:"success"`
-			Project struct {
-				ID     string `json:"id"`
-				Name   string `json:"name"`
-				SlugID string `json:"slugId"`
-				URL    string `json:"url"`
-				State  string `json:"state"`
-			} `json:"project"`
-		} `json:"projectUpdate"`
+		Documents struct {
+			PageInfo PageInfo `json:"pageInfo"`
+			Nodes    []struct {
+				ID        string `json:"id"`
+				Title     string `json:"title"`
+				SlugID    string `json:"slugId"`
+				Icon      string `json:"icon"`
+				URL       string `json:"url"`
+				CreatedAt string `json:"createdAt"`
+				UpdatedAt string `json:"updatedAt"`
+				Creator   *struct {
+					ID          string `json:"id"`
+					DisplayName string `json:"displayName"`
+				} `json:"creator"`
+				Project *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"project"`
+			} `json:"nodes"`
+		} `json:"documents"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
 		return nil, err
 	}
 
-	if !result.ProjectUpdate.Success {
-		return nil, fmt.Errorf("failed to update project")
+	documents := make([]DocumentListItem, len(result.Documents.Nodes))
+	for i, d := range result.Documents.Nodes {
+		documents[i] = DocumentListItem{
+			ID:        d.ID,
+			Title:     d.Title,
+			SlugID:    d.SlugID,
+			Icon:      d.Icon,
+			URL:       d.URL,
+			CreatedAt: d.CreatedAt,
+			UpdatedAt: d.UpdatedAt,
+			Creator:   d.Creator,
+			Project:   d.Project,
+		}
 	}
 
-	return &ProjectDetail{
-		ID:     result.ProjectUpdate.Project.ID,
-		Name:   result.ProjectUpdate.Project.Name,
-		SlugID: result.ProjectUpdate.Project.SlugID,
-		URL:    result.ProjectUpdate.Project.URL,
-		State:  result.ProjectUpdate.Project.State,
+	return &DocumentsResponse{
+		Documents: documents,
+		Count:     len(documents),
+		PageInfo:  &result.Documents.PageInfo,
 	}, nil
 }
 
-// DeleteProject archives a project
-func (c *Client) DeleteProject(ctx context.Context, projectID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		projectArchive(id: %q) {
-			success
+// GetDocument fetches a single document by ID
+func (c *Client) GetDocument(ctx context.Context, documentID string) (*Document, error) {
+	queryStr := fmt.Sprintf(`query {
+		document(id: %q) {
+			id
+			title
+			content
+			icon
+			color
+			slugId
+			url
+			createdAt
+			updatedAt
+			creator {
+				id
+				displayName
+			}
+			project {
+				id
+				name
+			}
 		}
-	}`, projectID)
+	}`, documentID)
 
 	var result struct {
-		ProjectArchive struct {
-			Success bool `json:"success"`
-		} `json:"projectArchive"`
+		Document struct {
+			ID        string `json:"id"`
+			Title     string `json:"title"`
+			Content   string `json:"content"`
+			Icon      string `json:"icon"`
+			Color     string `json:"color"`
+			SlugID    string `json:"slugId"`
+			URL       string `json:"url"`
+			CreatedAt string `json:"createdAt"`
+			UpdatedAt string `json:"updatedAt"`
+			Creator   *struct {
+				ID          string `json:"id"`
+				DisplayName string `json:"displayName"`
+			} `json:"creator"`
+			Project *struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"project"`
+		} `json:"document"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
 	}
 
-	if !result.ProjectArchive.Success {
-		return fmt.Errorf("failed to archive project")
+	if result.Document.ID == "" {
+		return nil, nil
 	}
 
-	return nil
+	return &Document{
+		ID:        result.Document.ID,
+		Title:     result.Document.Title,
+		Content:   result.Document.Content,
+		Icon:      result.Document.Icon,
+		Color:     result.Document.Color,
+		SlugID:    result.Document.SlugID,
+		URL:       result.Document.URL,
+		CreatedAt: result.Document.CreatedAt,
+		UpdatedAt: result.Document.UpdatedAt,
+		Creator:   result.Document.Creator,
+		Project:   result.Document.Project,
+	}, nil
 }
 
-// RestoreProject unarchives a project
-func (c *Client) RestoreProject(ctx context.Context, projectID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		projectUnarchive(id: %q) {
+// CreateDocument creates a new document
+func (c *Client) CreateDocument(ctx context.Context, input DocumentCreateInput) (*Document, error) {
+	mutation := `mutation($input: DocumentCreateInput!) {
+		documentCreate(input: $input) {
 			success
+			document {
+				id
+				title
+				content
+				slugId
+				url
+				createdAt
+				updatedAt
+				creator {
+					id
+					displayName
+				}
+				project {
+					id
+					name
+				}
+			}
 		}
-	}`, projectID)
+	}`
+	variables := map[string]interface{}{"input": input}
 
 	var result struct {
-		ProjectUnarchive struct {
-			Success bool `json:"success"`
-		} `json:"projectUnarchive"`
+		DocumentCreate struct {
+			Success  bool `json:"success"`
+			Document struct {
+				ID        string `json:"id"`
+				Title     string `json:"title"`
+				Content   string `json:"content"`
+				SlugID    string `json:"slugId"`
+				URL       string `json:"url"`
+				CreatedAt string `json:"createdAt"`
+				UpdatedAt string `json:"updatedAt"`
+				Creator   *struct {
+					ID          string `json:"id"`
+					DisplayName string `json:"displayName"`
+				} `json:"creator"`
+				Project *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"project"`
+			} `json:"document"`
+		} `json:"documentCreate"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
 	}
 
-	if !result.ProjectUnarchive.Success {
-		return fmt.Errorf("failed to restore project")
+	if !result.DocumentCreate.Success {
+		return nil, fmt.Errorf("failed to create document")
 	}
 
-	return nil
-}
-
-// Milestone represents a project milestone
-type Milestone struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	TargetDate  string `json:"targetDate,omitempty"`
-	SortOrder   int    `json:"sortOrder"`
+	return &Document{
+		ID:        result.DocumentCreate.Document.ID,
+		Title:     result.DocumentCreate.Document.Title,
+		Content:   result.DocumentCreate.Document.Content,
+		SlugID:    result.DocumentCreate.Document.SlugID,
+		URL:       result.DocumentCreate.Document.URL,
+		CreatedAt: result.DocumentCreate.Document.CreatedAt,
+		UpdatedAt: result.DocumentCreate.Document.UpdatedAt,
+		Creator:   result.DocumentCreate.Document.Creator,
+		Project:   result.DocumentCreate.Document.Project,
+	}, nil
 }
 
-// MilestonesResponse is the response for listing milestones
-type MilestonesResponse struct {
-	Milestones []Milestone `json:"milestones"`
-	Count      int         `json:"count"`
-}
+// UpdateDocument updates a document
+func (c *Client) UpdateDocument(ctx context.Context, documentID string, input DocumentUpdateInput) (*Document, error) {
+	if input == (DocumentUpdateInput{}) {
+		return nil, fmt.Errorf("no fields to update")
+	}
 
-// GetProjectMilestones fetches milestones for a project
-func (c *Client) GetProjectMilestones(ctx context.Context, projectID string) (*MilestonesResponse, error) {
-	queryStr := fmt.Sprintf(`query {
-		project(id: %q) {
-			projectMilestones {
-				nodes {
+	mutation := `mutation($id: String!, $input: DocumentUpdateInput!) {
+		documentUpdate(id: $id, input: $input) {
+			success
+			document {
+				id
+				title
+				content
+				slugId
+				url
+				createdAt
+				updatedAt
+				creator {
+					id
+					displayName
+				}
+				project {
 					id
 					name
-					description
-					targetDate
-					sortOrder
 				}
 			}
 		}
-	}`, projectID)
+	}`
+	variables := map[string]interface{}{"id": documentID, "input": input}
 
 	var result struct {
-		Project struct {
-			ProjectMilestones struct {
-				Nodes []struct {
+		DocumentUpdate struct {
+			Success  bool `json:"success"`
+			Document struct {
+				ID        string `json:"id"`
+				Title     string `json:"title"`
+				Content   string `json:"content"`
+				SlugID    string `json:"slugId"`
+				URL       string `json:"url"`
+				CreatedAt string `json:"createdAt"`
+				UpdatedAt string `json:"updatedAt"`
+				Creator   *struct {
 					ID          string `json:"id"`
-					Name        string `json:"name"`
-					Description string `json:"description"`
-					TargetDate  string `json:"targetDate"`
-					SortOrder   int    `json:"sortOrder"`
-				} `json:"nodes"`
-			} `json:"projectMilestones"`
-		} `json:"project"`
+					DisplayName string `json:"displayName"`
+				} `json:"creator"`
+				Project *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"project"`
+			} `json:"document"`
+		} `json:"documentUpdate"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return nil, err
 	}
 
-	milestones := make([]Milestone, len(result.Project.ProjectMilestones.Nodes))
-	for i, m := range result.Project.ProjectMilestones.Nodes {
-		milestones[i] = Milestone{
-			ID:          m.ID,
-			Name:        m.Name,
-			Description: m.Description,
-			TargetDate:  m.TargetDate,
-			SortOrder:   m.SortOrder,
-		}
+	if !result.DocumentUpdate.Success {
+		return nil, fmt.Errorf("failed to update document")
 	}
 
-	return &MilestonesResponse{
-		Milestones: milestones,
-		Count:      len(milestones),
+	return &Document{
+		ID:        result.DocumentUpdate.Document.ID,
+		Title:     result.DocumentUpdate.Document.Title,
+		Content:   result.DocumentUpdate.Document.Content,
+		SlugID:    result.DocumentUpdate.Document.SlugID,
+		URL:       result.DocumentUpdate.Document.URL,
+		CreatedAt: result.DocumentUpdate.Document.CreatedAt,
+		UpdatedAt: result.DocumentUpdate.Document.UpdatedAt,
+		Creator:   result.DocumentUpdate.Document.Creator,
+		Project:   result.DocumentUpdate.Document.Project,
 	}, nil
 }
 
-// CreateProjectMilestone creates a new milestone for a project
-func (c *Client) CreateProjectMilestone(ctx context.Context, projectID, name, description, targetDate string) (*Milestone, error) {
-	inputParts := []string{
-		fmt.Sprintf(`name: %q`, name),
-		fmt.Sprintf(`projectId: %q`, projectID),
+// documentListSelection is the document selection for DocumentListItem
+const documentListSelection = `id
+					title
+					slugId
+					icon
+					url
+					createdAt
+					updatedAt
+					creator {
+						id
+						displayName
+					}
+					project {
+						id
+						name
+					}`
+
+// LinkedDocuments is an issue or initiative with the documents attached
+// to it. Name is the issue identifier or the initiative name.
+type LinkedDocuments struct {
+	ID        string             `json:"id"`
+	Name      string             `json:"name"`
+	Documents []DocumentListItem `json:"documents"`
+}
+
+// GetIssueDocuments fetches the documents attached to an issue (by ID or
+// identifier). It returns nil when the issue does not exist.
+func (c *Client) GetIssueDocuments(ctx context.Context, issueID string) (*LinkedDocuments, error) {
+	query := `query($id: String!) {
+		issue(id: $id) {
+			id
+			identifier
+			documents(first: 100) {
+				nodes {
+					` + documentListSelection + `
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{"id": issueID}
+
+	var result struct {
+		Issue *struct {
+			ID         string `json:"id"`
+			Identifier string `json:"identifier"`
+			Documents  struct {
+				Nodes []DocumentListItem `json:"nodes"`
+			} `json:"documents"`
+		} `json:"issue"`
 	}
 
-	if description != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`description: %q`, description))
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, err
 	}
-	if targetDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`targetDate: %q`, targetDate))
+	if result.Issue == nil {
+		return nil, nil
 	}
+	return &LinkedDocuments{ID: result.Issue.ID, Name: result.Issue.Identifier, Documents: result.Issue.Documents.Nodes}, nil
+}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		projectMilestoneCreate(input: { %s }) {
-			success
-			projectMilestone {
-				id
-				name
-				description
-				targetDate
-				sortOrder
+// GetInitiativeDocuments fetches the documents attached to an initiative.
+// It returns nil when the initiative does not exist.
+func (c *Client) GetInitiativeDocuments(ctx context.Context, initiativeID string) (*LinkedDocuments, error) {
+	query := `query($id: String!) {
+		initiative(id: $id) {
+			id
+			name
+			documents(first: 100) {
+				nodes {
+					` + documentListSelection + `
+				}
 			}
 		}
-	}`, strings.Join(inputParts, ", "))
+	}`
+	variables := map[string]interface{}{"id": initiativeID}
 
 	var result struct {
-		ProjectMilestoneCreate struct {
-			Success          bool `json:"success"`
-			ProjectMilestone struct {
-				ID          string `json:"id"`
-				Name        string `json:"name"`
-				Description string `json:"description"`
-				TargetDate  string `json:"targetDate"`
-				SortOrder   int    `json:"sortOrder"`
-			} `json:"projectMilestone"`
-		} `json:"projectMilestoneCreate"`
+		Initiative *struct {
+			ID        string `json:"id"`
+			Name      string `json:"name"`
+			Documents struct {
+				Nodes []DocumentListItem `json:"nodes"`
+			} `json:"documents"`
+		} `json:"initiative"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, query, &result, variables); err != nil {
 		return nil, err
 	}
-
-	if !result.ProjectMilestoneCreate.Success {
-		return nil, fmt.Errorf("failed to create milestone")
+	if result.Initiative == nil {
+		return nil, nil
 	}
+	return &LinkedDocuments{ID: result.Initiative.ID, Name: result.Initiative.Name, Documents: result.Initiative.Documents.Nodes}, nil
+}
 
-	return &Milestone{
-		ID:          result.ProjectMilestoneCreate.ProjectMilestone.ID,
-		Name:        result.ProjectMilestoneCreate.ProjectMilestone.Name,
-		Description: result.ProjectMilestoneCreate.ProjectMilestone.Description,
-		TargetDate:  result.ProjectMilestoneCreate.ProjectMilestone.TargetDate,
-		SortOrder:   result.ProjectMilestoneCreate.ProjectMilestone.SortOrder,
-	}, nil
+// SetDocumentIssue attaches a document to an issue, or detaches it from
+// its issue when issueID is empty
+func (c *Client) SetDocumentIssue(ctx context.Context, documentID, issueID string) error {
+	return c.setDocumentParent(ctx, documentID, "issueId", issueID)
 }
 
-// UpdateProjectMilestone updates a milestone
-func (c *Client) UpdateProjectMilestone(ctx context.Context, milestoneID string, name, description, targetDate *string) (*Milestone, error) {
-	inputParts := []string{}
+// SetDocumentInitiative attaches a document to an initiative, or detaches
+// it from its initiative when initiativeID is empty
+func (c *Client) SetDocumentInitiative(ctx context.Context, documentID, initiativeID string) error {
+	return c.setDocumentParent(ctx, documentID, "initiativeId", initiativeID)
+}
 
-	if name != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`name: %q`, *name))
+// setDocumentParent sets one of a document's parent fields, sending null
+// for an empty ID so the link is cleared
+func (c *Client) setDocumentParent(ctx context.Context, documentID, field, parentID string) error {
+	mutation := `mutation($id: String!, $input: DocumentUpdateInput!) {
+		documentUpdate(id: $id, input: $input) {
+			success
+		}
+	}`
+	var value interface{}
+	if parentID != "" {
+		value = parentID
 	}
-	if description != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`description: %q`, *description))
+	variables := map[string]interface{}{
+		"id":    documentID,
+		"input": map[string]interface{}{field: value},
 	}
-	if targetDate != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`targetDate: %q`, *targetDate))
+
+	var result struct {
+		DocumentUpdate struct {
+			Success bool `json:"success"`
+		} `json:"documentUpdate"`
 	}
 
-	if len(inputParts) == 0 {
-		return nil, fmt.Errorf("no fields to update")
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+	if !result.DocumentUpdate.Success {
+		return fmt.Errorf("failed to update document")
 	}
+	return nil
+}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		projectMilestoneUpdate(id: %q, input: { %s }) {
+// DeleteDocument archives a document
+func (c *Client) DeleteDocument(ctx context.Context, documentID string) error {
+	mutation := `mutation($id: String!) {
+		documentDelete(id: $id) {
 			success
-			projectMilestone {
-				id
-				name
-				description
-				targetDate
-				sortOrder
-			}
 		}
-	}`, milestoneID, strings.Join(inputParts, ", "))
+	}`
+	variables := map[string]interface{}{"id": documentID}
 
 	var result struct {
-		ProjectMilestoneUpdate struct {
-			Success          bool `json:"success"`
-			ProjectMilestone struct {
-				ID          string `json:"id"`
-				Name        string `json:"name"`
-				Description string `json:"description"`
-				TargetDate  string `json:"targetDate"`
-				SortOrder   int    `json:"sortOrder"`
-			} `json:"projectMilestone"`
-		} `json:"projectMilestoneUpdate"`
+		DocumentDelete struct {
+			Success bool `json:"success"`
+		} `json:"documentDelete"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return nil, err
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
 	}
 
-	if !result.ProjectMilestoneUpdate.Success {
-		return nil, fmt.Errorf("failed to update milestone")
+	if !result.DocumentDelete.Success {
+		return fmt.Errorf("failed to delete document")
 	}
 
-	return &Milestone{
-		ID:          result.ProjectMilestoneUpdate.ProjectMilestone.ID,
-		Name:        result.ProjectMilestoneUpdate.ProjectMilestone.Name,
-		Description: result.ProjectMilestoneUpdate.ProjectMilestone.Description,
-		TargetDate:  result.ProjectMilestoneUpdate.ProjectMilestone.TargetDate,
-		SortOrder:   result.ProjectMilestoneUpdate.ProjectMilestone.SortOrder,
-	}, nil
+	return nil
 }
 
-// DeleteProjectMilestone deletes a milestone
-func (c *Client) DeleteProjectMilestone(ctx context.Context, milestoneID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		projectMilestoneDelete(id: %q) {
+// RestoreDocument restores (unarchives) a deleted document
+func (c *Client) RestoreDocument(ctx context.Context, documentID string) error {
+	mutation := `mutation($id: String!) {
+		documentUnarchive(id: $id) {
 			success
 		}
-	}`, milestoneID)
+	}`
+	variables := map[string]interface{}{"id": documentID}
 
 	var result struct {
-		ProjectMilestoneDelete struct {
+		DocumentUnarchive struct {
 			Success bool `json:"success"`
-		} `json:"projectMilestoneDelete"`
+		} `json:"documentUnarchive"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return err
 	}
 
-	if !result.ProjectMilestoneDelete.Success {
-		return fmt.Errorf("failed to delete milestone")
+	if !result.DocumentUnarchive.Success {
+		return fmt.Errorf("failed to restore document")
 	}
 
 	return nil
 }
 
-// ProjectUpdate represents a project status update
-type ProjectUpdate struct {
-	ID        string `json:"id"`
-	Body      string `json:"body"`
-	Health    string `json:"health,omitempty"`
-	CreatedAt string `json:"createdAt"`
-	User      *struct {
-		ID          string `json:"id"`
-		DisplayName string `json:"displayName"`
-	} `json:"user,omitempty"`
-}
-
-// ProjectUpdatesResponse is the response for listing project updates
-type ProjectUpdatesResponse struct {
-	Updates []ProjectUpdate `json:"updates"`
-	Count   int             `json:"count"`
-}
-
-// GetProjectUpdates fetches status updates for a project
-func (c *Client) GetProjectUpdates(ctx context.Context, projectID string, limit int) (*ProjectUpdatesResponse, error) {
+// SearchDocuments searches for documents
+func (c *Client) SearchDocuments(ctx context.Context, query string, limit int) (*DocumentSearchResponse, error) {
 	queryStr := fmt.Sprintf(`query {
-		project(id: %q) {
-			projectUpdates(first: %d) {
-				nodes {
+		searchDocuments(term: %q, first: %d) {
+			nodes {
+				id
+				title
+				slugId
+				icon
+				url
+				updatedAt
+				creator {
 					id
-					body
-					health
-					createdAt
-					user {
-						id
-						displayName
-					}
+					displayName
+				}
+				project {
+					id
+					name
 				}
 			}
+			totalCount
 		}
-	}`, projectID, limit)
+	}`, query, limit)
 
 	var result struct {
-		Project struct {
-			ProjectUpdates struct {
-				Nodes []struct {
-					ID        string `json:"id"`
-					Body      string `json:"body"`
-					Health    string `json:"health"`
-					CreatedAt string `json:"createdAt"`
-					User      *struct {
-						ID          string `json:"id"`
-						DisplayName string `json:"displayName"`
-					} `json:"user"`
-				} `json:"nodes"`
-			} `json:"projectUpdates"`
-		} `json:"project"`
+		SearchDocuments struct {
+			Nodes []struct {
+				ID        string `json:"id"`
+				Title     string `json:"title"`
+				SlugID    string `json:"slugId"`
+				Icon      string `json:"icon"`
+				URL       string `json:"url"`
+				UpdatedAt string `json:"updatedAt"`
+				Creator   *struct {
+					ID          string `json:"id"`
+					DisplayName string `json:"displayName"`
+				} `json:"creator"`
+				Project *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"project"`
+			} `json:"nodes"`
+			TotalCount int `json:"totalCount"`
+		} `json:"searchDocuments"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
 		return nil, err
 	}
 
-	updates := make([]ProjectUpdate, len(result.Project.ProjectUpdates.Nodes))
-	for i, u := range result.Project.ProjectUpdates.Nodes {
-		updates[i] = ProjectUpdate{
-			ID:        u.ID,
-			Body:      u.Body,
-			Health:    u.Health,
-			CreatedAt: u.CreatedAt,
-		}
-		if u.User != nil {
-			updates[i].User = &struct {
-				ID          string `json:"id"`
-				DisplayName string `json:"displayName"`
-			}{
-				ID:          u.User.ID,
-				DisplayName: u.User.DisplayName,
-			}
+	documents := make([]DocumentListItem, len(result.SearchDocuments.Nodes))
+	for i, d := range result.SearchDocuments.Nodes {
+		documents[i] = DocumentListItem{
+			ID:        d.ID,
+			Title:     d.Title,
+			SlugID:    d.SlugID,
+			Icon:      d.Icon,
+			URL:       d.URL,
+			UpdatedAt: d.UpdatedAt,
+			Creator:   d.Creator,
+			Project:   d.Project,
 		}
 	}
 
-	return &ProjectUpdatesResponse{
-		Updates: updates,
-		Count:   len(updates),
+	return &DocumentSearchResponse{
+		Documents:  documents,
+		Count:      len(documents),
+		Query:      query,
+		TotalCount: result.SearchDocuments.TotalCount,
 	}, nil
 }
 
-// CreateProjectUpdate creates a new status update for a project
-func (c *Client) CreateProjectUpdate(ctx context.Context, projectID, body string, health *string) (*ProjectUpdate, error) {
-	inputParts := []string{
-		fmt.Sprintf(`projectId: %q`, projectID),
-		fmt.Sprintf(`body: %q`, body),
-	}
+// Initiative represents a Linear initiative
+type Initiative struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Content     string `json:"content,omitempty"`
+	Status      string `json:"status"`
+	SlugID      string `json:"slugId"`
+	URL         string `json:"url"`
+	TargetDate  string `json:"targetDate,omitempty"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+	Owner       *struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+	} `json:"owner,omitempty"`
+	Projects []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"projects,omitempty"`
+	ParentInitiative *InitiativeRef  `json:"parentInitiative,omitempty"`
+	SubInitiatives   []InitiativeRef `json:"subInitiatives,omitempty"`
+}
 
-	if health != nil {
-		inputParts = append(inputParts, fmt.Sprintf(`health: %s`, *health))
+// InitiativeRef is a reference to a parent or sub-initiative
+type InitiativeRef struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status,omitempty"`
+	TargetDate string `json:"targetDate,omitempty"`
+}
+
+// InitiativeListItem represents an initiative in a list
+type InitiativeListItem struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	SlugID      string `json:"slugId"`
+	URL         string `json:"url"`
+	TargetDate  string `json:"targetDate,omitempty"`
+	UpdatedAt   string `json:"updatedAt"`
+	Owner       *struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+	} `json:"owner,omitempty"`
+	ProjectCount int    `json:"projectCount"`
+	ParentID     string `json:"parentId,omitempty"`
+}
+
+// InitiativesResponse is the response for listing initiatives
+type InitiativesResponse struct {
+	Initiatives []InitiativeListItem `json:"initiatives"`
+	Count       int                  `json:"count"`
+	PageInfo    *PageInfo            `json:"pageInfo,omitempty"`
+}
+
+// InitiativeCreateInput is the input for creating an initiative
+type InitiativeCreateInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Content     string `json:"content,omitempty"`
+	Status      string `json:"status,omitempty"`
+	OwnerID     string `json:"ownerId,omitempty"`
+	TargetDate  string `json:"targetDate,omitempty"`
+}
+
+// InitiativeUpdateInput is the input for updating an initiative
+type InitiativeUpdateInput struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Content     string `json:"content,omitempty"`
+	Status      string `json:"status,omitempty"`
+	OwnerID     string `json:"ownerId,omitempty"`
+	TargetDate  string `json:"targetDate,omitempty"`
+}
+
+// InitiativeRelationCreateInput is the input for nesting a sub-initiative
+// under a parent initiative
+type InitiativeRelationCreateInput struct {
+	InitiativeID        string `json:"initiativeId"`
+	RelatedInitiativeID string `json:"relatedInitiativeId"`
+}
+
+// InitiativeToProjectCreateInput is the input for linking a project to an initiative
+type InitiativeToProjectCreateInput struct {
+	InitiativeID string `json:"initiativeId"`
+	ProjectID    string `json:"projectId"`
+}
+
+// GetInitiatives fetches initiatives
+func (c *Client) GetInitiatives(ctx context.Context, status string, ownerID string, limit int, after string) (*InitiativesResponse, error) {
+	filterParts := []string{}
+	if status != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`status: { eq: %q }`, status))
+	}
+	if ownerID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`owner: { id: { eq: %q } }`, ownerID))
 	}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		projectUpdateCreate(input: { %s }) {
-			success
-			projectUpdate {
+	filterPart := ""
+	if len(filterParts) > 0 {
+		filterPart = fmt.Sprintf(`, filter: { %s }`, strings.Join(filterParts, ", "))
+	}
+
+	queryStr := fmt.Sprintf(`query {
+		initiatives(first: %d%s%s) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
 				id
-				body
-				health
-				createdAt
-				user {
+				name
+				status
+				slugId
+				targetDate
+				updatedAt
+				owner {
 					id
 					displayName
 				}
+				projects {
+					nodes {
+						id
+					}
+				}
+				parentInitiative {
+					id
+				}
 			}
 		}
-	}`, strings.Join(inputParts, ", "))
+	}`, limit, afterArg(after), filterPart)
 
 	var result struct {
-		ProjectUpdateCreate struct {
-			Success       bool `json:"success"`
-			ProjectUpdate struct {
-				ID        string `json:"id"`
-				Body      string `json:"body"`
-				Health    string `json:"health"`
-				CreatedAt string `json:"createdAt"`
-				User      *struct {
+		Initiatives struct {
+			PageInfo PageInfo `json:"pageInfo"`
+			Nodes    []struct {
+				ID         string `json:"id"`
+				Name       string `json:"name"`
+				Status     string `json:"status"`
+				SlugID     string `json:"slugId"`
+				TargetDate string `json:"targetDate"`
+				UpdatedAt  string `json:"updatedAt"`
+				Owner      *struct {
 					ID          string `json:"id"`
 					DisplayName string `json:"displayName"`
-				} `json:"user"`
-			} `json:"projectUpdate"`
-		} `json:"projectUpdateCreate"`
+				} `json:"owner"`
+				Projects struct {
+					Nodes []struct {
+						ID string `json:"id"`
+					} `json:"nodes"`
+				} `json:"projects"`
+				ParentInitiative *struct {
+					ID string `json:"id"`
+				} `json:"parentInitiative"`
+			} `json:"nodes"`
+		} `json:"initiatives"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
 		return nil, err
 	}
 
-	if !result.ProjectUpdateCreate.Success {
-		return nil, fmt.Errorf("failed to create project update")
-	}
-
-	update := &ProjectUpdate{
-		ID:        result.ProjectUpdateCreate.ProjectUpdate.ID,
-		Body:      result.ProjectUpdateCreate.ProjectUpdate.Body,
-		Health:    result.ProjectUpdateCreate.ProjectUpdate.Health,
-		CreatedAt: result.ProjectUpdateCreate.ProjectUpdate.CreatedAt,
-	}
-
-	if result.ProjectUpdateCreate.ProjectUpdate.User != nil {
-		update.User = &struct {
-			ID          string `json:"id"`
-			DisplayName string `json:"displayName"`
-		}{
-			ID:          result.ProjectUpdateCreate.ProjectUpdate.User.ID,
-			DisplayName: result.ProjectUpdateCreate.ProjectUpdate.User.DisplayName,
+	initiatives := make([]InitiativeListItem, len(result.Initiatives.Nodes))
+	for i, init := range result.Initiatives.Nodes {
+		initiatives[i] = InitiativeListItem{
+			ID:           init.ID,
+			Name:         init.Name,
+			Status:       init.Status,
+			SlugID:       init.SlugID,
+			TargetDate:   init.TargetDate,
+			UpdatedAt:    init.UpdatedAt,
+			Owner:        init.Owner,
+			ProjectCount: len(init.Projects.Nodes),
+		}
+		if init.ParentInitiative != nil {
+			initiatives[i].ParentID = init.ParentInitiative.ID
 		}
 	}
 
-	return update, nil
-}
-
-// DocumentListItem represents a document in a list
-type DocumentListItem struct {
-	ID        string `json:"id"`
-	Title     string `json:"title"`
-	SlugID    string `json:"slugId"`
-	Icon      string `json:"icon,omitempty"`
-	URL       string `json:"url"`
-	UpdatedAt string `json:"updatedAt"`
-	Creator   *struct {
-		ID          string `json:"id"`
-		DisplayName string `json:"displayName"`
-	} `json:"creator,omitempty"`
-	Project *struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-	} `json:"project,omitempty"`
-}
-
-// DocumentsResponse is the response for listing documents
-type DocumentsResponse struct {
-	Documents []DocumentListItem `json:"documents"`
-	Count     int                `json:"count"`
-}
-
-// DocumentSearchResponse is the response for searching documents
-type DocumentSearchResponse struct {
-	Documents  []DocumentListItem `json:"documents"`
-	Count      int                `json:"count"`
-	Query      string             `json:"query"`
-	TotalCount int                `json:"totalCount"`
+	return &InitiativesResponse{
+		Initiatives: initiatives,
+		Count:       len(initiatives),
+		PageInfo:    &result.Initiatives.PageInfo,
+	}, nil
 }
 
-// DocumentCreateInput is the input for creating a document
-type DocumentCreateInput struct {
-	Title      string `json:"title"`
-	Content    string `json:"content,omitempty"`
-	ProjectID  string `json:"projectId,omitempty"`
-	TeamID     string `json:"teamId,omitempty"`
-	Icon       string `json:"icon,omitempty"`
-	Color      string `json:"color,omitempty"`
+// RoadmapProject is a project placed on the roadmap timeline
+type RoadmapProject struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	Health     string  `json:"health,omitempty"`
+	Progress   float64 `json:"progress"`
+	StartDate  string  `json:"startDate,omitempty"`
+	TargetDate string  `json:"targetDate,omitempty"`
 }
 
-// DocumentUpdateInput is the input for updating a document
-type DocumentUpdateInput struct {
-	Title     string `json:"title,omitempty"`
-	Content   string `json:"content,omitempty"`
-	ProjectID string `json:"projectId,omitempty"`
-	Icon      string `json:"icon,omitempty"`
-	Color     string `json:"color,omitempty"`
+// RoadmapInitiative is an initiative with its projects, for the roadmap
+// timeline
+type RoadmapInitiative struct {
+	ID         string           `json:"id"`
+	Name       string           `json:"name"`
+	Status     string           `json:"status"`
+	Health     string           `json:"health,omitempty"`
+	TargetDate string           `json:"targetDate,omitempty"`
+	Owner      string           `json:"owner,omitempty"`
+	ParentID   string           `json:"parentId,omitempty"`
+	Projects   []RoadmapProject `json:"projects"`
 }
 
-// GetDocuments fetches documents
-func (c *Client) GetDocuments(ctx context.Context, projectID string, limit int) (*DocumentsResponse, error) {
+// GetRoadmapInitiatives fetches a page of initiatives with their projects'
+// dates, health and progress
+func (c *Client) GetRoadmapInitiatives(ctx context.Context, status string, limit int, after string) ([]RoadmapInitiative, *PageInfo, error) {
 	filterPart := ""
-	if projectID != "" {
-		filterPart = fmt.Sprintf(`, filter: { project: { id: { eq: "%s" } } }`, projectID)
+	if status != "" {
+		filterPart = fmt.Sprintf(`, filter: { status: { eq: %q } }`, status)
 	}
 
 	queryStr := fmt.Sprintf(`query {
-		documents(first: %d%s) {
+		initiatives(first: %d%s%s) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
 			nodes {
 				id
-				title
-				slugId
-				icon
-				url
-				updatedAt
-				creator {
-					id
+				name
+				status
+				health
+				targetDate
+				owner {
 					displayName
 				}
-				project {
+				parentInitiative {
 					id
-					name
+				}
+				projects(first: 100) {
+					nodes {
+						id
+						name
+						health
+						progress
+						startDate
+						targetDate
+						status {
+							name
+						}
+					}
 				}
 			}
 		}
-	}`, limit, filterPart)
+	}`, limit, afterArg(after), filterPart)
 
 	var result struct {
-		Documents struct {
-			Nodes []struct {
-				ID        string `json:"id"`
-				Title     string `json:"title"`
-				SlugID    string `json:"slugId"`
-				Icon      string `json:"icon"`
-				URL       string `json:"url"`
-				UpdatedAt string `json:"updatedAt"`
-				Creator   *struct {
-					ID          string `json:"id"`
+		Initiatives struct {
+			PageInfo PageInfo `json:"pageInfo"`
+			Nodes    []struct {
+				ID         string `json:"id"`
+				Name       string `json:"name"`
+				Status     string `json:"status"`
+				Health     string `json:"health"`
+				TargetDate string `json:"targetDate"`
+				Owner      *struct {
 					DisplayName string `json:"displayName"`
-				} `json:"creator"`
-				Project *struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-				} `json:"project"`
+				} `json:"owner"`
+				ParentInitiative *struct {
+					ID string `json:"id"`
+				} `json:"parentInitiative"`
+				Projects struct {
+					Nodes []struct {
+						ID         string  `json:"id"`
+						Name       string  `json:"name"`
+						Health     string  `json:"health"`
+						Progress   float64 `json:"progress"`
+						StartDate  string  `json:"startDate"`
+						TargetDate string  `json:"targetDate"`
+						Status     *struct {
+							Name string `json:"name"`
+						} `json:"status"`
+					} `json:"nodes"`
+				} `json:"projects"`
 			} `json:"nodes"`
-		} `json:"documents"`
+		} `json:"initiatives"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
-		return nil, err
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, nil, err
 	}
 
-	documents := make([]DocumentListItem, len(result.Documents.Nodes))
-	for i, d := range result.Documents.Nodes {
-		documents[i] = DocumentListItem{
-			ID:        d.ID,
-			Title:     d.Title,
-			SlugID:    d.SlugID,
-			Icon:      d.Icon,
-			URL:       d.URL,
-			UpdatedAt: d.UpdatedAt,
-			Creator:   d.Creator,
-			Project:   d.Project,
+	initiatives := make([]RoadmapInitiative, len(result.Initiatives.Nodes))
+	for i, init := range result.Initiatives.Nodes {
+		initiatives[i] = RoadmapInitiative{
+			ID:         init.ID,
+			Name:       init.Name,
+			Status:     init.Status,
+			Health:     init.Health,
+			TargetDate: init.TargetDate,
+			Projects:   make([]RoadmapProject, len(init.Projects.Nodes)),
+		}
+		if init.Owner != nil {
+			initiatives[i].Owner = init.Owner.DisplayName
+		}
+		if init.ParentInitiative != nil {
+			initiatives[i].ParentID = init.ParentInitiative.ID
+		}
+		for j, p := range init.Projects.Nodes {
+			project := RoadmapProject{
+				ID:         p.ID,
+				Name:       p.Name,
+				Health:     p.Health,
+				Progress:   p.Progress,
+				StartDate:  p.StartDate,
+				TargetDate: p.TargetDate,
+			}
+			if p.Status != nil {
+				project.Status = p.Status.Name
+			}
+			initiatives[i].Projects[j] = project
 		}
 	}
 
-	return &DocumentsResponse{
-		Documents: documents,
-		Count:     len(documents),
-	}, nil
+	return initiatives, &result.Initiatives.PageInfo, nil
 }
 
-// GetDocument fetches a single document by ID
-func (c *Client) GetDocument(ctx context.Context, documentID string) (*Document, error) {
+// GetInitiative fetches a single initiative by ID
+func (c *Client) GetInitiative(ctx context.Context, initiativeID string) (*Initiative, error) {
 	queryStr := fmt.Sprintf(`query {
-		document(id: %q) {
+		initiative(id: %q) {
 			id
-			title
+			name
+			description
 			content
-			icon
-			color
+			status
 			slugId
-			url
+			targetDate
 			createdAt
 			updatedAt
-			creator {
+			owner {
 				id
 				displayName
 			}
-			project {
+			projects {
+				nodes {
+					id
+					name
+				}
+			}
+			parentInitiative {
 				id
 				name
+				status
+				targetDate
+			}
+			subInitiatives {
+				nodes {
+					id
+					name
+					status
+					targetDate
+				}
 			}
 		}
-	}`, documentID)
+	}`, initiativeID)
 
 	var result struct {
-		Document struct {
-			ID        string `json:"id"`
-			Title     string `json:"title"`
-			Content   string `json:"content"`
-			Icon      string `json:"icon"`
-			Color     string `json:"color"`
-			SlugID    string `json:"slugId"`
-			URL       string `json:"url"`
-			CreatedAt string `json:"createdAt"`
-			UpdatedAt string `json:"updatedAt"`
-			Creator   *struct {
+		Initiative *struct {
+			ID          string `json:"id"`
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			Content     string `json:"content"`
+			Status      string `json:"status"`
+			SlugID      string `json:"slugId"`
+			TargetDate  string `json:"targetDate"`
+			CreatedAt   string `json:"createdAt"`
+			UpdatedAt   string `json:"updatedAt"`
+			Owner       *struct {
 				ID          string `json:"id"`
 				DisplayName string `json:"displayName"`
-			} `json:"creator"`
-			Project *struct {
-				ID   string `json:"id"`
-				Name string `json:"name"`
-			} `json:"project"`
-		} `json:"document"`
+			} `json:"owner"`
+			Projects struct {
+				Nodes []struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"nodes"`
+			} `json:"projects"`
+			ParentInitiative *InitiativeRef `json:"parentInitiative"`
+			SubInitiatives   struct {
+				Nodes []InitiativeRef `json:"nodes"`
+			} `json:"subInitiatives"`
+		} `json:"initiative"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
 		return nil, err
 	}
 
-	if result.Document.ID == "" {
+	if result.Initiative == nil {
 		return nil, nil
 	}
 
-	return &Document{
-		ID:        result.Document.ID,
-		Title:     result.Document.Title,
-		Content:   result.Document.Content,
-		Icon:      result.Document.Icon,
-		Color:     result.Document.Color,
-		SlugID:    result.Document.SlugID,
-		URL:       result.Document.URL,
-		CreatedAt: result.Document.CreatedAt,
-		UpdatedAt: result.Document.UpdatedAt,
-		Creator:   result.Document.Creator,
-		Project:   result.Document.Project,
+	return &Initiative{
+		ID:               result.Initiative.ID,
+		Name:             result.Initiative.Name,
+		Description:      result.Initiative.Description,
+		Content:          result.Initiative.Content,
+		Status:           result.Initiative.Status,
+		SlugID:           result.Initiative.SlugID,
+		TargetDate:       result.Initiative.TargetDate,
+		CreatedAt:        result.Initiative.CreatedAt,
+		UpdatedAt:        result.Initiative.UpdatedAt,
+		Owner:            result.Initiative.Owner,
+		Projects:         result.Initiative.Projects.Nodes,
+		ParentInitiative: result.Initiative.ParentInitiative,
+		SubInitiatives:   result.Initiative.SubInitiatives.Nodes,
 	}, nil
 }
 
-// CreateDocument creates a new document
-func (c *Client) CreateDocument(ctx context.Context, input DocumentCreateInput) (*Document, error) {
-	inputParts := []string{
-		fmt.Sprintf(`title: %q`, input.Title),
-	}
-
-	if input.Content != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`content: %q`, input.Content))
-	}
-	if input.ProjectID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`projectId: %q`, input.ProjectID))
-	}
-	if input.TeamID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`teamId: %q`, input.TeamID))
-	}
-	if input.Icon != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`icon: %q`, input.Icon))
-	}
-	if input.Color != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`color: %q`, input.Color))
-	}
-
-	mutationStr := fmt.Sprintf(`mutation {
-		documentCreate(input: { %s }) {
+// CreateInitiative creates a new initiative
+func (c *Client) CreateInitiative(ctx context.Context, input InitiativeCreateInput) (*Initiative, error) {
+	mutation := `mutation($input: InitiativeCreateInput!) {
+		initiativeCreate(input: $input) {
 			success
-			document {
+			initiative {
 				id
-				title
+				name
+				description
 				content
+				status
 				slugId
-				url
+				targetDate
 				createdAt
 				updatedAt
-				creator {
+				owner {
 					id
 					displayName
 				}
-				project {
-					id
-					name
-				}
 			}
 		}
-	}`, strings.Join(inputParts, ", "))
+	}`
+	variables := map[string]interface{}{"input": input}
 
 	var result struct {
-		DocumentCreate struct {
-			Success  bool `json:"success"`
-			Document struct {
-				ID        string `json:"id"`
-				Title     string `json:"title"`
-				Content   string `json:"content"`
-				SlugID    string `json:"slugId"`
-				URL       string `json:"url"`
-				CreatedAt string `json:"createdAt"`
-				UpdatedAt string `json:"updatedAt"`
-				Creator   *struct {
+		InitiativeCreate struct {
+			Success    bool `json:"success"`
+			Initiative struct {
+				ID          string `json:"id"`
+				Name        string `json:"name"`
+				Description string `json:"description"`
+				Content     string `json:"content"`
+				Status      string `json:"status"`
+				SlugID      string `json:"slugId"`
+				TargetDate  string `json:"targetDate"`
+				CreatedAt   string `json:"createdAt"`
+				UpdatedAt   string `json:"updatedAt"`
+				Owner       *struct {
 					ID          string `json:"id"`
 					DisplayName string `json:"displayName"`
-				} `json:"creator"`
-				Project *struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-				} `json:"project"`
-			} `json:"document"`
-		} `json:"documentCreate"`
+				} `json:"owner"`
+			} `json:"initiative"`
+		} `json:"initiativeCreate"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return nil, err
 	}
 
-	if !result.DocumentCreate.Success {
-		return nil, fmt.Errorf("failed to create document")
+	if !result.InitiativeCreate.Success {
+		return nil, fmt.Errorf("failed to create initiative")
 	}
 
-	return &Document{
-		ID:        result.DocumentCreate.Document.ID,
-		Title:     result.DocumentCreate.Document.Title,
-		Content:   result.DocumentCreate.Document.Content,
-		SlugID:    result.DocumentCreate.Document.SlugID,
-		URL:       result.DocumentCreate.Document.URL,
-		CreatedAt: result.DocumentCreate.Document.CreatedAt,
-		UpdatedAt: result.DocumentCreate.Document.UpdatedAt,
-		Creator:   result.DocumentCreate.Document.Creator,
-		Project:   result.DocumentCreate.Document.Project,
+	return &Initiative{
+		ID:          result.InitiativeCreate.Initiative.ID,
+		Name:        result.InitiativeCreate.Initiative.Name,
+		Description: result.InitiativeCreate.Initiative.Description,
+		Content:     result.InitiativeCreate.Initiative.Content,
+		Status:      result.InitiativeCreate.Initiative.Status,
+		SlugID:      result.InitiativeCreate.Initiative.SlugID,
+		TargetDate:  result.InitiativeCreate.Initiative.TargetDate,
+		CreatedAt:   result.InitiativeCreate.Initiative.CreatedAt,
+		UpdatedAt:   result.InitiativeCreate.Initiative.UpdatedAt,
+		Owner:       result.InitiativeCreate.Initiative.Owner,
 	}, nil
 }
 
-// UpdateDocument updates a document
-func (c *Client) UpdateDocument(ctx context.Context, documentID string, input DocumentUpdateInput) (*Document, error) {
-	inputParts := []string{}
-
-	if input.Title != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`title: %q`, input.Title))
-	}
-	if input.Content != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`content: %q`, input.Content))
-	}
-	if input.ProjectID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`projectId: %q`, input.ProjectID))
-	}
-	if input.Icon != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`icon: %q`, input.Icon))
-	}
-	if input.Color != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`color: %q`, input.Color))
-	}
-
-	if len(inputParts) == 0 {
-		return nil, fmt.Errorf("no fields to update")
+// UpdateInitiative updates an existing initiative
+func (c *Client) UpdateInitiative(ctx context.Context, initiativeID string, input InitiativeUpdateInput) (*Initiative, error) {
+	if input == (InitiativeUpdateInput{}) {
+		return nil, fmt.Errorf("at least one field must be specified to update")
 	}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		documentUpdate(id: %q, input: { %s }) {
+	mutation := `mutation($id: String!, $input: InitiativeUpdateInput!) {
+		initiativeUpdate(id: $id, input: $input) {
 			success
-			document {
+			initiative {
 				id
-				title
+				name
+				description
 				content
+				status
 				slugId
-				url
+				targetDate
 				createdAt
 				updatedAt
-				creator {
+				owner {
 					id
 					displayName
 				}
-				project {
-					id
-					name
-				}
 			}
 		}
-	}`, documentID, strings.Join(inputParts, ", "))
+	}`
+	variables := map[string]interface{}{"id": initiativeID, "input": input}
 
 	var result struct {
-		DocumentUpdate struct {
-			Success  bool `json:"success"`
-			Document struct {
-				ID        string `json:"id"`
-				Title     string `json:"title"`
-				Content   string `json:"content"`
-				SlugID    string `json:"slugId"`
-				URL       string `json:"url"`
-				CreatedAt string `json:"createdAt"`
-				UpdatedAt string `json:"updatedAt"`
-				Creator   *struct {
+		InitiativeUpdate struct {
+			Success    bool `json:"success"`
+			Initiative struct {
+				ID          string `json:"id"`
+				Name        string `json:"name"`
+				Description string `json:"description"`
+				Content     string `json:"content"`
+				Status      string `json:"status"`
+				SlugID      string `json:"slugId"`
+				TargetDate  string `json:"targetDate"`
+				CreatedAt   string `json:"createdAt"`
+				UpdatedAt   string `json:"updatedAt"`
+				Owner       *struct {
 					ID          string `json:"id"`
 					DisplayName string `json:"displayName"`
-				} `json:"creator"`
-				Project *struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-				} `json:"project"`
-			} `json:"document"`
-		} `json:"documentUpdate"`
+				} `json:"owner"`
+			} `json:"initiative"`
+		} `json:"initiativeUpdate"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return nil, err
 	}
 
-	if !result.DocumentUpdate.Success {
-		return nil, fmt.Errorf("failed to update document")
+	if !result.InitiativeUpdate.Success {
+		return nil, fmt.Errorf("failed to update initiative")
 	}
 
-	return &Document{
-		ID:        result.DocumentUpdate.Document.ID,
-		Title:     result.DocumentUpdate.Document.Title,
-		Content:   result.DocumentUpdate.Document.Content,
-		SlugID:    result.DocumentUpdate.Document.SlugID,
-		URL:       result.DocumentUpdate.Document.URL,
-		CreatedAt: result.DocumentUpdate.Document.CreatedAt,
-		UpdatedAt: result.DocumentUpdate.Document.UpdatedAt,
-		Creator:   result.DocumentUpdate.Document.Creator,
-		Project:   result.DocumentUpdate.Document.Project,
+	return &Initiative{
+		ID:          result.InitiativeUpdate.Initiative.ID,
+		Name:        result.InitiativeUpdate.Initiative.Name,
+		Description: result.InitiativeUpdate.Initiative.Description,
+		Content:     result.InitiativeUpdate.Initiative.Content,
+		Status:      result.InitiativeUpdate.Initiative.Status,
+		SlugID:      result.InitiativeUpdate.Initiative.SlugID,
+		TargetDate:  result.InitiativeUpdate.Initiative.TargetDate,
+		CreatedAt:   result.InitiativeUpdate.Initiative.CreatedAt,
+		UpdatedAt:   result.InitiativeUpdate.Initiative.UpdatedAt,
+		Owner:       result.InitiativeUpdate.Initiative.Owner,
 	}, nil
 }
 
-// DeleteDocument archives a document
-func (c *Client) DeleteDocument(ctx context.Context, documentID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		documentDelete(id: %q) {
+// ArchiveInitiative archives an initiative
+func (c *Client) ArchiveInitiative(ctx context.Context, initiativeID string) error {
+	mutation := `mutation($id: String!) {
+		initiativeArchive(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": initiativeID}
+
+	var result struct {
+		InitiativeArchive struct {
+			Success bool `json:"success"`
+		} `json:"initiativeArchive"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.InitiativeArchive.Success {
+		return fmt.Errorf("failed to archive initiative")
+	}
+
+	return nil
+}
+
+// RestoreInitiative restores an archived initiative
+func (c *Client) RestoreInitiative(ctx context.Context, initiativeID string) error {
+	mutation := `mutation($id: String!) {
+		initiativeUnarchive(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": initiativeID}
+
+	var result struct {
+		InitiativeUnarchive struct {
+			Success bool `json:"success"`
+		} `json:"initiativeUnarchive"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.InitiativeUnarchive.Success {
+		return fmt.Errorf("failed to restore initiative")
+	}
+
+	return nil
+}
+
+// AddProjectToInitiative adds a project to an initiative
+func (c *Client) AddProjectToInitiative(ctx context.Context, initiativeID, projectID string) error {
+	mutation := `mutation($input: InitiativeToProjectCreateInput!) {
+		initiativeToProjectCreate(input: $input) {
 			success
 		}
-	}`, documentID)
+	}`
+	variables := map[string]interface{}{
+		"input": InitiativeToProjectCreateInput{InitiativeID: initiativeID, ProjectID: projectID},
+	}
 
 	var result struct {
-		DocumentDelete struct {
+		InitiativeToProjectCreate struct {
 			Success bool `json:"success"`
-		} `json:"documentDelete"`
+		} `json:"initiativeToProjectCreate"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return err
 	}
 
-	if !result.DocumentDelete.Success {
-		return fmt.Errorf("failed to delete document")
+	if !result.InitiativeToProjectCreate.Success {
+		return fmt.Errorf("failed to add project to initiative")
 	}
 
 	return nil
 }
 
-// RestoreDocument restores (unarchives) a deleted document
-func (c *Client) RestoreDocument(ctx context.Context, documentID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		documentUnarchive(id: %q) {
+// SetInitiativeParent nests an initiative under a parent initiative,
+// replacing any existing parent. An empty parentID detaches the initiative
+// so it becomes top-level.
+func (c *Client) SetInitiativeParent(ctx context.Context, initiativeID, parentID string) error {
+	if parentID == initiativeID {
+		return fmt.Errorf("an initiative cannot be its own parent")
+	}
+
+	// Query all initiative relations to find the current parent link
+	queryStr := `query {
+		initiativeRelations {
+			nodes {
+				id
+				relatedInitiative {
+					id
+				}
+			}
+		}
+	}`
+
+	var queryResult struct {
+		InitiativeRelations struct {
+			Nodes []struct {
+				ID                string `json:"id"`
+				RelatedInitiative struct {
+					ID string `json:"id"`
+				} `json:"relatedInitiative"`
+			} `json:"nodes"`
+		} `json:"initiativeRelations"`
+	}
+
+	if err := c.exec(ctx, queryStr, &queryResult, nil); err != nil {
+		return err
+	}
+
+	deleteMutation := `mutation($id: String!) {
+		initiativeRelationDelete(id: $id) {
 			success
 		}
-	}`, documentID)
+	}`
+
+	for _, rel := range queryResult.InitiativeRelations.Nodes {
+		if rel.RelatedInitiative.ID != initiativeID {
+			continue
+		}
+
+		var result struct {
+			InitiativeRelationDelete struct {
+				Success bool `json:"success"`
+			} `json:"initiativeRelationDelete"`
+		}
+		if err := c.exec(ctx, deleteMutation, &result, map[string]interface{}{"id": rel.ID}); err != nil {
+			return err
+		}
+		if !result.InitiativeRelationDelete.Success {
+			return fmt.Errorf("failed to remove existing parent initiative")
+		}
+	}
+
+	if parentID == "" {
+		return nil
+	}
+
+	mutation := `mutation($input: InitiativeRelationCreateInput!) {
+		initiativeRelationCreate(input: $input) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{
+		"input": InitiativeRelationCreateInput{InitiativeID: parentID, RelatedInitiativeID: initiativeID},
+	}
 
 	var result struct {
-		DocumentUnarchive struct {
+		InitiativeRelationCreate struct {
 			Success bool `json:"success"`
-		} `json:"documentUnarchive"`
+		} `json:"initiativeRelationCreate"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return err
 	}
 
-	if !result.DocumentUnarchive.Success {
-		return fmt.Errorf("failed to restore document")
+	if !result.InitiativeRelationCreate.Success {
+		return fmt.Errorf("failed to set parent initiative")
 	}
 
 	return nil
 }
 
-// SearchDocuments searches for documents
-func (c *Client) SearchDocuments(ctx context.Context, query string, limit int) (*DocumentSearchResponse, error) {
-	queryStr := fmt.Sprintf(`query {
-		searchDocuments(term: %q, first: %d) {
+// RemoveProjectFromInitiative removes a project from an initiative
+func (c *Client) RemoveProjectFromInitiative(ctx context.Context, initiativeID, projectID string) error {
+	// Query all initiativeToProject links
+	queryStr := `query {
+		initiativeToProjects {
 			nodes {
 				id
-				title
-				slugId
-				icon
-				url
-				updatedAt
-				creator {
+				initiative {
 					id
-					displayName
 				}
 				project {
 					id
-					name
 				}
 			}
-			totalCount
 		}
-	}`, query, limit)
+	}`
 
-	var result struct {
-		SearchDocuments struct {
+	var queryResult struct {
+		InitiativeToProjects struct {
 			Nodes []struct {
-				ID        string `json:"id"`
-				Title     string `json:"title"`
-				SlugID    string `json:"slugId"`
-				Icon      string `json:"icon"`
-				URL       string `json:"url"`
-				UpdatedAt string `json:"updatedAt"`
-				Creator   *struct {
-					ID          string `json:"id"`
-					DisplayName string `json:"displayName"`
-				} `json:"creator"`
-				Project *struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
+				ID         string `json:"id"`
+				Initiative struct {
+					ID string `json:"id"`
+				} `json:"initiative"`
+				Project struct {
+					ID string `json:"id"`
 				} `json:"project"`
 			} `json:"nodes"`
-			TotalCount int `json:"totalCount"`
-		} `json:"searchDocuments"`
+		} `json:"initiativeToProjects"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
-		return nil, err
+	if err := c.exec(ctx, queryStr, &queryResult, nil); err != nil {
+		return err
 	}
 
-	documents := make([]DocumentListItem, len(result.SearchDocuments.Nodes))
-	for i, d := range result.SearchDocuments.Nodes {
-		documents[i] = DocumentListItem{
-			ID:        d.ID,
-			Title:     d.Title,
-			SlugID:    d.SlugID,
-			Icon:      d.Icon,
-			URL:       d.URL,
-			UpdatedAt: d.UpdatedAt,
-			Creator:   d.Creator,
-			Project:   d.Project,
+	// Find the link ID for the specified initiative and project
+	var linkID string
+	for _, link := range queryResult.InitiativeToProjects.Nodes {
+		if link.Initiative.ID == initiativeID && link.Project.ID == projectID {
+			linkID = link.ID
+			break
 		}
 	}
 
-	return &DocumentSearchResponse{
-		Documents:  documents,
-		Count:      len(documents),
-		Query:      query,
-		TotalCount: result.SearchDocuments.TotalCount,
-	}, nil
+	if linkID == "" {
+		return fmt.Errorf("project not found in initiative")
+	}
+
+	// Delete the link
+	mutation := `mutation($id: String!) {
+		initiativeToProjectDelete(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": linkID}
+
+	var result struct {
+		InitiativeToProjectDelete struct {
+			Success bool `json:"success"`
+		} `json:"initiativeToProjectDelete"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.InitiativeToProjectDelete.Success {
+		return fmt.Errorf("failed to remove project from initiative")
+	}
+
+	return nil
 }
 
-// Initiative represents a Linear initiative
-type Initiative struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	Content     string `json:"content,omitempty"`
-	Status      string `json:"status"`
-	SlugID      string `json:"slugId"`
-	URL         string `json:"url"`
-	TargetDate  string `json:"targetDate,omitempty"`
-	CreatedAt   string `json:"createdAt"`
-	UpdatedAt   string `json:"updatedAt"`
-	Owner       *struct {
-		ID          string `json:"id"`
-		DisplayName string `json:"displayName"`
-	} `json:"owner,omitempty"`
-	Projects []struct {
+// InitiativeUpdate represents an initiative status update
+type InitiativeUpdate struct {
+	ID         string `json:"id"`
+	Body       string `json:"body"`
+	Health     string `json:"health,omitempty"`
+	CreatedAt  string `json:"createdAt"`
+	URL        string `json:"url,omitempty"`
+	Initiative *struct {
 		ID   string `json:"id"`
 		Name string `json:"name"`
-	} `json:"projects,omitempty"`
-}
-
-// InitiativeListItem represents an initiative in a list
-type InitiativeListItem struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Status      string `json:"status"`
-	SlugID      string `json:"slugId"`
-	URL         string `json:"url"`
-	TargetDate  string `json:"targetDate,omitempty"`
-	UpdatedAt   string `json:"updatedAt"`
-	Owner       *struct {
+	} `json:"initiative,omitempty"`
+	User *struct {
 		ID          string `json:"id"`
 		DisplayName string `json:"displayName"`
-	} `json:"owner,omitempty"`
-	ProjectCount int `json:"projectCount"`
+	} `json:"user,omitempty"`
 }
 
-// InitiativesResponse is the response for listing initiatives
-type InitiativesResponse struct {
-	Initiatives []InitiativeListItem `json:"initiatives"`
-	Count       int                  `json:"count"`
+// InitiativeUpdateCreateInput is the input for posting an initiative status
+// update
+type InitiativeUpdateCreateInput struct {
+	InitiativeID string `json:"initiativeId"`
+	Body         string `json:"body"`
+	Health       string `json:"health,omitempty"`
 }
 
-// InitiativeCreateInput is the input for creating an initiative
-type InitiativeCreateInput struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	Content     string `json:"content,omitempty"`
-	Status      string `json:"status,omitempty"`
-	OwnerID     string `json:"ownerId,omitempty"`
-	TargetDate  string `json:"targetDate,omitempty"`
+// InitiativeUpdatesResponse is the response for listing initiative updates
+type InitiativeUpdatesResponse struct {
+	Updates []InitiativeUpdate `json:"updates"`
+	Count   int                `json:"count"`
 }
 
-// InitiativeUpdateInput is the input for updating an initiative
-type InitiativeUpdateInput struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	Content     string `json:"content,omitempty"`
-	Status      string `json:"status,omitempty"`
-	OwnerID     string `json:"ownerId,omitempty"`
-	TargetDate  string `json:"targetDate,omitempty"`
+const initiativeUpdateFields = `
+	id
+	body
+	health
+	createdAt
+	url
+	initiative {
+		id
+		name
+	}
+	user {
+		id
+		displayName
+	}`
+
+// GetInitiativeUpdates fetches the latest status updates for an initiative
+func (c *Client) GetInitiativeUpdates(ctx context.Context, initiativeID string, limit int) (*InitiativeUpdatesResponse, error) {
+	queryStr := `query($id: String!, $first: Int) {
+		initiative(id: $id) {
+			initiativeUpdates(first: $first) {
+				nodes {` + initiativeUpdateFields + `
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{"id": initiativeID, "first": limit}
+
+	var result struct {
+		Initiative *struct {
+			InitiativeUpdates struct {
+				Nodes []InitiativeUpdate `json:"nodes"`
+			} `json:"initiativeUpdates"`
+		} `json:"initiative"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
+		return nil, err
+	}
+	if result.Initiative == nil {
+		return nil, &Error{Kind: ErrNotFound, Message: fmt.Sprintf("Initiative '%s' not found", initiativeID)}
+	}
+
+	updates := result.Initiative.InitiativeUpdates.Nodes
+	if updates == nil {
+		updates = []InitiativeUpdate{}
+	}
+	return &InitiativeUpdatesResponse{
+		Updates: updates,
+		Count:   len(updates),
+	}, nil
 }
 
-// GetInitiatives fetches initiatives
-func (c *Client) GetInitiatives(ctx context.Context, status string, ownerID string, limit int) (*InitiativesResponse, error) {
-	filterParts := []string{}
-	if status != "" {
-		filterParts = append(filterParts, fmt.Sprintf(`status: { eq: %q }`, status))
+// CreateInitiativeUpdate posts a status update on an initiative
+func (c *Client) CreateInitiativeUpdate(ctx context.Context, input InitiativeUpdateCreateInput) (*InitiativeUpdate, error) {
+	mutation := `mutation($input: InitiativeUpdateCreateInput!) {
+		initiativeUpdateCreate(input: $input) {
+			success
+			initiativeUpdate {` + initiativeUpdateFields + `
+			}
+		}
+	}`
+	variables := map[string]interface{}{"input": input}
+
+	var result struct {
+		InitiativeUpdateCreate struct {
+			Success          bool             `json:"success"`
+			InitiativeUpdate InitiativeUpdate `json:"initiativeUpdate"`
+		} `json:"initiativeUpdateCreate"`
 	}
-	if ownerID != "" {
-		filterParts = append(filterParts, fmt.Sprintf(`owner: { id: { eq: %q } }`, ownerID))
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
 	}
 
-	filterPart := ""
-	if len(filterParts) > 0 {
-		filterPart = fmt.Sprintf(`, filter: { %s }`, strings.Join(filterParts, ", "))
+	if !result.InitiativeUpdateCreate.Success {
+		return nil, fmt.Errorf("failed to create initiative update")
+	}
+
+	return &result.InitiativeUpdateCreate.InitiativeUpdate, nil
+}
+
+// CustomView is a saved issue view from the Linear web app
+type CustomView struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Shared      bool            `json:"shared"`
+	Team        *IssueTeam      `json:"team,omitempty"`
+	Owner       string          `json:"owner,omitempty"`
+	UpdatedAt   string          `json:"updatedAt"`
+	FilterData  json.RawMessage `json:"filterData,omitempty"`
+}
+
+// customViewNode decodes a custom view selection
+type customViewNode struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Shared      bool            `json:"shared"`
+	UpdatedAt   string          `json:"updatedAt"`
+	FilterData  json.RawMessage `json:"filterData"`
+	Team        *IssueTeam      `json:"team"`
+	Owner       *struct {
+		DisplayName string `json:"displayName"`
+	} `json:"owner"`
+}
+
+func (n customViewNode) view() CustomView {
+	view := CustomView{
+		ID:          n.ID,
+		Name:        n.Name,
+		Description: n.Description,
+		Shared:      n.Shared,
+		Team:        n.Team,
+		UpdatedAt:   n.UpdatedAt,
+		FilterData:  n.FilterData,
 	}
+	if n.Owner != nil {
+		view.Owner = n.Owner.DisplayName
+	}
+	return view
+}
 
+// GetCustomViews fetches a page of the custom views visible to the viewer
+func (c *Client) GetCustomViews(ctx context.Context, limit int, after string) ([]CustomView, *PageInfo, error) {
 	queryStr := fmt.Sprintf(`query {
-		initiatives(first: %d%s) {
+		customViews(first: %d%s) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
 			nodes {
 				id
 				name
-				status
-				slugId
-				targetDate
+				description
+				shared
 				updatedAt
-				owner {
+				filterData
+				team {
 					id
-					displayName
+					key
+					name
 				}
-				projects {
-					nodes {
-						id
-					}
+				owner {
+					displayName
 				}
 			}
 		}
-	}`, limit, filterPart)
+	}`, limit, afterArg(after))
 
 	var result struct {
-		Initiatives struct {
-			Nodes []struct {
-				ID         string `json:"id"`
-				Name       string `json:"name"`
-				Status     string `json:"status"`
-				SlugID     string `json:"slugId"`
-				TargetDate string `json:"targetDate"`
-				UpdatedAt  string `json:"updatedAt"`
-				Owner      *struct {
-					ID          string `json:"id"`
-					DisplayName string `json:"displayName"`
-				} `json:"owner"`
-				Projects struct {
-					Nodes []struct {
-						ID string `json:"id"`
-					} `json:"nodes"`
-				} `json:"projects"`
-			} `json:"nodes"`
-		} `json:"initiatives"`
+		CustomViews struct {
+			PageInfo PageInfo         `json:"pageInfo"`
+			Nodes    []customViewNode `json:"nodes"`
+		} `json:"customViews"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
-		return nil, err
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, nil, err
 	}
 
-	initiatives := make([]InitiativeListItem, len(result.Initiatives.Nodes))
-	for i, init := range result.Initiatives.Nodes {
-		initiatives[i] = InitiativeListItem{
-			ID:           init.ID,
-			Name:         init.Name,
-			Status:       init.Status,
-			SlugID:       init.SlugID,
-			TargetDate:   init.TargetDate,
-			UpdatedAt:    init.UpdatedAt,
-			Owner:        init.Owner,
-			ProjectCount: len(init.Projects.Nodes),
-		}
+	views := make([]CustomView, len(result.CustomViews.Nodes))
+	for i, n := range result.CustomViews.Nodes {
+		views[i] = n.view()
 	}
-
-	return &InitiativesResponse{
-		Initiatives: initiatives,
-		Count:       len(initiatives),
-	}, nil
+	return views, &result.CustomViews.PageInfo, nil
 }
 
-// GetInitiative fetches a single initiative by ID
-func (c *Client) GetInitiative(ctx context.Context, initiativeID string) (*Initiative, error) {
-	queryStr := fmt.Sprintf(`query {
-		initiative(id: %q) {
+// GetCustomViewIssues fetches a page of the issues matching a custom view's
+// filters, along with the view itself
+func (c *Client) GetCustomViewIssues(ctx context.Context, viewID string, limit int, after string) (*CustomView, *IssuesResponse, error) {
+	queryStr := fmt.Sprintf(`query($id: String!) {
+		customView(id: $id) {
 			id
 			name
 			description
-			content
-			status
-			slugId
-			targetDate
-			createdAt
+			shared
 			updatedAt
-			owner {
+			team {
 				id
+				key
+				name
+			}
+			owner {
 				displayName
 			}
-			projects {
+			issues(first: %d%s) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 				nodes {
-					id
-					name
+					%s
 				}
 			}
 		}
-	}`, initiativeID)
+	}`, limit, afterArg(after), issueListSelection)
+	variables := map[string]interface{}{"id": viewID}
 
 	var result struct {
-		Initiative *struct {
-			ID          string `json:"id"`
-			Name        string `json:"name"`
-			Description string `json:"description"`
-			Content     string `json:"content"`
-			Status      string `json:"status"`
-			SlugID      string `json:"slugId"`
-			TargetDate  string `json:"targetDate"`
-			CreatedAt   string `json:"createdAt"`
-			UpdatedAt   string `json:"updatedAt"`
-			Owner       *struct {
-				ID          string `json:"id"`
-				DisplayName string `json:"displayName"`
-			} `json:"owner"`
-			Projects struct {
-				Nodes []struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-				} `json:"nodes"`
-			} `json:"projects"`
-		} `json:"initiative"`
+		CustomView struct {
+			customViewNode
+			Issues struct {
+				PageInfo PageInfo        `json:"pageInfo"`
+				Nodes    []issueListNode `json:"nodes"`
+			} `json:"issues"`
+		} `json:"customView"`
+	}
+
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
+		return nil, nil, err
+	}
+
+	view := result.CustomView.view()
+	issues := make([]IssueListItem, len(result.CustomView.Issues.Nodes))
+	for i, n := range result.CustomView.Issues.Nodes {
+		issues[i] = n.item()
+	}
+	return &view, &IssuesResponse{
+		Issues:   issues,
+		Count:    len(issues),
+		PageInfo: &result.CustomView.Issues.PageInfo,
+	}, nil
+}
+
+// Webhook represents a Linear webhook
+type Webhook struct {
+	ID            string   `json:"id"`
+	Label         string   `json:"label,omitempty"`
+	URL           string   `json:"url"`
+	Enabled       bool     `json:"enabled"`
+	ResourceTypes []string `json:"resourceTypes"`
+}
+
+// WebhookCreateInput is the input for creating a webhook
+type WebhookCreateInput struct {
+	URL            string   `json:"url"`
+	Label          string   `json:"label,omitempty"`
+	Secret         string   `json:"secret,omitempty"`
+	TeamID         string   `json:"teamId,omitempty"`
+	AllPublicTeams bool     `json:"allPublicTeams,omitempty"`
+	ResourceTypes  []string `json:"resourceTypes"`
+}
+
+// CreateWebhook registers a webhook
+func (c *Client) CreateWebhook(ctx context.Context, input WebhookCreateInput) (*Webhook, error) {
+	mutation := `mutation($input: WebhookCreateInput!) {
+		webhookCreate(input: $input) {
+			success
+			webhook {
+				id
+				label
+				url
+				enabled
+				resourceTypes
+			}
+		}
+	}`
+	variables := map[string]interface{}{"input": input}
+
+	var result struct {
+		WebhookCreate struct {
+			Success bool    `json:"success"`
+			Webhook Webhook `json:"webhook"`
+		} `json:"webhookCreate"`
 	}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
 		return nil, err
 	}
 
-	if result.Initiative == nil {
-		return nil, nil
+	if !result.WebhookCreate.Success {
+		return nil, fmt.Errorf("failed to create webhook")
 	}
 
-	return &Initiative{
-		ID:          result.Initiative.ID,
-		Name:        result.Initiative.Name,
-		Description: result.Initiative.Description,
-		Content:     result.Initiative.Content,
-		Status:      result.Initiative.Status,
-		SlugID:      result.Initiative.SlugID,
-		TargetDate:  result.Initiative.TargetDate,
-		CreatedAt:   result.Initiative.CreatedAt,
-		UpdatedAt:   result.Initiative.UpdatedAt,
-		Owner:       result.Initiative.Owner,
-		Projects:    result.Initiative.Projects.Nodes,
-	}, nil
+	return &result.WebhookCreate.Webhook, nil
 }
 
-// CreateInitiative creates a new initiative
-func (c *Client) CreateInitiative(ctx context.Context, input InitiativeCreateInput) (*Initiative, error) {
-	inputParts := []string{
-		fmt.Sprintf(`name: %q`, input.Name),
+// DeleteWebhook deletes a webhook
+func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	mutation := `mutation($id: String!) {
+		webhookDelete(id: $id) {
+			success
+		}
+	}`
+	variables := map[string]interface{}{"id": webhookID}
+
+	var result struct {
+		WebhookDelete struct {
+			Success bool `json:"success"`
+		} `json:"webhookDelete"`
+	}
+
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return err
+	}
+
+	if !result.WebhookDelete.Success {
+		return fmt.Errorf("failed to delete webhook")
 	}
 
-	if input.Description != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`description: %q`, input.Description))
+	return nil
+}
+
+// Permission probe verdicts
+const (
+	ProbeAllowed = "allowed"
+	ProbeDenied  = "denied"
+	ProbeUnknown = "unknown"
+)
+
+// probeEntityID is a well-formed ID that matches no entity, so mutations
+// sent with it are authorized by the server but never change anything
+const probeEntityID = "00000000-0000-4000-8000-000000000000"
+
+// PermissionProbe dry-runs the API operation behind one CLI command
+type PermissionProbe struct {
+	Command   string `json:"command"`
+	Operation string `json:"operation"`
+	Write     bool   `json:"write"`
+	// TeamScoped probes run against the selected team instead of a
+	// placeholder ID
+	TeamScoped bool `json:"teamScoped"`
+	query      string
+}
+
+// ProbeResult is the server's verdict on a permission probe
+type ProbeResult struct {
+	Verdict string `json:"verdict"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// PermissionProbes lists the commands 'linear policy simulate' can check.
+// Mutations reference placeholder IDs, so a "not found" error means the
+// credential passed authorization.
+var PermissionProbes = []PermissionProbe{
+	{Command: "issue list", Operation: "team.issues", TeamScoped: true, query: `query($id: String!) {
+		team(id: $id) { issues(first: 1) { nodes { id } } }
+	}`},
+	{Command: "issue view", Operation: "issue", query: `query($id: String!) {
+		issue(id: $id) { id }
+	}`},
+	{Command: "issue create", Operation: "issueCreate", Write: true, query: `mutation($id: String!) {
+		issueCreate(input: { teamId: $id, title: "Permission probe" }) { success }
+	}`},
+	{Command: "issue update", Operation: "issueUpdate", Write: true, query: `mutation($id: String!) {
+		issueUpdate(id: $id, input: {}) { success }
+	}`},
+	{Command: "issue delete", Operation: "issueDelete", Write: true, query: `mutation($id: String!) {
+		issueDelete(id: $id) { success }
+	}`},
+	{Command: "issue relate", Operation: "issueRelationCreate", Write: true, query: `mutation($id: String!) {
+		issueRelationCreate(input: { issueId: $id, relatedIssueId: $id, type: blocks }) { success }
+	}`},
+	{Command: "comment create", Operation: "commentCreate", Write: true, query: `mutation($id: String!) {
+		commentCreate(input: { issueId: $id, body: "Permission probe" }) { success }
+	}`},
+	{Command: "comment update", Operation: "commentUpdate", Write: true, query: `mutation($id: String!) {
+		commentUpdate(id: $id, input: { body: "Permission probe" }) { success }
+	}`},
+	{Command: "comment delete", Operation: "commentDelete", Write: true, query: `mutation($id: String!) {
+		commentDelete(id: $id) { success }
+	}`},
+	{Command: "attachment create", Operation: "attachmentCreate", Write: true, query: `mutation($id: String!) {
+		attachmentCreate(input: { issueId: $id, title: "Permission probe", url: "https://example.com" }) { success }
+	}`},
+	{Command: "label list", Operation: "team.labels", TeamScoped: true, query: `query($id: String!) {
+		team(id: $id) { labels(first: 1) { nodes { id } } }
+	}`},
+	{Command: "label create", Operation: "issueLabelCreate", Write: true, query: `mutation($id: String!) {
+		issueLabelCreate(input: { teamId: $id, name: "Permission probe" }) { success }
+	}`},
+	{Command: "project list", Operation: "team.projects", TeamScoped: true, query: `query($id: String!) {
+		team(id: $id) { projects(first: 1) { nodes { id } } }
+	}`},
+	{Command: "project create", Operation: "projectCreate", Write: true, query: `mutation($id: String!) {
+		projectCreate(input: { teamIds: [$id], name: "Permission probe" }) { success }
+	}`},
+	{Command: "project update", Operation: "projectUpdate", Write: true, query: `mutation($id: String!) {
+		projectUpdate(id: $id, input: {}) { success }
+	}`},
+	{Command: "document create", Operation: "documentCreate", Write: true, query: `mutation($id: String!) {
+		documentCreate(input: { projectId: $id, title: "Permission probe" }) { success }
+	}`},
+	{Command: "document update", Operation: "documentUpdate", Write: true, query: `mutation($id: String!) {
+		documentUpdate(id: $id, input: {}) { success }
+	}`},
+	{Command: "webhook create", Operation: "webhookCreate", Write: true, query: `mutation($id: String!) {
+		webhookCreate(input: { teamId: $id, url: "https://example.invalid/linear-permission-probe" }) { success }
+	}`},
+}
+
+// ProbePermission runs a permission probe and classifies the response.
+// teamID is used by team-scoped read probes.
+func (c *Client) ProbePermission(ctx context.Context, probe PermissionProbe, teamID string) ProbeResult {
+	id := probeEntityID
+	if probe.TeamScoped && teamID != "" {
+		id = teamID
 	}
-	if input.Content != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`content: %q`, input.Content))
+
+	var result map[string]interface{}
+	err := c.exec(ctx, probe.query, &result, map[string]interface{}{"id": id})
+	if err == nil {
+		return ProbeResult{Verdict: ProbeAllowed}
 	}
-	if input.Status != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`status: %s`, input.Status))
+	return classifyProbeError(err)
+}
+
+// classifyProbeError decides whether an error means the operation was
+// authorized (it failed on the placeholder ID) or refused
+func classifyProbeError(err error) ProbeResult {
+	reason := err.Error()
+	switch {
+	case errors.Is(err, ErrUnauthorized), errors.Is(err, ErrForbidden):
+		return ProbeResult{Verdict: ProbeDenied, Reason: reason}
+	case errors.Is(err, ErrNotFound):
+		return ProbeResult{Verdict: ProbeAllowed, Reason: reason}
+	}
+
+	text := strings.ToLower(reason)
+	for _, marker := range []string{"forbidden", "not authorized", "scope", "access denied", "admin"} {
+		if strings.Contains(text, marker) {
+			return ProbeResult{Verdict: ProbeDenied, Reason: reason}
+		}
 	}
-	if input.OwnerID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`ownerId: %q`, input.OwnerID))
+	if strings.Contains(text, "does not exist") {
+		return ProbeResult{Verdict: ProbeAllowed, Reason: reason}
 	}
-	if input.TargetDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`targetDate: %q`, input.TargetDate))
+	return ProbeResult{Verdict: ProbeUnknown, Reason: reason}
+}
+
+// DashboardIssue is an issue listed on the personal dashboard
+type DashboardIssue struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	Priority   int    `json:"priority"`
+	DueDate    string `json:"dueDate,omitempty"`
+	UpdatedAt  string `json:"updatedAt"`
+	State      struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"state"`
+	Team struct {
+		Key string `json:"key"`
+	} `json:"team"`
+}
+
+// DashboardCycle is the active cycle of one of the viewer's teams
+type DashboardCycle struct {
+	Cycle
+	TeamKey             string `json:"teamKey"`
+	IssueCount          int    `json:"issueCount"`
+	CompletedIssueCount int    `json:"completedIssueCount"`
+}
+
+// Dashboard is everything 'linear me' shows, fetched in one request
+type Dashboard struct {
+	Viewer struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"viewer"`
+	Assigned []DashboardIssue `json:"assigned"`
+	Triage   []DashboardIssue `json:"triage"`
+	Cycles   []DashboardCycle `json:"cycles"`
+}
+
+// GetDashboard fetches the viewer's open assigned issues, the issues they
+// created that are still in triage, and their teams' active cycles
+func (c *Client) GetDashboard(ctx context.Context, limit int) (*Dashboard, error) {
+	issueFields := `
+				nodes {
+					id
+					identifier
+					title
+					url
+					priority
+					dueDate
+					updatedAt
+					state { name type }
+					team { key }
+				}`
+	query := `query($limit: Int!) {
+		viewer {
+			id
+			name
+			displayName
+			assignedIssues(first: $limit, orderBy: updatedAt, filter: { state: { type: { nin: ["completed", "canceled"] } } }) {` + issueFields + `
+			}
+			createdIssues(first: $limit, orderBy: updatedAt, filter: { state: { type: { eq: "triage" } } }) {` + issueFields + `
+			}
+			teams {
+				nodes {
+					key
+					activeCycle {
+						id
+						number
+						name
+						startsAt
+						endsAt
+						progress
+						issueCountHistory
+						completedIssueCountHistory
+					}
+				}
+			}
+		}
+	}`
+	variables := map[string]interface{}{"limit": limit}
+
+	var result struct {
+		Viewer struct {
+			ID             string `json:"id"`
+			Name           string `json:"name"`
+			DisplayName    string `json:"displayName"`
+			AssignedIssues struct {
+				Nodes []DashboardIssue `json:"nodes"`
+			} `json:"assignedIssues"`
+			CreatedIssues struct {
+				Nodes []DashboardIssue `json:"nodes"`
+			} `json:"createdIssues"`
+			Teams struct {
+				Nodes []struct {
+					Key         string `json:"key"`
+					ActiveCycle *struct {
+						Cycle
+						IssueCountHistory          []float64 `json:"issueCountHistory"`
+						CompletedIssueCountHistory []float64 `json:"completedIssueCountHistory"`
+					} `json:"activeCycle"`
+				} `json:"nodes"`
+			} `json:"teams"`
+		} `json:"viewer"`
 	}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		initiativeCreate(input: { %s }) {
-			success
-			initiative {
+	if err := c.exec(ctx, query, &result, variables); err != nil {
+		return nil, err
+	}
+
+	dashboard := &Dashboard{
+		Assigned: result.Viewer.AssignedIssues.Nodes,
+		Triage:   result.Viewer.CreatedIssues.Nodes,
+		Cycles:   []DashboardCycle{},
+	}
+	dashboard.Viewer.ID = result.Viewer.ID
+	dashboard.Viewer.Name = result.Viewer.Name
+	dashboard.Viewer.DisplayName = result.Viewer.DisplayName
+
+	for _, team := range result.Viewer.Teams.Nodes {
+		if team.ActiveCycle == nil {
+			continue
+		}
+		cycle := DashboardCycle{Cycle: team.ActiveCycle.Cycle, TeamKey: team.Key}
+		if h := team.ActiveCycle.IssueCountHistory; len(h) > 0 {
+			cycle.IssueCount = int(h[len(h)-1])
+		}
+		if h := team.ActiveCycle.CompletedIssueCountHistory; len(h) > 0 {
+			cycle.CompletedIssueCount = int(h[len(h)-1])
+		}
+		dashboard.Cycles = append(dashboard.Cycles, cycle)
+	}
+
+	return dashboard, nil
+}
+
+// StandupIssue is an issue considered for a standup summary
+type StandupIssue struct {
+	ID          string `json:"id"`
+	Identifier  string `json:"identifier"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	Priority    int    `json:"priority"`
+	UpdatedAt   string `json:"updatedAt"`
+	CompletedAt string `json:"completedAt,omitempty"`
+	State       struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"state"`
+	Team struct {
+		Key string `json:"key"`
+	} `json:"team"`
+	Labels struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	History struct {
+		Nodes []StandupHistoryEntry `json:"nodes"`
+	} `json:"history"`
+	InverseRelations struct {
+		Nodes []struct {
+			Type  string `json:"type"`
+			Issue struct {
+				Identifier string `json:"identifier"`
+				State      struct {
+					Type string `json:"type"`
+				} `json:"state"`
+			} `json:"issue"`
+		} `json:"nodes"`
+	} `json:"inverseRelations"`
+}
+
+// StandupHistoryEntry is a state change from an issue's history
+type StandupHistoryEntry struct {
+	CreatedAt string `json:"createdAt"`
+	Actor     *struct {
+		ID string `json:"id"`
+	} `json:"actor"`
+	FromState *struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"fromState"`
+	ToState *struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"toState"`
+}
+
+// StandupComment is a comment the user wrote, with the issue it is on
+type StandupComment struct {
+	ID        string `json:"id"`
+	Body      string `json:"body"`
+	URL       string `json:"url"`
+	CreatedAt string `json:"createdAt"`
+	Issue     *struct {
+		ID         string `json:"id"`
+		Identifier string `json:"identifier"`
+		Title      string `json:"title"`
+		URL        string `json:"url"`
+		State      struct {
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"state"`
+	} `json:"issue"`
+}
+
+// Standup is the raw material for a standup summary
+type Standup struct {
+	User struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"user"`
+	// Updated is the user's assigned issues updated since the start of
+	// the period, with their recent history
+	Updated []StandupIssue `json:"updated"`
+	// Open is the user's assigned issues that are started or unstarted
+	Open     []StandupIssue   `json:"open"`
+	Comments []StandupComment `json:"comments"`
+}
+
+// GetStandup fetches, in one request, a user's assigned issues updated
+// since the given RFC 3339 time (with their state history), their open assigned
+// issues, and the comments they wrote since then
+func (c *Client) GetStandup(ctx context.Context, userID string, since string, limit int) (*Standup, error) {
+	issueFields := `
+				nodes {
+					id
+					identifier
+					title
+					url
+					priority
+					updatedAt
+					completedAt
+					state { name type }
+					team { key }
+					labels { nodes { name } }
+					history(first: 25) {
+						nodes {
+							createdAt
+							actor { id }
+							fromState { name type }
+							toState { name type }
+						}
+					}
+					inverseRelations(first: 25) {
+						nodes {
+							type
+							issue { identifier state { type } }
+						}
+					}
+				}`
+	query := `query($userId: String!, $limit: Int!, $updated: IssueFilter, $open: IssueFilter, $comments: CommentFilter) {
+		user(id: $userId) {
+			id
+			name
+			displayName
+			updated: assignedIssues(first: $limit, orderBy: updatedAt, filter: $updated) {` + issueFields + `
+			}
+			open: assignedIssues(first: $limit, orderBy: updatedAt, filter: $open) {` + issueFields + `
+			}
+		}
+		comments(first: $limit, filter: $comments) {
+			nodes {
 				id
-				name
-				description
-				content
-				status
-				slugId
-				targetDate
+				body
+				url
 				createdAt
-				updatedAt
-				owner {
+				issue {
 					id
-					displayName
+					identifier
+					title
+					url
+					state { name type }
 				}
 			}
 		}
-	}`, strings.Join(inputParts, ", "))
-
-	var result struct {
-		InitiativeCreate struct {
-			Success    bool `json:"success"`
-			Initiative struct {
-				ID          string `json:"id"`
-				Name        string `json:"name"`
-				Description string `json:"description"`
-				Content     string `json:"content"`
-				Status      string `json:"status"`
-				SlugID      string `json:"slugId"`
-				TargetDate  string `json:"targetDate"`
-				CreatedAt   string `json:"createdAt"`
-				UpdatedAt   string `json:"updatedAt"`
-				Owner       *struct {
-					ID          string `json:"id"`
-					DisplayName string `json:"displayName"`
-				} `json:"owner"`
-			} `json:"initiative"`
-		} `json:"initiativeCreate"`
+	}`
+	variables := map[string]interface{}{
+		"userId": userID,
+		"limit":  limit,
+		"updated": map[string]interface{}{
+			"updatedAt": map[string]interface{}{"gte": since},
+		},
+		"open": map[string]interface{}{
+			"state": map[string]interface{}{"type": map[string]interface{}{"in": []string{"started", "unstarted"}}},
+		},
+		"comments": map[string]interface{}{
+			"user":      map[string]interface{}{"id": map[string]interface{}{"eq": userID}},
+			"createdAt": map[string]interface{}{"gte": since},
+		},
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+	var result struct {
+		User *struct {
+			ID          string `json:"id"`
+			Name        string `json:"name"`
+			DisplayName string `json:"displayName"`
+			Updated     struct {
+				Nodes []StandupIssue `json:"nodes"`
+			} `json:"updated"`
+			Open struct {
+				Nodes []StandupIssue `json:"nodes"`
+			} `json:"open"`
+		} `json:"user"`
+		Comments struct {
+			Nodes []StandupComment `json:"nodes"`
+		} `json:"comments"`
+	}
+
+	if err := c.exec(ctx, query, &result, variables); err != nil {
 		return nil, err
 	}
+	if result.User == nil {
+		return nil, nil
+	}
 
-	if !result.InitiativeCreate.Success {
-		return nil, fmt.Errorf("failed to create initiative")
+	standup := &Standup{
+		Updated:  result.User.Updated.Nodes,
+		Open:     result.User.Open.Nodes,
+		Comments: result.Comments.Nodes,
 	}
+	standup.User.ID = result.User.ID
+	standup.User.Name = result.User.Name
+	standup.User.DisplayName = result.User.DisplayName
+	return standup, nil
+}
 
-	return &Initiative{
-		ID:          result.InitiativeCreate.Initiative.ID,
-		Name:        result.InitiativeCreate.Initiative.Name,
-		Description: result.InitiativeCreate.Initiative.Description,
-		Content:     result.InitiativeCreate.Initiative.Content,
-		Status:      result.InitiativeCreate.Initiative.Status,
-		SlugID:      result.InitiativeCreate.Initiative.SlugID,
-		TargetDate:  result.InitiativeCreate.Initiative.TargetDate,
-		CreatedAt:   result.InitiativeCreate.Initiative.CreatedAt,
-		UpdatedAt:   result.InitiativeCreate.Initiative.UpdatedAt,
-		Owner:       result.InitiativeCreate.Initiative.Owner,
-	}, nil
+// Customer is a customer organization tracked in Linear
+type Customer struct {
+	ID                   string   `json:"id"`
+	Name                 string   `json:"name"`
+	SlugID               string   `json:"slugId,omitempty"`
+	Domains              []string `json:"domains"`
+	ExternalIDs          []string `json:"externalIds"`
+	Revenue              *float64 `json:"revenue,omitempty"`
+	Size                 *float64 `json:"size,omitempty"`
+	ApproximateNeedCount float64  `json:"approximateNeedCount"`
+	URL                  string   `json:"url,omitempty"`
+	Status               *struct {
+		Name string `json:"name"`
+	} `json:"status,omitempty"`
+	Tier *struct {
+		Name string `json:"name"`
+	} `json:"tier,omitempty"`
+	Owner *struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+	} `json:"owner,omitempty"`
 }
 
-// UpdateInitiative updates an existing initiative
-func (c *Client) UpdateInitiative(ctx context.Context, initiativeID string, input InitiativeUpdateInput) (*Initiative, error) {
-	inputParts := []string{}
+const customerFields = `
+	id
+	name
+	slugId
+	domains
+	externalIds
+	revenue
+	size
+	approximateNeedCount
+	url
+	status {
+		name
+	}
+	tier {
+		name
+	}
+	owner {
+		id
+		displayName
+	}`
 
-	if input.Name != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`name: %q`, input.Name))
-	}
-	if input.Description != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`description: %q`, input.Description))
-	}
-	if input.Content != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`content: %q`, input.Content))
-	}
-	if input.Status != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`status: %s`, input.Status))
-	}
-	if input.OwnerID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`ownerId: %q`, input.OwnerID))
-	}
-	if input.TargetDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`targetDate: %q`, input.TargetDate))
-	}
+// CustomerNeed is a customer request linked to an issue or project
+type CustomerNeed struct {
+	ID        string  `json:"id"`
+	Body      string  `json:"body,omitempty"`
+	Priority  float64 `json:"priority"`
+	CreatedAt string  `json:"createdAt"`
+	Customer  *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"customer,omitempty"`
+	Issue *struct {
+		ID         string `json:"id"`
+		Identifier string `json:"identifier"`
+		Title      string `json:"title"`
+		State      *struct {
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"state,omitempty"`
+	} `json:"issue,omitempty"`
+	Creator *struct {
+		DisplayName string `json:"displayName"`
+	} `json:"creator,omitempty"`
+	Attachment *struct {
+		URL string `json:"url"`
+	} `json:"attachment,omitempty"`
+}
 
-	if len(inputParts) == 0 {
-		return nil, fmt.Errorf("at least one field must be specified to update")
-	}
+// Important reports whether the need is marked important (priority 1)
+func (n CustomerNeed) Important() bool {
+	return n.Priority >= 1
+}
 
-	mutationStr := fmt.Sprintf(`mutation {
-		initiativeUpdate(id: %q, input: { %s }) {
-			success
-			initiative {
-				id
-				name
-				description
-				content
-				status
-				slugId
-				targetDate
-				createdAt
-				updatedAt
-				owner {
-					id
-					displayName
-				}
-			}
+const customerNeedFields = `
+	id
+	body
+	priority
+	createdAt
+	customer {
+		id
+		name
+	}
+	issue {
+		id
+		identifier
+		title
+		state {
+			name
+			type
 		}
-	}`, initiativeID, strings.Join(inputParts, ", "))
-
-	var result struct {
-		InitiativeUpdate struct {
-			Success    bool `json:"success"`
-			Initiative struct {
-				ID          string `json:"id"`
-				Name        string `json:"name"`
-				Description string `json:"description"`
-				Content     string `json:"content"`
-				Status      string `json:"status"`
-				SlugID      string `json:"slugId"`
-				TargetDate  string `json:"targetDate"`
-				CreatedAt   string `json:"createdAt"`
-				UpdatedAt   string `json:"updatedAt"`
-				Owner       *struct {
-					ID          string `json:"id"`
-					DisplayName string `json:"displayName"`
-				} `json:"owner"`
-			} `json:"initiative"`
-		} `json:"initiativeUpdate"`
 	}
-
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return nil, err
+	creator {
+		displayName
 	}
+	attachment {
+		url
+	}`
 
-	if !result.InitiativeUpdate.Success {
-		return nil, fmt.Errorf("failed to update initiative")
-	}
+// CustomerNeedFilter selects customer needs by customer and/or issue
+type CustomerNeedFilter struct {
+	CustomerID string
+	IssueID    string
+}
 
-	return &Initiative{
-		ID:          result.InitiativeUpdate.Initiative.ID,
-		Name:        result.InitiativeUpdate.Initiative.Name,
-		Description: result.InitiativeUpdate.Initiative.Description,
-		Content:     result.InitiativeUpdate.Initiative.Content,
-		Status:      result.InitiativeUpdate.Initiative.Status,
-		SlugID:      result.InitiativeUpdate.Initiative.SlugID,
-		TargetDate:  result.InitiativeUpdate.Initiative.TargetDate,
-		CreatedAt:   result.InitiativeUpdate.Initiative.CreatedAt,
-		UpdatedAt:   result.InitiativeUpdate.Initiative.UpdatedAt,
-		Owner:       result.InitiativeUpdate.Initiative.Owner,
-	}, nil
+// CustomerNeedCreateInput is the input for attaching a customer need to an
+// issue
+type CustomerNeedCreateInput struct {
+	CustomerID string  `json:"customerId"`
+	IssueID    string  `json:"issueId"`
+	Body       string  `json:"body,omitempty"`
+	Priority   float64 `json:"priority"`
 }
 
-// ArchiveInitiative archives an initiative
-func (c *Client) ArchiveInitiative(ctx context.Context, initiativeID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		initiativeArchive(id: %q) {
-			success
+// GetCustomers fetches a page of customers, ordered by name
+func (c *Client) GetCustomers(ctx context.Context, limit int, after string) ([]Customer, *PageInfo, error) {
+	queryStr := fmt.Sprintf(`query {
+		customers(first: %d%s, sorts: [{ name: { order: Ascending } }]) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {%s
+			}
 		}
-	}`, initiativeID)
+	}`, limit, afterArg(after), customerFields)
 
 	var result struct {
-		InitiativeArchive struct {
-			Success bool `json:"success"`
-		} `json:"initiativeArchive"`
-	}
-
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+		Customers struct {
+			PageInfo PageInfo   `json:"pageInfo"`
+			Nodes    []Customer `json:"nodes"`
+		} `json:"customers"`
 	}
 
-	if !result.InitiativeArchive.Success {
-		return fmt.Errorf("failed to archive initiative")
+	if err := c.exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, nil, err
 	}
-
-	return nil
+	return result.Customers.Nodes, &result.Customers.PageInfo, nil
 }
 
-// RestoreInitiative restores an archived initiative
-func (c *Client) RestoreInitiative(ctx context.Context, initiativeID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		initiativeUnarchive(id: %q) {
-			success
+// GetCustomer fetches a customer by ID or slug
+func (c *Client) GetCustomer(ctx context.Context, customerID string) (*Customer, error) {
+	queryStr := `query($id: String!) {
+		customer(id: $id) {` + customerFields + `
 		}
-	}`, initiativeID)
+	}`
+	variables := map[string]interface{}{"id": customerID}
 
 	var result struct {
-		InitiativeUnarchive struct {
-			Success bool `json:"success"`
-		} `json:"initiativeUnarchive"`
+		Customer *Customer `json:"customer"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
+		return nil, err
 	}
-
-	if !result.InitiativeUnarchive.Success {
-		return fmt.Errorf("failed to restore initiative")
+	if result.Customer == nil {
+		return nil, &Error{Kind: ErrNotFound, Message: fmt.Sprintf("Customer '%s' not found", customerID)}
 	}
-
-	return nil
+	return result.Customer, nil
 }
 
-// AddProjectToInitiative adds a project to an initiative
-func (c *Client) AddProjectToInitiative(ctx context.Context, initiativeID, projectID string) error {
-	mutationStr := fmt.Sprintf(`mutation {
-		initiativeToProjectCreate(input: { initiativeId: %q, projectId: %q }) {
-			success
+// FindCustomerByName returns the customer named name, case-insensitively,
+// or nil when there is none
+func (c *Client) FindCustomerByName(ctx context.Context, name string) (*Customer, error) {
+	queryStr := `query($name: String!) {
+		customers(first: 1, filter: { name: { eqIgnoreCase: $name } }) {
+			nodes {` + customerFields + `
+			}
 		}
-	}`, initiativeID, projectID)
+	}`
+	variables := map[string]interface{}{"name": name}
 
 	var result struct {
-		InitiativeToProjectCreate struct {
-			Success bool `json:"success"`
-		} `json:"initiativeToProjectCreate"`
+		Customers struct {
+			Nodes []Customer `json:"nodes"`
+		} `json:"customers"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
+		return nil, err
 	}
-
-	if !result.InitiativeToProjectCreate.Success {
-		return fmt.Errorf("failed to add project to initiative")
+	if len(result.Customers.Nodes) == 0 {
+		return nil, nil
 	}
-
-	return nil
+	return &result.Customers.Nodes[0], nil
 }
 
-// RemoveProjectFromInitiative removes a project from an initiative
-func (c *Client) RemoveProjectFromInitiative(ctx context.Context, initiativeID, projectID string) error {
-	// Query all initiativeToProject links
-	queryStr := `query {
-		initiativeToProjects {
-			nodes {
-				id
-				initiative {
-					id
-				}
-				project {
-					id
-				}
+// GetCustomerNeeds fetches a page of customer needs matching filter, newest
+// first
+func (c *Client) GetCustomerNeeds(ctx context.Context, filter CustomerNeedFilter, limit int, after string) ([]CustomerNeed, *PageInfo, error) {
+	queryStr := fmt.Sprintf(`query($filter: CustomerNeedFilter) {
+		customerNeeds(first: %d%s, filter: $filter) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {%s
 			}
 		}
-	}`
+	}`, limit, afterArg(after), customerNeedFields)
 
-	var queryResult struct {
-		InitiativeToProjects struct {
-			Nodes []struct {
-				ID         string `json:"id"`
-				Initiative struct {
-					ID string `json:"id"`
-				} `json:"initiative"`
-				Project struct {
-					ID string `json:"id"`
-				} `json:"project"`
-			} `json:"nodes"`
-		} `json:"initiativeToProjects"`
+	needFilter := map[string]interface{}{}
+	if filter.CustomerID != "" {
+		needFilter["customer"] = map[string]interface{}{"id": map[string]interface{}{"eq": filter.CustomerID}}
 	}
-
-	if err := c.graphql.Exec(ctx, queryStr, &queryResult, nil); err != nil {
-		return err
+	if filter.IssueID != "" {
+		needFilter["issue"] = map[string]interface{}{"id": map[string]interface{}{"eq": filter.IssueID}}
 	}
+	variables := map[string]interface{}{"filter": needFilter}
 
-	// Find the link ID for the specified initiative and project
-	var linkID string
-	for _, link := range queryResult.InitiativeToProjects.Nodes {
-		if link.Initiative.ID == initiativeID && link.Project.ID == projectID {
-			linkID = link.ID
-			break
-		}
+	var result struct {
+		CustomerNeeds struct {
+			PageInfo PageInfo       `json:"pageInfo"`
+			Nodes    []CustomerNeed `json:"nodes"`
+		} `json:"customerNeeds"`
 	}
 
-	if linkID == "" {
-		return fmt.Errorf("project not found in initiative")
+	if err := c.exec(ctx, queryStr, &result, variables); err != nil {
+		return nil, nil, err
 	}
+	return result.CustomerNeeds.Nodes, &result.CustomerNeeds.PageInfo, nil
+}
 
-	// Delete the link
-	mutationStr := fmt.Sprintf(`mutation {
-		initiativeToProjectDelete(id: %q) {
+// CreateCustomerNeed attaches a customer need to an issue
+func (c *Client) CreateCustomerNeed(ctx context.Context, input CustomerNeedCreateInput) (*CustomerNeed, error) {
+	mutation := `mutation($input: CustomerNeedCreateInput!) {
+		customerNeedCreate(input: $input) {
 			success
+			need {` + customerNeedFields + `
+			}
 		}
-	}`, linkID)
+	}`
+	variables := map[string]interface{}{"input": input}
 
 	var result struct {
-		InitiativeToProjectDelete struct {
-			Success bool `json:"success"`
-		} `json:"initiativeToProjectDelete"`
+		CustomerNeedCreate struct {
+			Success bool         `json:"success"`
+			Need    CustomerNeed `json:"need"`
+		} `json:"customerNeedCreate"`
 	}
 
-	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
-		return err
+	if err := c.exec(ctx, mutation, &result, variables); err != nil {
+		return nil, err
 	}
 
-	if !result.InitiativeToProjectDelete.Success {
-		return fmt.Errorf("failed to remove project from initiative")
+	if !result.CustomerNeedCreate.Success {
+		return nil, fmt.Errorf("failed to create customer need")
 	}
 
-	return nil
+	return &result.CustomerNeedCreate.Need, nil
 }
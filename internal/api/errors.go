@@ -0,0 +1,187 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+// Sentinel errors for the kinds of API failure commands handle
+// differently. Use errors.Is to test for them, and errors.As with *Error
+// for the details.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("permission denied")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrValidation   = errors.New("invalid input")
+)
+
+// Error is a failure reported by the Linear API
+type Error struct {
+	// Kind is one of the sentinel errors, or nil for other failures
+	Kind error
+	// Code is the error code from the GraphQL error extensions
+	Code string
+	// Message is the error message reported by the API
+	Message string
+	// Field is the input field a validation error refers to, if known
+	Field string
+	// StatusCode is the HTTP status of the response, if not 200
+	StatusCode int
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+// ErrorCode returns the code used for this error in JSON output, or ""
+// for failures of no particular kind
+func (e *Error) ErrorCode() string {
+	switch e.Kind {
+	case ErrNotFound:
+		return "NOT_FOUND"
+	case ErrUnauthorized:
+		return "UNAUTHORIZED"
+	case ErrForbidden:
+		return "FORBIDDEN"
+	case ErrRateLimited:
+		return "RATE_LIMITED"
+	case ErrValidation:
+		return "VALIDATION_ERROR"
+	}
+	return ""
+}
+
+// ErrorField returns the input field a validation error refers to
+func (e *Error) ErrorField() string {
+	return e.Field
+}
+
+// classifyError converts errors from the GraphQL client to *Error. Other
+// errors, such as network failures and cancellation, are returned as-is.
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var gqlErrs graphql.Errors
+	if !errors.As(err, &gqlErrs) || len(gqlErrs) == 0 {
+		return err
+	}
+
+	first := gqlErrs[0]
+	if code, _ := first.Extensions["code"].(string); code == graphql.ErrRequestError {
+		if status, body, ok := parseStatusError(first.Message); ok {
+			return classifyResponse(status, body)
+		}
+		// Transport failures are wrapped in a request_error too
+		if inner := errors.Unwrap(first); inner != nil {
+			return inner
+		}
+	}
+	return classifyGraphQLError(first, 0)
+}
+
+// parseStatusError splits the "<status>; body: <quoted body>" message the
+// GraphQL client produces for non-200 responses
+func parseStatusError(message string) (int, string, bool) {
+	status, quoted, ok := strings.Cut(message, "; body: ")
+	if !ok {
+		return 0, "", false
+	}
+	code, err := strconv.Atoi(strings.Fields(status + " ")[0])
+	if err != nil {
+		return 0, "", false
+	}
+	body, err := strconv.Unquote(quoted)
+	if err != nil {
+		body = quoted
+	}
+	return code, body, true
+}
+
+// classifyResponse classifies a non-200 response from its GraphQL errors,
+// falling back to the HTTP status
+func classifyResponse(status int, body string) *Error {
+	var resp struct {
+		Errors []graphql.Error `json:"errors"`
+	}
+	if json.Unmarshal([]byte(body), &resp) == nil && len(resp.Errors) > 0 {
+		return classifyGraphQLError(resp.Errors[0], status)
+	}
+
+	e := &Error{
+		Message:    fmt.Sprintf("unexpected response (HTTP %d): %s", status, strings.TrimSpace(body)),
+		StatusCode: status,
+	}
+	switch status {
+	case 401:
+		e.Kind = ErrUnauthorized
+	case 403:
+		e.Kind = ErrForbidden
+	case 404:
+		e.Kind = ErrNotFound
+	case 429:
+		e.Kind = ErrRateLimited
+	}
+	return e
+}
+
+// classifyGraphQLError maps a GraphQL error to *Error using its extension
+// code and type, and the message for errors Linear reports generically
+func classifyGraphQLError(gqlErr graphql.Error, status int) *Error {
+	ext := gqlErr.Extensions
+	code, _ := ext["code"].(string)
+	errType, _ := ext["type"].(string)
+
+	e := &Error{
+		Code:       code,
+		Message:    gqlErr.Message,
+		StatusCode: status,
+	}
+	if e.Message == "" {
+		e.Message, _ = ext["userPresentableMessage"].(string)
+	}
+
+	lowerMessage := strings.ToLower(gqlErr.Message)
+	switch {
+	case code == "RATELIMITED" || status == 429:
+		e.Kind = ErrRateLimited
+	case code == "AUTHENTICATION_ERROR" || errType == "authentication error" || status == 401:
+		e.Kind = ErrUnauthorized
+	case code == "FORBIDDEN" || errType == "forbidden" || strings.Contains(lowerMessage, "permission") || status == 403:
+		e.Kind = ErrForbidden
+	case strings.Contains(lowerMessage, "not found") || strings.Contains(lowerMessage, "could not find"):
+		e.Kind = ErrNotFound
+	case code == "INPUT_ERROR" || code == "INVALID_INPUT" || code == "GRAPHQL_VALIDATION_FAILED" || errType == "invalid input":
+		e.Kind = ErrValidation
+		e.Field = errorField(gqlErr)
+	}
+	return e
+}
+
+// errorField returns the input field a validation error points at, from
+// the error extensions
+func errorField(gqlErr graphql.Error) string {
+	for _, key := range []string{"argumentPath", "field"} {
+		if field, ok := gqlErr.Extensions[key].(string); ok && field != "" {
+			return field
+		}
+	}
+	if errs, ok := gqlErr.Extensions["validationErrors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			if prop, ok := first["property"].(string); ok {
+				return prop
+			}
+		}
+	}
+	return ""
+}
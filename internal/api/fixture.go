@@ -0,0 +1,164 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces secret variable values in fixtures
+const RedactedValue = "[redacted]"
+
+// Fixture is one recorded GraphQL exchange: the request a client sent and
+// the response the API returned to it
+type Fixture struct {
+	// Operation describes the request, such as "query issues"
+	Operation string                 `json:"operation"`
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	Status    int                    `json:"status"`
+	Response  json.RawMessage        `json:"response"`
+}
+
+// OperationOf describes a GraphQL document the way fixtures and request
+// logs name it, such as "query issues" or "mutation issueCreate"
+func OperationOf(query string) string {
+	return operationName(query)
+}
+
+// recordDir is where clients record fixtures; empty disables recording
+var recordDir string
+
+// SetRecordDir makes clients created afterwards save every GraphQL
+// exchange as a fixture file in dir, for replaying against a mock server.
+// Response caching is disabled while recording so nothing is missed.
+func SetRecordDir(dir string) error {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return fmt.Errorf("failed to create fixture directory: %w", err)
+		}
+	}
+	recordDir = dir
+	return nil
+}
+
+// recordTransport saves each exchange over base as a fixture. It sits
+// below retryTransport so retried attempts are recorded in order.
+type recordTransport struct {
+	base http.RoundTripper
+	dir  string
+}
+
+// newRecordTransport wraps base with recording when it is enabled
+func newRecordTransport(base http.RoundTripper) http.RoundTripper {
+	if recordDir == "" {
+		return base
+	}
+	return &recordTransport{base: base, dir: recordDir}
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var payload struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if body := peekBody(req); body == nil || json.Unmarshal(body, &payload) != nil || payload.Query == "" {
+		return t.base.RoundTrip(req)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil || !json.Valid(data) {
+		return resp, nil
+	}
+
+	fixture := Fixture{
+		Operation: operationName(payload.Query),
+		Query:     payload.Query,
+		Variables: redactVariables(payload.Variables),
+		Status:    resp.StatusCode,
+		Response:  data,
+	}
+	if err := writeFixture(t.dir, fixture); err != nil {
+		logf("failed to record %s: %v", fixture.Operation, err)
+	}
+	return resp, nil
+}
+
+// redactVariables hides secrets in recorded variables; replay treats a
+// redacted value as matching anything
+func redactVariables(variables map[string]interface{}) map[string]interface{} {
+	if variables == nil {
+		return nil
+	}
+	return redact(variables).(map[string]interface{})
+}
+
+var (
+	fixtureMu   sync.Mutex
+	fixtureNext int
+	// fixtureNamePattern matches the characters kept in fixture file names
+	fixtureNamePattern = regexp.MustCompile(`[^A-Za-z0-9]+`)
+)
+
+// writeFixture saves fixture in dir as NNNN-operation.json, numbered after
+// the fixtures already there so replay keeps the recorded order
+func writeFixture(dir string, fixture Fixture) error {
+	fixtureMu.Lock()
+	defer fixtureMu.Unlock()
+
+	if fixtureNext == 0 {
+		existing, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+		fixtureNext = len(existing) + 1
+	}
+	name := fmt.Sprintf("%04d-%s.json", fixtureNext, strings.Trim(fixtureNamePattern.ReplaceAllString(fixture.Operation, "-"), "-"))
+	fixtureNext++
+
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, name), append(data, '\n'), 0600)
+}
+
+// ReadFixtures loads the fixtures in dir in recorded order
+func ReadFixtures(dir string) ([]Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	fixtures := make([]Fixture, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture: %w", err)
+		}
+		var fixture Fixture
+		if err := json.Unmarshal(data, &fixture); err != nil {
+			return nil, fmt.Errorf("failed to parse fixture %s: %w", filepath.Base(path), err)
+		}
+		if fixture.Operation == "" {
+			fixture.Operation = operationName(fixture.Query)
+		}
+		if fixture.Status == 0 {
+			fixture.Status = http.StatusOK
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, nil
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogLevel controls request logging to stderr
+type LogLevel int
+
+const (
+	// LogOff disables request logging
+	LogOff LogLevel = iota
+	// LogDebug logs one line per request: operation, status, latency and
+	// rate limit budget, plus retries
+	LogDebug
+	// LogTrace also logs request variables, with secrets redacted
+	LogTrace
+)
+
+var (
+	logLevel            = LogOff
+	logOutput io.Writer = os.Stderr
+)
+
+// SetLogLevel sets request logging for clients created afterwards
+func SetLogLevel(level LogLevel) {
+	logLevel = level
+}
+
+// ParseLogLevel parses a LINEAR_LOG value: off, debug or trace
+func ParseLogLevel(value string) (LogLevel, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "off", "none":
+		return LogOff, true
+	case "debug", "1", "true":
+		return LogDebug, true
+	case "trace":
+		return LogTrace, true
+	}
+	return LogOff, false
+}
+
+// logf writes a log line when logging is enabled
+func logf(format string, args ...interface{}) {
+	if logLevel < LogDebug {
+		return
+	}
+	fmt.Fprintf(logOutput, "[linear] "+format+"\n", args...)
+}
+
+// logTransport logs each HTTP attempt; it sits below retryTransport so
+// retried attempts are logged individually
+type logTransport struct {
+	base http.RoundTripper
+}
+
+// newLogTransport wraps base with request logging when it is enabled
+func newLogTransport(base http.RoundTripper) http.RoundTripper {
+	if logLevel < LogDebug {
+		return base
+	}
+	return &logTransport{base: base}
+}
+
+func (t *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	op := req.Method + " " + req.URL.Host + req.URL.Path
+	var variables map[string]interface{}
+
+	if body := peekBody(req); body != nil {
+		var payload struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if json.Unmarshal(body, &payload) == nil && payload.Query != "" {
+			op = operationName(payload.Query)
+			variables = payload.Variables
+		}
+	}
+
+	if logLevel >= LogTrace && len(variables) > 0 {
+		data, _ := json.Marshal(redact(variables))
+		logf("%s variables: %s", op, data)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		logf("%s failed after %s: %v", op, elapsed, err)
+		return nil, err
+	}
+
+	line := fmt.Sprintf("%s -> %d in %s", op, resp.StatusCode, elapsed)
+	if rl := parseRateLimit(resp.Header); rl != nil {
+		line += fmt.Sprintf(" (requests %d/%d, complexity %d/%d, cost %d)",
+			rl.RequestsRemaining, rl.RequestsLimit, rl.ComplexityRemaining, rl.ComplexityLimit, rl.LastComplexity)
+	}
+	logf("%s", line)
+	return resp, nil
+}
+
+// peekBody returns the request body without consuming it
+func peekBody(req *http.Request) []byte {
+	if req.Body == nil {
+		return nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil
+		}
+		defer body.Close()
+		data, _ := io.ReadAll(body)
+		return data
+	}
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+var (
+	operationPattern = regexp.MustCompile(`^\s*(query|mutation|subscription)?\s*(\w+)?`)
+	rootFieldPattern = regexp.MustCompile(`\{\s*(?:\w+\s*:\s*)?(\w+)`)
+)
+
+// operationName describes a GraphQL document as its operation type and
+// name, or its first root field for anonymous operations, such as
+// "query issues" or "mutation issueCreate"
+func operationName(query string) string {
+	kind, name := "query", ""
+	if m := operationPattern.FindStringSubmatch(query); m != nil {
+		if m[1] != "" {
+			kind = m[1]
+		}
+		name = m[2]
+	}
+	if name == "" {
+		if m := rootFieldPattern.FindStringSubmatch(query); m != nil {
+			name = m[1]
+		}
+	}
+	if name == "" {
+		return kind
+	}
+	return kind + " " + name
+}
+
+// secretKeyPattern matches variable names whose values are redacted
+var secretKeyPattern = regexp.MustCompile(`(?i)secret|token|password|authorization|apikey|api_key`)
+
+// redact replaces the values of secret-looking keys, recursively
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, inner := range v {
+			if secretKeyPattern.MatchString(key) {
+				out[key] = RedactedValue
+				continue
+			}
+			out[key] = redact(inner)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, inner := range v {
+			out[i] = redact(inner)
+		}
+		return out
+	}
+	return value
+}
@@ -0,0 +1,319 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/state"
+)
+
+// ReadCacheOptions controls the response cache for idempotent queries:
+// the viewer, teams, workflow states, labels and users
+type ReadCacheOptions struct {
+	// TTL is how long a response is reused; 0 disables the cache
+	TTL time.Duration
+	// Refresh skips cached responses but still stores the fresh ones
+	Refresh bool
+	// Dir holds responses shared with other processes. Without it
+	// responses are only shared within the process.
+	Dir string
+}
+
+// DefaultReadCacheTTL is short: the cache saves the repeated lookups of a
+// single command or a burst of agent calls, not data that should be synced
+const DefaultReadCacheTTL = time.Minute
+
+// ReadCacheStatsFile holds the cumulative counters in the cache directory
+const ReadCacheStatsFile = "stats.json"
+
+var readCacheOptions = ReadCacheOptions{TTL: DefaultReadCacheTTL}
+
+// SetReadCacheOptions sets the response cache of clients created
+// afterwards
+func SetReadCacheOptions(opts ReadCacheOptions) {
+	readCacheOptions = opts
+}
+
+// ReadCacheStats counts response cache lookups
+type ReadCacheStats struct {
+	Hits   int `json:"hits"`
+	Misses int `json:"misses"`
+	// Refreshes are lookups skipped by --refresh or Fresh
+	Refreshes int `json:"refreshes"`
+	// Invalidations count mutations that dropped the cached responses
+	Invalidations int       `json:"invalidations"`
+	Since         time.Time `json:"since,omitempty"`
+}
+
+// HitRate is the share of lookups answered from the cache
+func (s ReadCacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses + s.Refreshes
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+func (s *ReadCacheStats) add(other ReadCacheStats) {
+	s.Hits += other.Hits
+	s.Misses += other.Misses
+	s.Refreshes += other.Refreshes
+	s.Invalidations += other.Invalidations
+}
+
+// readCache is shared by every client in the process
+var readCache = struct {
+	mu      sync.Mutex
+	entries map[string]cachedResponse
+	stats   ReadCacheStats
+}{entries: map[string]cachedResponse{}}
+
+// cachedResponse is a stored response body, in memory and on disk
+type cachedResponse struct {
+	StoredAt time.Time       `json:"storedAt"`
+	Body     json.RawMessage `json:"body"`
+}
+
+type (
+	readCacheKey struct{}
+	freshKey     struct{}
+)
+
+// cacheable marks a query as idempotent so its response may be served
+// from the read cache
+func cacheable(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readCacheKey{}, true)
+}
+
+// Fresh makes queries made with ctx skip cached responses, as --refresh
+// does, for commands whose point is to fetch current data
+func Fresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, freshKey{}, true)
+}
+
+// cacheTransport answers cacheable queries from the read cache and drops
+// the cache on every mutation, so a command never reads back stale data
+// after its own writes
+type cacheTransport struct {
+	base http.RoundTripper
+	opts ReadCacheOptions
+}
+
+// newCacheTransport wraps base with the read cache when it is enabled
+func newCacheTransport(base http.RoundTripper) http.RoundTripper {
+	if readCacheOptions.TTL <= 0 {
+		return base
+	}
+	return &cacheTransport{base: base, opts: readCacheOptions}
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := peekBody(req)
+	if isMutation(body) {
+		t.invalidate()
+		return t.base.RoundTrip(req)
+	}
+	if on, _ := req.Context().Value(readCacheKey{}).(bool); !on || body == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	key := responseKey(req.Header.Get("Authorization"), req.URL.String(), body)
+	if fresh, _ := req.Context().Value(freshKey{}).(bool); fresh || t.opts.Refresh {
+		t.count(func(s *ReadCacheStats) { s.Refreshes++ })
+	} else if cached, ok := t.lookup(key); ok {
+		t.count(func(s *ReadCacheStats) { s.Hits++ })
+		logf("%s served from cache (%s old)", operationName(queryOf(body)), time.Since(cached.StoredAt).Round(time.Second))
+		return &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(cached.Body)),
+			Request:    req,
+		}, nil
+	} else {
+		t.count(func(s *ReadCacheStats) { s.Misses++ })
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if hasGraphQLErrors(data) {
+		return resp, nil
+	}
+	t.store(key, cachedResponse{StoredAt: time.Now(), Body: data})
+	return resp, nil
+}
+
+// lookup returns a fresh response from memory, then from disk
+func (t *cacheTransport) lookup(key string) (cachedResponse, bool) {
+	readCache.mu.Lock()
+	entry, ok := readCache.entries[key]
+	readCache.mu.Unlock()
+	if ok && time.Since(entry.StoredAt) <= t.opts.TTL {
+		return entry, true
+	}
+	if t.opts.Dir == "" {
+		return cachedResponse{}, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(t.opts.Dir, key+".json"))
+	if err != nil || json.Unmarshal(data, &entry) != nil {
+		return cachedResponse{}, false
+	}
+	if time.Since(entry.StoredAt) > t.opts.TTL {
+		return cachedResponse{}, false
+	}
+	readCache.mu.Lock()
+	readCache.entries[key] = entry
+	readCache.mu.Unlock()
+	return entry, true
+}
+
+// store saves a response in memory and, best effort, on disk
+func (t *cacheTransport) store(key string, entry cachedResponse) {
+	readCache.mu.Lock()
+	readCache.entries[key] = entry
+	readCache.mu.Unlock()
+	if t.opts.Dir == "" {
+		return
+	}
+	if data, err := json.Marshal(entry); err == nil {
+		state.WriteFile(filepath.Join(t.opts.Dir, key+".json"), data)
+	}
+}
+
+// invalidate drops every cached response, in memory and on disk
+func (t *cacheTransport) invalidate() {
+	readCache.mu.Lock()
+	readCache.entries = map[string]cachedResponse{}
+	readCache.stats.Invalidations++
+	readCache.mu.Unlock()
+	if t.opts.Dir == "" {
+		return
+	}
+	ClearReadCache(t.opts.Dir)
+}
+
+func (t *cacheTransport) count(update func(*ReadCacheStats)) {
+	readCache.mu.Lock()
+	update(&readCache.stats)
+	readCache.mu.Unlock()
+}
+
+// ClearReadCache removes the cached responses in dir, keeping the stats,
+// and returns how many it removed
+func ClearReadCache(dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	removed := 0
+	for _, entry := range entries {
+		name := entry.Name()
+		if filepath.Ext(name) != ".json" || name == ReadCacheStatsFile {
+			continue
+		}
+		if os.Remove(filepath.Join(dir, name)) == nil {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// LoadReadCacheStats reads the counters saved in dir by earlier runs
+func LoadReadCacheStats(dir string) (ReadCacheStats, error) {
+	var stats ReadCacheStats
+	data, err := os.ReadFile(filepath.Join(dir, ReadCacheStatsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return stats, nil
+		}
+		return stats, err
+	}
+	if err := json.Unmarshal(data, &stats); err != nil {
+		return ReadCacheStats{}, nil
+	}
+	return stats, nil
+}
+
+// SaveReadCacheStats adds this process's counters to those saved in the
+// cache directory. It is called once, as the process exits.
+func SaveReadCacheStats() error {
+	dir := readCacheOptions.Dir
+	readCache.mu.Lock()
+	run := readCache.stats
+	readCache.stats = ReadCacheStats{}
+	readCache.mu.Unlock()
+	if dir == "" || run == (ReadCacheStats{}) {
+		return nil
+	}
+
+	stats, err := LoadReadCacheStats(dir)
+	if err != nil {
+		return err
+	}
+	if stats.Since.IsZero() {
+		stats.Since = time.Now().UTC()
+	}
+	stats.add(run)
+	data, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return err
+	}
+	return state.WriteFile(filepath.Join(dir, ReadCacheStatsFile), data)
+}
+
+// responseKey identifies a response by the credentials, endpoint and
+// request body, so workspaces never share entries
+func responseKey(authorization, endpoint string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(authorization))
+	h.Write([]byte{0})
+	h.Write([]byte(endpoint))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+// queryOf returns the query document of a GraphQL request body
+func queryOf(body []byte) string {
+	var payload struct {
+		Query string `json:"query"`
+	}
+	json.Unmarshal(body, &payload)
+	return payload.Query
+}
+
+// isMutation reports whether a GraphQL request body is a mutation
+func isMutation(body []byte) bool {
+	return strings.HasPrefix(strings.TrimSpace(queryOf(body)), "mutation")
+}
+
+// hasGraphQLErrors reports whether a response reports errors, which are
+// never cached
+func hasGraphQLErrors(body []byte) bool {
+	var payload struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	return json.Unmarshal(body, &payload) != nil || len(payload.Errors) > 0
+}
@@ -0,0 +1,301 @@
+package api
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryOptions controls how requests are retried after rate limiting and
+// transient server errors
+type RetryOptions struct {
+	// MaxRetries is the number of retries after the first attempt; 0
+	// disables retrying
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry, doubled on each
+	// following one
+	BaseDelay time.Duration
+	// MaxDelay caps a single wait. A rate limit that resets later than
+	// this is returned to the caller instead of being waited out.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryOptions are used unless SetRetryOptions is called
+var DefaultRetryOptions = RetryOptions{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   30 * time.Second,
+}
+
+var retryOptions = DefaultRetryOptions
+
+// SetRetryOptions sets the retry behavior of clients created afterwards
+func SetRetryOptions(opts RetryOptions) {
+	retryOptions = opts
+}
+
+// RateLimit is the request and complexity budget reported by the API in
+// the X-RateLimit-* headers of the most recent response
+type RateLimit struct {
+	RequestsLimit       int       `json:"requestsLimit"`
+	RequestsRemaining   int       `json:"requestsRemaining"`
+	RequestsReset       time.Time `json:"requestsReset"`
+	ComplexityLimit     int       `json:"complexityLimit"`
+	ComplexityRemaining int       `json:"complexityRemaining"`
+	ComplexityReset     time.Time `json:"complexityReset"`
+	// LastComplexity is the complexity charged for the last request
+	LastComplexity int `json:"lastComplexity"`
+}
+
+// parseRateLimit reads the rate limit headers, returning nil when the
+// response carries none
+func parseRateLimit(h http.Header) *RateLimit {
+	if h.Get("X-RateLimit-Requests-Limit") == "" && h.Get("X-RateLimit-Complexity-Limit") == "" {
+		return nil
+	}
+	return &RateLimit{
+		RequestsLimit:       headerInt(h, "X-RateLimit-Requests-Limit"),
+		RequestsRemaining:   headerInt(h, "X-RateLimit-Requests-Remaining"),
+		RequestsReset:       headerMillis(h, "X-RateLimit-Requests-Reset"),
+		ComplexityLimit:     headerInt(h, "X-RateLimit-Complexity-Limit"),
+		ComplexityRemaining: headerInt(h, "X-RateLimit-Complexity-Remaining"),
+		ComplexityReset:     headerMillis(h, "X-RateLimit-Complexity-Reset"),
+		LastComplexity:      headerInt(h, "X-Complexity"),
+	}
+}
+
+func headerInt(h http.Header, key string) int {
+	n, _ := strconv.Atoi(h.Get(key))
+	return n
+}
+
+// headerMillis parses a header holding a Unix timestamp in milliseconds
+func headerMillis(h http.Header, key string) time.Time {
+	ms, err := strconv.ParseInt(h.Get(key), 10, 64)
+	if err != nil || ms <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// exhaustedUntil returns when the budget refills if either budget is used
+// up, or the zero time otherwise
+func (r *RateLimit) exhaustedUntil() time.Time {
+	var until time.Time
+	if r.RequestsLimit > 0 && r.RequestsRemaining <= 0 && r.RequestsReset.After(until) {
+		until = r.RequestsReset
+	}
+	if r.ComplexityLimit > 0 && r.ComplexityRemaining <= 0 && r.ComplexityReset.After(until) {
+		until = r.ComplexityReset
+	}
+	return until
+}
+
+// retryTransport retries rate-limited and transient failures with
+// exponential backoff and jitter, and records the latest rate limit
+// headers. Mutations are retried only after rate limiting.
+type retryTransport struct {
+	base http.RoundTripper
+	opts RetryOptions
+
+	mu   sync.Mutex
+	last *RateLimit
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A mutation that failed on the network or with a server error may
+	// still have been applied, and repeating it could create a second
+	// issue or comment. Only a rate limit proves it was not.
+	mutation := isMutation(peekBody(req))
+
+	// Buffer the body so it can be replayed on each attempt
+	var body []byte
+	if req.Body != nil && req.GetBody == nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = data
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := t.waitForBudget(req); err != nil {
+			return nil, err
+		}
+
+		attemptReq := req
+		if attempt > 0 || body != nil {
+			attemptReq = req.Clone(req.Context())
+			switch {
+			case body != nil:
+				attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+			case req.GetBody != nil:
+				b, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body = b
+			}
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if err != nil {
+			if attempt >= t.opts.MaxRetries || req.Context().Err() != nil || mutation {
+				return nil, err
+			}
+			delay := t.backoff(attempt)
+			logf("retry %d/%d in %s after %v", attempt+1, t.opts.MaxRetries, delay.Round(time.Millisecond), err)
+			if werr := sleepContext(req, delay); werr != nil {
+				return nil, werr
+			}
+			continue
+		}
+
+		rl := parseRateLimit(resp.Header)
+		if rl != nil {
+			t.mu.Lock()
+			t.last = rl
+			t.mu.Unlock()
+		}
+
+		limited, err := isRateLimited(resp)
+		if err != nil {
+			return nil, err
+		}
+		if !limited && (mutation || !isTransient(resp.StatusCode)) {
+			return resp, nil
+		}
+		if attempt >= t.opts.MaxRetries {
+			return resp, nil
+		}
+
+		delay := t.backoff(attempt)
+		if d, ok := retryAfter(resp.Header); ok {
+			delay = d
+		} else if limited && rl != nil {
+			if until := rl.exhaustedUntil(); !until.IsZero() {
+				delay = time.Until(until)
+			}
+		}
+		if delay > t.opts.MaxDelay {
+			// Waiting this out would look like a hang; report it instead
+			logf("not retrying: wait of %s exceeds %s", delay.Round(time.Second), t.opts.MaxDelay)
+			return resp, nil
+		}
+		logf("retry %d/%d in %s after status %d", attempt+1, t.opts.MaxRetries, delay.Round(time.Millisecond), resp.StatusCode)
+
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if err := sleepContext(req, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// RateLimit returns the budget reported by the last response, or nil if
+// no response has carried rate limit headers yet
+func (t *retryTransport) RateLimit() *RateLimit {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.last == nil {
+		return nil
+	}
+	rl := *t.last
+	return &rl
+}
+
+// waitForBudget sleeps until the budget resets when the last response
+// reported it exhausted and the reset is near enough to wait for
+func (t *retryTransport) waitForBudget(req *http.Request) error {
+	rl := t.RateLimit()
+	if rl == nil {
+		return nil
+	}
+	until := rl.exhaustedUntil()
+	if until.IsZero() {
+		return nil
+	}
+	delay := time.Until(until)
+	if delay <= 0 || delay > t.opts.MaxDelay {
+		return nil
+	}
+	return sleepContext(req, delay)
+}
+
+// backoff returns the wait before retry attempt+1: exponential from
+// BaseDelay, capped at MaxDelay, with equal jitter
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	delay := t.opts.BaseDelay << attempt
+	if delay <= 0 || delay > t.opts.MaxDelay {
+		delay = t.opts.MaxDelay
+	}
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isTransient reports whether a status code is worth retrying
+func isTransient(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isRateLimited reports whether the API rejected the request for rate
+// limiting. Linear answers with HTTP 400 and a RATELIMITED error code, so
+// the body of 400 responses is inspected and restored for the caller.
+func isRateLimited(resp *http.Response) (bool, error) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		return false, nil
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return false, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return bytes.Contains(data, []byte("RATELIMITED")), nil
+}
+
+// retryAfter parses a Retry-After header given in seconds or as a date
+func retryAfter(h http.Header) (time.Duration, bool) {
+	value := h.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until the request is cancelled
+func sleepContext(req *http.Request, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// TransportOptions controls how connections to Linear are made, for
+// networks that route traffic through a proxy or inspect TLS
+type TransportOptions struct {
+	// Proxy is the URL of the proxy for all requests. When empty,
+	// HTTPS_PROXY, HTTP_PROXY and NO_PROXY are honored.
+	Proxy string
+	// CABundle is a PEM file of certificates trusted in addition to the
+	// system roots, such as a corporate proxy's CA
+	CABundle string
+	// Insecure disables TLS certificate verification
+	Insecure bool
+}
+
+// SetTransportOptions applies opts to http.DefaultTransport, which API
+// clients, token refreshes, file transfers and the daemon all build on, so
+// a proxy or CA applies to every connection the CLI makes
+func SetTransportOptions(opts TransportOptions) error {
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("the HTTP transport can't be configured")
+	}
+	transport := base.Clone()
+
+	if opts.Proxy != "" {
+		proxy, err := parseProxy(opts.Proxy)
+		if err != nil {
+			return err
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if opts.CABundle != "" || opts.Insecure {
+		tlsConfig := &tls.Config{}
+		if transport.TLSClientConfig != nil {
+			tlsConfig = transport.TLSClientConfig.Clone()
+		}
+		if opts.CABundle != "" {
+			pool, err := loadCABundle(opts.CABundle)
+			if err != nil {
+				return err
+			}
+			tlsConfig.RootCAs = pool
+		}
+		tlsConfig.InsecureSkipVerify = opts.Insecure
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	http.DefaultTransport = transport
+	return nil
+}
+
+// parseProxy parses a proxy URL, defaulting to http:// when no scheme is
+// given as HTTPS_PROXY does
+func parseProxy(value string) (*url.URL, error) {
+	if !strings.Contains(value, "://") {
+		value = "http://" + value
+	}
+	proxy, err := url.Parse(value)
+	if err != nil || proxy.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL '%s'", value)
+	}
+	switch proxy.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme '%s' (use http, https or socks5)", proxy.Scheme)
+	}
+	return proxy, nil
+}
+
+// loadCABundle returns the system roots plus the certificates in path
+func loadCABundle(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM certificates found in CA bundle '%s'", path)
+	}
+	return pool, nil
+}
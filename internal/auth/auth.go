@@ -51,9 +51,13 @@ type TokenInfo struct {
 type AuthStatus struct {
 	Authenticated bool       `json:"authenticated"`
 	Method        AuthMethod `json:"method"`
-	Source        string     `json:"source"` // "env", "keychain", "config"
-	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
-	User          *UserInfo  `json:"user,omitempty"`
+	Source        string     `json:"source"` // "env", "keychain", "file"
+	// Storage is where 'linear auth login' stores credentials: "keychain"
+	// or "file", with StoragePath for files
+	Storage     string     `json:"storage"`
+	StoragePath string     `json:"storagePath,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	User        *UserInfo  `json:"user,omitempty"`
 }
 
 // UserInfo contains authenticated user information
@@ -70,13 +74,36 @@ type Manager struct {
 	storage Storage
 }
 
-// NewManager creates a new auth manager
+// NewManager creates a new auth manager. Credentials are kept in the
+// system keychain unless LINEAR_CREDENTIALS_FILE is set or the default
+// credentials file exists (see NewFileManager).
 func NewManager() *Manager {
+	if path := os.Getenv(CredentialsFileEnv); path != "" {
+		return &Manager{storage: NewFileStorage(path)}
+	}
+	if _, err := os.Stat(DefaultCredentialsPath()); err == nil {
+		return &Manager{storage: NewFileStorage("")}
+	}
 	return &Manager{
 		storage: NewKeyringStorage(),
 	}
 }
 
+// NewFileManager creates an auth manager that keeps credentials in an
+// encrypted file instead of the keychain: LINEAR_CREDENTIALS_FILE, or
+// DefaultCredentialsPath when it is unset
+func NewFileManager() *Manager {
+	return &Manager{storage: NewFileStorage(os.Getenv(CredentialsFileEnv))}
+}
+
+// Storage returns the storage backend name and, for files, the path
+func (m *Manager) Storage() (backend, path string) {
+	if fs, ok := m.storage.(*FileStorage); ok {
+		return BackendFile, fs.Path()
+	}
+	return m.storage.Backend(), ""
+}
+
 // GetToken returns the current access token using priority order:
 // 1. Environment variables (LINEAR_API_KEY or LINEAR_CLIENT_ID+LINEAR_CLIENT_SECRET)
 // 2. Keychain storage
@@ -99,9 +126,13 @@ func (m *Manager) GetToken(ctx context.Context) (string, AuthMethod, error) {
 	}
 
 	// Priority 3: Stored API key in keychain
-	if apiKey, err := m.storage.GetAPIKey(); err == nil && apiKey != "" {
+	apiKey, err := m.storage.GetAPIKey()
+	if err == nil && apiKey != "" {
 		return apiKey, AuthMethodAPIKey, nil
 	}
+	if errors.Is(err, ErrCredentialsKey) {
+		return "", AuthMethodNone, err
+	}
 
 	// Priority 4: Stored OAuth token in keychain
 	if tokenInfo, err := m.storage.GetTokenInfo(); err == nil && tokenInfo != nil {
@@ -132,6 +163,7 @@ func (m *Manager) GetStatus(ctx context.Context) (*AuthStatus, error) {
 		Authenticated: false,
 		Method:        AuthMethodNone,
 	}
+	status.Storage, status.StoragePath = m.Storage()
 
 	// Check environment variables first
 	if apiKey := os.Getenv("LINEAR_API_KEY"); apiKey != "" {
@@ -150,18 +182,22 @@ func (m *Manager) GetStatus(ctx context.Context) (*AuthStatus, error) {
 		return status, nil
 	}
 
-	// Check keychain
-	if apiKey, err := m.storage.GetAPIKey(); err == nil && apiKey != "" {
+	// Check stored credentials
+	apiKey, err := m.storage.GetAPIKey()
+	if err == nil && apiKey != "" {
 		status.Authenticated = true
 		status.Method = AuthMethodAPIKey
-		status.Source = "keychain"
+		status.Source = status.Storage
 		return status, nil
 	}
+	if errors.Is(err, ErrCredentialsKey) {
+		return nil, err
+	}
 
 	if tokenInfo, err := m.storage.GetTokenInfo(); err == nil && tokenInfo != nil {
 		status.Authenticated = true
 		status.Method = AuthMethodClientCredentials
-		status.Source = "keychain"
+		status.Source = status.Storage
 		status.ExpiresAt = &tokenInfo.ExpiresAt
 		return status, nil
 	}
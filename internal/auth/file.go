@@ -0,0 +1,312 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	// CredentialsFileEnv selects file storage at the given path
+	CredentialsFileEnv = "LINEAR_CREDENTIALS_FILE"
+
+	// CredentialsKeyEnv holds the passphrase that encrypts the credentials
+	// file
+	CredentialsKeyEnv = "LINEAR_CREDENTIALS_KEY"
+
+	// credentialsFileName is the default credentials file under the config
+	// directory
+	credentialsFileName = "credentials.enc"
+
+	// pbkdf2Iterations is the PBKDF2-SHA256 work factor for new files
+	pbkdf2Iterations = 600_000
+)
+
+// ErrCredentialsKey is returned when the credentials file cannot be used
+// because LINEAR_CREDENTIALS_KEY is unset or wrong
+var ErrCredentialsKey = errors.New("credentials file needs the passphrase in " + CredentialsKeyEnv)
+
+// Storage backends reported by Backend
+const (
+	BackendKeychain = "keychain"
+	BackendFile     = "file"
+	BackendMemory   = "memory"
+)
+
+// FileStorage implements Storage in a file encrypted with AES-256-GCM, for
+// CI and containers without a system keychain. The key is derived from the
+// LINEAR_CREDENTIALS_KEY passphrase with PBKDF2-SHA256.
+type FileStorage struct {
+	path string
+}
+
+// credentialsFile is the on-disk envelope of a FileStorage
+type credentialsFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// NewFileStorage creates file storage at path, or at the default path when
+// path is empty
+func NewFileStorage(path string) *FileStorage {
+	if path == "" {
+		path = DefaultCredentialsPath()
+	}
+	return &FileStorage{path: path}
+}
+
+// DefaultCredentialsPath returns $XDG_CONFIG_HOME/agent-linear-cli/credentials.enc
+func DefaultCredentialsPath() string {
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return credentialsFileName
+		}
+		configHome = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configHome, ServiceName, credentialsFileName)
+}
+
+// Path returns the credentials file path
+func (s *FileStorage) Path() string {
+	return s.path
+}
+
+// read decrypts the stored values; a missing file has none
+func (s *FileStorage) read() (map[string]string, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	var file credentialsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid credentials file %s: %w", s.path, err)
+	}
+	if file.Version != 1 {
+		return nil, fmt.Errorf("unsupported credentials file version %d", file.Version)
+	}
+
+	gcm, err := fileCipher(file.Salt, file.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot decrypt %s", ErrCredentialsKey, s.path)
+	}
+
+	values := map[string]string{}
+	if err := json.Unmarshal(plain, &values); err != nil {
+		return nil, fmt.Errorf("invalid credentials file %s: %w", s.path, err)
+	}
+	return values, nil
+}
+
+// write encrypts values with a fresh salt and nonce and replaces the file
+func (s *FileStorage) write(values map[string]string) error {
+	plain, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	file := credentialsFile{
+		Version:    1,
+		KDF:        "pbkdf2-sha256",
+		Iterations: pbkdf2Iterations,
+		Salt:       make([]byte, 16),
+	}
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+	gcm, err := fileCipher(file.Salt, file.Iterations)
+	if err != nil {
+		return err
+	}
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Ciphertext = gcm.Seal(nil, file.Nonce, plain, nil)
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// derivedKey identifies a PBKDF2 derivation in derivedKeys
+type derivedKey struct {
+	passphrase string
+	salt       string
+	iterations int
+}
+
+var (
+	// derivedKeys caches PBKDF2 keys for the life of the process, since
+	// every credential read decrypts the file and each derivation takes
+	// a noticeable fraction of a second
+	derivedKeys   = map[derivedKey][]byte{}
+	derivedKeysMu sync.Mutex
+)
+
+// fileKey derives the AES-256 key from the passphrase, once per salt
+func fileKey(passphrase string, salt []byte, iterations int) ([]byte, error) {
+	id := derivedKey{passphrase: passphrase, salt: string(salt), iterations: iterations}
+	derivedKeysMu.Lock()
+	defer derivedKeysMu.Unlock()
+	if key, ok := derivedKeys[id]; ok {
+		return key, nil
+	}
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, 32)
+	if err != nil {
+		return nil, err
+	}
+	derivedKeys[id] = key
+	return key, nil
+}
+
+// fileCipher derives the AES-256-GCM cipher from the passphrase
+func fileCipher(salt []byte, iterations int) (cipher.AEAD, error) {
+	passphrase := os.Getenv(CredentialsKeyEnv)
+	if passphrase == "" {
+		return nil, ErrCredentialsKey
+	}
+	key, err := fileKey(passphrase, salt, iterations)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (s *FileStorage) get(key string) (string, error) {
+	values, err := s.read()
+	if err != nil {
+		return "", err
+	}
+	if v, ok := values[key]; ok {
+		return v, nil
+	}
+	return "", keyring.ErrNotFound
+}
+
+func (s *FileStorage) set(key, value string) error {
+	values, err := s.read()
+	if err != nil {
+		return err
+	}
+	values[key] = value
+	return s.write(values)
+}
+
+func (s *FileStorage) delete(key string) error {
+	if _, err := os.Stat(s.path); os.IsNotExist(err) {
+		return nil
+	}
+	values, err := s.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := values[key]; !ok {
+		return nil
+	}
+	delete(values, key)
+	if len(values) == 0 {
+		return os.Remove(s.path)
+	}
+	return s.write(values)
+}
+
+func (s *FileStorage) Backend() string {
+	return BackendFile
+}
+
+func (s *FileStorage) GetAPIKey() (string, error) {
+	return s.get(keyAPIKey)
+}
+
+func (s *FileStorage) SetAPIKey(key string) error {
+	return s.set(keyAPIKey, key)
+}
+
+func (s *FileStorage) DeleteAPIKey() error {
+	return s.delete(keyAPIKey)
+}
+
+func (s *FileStorage) GetTokenInfo() (*TokenInfo, error) {
+	data, err := s.get(keyTokenInfo)
+	if err != nil {
+		return nil, err
+	}
+	var info TokenInfo
+	if err := json.Unmarshal([]byte(data), &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (s *FileStorage) SetTokenInfo(info *TokenInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return s.set(keyTokenInfo, string(data))
+}
+
+func (s *FileStorage) DeleteTokenInfo() error {
+	return s.delete(keyTokenInfo)
+}
+
+func (s *FileStorage) GetClientID() (string, error) {
+	return s.get(keyClientID)
+}
+
+func (s *FileStorage) SetClientID(id string) error {
+	return s.set(keyClientID, id)
+}
+
+func (s *FileStorage) DeleteClientID() error {
+	return s.delete(keyClientID)
+}
+
+func (s *FileStorage) GetClientSecret() (string, error) {
+	return s.get(keyClientSecret)
+}
+
+func (s *FileStorage) SetClientSecret(secret string) error {
+	return s.set(keyClientSecret, secret)
+}
+
+func (s *FileStorage) DeleteClientSecret() error {
+	return s.delete(keyClientSecret)
+}
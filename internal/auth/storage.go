@@ -16,6 +16,9 @@ const (
 
 // Storage defines the interface for credential storage
 type Storage interface {
+	// Backend names the storage, such as BackendKeychain
+	Backend() string
+
 	// API Key methods
 	GetAPIKey() (string, error)
 	SetAPIKey(key string) error
@@ -48,6 +51,11 @@ func NewKeyringStorage() *KeyringStorage {
 	}
 }
 
+// Backend returns BackendKeychain
+func (s *KeyringStorage) Backend() string {
+	return BackendKeychain
+}
+
 // GetAPIKey retrieves the stored API key
 func (s *KeyringStorage) GetAPIKey() (string, error) {
 	return keyring.Get(s.service, keyAPIKey)
@@ -149,6 +157,10 @@ func NewMemoryStorage() *MemoryStorage {
 	}
 }
 
+func (s *MemoryStorage) Backend() string {
+	return BackendMemory
+}
+
 func (s *MemoryStorage) GetAPIKey() (string, error) {
 	if v, ok := s.data[keyAPIKey]; ok {
 		return v, nil
@@ -0,0 +1,331 @@
+// Package breakdown parses task breakdowns (markdown checklists, YAML or
+// JSON) into a tree of sub-issues to create under a parent issue.
+package breakdown
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Task is one issue in a breakdown
+type Task struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Priority    string   `json:"priority,omitempty"`
+	Estimate    float64  `json:"estimate,omitempty"`
+	Assignee    string   `json:"assignee,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	Done        bool     `json:"done,omitempty"`
+	Subtasks    []*Task  `json:"subtasks,omitempty"`
+}
+
+// Plan is a parsed breakdown: an optional parent title and description,
+// and the tasks to create beneath it
+type Plan struct {
+	Title       string  `json:"title,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Subtasks    []*Task `json:"subtasks"`
+}
+
+// Count returns the number of tasks in the plan, at every level
+func (p *Plan) Count() int {
+	return countTasks(p.Subtasks)
+}
+
+func countTasks(tasks []*Task) int {
+	n := len(tasks)
+	for _, t := range tasks {
+		n += countTasks(t.Subtasks)
+	}
+	return n
+}
+
+// Load reads a breakdown file. The format is chosen by extension: .yaml
+// and .yml are YAML, .json is JSON, anything else is a markdown checklist.
+func Load(path string) (*Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan *Plan
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		value, err := ParseYAML(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		plan, err = planFromValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	case ".json":
+		var value interface{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		plan, err = planFromValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	default:
+		plan = ParseMarkdown(string(data))
+	}
+
+	if len(plan.Subtasks) == 0 {
+		return nil, fmt.Errorf("%s: no tasks found", path)
+	}
+	return plan, nil
+}
+
+var (
+	headingPattern  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	listItemPattern = regexp.MustCompile(`^(?:[-*+]|\d+[.)])\s+(?:\[([ xX])\]\s+)?(.*)$`)
+)
+
+// ParseMarkdown parses a markdown checklist. A level-one heading is the
+// parent title and text before the first task is its description. List
+// items (checkboxes or plain bullets) become tasks, nested by indentation;
+// indented text under an item is its description. Lower-level headings
+// become tasks that group the items below them. Checked items are Done.
+func ParseMarkdown(data string) *Plan {
+	plan := &Plan{Subtasks: []*Task{}}
+
+	type level struct {
+		indent int
+		task   *Task
+	}
+	var (
+		stack       []level
+		group       *Task
+		last        *Task
+		description []string
+		fenced      bool
+
+		// contentIndent is the indentation of text under each list item
+		contentIndent = map[*Task]int{}
+	)
+
+	appendText := func(task *Task, text string) {
+		if task.Description == "" {
+			task.Description = text
+		} else {
+			task.Description += "\n" + text
+		}
+	}
+
+	for _, raw := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		line := strings.ReplaceAll(raw, "\t", "    ")
+		text := strings.TrimSpace(line)
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+
+		if strings.HasPrefix(text, "```") {
+			fenced = !fenced
+		}
+
+		if !fenced {
+			if m := headingPattern.FindStringSubmatch(text); m != nil && indent == 0 {
+				if len(m[1]) == 1 && plan.Title == "" && len(plan.Subtasks) == 0 {
+					plan.Title = strings.TrimSpace(m[2])
+					continue
+				}
+				group = &Task{Title: strings.TrimSpace(m[2])}
+				plan.Subtasks = append(plan.Subtasks, group)
+				stack = nil
+				last = group
+				continue
+			}
+
+			if m := listItemPattern.FindStringSubmatch(text); m != nil {
+				task := &Task{Title: strings.TrimSpace(m[2]), Done: m[1] == "x" || m[1] == "X"}
+				for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+					stack = stack[:len(stack)-1]
+				}
+				switch {
+				case len(stack) > 0:
+					parent := stack[len(stack)-1].task
+					parent.Subtasks = append(parent.Subtasks, task)
+				case group != nil:
+					group.Subtasks = append(group.Subtasks, task)
+				default:
+					plan.Subtasks = append(plan.Subtasks, task)
+				}
+				stack = append(stack, level{indent: indent, task: task})
+				contentIndent[task] = indent + len(text) - len(m[2])
+				last = task
+				continue
+			}
+		}
+
+		// Free text: indented text belongs to the task above it; other
+		// text to the current heading group, or to the parent
+		if text == "" {
+			if last != nil && last.Description != "" {
+				appendText(last, "")
+			}
+			if len(description) > 0 {
+				description = append(description, "")
+			}
+			continue
+		}
+		switch {
+		case last != nil && (indent > 0 || fenced) && last != group:
+			appendText(last, strings.TrimRight(line[min(indent, contentIndent[last]):], " "))
+		case group != nil:
+			appendText(group, strings.TrimRight(line, " "))
+		default:
+			description = append(description, strings.TrimRight(line, " "))
+		}
+	}
+
+	plan.Description = strings.TrimSpace(strings.Join(description, "\n"))
+	trimDescriptions(plan.Subtasks)
+	return plan
+}
+
+func trimDescriptions(tasks []*Task) {
+	for _, t := range tasks {
+		t.Description = strings.TrimSpace(t.Description)
+		trimDescriptions(t.Subtasks)
+	}
+}
+
+// planFromValue converts decoded YAML or JSON into a plan. The document is
+// either a list of tasks or a mapping with title, description and
+// subtasks keys.
+func planFromValue(value interface{}) (*Plan, error) {
+	plan := &Plan{}
+	var items interface{}
+
+	switch v := value.(type) {
+	case []interface{}:
+		items = v
+	case map[string]interface{}:
+		for key, field := range v {
+			switch key {
+			case "title":
+				plan.Title = scalarString(field)
+			case "description":
+				plan.Description = scalarString(field)
+			case "subtasks", "tasks", "children":
+				items = field
+			default:
+				return nil, fmt.Errorf("unknown key %q", key)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("expected a list of tasks or a mapping with subtasks")
+	}
+
+	tasks, err := tasksFromValue(items, "subtasks")
+	if err != nil {
+		return nil, err
+	}
+	plan.Subtasks = tasks
+	return plan, nil
+}
+
+// tasksFromValue converts a list of tasks; items may be plain titles or
+// mappings
+func tasksFromValue(value interface{}, path string) ([]*Task, error) {
+	if value == nil {
+		return []*Task{}, nil
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a list", path)
+	}
+
+	tasks := make([]*Task, 0, len(list))
+	for i, item := range list {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		task := &Task{}
+
+		switch v := item.(type) {
+		case string:
+			task.Title = v
+		case map[string]interface{}:
+			for key, field := range v {
+				var err error
+				switch key {
+				case "title":
+					task.Title = scalarString(field)
+				case "description":
+					task.Description = scalarString(field)
+				case "priority":
+					task.Priority = scalarString(field)
+				case "estimate":
+					task.Estimate, err = strconv.ParseFloat(scalarString(field), 64)
+					if err != nil {
+						return nil, fmt.Errorf("%s: invalid estimate %q", itemPath, scalarString(field))
+					}
+				case "assignee":
+					task.Assignee = scalarString(field)
+				case "labels":
+					task.Labels, err = stringList(field)
+					if err != nil {
+						return nil, fmt.Errorf("%s.labels: %w", itemPath, err)
+					}
+				case "done":
+					task.Done = scalarString(field) == "true"
+				case "subtasks", "tasks", "children":
+					task.Subtasks, err = tasksFromValue(field, itemPath+"."+key)
+					if err != nil {
+						return nil, err
+					}
+				default:
+					return nil, fmt.Errorf("%s: unknown key %q", itemPath, key)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("%s: expected a title or a mapping", itemPath)
+		}
+
+		if strings.TrimSpace(task.Title) == "" {
+			return nil, fmt.Errorf("%s: title is required", itemPath)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// scalarString renders a decoded scalar as a string
+func scalarString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// stringList converts a list of scalars, or a comma-separated string
+func stringList(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		items := []string{}
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				items = append(items, s)
+			}
+		}
+		return items, nil
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = scalarString(item)
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("expected a list")
+	}
+}
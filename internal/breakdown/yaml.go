@@ -0,0 +1,234 @@
+package breakdown
+
+import (
+	"fmt"
+	"strings"
+)
+
+// yamlLine is a non-blank line of a YAML document
+type yamlLine struct {
+	n      int
+	indent int
+	text   string
+}
+
+// yamlParser parses the block-style YAML subset used by breakdown files:
+// nested mappings and sequences, plain and quoted scalars, [a, b] flow
+// lists, | and > block scalars, and # comments
+type yamlParser struct {
+	lines []yamlLine
+	raw   []string
+	pos   int
+}
+
+// ParseYAML decodes a document into maps, slices and strings. Other
+// file formats built on the same subset, like apply manifests, use it too.
+func ParseYAML(data string) (interface{}, error) {
+	p := &yamlParser{raw: strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")}
+	for i, raw := range p.raw {
+		line := stripYAMLComment(strings.ReplaceAll(raw, "\t", "  "))
+		text := strings.TrimSpace(line)
+		if text == "" || text == "---" {
+			continue
+		}
+		p.lines = append(p.lines, yamlLine{n: i, indent: len(line) - len(strings.TrimLeft(line, " ")), text: text})
+	}
+	if len(p.lines) == 0 {
+		return nil, nil
+	}
+
+	value, err := p.parseBlock(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("line %d: unexpected indentation", p.lines[p.pos].n+1)
+	}
+	return value, nil
+}
+
+// parseBlock parses the mapping or sequence starting at the current line
+func (p *yamlParser) parseBlock(indent int) (interface{}, error) {
+	if isYAMLSequenceItem(p.lines[p.pos].text) {
+		return p.parseSequence(indent)
+	}
+	return p.parseMapping(indent)
+}
+
+func (p *yamlParser) parseSequence(indent int) ([]interface{}, error) {
+	items := []interface{}{}
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent || !isYAMLSequenceItem(line.text) {
+			return nil, fmt.Errorf("line %d: expected a list item", line.n+1)
+		}
+
+		rest := strings.TrimSpace(strings.TrimPrefix(line.text, "-"))
+		switch {
+		case rest == "":
+			p.pos++
+			if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+				value, err := p.parseBlock(p.lines[p.pos].indent)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, value)
+			} else {
+				items = append(items, nil)
+			}
+		case isYAMLMappingEntry(rest):
+			// "- key: value" starts a mapping indented to the key's column
+			p.lines[p.pos] = yamlLine{n: line.n, indent: line.indent + len(line.text) - len(rest), text: rest}
+			value, err := p.parseMapping(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		default:
+			p.pos++
+			items = append(items, parseYAMLScalar(rest))
+		}
+	}
+	return items, nil
+}
+
+func (p *yamlParser) parseMapping(indent int) (map[string]interface{}, error) {
+	mapping := map[string]interface{}{}
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", line.n+1)
+		}
+		if !isYAMLMappingEntry(line.text) {
+			return nil, fmt.Errorf("line %d: expected key: value", line.n+1)
+		}
+
+		key, value, _ := strings.Cut(line.text, ":")
+		key = unquoteYAML(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		if _, dup := mapping[key]; dup {
+			return nil, fmt.Errorf("line %d: duplicate key %q", line.n+1, key)
+		}
+		p.pos++
+
+		switch {
+		case value == "|" || value == ">" || value == "|-" || value == ">-":
+			mapping[key] = p.parseBlockScalar(line, value[0] == '>')
+		case value != "":
+			mapping[key] = parseYAMLScalar(value)
+		case p.pos < len(p.lines) && (p.lines[p.pos].indent > indent ||
+			(p.lines[p.pos].indent == indent && isYAMLSequenceItem(p.lines[p.pos].text))):
+			child, err := p.parseBlock(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			mapping[key] = child
+		default:
+			mapping[key] = nil
+		}
+	}
+	return mapping, nil
+}
+
+// parseBlockScalar collects the raw lines indented under a | or > key
+func (p *yamlParser) parseBlockScalar(key yamlLine, folded bool) string {
+	end := len(p.raw)
+	if p.pos < len(p.lines) {
+		if p.lines[p.pos].indent > key.indent {
+			// Skip the structural lines that belong to the scalar
+			for p.pos < len(p.lines) && p.lines[p.pos].indent > key.indent {
+				p.pos++
+			}
+		}
+		if p.pos < len(p.lines) {
+			end = p.lines[p.pos].n
+		}
+	}
+
+	body := p.raw[key.n+1 : end]
+	indent := -1
+	for _, l := range body {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		if i := len(l) - len(strings.TrimLeft(l, " ")); indent < 0 || i < indent {
+			indent = i
+		}
+	}
+
+	lines := make([]string, 0, len(body))
+	for _, l := range body {
+		if len(l) >= indent && indent > 0 {
+			l = l[indent:]
+		}
+		lines = append(lines, strings.TrimRight(l, " "))
+	}
+	text := strings.TrimRight(strings.Join(lines, "\n"), "\n")
+
+	if folded {
+		paragraphs := strings.Split(text, "\n\n")
+		for i, para := range paragraphs {
+			paragraphs[i] = strings.Join(strings.Fields(para), " ")
+		}
+		text = strings.Join(paragraphs, "\n")
+	}
+	return text
+}
+
+// parseYAMLScalar decodes a plain, quoted or [a, b] flow value
+func parseYAMLScalar(value string) interface{} {
+	if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+		items := []interface{}{}
+		for _, item := range strings.Split(value[1:len(value)-1], ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, unquoteYAML(item))
+			}
+		}
+		return items
+	}
+	return unquoteYAML(value)
+}
+
+// unquoteYAML strips matching single or double quotes
+func unquoteYAML(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+func isYAMLSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// isYAMLMappingEntry reports whether text is "key: value" or "key:"
+func isYAMLMappingEntry(text string) bool {
+	if text == "" || text[0] == '"' || text[0] == '\'' || text[0] == '[' {
+		return false
+	}
+	return strings.Contains(text, ": ") || strings.HasSuffix(text, ":")
+}
+
+// stripYAMLComment removes a trailing # comment outside quotes
+func stripYAMLComment(line string) string {
+	quote := byte(0)
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && (i == 0 || strings.IndexByte(" :[,", line[i-1]) >= 0):
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' '):
+			return line[:i]
+		}
+	}
+	return line
+}
@@ -1,3 +1,16 @@
+// Package cache is the CLI's local store: cached lookups, API responses
+// and the data 'linear sync' pulls for offline reads.
+//
+// Each key is a JSON file in the cache directory, written whole and
+// decoded whole on every read; there is no database, index or query
+// layer, and offline commands filter what they read in memory. This keeps
+// the store dependency-free and readable with any JSON tool, and suits
+// what is stored: workspace metadata and one user's open issues, about a
+// kilobyte per issue. It does not suit a whole workspace's issues: a key
+// holding tens of megabytes costs that much to read on every command.
+// Concurrent writes to one key leave the last one, and a read racing a
+// write may find a partial file, which counts as a miss. Policy.MaxSize
+// bounds the directory as a whole, not a single key.
 package cache
 
 import (
@@ -13,6 +26,10 @@ const (
 
 	// CacheDir is the cache directory name
 	CacheDir = "agent-linear-cli"
+
+	// ResponsesDir is the subdirectory of the cache directory holding API
+	// responses cached by the api package
+	ResponsesDir = "responses"
 )
 
 // Entry represents a cached item with timestamp
@@ -21,6 +38,42 @@ type Entry[T any] struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// Policy bounds the age and size of the cache
+type Policy struct {
+	// TTL is how long cached items are read back
+	TTL time.Duration
+	// ResponseTTL is how long the api package reuses cached responses;
+	// it only decides which responses list as expired here
+	ResponseTTL time.Duration
+	// MaxSize caps the cache directory in bytes; the least recently
+	// written items are removed past it. 0 means no limit.
+	MaxSize int64
+}
+
+var (
+	policy = Policy{TTL: DefaultTTL}
+
+	// refresh makes Read miss, see SetRefresh
+	refresh bool
+)
+
+// SetPolicy sets the policy of managers created afterwards
+func SetPolicy(p Policy) {
+	policy = p
+}
+
+// CurrentPolicy returns the policy set by SetPolicy
+func CurrentPolicy() Policy {
+	return policy
+}
+
+// SetRefresh makes Read and GetOrFetch ignore cached items, as --refresh
+// asks, while Write still stores fresh ones. Offline reads through
+// ReadEntry are unaffected.
+func SetRefresh(on bool) {
+	refresh = on
+}
+
 // Manager handles cache operations
 type Manager struct {
 	dir string
@@ -36,7 +89,7 @@ func NewManager() (*Manager, error) {
 
 	return &Manager{
 		dir: cacheDir,
-		ttl: DefaultTTL,
+		ttl: policy.TTL,
 	}, nil
 }
 
@@ -65,8 +118,12 @@ func (m *Manager) keyPath(key string) string {
 	return filepath.Join(m.dir, key+".json")
 }
 
-// Read retrieves a cached item, returns nil if not found or expired
+// Read retrieves a cached item, returns nil if not found, expired or
+// refreshing
 func Read[T any](m *Manager, key string) (*T, error) {
+	if refresh {
+		return nil, nil
+	}
 	path := m.keyPath(key)
 
 	data, err := os.ReadFile(path)
@@ -83,16 +140,44 @@ func Read[T any](m *Manager, key string) (*T, error) {
 		return nil, nil
 	}
 
-	// Check if expired
+	// Check if expired. Expired entries stay on disk so they remain
+	// available to offline reads via ReadEntry.
 	if time.Since(entry.Timestamp) > m.ttl {
-		// Expired, clean up
-		os.Remove(path)
 		return nil, nil
 	}
 
 	return &entry.Data, nil
 }
 
+// ReadEntry retrieves a cached entry regardless of its age, returns nil if
+// not found. Used by offline mode, where stale data beats no data.
+func ReadEntry[T any](m *Manager, key string) (*Entry[T], error) {
+	data, err := os.ReadFile(m.keyPath(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entry Entry[T]
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, nil
+	}
+
+	return &entry, nil
+}
+
+// Dir returns the cache directory
+func (m *Manager) Dir() string {
+	return m.dir
+}
+
+// TTL returns the cache time-to-live
+func (m *Manager) TTL() time.Duration {
+	return m.ttl
+}
+
 // Write stores an item in the cache
 func Write[T any](m *Manager, key string, data T) error {
 	if err := m.ensureDir(); err != nil {
@@ -109,7 +194,11 @@ func Write[T any](m *Manager, key string, data T) error {
 		return err
 	}
 
-	return os.WriteFile(m.keyPath(key), bytes, 0644)
+	if err := os.WriteFile(m.keyPath(key), bytes, 0644); err != nil {
+		return err
+	}
+	m.Prune()
+	return nil
 }
 
 // Clear removes a specific cache entry
@@ -121,23 +210,21 @@ func (m *Manager) Clear(key string) error {
 	return err
 }
 
-// ClearAll removes all cache entries
-func (m *Manager) ClearAll() error {
-	entries, err := os.ReadDir(m.dir)
+// ClearAll removes all cache entries, API responses included, and
+// returns how many it removed
+func (m *Manager) ClearAll() (int, error) {
+	infos, err := m.List()
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil
-		}
-		return err
+		return 0, err
 	}
-
-	for _, entry := range entries {
-		if filepath.Ext(entry.Name()) == ".json" {
-			os.Remove(filepath.Join(m.dir, entry.Name()))
+	removed := 0
+	for _, info := range infos {
+		if err := os.Remove(info.path); err != nil && !os.IsNotExist(err) {
+			return removed, err
 		}
+		removed++
 	}
-
-	return nil
+	return removed, nil
 }
 
 // Has checks if a cache key exists and is not expired
@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Namespaces group cache entries by the data they hold, for listing and
+// clearing. NamespaceResponses holds the API responses of the api package.
+const (
+	NamespaceLabels    = "labels"
+	NamespaceTeams     = "teams"
+	NamespaceStates    = "states"
+	NamespaceUsers     = "users"
+	NamespaceIssues    = "issues"
+	NamespaceCycles    = "cycles"
+	NamespaceProjects  = "projects"
+	NamespaceSync      = "sync"
+	NamespaceResponses = "responses"
+)
+
+// Namespaces lists every namespace, in the order stats show them
+var Namespaces = []string{
+	NamespaceLabels,
+	NamespaceTeams,
+	NamespaceStates,
+	NamespaceUsers,
+	NamespaceIssues,
+	NamespaceCycles,
+	NamespaceProjects,
+	NamespaceSync,
+	NamespaceResponses,
+}
+
+// resourceNamespaces maps the resource part of a key to its namespace
+var resourceNamespaces = map[string]string{
+	"labels":     NamespaceLabels,
+	"teams":      NamespaceTeams,
+	"estimation": NamespaceTeams,
+	"workflows":  NamespaceStates,
+	"statuses":   NamespaceStates,
+	"users":      NamespaceUsers,
+	"viewer":     NamespaceUsers,
+	"my-issues":  NamespaceIssues,
+	"cycles":     NamespaceCycles,
+	"projects":   NamespaceProjects,
+	"sync":       NamespaceSync,
+}
+
+// responsesStatsFile holds the api package's response cache counters,
+// which are not an entry
+const responsesStatsFile = "stats.json"
+
+// Namespace returns the namespace of a key built with TeamKey or
+// WorkspaceKey, or "" when it belongs to none
+func Namespace(key string) string {
+	resource := key
+	if i := strings.Index(key, "-team-"); i >= 0 {
+		resource = key[:i]
+	} else {
+		resource = strings.TrimSuffix(key, "-workspace")
+	}
+	return resourceNamespaces[resource]
+}
+
+// Info describes a cache entry
+type Info struct {
+	Key       string    `json:"key"`
+	Namespace string    `json:"namespace"`
+	Size      int64     `json:"size"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	Expired   bool      `json:"expired"`
+
+	path string
+}
+
+// List returns the cache entries, API responses included, newest first
+func (m *Manager) List() ([]Info, error) {
+	var infos []Info
+	add := func(dir, namespace string, ttl time.Duration) error {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || filepath.Ext(name) != ".json" {
+				continue
+			}
+			if namespace == NamespaceResponses && name == responsesStatsFile {
+				continue
+			}
+			fi, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			key := strings.TrimSuffix(name, ".json")
+			ns := namespace
+			if ns == "" {
+				ns = Namespace(key)
+			}
+			infos = append(infos, Info{
+				Key:       key,
+				Namespace: ns,
+				Size:      fi.Size(),
+				UpdatedAt: fi.ModTime(),
+				Expired:   time.Since(fi.ModTime()) > ttl,
+				path:      filepath.Join(dir, name),
+			})
+		}
+		return nil
+	}
+
+	if err := add(m.dir, "", m.ttl); err != nil {
+		return nil, err
+	}
+	if err := add(filepath.Join(m.dir, ResponsesDir), NamespaceResponses, policy.ResponseTTL); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(infos, func(i, j int) bool { return infos[i].UpdatedAt.After(infos[j].UpdatedAt) })
+	return infos, nil
+}
+
+// ClearNamespace removes the entries of a namespace and returns how many
+// it removed
+func (m *Manager) ClearNamespace(namespace string) (int, error) {
+	infos, err := m.List()
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, info := range infos {
+		if info.Namespace != namespace {
+			continue
+		}
+		if err := os.Remove(info.path); err != nil && !os.IsNotExist(err) {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Prune removes the least recently written entries until the cache fits
+// the policy's MaxSize, and returns how many it removed
+func (m *Manager) Prune() int {
+	if policy.MaxSize <= 0 {
+		return 0
+	}
+	infos, err := m.List()
+	if err != nil {
+		return 0
+	}
+	var total int64
+	for _, info := range infos {
+		total += info.Size
+	}
+	removed := 0
+	// infos is newest first
+	for i := len(infos) - 1; i >= 0 && total > policy.MaxSize; i-- {
+		if os.Remove(infos[i].path) == nil {
+			total -= infos[i].Size
+			removed++
+		}
+	}
+	return removed
+}
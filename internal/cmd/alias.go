@@ -0,0 +1,404 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/config"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// shellAliasPrefix marks an alias expansion run by the shell
+const shellAliasPrefix = "!"
+
+// Alias is a user-defined command
+type Alias struct {
+	Name      string `json:"name"`
+	Expansion string `json:"expansion"`
+	Shell     bool   `json:"shell"`
+}
+
+// AliasListResponse is the response for 'linear alias list'
+type AliasListResponse struct {
+	Aliases []Alias `json:"aliases"`
+	Count   int     `json:"count"`
+}
+
+// NewAliasCmd creates the alias command group
+func NewAliasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Create shortcuts for linear commands",
+		Long: `Define your own commands as shortcuts for longer linear commands or shell
+pipelines.
+
+An alias expands to a linear command line. Arguments after the alias are
+appended, or substituted for $1, $2, ... where the expansion uses them.
+An expansion starting with '!' is run by sh, with the arguments as $1, $2,
+... and "$@".
+
+Aliases are stored under [aliases] in ~/.linear.toml. Repository configs
+cannot define them, so running linear in a cloned repository never runs
+commands it chose. Built-in commands always take precedence over aliases.
+
+Examples:
+  linear alias set mybugs 'issue list --team ENG --label bug --assignee self'
+  linear mybugs --human
+
+  linear alias set triage 'issue update $1 --state Triage --assignee self'
+  linear triage ENG-123
+
+  linear alias set ids '!linear issue list --jq ".issues[].identifier" "$@" | sort'
+  linear ids --team ENG`,
+	}
+
+	cmd.AddCommand(newAliasSetCmd())
+	cmd.AddCommand(newAliasListCmd())
+	cmd.AddCommand(newAliasDeleteCmd())
+
+	return cmd
+}
+
+func newAliasSetCmd() *cobra.Command {
+	var (
+		shell  bool
+		global bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set <name> <expansion>",
+		Short: "Define an alias",
+		Long: `Define an alias, replacing any alias of the same name.
+
+Quote the expansion so the shell passes it as one argument. Use --shell,
+or start the expansion with '!', to run it with sh instead of linear.
+
+Examples:
+  linear alias set mybugs 'issue list --team ENG --label bug --assignee self'
+  linear alias set done 'issue update $1 --state Done'
+  linear alias set --shell open-mine 'linear issue list --assignee self --jq ".issues[0].url" | xargs open'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, expansion := args[0], strings.TrimSpace(args[1])
+			if shell && !strings.HasPrefix(expansion, shellAliasPrefix) {
+				expansion = shellAliasPrefix + expansion
+			}
+
+			if err := validateAlias(cmd.Root(), name, expansion); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_ALIAS", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_ALIAS", err.Error())
+			}
+
+			manager, err := config.NewManager()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			replaced, err := manager.SetAlias(name, expansion)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				verb := "Added"
+				if replaced {
+					verb = "Replaced"
+				}
+				output.SuccessHuman(fmt.Sprintf("%s alias %s: %s", verb, name, expansion))
+				output.HumanLn("  Config file: %s", manager.Path())
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":  true,
+				"alias":    newAlias(name, expansion),
+				"replaced": replaced,
+				"path":     manager.Path(),
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&shell, "shell", false, "Run the expansion with sh (same as a leading '!')")
+	cmd.Flags().BoolVar(&global, "global", false, "Ignored; aliases are always written to ~/.linear.toml")
+	cmd.Flags().MarkDeprecated("global", "aliases are always written to ~/.linear.toml")
+
+	return cmd
+}
+
+func newAliasListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List aliases",
+		Long: `List the aliases defined in ~/.linear.toml.
+
+Examples:
+  linear alias list --human`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			aliases := loadConfig().Aliases
+			names := make([]string, 0, len(aliases))
+			for name := range aliases {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			resp := AliasListResponse{Aliases: []Alias{}}
+			for _, name := range names {
+				resp.Aliases = append(resp.Aliases, newAlias(name, aliases[name]))
+			}
+			resp.Count = len(resp.Aliases)
+
+			if IsHumanOutput() {
+				if resp.Count == 0 {
+					output.HumanLn("No aliases. Add one with 'linear alias set <name> <expansion>'.")
+					return nil
+				}
+				rows := make([][]string, len(resp.Aliases))
+				for i, a := range resp.Aliases {
+					rows[i] = []string{a.Name, aliases[a.Name]}
+				}
+				output.TableWithColors([]string{"NAME", "EXPANSION"}, rows)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+}
+
+func newAliasDeleteCmd() *cobra.Command {
+	var global bool
+
+	cmd := &cobra.Command{
+		Use:     "delete <name>",
+		Aliases: []string{"rm"},
+		Short:   "Delete an alias",
+		Long: `Delete an alias from ~/.linear.toml.
+
+Examples:
+  linear alias delete mybugs`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			manager, err := config.NewManager()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			deleted, err := manager.DeleteAlias(name)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			if !deleted {
+				msg := fmt.Sprintf("No alias '%s' in %s", name, manager.Path())
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", msg)
+					return nil
+				}
+				return output.Error("NOT_FOUND", msg)
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Deleted alias %s", name))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success": true,
+				"name":    name,
+				"path":    manager.Path(),
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&global, "global", false, "Ignored; aliases are always deleted from ~/.linear.toml")
+	cmd.Flags().MarkDeprecated("global", "aliases are always deleted from ~/.linear.toml")
+
+	return cmd
+}
+
+func newAlias(name, expansion string) Alias {
+	return Alias{
+		Name:      name,
+		Expansion: strings.TrimPrefix(expansion, shellAliasPrefix),
+		Shell:     strings.HasPrefix(expansion, shellAliasPrefix),
+	}
+}
+
+// validateAlias checks that name does not shadow a command and that a
+// linear expansion starts with a command
+func validateAlias(root *cobra.Command, name, expansion string) error {
+	if name == "" || strings.ContainsAny(name, " \t\n") || strings.HasPrefix(name, "-") {
+		return fmt.Errorf("invalid alias name '%s'", name)
+	}
+	if isBuiltinCommand(root, name) {
+		return fmt.Errorf("'%s' is a built-in command", name)
+	}
+	if strings.HasPrefix(expansion, shellAliasPrefix) {
+		if strings.TrimSpace(expansion[len(shellAliasPrefix):]) == "" {
+			return errors.New("empty shell alias")
+		}
+		return nil
+	}
+
+	words, err := splitCommandLine(expansion)
+	if err != nil {
+		return err
+	}
+	if len(words) == 0 {
+		return errors.New("empty alias expansion")
+	}
+	if !isBuiltinCommand(root, words[0]) {
+		return fmt.Errorf("expansion must start with a linear command, not '%s'", words[0])
+	}
+	return nil
+}
+
+// isBuiltinCommand reports whether name is a top-level command or one of
+// cobra's own
+func isBuiltinCommand(root *cobra.Command, name string) bool {
+	switch name {
+	case "help", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return true
+	}
+	for _, c := range root.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// aliasArgPattern matches the $1, $2, ... placeholders of an expansion
+var aliasArgPattern = regexp.MustCompile(`\$(\d+)`)
+
+// expandAlias expands args when args[0] names an alias. Placeholders take
+// the matching arguments and the rest are appended. Shell aliases return
+// the script to run instead.
+func expandAlias(root *cobra.Command, aliases map[string]string, args []string) (expanded []string, script string, err error) {
+	if len(args) == 0 || isBuiltinCommand(root, args[0]) {
+		return args, "", nil
+	}
+	expansion, ok := aliases[args[0]]
+	if !ok {
+		return args, "", nil
+	}
+	name, rest := args[0], args[1:]
+
+	if strings.HasPrefix(expansion, shellAliasPrefix) {
+		return rest, expansion[len(shellAliasPrefix):], nil
+	}
+
+	words, err := splitCommandLine(expansion)
+	if err != nil {
+		return nil, "", fmt.Errorf("alias '%s': %w", name, err)
+	}
+
+	used := map[int]bool{}
+	for i, word := range words {
+		var missing int
+		words[i] = aliasArgPattern.ReplaceAllStringFunc(word, func(m string) string {
+			n, _ := strconv.Atoi(m[1:])
+			if n < 1 || n > len(rest) {
+				missing = n
+				return m
+			}
+			used[n] = true
+			return rest[n-1]
+		})
+		if missing > 0 {
+			return nil, "", fmt.Errorf("alias '%s' uses $%d but got %d arguments: %s", name, missing, len(rest), expansion)
+		}
+	}
+	for i, arg := range rest {
+		if !used[i+1] {
+			words = append(words, arg)
+		}
+	}
+	return words, "", nil
+}
+
+// runShellAlias runs a shell alias script with args as its positional
+// parameters, and returns its exit status
+func runShellAlias(name, script string, args []string) int {
+	c := exec.Command("sh", append([]string{"-c", script, name}, args...)...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := c.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode()
+		}
+		fmt.Fprintf(os.Stderr, "Error: alias '%s': %v\n", name, err)
+		return output.ExitError
+	}
+	return 0
+}
+
+// splitCommandLine splits s into words like a POSIX shell: whitespace
+// separates words, quotes group them and backslashes escape
+func splitCommandLine(s string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errors.New("unterminated quote or escape")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
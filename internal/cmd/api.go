@@ -0,0 +1,469 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// NewAPICmd creates the api command group
+func NewAPICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "api",
+		Short: "Make raw Linear API requests",
+		Long: `Send GraphQL requests straight to the Linear API with the stored
+credentials, for anything the other commands do not cover.
+
+The schema is documented at https://developers.linear.app.`,
+	}
+
+	cmd.AddCommand(newAPIGraphQLCmd())
+	cmd.AddCommand(newAPIPaginateCmd())
+	cmd.AddCommand(newAPIRateLimitCmd())
+
+	return cmd
+}
+
+func newAPIGraphQLCmd() *cobra.Command {
+	var (
+		query     string
+		vars      []string
+		rawFields []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "graphql",
+		Short: "Run a GraphQL query or mutation",
+		Long: `Run an arbitrary GraphQL query or mutation and print the raw JSON
+response, including any errors the server reports.
+
+--query takes the document itself, @file to read it from a file, or @-
+to read it from stdin.
+
+--var key=value sets a variable; values that are valid JSON (numbers,
+true/false, null, arrays, objects) are sent typed, anything else as a
+string. --raw-var key=value always sends a string.
+
+Examples:
+  linear api graphql --query '{ viewer { id name } }'
+  linear api graphql --query @issues.graphql --var team=ENG --var first=10
+  linear api graphql --query 'query($id: String!) { issue(id: $id) { title } }' --raw-var id=ENG-123
+  echo 'mutation { issueArchive(id: "...") { success } }' | linear api graphql --query @-`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if query == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_QUERY",
+						"Query is required",
+						"Provide a GraphQL document with --query, or @file to read one",
+						"linear api graphql --query '{ viewer { id } }'",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_QUERY",
+					"Query is required",
+					"Provide a GraphQL document with --query, or @file to read one",
+					"linear api graphql --query '{ viewer { id } }'",
+				)
+			}
+
+			document, err := readQueryArg(query)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FILE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_FILE", err.Error())
+			}
+
+			variables, err := parseAPIVariables(vars, rawFields)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_INPUT", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_INPUT", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			body, err := client.RawGraphQL(ctx, document, variables)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			return writeRawJSON(os.Stdout, body)
+		},
+	}
+
+	cmd.Flags().StringVarP(&query, "query", "q", "", "GraphQL document, @file, or @- for stdin")
+	cmd.Flags().StringArrayVarP(&vars, "var", "F", nil, "Variable as key=value, JSON-typed when valid (repeatable)")
+	cmd.Flags().StringArrayVarP(&rawFields, "raw-var", "f", nil, "String variable as key=value (repeatable)")
+
+	return cmd
+}
+
+func newAPIPaginateCmd() *cobra.Command {
+	var (
+		vars      []string
+		rawFields []string
+		path      string
+		maxPages  int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "paginate <query>",
+		Short: "Fetch every page of a connection as newline-delimited JSON",
+		Long: `Run a GraphQL query over a connection, follow its pageInfo cursor until
+the last page, and print each node as one line of JSON.
+
+The query must declare an $after variable, pass it to the connection, and
+select pageInfo { hasNextPage endCursor } alongside nodes (or edges { node }).
+The first connection found in the response is walked; use --path to pick
+another, e.g. --path team.issues.
+
+The query may be given inline, as @file, or as @- for stdin. Variables
+work as in 'linear api graphql'.
+
+Examples:
+  linear api paginate 'query($after: String) {
+    issues(first: 100, after: $after) {
+      nodes { identifier title }
+      pageInfo { hasNextPage endCursor }
+    }
+  }' > issues.ndjson
+
+  linear api paginate @team-issues.graphql --raw-var team=ENG --path team.issues`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			document, err := readQueryArg(args[0])
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FILE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_FILE", err.Error())
+			}
+
+			if !strings.Contains(document, "$after") || !strings.Contains(document, "pageInfo") {
+				msg := "Query must declare and use an $after variable and select pageInfo { hasNextPage endCursor }"
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint("INVALID_QUERY", msg, "Pass after: $after to the connection you want to walk",
+						"linear api paginate 'query($after: String) { issues(first: 100, after: $after) { nodes { id } pageInfo { hasNextPage endCursor } } }'")
+					return nil
+				}
+				return output.ErrorWithHint("INVALID_QUERY", msg, "Pass after: $after to the connection you want to walk",
+					"linear api paginate 'query($after: String) { issues(first: 100, after: $after) { nodes { id } pageInfo { hasNextPage endCursor } } }'")
+			}
+
+			variables, err := parseAPIVariables(vars, rawFields)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_INPUT", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_INPUT", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			for page := 1; maxPages <= 0 || page <= maxPages; page++ {
+				body, err := client.RawGraphQL(ctx, document, variables)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+
+				conn, err := findConnection(body, path)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("API_ERROR", fmt.Sprintf("page %d: %v", page, err))
+						return nil
+					}
+					return output.Error("API_ERROR", fmt.Sprintf("page %d: %v", page, err))
+				}
+
+				for _, node := range conn.nodes() {
+					if err := encoder.Encode(node); err != nil {
+						return err
+					}
+				}
+
+				if !conn.PageInfo.HasNextPage || conn.PageInfo.EndCursor == "" {
+					break
+				}
+				variables["after"] = conn.PageInfo.EndCursor
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&vars, "var", "F", nil, "Variable as key=value, JSON-typed when valid (repeatable)")
+	cmd.Flags().StringArrayVarP(&rawFields, "raw-var", "f", nil, "String variable as key=value (repeatable)")
+	cmd.Flags().StringVar(&path, "path", "", "Dot path to the connection in the response data (default: first found)")
+	cmd.Flags().IntVar(&maxPages, "max-pages", 0, "Stop after this many pages (0 = no limit)")
+
+	return cmd
+}
+
+func newAPIRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ratelimit",
+		Short: "Show the remaining API rate limit budget",
+		Long: `Make a minimal request and show the request and complexity budget the
+API reports for the current credentials, and when each resets.
+
+Requests that hit the rate limit or a transient server error are retried
+automatically with exponential backoff; see --max-retries and --retry-delay.
+
+Examples:
+  linear api ratelimit
+  linear api ratelimit --human`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			rl, err := client.GetRateLimit(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				printRateLimitBudget("Requests", rl.RequestsRemaining, rl.RequestsLimit, rl.RequestsReset)
+				printRateLimitBudget("Complexity", rl.ComplexityRemaining, rl.ComplexityLimit, rl.ComplexityReset)
+				return nil
+			}
+
+			output.JSON(map[string]interface{}{
+				"rateLimit": rl,
+			})
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printRateLimitBudget prints one rate limit budget line with a usage bar
+func printRateLimitBudget(name string, remaining, limit int, reset time.Time) {
+	if limit <= 0 {
+		output.HumanLn("%-11s %s", name, output.Muted("not reported"))
+		return
+	}
+	resets := ""
+	if !reset.IsZero() {
+		resets = "resets in " + time.Until(reset).Round(time.Second).String()
+	}
+	output.HumanLn("%-11s %s %d/%d remaining  %s", name,
+		progressBar(float64(remaining)/float64(limit), dashboardBarWidth), remaining, limit, output.Muted("%s", resets))
+}
+
+// rawConnection is a GraphQL connection in a raw response
+type rawConnection struct {
+	Nodes []json.RawMessage `json:"nodes"`
+	Edges []struct {
+		Node json.RawMessage `json:"node"`
+	} `json:"edges"`
+	PageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+}
+
+// nodes returns the connection's nodes, from nodes or edges
+func (c *rawConnection) nodes() []json.RawMessage {
+	if len(c.Nodes) > 0 {
+		return c.Nodes
+	}
+	nodes := make([]json.RawMessage, len(c.Edges))
+	for i, e := range c.Edges {
+		nodes[i] = e.Node
+	}
+	return nodes
+}
+
+// findConnection locates the connection at path (or the first object with
+// pageInfo) in a raw response, failing on GraphQL errors
+func findConnection(body []byte, path string) (*rawConnection, error) {
+	var resp struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Errors) > 0 {
+		messages := make([]string, len(resp.Errors))
+		for i, e := range resp.Errors {
+			messages[i] = e.Message
+		}
+		return nil, fmt.Errorf("%s", strings.Join(messages, "; "))
+	}
+
+	var found interface{}
+	if path != "" {
+		found = resp.Data
+		for _, key := range strings.Split(path, ".") {
+			obj, ok := found.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("path %q not found in response", path)
+			}
+			found = obj[key]
+		}
+	} else {
+		found = firstConnection(resp.Data)
+	}
+
+	obj, ok := found.(map[string]interface{})
+	if !ok || obj["pageInfo"] == nil {
+		return nil, fmt.Errorf("no connection with pageInfo found in response")
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var conn rawConnection
+	if err := json.Unmarshal(data, &conn); err != nil {
+		return nil, err
+	}
+	return &conn, nil
+}
+
+// firstConnection returns the first object with a pageInfo field, searching
+// breadth-first in key order
+func firstConnection(value interface{}) interface{} {
+	queue := []interface{}{value}
+	for len(queue) > 0 {
+		obj, ok := queue[0].(map[string]interface{})
+		queue = queue[1:]
+		if !ok {
+			continue
+		}
+		if _, ok := obj["pageInfo"]; ok {
+			return obj
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			queue = append(queue, obj[k])
+		}
+	}
+	return nil
+}
+
+// readQueryArg returns a query given inline, as @file, or as @- for stdin
+func readQueryArg(value string) (string, error) {
+	path, ok := strings.CutPrefix(value, "@")
+	if !ok {
+		return value, nil
+	}
+
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return "", fmt.Errorf("query file %s is empty", path)
+	}
+	return string(data), nil
+}
+
+// parseAPIVariables builds GraphQL variables from typed and raw key=value
+// pairs
+func parseAPIVariables(typed, raw []string) (map[string]interface{}, error) {
+	variables := map[string]interface{}{}
+
+	for _, pair := range typed {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable %q (use key=value)", pair)
+		}
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err == nil {
+			variables[key] = decoded
+		} else {
+			variables[key] = value
+		}
+	}
+
+	for _, pair := range raw {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable %q (use key=value)", pair)
+		}
+		variables[key] = value
+	}
+
+	return variables, nil
+}
+
+// writeRawJSON writes a JSON document indented like other command output
+func writeRawJSON(w io.Writer, data []byte) error {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(w)
+	return err
+}
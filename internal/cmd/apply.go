@@ -0,0 +1,584 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/manifest"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+// Statuses of an apply operation
+const (
+	applyApplied    = "applied"
+	applyPlanned    = "planned"
+	applyFailed     = "failed"
+	applySkipped    = "skipped"
+	applyRolledBack = "rolled_back"
+)
+
+// ApplyResult is the outcome of one manifest operation
+type ApplyResult struct {
+	Index  int    `json:"index"`
+	Op     string `json:"op"`
+	Status string `json:"status"`
+	// Issue is the issue the operation created or acted on
+	Issue   string `json:"issue,omitempty"`
+	Related string `json:"related,omitempty"`
+	// ID and URL identify what the operation created
+	ID    string `json:"id,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Title string `json:"title,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// ApplyResponse is the response for 'linear apply'
+type ApplyResponse struct {
+	Success    bool          `json:"success"`
+	DryRun     bool          `json:"dryRun"`
+	File       string        `json:"file"`
+	Results    []ApplyResult `json:"results"`
+	Applied    int           `json:"applied"`
+	Failed     int           `json:"failed"`
+	Skipped    int           `json:"skipped"`
+	RolledBack int           `json:"rolledBack"`
+}
+
+// NewApplyCmd creates the apply command
+func NewApplyCmd() *cobra.Command {
+	var (
+		filePath        string
+		teamKey         string
+		dryRun          bool
+		continueOnError bool
+		rollback        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Run a batch of changes from a YAML or JSON file",
+		Long: `Run the operations in a YAML or JSON manifest, in order: create issues,
+update them, comment on them and relate them.
+
+The manifest is a list of operations, or a mapping with a default team and
+an operations list. Each operation has an op and its fields:
+
+  create_issue   title (required), team, description, state, assignee,
+                 priority, estimate, labels, project, parent, due_date
+  update_issue   issue (required) and any create_issue field but team
+  comment        issue, body (required)
+  relate         issue, related (required), type: related (default),
+                 blocks, blocked_by or duplicate
+
+Any field may reference what an earlier operation created:
+$issue[N].id, $issue[N].identifier and $issue[N].url for the Nth
+create_issue, and $comment[N].id for the Nth comment, counting from 0.
+
+The whole manifest is checked before anything runs. Execution stops at the
+first failed operation and the rest are skipped, unless --continue-on-error
+is set; operations that reference a failed one fail too. --rollback deletes
+the issues and comments this run created when an operation fails (updates
+and relations are not undone). --dry-run resolves every team, state, label,
+user and issue without changing anything.
+
+The report lists every operation with its status: applied, planned,
+failed, skipped or rolled_back.
+
+Example manifest:
+  team: ENG
+  operations:
+    - op: create_issue
+      title: Checkout redesign
+      labels: [feature]
+    - op: create_issue
+      title: Update payment form
+      parent: $issue[0].id
+      assignee: self
+    - op: relate
+      issue: $issue[1].identifier
+      related: ENG-42
+      type: blocks
+    - op: comment
+      issue: $issue[0].id
+      body: Broken down into $issue[1].identifier
+
+Examples:
+  linear apply -f changes.yaml --dry-run
+  linear apply -f changes.yaml --rollback
+  generate-changes | linear apply -f -`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filePath == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_FILE",
+						"Manifest file is required",
+						"Provide a YAML or JSON manifest using the --file flag, or - for stdin",
+						"linear apply -f changes.yaml",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_FILE",
+					"Manifest file is required",
+					"Provide a YAML or JSON manifest using the --file flag, or - for stdin",
+					"linear apply -f changes.yaml",
+				)
+			}
+			if continueOnError && rollback {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", "--rollback cannot be combined with --continue-on-error")
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", "--rollback cannot be combined with --continue-on-error")
+			}
+
+			m, err := manifest.Load(filePath)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FILE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_FILE", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			if teamKey == "" {
+				teamKey = m.Team
+			}
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+
+			a := &applier{
+				client:      client,
+				dryRun:      dryRun,
+				defaultTeam: teamKey,
+				teams:       map[string]string{},
+				issueRefs:   map[string]appliedIssue{},
+			}
+			resp := &ApplyResponse{DryRun: dryRun, File: filePath, Results: []ApplyResult{}}
+
+			stopped := false
+			for i, op := range m.Operations {
+				if stopped {
+					resp.Results = append(resp.Results, ApplyResult{Index: i, Op: op.Op, Status: applySkipped, Title: op.Title})
+					a.skip(op)
+					continue
+				}
+
+				result := a.apply(ctx, i, op)
+				resp.Results = append(resp.Results, result)
+				if result.Status == applyFailed && !continueOnError && !dryRun {
+					stopped = true
+				}
+				if IsHumanOutput() {
+					printApplyProgress(result, len(m.Operations))
+				}
+			}
+
+			if rollback && stopped && !dryRun {
+				a.rollback(ctx, resp.Results)
+			}
+
+			for _, r := range resp.Results {
+				switch r.Status {
+				case applyApplied, applyPlanned:
+					resp.Applied++
+				case applyFailed:
+					resp.Failed++
+				case applySkipped:
+					resp.Skipped++
+				case applyRolledBack:
+					resp.RolledBack++
+				}
+			}
+			resp.Success = resp.Failed == 0
+			if resp.Failed > 0 {
+				output.Fail("APPLY_FAILED")
+			}
+
+			if IsHumanOutput() {
+				printApplyHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "YAML or JSON manifest (- for stdin)")
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team for create_issue operations that don't name one")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate and resolve every operation without changing anything")
+	cmd.Flags().BoolVar(&continueOnError, "continue-on-error", false, "Keep going after an operation fails")
+	cmd.Flags().BoolVar(&rollback, "rollback", false, "Delete the issues and comments created by this run if an operation fails")
+
+	return cmd
+}
+
+// appliedIssue is an issue an operation may act on
+type appliedIssue struct {
+	ID         string
+	Identifier string
+	TeamID     string
+}
+
+// applier runs manifest operations in order, remembering what they
+// created for later references
+type applier struct {
+	client      *api.Client
+	dryRun      bool
+	defaultTeam string
+
+	// teams maps team keys to IDs
+	teams map[string]string
+	// issueRefs maps the IDs and identifiers of issues seen so far
+	issueRefs map[string]appliedIssue
+	// issues and comments hold what each create_issue and comment
+	// operation created, nil when it did not succeed
+	issues   []*manifest.Result
+	comments []*manifest.Result
+}
+
+// skip records that an operation created nothing
+func (a *applier) skip(op *manifest.Operation) {
+	switch op.Op {
+	case manifest.OpCreateIssue:
+		a.issues = append(a.issues, nil)
+	case manifest.OpComment:
+		a.comments = append(a.comments, nil)
+	}
+}
+
+// apply runs one operation, or in a dry run resolves it and records
+// placeholders for what it would create
+func (a *applier) apply(ctx context.Context, index int, op *manifest.Operation) ApplyResult {
+	result := ApplyResult{Index: index, Op: op.Op, Title: op.Title}
+	fail := func(err error) ApplyResult {
+		a.skip(op)
+		result.Status = applyFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	expanded, err := op.Expand(a.issues, a.comments)
+	if err != nil {
+		return fail(err)
+	}
+	op = expanded
+	result.Title = op.Title
+
+	switch op.Op {
+	case manifest.OpCreateIssue:
+		input, err := a.createInput(ctx, op)
+		if err != nil {
+			return fail(err)
+		}
+		n := len(a.issues)
+		created := &api.IssueCreateResponse{
+			ID:         fmt.Sprintf("$issue[%d].id", n),
+			Identifier: fmt.Sprintf("$issue[%d].identifier", n),
+			URL:        fmt.Sprintf("$issue[%d].url", n),
+		}
+		if !a.dryRun {
+			if created, err = a.client.CreateIssue(ctx, *input); err != nil {
+				return fail(err)
+			}
+		}
+		a.issues = append(a.issues, &manifest.Result{ID: created.ID, Identifier: created.Identifier, URL: created.URL})
+		a.remember(appliedIssue{ID: created.ID, Identifier: created.Identifier, TeamID: input.TeamID})
+		result.Issue, result.ID, result.URL = created.Identifier, created.ID, created.URL
+
+	case manifest.OpUpdateIssue:
+		issue, err := a.issue(ctx, op.Issue)
+		if err != nil {
+			return fail(err)
+		}
+		input, err := a.updateInput(ctx, op, issue.TeamID)
+		if err != nil {
+			return fail(err)
+		}
+		if !a.dryRun {
+			if _, err := a.client.UpdateIssue(ctx, issue.ID, *input); err != nil {
+				return fail(err)
+			}
+		}
+		result.Issue = issue.Identifier
+
+	case manifest.OpComment:
+		issue, err := a.issue(ctx, op.Issue)
+		if err != nil {
+			return fail(err)
+		}
+		comment := &api.Comment{ID: fmt.Sprintf("$comment[%d].id", len(a.comments))}
+		if !a.dryRun {
+			if comment, err = a.client.CreateComment(ctx, issue.ID, op.Body); err != nil {
+				return fail(err)
+			}
+		}
+		a.comments = append(a.comments, &manifest.Result{ID: comment.ID})
+		result.Issue, result.ID = issue.Identifier, comment.ID
+
+	case manifest.OpRelate:
+		issue, err := a.issue(ctx, op.Issue)
+		if err != nil {
+			return fail(err)
+		}
+		related, err := a.issue(ctx, op.Related)
+		if err != nil {
+			return fail(err)
+		}
+		if !a.dryRun {
+			if err := a.client.CreateIssueRelation(ctx, issue.ID, related.ID, op.Type); err != nil {
+				return fail(err)
+			}
+		}
+		result.Issue, result.Related = issue.Identifier, related.Identifier
+	}
+
+	result.Status = applyApplied
+	if a.dryRun {
+		result.Status = applyPlanned
+	}
+	return result
+}
+
+// remember records an issue under its ID and identifier
+func (a *applier) remember(issue appliedIssue) {
+	a.issueRefs[issue.ID] = issue
+	a.issueRefs[issue.Identifier] = issue
+}
+
+// issue looks up an issue by reference, fetching it unless an earlier
+// operation already created or fetched it
+func (a *applier) issue(ctx context.Context, ref string) (appliedIssue, error) {
+	if issue, ok := a.issueRefs[ref]; ok {
+		return issue, nil
+	}
+	id, err := resolveRef(resolver.KindIssue, ref)
+	if err != nil {
+		return appliedIssue{}, err
+	}
+	if issue, ok := a.issueRefs[id]; ok {
+		return issue, nil
+	}
+	detail, err := a.client.GetIssue(ctx, id, false)
+	if err != nil {
+		return appliedIssue{}, fmt.Errorf("issue %s: %w", ref, err)
+	}
+	issue := appliedIssue{ID: detail.ID, Identifier: detail.Identifier, TeamID: detail.Team.ID}
+	a.remember(issue)
+	return issue, nil
+}
+
+// teamID resolves a team key, falling back to the default team
+func (a *applier) teamID(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		key = a.defaultTeam
+	}
+	if key == "" {
+		return "", fmt.Errorf("team is required: set team on the operation or the manifest, pass --team, or configure a default team")
+	}
+	if isUUID(key) {
+		return key, nil
+	}
+	if id, ok := a.teams[strings.ToUpper(key)]; ok {
+		return id, nil
+	}
+	team, err := a.client.GetTeamByKey(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	if team == nil {
+		return "", fmt.Errorf("team '%s' not found", key)
+	}
+	a.teams[strings.ToUpper(key)] = team.ID
+	return team.ID, nil
+}
+
+// issueFields holds the fields create_issue and update_issue share,
+// resolved to IDs for the issue's team
+type issueFields struct {
+	priority   *int
+	estimate   *float64
+	dueDate    string
+	stateID    string
+	assigneeID string
+	labelIDs   []string
+	parentID   string
+}
+
+func (a *applier) resolveFields(ctx context.Context, op *manifest.Operation, teamID string) (*issueFields, error) {
+	f := &issueFields{}
+	var err error
+
+	if op.Priority != "" {
+		priority, err := parsePriority(op.Priority)
+		if err != nil {
+			return nil, err
+		}
+		f.priority = &priority
+	}
+	if op.Estimate != "" {
+		estimate, err := strconv.ParseFloat(op.Estimate, 64)
+		if err != nil || estimate < 0 {
+			return nil, fmt.Errorf("invalid estimate '%s'", op.Estimate)
+		}
+		f.estimate = &estimate
+	}
+	if f.dueDate, err = resolveDueDate(op.DueDate); err != nil {
+		return nil, err
+	}
+	if f.stateID, err = resolveStateID(ctx, a.client, teamID, op.State, ""); err != nil {
+		return nil, err
+	}
+	if f.assigneeID, err = resolveUserID(ctx, a.client, op.Assignee); err != nil {
+		return nil, err
+	}
+	if len(op.Labels) > 0 {
+		if f.labelIDs, err = resolveLabelIDs(ctx, a.client, teamID, op.Labels, false); err != nil {
+			return nil, err
+		}
+	}
+	if op.Parent != "" {
+		parent, err := a.issue(ctx, op.Parent)
+		if err != nil {
+			return nil, err
+		}
+		f.parentID = parent.ID
+	}
+	return f, nil
+}
+
+func (a *applier) createInput(ctx context.Context, op *manifest.Operation) (*api.IssueCreateInput, error) {
+	teamID, err := a.teamID(ctx, op.Team)
+	if err != nil {
+		return nil, err
+	}
+	f, err := a.resolveFields(ctx, op, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.IssueCreateInput{
+		Title:       op.Title,
+		TeamID:      teamID,
+		Description: op.Description,
+		AssigneeID:  f.assigneeID,
+		Priority:    f.priority,
+		Estimate:    f.estimate,
+		DueDate:     f.dueDate,
+		LabelIDs:    f.labelIDs,
+		ProjectID:   op.Project,
+		StateID:     f.stateID,
+		ParentID:    f.parentID,
+	}, nil
+}
+
+func (a *applier) updateInput(ctx context.Context, op *manifest.Operation, teamID string) (*api.IssueUpdateInput, error) {
+	f, err := a.resolveFields(ctx, op, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.IssueUpdateInput{
+		Title:       op.Title,
+		Description: optionalString(op.Description),
+		AssigneeID:  f.assigneeID,
+		Priority:    f.priority,
+		Estimate:    f.estimate,
+		DueDate:     f.dueDate,
+		LabelIDs:    f.labelIDs,
+		ProjectID:   op.Project,
+		StateID:     f.stateID,
+		ParentID:    f.parentID,
+	}, nil
+}
+
+// rollback deletes the issues and comments created by this run, newest
+// first, and marks their operations rolled back
+func (a *applier) rollback(ctx context.Context, results []ApplyResult) {
+	for i := len(results) - 1; i >= 0; i-- {
+		r := &results[i]
+		if r.Status != applyApplied {
+			continue
+		}
+		var err error
+		switch r.Op {
+		case manifest.OpCreateIssue:
+			err = a.client.DeleteIssue(ctx, r.ID)
+		case manifest.OpComment:
+			err = a.client.DeleteComment(ctx, r.ID)
+		default:
+			continue
+		}
+		if err != nil {
+			r.Error = "rollback failed: " + err.Error()
+			continue
+		}
+		r.Status = applyRolledBack
+	}
+}
+
+// describeApplyResult summarizes what an operation did, for humans
+func describeApplyResult(r ApplyResult) string {
+	switch r.Op {
+	case manifest.OpCreateIssue:
+		return fmt.Sprintf("create %s %s", r.Issue, display.Truncate(r.Title, 60))
+	case manifest.OpUpdateIssue:
+		return fmt.Sprintf("update %s", r.Issue)
+	case manifest.OpComment:
+		return fmt.Sprintf("comment on %s", r.Issue)
+	case manifest.OpRelate:
+		return fmt.Sprintf("relate %s to %s", r.Issue, r.Related)
+	}
+	return r.Op
+}
+
+func printApplyProgress(r ApplyResult, total int) {
+	switch r.Status {
+	case applyFailed:
+		output.HumanLn("[%d/%d] %s %s: %s", r.Index+1, total, output.Red("✗"), r.Op, r.Error)
+	case applyPlanned:
+		output.HumanLn("[%d/%d] %s %s", r.Index+1, total, output.Muted("○"), describeApplyResult(r))
+	default:
+		output.HumanLn("[%d/%d] %s %s", r.Index+1, total, output.Green("✓"), describeApplyResult(r))
+	}
+}
+
+func printApplyHuman(resp *ApplyResponse) {
+	for _, r := range resp.Results {
+		switch r.Status {
+		case applySkipped:
+			output.HumanLn("[%d/%d] %s %s skipped", r.Index+1, len(resp.Results), output.Muted("-"), r.Op)
+		case applyRolledBack:
+			output.HumanLn("[%d/%d] %s %s rolled back", r.Index+1, len(resp.Results), output.Yellow("↺"), describeApplyResult(r))
+		}
+		if r.Status == applyApplied && r.Error != "" {
+			output.HumanLn("[%d/%d] %s %s", r.Index+1, len(resp.Results), output.Red("✗"), r.Error)
+		}
+	}
+
+	if resp.DryRun {
+		output.HumanLn("\nDry run: %d operations would be applied, %d failed", resp.Applied, resp.Failed)
+		return
+	}
+	summary := fmt.Sprintf("\n%d applied, %d failed, %d skipped", resp.Applied, resp.Failed, resp.Skipped)
+	if resp.RolledBack > 0 {
+		summary += fmt.Sprintf(", %d rolled back", resp.RolledBack)
+	}
+	output.HumanLn("%s", summary)
+}
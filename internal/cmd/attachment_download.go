@@ -0,0 +1,340 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// attachmentManifestFile is written to the download directory after every
+// run, with a numeric suffix when an earlier run left one there
+const attachmentManifestFile = "manifest.json"
+
+// DownloadedAttachment is a single manifest entry for a downloaded attachment
+type DownloadedAttachment struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	File        string `json:"file,omitempty"`
+	Bytes       int64  `json:"bytes"`
+	ContentType string `json:"contentType,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// AttachmentDownloadResponse is the response for attachment download
+type AttachmentDownloadResponse struct {
+	Success     bool                   `json:"success"`
+	Issue       string                 `json:"issue,omitempty"`
+	Dir         string                 `json:"dir"`
+	Manifest    string                 `json:"manifest"`
+	Downloaded  int                    `json:"downloaded"`
+	Failed      int                    `json:"failed"`
+	Attachments []DownloadedAttachment `json:"attachments"`
+}
+
+func newIssueAttachmentDownloadCmd() *cobra.Command {
+	var (
+		all     bool
+		issueID string
+		dir     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "download [attachment-id]",
+		Short: "Download attachment targets to local files",
+		Long: `Download the files behind issue attachments.
+
+Files hosted on uploads.linear.app are fetched with your credentials; other
+URLs are fetched anonymously. Files are named after the attachment title and
+a manifest.json describing every download is written to the target directory.
+Existing files, including the manifest of an earlier run, are never
+overwritten; a numeric suffix is added instead.
+
+Examples:
+  linear issue attachment download abc123
+  linear issue attachment download --all --issue ENG-123 --dir ./artifacts`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && !(all && issueID != "") {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_FIELD",
+						"An attachment ID or --all --issue is required",
+						"Download a single attachment by ID, or every attachment on an issue",
+						"linear issue attachment download <attachment-id>",
+						"linear issue attachment download --all --issue ENG-123",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_FIELD",
+					"An attachment ID or --all --issue is required",
+					"Download a single attachment by ID, or every attachment on an issue",
+					"linear issue attachment download <attachment-id>",
+					"linear issue attachment download --all --issue ENG-123",
+				)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			var attachments []api.Attachment
+			if len(args) == 1 {
+				attachment, err := client.GetAttachment(ctx, args[0])
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if attachment == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Attachment '%s' not found", args[0]))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Attachment '%s' not found", args[0]))
+				}
+				attachments = append(attachments, *attachment)
+			} else {
+				resp, err := client.GetIssueAttachments(ctx, issueID)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				attachments = resp.Attachments
+			}
+
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("FILE_ERROR", fmt.Sprintf("Failed to create directory: %s", err.Error()))
+					return nil
+				}
+				return output.Error("FILE_ERROR", fmt.Sprintf("Failed to create directory: %s", err.Error()))
+			}
+
+			response := &AttachmentDownloadResponse{
+				Success:     true,
+				Issue:       issueID,
+				Dir:         dir,
+				Attachments: []DownloadedAttachment{},
+			}
+
+			used := map[string]bool{attachmentManifestFile: true}
+			for _, a := range attachments {
+				entry := downloadAttachment(ctx, client, a, dir, used)
+				if entry.Error != "" {
+					response.Failed++
+				} else {
+					response.Downloaded++
+				}
+				response.Attachments = append(response.Attachments, entry)
+			}
+
+			if response.Failed > 0 {
+				response.Success = false
+				output.Fail("DOWNLOAD_FAILED")
+			}
+
+			delete(used, attachmentManifestFile)
+			if err := writeAttachmentManifest(response, used); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("FILE_ERROR", fmt.Sprintf("Failed to write manifest: %s", err.Error()))
+					return nil
+				}
+				return output.Error("FILE_ERROR", fmt.Sprintf("Failed to write manifest: %s", err.Error()))
+			}
+
+			if IsHumanOutput() {
+				printAttachmentDownloadsHuman(response)
+			} else {
+				output.JSON(response)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "Download every attachment on the issue given by --issue")
+	cmd.Flags().StringVarP(&issueID, "issue", "i", "", "Issue identifier (e.g., ENG-123) used with --all")
+	cmd.Flags().StringVarP(&dir, "dir", "d", ".", "Directory to write files to")
+
+	return cmd
+}
+
+// downloadAttachment fetches a single attachment into dir, recording the
+// chosen file name in used so later downloads never overwrite it.
+func downloadAttachment(ctx context.Context, client *api.Client, a api.Attachment, dir string, used map[string]bool) DownloadedAttachment {
+	entry := DownloadedAttachment{
+		ID:    a.ID,
+		Title: a.Title,
+		URL:   a.URL,
+	}
+
+	resp, err := client.Download(ctx, a.URL)
+	if err != nil {
+		entry.Error = err.Error()
+		return entry
+	}
+	defer resp.Body.Close()
+
+	entry.ContentType = resp.Header.Get("Content-Type")
+	file, name, err := createUnique(dir, attachmentFilename(a, entry.ContentType), used)
+	if err != nil {
+		entry.Error = err.Error()
+		return entry
+	}
+
+	n, err := io.Copy(file, resp.Body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	entry.Bytes = n
+	if err != nil {
+		// Leave no truncated file behind
+		os.Remove(file.Name())
+		entry.Error = err.Error()
+		return entry
+	}
+
+	entry.File = name
+	return entry
+}
+
+// attachmentFilename derives a safe local file name from an attachment's
+// title, falling back to the URL path, and adds an extension when missing.
+func attachmentFilename(a api.Attachment, contentType string) string {
+	urlBase := ""
+	if u, err := url.Parse(a.URL); err == nil {
+		urlBase = path.Base(u.Path)
+		if urlBase == "/" || urlBase == "." {
+			urlBase = ""
+		}
+	}
+
+	name := sanitizeFilename(a.Title)
+	if name == "" {
+		name = sanitizeFilename(urlBase)
+	}
+	if name == "" {
+		name = a.ID
+	}
+
+	if filepath.Ext(name) == "" {
+		ext := filepath.Ext(urlBase)
+		if ext == "" && contentType != "" {
+			if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+				if exts, err := mime.ExtensionsByType(mediaType); err == nil && len(exts) > 0 {
+					ext = exts[0]
+				}
+			}
+		}
+		name += sanitizeFilename(ext)
+	}
+
+	return name
+}
+
+// sanitizeFilename replaces characters that are unsafe in file names and
+// strips leading dots so a title can never escape the target directory.
+func sanitizeFilename(s string) string {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(s) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+
+	name := strings.TrimLeft(b.String(), "._")
+	if len(name) > 120 {
+		name = name[:120]
+	}
+	return name
+}
+
+// writeAttachmentManifest writes the manifest for response to a new file in
+// its directory, named like attachments so that an earlier manifest is
+// never overwritten, and records the path on response
+func writeAttachmentManifest(response *AttachmentDownloadResponse, used map[string]bool) error {
+	manifest, err := output.JSONString(response.Attachments)
+	if err != nil {
+		return err
+	}
+	file, name, err := createUnique(response.Dir, attachmentManifestFile, used)
+	if err != nil {
+		return err
+	}
+	response.Manifest = filepath.Join(response.Dir, name)
+	if _, err := file.WriteString(manifest + "\n"); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// createUnique creates a new file in dir for name, adding a numeric suffix
+// when the name was used by this run or a file of that name already exists
+func createUnique(dir, name string, used map[string]bool) (*os.File, string, error) {
+	for {
+		candidate := uniqueFilename(name, used)
+		file, err := os.OpenFile(filepath.Join(dir, candidate), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if os.IsExist(err) {
+			continue
+		}
+		return file, candidate, err
+	}
+}
+
+// uniqueFilename appends a numeric suffix until name is not in used
+func uniqueFilename(name string, used map[string]bool) string {
+	candidate := name
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s-%d%s", base, i, ext)
+	}
+	used[candidate] = true
+	return candidate
+}
+
+func printAttachmentDownloadsHuman(response *AttachmentDownloadResponse) {
+	if len(response.Attachments) == 0 {
+		output.HumanLn("No attachments to download")
+		return
+	}
+
+	for _, a := range response.Attachments {
+		if a.Error != "" {
+			output.HumanLn("%s %s %s", output.Red("✗"), a.Title, output.Muted("(%s)", a.Error))
+			continue
+		}
+		output.HumanLn("%s %s %s", output.Green("✓"), filepath.Join(response.Dir, a.File), output.Muted("(%d bytes)", a.Bytes))
+	}
+
+	output.HumanLn("\n%d downloaded, %d failed", response.Downloaded, response.Failed)
+	output.HumanLn("Manifest: %s", response.Manifest)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
 	"github.com/juanbermudez/agent-linear-cli/internal/auth"
 	"github.com/juanbermudez/agent-linear-cli/internal/config"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 )
@@ -25,19 +26,28 @@ func NewAuthCmd() *cobra.Command {
 
 Authentication methods (in priority order):
   1. Environment variables: LINEAR_API_KEY or LINEAR_CLIENT_ID + LINEAR_CLIENT_SECRET
-  2. System keychain (secure storage)
+  2. Stored credentials: the system keychain, or an encrypted file
   3. Config file (legacy fallback)
 
+Where no keychain is available (CI, containers), --no-keychain stores
+credentials in a file encrypted with the passphrase in
+LINEAR_CREDENTIALS_KEY, at LINEAR_CREDENTIALS_FILE or
+~/.config/agent-linear-cli/credentials.enc. Later commands use the file
+when LINEAR_CREDENTIALS_FILE is set or the default file exists.
+
 Examples:
   linear auth                    # Interactive login (prompts for method)
   linear auth status             # Check authentication status
-  linear auth logout             # Remove stored credentials`,
+  linear auth logout             # Remove stored credentials
+  echo $KEY | LINEAR_CREDENTIALS_KEY=... linear auth login --stdin --no-keychain`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Running "linear auth" without subcommand triggers interactive login
-			return runInteractiveAuth()
+			return runInteractiveAuth(cmd.Context(), newAuthManager(cmd))
 		},
 	}
 
+	cmd.PersistentFlags().Bool("no-keychain", false, "Store credentials in an encrypted file instead of the system keychain")
+
 	cmd.AddCommand(newAuthLoginCmd())
 	cmd.AddCommand(newAuthStatusCmd())
 	cmd.AddCommand(newAuthLogoutCmd())
@@ -46,11 +56,30 @@ Examples:
 	return cmd
 }
 
-// runInteractiveAuth prompts the user to choose an auth method
-func runInteractiveAuth() error {
-	manager := auth.NewManager()
-	ctx := context.Background()
+// newAuthManager returns the auth manager for --no-keychain, or the
+// default one
+func newAuthManager(cmd *cobra.Command) *auth.Manager {
+	if noKeychain, _ := cmd.Flags().GetBool("no-keychain"); noKeychain {
+		return auth.NewFileManager()
+	}
+	return auth.NewManager()
+}
+
+// storedMessage describes where credentials were stored, warning about
+// file storage
+func storedMessage(manager *auth.Manager, what string) {
+	backend, path := manager.Storage()
+	if backend != auth.BackendFile {
+		fmt.Printf("  %s stored securely in system keychain\n", what)
+		return
+	}
+	fmt.Printf("  %s stored in encrypted file %s\n", what, path)
+	color.Yellow("  Warning: anyone with this file and %s can use these credentials", auth.CredentialsKeyEnv)
+	color.Yellow("  Keep the passphrase in your CI secret store, not next to the file")
+}
 
+// runInteractiveAuth prompts the user to choose an auth method
+func runInteractiveAuth(ctx context.Context, manager *auth.Manager) error {
 	fmt.Println("Linear CLI Authentication")
 	fmt.Println()
 	fmt.Println("Choose authentication method:")
@@ -106,9 +135,10 @@ Examples:
   linear auth login --with-token --team ENG   # Set up with default team
   linear auth login --client-credentials      # Set up OAuth client credentials
   echo $TOKEN | linear auth login --stdin     # Read from stdin (for scripts)`,
+		Annotations: map[string]string{noTimeoutAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			manager := auth.NewManager()
-			ctx := context.Background()
+			manager := newAuthManager(cmd)
+			ctx := cmd.Context()
 
 			var err error
 			if clientCredentials {
@@ -178,14 +208,16 @@ func loginWithAPIKey(manager *auth.Manager, withToken, stdin bool) error {
 		return err
 	}
 
+	backend, path := manager.Storage()
 	if IsHumanOutput() {
 		color.Green("✓ Authentication successful")
-		fmt.Println("  Token stored securely in system keychain")
+		storedMessage(manager, "Token")
 	} else {
 		OutputJSON(map[string]interface{}{
-			"success": true,
-			"method":  "api_key",
-			"storage": "keychain",
+			"success":     true,
+			"method":      "api_key",
+			"storage":     backend,
+			"storagePath": path,
 		})
 	}
 
@@ -241,15 +273,17 @@ func loginWithClientCredentials(ctx context.Context, manager *auth.Manager, stdi
 		return err
 	}
 
+	backend, path := manager.Storage()
 	if IsHumanOutput() {
 		color.Green("✓ Authentication successful")
-		fmt.Println("  Credentials stored securely in system keychain")
+		storedMessage(manager, "Credentials")
 		fmt.Println("  Token will auto-refresh every 30 days")
 	} else {
 		OutputJSON(map[string]interface{}{
-			"success": true,
-			"method":  "client_credentials",
-			"storage": "keychain",
+			"success":     true,
+			"method":      "client_credentials",
+			"storage":     backend,
+			"storagePath": path,
 		})
 	}
 
@@ -265,11 +299,12 @@ func newAuthStatusCmd() *cobra.Command {
 Shows:
   - Whether you're authenticated
   - Authentication method (API key or client credentials)
-  - Token source (environment, keychain, or config file)
+  - Token source (environment, keychain, or encrypted file)
+  - Storage backend used by 'linear auth login' (keychain or file)
   - Token expiry (for OAuth tokens)`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			manager := auth.NewManager()
-			ctx := context.Background()
+			manager := newAuthManager(cmd)
+			ctx := cmd.Context()
 
 			status, err := manager.GetStatus(ctx)
 			if err != nil {
@@ -282,13 +317,15 @@ Shows:
 					fmt.Printf("  Method: %s\n", status.Method)
 					fmt.Printf("  Source: %s\n", status.Source)
 					if status.ExpiresAt != nil {
-						fmt.Printf("  Expires: %s\n", status.ExpiresAt.Format("2006-01-02 15:04:05"))
+						fmt.Printf("  Expires: %s\n", display.FormatDateTime(*status.ExpiresAt))
 					}
+					printStorageHuman(status)
 				} else {
 					color.Red("✗ Not authenticated")
 					fmt.Println()
 					fmt.Println("Run 'linear auth' to authenticate")
 					fmt.Println("Or set LINEAR_API_KEY environment variable")
+					printStorageHuman(status)
 				}
 			} else {
 				OutputJSON(status)
@@ -303,20 +340,22 @@ func newAuthLogoutCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "logout",
 		Short: "Remove stored credentials",
-		Long: `Remove all stored credentials from the system keychain.
+		Long: `Remove all stored credentials from the system keychain, or from the
+credentials file with --no-keychain or LINEAR_CREDENTIALS_FILE.
 
 Note: This does not affect environment variables.
 To fully logout, also unset LINEAR_API_KEY, LINEAR_CLIENT_ID, and LINEAR_CLIENT_SECRET.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			manager := auth.NewManager()
+			manager := newAuthManager(cmd)
 
 			if err := manager.Logout(); err != nil {
 				return err
 			}
 
+			backend, _ := manager.Storage()
 			if IsHumanOutput() {
 				color.Green("✓ Logged out")
-				fmt.Println("  Credentials removed from keychain")
+				fmt.Printf("  Credentials removed from %s\n", backend)
 
 				// Warn about environment variables
 				if os.Getenv("LINEAR_API_KEY") != "" {
@@ -328,7 +367,7 @@ To fully logout, also unset LINEAR_API_KEY, LINEAR_CLIENT_ID, and LINEAR_CLIENT_
 			} else {
 				OutputJSON(map[string]interface{}{
 					"success": true,
-					"message": "credentials removed from keychain",
+					"message": "credentials removed from " + backend,
 				})
 			}
 
@@ -349,8 +388,8 @@ The token is printed without a trailing newline.
 Example:
   curl -H "Authorization: $(linear auth token)" https://api.linear.app/graphql`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			manager := auth.NewManager()
-			ctx := context.Background()
+			manager := newAuthManager(cmd)
+			ctx := cmd.Context()
 
 			token, _, err := manager.GetToken(ctx)
 			if err != nil {
@@ -364,6 +403,15 @@ Example:
 	}
 }
 
+// printStorageHuman prints the credential storage backend
+func printStorageHuman(status *auth.AuthStatus) {
+	if status.StoragePath != "" {
+		fmt.Printf("  Storage: %s (%s)\n", status.Storage, status.StoragePath)
+		return
+	}
+	fmt.Printf("  Storage: %s\n", status.Storage)
+}
+
 // handlePostAuthTeamSetup sets up team config after successful authentication
 func handlePostAuthTeamSetup(ctx context.Context, teamKey string) error {
 	// Create API client to fetch teams
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/vcs"
+	"github.com/spf13/cobra"
+)
+
+// NewBranchCmd creates the branch command group
+func NewBranchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "branch",
+		Short: "Create git branches for issues",
+		Long: `Create git branches named after Linear issues.
+
+Branches use Linear's suggested name (for example user/eng-123-fix-login),
+so 'linear issue current' and commands run without an issue ID detect the
+issue from them.
+
+Examples:
+  linear branch create ENG-123`,
+	}
+
+	cmd.AddCommand(newBranchCreateCmd())
+
+	return cmd
+}
+
+func newBranchCreateCmd() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "create <issue-id>",
+		Short: "Create and check out the branch for an issue",
+		Long: `Run 'git checkout -b' with the issue's suggested branch name. If the
+branch already exists it is checked out instead.
+
+Examples:
+  linear branch create ENG-123
+  linear branch create ENG-123 --name eng-123-hotfix`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			issue, err := client.GetIssue(ctx, args[0], false)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			branch := name
+			if branch == "" {
+				branch = issue.BranchName
+			}
+			if branch == "" {
+				msg := fmt.Sprintf("Issue %s has no suggested branch name", issue.Identifier)
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_BRANCH_NAME", msg)
+					return nil
+				}
+				return output.Error("MISSING_BRANCH_NAME", msg)
+			}
+
+			created := !vcs.BranchExists(ctx, branch)
+			if created {
+				err = vcs.CreateBranch(ctx, branch)
+			} else {
+				err = vcs.Checkout(ctx, branch)
+			}
+			if err != nil {
+				code := "GIT_ERROR"
+				if errors.Is(err, vcs.ErrNotRepository) {
+					code = "NOT_A_REPOSITORY"
+				}
+				if IsHumanOutput() {
+					output.ErrorHuman(code, err.Error())
+					return nil
+				}
+				return output.Error(code, err.Error())
+			}
+
+			if IsHumanOutput() {
+				verb := "Switched to existing branch"
+				if created {
+					verb = "Created branch"
+				}
+				output.SuccessHuman(fmt.Sprintf("%s %s for %s", verb, output.Cyan("%s", branch), issue.Identifier))
+				return nil
+			}
+
+			return output.JSON(map[string]interface{}{
+				"success": true,
+				"branch":  branch,
+				"created": created,
+				"issue":   issue.Identifier,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Branch name (default: the issue's suggested branch name)")
+
+	return cmd
+}
@@ -0,0 +1,431 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// CachePolicyInfo is the cache policy in effect, from the [cache] config
+type CachePolicyInfo struct {
+	TTL         string `json:"ttl"`
+	ResponseTTL string `json:"responseTtl"`
+	// MaxSize is in bytes; 0 means no limit
+	MaxSize int64 `json:"maxSize"`
+}
+
+// CacheNamespaceStats sums the entries of one namespace
+type CacheNamespaceStats struct {
+	Namespace string `json:"namespace"`
+	Entries   int    `json:"entries"`
+	Expired   int    `json:"expired"`
+	Bytes     int64  `json:"bytes"`
+}
+
+// ResponseCacheStats describes the API response cache
+type ResponseCacheStats struct {
+	// Entries counts cached responses; Fresh those still within the TTL
+	Entries       int       `json:"entries"`
+	Fresh         int       `json:"fresh"`
+	Bytes         int64     `json:"bytes"`
+	Hits          int       `json:"hits"`
+	Misses        int       `json:"misses"`
+	Refreshes     int       `json:"refreshes"`
+	Invalidations int       `json:"invalidations"`
+	HitRate       float64   `json:"hitRate"`
+	Since         time.Time `json:"since,omitempty"`
+}
+
+// CacheStatsResponse is the response for the cache stats command
+type CacheStatsResponse struct {
+	Dir        string                `json:"dir"`
+	Policy     CachePolicyInfo       `json:"policy"`
+	Entries    int                   `json:"entries"`
+	Bytes      int64                 `json:"bytes"`
+	Namespaces []CacheNamespaceStats `json:"namespaces"`
+	Responses  ResponseCacheStats    `json:"responses"`
+}
+
+// CacheListResponse is the response for the cache list command
+type CacheListResponse struct {
+	Entries []cache.Info `json:"entries"`
+	Count   int          `json:"count"`
+	Bytes   int64        `json:"bytes"`
+}
+
+// CacheClearResponse is the response for the cache clear command
+type CacheClearResponse struct {
+	Success bool `json:"success"`
+	// Namespaces are the namespaces cleared; empty when all were
+	Namespaces []string `json:"namespaces"`
+	Removed    int      `json:"removed"`
+}
+
+// NewCacheCmd creates the cache command
+func NewCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Inspect and clear the local cache",
+		Long: `Inspect and clear the local cache.
+
+Workspace data (teams, workflow states, labels, users, cycles, projects and
+the issues pulled by 'linear sync') is cached for 24 hours. Responses to
+idempotent queries (the viewer, teams, workflow states, labels and users)
+are cached for a minute and shared between runs, so bursts of commands
+don't repeat the same lookups. Any mutation drops them, and --refresh on any
+command skips both.
+
+Entries are grouped in namespaces: ` + strings.Join(cache.Namespaces, ", ") + `.
+
+The policy is configurable:
+  linear config set cache.ttl 12h
+  linear config set cache.response_ttl 30s
+  linear config set cache.max_size 50MB`,
+	}
+
+	cmd.AddCommand(newCacheListCmd())
+	cmd.AddCommand(newCacheClearCmd())
+	cmd.AddCommand(newCacheStatsCmd())
+	cmd.AddCommand(newCachePathCmd())
+
+	return cmd
+}
+
+// cacheManagerOrError opens the cache, reporting failures
+func cacheManagerOrError() (*cache.Manager, error) {
+	m, err := cache.NewManager()
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman("CACHE_ERROR", err.Error())
+			return nil, nil
+		}
+		return nil, output.Error("CACHE_ERROR", err.Error())
+	}
+	return m, nil
+}
+
+// checkNamespaces rejects names that are not cache namespaces
+func checkNamespaces(names []string) error {
+	for _, name := range names {
+		if !containsFold(cache.Namespaces, name) {
+			return fmt.Errorf("unknown namespace %q (use %s)", name, strings.Join(cache.Namespaces, ", "))
+		}
+	}
+	return nil
+}
+
+func newCacheListCmd() *cobra.Command {
+	var (
+		namespace   string
+		expiredOnly bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List cache entries",
+		Long: `List cache entries, most recently written first.
+
+Examples:
+  linear cache list
+  linear cache list --namespace labels --human
+  linear cache list --expired`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if namespace != "" {
+				if err := checkNamespaces([]string{namespace}); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("INVALID_INPUT", err.Error())
+						return nil
+					}
+					return output.Error("INVALID_INPUT", err.Error())
+				}
+			}
+
+			m, err := cacheManagerOrError()
+			if m == nil {
+				return err
+			}
+
+			infos, err := m.List()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CACHE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CACHE_ERROR", err.Error())
+			}
+
+			resp := CacheListResponse{Entries: []cache.Info{}}
+			for _, info := range infos {
+				if namespace != "" && !strings.EqualFold(info.Namespace, namespace) {
+					continue
+				}
+				if expiredOnly && !info.Expired {
+					continue
+				}
+				resp.Entries = append(resp.Entries, info)
+				resp.Bytes += info.Size
+			}
+			resp.Count = len(resp.Entries)
+
+			if IsHumanOutput() {
+				if resp.Count == 0 {
+					output.HumanLn("No cache entries")
+					return nil
+				}
+				rows := make([][]string, len(resp.Entries))
+				for i, info := range resp.Entries {
+					updated := display.TimeAgo(info.UpdatedAt)
+					if info.Expired {
+						updated += " " + output.Muted("(expired)")
+					}
+					rows[i] = []string{dashIfEmpty(info.Namespace), info.Key, formatBytes(info.Size), updated}
+				}
+				output.TableWithColors([]string{"NAMESPACE", "KEY", "SIZE", "UPDATED"}, rows)
+				output.HumanLn("\n%d entries, %s", resp.Count, formatBytes(resp.Bytes))
+			} else {
+				output.JSON(resp)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&namespace, "namespace", "", "Only list this namespace: "+strings.Join(cache.Namespaces, ", "))
+	cmd.Flags().BoolVar(&expiredOnly, "expired", false, "Only list entries past their TTL")
+
+	return cmd
+}
+
+func newCacheClearCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear [namespace...]",
+		Short: "Remove cache entries",
+		Long: `Remove cache entries, of the given namespaces or all of them.
+
+Clearing removes offline data too; run 'linear sync' to pull it again.
+
+Namespaces: ` + strings.Join(cache.Namespaces, ", ") + `
+
+Examples:
+  linear cache clear
+  linear cache clear labels
+  linear cache clear users responses`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkNamespaces(args); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_INPUT", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_INPUT", err.Error())
+			}
+
+			m, err := cacheManagerOrError()
+			if m == nil {
+				return err
+			}
+
+			resp := CacheClearResponse{Success: true, Namespaces: []string{}}
+			if len(args) == 0 {
+				resp.Removed, err = m.ClearAll()
+			} else {
+				for _, name := range args {
+					name = strings.ToLower(name)
+					var removed int
+					removed, err = m.ClearNamespace(name)
+					resp.Removed += removed
+					resp.Namespaces = append(resp.Namespaces, name)
+					if err != nil {
+						break
+					}
+				}
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CACHE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CACHE_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				what := "the cache"
+				if len(resp.Namespaces) > 0 {
+					what = strings.Join(resp.Namespaces, ", ")
+				}
+				output.SuccessHuman(fmt.Sprintf("Cleared %s (%d entries)", what, resp.Removed))
+			} else {
+				output.JSON(resp)
+			}
+
+			return nil
+		},
+	}
+}
+
+func newCacheStatsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stats",
+		Short: "Show cache size and response cache hit rate",
+		Long: `Show the size of the cache by namespace, the policy in effect, and the
+hits and misses of the API response cache since they were first counted.
+
+Examples:
+  linear cache stats
+  linear cache stats --human`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := cacheManagerOrError()
+			if m == nil {
+				return err
+			}
+
+			infos, err := m.List()
+			if err == nil {
+				var stats api.ReadCacheStats
+				stats, err = api.LoadReadCacheStats(filepath.Join(m.Dir(), cache.ResponsesDir))
+				if err == nil {
+					resp := cacheStats(m, infos, stats)
+					if IsHumanOutput() {
+						printCacheStatsHuman(resp)
+					} else {
+						output.JSON(resp)
+					}
+					return nil
+				}
+			}
+			if IsHumanOutput() {
+				output.ErrorHuman("CACHE_ERROR", err.Error())
+				return nil
+			}
+			return output.Error("CACHE_ERROR", err.Error())
+		},
+	}
+}
+
+// cacheStats sums the cache entries by namespace
+func cacheStats(m *cache.Manager, infos []cache.Info, stats api.ReadCacheStats) CacheStatsResponse {
+	policy := cache.CurrentPolicy()
+	resp := CacheStatsResponse{
+		Dir: m.Dir(),
+		Policy: CachePolicyInfo{
+			TTL:         policy.TTL.String(),
+			ResponseTTL: policy.ResponseTTL.String(),
+			MaxSize:     policy.MaxSize,
+		},
+		Namespaces: []CacheNamespaceStats{},
+		Responses: ResponseCacheStats{
+			Hits:          stats.Hits,
+			Misses:        stats.Misses,
+			Refreshes:     stats.Refreshes,
+			Invalidations: stats.Invalidations,
+			HitRate:       stats.HitRate(),
+			Since:         stats.Since,
+		},
+	}
+
+	byNamespace := map[string]*CacheNamespaceStats{}
+	for _, info := range infos {
+		resp.Entries++
+		resp.Bytes += info.Size
+		ns := byNamespace[info.Namespace]
+		if ns == nil {
+			ns = &CacheNamespaceStats{Namespace: info.Namespace}
+			byNamespace[info.Namespace] = ns
+		}
+		ns.Entries++
+		ns.Bytes += info.Size
+		if info.Expired {
+			ns.Expired++
+		}
+		if info.Namespace == cache.NamespaceResponses {
+			resp.Responses.Entries++
+			resp.Responses.Bytes += info.Size
+			if !info.Expired {
+				resp.Responses.Fresh++
+			}
+		}
+	}
+	// Entries of unknown keys are listed last, under ""
+	for _, name := range append(cache.Namespaces, "") {
+		if ns := byNamespace[name]; ns != nil {
+			resp.Namespaces = append(resp.Namespaces, *ns)
+		}
+	}
+	return resp
+}
+
+func printCacheStatsHuman(resp CacheStatsResponse) {
+	maxSize := "no limit"
+	if resp.Policy.MaxSize > 0 {
+		maxSize = formatBytes(resp.Policy.MaxSize)
+	}
+	output.KeyValue("Directory", resp.Dir)
+	output.KeyValue("Size", fmt.Sprintf("%s in %d entries (limit: %s)", formatBytes(resp.Bytes), resp.Entries, maxSize))
+	output.KeyValue("TTL", fmt.Sprintf("%s, responses %s", resp.Policy.TTL, resp.Policy.ResponseTTL))
+
+	if len(resp.Namespaces) > 0 {
+		output.HumanLn("")
+		rows := make([][]string, len(resp.Namespaces))
+		for i, ns := range resp.Namespaces {
+			rows[i] = []string{dashIfEmpty(ns.Namespace), fmt.Sprintf("%d", ns.Entries), fmt.Sprintf("%d", ns.Expired), formatBytes(ns.Bytes)}
+		}
+		output.TableWithColors([]string{"NAMESPACE", "ENTRIES", "EXPIRED", "SIZE"}, rows)
+	}
+
+	r := resp.Responses
+	output.HumanLn("")
+	output.HumanLn("%s", output.Bold("Response cache"))
+	output.KeyValue("Entries", fmt.Sprintf("%d (%d fresh)", r.Entries, r.Fresh))
+	output.KeyValue("Hits", fmt.Sprintf("%d", r.Hits))
+	output.KeyValue("Misses", fmt.Sprintf("%d", r.Misses))
+	output.KeyValue("Refreshes", fmt.Sprintf("%d", r.Refreshes))
+	output.KeyValue("Invalidations", fmt.Sprintf("%d", r.Invalidations))
+	output.KeyValue("Hit rate", fmt.Sprintf("%.0f%%", r.HitRate*100))
+	if !r.Since.IsZero() {
+		output.KeyValue("Counting since", r.Since.Local().Format("2006-01-02 15:04"))
+	}
+}
+
+func newCachePathCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "path",
+		Short: "Print the cache directory",
+		Long: `Print the cache directory.
+
+Examples:
+  linear cache path
+  du -sh "$(linear cache path --human)"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := cacheManagerOrError()
+			if m == nil {
+				return err
+			}
+			if IsHumanOutput() {
+				output.HumanLn("%s", m.Dir())
+			} else {
+				output.JSON(map[string]string{"path": m.Dir()})
+			}
+			return nil
+		},
+	}
+}
+
+// formatBytes renders a size in B, KB or MB
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
@@ -0,0 +1,468 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// clonePageSize is how many project issues are fetched per request
+const clonePageSize = 100
+
+// ClonedIssue pairs a new issue with the issue it was copied from
+type ClonedIssue struct {
+	Source     string `json:"source"`
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	URL        string `json:"url"`
+	Title      string `json:"title"`
+	Parent     string `json:"parent,omitempty"`
+}
+
+// IssueCloneResponse is the response for 'issue clone'. The first issue is
+// the clone of the issue given; the rest are cloned sub-issues.
+type IssueCloneResponse struct {
+	Success bool          `json:"success"`
+	Source  string        `json:"source"`
+	Issues  []ClonedIssue `json:"issues"`
+	Count   int           `json:"count"`
+}
+
+// ProjectCloneResponse is the response for 'project clone'
+type ProjectCloneResponse struct {
+	Success    bool               `json:"success"`
+	Source     string             `json:"source"`
+	Project    *api.ProjectDetail `json:"project"`
+	Milestones []api.Milestone    `json:"milestones"`
+	Issues     []ClonedIssue      `json:"issues"`
+}
+
+// cloneError reports a clone that failed part way, with what it created
+type cloneError struct {
+	err     error
+	created int
+}
+
+func (e *cloneError) Error() string {
+	if e.created == 0 {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("%s (%d issues were cloned before the failure)", e.err, e.created)
+}
+
+func (e *cloneError) Unwrap() error { return e.err }
+
+// issueCloner copies issues, their labels and optionally their sub-issues
+type issueCloner struct {
+	client *api.Client
+	// teamID moves clones to another team; empty keeps each issue's team
+	teamID              string
+	includeSubtasks     bool
+	createMissingLabels bool
+
+	// projectID and milestones, when set, put clones in a cloned project,
+	// mapping source milestone IDs to the new project's milestones
+	projectID  string
+	milestones map[string]string
+
+	results []ClonedIssue
+}
+
+// clone copies src, under parentID when set, and then its sub-issues
+func (c *issueCloner) clone(ctx context.Context, src *api.IssueDetail, title, parentID string) error {
+	teamID := src.Team.ID
+	if c.teamID != "" {
+		teamID = c.teamID
+	}
+
+	input := api.IssueCreateInput{
+		Title:       title,
+		TeamID:      teamID,
+		Description: src.Description,
+		Estimate:    src.Estimate,
+		ParentID:    parentID,
+	}
+	if src.Priority != 0 {
+		priority := src.Priority
+		input.Priority = &priority
+	}
+
+	if len(src.Labels) > 0 {
+		if teamID == src.Team.ID {
+			for _, label := range src.Labels {
+				input.LabelIDs = append(input.LabelIDs, label.ID)
+			}
+		} else {
+			// Team labels do not carry over, so match them by name
+			names := make([]string, len(src.Labels))
+			for i, label := range src.Labels {
+				names[i] = label.Name
+			}
+			labelIDs, err := resolveLabelIDs(ctx, c.client, teamID, names, c.createMissingLabels)
+			if err != nil {
+				return err
+			}
+			input.LabelIDs = labelIDs
+		}
+	}
+
+	switch {
+	case c.projectID != "":
+		input.ProjectID = c.projectID
+		if src.ProjectMilestone != nil {
+			input.ProjectMilestoneID = c.milestones[src.ProjectMilestone.ID]
+		}
+	case src.Project != nil && teamID == src.Team.ID:
+		input.ProjectID = src.Project.ID
+		if src.ProjectMilestone != nil {
+			input.ProjectMilestoneID = src.ProjectMilestone.ID
+		}
+	}
+
+	created, err := c.client.CreateIssue(ctx, input)
+	if err != nil {
+		return fmt.Errorf("%s: %w", src.Identifier, err)
+	}
+	recordIssue(created.ID, created.Identifier, input.Title, created.URL, history.ActionCreated)
+
+	result := ClonedIssue{
+		Source:     src.Identifier,
+		ID:         created.ID,
+		Identifier: created.Identifier,
+		URL:        created.URL,
+		Title:      input.Title,
+	}
+	for _, r := range c.results {
+		if r.ID == parentID {
+			result.Parent = r.Identifier
+		}
+	}
+	c.results = append(c.results, result)
+
+	if !c.includeSubtasks {
+		return nil
+	}
+	for _, child := range src.Children {
+		detail, err := c.client.GetIssue(ctx, child.ID, false)
+		if err != nil {
+			return fmt.Errorf("%s: %w", child.Identifier, err)
+		}
+		if detail == nil {
+			continue
+		}
+		if err := c.clone(ctx, detail, detail.Title, created.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cloneErrorCode picks the error code for a failed clone
+func cloneErrorCode(err error) string {
+	var labelErr *LabelResolveError
+	if errors.As(err, &labelErr) {
+		return "INVALID_LABEL"
+	}
+	return "API_ERROR"
+}
+
+func newIssueCloneCmd() *cobra.Command {
+	var (
+		teamKey             string
+		title               string
+		includeSubtasks     bool
+		createMissingLabels bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "clone <issue-id>",
+		Short: "Copy an issue, optionally with its sub-issues",
+		Long: `Create a copy of an issue with the same title, description, priority,
+estimate, labels and project. Clones start in the team's default state and
+are unassigned.
+
+With --include-subtasks, sub-issues are cloned too, keeping the same tree
+under the new issue. --team moves the copies to another team; labels are
+then matched by name in that team and the project is not kept.
+
+Examples:
+  linear issue clone ENG-123
+  linear issue clone ENG-123 --title "Release 2.4 checklist" --include-subtasks
+  linear issue clone ENG-123 --team OPS --create-missing-labels`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			src, err := client.GetIssue(ctx, issueID, false)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if src == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+			}
+
+			cloner := &issueCloner{
+				client:              client,
+				includeSubtasks:     includeSubtasks,
+				createMissingLabels: createMissingLabels,
+			}
+			if teamKey != "" {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+						return nil
+					}
+					return output.Error("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+				}
+				cloner.teamID = team.ID
+			}
+
+			if title == "" {
+				title = src.Title
+			}
+			if err := cloner.clone(ctx, src, title, ""); err != nil {
+				err = &cloneError{err: err, created: len(cloner.results)}
+				if IsHumanOutput() {
+					output.ErrorHuman(cloneErrorCode(err), err.Error())
+					return nil
+				}
+				return output.Error(cloneErrorCode(err), err.Error())
+			}
+
+			response := &IssueCloneResponse{
+				Success: true,
+				Source:  src.Identifier,
+				Issues:  cloner.results,
+				Count:   len(cloner.results),
+			}
+			if IsHumanOutput() {
+				printClonedIssuesHuman(response.Issues)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team to create the copies in (default: the source issue's team)")
+	cmd.Flags().StringVar(&title, "title", "", "Title for the copy (default: the source title)")
+	cmd.Flags().BoolVar(&includeSubtasks, "include-subtasks", false, "Clone sub-issues too, recursively")
+	cmd.Flags().BoolVar(&createMissingLabels, "create-missing-labels", false, "With --team, create labels the target team lacks")
+
+	return cmd
+}
+
+func newProjectCloneCmd() *cobra.Command {
+	var (
+		name          string
+		includeIssues bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "clone <project-id>",
+		Short: "Copy a project with its milestones and optionally its issues",
+		Long: `Create a copy of a project with the same description, content, teams,
+lead, icon and color, and copies of its milestones. Dates and status are
+not copied.
+
+With --include-issues, the project's issues are cloned into the new
+project with their sub-issue trees, labels and milestones.
+
+Examples:
+  linear project clone <project-id> --name "Release 2.5"
+  linear project clone <project-id> --name "Onboarding: Dana" --include-issues`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			src, err := client.GetProject(ctx, projectID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if src == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Project '%s' not found", projectID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Project '%s' not found", projectID))
+			}
+
+			if name == "" {
+				name = src.Name + " (copy)"
+			}
+			input := api.ProjectCreateInput{
+				Name:        name,
+				Description: src.Description,
+				Content:     src.Content,
+				Icon:        src.Icon,
+				Color:       src.Color,
+			}
+			for _, team := range src.Teams {
+				input.TeamIDs = append(input.TeamIDs, team.ID)
+			}
+			if src.Lead != nil {
+				input.LeadID = src.Lead.ID
+			}
+
+			response, err := cloneProject(ctx, client, src, input, includeIssues)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(cloneErrorCode(err), err.Error())
+					return nil
+				}
+				return output.Error(cloneErrorCode(err), err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Cloned %s as %s", src.Name, response.Project.Name))
+				output.HumanLn("  %s", response.Project.URL)
+				if len(response.Milestones) > 0 {
+					output.HumanLn("  %d milestones", len(response.Milestones))
+				}
+				if len(response.Issues) > 0 {
+					output.HumanLn("")
+					printClonedIssuesHuman(response.Issues)
+				}
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Name for the copy (default: the source name with \" (copy)\")")
+	cmd.Flags().BoolVar(&includeIssues, "include-issues", false, "Clone the project's issues and their sub-issues")
+
+	return cmd
+}
+
+// cloneProject creates the copy, then its milestones, then its issues.
+// Issues whose parent is also in the project are cloned with that parent.
+func cloneProject(ctx context.Context, client *api.Client, src *api.ProjectDetail, input api.ProjectCreateInput, includeIssues bool) (*ProjectCloneResponse, error) {
+	project, err := client.CreateProject(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	recordProject(project, history.ActionCreated)
+
+	response := &ProjectCloneResponse{
+		Success:    true,
+		Source:     src.ID,
+		Project:    project,
+		Milestones: []api.Milestone{},
+		Issues:     []ClonedIssue{},
+	}
+
+	milestones, err := client.GetProjectMilestones(ctx, src.ID)
+	if err != nil {
+		return nil, fmt.Errorf("project %s was created, but its milestones could not be read: %w", project.SlugID, err)
+	}
+	cloner := &issueCloner{
+		client:          client,
+		includeSubtasks: true,
+		projectID:       project.ID,
+		milestones:      map[string]string{},
+	}
+	for _, m := range milestones.Milestones {
+		created, err := client.CreateProjectMilestone(ctx, api.ProjectMilestoneCreateInput{
+			ProjectID:   project.ID,
+			Name:        m.Name,
+			Description: m.Description,
+			TargetDate:  m.TargetDate,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("project %s was created, but milestone '%s' failed: %w", project.SlugID, m.Name, err)
+		}
+		cloner.milestones[m.ID] = created.ID
+		response.Milestones = append(response.Milestones, *created)
+	}
+
+	if !includeIssues {
+		return response, nil
+	}
+
+	issues, _, err := collectPages("", true, func(after string) ([]api.IssueListItem, *api.PageInfo, error) {
+		page, err := client.GetIssues(ctx, api.IssueFilter{ProjectID: src.ID}, clonePageSize, "", after)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Issues, page.PageInfo, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("project %s was created, but its issues could not be read: %w", project.SlugID, err)
+	}
+
+	inProject := map[string]bool{}
+	for _, issue := range issues {
+		inProject[issue.ID] = true
+	}
+	for _, issue := range issues {
+		detail, err := client.GetIssue(ctx, issue.ID, false)
+		if err != nil {
+			return nil, &cloneError{err: err, created: len(cloner.results)}
+		}
+		// Sub-issues are cloned along with their parent
+		if detail == nil || (detail.Parent != nil && inProject[detail.Parent.ID]) {
+			continue
+		}
+		if err := cloner.clone(ctx, detail, detail.Title, ""); err != nil {
+			return nil, &cloneError{err: err, created: len(cloner.results)}
+		}
+	}
+	response.Issues = cloner.results
+	return response, nil
+}
+
+func printClonedIssuesHuman(issues []ClonedIssue) {
+	output.HumanLn("Cloned %d issues:\n", len(issues))
+
+	headers := []string{"SOURCE", "CLONE", "TITLE", "PARENT"}
+	rows := make([][]string, len(issues))
+	for i, issue := range issues {
+		rows[i] = []string{issue.Source, issue.Identifier, issue.Title, issue.Parent}
+	}
+	output.TableWithColors(headers, rows)
+}
@@ -0,0 +1,402 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// listColumn is a --columns choice for a list of T
+type listColumn[T any] struct {
+	name    string
+	aliases []string
+	header  string
+	// field is the flattened JSON key the column shows in TSV output
+	field string
+	cell  func(T) string
+}
+
+// parseColumns picks the columns named in value, a comma-separated list,
+// falling back to the columns saved in config and then to defaults. custom
+// reports whether value or saved chose them.
+func parseColumns[T any](columns []listColumn[T], value string, saved, defaults []string) (selected []*listColumn[T], custom bool, err error) {
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		names = saved
+	}
+	custom = len(names) > 0
+	if !custom {
+		names = defaults
+	}
+
+	for _, name := range names {
+		column := findColumn(columns, name)
+		if column == nil {
+			return nil, false, fmt.Errorf("unknown column %q (use %s)", name, columnNames(columns))
+		}
+		selected = append(selected, column)
+	}
+	return selected, custom, nil
+}
+
+func findColumn[T any](columns []listColumn[T], name string) *listColumn[T] {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for i := range columns {
+		c := &columns[i]
+		if name == c.name || containsFold(c.aliases, name) {
+			return c
+		}
+	}
+	return nil
+}
+
+// defaultColumns looks up a command's default columns by name
+func defaultColumns[T any](columns []listColumn[T], names []string) []*listColumn[T] {
+	selected := make([]*listColumn[T], 0, len(names))
+	for _, name := range names {
+		if column := findColumn(columns, name); column != nil {
+			selected = append(selected, column)
+		}
+	}
+	return selected
+}
+
+// columnNames lists the columns for flag help and errors
+func columnNames[T any](columns []listColumn[T]) string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// setTSVColumns limits TSV output to columns
+func setTSVColumns[T any](columns []*listColumn[T]) {
+	tsv := make([]output.Column, len(columns))
+	for i, c := range columns {
+		tsv[i] = output.Column{Header: c.name, Key: c.field}
+	}
+	output.SetColumns(tsv)
+}
+
+// printColumnTable prints items as a table of columns
+func printColumnTable[T any](columns []*listColumn[T], items []T) {
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = c.header
+	}
+	rows := make([][]string, len(items))
+	for i, item := range items {
+		rows[i] = make([]string, len(columns))
+		for j, c := range columns {
+			rows[i][j] = c.cell(item)
+		}
+	}
+	output.TableWithColors(headers, rows)
+}
+
+// timeAgoCell renders an RFC 3339 timestamp as a muted relative time
+func timeAgoCell(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return output.Muted("%s", display.TimeAgo(t))
+}
+
+// dashIfEmpty renders a missing value as "-"
+func dashIfEmpty(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// graphIssueIdentifiers joins the identifiers of issues with commas
+func graphIssueIdentifiers(issues []api.GraphIssue) string {
+	identifiers := make([]string, len(issues))
+	for i, issue := range issues {
+		identifiers[i] = issue.Identifier
+	}
+	return strings.Join(identifiers, ", ")
+}
+
+// issueDefaultColumns are the issue table columns without --columns
+var issueDefaultColumns = []string{"priority", "id", "title", "labels", "estimate", "assignee", "state", "updated"}
+
+// issueColumns are the --columns choices of issue lists
+var issueColumns = []listColumn[api.IssueListItem]{
+	{
+		name:   "priority",
+		field:  "priority",
+		header: "",
+		cell: func(i api.IssueListItem) string {
+			// The table pads cells itself; a colored trailing space would
+			// wrap
+			return output.PriorityColor(i.Priority, strings.TrimSpace(display.PriorityIcon(i.Priority)))
+		},
+	},
+	{
+		name:    "id",
+		aliases: []string{"identifier"},
+		header:  "ID",
+		field:   "identifier",
+		cell:    func(i api.IssueListItem) string { return i.Identifier },
+	},
+	{
+		name:   "title",
+		header: "TITLE",
+		field:  "title",
+		cell:   func(i api.IssueListItem) string { return display.Truncate(i.Title, 40) },
+	},
+	{
+		name:   "labels",
+		header: "LABELS",
+		field:  "labels",
+		cell: func(i api.IssueListItem) string {
+			names := make([]string, len(i.Labels))
+			for j, l := range i.Labels {
+				names[j] = l.Name
+			}
+			labels := strings.Join(names, ", ")
+			if len(labels) > 20 {
+				labels = labels[:17] + "..."
+			}
+			return labels
+		},
+	},
+	{
+		name:   "estimate",
+		header: "E",
+		field:  "estimate",
+		cell: func(i api.IssueListItem) string {
+			if i.Estimate == nil {
+				return ""
+			}
+			return fmt.Sprintf("%.0f", *i.Estimate)
+		},
+	},
+	{
+		// Initials keep the default table narrow
+		name:   "assignee",
+		header: "A",
+		field:  "assignee.displayName",
+		cell: func(i api.IssueListItem) string {
+			if i.Assignee == nil {
+				return ""
+			}
+			return display.Initials(i.Assignee.DisplayName)
+		},
+	},
+	{
+		name:   "state",
+		header: "STATE",
+		field:  "state.name",
+		cell:   func(i api.IssueListItem) string { return output.StateColor(i.State.Type, i.State.Name) },
+	},
+	{
+		name:   "updated",
+		header: "UPDATED",
+		field:  "updatedAt",
+		cell: func(i api.IssueListItem) string {
+			updatedAt, _ := time.Parse(time.RFC3339, i.UpdatedAt)
+			return output.Muted("%s", display.TimeAgo(updatedAt))
+		},
+	},
+	{
+		name:   "created",
+		header: "CREATED",
+		field:  "createdAt",
+		cell:   func(i api.IssueListItem) string { return timeAgoCell(i.CreatedAt) },
+	},
+	{
+		name:    "due",
+		aliases: []string{"duedate"},
+		header:  "DUE",
+		field:   "dueDate",
+		cell:    func(i api.IssueListItem) string { return i.DueDate },
+	},
+	{
+		// The open issues blocking this one, fetched after the list
+		name:   "blocked",
+		header: "BLOCKED",
+		field:  "blockedBy",
+		cell: func(i api.IssueListItem) string {
+			if len(i.BlockedBy) == 0 {
+				return ""
+			}
+			return output.Red("%s", graphIssueIdentifiers(i.BlockedBy))
+		},
+	},
+	{
+		name:   "blocking",
+		header: "BLOCKING",
+		field:  "blocking",
+		cell:   func(i api.IssueListItem) string { return graphIssueIdentifiers(i.Blocking) },
+	},
+	{
+		name:   "uuid",
+		header: "UUID",
+		field:  "id",
+		cell:   func(i api.IssueListItem) string { return output.Muted("%s", i.ID) },
+	},
+}
+
+// projectDefaultColumns are the project table columns without --columns
+var projectDefaultColumns = []string{"name", "status", "progress", "lead", "teams", "target", "id"}
+
+// projectColumns are the --columns choices of project lists
+var projectColumns = []listColumn[api.ProjectListItem]{
+	{
+		name:   "name",
+		header: "NAME",
+		field:  "name",
+		cell:   func(p api.ProjectListItem) string { return display.Truncate(p.Name, 40) },
+	},
+	{
+		name:    "status",
+		aliases: []string{"state"},
+		header:  "STATUS",
+		field:   "status.name",
+		cell: func(p api.ProjectListItem) string {
+			if p.Status == nil {
+				return "-"
+			}
+			return output.StateColor(p.Status.Type, p.Status.Name)
+		},
+	},
+	{
+		name:   "progress",
+		header: "PROGRESS",
+		field:  "progress",
+		cell:   func(p api.ProjectListItem) string { return fmt.Sprintf("%.0f%%", p.Progress*100) },
+	},
+	{
+		name:   "lead",
+		header: "LEAD",
+		field:  "lead.displayName",
+		cell: func(p api.ProjectListItem) string {
+			if p.Lead == nil {
+				return "-"
+			}
+			return p.Lead.DisplayName
+		},
+	},
+	{
+		name:   "teams",
+		header: "TEAMS",
+		field:  "teams",
+		cell: func(p api.ProjectListItem) string {
+			keys := make([]string, len(p.Teams))
+			for i, t := range p.Teams {
+				keys[i] = t.Key
+			}
+			return dashIfEmpty(strings.Join(keys, ", "))
+		},
+	},
+	{
+		name:    "target",
+		aliases: []string{"targetdate", "due"},
+		header:  "TARGET",
+		field:   "targetDate",
+		cell:    func(p api.ProjectListItem) string { return dashIfEmpty(p.TargetDate) },
+	},
+	{
+		name:   "id",
+		header: "ID",
+		field:  "id",
+		cell:   func(p api.ProjectListItem) string { return output.Muted("%s", p.ID) },
+	},
+	{
+		name:   "updated",
+		header: "UPDATED",
+		field:  "updatedAt",
+		cell:   func(p api.ProjectListItem) string { return timeAgoCell(p.UpdatedAt) },
+	},
+	{
+		name:   "created",
+		header: "CREATED",
+		field:  "createdAt",
+		cell:   func(p api.ProjectListItem) string { return timeAgoCell(p.CreatedAt) },
+	},
+	{
+		name:   "url",
+		header: "URL",
+		field:  "url",
+		cell:   func(p api.ProjectListItem) string { return p.URL },
+	},
+}
+
+// documentDefaultColumns are the document table columns without --columns
+var documentDefaultColumns = []string{"title", "project", "creator", "updated", "id"}
+
+// documentColumns are the --columns choices of document lists
+var documentColumns = []listColumn[api.DocumentListItem]{
+	{
+		name:    "title",
+		aliases: []string{"name"},
+		header:  "TITLE",
+		field:   "title",
+		cell:    func(d api.DocumentListItem) string { return display.Truncate(d.Title, 40) },
+	},
+	{
+		name:   "project",
+		header: "PROJECT",
+		field:  "project.name",
+		cell: func(d api.DocumentListItem) string {
+			if d.Project == nil {
+				return "-"
+			}
+			return display.Truncate(d.Project.Name, 20)
+		},
+	},
+	{
+		name:   "creator",
+		header: "CREATOR",
+		field:  "creator.displayName",
+		cell: func(d api.DocumentListItem) string {
+			if d.Creator == nil {
+				return "-"
+			}
+			return d.Creator.DisplayName
+		},
+	},
+	{
+		name:   "updated",
+		header: "UPDATED",
+		field:  "updatedAt",
+		cell: func(d api.DocumentListItem) string {
+			if t, err := time.Parse(time.RFC3339, d.UpdatedAt); err == nil {
+				return display.TimeAgo(t)
+			}
+			return d.UpdatedAt
+		},
+	},
+	{
+		name:   "id",
+		header: "ID",
+		field:  "id",
+		cell:   func(d api.DocumentListItem) string { return output.Muted("%s", d.ID) },
+	},
+	{
+		name:   "created",
+		header: "CREATED",
+		field:  "createdAt",
+		cell:   func(d api.DocumentListItem) string { return timeAgoCell(d.CreatedAt) },
+	},
+	{
+		name:   "url",
+		header: "URL",
+		field:  "url",
+		cell:   func(d api.DocumentListItem) string { return d.URL },
+	},
+}
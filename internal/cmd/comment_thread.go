@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// CommentListResponse is the response for comment list
+type CommentListResponse struct {
+	Comments []CommentListItem `json:"comments"`
+	Count    int               `json:"count"`
+}
+
+// CommentListItem is a comment with the number of replies to it
+type CommentListItem struct {
+	api.Comment
+	ReplyCount int `json:"replyCount"`
+}
+
+// commentThread is a comment and the replies to it
+type commentThread struct {
+	comment api.Comment
+	replies []*commentThread
+}
+
+// threadComments groups comments into threads, keeping their order. A
+// reply whose parent isn't among comments, such as one past --limit,
+// starts a thread of its own.
+func threadComments(comments []api.Comment) []*commentThread {
+	byID := make(map[string]*commentThread, len(comments))
+	for _, c := range comments {
+		byID[c.ID] = &commentThread{comment: c}
+	}
+
+	var roots []*commentThread
+	for _, c := range comments {
+		thread := byID[c.ID]
+		if c.Parent != nil {
+			if parent, ok := byID[c.Parent.ID]; ok && parent != thread {
+				parent.replies = append(parent.replies, thread)
+				continue
+			}
+		}
+		roots = append(roots, thread)
+	}
+	return roots
+}
+
+// findThread returns the thread holding the comment with ID id, from its
+// first comment, or nil when no thread holds it
+func findThread(threads []*commentThread, id string) *commentThread {
+	for _, thread := range threads {
+		found := false
+		thread.walk(0, func(t *commentThread, _ int) {
+			found = found || t.comment.ID == id
+		})
+		if found {
+			return thread
+		}
+	}
+	return nil
+}
+
+// walk calls fn for the thread's comment and then its replies, depth
+// first, with how deep each is in the thread
+func (t *commentThread) walk(depth int, fn func(t *commentThread, depth int)) {
+	fn(t, depth)
+	for _, reply := range t.replies {
+		reply.walk(depth+1, fn)
+	}
+}
+
+// commentListItems flattens threads back into a list, each reply after
+// the comment it answers
+func commentListItems(threads []*commentThread) []CommentListItem {
+	items := []CommentListItem{}
+	for _, thread := range threads {
+		thread.walk(0, func(t *commentThread, _ int) {
+			items = append(items, CommentListItem{Comment: t.comment, ReplyCount: len(t.replies)})
+		})
+	}
+	return items
+}
+
+// printCommentThreadsHuman prints threads with replies indented under the
+// comment they answer
+func printCommentThreadsHuman(threads []*commentThread) {
+	count := 0
+	for _, thread := range threads {
+		thread.walk(0, func(t *commentThread, depth int) {
+			count++
+			printCommentHuman(t.comment, strings.Repeat("    ", depth))
+		})
+	}
+	output.HumanLn("%d comments", count)
+}
+
+// printCommentHuman prints a comment with each line indented by indent
+func printCommentHuman(comment api.Comment, indent string) {
+	author := "Unknown"
+	if comment.User != nil {
+		author = comment.User.DisplayName
+	}
+	verb := "commented"
+	if comment.Parent != nil {
+		verb = "replied"
+	}
+	createdAt, _ := time.Parse(time.RFC3339, comment.CreatedAt)
+	output.HumanLn("%s@%s %s %s %s", indent, author, verb, display.TimeAgo(createdAt), output.Muted("(%s)", comment.ID))
+	for _, line := range strings.Split(comment.Body, "\n") {
+		if line == "" {
+			output.HumanLn("")
+			continue
+		}
+		output.HumanLn("%s%s", indent, line)
+	}
+	if len(comment.Reactions) > 0 {
+		output.HumanLn("%s%s", indent, reactionSummary(comment.Reactions))
+	}
+	output.HumanLn("")
+}
@@ -0,0 +1,328 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/resolver"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// completionTimeout bounds the API calls made while the shell waits for
+// suggestions
+const completionTimeout = 3 * time.Second
+
+// completionProjectLimit caps how many projects are fetched for suggestions
+const completionProjectLimit = 100
+
+type completeFunc = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// NewCompletionCmd creates the completion command
+func NewCompletionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "completion <bash|zsh|fish|powershell>",
+		Short: "Generate shell completion scripts",
+		Long: `Generate a completion script for your shell.
+
+Besides commands and flags, completions suggest team keys, label names,
+workflow state names, projects and your recent issues. Suggestions come
+from the local cache ('linear sync') and history, falling back to the API
+for a few seconds when nothing is cached.
+
+Bash:
+  source <(linear completion bash)
+  # or, for every session
+  linear completion bash > /etc/bash_completion.d/linear
+
+Zsh:
+  linear completion zsh > "${fpath[1]}/_linear"
+
+Fish:
+  linear completion fish > ~/.config/fish/completions/linear.fish
+
+PowerShell:
+  linear completion powershell | Out-String | Invoke-Expression`,
+		ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root := cmd.Root()
+			var err error
+			switch args[0] {
+			case "bash":
+				err = root.GenBashCompletionV2(os.Stdout, true)
+			case "zsh":
+				err = root.GenZshCompletion(os.Stdout)
+			case "fish":
+				err = root.GenFishCompletion(os.Stdout, true)
+			case "powershell":
+				err = root.GenPowerShellCompletionWithDesc(os.Stdout)
+			}
+			if err != nil {
+				return output.Error("COMPLETION_ERROR", err.Error())
+			}
+			return nil
+		},
+	}
+}
+
+// registerCompletions adds dynamic suggestions to cmd and all its
+// subcommands: entity arguments named in usage lines, and the team,
+// label, state, project and issue flags
+func registerCompletions(cmd *cobra.Command) {
+	if cmd.ValidArgsFunction == nil && len(cmd.ValidArgs) == 0 && takesRefArgs(cmd) {
+		cmd.ValidArgsFunction = completeArgs
+	}
+
+	register := func(f *pflag.Flag) {
+		if fn := flagCompletion(f); fn != nil {
+			_ = cmd.RegisterFlagCompletionFunc(f.Name, fn)
+		}
+	}
+	cmd.LocalNonPersistentFlags().VisitAll(register)
+	cmd.PersistentFlags().VisitAll(register)
+
+	for _, sub := range cmd.Commands() {
+		registerCompletions(sub)
+	}
+}
+
+// flagCompletion picks the suggestions for a flag by its name
+func flagCompletion(f *pflag.Flag) completeFunc {
+	if kind := f.Annotations[refKindAnnotation]; len(kind) > 0 && kind[0] == resolver.KindIssue {
+		return completeIssues
+	}
+	switch f.Name {
+	case "team":
+		return completeTeams
+	case "label":
+		return completeLabels
+	case "project":
+		return completeProjectIDs
+	case "state":
+		// List filters take state types rather than names
+		if strings.Contains(f.Usage, "state type") {
+			return cobra.FixedCompletions(StateTypes, cobra.ShellCompDirectiveNoFileComp)
+		}
+		return completeStates
+	}
+	return nil
+}
+
+// takesRefArgs reports whether cmd's usage line names an issue, project,
+// document or initiative argument
+func takesRefArgs(cmd *cobra.Command) bool {
+	for _, m := range placeholderPattern.FindAllStringSubmatch(cmd.Use, -1) {
+		if placeholderKinds[strings.TrimSpace(m[1])] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// completeArgs suggests the entity named by the usage placeholder of the
+// argument being completed
+func completeArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	kinds, _ := argKinds(cmd, len(args)+1)
+	switch kinds[len(args)] {
+	case resolver.KindIssue:
+		return completeIssues(cmd, args, toComplete)
+	case resolver.KindProject:
+		return completeProjectSlugs(cmd, args, toComplete)
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeIssues suggests recent and favorite issues from the local
+// history, then the issues assigned to you from the last 'linear sync'
+func completeIssues(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	seen := map[string]bool{}
+	suggestions := []string{}
+	add := func(identifier, title string) {
+		if identifier == "" || seen[identifier] {
+			return
+		}
+		seen[identifier] = true
+		suggestions = append(suggestions, identifier+"\t"+title)
+	}
+
+	if store, err := history.NewStore(); err == nil {
+		recent, _ := store.Recent()
+		favorites, _ := store.Favorites()
+		for _, item := range append(recent, favorites...) {
+			if item.Kind == history.KindIssue {
+				add(item.Key, item.Title)
+			}
+		}
+	}
+	if synced, _, err := readOffline[api.IssuesResponse](cache.WorkspaceKey("my-issues")); err == nil {
+		for _, issue := range synced.Issues {
+			add(issue.Identifier, issue.Title)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeTeams suggests team keys
+func completeTeams(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+	defer cancel()
+
+	teams, err := completionTeams(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	suggestions := make([]string, len(teams.Teams))
+	for i, team := range teams.Teams {
+		suggestions[i] = team.Key + "\t" + team.Name
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeLabels suggests the label names of the command's team
+func completeLabels(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+	defer cancel()
+
+	teamID, client := completionTeam(ctx, cmd)
+	if teamID == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	labels, err := teamLabels(ctx, client, teamID)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	suggestions := make([]string, len(labels.Labels))
+	for i, label := range labels.Labels {
+		suggestions[i] = label.Name
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeStates suggests the workflow state names of the command's team
+func completeStates(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+	defer cancel()
+
+	teamID, client := completionTeam(ctx, cmd)
+	if teamID == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	states, err := teamWorkflowStates(ctx, client, teamID)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	suggestions := make([]string, len(states.WorkflowStates))
+	for i, state := range states.WorkflowStates {
+		suggestions[i] = state.Name + "\t" + state.Type
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeProjectIDs suggests project IDs, described by name, for flags
+// that need the ID itself
+func completeProjectIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeProjects(cmd.Context(), func(p api.ProjectListItem) string { return p.ID })
+}
+
+// completeProjectSlugs suggests projects by URL slug, such as
+// q3-launch-0a1b2c3d4e5f, so typing part of the name matches
+func completeProjectSlugs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeProjects(cmd.Context(), func(p api.ProjectListItem) string {
+		if i := strings.LastIndex(p.URL, "/project/"); i >= 0 {
+			return strings.SplitN(p.URL[i+len("/project/"):], "/", 2)[0]
+		}
+		return p.SlugID
+	})
+}
+
+func completeProjects(ctx context.Context, value func(api.ProjectListItem) string) ([]string, cobra.ShellCompDirective) {
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+
+	cacheManager, _ := cache.NewManager()
+	if cacheManager == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	projects, err := cache.GetOrFetch(cacheManager, cache.WorkspaceKey("projects"), func() (api.ProjectsResponse, error) {
+		client, err := api.NewClient(ctx)
+		if err != nil {
+			return api.ProjectsResponse{}, err
+		}
+		resp, err := client.GetProjects(ctx, "", completionProjectLimit, "", "")
+		if err != nil {
+			return api.ProjectsResponse{}, err
+		}
+		return *resp, nil
+	})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	suggestions := make([]string, len(projects.Projects))
+	for i, p := range projects.Projects {
+		suggestions[i] = value(p) + "\t" + p.Name
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionTeams returns the workspace's teams, cached by 'linear sync'
+// or fetched and cached here
+func completionTeams(ctx context.Context) (*api.TeamsResponse, error) {
+	cacheManager, err := cache.NewManager()
+	if err != nil {
+		return nil, err
+	}
+	teams, err := cache.GetOrFetch(cacheManager, cache.WorkspaceKey("teams"), func() (api.TeamsResponse, error) {
+		client, err := api.NewClient(ctx)
+		if err != nil {
+			return api.TeamsResponse{}, err
+		}
+		resp, err := client.GetTeams(ctx)
+		if err != nil {
+			return api.TeamsResponse{}, err
+		}
+		return *resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &teams, nil
+}
+
+// completionTeam returns the ID of the team named by the command's --team
+// flag or the default team, and a client for fetching what is not cached
+func completionTeam(ctx context.Context, cmd *cobra.Command) (string, *api.Client) {
+	team := GetTeamID()
+	if f := cmd.Flag("team"); f != nil && f.Changed {
+		team = f.Value.String()
+	}
+	if team == "" {
+		return "", nil
+	}
+
+	client, err := api.NewClient(ctx)
+	if err != nil {
+		return "", nil
+	}
+	if isUUID(team) {
+		return team, client
+	}
+
+	teams, err := completionTeams(ctx)
+	if err != nil {
+		return "", nil
+	}
+	for _, t := range teams.Teams {
+		if strings.EqualFold(t.Key, team) {
+			return t.ID, client
+		}
+	}
+	return "", nil
+}
@@ -5,22 +5,20 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
 	"github.com/juanbermudez/agent-linear-cli/internal/auth"
 	"github.com/juanbermudez/agent-linear-cli/internal/config"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
 	"github.com/juanbermudez/agent-linear-cli/internal/output"
 	"github.com/spf13/cobra"
 )
 
 // Valid configuration keys
-var validConfigKeys = []string{
-	"api_key",
-	"team_id",
-	"team_key",
-}
+var validConfigKeys = config.Keys
 
 // NewConfigCmd creates the config command group
 func NewConfigCmd() *cobra.Command {
@@ -29,12 +27,62 @@ func NewConfigCmd() *cobra.Command {
 		Short: "Manage CLI configuration",
 		Long: `View and modify CLI configuration settings.
 
-Configuration is stored in ~/.linear.toml or ./.linear.toml
+Configuration is read from ~/.linear.toml and from a repository config:
+the nearest .linear.toml in the working directory or its parents, found
+like git finds .git. Repository values override the home config, and
+environment variables (LINEAR_API_KEY, LINEAR_API_ENDPOINT) override both. 'linear config list'
+shows where each value comes from.
 
 Available keys:
-  api_key   - Linear API key (prefer using keychain via 'linear auth')
-  team_id   - Default team ID
-  team_key  - Default team key (e.g., ENG)
+  api_key            - Linear API key (prefer using keychain via 'linear auth')
+  team_id            - Default team ID
+  team_key           - Default team key (e.g., ENG)
+  date_format        - Date format: iso, us, eu, long, or a Go layout
+  timezone           - Time zone for displayed times (e.g., Europe/Berlin)
+  project_id         - Default project ID
+  default_project    - Project new issues are created in
+  default_cycle      - Cycle new issues are added to: current, next or an ID
+  labels             - Labels added to new issues (e.g., backend,api)
+  issue_template     - Issue template used for new issues
+  templates_dir      - Template directory, relative to the config file
+  actor              - Name to create issues and comments on behalf of
+                       with an app token ("app" for the app itself)
+  actor_icon_url     - Avatar URL shown for actor
+  api_endpoint       - GraphQL endpoint, such as a mock server or proxy
+  calendar.workdays  - Working weekdays (e.g., mon,tue,wed,thu,fri)
+  calendar.holidays  - Non-working dates (e.g., 2025-12-25,2026-01-01)
+  columns.issue_list - Table columns of issue list (e.g., id,title,due)
+  columns.project_list, columns.document_list
+                     - Table columns of project and document lists
+  theme.color        - Color human output: auto, always or never
+  theme.depth        - Terminal colors: 16, 256 or truecolor
+  cache.ttl          - How long cached workspace data is used (default 24h)
+  cache.response_ttl - How long API responses are reused (default 1m, 0 off)
+  cache.max_size     - Cache directory size limit (e.g., 50MB)
+
+Blackout periods and theme colors are configured directly in the config
+file. Colors are names (red, green, yellow, blue, magenta, cyan, white,
+black, gray), hex values or "none":
+
+  [[calendar.blackouts]]
+  name = "Year-end freeze"
+  start = "2025-12-20"
+  end = "2026-01-02"
+
+  [theme.priority]
+  urgent = "#e5484d"
+  high = "yellow"
+
+  [theme.state]
+  started = "#f2c94c"
+  completed = "green"
+
+Teams can have their own default_project and default_cycle, which override
+the top-level ones for issues created in that team:
+
+  [teams.ENG]
+  default_project = "mobile-app-2f3c4d5e6a7b"
+  default_cycle = "current"
 
 Examples:
   linear config list
@@ -58,9 +106,28 @@ func newConfigGetCmd() *cobra.Command {
 		Long: `Get a configuration value by key.
 
 Available keys:
-  api_key   - Linear API key
-  team_id   - Default team ID
-  team_key  - Default team key
+  api_key            - Linear API key
+  team_id            - Default team ID
+  team_key           - Default team key
+  date_format        - Date format for human output
+  timezone           - Time zone for human output
+  project_id         - Default project ID
+  default_project    - Project of new issues
+  default_cycle      - Cycle of new issues
+  labels             - Labels added to new issues
+  issue_template     - Issue template used for new issues
+  templates_dir      - Template directory
+  actor              - Name issues and comments are created on behalf of
+  actor_icon_url     - Avatar URL shown for actor
+  api_endpoint       - GraphQL endpoint
+  calendar.workdays  - Working weekdays
+  calendar.holidays  - Non-working dates
+  columns.issue_list, columns.project_list, columns.document_list
+                     - Table columns of list commands
+  theme.color        - Color mode
+  theme.depth        - Terminal color depth
+  cache.ttl, cache.response_ttl, cache.max_size
+                     - Cache policy
 
 Examples:
   linear config get team_key
@@ -72,7 +139,7 @@ Examples:
 			manager, err := config.NewManager()
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("CONFIG_ERROR", err.Error())
@@ -81,7 +148,7 @@ Examples:
 			value, err := manager.Get(key)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("CONFIG_ERROR", err.Error())
@@ -93,14 +160,15 @@ Examples:
 				} else if key == "api_key" {
 					// Mask API key for security
 					masked := maskSecret(value)
-					output.HumanLn("%s: %s", key, masked)
+					output.HumanLn("%s: %s %s", key, masked, output.Muted("(%s)", manager.Source(key)))
 				} else {
-					output.HumanLn("%s: %s", key, value)
+					output.HumanLn("%s: %s %s", key, value, output.Muted("(%s)", manager.Source(key)))
 				}
 			} else {
 				output.JSON(map[string]interface{}{
-					"key":   key,
-					"value": value,
+					"key":    key,
+					"value":  value,
+					"source": manager.Source(key),
 				})
 			}
 
@@ -112,19 +180,63 @@ Examples:
 }
 
 func newConfigSetCmd() *cobra.Command {
+	var global bool
+
 	cmd := &cobra.Command{
 		Use:   "set <key> <value>",
 		Short: "Set a configuration value",
 		Long: `Set a configuration value.
 
 Available keys:
-  api_key   - Linear API key (prefer using 'linear auth' instead)
-  team_id   - Default team ID
-  team_key  - Default team key (e.g., ENG)
+  api_key            - Linear API key (prefer using 'linear auth' instead)
+  team_id            - Default team ID
+  team_key           - Default team key (e.g., ENG)
+  date_format        - Date format: iso (2006-01-02), us (01/02/2006), eu (02/01/2006),
+                       long (Jan 02, 2006), or a Go layout (e.g., 02.01.2006)
+  timezone           - IANA time zone for displayed times (e.g., Europe/Berlin, UTC)
+  project_id         - Default project ID
+  default_project    - Project new issues are created in without --project (ID,
+                       slug or URL)
+  default_cycle      - Cycle new issues are added to without --cycle: current,
+                       next or a cycle ID
+  labels             - Labels added to new issues, comma-separated (e.g., backend,api)
+  issue_template     - Issue template used for new issues without --description
+  templates_dir      - Template directory, relative to the config file
+  actor              - With an app token, name to create issues and comments on
+                       behalf of ("app" creates them as the app)
+  actor_icon_url     - Avatar URL shown for actor
+  api_endpoint       - GraphQL endpoint requests are sent to, such as a mock
+                       server or a proxy (default: https://api.linear.app/graphql)
+  calendar.workdays  - Working weekdays, comma-separated (e.g., mon,tue,wed,thu,fri)
+  calendar.holidays  - Non-working dates, comma-separated (e.g., 2025-12-25,2026-01-01)
+  columns.issue_list, columns.project_list, columns.document_list
+                     - Table and TSV columns of list commands, comma-separated
+                       (see each command's --columns)
+  theme.color        - Color human output: auto (terminals only, honoring
+                       NO_COLOR), always or never
+  theme.depth        - Terminal colors for hex theme colors: 16, 256 or
+                       truecolor (default: detected from COLORTERM and TERM)
+  cache.ttl          - How long cached workspace data is used, as a duration
+                       (default 24h)
+  cache.response_ttl - How long API responses are reused (default 1m; 0
+                       disables the response cache)
+  cache.max_size     - Cache directory size limit such as 50MB; the oldest
+                       entries are removed past it (default: no limit)
+
+Values are written to the repository .linear.toml when there is one, and
+to ~/.linear.toml otherwise; --global always writes ~/.linear.toml.
+api_key, api_endpoint, actor and actor_icon_url are always written to
+~/.linear.toml, since repository configs cannot set them.
 
 Examples:
   linear config set team_key ENG
-  linear config set team_id abc123`,
+  linear config set labels backend,api
+  linear config set timezone UTC --global
+  linear config set team_id abc123
+  linear config set date_format eu
+  linear config set timezone America/New_York
+  linear config set calendar.holidays 2025-12-25,2026-01-01
+  linear config set columns.issue_list id,title,assignee,due`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			key := args[0]
@@ -133,24 +245,53 @@ Examples:
 			// Validate key
 			if !isValidConfigKey(key) {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Unknown config key: %s\nValid keys: %s", key, strings.Join(validConfigKeys, ", ")))
+					output.ErrorHuman("INVALID_KEY", fmt.Sprintf("Unknown config key: %s\nValid keys: %s", key, strings.Join(validConfigKeys, ", ")))
 					return nil
 				}
 				return output.Error("INVALID_KEY", fmt.Sprintf("Unknown config key: %s", key))
 			}
 
+			if err := validateThemeConfig(key, value); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_VALUE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_VALUE", err.Error())
+			}
+
+			if err := validateColumnsConfig(key, value); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_VALUE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_VALUE", err.Error())
+			}
+
+			if key == "date_format" {
+				if _, err := display.ParseDateFormat(value); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("INVALID_VALUE", err.Error())
+						return nil
+					}
+					return output.Error("INVALID_VALUE", err.Error())
+				}
+			}
+
 			manager, err := config.NewManager()
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("CONFIG_ERROR", err.Error())
 			}
+			if global || config.UserOnly(key) {
+				manager.UseHome()
+			}
 
 			if err := manager.Set(key, value); err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("CONFIG_ERROR", err.Error())
@@ -171,6 +312,8 @@ Examples:
 		},
 	}
 
+	cmd.Flags().BoolVar(&global, "global", false, "Write to ~/.linear.toml instead of the repository config")
+
 	return cmd
 }
 
@@ -180,9 +323,10 @@ func newConfigListCmd() *cobra.Command {
 		Short: "List all configuration values",
 		Long: `List all configuration values.
 
-Shows values from:
-  - Config file (~/.linear.toml or ./.linear.toml)
-  - Environment variables (LINEAR_API_KEY, etc.)
+Shows each value with its source, in increasing precedence:
+  - home: ~/.linear.toml
+  - repo: the nearest .linear.toml in the working directory or its parents
+  - env:  environment variables (LINEAR_API_KEY)
 
 Examples:
   linear config list`,
@@ -190,7 +334,7 @@ Examples:
 			manager, err := config.NewManager()
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("CONFIG_ERROR", err.Error())
@@ -199,40 +343,101 @@ Examples:
 			cfg, err := manager.Load()
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("CONFIG_ERROR", err.Error())
 			}
 
 			if IsHumanOutput() {
-				output.HumanLn("Configuration (%s):\n", manager.Path())
+				output.HumanLn("Config files (later overrides earlier):")
+				printConfigFile(config.SourceHome, manager.HomePath())
+				printConfigFile(config.SourceRepo, manager.RepoPath())
+				output.HumanLn("")
 
-				// API Key
-				apiKeyValue := cfg.APIKey
-				apiKeySource := "config"
-				if envKey := os.Getenv("LINEAR_API_KEY"); envKey != "" {
-					apiKeyValue = envKey
-					apiKeySource = "env"
+				var shown []string
+				for _, key := range validConfigKeys {
+					if !strings.HasPrefix(key, "calendar.") && !strings.HasPrefix(key, "columns.") {
+						shown = append(shown, key)
+					}
 				}
-				if apiKeyValue != "" {
-					output.HumanLn("  api_key:  %s (%s)", maskSecret(apiKeyValue), apiKeySource)
-				} else {
-					output.HumanLn("  api_key:  %s", output.Muted("(not set)"))
+				width := keyColumnWidth(shown, "")
+				for _, key := range shown {
+					value, _ := cfg.Value(key)
+					if value == "" {
+						output.HumanLn("  %-*s %s", width, key+":", output.Muted("(not set)"))
+						continue
+					}
+					if key == "api_key" {
+						value = maskSecret(value)
+					}
+					output.HumanLn("  %-*s %s %s", width, key+":", value, output.Muted("(%s)", manager.Source(key)))
 				}
 
-				// Team ID
-				if cfg.TeamID != "" {
-					output.HumanLn("  team_id:  %s", cfg.TeamID)
-				} else {
-					output.HumanLn("  team_id:  %s", output.Muted("(not set)"))
+				// Calendar
+				if len(cfg.Calendar.Workdays) > 0 || len(cfg.Calendar.Holidays) > 0 || len(cfg.Calendar.Blackouts) > 0 {
+					output.HumanLn("")
+					output.HumanLn("Calendar:")
+					if len(cfg.Calendar.Workdays) > 0 {
+						output.HumanLn("  workdays:  %s %s", strings.Join(cfg.Calendar.Workdays, ", "), output.Muted("(%s)", manager.Source("calendar.workdays")))
+					}
+					if len(cfg.Calendar.Holidays) > 0 {
+						output.HumanLn("  holidays:  %s %s", strings.Join(cfg.Calendar.Holidays, ", "), output.Muted("(%s)", manager.Source("calendar.holidays")))
+					}
+					for _, b := range cfg.Calendar.Blackouts {
+						output.HumanLn("  blackout:  %s to %s %s", b.Start, b.End, output.Muted("%s", b.Name))
+					}
 				}
 
-				// Team Key
-				if cfg.TeamKey != "" {
-					output.HumanLn("  team_key: %s", cfg.TeamKey)
-				} else {
-					output.HumanLn("  team_key: %s", output.Muted("(not set)"))
+				// Columns
+				if len(cfg.Columns.IssueList) > 0 || len(cfg.Columns.ProjectList) > 0 || len(cfg.Columns.DocumentList) > 0 {
+					output.HumanLn("")
+					output.HumanLn("Columns:")
+					width := keyColumnWidth(validConfigKeys, "columns.")
+					for _, key := range validConfigKeys {
+						if !strings.HasPrefix(key, "columns.") {
+							continue
+						}
+						if value, _ := cfg.Value(key); value != "" {
+							output.HumanLn("  %-*s %s %s", width, strings.TrimPrefix(key, "columns.")+":", value, output.Muted("(%s)", manager.Source(key)))
+						}
+					}
+				}
+
+				// Cache policy
+				if cfg.Cache != (config.CacheConfig{}) {
+					output.HumanLn("")
+					output.HumanLn("Cache:")
+					width := keyColumnWidth(validConfigKeys, "cache.")
+					for _, key := range validConfigKeys {
+						if !strings.HasPrefix(key, "cache.") {
+							continue
+						}
+						if value, _ := cfg.Value(key); value != "" {
+							output.HumanLn("  %-*s %s %s", width, strings.TrimPrefix(key, "cache.")+":", value, output.Muted("(%s)", manager.Source(key)))
+						}
+					}
+				}
+
+				// Team defaults
+				if len(cfg.Teams) > 0 {
+					output.HumanLn("")
+					output.HumanLn("Teams: %s", output.Muted("(%s)", manager.Source("teams")))
+					keys := make([]string, 0, len(cfg.Teams))
+					for key := range cfg.Teams {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					width := keyColumnWidth(keys, "")
+					for _, key := range keys {
+						team := cfg.Teams[key]
+						if team.DefaultProject != "" {
+							output.HumanLn("  %-*s default_project = %s", width, key+":", team.DefaultProject)
+						}
+						if team.DefaultCycle != "" {
+							output.HumanLn("  %-*s default_cycle = %s", width, key+":", team.DefaultCycle)
+						}
+					}
 				}
 
 				// Environment variable hints
@@ -242,15 +447,39 @@ Examples:
 				printEnvVar("LINEAR_CLIENT_ID")
 				printEnvVar("LINEAR_CLIENT_SECRET")
 				printEnvVar("LINEAR_TEAM")
+				printEnvVar("LINEAR_API_ENDPOINT")
 			} else {
 				configMap := map[string]interface{}{
-					"api_key":  cfg.APIKey,
-					"team_id":  cfg.TeamID,
-					"team_key": cfg.TeamKey,
+					"api_key":         cfg.APIKey,
+					"team_id":         cfg.TeamID,
+					"team_key":        cfg.TeamKey,
+					"date_format":     cfg.DateFormat,
+					"timezone":        cfg.Timezone,
+					"project_id":      cfg.ProjectID,
+					"default_project": cfg.DefaultProject,
+					"default_cycle":   cfg.DefaultCycle,
+					"teams":           cfg.Teams,
+					"labels":          cfg.Labels,
+					"issue_template":  cfg.IssueTemplate,
+					"templates_dir":   cfg.TemplatesDir,
+					"actor":           cfg.Actor,
+					"actor_icon_url":  cfg.ActorIconURL,
+					"api_endpoint":    cfg.APIEndpoint,
+					"calendar":        cfg.Calendar,
+					"columns":         cfg.Columns,
+					"theme":           cfg.Theme,
+					"cache":           cfg.Cache,
+				}
+
+				sources := map[string]string{}
+				for _, key := range append(validConfigKeys, "calendar.blackouts", "theme.priority", "theme.state", "teams") {
+					if source := manager.Source(key); source != "" {
+						sources[key] = source
+					}
 				}
 
 				envVars := map[string]string{}
-				for _, key := range []string{"LINEAR_API_KEY", "LINEAR_CLIENT_ID", "LINEAR_CLIENT_SECRET", "LINEAR_TEAM"} {
+				for _, key := range []string{"LINEAR_API_KEY", "LINEAR_CLIENT_ID", "LINEAR_CLIENT_SECRET", "LINEAR_TEAM", "LINEAR_API_ENDPOINT"} {
 					if val := os.Getenv(key); val != "" {
 						if strings.Contains(key, "KEY") || strings.Contains(key, "SECRET") {
 							envVars[key] = "(set)"
@@ -261,9 +490,11 @@ Examples:
 				}
 
 				output.JSON(map[string]interface{}{
-					"path":   manager.Path(),
-					"config": configMap,
-					"env":    envVars,
+					"path":    manager.Path(),
+					"files":   map[string]string{config.SourceHome: manager.HomePath(), config.SourceRepo: manager.RepoPath()},
+					"config":  configMap,
+					"sources": sources,
+					"env":     envVars,
 				})
 			}
 
@@ -286,7 +517,7 @@ Examples:
 			manager, err := config.NewManager()
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("CONFIG_ERROR", err.Error())
@@ -328,8 +559,9 @@ Examples:
   linear config setup --api-key lin_api_xxx
   linear config setup --validate
   echo "lin_api_xxx" | linear config setup --stdin --team ENG`,
+		Annotations: map[string]string{noTimeoutAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			// If validate flag is set, just validate existing config
 			if validate {
@@ -342,7 +574,7 @@ Examples:
 				line, err := reader.ReadString('\n')
 				if err != nil && line == "" {
 					if IsHumanOutput() {
-						output.ErrorHuman("Failed to read API key from stdin")
+						output.ErrorHuman("STDIN_ERROR", "Failed to read API key from stdin")
 						return nil
 					}
 					return output.Error("STDIN_ERROR", "Failed to read API key from stdin")
@@ -353,7 +585,7 @@ Examples:
 			// Require API key if not validating
 			if apiKey == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("API key is required. Use --api-key or --stdin flag.")
+					output.ErrorHuman("MISSING_API_KEY", "API key is required. Use --api-key or --stdin flag.")
 					output.HumanLn("\nTo get an API key:")
 					output.HumanLn("  1. Go to: https://linear.app/settings/api")
 					output.HumanLn("  2. Create a new Personal API key")
@@ -370,7 +602,7 @@ Examples:
 			// Validate API key format
 			if !strings.HasPrefix(apiKey, "lin_api_") {
 				if IsHumanOutput() {
-					output.ErrorHuman("Invalid API key format. Must start with 'lin_api_'")
+					output.ErrorHuman("INVALID_API_KEY", "Invalid API key format. Must start with 'lin_api_'")
 					return nil
 				}
 				return output.Error("INVALID_API_KEY", "API key must start with 'lin_api_'")
@@ -380,7 +612,7 @@ Examples:
 			authManager := auth.NewManager()
 			if err := authManager.LoginWithAPIKey(apiKey); err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Failed to store API key: %s", err.Error()))
+					output.ErrorHuman("STORE_ERROR", fmt.Sprintf("Failed to store API key: %s", err.Error()))
 					return nil
 				}
 				return output.Error("STORE_ERROR", err.Error())
@@ -390,16 +622,16 @@ Examples:
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Failed to create API client: %s", err.Error()))
+					output.ErrorHuman("API_ERROR", fmt.Sprintf("Failed to create API client: %s", err.Error()))
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			viewer, err := client.GetViewer(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("API key validation failed: %s", err.Error()))
+					output.ErrorHuman("VALIDATION_ERROR", fmt.Sprintf("API key validation failed: %s", err.Error()))
 					return nil
 				}
 				return output.Error("VALIDATION_ERROR", err.Error())
@@ -410,7 +642,7 @@ Examples:
 				manager, err := config.NewManager()
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHuman("CONFIG_ERROR", err.Error())
 						return nil
 					}
 					return output.Error("CONFIG_ERROR", err.Error())
@@ -420,7 +652,7 @@ Examples:
 				team, err := client.GetTeamByKey(ctx, teamKey)
 				if err != nil || team == nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+						output.ErrorHuman("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
 						return nil
 					}
 					return output.Error("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
@@ -428,7 +660,7 @@ Examples:
 
 				if err := manager.Set("team_key", teamKey); err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHuman("CONFIG_ERROR", err.Error())
 						return nil
 					}
 					return output.Error("CONFIG_ERROR", err.Error())
@@ -436,7 +668,7 @@ Examples:
 
 				if err := manager.Set("team_id", team.ID); err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHuman("CONFIG_ERROR", err.Error())
 						return nil
 					}
 					return output.Error("CONFIG_ERROR", err.Error())
@@ -512,7 +744,7 @@ func validateConfig(ctx context.Context) error {
 	client, err := api.NewClient(ctx)
 	if err != nil {
 		if IsHumanOutput() {
-			output.ErrorHuman(fmt.Sprintf("Configuration invalid: %s", err.Error()))
+			output.ErrorHuman("INVALID_CONFIG", fmt.Sprintf("Configuration invalid: %s", err.Error()))
 			return nil
 		}
 		return output.Error("INVALID_CONFIG", err.Error())
@@ -521,7 +753,7 @@ func validateConfig(ctx context.Context) error {
 	viewer, err := client.GetViewer(ctx)
 	if err != nil {
 		if IsHumanOutput() {
-			output.ErrorHuman(fmt.Sprintf("API validation failed: %s", err.Error()))
+			output.ErrorHuman("VALIDATION_ERROR", fmt.Sprintf("API validation failed: %s", err.Error()))
 			return nil
 		}
 		return output.Error("VALIDATION_ERROR", err.Error())
@@ -555,6 +787,32 @@ func isValidConfigKey(key string) bool {
 	return false
 }
 
+// validateColumnsConfig checks the column names of a columns.* value
+func validateColumnsConfig(key, value string) error {
+	var err error
+	switch key {
+	case "columns.issue_list":
+		_, _, err = parseColumns(issueColumns, value, nil, nil)
+	case "columns.project_list":
+		_, _, err = parseColumns(projectColumns, value, nil, nil)
+	case "columns.document_list":
+		_, _, err = parseColumns(documentColumns, value, nil, nil)
+	}
+	return err
+}
+
+// validateThemeConfig checks a theme.* value
+func validateThemeConfig(key, value string) error {
+	var err error
+	switch key {
+	case "theme.color":
+		_, err = output.ParseColorMode(value)
+	case "theme.depth":
+		_, err = output.ParseColorDepth(value)
+	}
+	return err
+}
+
 func maskSecret(secret string) string {
 	if len(secret) <= 8 {
 		return "****"
@@ -562,6 +820,31 @@ func maskSecret(secret string) string {
 	return secret[:4] + "..." + secret[len(secret)-4:]
 }
 
+// printConfigFile prints a config file layer, marking missing files
+func printConfigFile(source, path string) {
+	if path == "" {
+		output.HumanLn("  %-5s %s", source, output.Muted("(none)"))
+		return
+	}
+	if _, err := os.Stat(path); err != nil {
+		output.HumanLn("  %-5s %s %s", source, path, output.Muted("(missing)"))
+		return
+	}
+	output.HumanLn("  %-5s %s", source, path)
+}
+
+// keyColumnWidth returns the width of a "key:" column fitting each of keys
+// that starts with prefix, without the prefix
+func keyColumnWidth(keys []string, prefix string) int {
+	width := 0
+	for _, key := range keys {
+		if strings.HasPrefix(key, prefix) {
+			width = max(width, len(key)-len(prefix)+1)
+		}
+	}
+	return width
+}
+
 func printEnvVar(name string) {
 	value := os.Getenv(name)
 	if value != "" {
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// readContentFile reads markdown from a file, or from stdin when path is
+// "-"
+func readContentFile(path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// applyContentFile loads the file named by fileFlag (such as
+// --content-file) into textFlag (such as --content), marking textFlag as
+// changed. It reports errors itself: when ok is false, err should be
+// returned from RunE.
+func applyContentFile(cmd *cobra.Command, textFlag, fileFlag string) (ok bool, err error) {
+	flags := cmd.Flags()
+	if !flags.Changed(fileFlag) {
+		return true, nil
+	}
+	if flags.Changed(textFlag) {
+		msg := fmt.Sprintf("--%s and --%s cannot be used together", textFlag, fileFlag)
+		if IsHumanOutput() {
+			output.ErrorHuman("INVALID_FLAGS", msg)
+			return false, nil
+		}
+		return false, output.Error("INVALID_FLAGS", msg)
+	}
+
+	path, _ := flags.GetString(fileFlag)
+	text, err := readContentFile(path)
+	if err == nil {
+		err = flags.Set(textFlag, text)
+	}
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman("FILE_ERROR", err.Error())
+			return false, nil
+		}
+		return false, output.Error("FILE_ERROR", err.Error())
+	}
+	return true, nil
+}
+
+// appendUploads uploads files to Linear and appends a markdown link to each
+// to text: an inline image for images, a plain link otherwise
+func appendUploads(ctx context.Context, client *api.Client, text string, paths []string) (string, []*api.UploadedFile, error) {
+	uploads := make([]*api.UploadedFile, 0, len(paths))
+	links := make([]string, 0, len(paths))
+	for _, path := range paths {
+		upload, err := client.UploadFile(ctx, path)
+		if err != nil {
+			return "", uploads, fmt.Errorf("failed to upload %s: %w", path, err)
+		}
+		uploads = append(uploads, upload)
+
+		link := fmt.Sprintf("[%s](%s)", upload.Filename, upload.AssetURL)
+		if strings.HasPrefix(upload.ContentType, "image/") {
+			link = "!" + link
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return text, uploads, nil
+	}
+	if text = strings.TrimRight(text, " \t\r\n"); text != "" {
+		text += "\n\n"
+	}
+	return text + strings.Join(links, "\n\n"), uploads, nil
+}
+
+// editorCommand returns the user's editor from $VISUAL or $EDITOR, falling
+// back to vi (notepad on Windows) like git does
+func editorCommand() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// editText opens initial in the user's editor and returns the saved text.
+// The editor command may include arguments, such as "code --wait".
+func editText(initial string) (string, error) {
+	f, err := os.CreateTemp("", "linear-*.md")
+	if err != nil {
+		return "", err
+	}
+	path := f.Name()
+	defer os.Remove(path)
+
+	_, err = f.WriteString(initial)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	editor := editorCommand()
+	var c *exec.Cmd
+	if runtime.GOOS == "windows" {
+		c = exec.Command("cmd", "/C", editor+" "+path)
+	} else {
+		c = exec.Command("sh", "-c", editor+` "$@"`, "sh", path)
+	}
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := c.Run(); err != nil {
+		return "", fmt.Errorf("editor '%s' failed: %w", editor, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), " \t\r\n"), nil
+}
+
+// applyEditor opens textFlag's value in the user's editor when --editor is
+// set and stores the saved text back into textFlag. Without a value, the
+// editor starts from initial (the current content for updates; may be
+// nil). As with git commit, saving empty text aborts. Errors are reported
+// like applyContentFile.
+func applyEditor(cmd *cobra.Command, textFlag string, initial func() (string, error)) (ok bool, err error) {
+	flags := cmd.Flags()
+	if edit, _ := flags.GetBool("editor"); !edit {
+		return true, nil
+	}
+
+	text, _ := flags.GetString(textFlag)
+	if !flags.Changed(textFlag) && initial != nil {
+		if text, err = initial(); err != nil {
+			if IsHumanOutput() {
+				output.ErrorHumanFrom(err, "API_ERROR")
+				return false, nil
+			}
+			return false, output.ErrorFrom(err, "API_ERROR")
+		}
+	}
+
+	text, err = editText(text)
+	if err == nil {
+		err = flags.Set(textFlag, text)
+	}
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman("EDITOR_ERROR", err.Error())
+			return false, nil
+		}
+		return false, output.Error("EDITOR_ERROR", err.Error())
+	}
+
+	if strings.TrimSpace(text) == "" {
+		msg := fmt.Sprintf("Aborting due to empty %s", textFlag)
+		if IsHumanOutput() {
+			output.ErrorHuman("ABORTED", msg)
+			return false, nil
+		}
+		return false, output.Error("ABORTED", msg)
+	}
+	return true, nil
+}
@@ -0,0 +1,491 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// charsPerToken approximates tokenizer output for English and markdown
+	charsPerToken = 4
+
+	// contextMaxIssues caps how many related issues a bundle fetches
+	contextMaxIssues = 25
+
+	// contextMinSectionTokens is the smallest useful truncated section;
+	// sections that would be cut shorter are omitted instead
+	contextMinSectionTokens = 100
+
+	// contextDocSummaryChars is how much of each document is included
+	contextDocSummaryChars = 1500
+
+	// contextCommentFetchLimit is how many comments are scanned for the latest
+	contextCommentFetchLimit = 100
+)
+
+// Section priorities; lower values are kept first when over budget
+const (
+	priorityIssue    = 0
+	priorityBlocker  = 10
+	priorityParent   = 20
+	priorityComments = 30
+	priorityChild    = 40
+	priorityDocument = 50
+	priorityDistant  = 60
+)
+
+// ContextSection is one part of a context bundle
+type ContextSection struct {
+	Kind      string `json:"kind"`
+	Title     string `json:"title"`
+	Tokens    int    `json:"tokens"`
+	Truncated bool   `json:"truncated"`
+	Content   string `json:"content"`
+
+	priority int
+}
+
+// ContextBundle is the response for 'linear context'
+type ContextBundle struct {
+	Issue     string           `json:"issue"`
+	Budget    int              `json:"budget"`
+	Tokens    int              `json:"tokens"`
+	Truncated bool             `json:"truncated"`
+	Sections  []ContextSection `json:"sections"`
+	Omitted   []string         `json:"omitted"`
+	Markdown  string           `json:"markdown"`
+}
+
+// contextIssue is a related issue queued for the bundle
+type contextIssue struct {
+	id       string
+	hop      int
+	relation string
+}
+
+// NewContextCmd creates the context command
+func NewContextCmd() *cobra.Command {
+	var (
+		depth     int
+		maxTokens int
+		comments  int
+		docs      int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "context <issue-id>",
+		Short: "Bundle an issue and its surroundings for an LLM agent",
+		Long: `Produce a single markdown bundle with everything an agent needs to work
+on an issue: the issue itself, issues blocking it, its parent, the latest
+comments, its sub-issues, and summaries of its project's documents.
+
+--depth controls how far related issues are followed: 0 lists them by
+title only, 1 includes their full descriptions, 2 also includes the
+issues related to those, and so on.
+
+The bundle is fitted to --max-tokens (estimated at ~4 characters per
+token). Sections are kept in priority order (issue, blockers, parent,
+comments, sub-issues, documents, more distant issues); the first section
+that does not fit is truncated and the rest are listed as omitted.
+
+JSON output includes each section and the assembled markdown; --human
+prints the markdown only.
+
+Examples:
+  linear context ENG-123
+  linear context ENG-123 --depth 2 --max-tokens 16000
+  linear context ENG-123 --human > context.md`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+			ctx := cmd.Context()
+
+			if depth < 0 || maxTokens <= 0 {
+				msg := "--depth must be 0 or more and --max-tokens must be positive"
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_INPUT", msg)
+					return nil
+				}
+				return output.Error("INVALID_INPUT", msg)
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			issue, err := client.GetIssue(ctx, issueID, false)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if issue == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+			}
+
+			sections, err := collectContextSections(ctx, client, issue, depth, comments, docs)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			bundle := packContextBundle(issue.Identifier, sections, maxTokens)
+
+			if IsHumanOutput() {
+				output.HumanLn("%s", bundle.Markdown)
+				output.HumanLn("%s", output.Muted("~%d of %d tokens, %d sections, %d omitted", bundle.Tokens, bundle.Budget, len(bundle.Sections), len(bundle.Omitted)))
+			} else {
+				output.JSON(bundle)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&depth, "depth", 1, "How many hops of related issues to include in full")
+	cmd.Flags().IntVar(&maxTokens, "max-tokens", 8000, "Approximate token budget for the bundle")
+	cmd.Flags().IntVar(&comments, "comments", 10, "Number of latest comments to include")
+	cmd.Flags().IntVar(&docs, "docs", 3, "Number of project documents to summarize")
+
+	return cmd
+}
+
+// collectContextSections fetches the issue's surroundings and renders each
+// part as a prioritized markdown section
+func collectContextSections(ctx context.Context, client *api.Client, issue *api.IssueDetail, depth, commentLimit, docLimit int) ([]ContextSection, error) {
+	blockers, err := client.GetIssueInverseRelations(ctx, issue.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := []ContextSection{{
+		Kind:     "issue",
+		Title:    fmt.Sprintf("%s: %s", issue.Identifier, issue.Title),
+		Content:  renderContextIssue(issue, blockers, ""),
+		priority: priorityIssue,
+	}}
+
+	// Walk related issues breadth-first up to depth hops
+	seen := map[string]bool{issue.ID: true}
+	queue := relatedContextIssues(issue, blockers, 1)
+	fetched := 0
+	for len(queue) > 0 && fetched < contextMaxIssues {
+		next := queue[0]
+		queue = queue[1:]
+		if next.hop > depth || seen[next.id] {
+			continue
+		}
+		seen[next.id] = true
+
+		related, err := client.GetIssue(ctx, next.id, false)
+		if err != nil {
+			return nil, err
+		}
+		if related == nil {
+			continue
+		}
+		fetched++
+
+		relatedBlockers, err := client.GetIssueInverseRelations(ctx, related.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		sections = append(sections, ContextSection{
+			Kind:     next.relation,
+			Title:    fmt.Sprintf("%s: %s", related.Identifier, related.Title),
+			Content:  renderContextIssue(related, relatedBlockers, contextRelationLabel(next.relation, issue.Identifier, next.hop)),
+			priority: contextIssuePriority(next.relation, next.hop),
+		})
+		queue = append(queue, relatedContextIssues(related, relatedBlockers, next.hop+1)...)
+	}
+
+	if commentLimit > 0 {
+		allComments, err := client.GetIssueComments(ctx, issue.ID, contextCommentFetchLimit)
+		if err != nil {
+			return nil, err
+		}
+		if len(allComments) > 0 {
+			sections = append(sections, ContextSection{
+				Kind:     "comments",
+				Title:    "Comments",
+				Content:  renderContextComments(allComments, commentLimit),
+				priority: priorityComments,
+			})
+		}
+	}
+
+	if docLimit > 0 && issue.Project != nil {
+		documents, err := client.GetDocuments(ctx, issue.Project.ID, docLimit, "", "")
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range documents.Documents {
+			doc, err := client.GetDocument(ctx, d.ID)
+			if err != nil {
+				return nil, err
+			}
+			if doc == nil {
+				continue
+			}
+			sections = append(sections, ContextSection{
+				Kind:     "document",
+				Title:    doc.Title,
+				Content:  renderContextDocument(doc, issue.Project.Name),
+				priority: priorityDocument,
+			})
+		}
+	}
+
+	sort.SliceStable(sections, func(i, j int) bool { return sections[i].priority < sections[j].priority })
+	return sections, nil
+}
+
+// relatedContextIssues lists an issue's blockers, parent and children
+func relatedContextIssues(issue *api.IssueDetail, blockers []api.IssueRelation, hop int) []contextIssue {
+	related := []contextIssue{}
+	for _, r := range blockers {
+		if r.Type == "blocks" {
+			related = append(related, contextIssue{id: r.RelatedIssue.ID, hop: hop, relation: "blocker"})
+		}
+	}
+	if issue.Parent != nil {
+		related = append(related, contextIssue{id: issue.Parent.ID, hop: hop, relation: "parent"})
+	}
+	for _, c := range issue.Children {
+		related = append(related, contextIssue{id: c.ID, hop: hop, relation: "child"})
+	}
+	return related
+}
+
+// contextIssuePriority ranks related issues: direct blockers, then the
+// parent, then children, then anything further away
+func contextIssuePriority(relation string, hop int) int {
+	if hop > 1 {
+		return priorityDistant + hop
+	}
+	switch relation {
+	case "blocker":
+		return priorityBlocker
+	case "parent":
+		return priorityParent
+	default:
+		return priorityChild
+	}
+}
+
+// contextRelationLabel describes how a related issue connects to the
+// bundle's issue
+func contextRelationLabel(relation, identifier string, hop int) string {
+	if hop > 1 {
+		return fmt.Sprintf("related to %s (%d hops)", identifier, hop)
+	}
+	switch relation {
+	case "blocker":
+		return fmt.Sprintf("blocks %s", identifier)
+	case "parent":
+		return fmt.Sprintf("parent of %s", identifier)
+	default:
+		return fmt.Sprintf("sub-issue of %s", identifier)
+	}
+}
+
+// renderContextIssue renders an issue's fields, relations and description
+func renderContextIssue(issue *api.IssueDetail, blockers []api.IssueRelation, relation string) string {
+	var b strings.Builder
+
+	heading := fmt.Sprintf("## %s: %s", issue.Identifier, issue.Title)
+	if relation != "" {
+		heading += fmt.Sprintf(" (%s)", relation)
+	}
+	b.WriteString(heading + "\n\n")
+
+	fields := []string{
+		fmt.Sprintf("State: %s (%s)", issue.State.Name, issue.State.Type),
+		fmt.Sprintf("Priority: %s", display.PriorityName(issue.Priority)),
+	}
+	if issue.Estimate != nil {
+		fields = append(fields, fmt.Sprintf("Estimate: %g", *issue.Estimate))
+	}
+	if issue.Assignee != nil {
+		fields = append(fields, fmt.Sprintf("Assignee: %s", issue.Assignee.DisplayName))
+	}
+	if issue.DueDate != "" {
+		fields = append(fields, fmt.Sprintf("Due: %s", issue.DueDate))
+	}
+	fields = append(fields, fmt.Sprintf("Team: %s", issue.Team.Key))
+	if issue.Project != nil {
+		fields = append(fields, fmt.Sprintf("Project: %s", issue.Project.Name))
+	}
+	if issue.Cycle != nil {
+		fields = append(fields, fmt.Sprintf("Cycle: %s", issue.Cycle.Name))
+	}
+	if len(issue.Labels) > 0 {
+		names := make([]string, len(issue.Labels))
+		for i, l := range issue.Labels {
+			names[i] = l.Name
+		}
+		fields = append(fields, fmt.Sprintf("Labels: %s", strings.Join(names, ", ")))
+	}
+	if issue.Parent != nil {
+		fields = append(fields, fmt.Sprintf("Parent: %s %s", issue.Parent.Identifier, issue.Parent.Title))
+	}
+	if len(issue.Children) > 0 {
+		children := make([]string, len(issue.Children))
+		for i, c := range issue.Children {
+			children[i] = fmt.Sprintf("%s %s [%s]", c.Identifier, c.Title, c.State.Name)
+		}
+		fields = append(fields, fmt.Sprintf("Sub-issues: %s", strings.Join(children, "; ")))
+	}
+	if refs := relationRefs(blockers, "blocks"); refs != "" {
+		fields = append(fields, fmt.Sprintf("Blocked by: %s", refs))
+	}
+	if refs := relationRefs(issue.Relations, "blocks"); refs != "" {
+		fields = append(fields, fmt.Sprintf("Blocks: %s", refs))
+	}
+	if refs := relationRefs(issue.Relations, "related"); refs != "" {
+		fields = append(fields, fmt.Sprintf("Related: %s", refs))
+	}
+	fields = append(fields, fmt.Sprintf("URL: %s", issue.URL))
+
+	for _, f := range fields {
+		b.WriteString("- " + f + "\n")
+	}
+
+	if description := strings.TrimSpace(issue.Description); description != "" {
+		b.WriteString("\n" + description + "\n")
+	}
+	return b.String()
+}
+
+// relationRefs lists the related issues of relations of one type
+func relationRefs(relations []api.IssueRelation, relationType string) string {
+	refs := []string{}
+	for _, r := range relations {
+		if r.Type == relationType {
+			refs = append(refs, fmt.Sprintf("%s %s", r.RelatedIssue.Identifier, r.RelatedIssue.Title))
+		}
+	}
+	return strings.Join(refs, "; ")
+}
+
+// renderContextComments renders the latest comments, oldest first
+func renderContextComments(comments []api.Comment, limit int) string {
+	sorted := make([]api.Comment, len(comments))
+	copy(sorted, comments)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt < sorted[j].CreatedAt })
+	if len(sorted) > limit {
+		sorted = sorted[len(sorted)-limit:]
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Comments (latest %d of %d)\n", len(sorted), len(comments))
+	for _, c := range sorted {
+		author := "unknown"
+		if c.User != nil {
+			author = c.User.DisplayName
+		}
+		when := c.CreatedAt
+		if t, err := display.ParseISO(c.CreatedAt); err == nil {
+			when = display.FormatDateTime(t)
+		}
+		fmt.Fprintf(&b, "\n**@%s** · %s\n\n%s\n", author, when, strings.TrimSpace(c.Body))
+	}
+	return b.String()
+}
+
+// renderContextDocument renders the start of a project document
+func renderContextDocument(doc *api.Document, projectName string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Document: %s\n\n", doc.Title)
+	fmt.Fprintf(&b, "- Project: %s\n- URL: %s\n", projectName, doc.URL)
+
+	content := strings.TrimSpace(doc.Content)
+	if content != "" {
+		summary, cut := truncateText(content, contextDocSummaryChars)
+		b.WriteString("\n" + summary + "\n")
+		if cut {
+			b.WriteString("\n[document continues]\n")
+		}
+	}
+	return b.String()
+}
+
+// packContextBundle keeps sections in priority order until the budget is
+// spent, truncating the first section that does not fit
+func packContextBundle(identifier string, sections []ContextSection, budget int) *ContextBundle {
+	bundle := &ContextBundle{
+		Issue:    identifier,
+		Budget:   budget,
+		Sections: []ContextSection{},
+		Omitted:  []string{},
+	}
+
+	remaining := budget
+	for _, section := range sections {
+		tokens := estimateTokens(section.Content)
+		if tokens > remaining {
+			if remaining < contextMinSectionTokens {
+				bundle.Omitted = append(bundle.Omitted, fmt.Sprintf("%s: %s", section.Kind, section.Title))
+				bundle.Truncated = true
+				continue
+			}
+			section.Content, _ = truncateText(section.Content, remaining*charsPerToken)
+			section.Content += "\n[truncated to fit the token budget]\n"
+			section.Truncated = true
+			bundle.Truncated = true
+			tokens = estimateTokens(section.Content)
+		}
+		section.Tokens = tokens
+		remaining -= tokens
+		bundle.Tokens += tokens
+		bundle.Sections = append(bundle.Sections, section)
+	}
+
+	parts := make([]string, len(bundle.Sections))
+	for i, s := range bundle.Sections {
+		parts[i] = strings.TrimRight(s.Content, "\n")
+	}
+	bundle.Markdown = fmt.Sprintf("# Context for %s\n\n%s\n", identifier, strings.Join(parts, "\n\n"))
+	return bundle
+}
+
+// estimateTokens approximates the token count of text
+func estimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + charsPerToken - 1) / charsPerToken
+}
+
+// truncateText cuts text to at most maxChars characters, preferring a line
+// break, and reports whether anything was cut
+func truncateText(text string, maxChars int) (string, bool) {
+	runes := []rune(text)
+	if len(runes) <= maxChars {
+		return text, false
+	}
+	cut := string(runes[:maxChars])
+	if i := strings.LastIndex(cut, "\n"); i > len(cut)/2 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " \n"), true
+}
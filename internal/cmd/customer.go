@@ -0,0 +1,520 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+// CustomerListResponse is the response for the customer list command
+type CustomerListResponse struct {
+	Customers []api.Customer `json:"customers"`
+	Count     int            `json:"count"`
+	PageInfo  *api.PageInfo  `json:"pageInfo,omitempty"`
+}
+
+// CustomerViewResponse is the response for the customer view command
+type CustomerViewResponse struct {
+	Customer *api.Customer      `json:"customer"`
+	Requests []api.CustomerNeed `json:"requests"`
+	Count    int                `json:"count"`
+	PageInfo *api.PageInfo      `json:"pageInfo,omitempty"`
+}
+
+// CustomerRequestsResponse is the response for the customer requests command
+type CustomerRequestsResponse struct {
+	Customer *api.Customer      `json:"customer,omitempty"`
+	Issue    string             `json:"issue,omitempty"`
+	Requests []api.CustomerNeed `json:"requests"`
+	Count    int                `json:"count"`
+	PageInfo *api.PageInfo      `json:"pageInfo,omitempty"`
+}
+
+// NewCustomerCmd creates the customer command group
+func NewCustomerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "customer",
+		Aliases: []string{"customers"},
+		Short:   "Triage customer requests",
+		Long: `List customers, review their requests and attach customer requests
+(customer needs) to issues.
+
+Customers are given by ID, slug or name (case-insensitive).
+
+Examples:
+  linear customer list
+  linear customer view Acme --human
+  linear customer requests --issue ENG-123
+  linear customer attach ENG-123 --customer Acme --body "Blocking their rollout" --important`,
+	}
+
+	cmd.AddCommand(newCustomerListCmd())
+	cmd.AddCommand(newCustomerViewCmd())
+	cmd.AddCommand(newCustomerRequestsCmd())
+	cmd.AddCommand(newCustomerAttachCmd())
+
+	return cmd
+}
+
+func newCustomerListCmd() *cobra.Command {
+	var (
+		limit int
+		after string
+		all   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List customers",
+		Long: `List customers with their status, tier, owner and request count.
+
+Examples:
+  linear customer list
+  linear customer list --all --human`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			customers, pageInfo, err := collectPages(after, all, func(after string) ([]api.Customer, *api.PageInfo, error) {
+				return client.GetCustomers(ctx, limit, after)
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			response := &CustomerListResponse{
+				Customers: customers,
+				Count:     len(customers),
+				PageInfo:  pageInfo,
+			}
+
+			if IsHumanOutput() {
+				printCustomersHuman(response)
+				printPageHintHuman(response.PageInfo)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum number of customers to return (page size with --all)")
+	cmd.Flags().StringVar(&after, "after", "", "Start after this cursor (from pageInfo.endCursor)")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch every page of results")
+
+	return cmd
+}
+
+func newCustomerViewCmd() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "view <customer>",
+		Short: "View a customer and its requests",
+		Long: `View a customer with its most recent requests and the issues they are
+attached to.
+
+Examples:
+  linear customer view Acme
+  linear customer view acme-corp --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			customer, ok, err := resolveCustomer(ctx, client, args[0])
+			if !ok {
+				return err
+			}
+
+			needs, pageInfo, err := client.GetCustomerNeeds(ctx, api.CustomerNeedFilter{CustomerID: customer.ID}, limit, "")
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			response := &CustomerViewResponse{
+				Customer: customer,
+				Requests: needs,
+				Count:    len(needs),
+				PageInfo: pageInfo,
+			}
+
+			if IsHumanOutput() {
+				printCustomerDetailHuman(response)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 25, "Maximum number of requests to show")
+
+	return cmd
+}
+
+func newCustomerRequestsCmd() *cobra.Command {
+	var (
+		issueID string
+		limit   int
+		after   string
+		all     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "requests [customer]",
+		Short: "List customer requests",
+		Long: `List customer requests (customer needs) for a customer, for an issue, or
+for both.
+
+Examples:
+  linear customer requests Acme
+  linear customer requests --issue ENG-123 --human
+  linear customer requests Acme --issue ENG-123`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && issueID == "" {
+				msg := "A customer or --issue is required"
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_FILTER", msg)
+					return nil
+				}
+				return output.Error("MISSING_FILTER", msg)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			response := &CustomerRequestsResponse{}
+			var filter api.CustomerNeedFilter
+			if len(args) == 1 {
+				customer, ok, err := resolveCustomer(ctx, client, args[0])
+				if !ok {
+					return err
+				}
+				response.Customer = customer
+				filter.CustomerID = customer.ID
+			}
+			if issueID != "" {
+				issue, err := client.GetIssue(ctx, issueID, false)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				response.Issue = issue.Identifier
+				filter.IssueID = issue.ID
+			}
+
+			needs, pageInfo, err := collectPages(after, all, func(after string) ([]api.CustomerNeed, *api.PageInfo, error) {
+				return client.GetCustomerNeeds(ctx, filter, limit, after)
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			response.Requests = needs
+			response.Count = len(needs)
+			response.PageInfo = pageInfo
+
+			if IsHumanOutput() {
+				printCustomerNeedsHuman(needs)
+				printPageHintHuman(pageInfo)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().StringVar(&issueID, "issue", "", "Only requests attached to this issue")
+	markRefFlag(cmd, "issue", resolver.KindIssue)
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum number of requests to return (page size with --all)")
+	cmd.Flags().StringVar(&after, "after", "", "Start after this cursor (from pageInfo.endCursor)")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch every page of results")
+
+	return cmd
+}
+
+func newCustomerAttachCmd() *cobra.Command {
+	var (
+		customerArg string
+		body        string
+		important   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "attach <issue-id>",
+		Aliases: []string{"need"},
+		Short:   "Attach a customer request to an issue",
+		Long: `Record that a customer needs an issue, optionally quoting their request and
+marking it important.
+
+Examples:
+  linear customer attach ENG-123 --customer Acme
+  linear customer attach ENG-123 --customer Acme --body "SSO is blocking their rollout" --important`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if customerArg == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_CUSTOMER", "Customer is required. Use --customer flag.")
+					return nil
+				}
+				return output.Error("MISSING_CUSTOMER", "Customer is required")
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			customer, ok, err := resolveCustomer(ctx, client, customerArg)
+			if !ok {
+				return err
+			}
+
+			issue, err := client.GetIssue(ctx, args[0], false)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			input := api.CustomerNeedCreateInput{
+				CustomerID: customer.ID,
+				IssueID:    issue.ID,
+				Body:       body,
+			}
+			if important {
+				input.Priority = 1
+			}
+
+			need, err := client.CreateCustomerNeed(ctx, input)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Attached %s request to %s", customer.Name, issue.Identifier))
+				output.HumanLn("  ID: %s", need.ID)
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "attach",
+				"request":   need,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&customerArg, "customer", "c", "", "Customer ID, slug or name (required)")
+	cmd.Flags().StringVarP(&body, "body", "b", "", "The customer's request, in markdown")
+	cmd.Flags().BoolVar(&important, "important", false, "Mark the request as important")
+
+	return cmd
+}
+
+// resolveCustomer finds a customer by name, falling back to ID or slug. On
+// failure it reports the error itself and returns ok=false with the error
+// RunE should return.
+func resolveCustomer(ctx context.Context, client *api.Client, arg string) (customer *api.Customer, ok bool, err error) {
+	if !isUUID(arg) {
+		customer, err = client.FindCustomerByName(ctx, arg)
+		if err == nil && customer != nil {
+			return customer, true, nil
+		}
+	}
+	if err == nil {
+		customer, err = client.GetCustomer(ctx, arg)
+	}
+	if err == nil {
+		return customer, true, nil
+	}
+
+	if errors.Is(err, api.ErrNotFound) {
+		msg := fmt.Sprintf("Customer '%s' not found", arg)
+		hint := "List customers with 'linear customer list'"
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("NOT_FOUND", msg, hint)
+			return nil, false, nil
+		}
+		return nil, false, output.ErrorWithHint("NOT_FOUND", msg, hint)
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil, false, nil
+	}
+	return nil, false, output.ErrorFrom(err, "API_ERROR")
+}
+
+func printCustomersHuman(response *CustomerListResponse) {
+	if len(response.Customers) == 0 {
+		output.HumanLn("No customers found")
+		return
+	}
+
+	headers := []string{"NAME", "STATUS", "TIER", "OWNER", "REQUESTS", "DOMAINS"}
+	rows := make([][]string, len(response.Customers))
+
+	for i, c := range response.Customers {
+		status, tier, owner := "-", "-", "-"
+		if c.Status != nil {
+			status = c.Status.Name
+		}
+		if c.Tier != nil {
+			tier = c.Tier.Name
+		}
+		if c.Owner != nil {
+			owner = c.Owner.DisplayName
+		}
+		domains := strings.Join(c.Domains, ", ")
+		if domains == "" {
+			domains = "-"
+		}
+
+		rows[i] = []string{
+			display.Truncate(c.Name, 30),
+			status,
+			tier,
+			owner,
+			fmt.Sprintf("%.0f", c.ApproximateNeedCount),
+			display.Truncate(domains, 30),
+		}
+	}
+
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d customers", response.Count)
+}
+
+func printCustomerDetailHuman(response *CustomerViewResponse) {
+	c := response.Customer
+	output.HumanLn("%s", output.Bold("%s", c.Name))
+	if c.Status != nil {
+		output.HumanLn("Status: %s", c.Status.Name)
+	}
+	if c.Tier != nil {
+		output.HumanLn("Tier: %s", c.Tier.Name)
+	}
+	if c.Owner != nil {
+		output.HumanLn("Owner: %s", c.Owner.DisplayName)
+	}
+	if len(c.Domains) > 0 {
+		output.HumanLn("Domains: %s", strings.Join(c.Domains, ", "))
+	}
+	if c.Revenue != nil {
+		output.HumanLn("Revenue: %.0f", *c.Revenue)
+	}
+	if c.Size != nil {
+		output.HumanLn("Size: %.0f", *c.Size)
+	}
+	if c.URL != "" {
+		output.HumanLn("%s", output.Muted("%s", c.URL))
+	}
+
+	output.HumanLn("\n%s\n", output.Bold("Requests"))
+	printCustomerNeedsHuman(response.Requests)
+	if response.PageInfo != nil && response.PageInfo.HasNextPage {
+		output.HumanLn("%s", output.Muted("More requests: linear customer requests %q --all", c.Name))
+	}
+}
+
+func printCustomerNeedsHuman(needs []api.CustomerNeed) {
+	if len(needs) == 0 {
+		output.HumanLn("No customer requests found")
+		return
+	}
+
+	headers := []string{"CUSTOMER", "ISSUE", "STATE", "IMPORTANT", "REQUEST", "CREATED"}
+	rows := make([][]string, len(needs))
+
+	for i, n := range needs {
+		customer, issue, state := "-", "-", "-"
+		if n.Customer != nil {
+			customer = n.Customer.Name
+		}
+		if n.Issue != nil {
+			issue = n.Issue.Identifier + " " + n.Issue.Title
+			if n.Issue.State != nil {
+				state = n.Issue.State.Name
+			}
+		}
+		important := ""
+		if n.Important() {
+			important = output.Red("yes")
+		}
+		body := strings.Join(strings.Fields(n.Body), " ")
+		if body == "" {
+			body = "-"
+		}
+		created := n.CreatedAt
+		if t, err := display.ParseISO(n.CreatedAt); err == nil {
+			created = display.TimeAgo(t)
+		}
+
+		rows[i] = []string{
+			display.Truncate(customer, 20),
+			display.Truncate(issue, 40),
+			state,
+			important,
+			display.Truncate(body, 40),
+			created,
+		}
+	}
+
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d requests", len(needs))
+}
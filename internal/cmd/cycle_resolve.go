@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// CycleResolveError reports a relative cycle the team doesn't have, such
+// as "current" for a team without an active cycle
+type CycleResolveError struct {
+	Cycle string
+}
+
+func (e *CycleResolveError) Error() string {
+	return fmt.Sprintf("team has no %s cycle", e.Cycle)
+}
+
+// Hint suggests how to find the team's cycles
+func (e *CycleResolveError) Hint() string {
+	return "Check the team's active cycle with 'linear team view <key>', pass --cycle with a cycle ID, or skip configured defaults with --no-defaults"
+}
+
+// resolveCycleID turns "current", "next" or "previous" into the ID of the
+// team's cycle, leaving cycle IDs as they are
+func resolveCycleID(ctx context.Context, client *api.Client, teamID, value string) (string, error) {
+	switch value {
+	case "":
+		return "", nil
+	case "current", "active", "next", "previous":
+	default:
+		return value, nil
+	}
+
+	cycle, err := client.GetRelativeCycle(ctx, teamID, value)
+	if err != nil {
+		return "", err
+	}
+	if cycle == nil {
+		return "", &CycleResolveError{Cycle: value}
+	}
+	return cycle.ID, nil
+}
+
+// cycleError reports an error from resolveCycleID
+func cycleError(err error) error {
+	var resolveErr *CycleResolveError
+	if errors.As(err, &resolveErr) {
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("INVALID_CYCLE", resolveErr.Error(), resolveErr.Hint())
+			return nil
+		}
+		return output.ErrorWithHint("INVALID_CYCLE", resolveErr.Error(), resolveErr.Hint())
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil
+	}
+	return output.ErrorFrom(err, "API_ERROR")
+}
@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/auth"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/daemon"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// NewDaemonCmd creates the daemon command group
+func NewDaemonCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Run a background process that speeds up API calls",
+		Long: `Run a long-lived process that keeps an authenticated connection to Linear
+open and the cache fresh, serving API requests over a unix socket.
+
+While the daemon runs, every linear command detects it and sends its
+requests through it, skipping the credential lookup and TLS handshake. This
+matters for agents issuing hundreds of calls. Commands use the daemon's
+credentials unless LINEAR_API_KEY is set; set LINEAR_DAEMON=off to bypass
+the daemon.
+
+The socket is $XDG_RUNTIME_DIR/agent-linear-cli/daemon.sock, or
+$LINEAR_DAEMON_SOCKET when set. Without XDG_RUNTIME_DIR it is in
+agent-linear-cli-<uid> under the system temp directory. The CLI only uses a
+socket you own, and outside $LINEAR_DAEMON_SOCKET only in a directory you
+own with mode 0700; the daemon refuses to start in any other directory.
+
+Examples:
+  linear daemon start &
+  linear daemon status --human
+  linear daemon stop`,
+	}
+
+	cmd.AddCommand(newDaemonStartCmd())
+	cmd.AddCommand(newDaemonStatusCmd())
+	cmd.AddCommand(newDaemonStopCmd())
+
+	return cmd
+}
+
+func newDaemonStartCmd() *cobra.Command {
+	var refresh time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Run the daemon in the foreground",
+		Long: `Run the daemon until it is interrupted or 'linear daemon stop' is run.
+
+Every --refresh interval the daemon pulls teams, workflow states, labels,
+users, cycles and your assigned issues into the cache, like 'linear sync'.
+--refresh 0 disables this.
+
+Examples:
+  linear daemon start &
+  linear daemon start --refresh 5m
+  nohup linear daemon start > /dev/null 2>&1 &`,
+		Annotations: map[string]string{noTimeoutAnnotation: "true"},
+		Args:        cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if daemon.Running() {
+				msg := fmt.Sprintf("A daemon is already running on %s", daemon.SocketPath())
+				if IsHumanOutput() {
+					output.ErrorHuman("DAEMON_RUNNING", msg)
+					return nil
+				}
+				return output.Error("DAEMON_RUNNING", msg)
+			}
+
+			ctx := cmd.Context()
+
+			token, _, err := auth.NewManager().GetToken(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+			client := api.NewClientWithToken(token)
+
+			server := &daemon.Server{Token: token, Interval: refresh}
+			if cacheManager, err := cache.NewManager(); err == nil {
+				server.Refresh = func(ctx context.Context) error {
+					_, err := runSync(ctx, client, cacheManager, nil)
+					return err
+				}
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Daemon listening on %s", daemon.SocketPath()))
+			} else {
+				output.JSON(map[string]interface{}{
+					"success": true,
+					"pid":     os.Getpid(),
+					"socket":  daemon.SocketPath(),
+				})
+			}
+
+			if err := server.Serve(ctx); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("DAEMON_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("DAEMON_ERROR", err.Error())
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&refresh, "refresh", 15*time.Minute, "How often to refresh the cache (0 disables)")
+
+	return cmd
+}
+
+func newDaemonStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show whether the daemon is running",
+		Long: `Show the running daemon's process ID, socket, uptime, the number of
+requests it served and its last cache refresh. Exits 1 when no daemon is
+running.
+
+Examples:
+  linear daemon status --human`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status, err := daemon.GetStatus(cmd.Context())
+			if err != nil {
+				return daemonError(err)
+			}
+
+			if IsHumanOutput() {
+				output.HumanLn("%s", output.Green("Daemon running"))
+				output.KeyValue("PID", fmt.Sprintf("%d", status.PID))
+				output.KeyValue("Socket", status.Socket)
+				output.KeyValue("Started", display.TimeAgo(status.StartedAt))
+				output.KeyValue("Requests", fmt.Sprintf("%d", status.Requests))
+				if status.LastRefresh != nil {
+					output.KeyValue("Cache refreshed", display.TimeAgo(*status.LastRefresh))
+				}
+				if status.RefreshError != "" {
+					output.KeyValue("Refresh error", output.Red("%s", status.RefreshError))
+				}
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"running": true,
+				"daemon":  status,
+			})
+		},
+	}
+}
+
+func newDaemonStopCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stop",
+		Short: "Stop the running daemon",
+		Long: `Ask the running daemon to finish in-flight requests and exit.
+
+Examples:
+  linear daemon stop`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := daemon.Stop(cmd.Context()); err != nil {
+				return daemonError(err)
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Daemon stopped")
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success": true,
+			})
+		},
+	}
+}
+
+// daemonError reports a failure to reach the daemon
+func daemonError(err error) error {
+	code := "DAEMON_ERROR"
+	if errors.Is(err, daemon.ErrNotRunning) {
+		code = "DAEMON_NOT_RUNNING"
+		err = fmt.Errorf("no daemon is running on %s; start one with 'linear daemon start &'", daemon.SocketPath())
+	}
+	if IsHumanOutput() {
+		output.ErrorHuman(code, err.Error())
+		return nil
+	}
+	return output.Error(code, err.Error())
+}
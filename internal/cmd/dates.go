@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/dates"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// loadCalendar returns the configured working calendar, wrapping config errors
+func loadCalendar() (*dates.Calendar, error) {
+	cal, err := dates.Load()
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar config: %w", err)
+	}
+	return cal, nil
+}
+
+// resolveDueDate turns a due date expression such as "2025-03-01", "tomorrow",
+// or "in 3 business days" into a YYYY-MM-DD date. Empty input stays empty.
+func resolveDueDate(expr string) (string, error) {
+	if expr == "" {
+		return "", nil
+	}
+
+	cal, err := loadCalendar()
+	if err != nil {
+		return "", err
+	}
+
+	t, err := cal.Parse(expr, time.Now())
+	if err != nil {
+		return "", err
+	}
+	return t.Format(dates.DateLayout), nil
+}
+
+// dateFlagHelp describes the date expressions accepted by date flags
+const dateFlagHelp = "YYYY-MM-DD, tomorrow, friday, \"next week\", \"in 2 weeks\", eow"
+
+// resolvedDates are the dates that date flags resolved to, keyed by the
+// field they set, such as dueDate. Commands echo them back so a natural
+// language date can be checked.
+type resolvedDates map[string]string
+
+// resolveDateFlags resolves the date expressions of the given flags, keyed
+// by flag name, replacing each value with its YYYY-MM-DD date. Empty values
+// are left alone.
+func resolveDateFlags(flags map[string]*string) (resolvedDates, error) {
+	resolved := resolvedDates{}
+	for flag, value := range flags {
+		if *value == "" {
+			continue
+		}
+		date, err := resolveDueDate(*value)
+		if err != nil {
+			return nil, fmt.Errorf("--%s: %w", flag, err)
+		}
+		*value = date
+		resolved[dateField(flag)] = date
+	}
+	return resolved, nil
+}
+
+// dateField turns a flag name like due-date into its field name, dueDate
+func dateField(flag string) string {
+	parts := strings.Split(flag, "-")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// addTo sets the resolved dates on a JSON response
+func (d resolvedDates) addTo(response map[string]interface{}) {
+	if len(d) > 0 {
+		response["dates"] = d
+	}
+}
+
+// printHuman lists the resolved dates under a success message
+func (d resolvedDates) printHuman() {
+	fields := make([]string, 0, len(d))
+	for field := range d {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		output.KeyValue(field, d[field])
+	}
+}
+
+// dateError reports an invalid date flag
+func dateError(err error) error {
+	if IsHumanOutput() {
+		output.ErrorHuman("INVALID_DATE", err.Error())
+		return nil
+	}
+	return output.Error("INVALID_DATE", err.Error())
+}
+
+var pastOffsetPattern = regexp.MustCompile(`^(?:-(\d+)\s*(d|days?|w|weeks?)|(\d+)\s*(d|days?|w|weeks?)\s+ago)$`)
+
+// resolveFilterDate turns a date filter expression into an ISO 8601 date or
+// time. It accepts everything resolveDueDate does, RFC 3339 timestamps,
+// "yesterday", and past offsets such as "-7d" or "2 weeks ago".
+func resolveFilterDate(expr string) (string, error) {
+	s := strings.ToLower(strings.TrimSpace(expr))
+	if s == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(time.RFC3339, expr); err == nil {
+		return expr, nil
+	}
+
+	today := time.Now()
+	if s == "yesterday" {
+		return today.AddDate(0, 0, -1).Format(dates.DateLayout), nil
+	}
+	if m := pastOffsetPattern.FindStringSubmatch(s); m != nil {
+		n, unit := m[1], m[2]
+		if n == "" {
+			n, unit = m[3], m[4]
+		}
+		days, _ := strconv.Atoi(n)
+		if strings.HasPrefix(unit, "w") {
+			days *= 7
+		}
+		return today.AddDate(0, 0, -days).Format(dates.DateLayout), nil
+	}
+
+	date, err := resolveDueDate(expr)
+	if err != nil {
+		return "", fmt.Errorf("%w; past dates can be given as -7d or \"2 weeks ago\"", err)
+	}
+	return date, nil
+}
+
+// overdueBusinessDays returns how many working days have passed since the
+// given YYYY-MM-DD due date, or 0 if it is not overdue
+func overdueBusinessDays(dueDate string) int {
+	due, err := time.ParseInLocation(dates.DateLayout, dueDate, time.Local)
+	if err != nil {
+		return 0
+	}
+
+	cal, err := loadCalendar()
+	if err != nil {
+		cal = dates.Default()
+	}
+
+	if days := cal.BusinessDaysBetween(due, time.Now()); days > 0 {
+		return days
+	}
+	return 0
+}
@@ -1,12 +1,14 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
 	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
 	"github.com/juanbermudez/agent-linear-cli/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -32,14 +34,20 @@ Examples:
 	cmd.AddCommand(newDocumentDeleteCmd())
 	cmd.AddCommand(newDocumentRestoreCmd())
 	cmd.AddCommand(newDocumentSearchCmd())
+	cmd.AddCommand(newDocumentExportCmd())
 
 	return cmd
 }
 
 func newDocumentListCmd() *cobra.Command {
 	var (
-		projectID string
-		limit     int
+		projectID  string
+		limit      int
+		all        bool
+		after      string
+		sortBy     string
+		reverse    bool
+		columnList string
 	)
 
 	cmd := &cobra.Command{
@@ -50,31 +58,86 @@ func newDocumentListCmd() *cobra.Command {
 Examples:
   linear document list
   linear document list --project abc123
-  linear document list --limit 20`,
+  linear document list --limit 20
+  linear document list --all
+  linear document list --sort title
+  linear document list --columns title,creator,url --human
+
+--sort orders by updated or created (newest first) or title. The API
+sorts by updated and created across pages; title sorts the fetched
+results, so combine it with --all to sort everything.
+
+--columns picks the columns of table and TSV output from ` + columnNames(documentColumns) + `.
+Save a preference with 'linear config set columns.document_list title,url'.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
+
+			order, err := parseSort(documentSortKeys, sortBy, reverse)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_SORT", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_SORT", err.Error())
+			}
+
+			columns, custom, err := parseColumns(documentColumns, columnList, loadConfig().Columns.DocumentList, documentDefaultColumns)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_COLUMNS", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_COLUMNS", err.Error())
+			}
+			if custom {
+				setTSVColumns(columns)
+			}
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
-			documents, err := client.GetDocuments(ctx, projectID, limit)
+			fetch := func(after string) ([]api.DocumentListItem, *api.PageInfo, error) {
+				page, err := client.GetDocuments(ctx, projectID, limit, order.serverOrder(), after)
+				if err != nil {
+					return nil, nil, err
+				}
+				return page.Documents, page.PageInfo, nil
+			}
+			var (
+				items    []api.DocumentListItem
+				pageInfo *api.PageInfo
+				streamed bool
+			)
+			if order.local() {
+				items, pageInfo, err = collectPages(after, all, fetch)
+			} else {
+				items, pageInfo, streamed, err = streamPages(after, all, fetch)
+			}
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			order.apply(items)
+
+			documents := &api.DocumentsResponse{
+				Documents: items,
+				Count:     len(items),
+				PageInfo:  pageInfo,
 			}
 
 			if IsHumanOutput() {
-				printDocumentsHuman(documents)
-			} else {
+				printDocumentsHuman(documents, columns)
+				printPageHintHuman(documents.PageInfo)
+			} else if !streamed {
 				output.JSON(documents)
 			}
 
@@ -83,7 +146,12 @@ Examples:
 	}
 
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Filter by project ID")
-	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum documents to return")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum documents to return (page size with --all)")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch every page of results")
+	cmd.Flags().StringVar(&after, "after", "", "Start after this cursor (from pageInfo.endCursor)")
+	cmd.Flags().StringVar(&sortBy, "sort", "", "Sort by "+sortNames(documentSortKeys)+" (default: API order)")
+	cmd.Flags().BoolVar(&reverse, "reverse", false, "Reverse the sort order")
+	cmd.Flags().StringVar(&columnList, "columns", "", "Comma-separated columns for table and TSV output")
 
 	return cmd
 }
@@ -100,12 +168,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			documentID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -114,19 +182,20 @@ Examples:
 			document, err := client.GetDocument(ctx, documentID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if document == nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Document '%s' not found", documentID))
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Document '%s' not found", documentID))
 					return nil
 				}
 				return output.Error("NOT_FOUND", fmt.Sprintf("Document '%s' not found", documentID))
 			}
+			recordDocument(document, history.ActionViewed)
 
 			if IsHumanOutput() {
 				printDocumentDetailHuman(document)
@@ -143,12 +212,14 @@ Examples:
 
 func newDocumentCreateCmd() *cobra.Command {
 	var (
-		title     string
-		content   string
-		projectID string
-		teamKey   string
-		icon      string
-		color     string
+		title        string
+		content      string
+		projectID    string
+		teamKey      string
+		issueID      string
+		initiativeID string
+		icon         string
+		color        string
 	)
 
 	cmd := &cobra.Command{
@@ -156,16 +227,28 @@ func newDocumentCreateCmd() *cobra.Command {
 		Short: "Create a new document",
 		Long: `Create a new document in Linear.
 
-Note: Documents must be associated with a project or team.
+Note: Documents must be associated with a project, team, issue or
+initiative.
 
 Examples:
   linear document create --title "PRD: Feature X" --team ENG
   linear document create --title "Research Notes" --content "## Summary..." --project abc123
-  linear document create --title "Spec" --project abc123`,
+  linear document create --title "Spec" --content-file spec.md --project abc123
+  linear document create --title "Investigation" --content-file notes.md --issue ENG-123
+  generate-notes | linear document create --title "Notes" --content-file - --team ENG
+  linear document create --title "Notes" --editor --team ENG`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if ok, err := applyContentFile(cmd, "content", "content-file"); !ok {
+				return err
+			}
+			if ok, err := applyEditor(cmd, "content", nil); !ok {
+				return err
+			}
+
 			if title == "" {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"MISSING_TITLE",
 						"Document title is required",
 						"Provide a title using the --title flag",
 						"linear document create --title \"My Doc\" --team ENG",
@@ -180,12 +263,17 @@ Examples:
 				)
 			}
 
-			ctx := context.Background()
+			if err := normalizeIconFlag(&icon); err != nil {
+				return iconError(err)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"AUTH_ERROR",
 						err.Error(),
 						"Authentication failed. Make sure you're logged in",
 						"linear auth login --with-token",
@@ -200,19 +288,37 @@ Examples:
 				)
 			}
 
+			// An issue or initiative is the document's parent, so it needs
+			// no project or team
+			if issueID != "" {
+				parent, err := getDocLinkParent(ctx, client, issueDocTarget, issueID)
+				if err != nil {
+					return docLinkError(err)
+				}
+				issueID = parent.ID
+			}
+			if initiativeID != "" {
+				parent, err := getDocLinkParent(ctx, client, initiativeDocTarget, initiativeID)
+				if err != nil {
+					return docLinkError(err)
+				}
+				initiativeID = parent.ID
+			}
+			attached := issueID != "" || initiativeID != ""
+
 			// Resolve team key to ID if provided
 			var teamID string
-			if teamKey == "" {
+			if teamKey == "" && !attached {
 				teamKey = GetTeamID()
 			}
 			if teamKey != "" && projectID == "" {
 				team, err := client.GetTeamByKey(ctx, teamKey)
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHumanFrom(err, "API_ERROR")
 						return nil
 					}
-					return output.Error("API_ERROR", err.Error())
+					return output.ErrorFrom(err, "API_ERROR")
 				}
 				if team != nil {
 					teamID = team.ID
@@ -220,11 +326,12 @@ Examples:
 			}
 
 			// Ensure we have at least a project or team
-			if projectID == "" && teamID == "" {
+			if projectID == "" && teamID == "" && !attached {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
-						"Either --project or --team is required",
-						"Documents must be associated with a project or team",
+						"MISSING_ASSOCIATION",
+						"One of --project, --team, --issue or --initiative is required",
+						"Documents must be associated with a project, team, issue or initiative",
 						"linear document create --title \"My Doc\" --team ENG",
 						"linear document create --title \"My Doc\" --project <project-id>",
 					)
@@ -232,30 +339,33 @@ Examples:
 				}
 				return output.ErrorWithHint(
 					"MISSING_ASSOCIATION",
-					"Either --project or --team is required",
-					"Documents must be associated with a project or team",
+					"One of --project, --team, --issue or --initiative is required",
+					"Documents must be associated with a project, team, issue or initiative",
 					"linear document create --title \"My Doc\" --team ENG",
 					"linear document create --title \"My Doc\" --project <project-id>",
 				)
 			}
 
 			input := api.DocumentCreateInput{
-				Title:     title,
-				Content:   content,
-				ProjectID: projectID,
-				TeamID:    teamID,
-				Icon:      icon,
-				Color:     color,
+				Title:        title,
+				Content:      content,
+				ProjectID:    projectID,
+				TeamID:       teamID,
+				IssueID:      issueID,
+				InitiativeID: initiativeID,
+				Icon:         icon,
+				Color:        color,
 			}
 
 			document, err := client.CreateDocument(ctx, input)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
+			recordDocument(document, history.ActionCreated)
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Document created: %s", document.Title))
@@ -275,9 +385,13 @@ Examples:
 
 	cmd.Flags().StringVarP(&title, "title", "t", "", "Document title (required)")
 	cmd.Flags().StringVarP(&content, "content", "c", "", "Document content (markdown)")
+	cmd.Flags().String("content-file", "", "Read document content from a markdown file (- for stdin)")
+	cmd.Flags().Bool("editor", false, "Write the content in $EDITOR")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Project ID to attach document to")
 	cmd.Flags().StringVar(&teamKey, "team", "", "Team key (e.g., ENG)")
-	cmd.Flags().StringVarP(&icon, "icon", "i", "", "Document icon")
+	cmd.Flags().StringVar(&issueID, "issue", "", "Issue to attach document to (ID or identifier)")
+	cmd.Flags().StringVar(&initiativeID, "initiative", "", "Initiative ID to attach document to")
+	cmd.Flags().StringVarP(&icon, "icon", "i", "", "Document icon: a named icon or emoji (see 'linear icons search')")
 	cmd.Flags().StringVar(&color, "color", "", "Document color (#RRGGBB)")
 
 	return cmd
@@ -300,11 +414,32 @@ func newDocumentUpdateCmd() *cobra.Command {
 Examples:
   linear document update abc123 --title "New Title"
   linear document update abc123 --content "Updated content..."
+  linear document update abc123 --content-file spec.md
+  linear document update abc123 --editor
   linear document update abc123 --project xyz789`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			documentID := args[0]
 
+			if ok, err := applyContentFile(cmd, "content", "content-file"); !ok {
+				return err
+			}
+			current := func() (string, error) {
+				ctx := cmd.Context()
+				client, err := api.NewClient(ctx)
+				if err != nil {
+					return "", err
+				}
+				document, err := client.GetDocument(ctx, documentID)
+				if err != nil || document == nil {
+					return "", err
+				}
+				return document.Content, nil
+			}
+			if ok, err := applyEditor(cmd, "content", current); !ok {
+				return err
+			}
+
 			// Check if at least one field is being updated
 			if !cmd.Flags().Changed("title") &&
 				!cmd.Flags().Changed("content") &&
@@ -312,18 +447,22 @@ Examples:
 				!cmd.Flags().Changed("icon") &&
 				!cmd.Flags().Changed("color") {
 				if IsHumanOutput() {
-					output.ErrorHuman("At least one field must be specified to update")
+					output.ErrorHuman("MISSING_FIELDS", "At least one field must be specified to update")
 					return nil
 				}
 				return output.Error("MISSING_FIELDS", "At least one field must be specified to update")
 			}
 
-			ctx := context.Background()
+			if err := normalizeIconFlag(&icon); err != nil {
+				return iconError(err)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -350,11 +489,12 @@ Examples:
 			document, err := client.UpdateDocument(ctx, documentID, input)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
+			recordDocument(document, history.ActionEdited)
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Document updated: %s", document.Title))
@@ -372,13 +512,96 @@ Examples:
 
 	cmd.Flags().StringVarP(&title, "title", "t", "", "Document title")
 	cmd.Flags().StringVarP(&content, "content", "c", "", "Document content (markdown)")
+	cmd.Flags().String("content-file", "", "Read document content from a markdown file (- for stdin)")
+	cmd.Flags().Bool("editor", false, "Edit the current content in $EDITOR")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Project ID to attach document to")
-	cmd.Flags().StringVarP(&icon, "icon", "i", "", "Document icon")
+	cmd.Flags().StringVarP(&icon, "icon", "i", "", "Document icon: a named icon or emoji (see 'linear icons search')")
 	cmd.Flags().StringVar(&color, "color", "", "Document color (#RRGGBB)")
 
 	return cmd
 }
 
+func newDocumentExportCmd() *cobra.Command {
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "export <document-id>",
+		Short: "Export a document's markdown",
+		Long: `Write a document's markdown content to a file, or to stdout without
+--output.
+
+Examples:
+  linear document export abc123 --output spec.md
+  linear document export abc123 > spec.md`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			documentID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			document, err := client.GetDocument(ctx, documentID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if document == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Document '%s' not found", documentID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Document '%s' not found", documentID))
+			}
+
+			content := document.Content
+			if content != "" && !strings.HasSuffix(content, "\n") {
+				content += "\n"
+			}
+
+			// Exports to stdout are the output; only report on file exports
+			if outputPath == "" {
+				fmt.Print(content)
+				return nil
+			}
+
+			if err := os.WriteFile(outputPath, []byte(content), 0o644); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("FILE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("FILE_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Exported %s to %s", document.Title, outputPath))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":    true,
+				"operation":  "export",
+				"documentId": document.ID,
+				"title":      document.Title,
+				"path":       outputPath,
+				"bytes":      len(content),
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write the markdown to a file instead of stdout")
+
+	return cmd
+}
+
 func newDocumentDeleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete <document-id>",
@@ -390,12 +613,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			documentID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -404,10 +627,10 @@ Examples:
 			err = client.DeleteDocument(ctx, documentID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -438,12 +661,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			documentID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -452,10 +675,10 @@ Examples:
 			err = client.RestoreDocument(ctx, documentID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -489,12 +712,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			query := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -503,10 +726,10 @@ Examples:
 			results, err := client.SearchDocuments(ctx, query, limit)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -526,41 +749,13 @@ Examples:
 
 // Human output formatters
 
-func printDocumentsHuman(documents *api.DocumentsResponse) {
+func printDocumentsHuman(documents *api.DocumentsResponse, columns []*listColumn[api.DocumentListItem]) {
 	if len(documents.Documents) == 0 {
 		output.HumanLn("No documents found")
 		return
 	}
 
-	headers := []string{"TITLE", "PROJECT", "CREATOR", "UPDATED", "ID"}
-	rows := make([][]string, len(documents.Documents))
-
-	for i, d := range documents.Documents {
-		projectName := "-"
-		if d.Project != nil {
-			projectName = d.Project.Name
-		}
-
-		creatorName := "-"
-		if d.Creator != nil {
-			creatorName = d.Creator.DisplayName
-		}
-
-		updatedAt := d.UpdatedAt
-		if t, err := time.Parse(time.RFC3339, d.UpdatedAt); err == nil {
-			updatedAt = display.TimeAgo(t)
-		}
-
-		rows[i] = []string{
-			display.Truncate(d.Title, 40),
-			display.Truncate(projectName, 20),
-			creatorName,
-			updatedAt,
-			output.Muted("%s", d.ID),
-		}
-	}
-
-	output.TableWithColors(headers, rows)
+	printColumnTable(columns, documents.Documents)
 	output.HumanLn("\n%d documents", documents.Count)
 }
 
@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// docLinkTarget is an entity documents can be attached to
+type docLinkTarget struct {
+	// kind names the entity in messages and JSON keys; name is its
+	// capitalized form
+	kind    string
+	name    string
+	example string
+	get     func(c *api.Client, ctx context.Context, id string) (*api.LinkedDocuments, error)
+	set     func(c *api.Client, ctx context.Context, documentID, parentID string) error
+}
+
+var (
+	issueDocTarget = docLinkTarget{
+		kind:    "issue",
+		name:    "Issue",
+		example: "ENG-123",
+		get:     (*api.Client).GetIssueDocuments,
+		set:     (*api.Client).SetDocumentIssue,
+	}
+	initiativeDocTarget = docLinkTarget{
+		kind:    "initiative",
+		name:    "Initiative",
+		example: "abc123",
+		get:     (*api.Client).GetInitiativeDocuments,
+		set:     (*api.Client).SetDocumentInitiative,
+	}
+)
+
+// idKey is the JSON key for the target's ID, such as "issueId"
+func (t docLinkTarget) idKey() string {
+	return t.kind + "Id"
+}
+
+// newDocLinkCmd creates the doc command group that attaches documents to
+// issues or initiatives
+func newDocLinkCmd(target docLinkTarget) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "doc",
+		Aliases: []string{"docs"},
+		Short:   fmt.Sprintf("Attach documents to an %s", target.kind),
+		Long: fmt.Sprintf(`Attach existing documents to an %[1]s, list them, or detach them.
+
+A document has a single parent, so attaching it to an %[1]s moves it there.
+To write a new document straight onto an %[1]s, use
+'linear document create --%[1]s <id>'.`, target.kind),
+	}
+
+	cmd.AddCommand(newDocLinkAddCmd(target))
+	cmd.AddCommand(newDocLinkListCmd(target))
+	cmd.AddCommand(newDocLinkRemoveCmd(target))
+
+	return cmd
+}
+
+func newDocLinkAddCmd(target docLinkTarget) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("add <%s-id> <document-id>...", target.kind),
+		Short: fmt.Sprintf("Attach documents to an %s", target.kind),
+		Long: fmt.Sprintf(`Attach one or more documents, by ID or slug, to an %s.
+
+Examples:
+  linear %s doc add %s 9f1c2d3e4b5a`, target.kind, target.kind, target.example),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			parent, err := getDocLinkParent(ctx, client, target, args[0])
+			if err != nil {
+				return docLinkError(err)
+			}
+
+			documents := []*api.Document{}
+			for _, id := range args[1:] {
+				document, err := client.GetDocument(ctx, id)
+				if err != nil {
+					return docLinkError(err)
+				}
+				if document == nil {
+					return docLinkError(docLinkNotFound(fmt.Sprintf("Document '%s' not found", id)))
+				}
+				documents = append(documents, document)
+			}
+
+			ids := make([]string, len(documents))
+			for i, document := range documents {
+				if err := target.set(client, ctx, document.ID, parent.ID); err != nil {
+					return docLinkError(err)
+				}
+				ids[i] = document.ID
+			}
+
+			if IsHumanOutput() {
+				for _, document := range documents {
+					output.SuccessHuman(fmt.Sprintf("Attached %s to %s", document.Title, parent.Name))
+				}
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":      true,
+				"operation":    "doc-add",
+				target.idKey(): parent.ID,
+				"documentIds":  ids,
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newDocLinkListCmd(target docLinkTarget) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("list <%s-id>", target.kind),
+		Aliases: []string{"ls"},
+		Short:   fmt.Sprintf("List the documents attached to an %s", target.kind),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			parent, err := getDocLinkParent(ctx, client, target, args[0])
+			if err != nil {
+				return docLinkError(err)
+			}
+
+			if IsHumanOutput() {
+				printDocumentsHuman(&api.DocumentsResponse{Documents: parent.Documents, Count: len(parent.Documents)}, defaultColumns(documentColumns, documentDefaultColumns))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				target.idKey(): parent.ID,
+				"documents":    parent.Documents,
+				"count":        len(parent.Documents),
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newDocLinkRemoveCmd(target docLinkTarget) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("remove <%s-id> <document-id>...", target.kind),
+		Aliases: []string{"rm"},
+		Short:   fmt.Sprintf("Detach documents from an %s", target.kind),
+		Long: fmt.Sprintf(`Detach documents from an %s. The documents are kept; only the link
+is removed.
+
+Examples:
+  linear %s doc remove %s 9f1c2d3e4b5a`, target.kind, target.kind, target.example),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			parent, err := getDocLinkParent(ctx, client, target, args[0])
+			if err != nil {
+				return docLinkError(err)
+			}
+
+			// Only documents attached to this parent may be detached, so a
+			// typo cannot unlink a document from somewhere else
+			attached := []api.DocumentListItem{}
+			for _, id := range args[1:] {
+				found := false
+				for _, d := range parent.Documents {
+					if d.ID == id || d.SlugID == id {
+						attached = append(attached, d)
+						found = true
+						break
+					}
+				}
+				if !found {
+					return docLinkError(docLinkNotFound(fmt.Sprintf("Document '%s' is not attached to %s", id, parent.Name)))
+				}
+			}
+
+			ids := make([]string, len(attached))
+			for i, d := range attached {
+				if err := target.set(client, ctx, d.ID, ""); err != nil {
+					return docLinkError(err)
+				}
+				ids[i] = d.ID
+			}
+
+			if IsHumanOutput() {
+				for _, d := range attached {
+					output.SuccessHuman(fmt.Sprintf("Detached %s from %s", d.Title, parent.Name))
+				}
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":      true,
+				"operation":    "doc-remove",
+				target.idKey(): parent.ID,
+				"documentIds":  ids,
+			})
+		},
+	}
+
+	return cmd
+}
+
+// docLinkNotFound is a NOT_FOUND error for doc commands
+type docLinkNotFound string
+
+func (e docLinkNotFound) Error() string     { return string(e) }
+func (e docLinkNotFound) ErrorCode() string { return "NOT_FOUND" }
+
+// getDocLinkParent fetches the issue or initiative with its documents
+func getDocLinkParent(ctx context.Context, client *api.Client, target docLinkTarget, id string) (*api.LinkedDocuments, error) {
+	parent, err := target.get(client, ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		return nil, docLinkNotFound(fmt.Sprintf("%s '%s' not found", target.name, id))
+	}
+	return parent, nil
+}
+
+// docLinkError reports an error from a doc command
+func docLinkError(err error) error {
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil
+	}
+	return output.ErrorFrom(err, "API_ERROR")
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// estimateScale is one of Linear's issue estimation types
+type estimateScale struct {
+	name     string
+	values   []float64
+	extended []float64
+	// sizes names the values of the T-shirt scale
+	sizes []string
+}
+
+var estimateScales = map[string]estimateScale{
+	"exactCount":  {name: "exact count", values: []float64{1, 2, 3, 4, 5}, extended: []float64{6, 7}},
+	"linear":      {name: "linear", values: []float64{1, 2, 3, 4, 5}, extended: []float64{6, 7}},
+	"fibonacci":   {name: "Fibonacci", values: []float64{1, 2, 3, 5, 8}, extended: []float64{13, 21}},
+	"exponential": {name: "exponential", values: []float64{1, 2, 4, 8, 16}, extended: []float64{32, 64}},
+	"tShirt": {
+		name:     "T-shirt sizes",
+		values:   []float64{1, 2, 3, 5, 8},
+		extended: []float64{13, 21},
+		sizes:    []string{"XS", "S", "M", "L", "XL", "XXL", "XXXL"},
+	},
+}
+
+// teamEstimation returns a team's estimation settings, cached for 24 hours
+func teamEstimation(ctx context.Context, client *api.Client, teamID string) (*api.TeamEstimation, error) {
+	cacheManager, _ := cache.NewManager()
+	cacheKey := cache.TeamKey("estimation", teamID)
+
+	if cacheManager != nil {
+		if cached, _ := cache.Read[api.TeamEstimation](cacheManager, cacheKey); cached != nil {
+			return cached, nil
+		}
+	}
+
+	estimation, err := client.GetTeamEstimation(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if cacheManager != nil {
+		cache.Write(cacheManager, cacheKey, *estimation)
+	}
+	return estimation, nil
+}
+
+// EstimateError reports an estimate that is not on the team's scale
+type EstimateError struct {
+	Value string
+	// Allowed describes the team's scale, or is empty when the team does
+	// not use estimates
+	Allowed string
+}
+
+func (e *EstimateError) Error() string {
+	if e.Allowed == "" {
+		return fmt.Sprintf("Invalid estimate '%s': the team does not use estimates", e.Value)
+	}
+	return fmt.Sprintf("Invalid estimate '%s': team uses %s", e.Value, e.Allowed)
+}
+
+// Hint explains how to change the team's scale
+func (e *EstimateError) Hint() string {
+	return "Estimate scales are set in the team's settings; see 'linear team view <key>'"
+}
+
+// allowedEstimates lists the values accepted for a team's estimation
+// settings, with their T-shirt sizes when the scale uses them
+func allowedEstimates(estimation *api.TeamEstimation) ([]float64, []string) {
+	scale, ok := estimateScales[estimation.Type]
+	if !ok {
+		return nil, nil
+	}
+
+	values := append([]float64{}, scale.values...)
+	if estimation.Extended {
+		values = append(values, scale.extended...)
+	}
+	var sizes []string
+	if scale.sizes != nil {
+		sizes = scale.sizes[:len(values)]
+	}
+	if estimation.AllowZero {
+		values = append([]float64{0}, values...)
+		if sizes != nil {
+			sizes = append([]string{"0"}, sizes...)
+		}
+	}
+	return values, sizes
+}
+
+// describeEstimates renders a team's scale, e.g. "Fibonacci: 1,2,3,5,8"
+func describeEstimates(estimation *api.TeamEstimation) string {
+	values, sizes := allowedEstimates(estimation)
+	if values == nil {
+		return ""
+	}
+
+	items := make([]string, len(values))
+	for i, v := range values {
+		items[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		if sizes != nil && sizes[i] != items[i] {
+			items[i] = sizes[i] + "=" + items[i]
+		}
+	}
+	return estimateScales[estimation.Type].name + ": " + strings.Join(items, ",")
+}
+
+// parseEstimate parses an --estimate value, a number or a T-shirt size,
+// and checks it is on the team's scale
+func parseEstimate(s string, estimation *api.TeamEstimation) (float64, error) {
+	s = strings.TrimSpace(s)
+	values, sizes := allowedEstimates(estimation)
+	if values == nil {
+		return 0, &EstimateError{Value: s}
+	}
+
+	for i, size := range sizes {
+		if strings.EqualFold(size, s) {
+			return values[i], nil
+		}
+	}
+	if v, err := strconv.ParseFloat(s, 64); err == nil {
+		for _, allowed := range values {
+			if v == allowed {
+				return v, nil
+			}
+		}
+	}
+	return 0, &EstimateError{Value: s, Allowed: describeEstimates(estimation)}
+}
+
+// resolveEstimate parses an --estimate value against a team's settings
+func resolveEstimate(ctx context.Context, client *api.Client, teamID, value string) (float64, error) {
+	estimation, err := teamEstimation(ctx, client, teamID)
+	if err != nil {
+		return 0, err
+	}
+	return parseEstimate(value, estimation)
+}
+
+// estimateError reports an estimate resolution failure
+func estimateError(err error) error {
+	var estimateErr *EstimateError
+	if errors.As(err, &estimateErr) {
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("INVALID_ESTIMATE", estimateErr.Error(), estimateErr.Hint())
+			return nil
+		}
+		return output.ErrorWithHint("INVALID_ESTIMATE", estimateErr.Error(), estimateErr.Hint())
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil
+	}
+	return output.ErrorFrom(err, "API_ERROR")
+}
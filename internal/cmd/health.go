@@ -0,0 +1,330 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/auth"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// Outcomes of a health check
+const (
+	checkPass = "pass"
+	checkWarn = "warn"
+	checkFail = "fail"
+	checkSkip = "skip"
+)
+
+const (
+	// healthSlowLatency is the API round trip above which the api check warns
+	healthSlowLatency = 2 * time.Second
+	// healthTokenWarning is how long before a token expires the token
+	// check starts warning
+	healthTokenWarning = 7 * 24 * time.Hour
+	// healthLowBudget is the share of the rate limit budget below which the
+	// rate_limit check warns
+	healthLowBudget = 0.1
+)
+
+// HealthCheck is the outcome of one check of linear status
+type HealthCheck struct {
+	// Name is auth, token, api, organization, team, rate_limit or cache
+	Name string `json:"name"`
+	// Status is pass, warn, fail or skip
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	// Code is the error code of a failed check
+	Code string `json:"code,omitempty"`
+	Hint string `json:"hint,omitempty"`
+}
+
+// HealthResponse is the response for the status command
+type HealthResponse struct {
+	// Healthy is false when any check failed; warnings don't count
+	Healthy      bool              `json:"healthy"`
+	Checks       []HealthCheck     `json:"checks"`
+	User         *api.Viewer       `json:"user,omitempty"`
+	Organization *api.Organization `json:"organization,omitempty"`
+	Team         string            `json:"team,omitempty"`
+	LatencyMs    int64             `json:"latencyMs,omitempty"`
+	RateLimit    *api.RateLimit    `json:"rateLimit,omitempty"`
+}
+
+// add records a check, remembering the first failure's exit status
+func (r *HealthResponse) add(check HealthCheck) {
+	if check.Status == checkFail {
+		if check.Code == "" {
+			check.Code = "HEALTH_CHECK_FAILED"
+		}
+		output.Fail(check.Code)
+		r.Healthy = false
+	}
+	r.Checks = append(r.Checks, check)
+}
+
+// skip records checks that can't run because an earlier one failed
+func (r *HealthResponse) skip(reason string, names ...string) {
+	for _, name := range names {
+		r.add(HealthCheck{Name: name, Status: checkSkip, Message: reason})
+	}
+}
+
+// runHealthCheck runs every check of linear status. Checks after a failed
+// one that they depend on are skipped rather than failed again.
+func runHealthCheck(ctx context.Context) *HealthResponse {
+	resp := &HealthResponse{Healthy: true, Checks: []HealthCheck{}}
+
+	authManager := auth.NewManager()
+	status, err := authManager.GetStatus(ctx)
+	switch {
+	case err != nil:
+		resp.add(HealthCheck{Name: "auth", Status: checkFail, Message: err.Error(), Code: "AUTH_ERROR"})
+	case !status.Authenticated:
+		resp.add(HealthCheck{
+			Name:    "auth",
+			Status:  checkFail,
+			Message: "Not authenticated",
+			Code:    "NOT_AUTHENTICATED",
+			Hint:    "Run 'linear auth login' or set LINEAR_API_KEY",
+		})
+	default:
+		resp.add(HealthCheck{Name: "auth", Status: checkPass, Message: fmt.Sprintf("%s from %s", status.Method, status.Source)})
+	}
+	if !resp.Healthy {
+		resp.skip("not authenticated", "token", "api", "organization", "team", "rate_limit")
+		resp.add(cacheHealth())
+		return resp
+	}
+
+	client, err := api.NewClient(ctx)
+	if err != nil {
+		resp.add(HealthCheck{Name: "token", Status: checkFail, Message: err.Error(), Code: "AUTH_ERROR", Hint: "Run 'linear auth login' to authenticate again"})
+		resp.skip("no usable token", "api", "organization", "team", "rate_limit")
+		resp.add(cacheHealth())
+		return resp
+	}
+
+	// The viewer query is timed fresh so the cache can't hide a slow or
+	// unreachable API; fetching it also renews an expired stored token
+	start := time.Now()
+	viewer, viewerErr := client.GetViewer(api.Fresh(ctx))
+	latency := time.Since(start)
+
+	// Read the expiry after the request, which may have renewed the token
+	if renewed, err := authManager.GetStatus(ctx); err == nil {
+		status = renewed
+	}
+	resp.add(tokenHealth(status))
+
+	if viewerErr != nil {
+		resp.add(HealthCheck{Name: "api", Status: checkFail, Message: viewerErr.Error(), Code: output.CodeFrom(viewerErr, "API_ERROR")})
+		resp.skip("API unreachable", "organization", "team", "rate_limit")
+		resp.add(cacheHealth())
+		return resp
+	}
+
+	resp.LatencyMs = latency.Milliseconds()
+	apiCheck := HealthCheck{Name: "api", Status: checkPass, Message: fmt.Sprintf("Reachable in %dms", resp.LatencyMs)}
+	if latency > healthSlowLatency {
+		apiCheck.Status = checkWarn
+		apiCheck.Message = fmt.Sprintf("Slow: %dms round trip", resp.LatencyMs)
+	}
+	if endpoint := api.Endpoint(); endpoint != api.LinearAPIEndpoint {
+		apiCheck.Message += " at " + endpoint
+	}
+	resp.add(apiCheck)
+
+	resp.User, resp.Organization = &viewer.Viewer, &viewer.Organization
+	resp.add(HealthCheck{
+		Name:    "organization",
+		Status:  checkPass,
+		Message: fmt.Sprintf("%s (%s) as %s", viewer.Organization.Name, viewer.Organization.UrlKey, viewer.Viewer.DisplayName),
+	})
+
+	resp.add(teamHealth(ctx, client, resp))
+	resp.RateLimit = client.RateLimit()
+	resp.add(rateLimitHealth(resp.RateLimit))
+	resp.add(cacheHealth())
+	return resp
+}
+
+// tokenHealth checks how long the token has left. API keys don't expire.
+func tokenHealth(status *auth.AuthStatus) HealthCheck {
+	check := HealthCheck{Name: "token", Status: checkPass}
+	if status.ExpiresAt == nil {
+		check.Message = "Does not expire"
+		return check
+	}
+	left := time.Until(*status.ExpiresAt)
+	expires := status.ExpiresAt.UTC().Format(time.RFC3339)
+	switch {
+	case left <= 0:
+		check.Status, check.Code = checkFail, "AUTH_ERROR"
+		check.Message = fmt.Sprintf("Expired at %s", expires)
+		check.Hint = "Run 'linear auth login' to authenticate again"
+	case left < healthTokenWarning:
+		check.Status = checkWarn
+		check.Message = fmt.Sprintf("Expires in %s (%s)", left.Round(time.Minute), expires)
+	default:
+		check.Message = fmt.Sprintf("Expires %s", expires)
+	}
+	return check
+}
+
+// teamHealth checks that the default team, if any, exists
+func teamHealth(ctx context.Context, client *api.Client, resp *HealthResponse) HealthCheck {
+	key := GetTeamID()
+	if key == "" {
+		return HealthCheck{
+			Name:    "team",
+			Status:  checkWarn,
+			Message: "No default team configured",
+			Hint:    "Set one with 'linear config set team_key <KEY>' so --team can be left out",
+		}
+	}
+	teams, err := client.GetTeams(api.Fresh(ctx))
+	if err != nil {
+		return HealthCheck{Name: "team", Status: checkFail, Message: err.Error(), Code: output.CodeFrom(err, "API_ERROR")}
+	}
+	team := findTeam(teams.Teams, key)
+	if team == nil {
+		return HealthCheck{
+			Name:    "team",
+			Status:  checkFail,
+			Message: fmt.Sprintf("Default team '%s' not found", key),
+			Code:    "TEAM_NOT_FOUND",
+			Hint:    "List teams with 'linear team list' and set one with 'linear config set team_key <KEY>'",
+		}
+	}
+	resp.Team = team.Key
+	return HealthCheck{Name: "team", Status: checkPass, Message: fmt.Sprintf("%s (%s)", team.Key, team.Name)}
+}
+
+// rateLimitHealth checks the request and complexity budget left
+func rateLimitHealth(rl *api.RateLimit) HealthCheck {
+	check := HealthCheck{Name: "rate_limit", Status: checkPass}
+	if rl == nil {
+		check.Status = checkWarn
+		check.Message = "The API did not report rate limit headers"
+		return check
+	}
+	check.Message = fmt.Sprintf("%d/%d requests, %d/%d complexity left",
+		rl.RequestsRemaining, rl.RequestsLimit, rl.ComplexityRemaining, rl.ComplexityLimit)
+
+	exhausted := (rl.RequestsLimit > 0 && rl.RequestsRemaining <= 0) ||
+		(rl.ComplexityLimit > 0 && rl.ComplexityRemaining <= 0)
+	low := (rl.RequestsLimit > 0 && float64(rl.RequestsRemaining) < healthLowBudget*float64(rl.RequestsLimit)) ||
+		(rl.ComplexityLimit > 0 && float64(rl.ComplexityRemaining) < healthLowBudget*float64(rl.ComplexityLimit))
+	reset := rl.RequestsReset
+	if rl.ComplexityReset.After(reset) {
+		reset = rl.ComplexityReset
+	}
+	switch {
+	case exhausted:
+		check.Status, check.Code = checkFail, "RATE_LIMITED"
+	case low:
+		check.Status = checkWarn
+	}
+	if check.Status != checkPass && !reset.IsZero() {
+		check.Hint = "The budget resets in " + time.Until(reset).Round(time.Second).String()
+	}
+	return check
+}
+
+// cacheHealth checks that the local cache is usable and how fresh the
+// offline data from 'linear sync' is. It needs no credentials.
+func cacheHealth() HealthCheck {
+	check := HealthCheck{Name: "cache", Status: checkPass}
+	m, err := cache.NewManager()
+	if err != nil {
+		check.Status, check.Message = checkWarn, fmt.Sprintf("Cache unavailable: %v", err)
+		return check
+	}
+	entries, err := m.List()
+	if err != nil {
+		check.Status, check.Message = checkWarn, fmt.Sprintf("Cache unreadable: %v", err)
+		return check
+	}
+	expired := 0
+	for _, e := range entries {
+		if e.Expired {
+			expired++
+		}
+	}
+	check.Message = fmt.Sprintf("%d entries, %d expired", len(entries), expired)
+
+	_, info, err := readOffline[SyncInfo](cache.WorkspaceKey("sync"))
+	switch {
+	case errors.Is(err, errNotSynced):
+		check.Message += "; never synced for offline use"
+	case err != nil:
+		check.Status = checkWarn
+		check.Message += fmt.Sprintf("; offline data unreadable: %v", err)
+	case info.Stale:
+		check.Status = checkWarn
+		check.Message += fmt.Sprintf("; offline data synced %s", info.Age)
+		check.Hint = "Run 'linear sync' to refresh offline data"
+	default:
+		check.Message += fmt.Sprintf("; offline data synced %s", info.Age)
+	}
+	return check
+}
+
+func printHealthHuman(resp *HealthResponse) {
+	for _, check := range resp.Checks {
+		var mark string
+		switch check.Status {
+		case checkPass:
+			mark = output.Green("✓")
+		case checkWarn:
+			mark = output.Yellow("!")
+		case checkFail:
+			mark = output.Red("✗")
+		default:
+			mark = output.Muted("-")
+		}
+		message := check.Message
+		if check.Status == checkSkip {
+			message = output.Muted("skipped: %s", message)
+		}
+		output.HumanLn("%s %-13s %s", mark, check.Name, message)
+		if check.Hint != "" {
+			output.HumanLn("  %-13s %s", "", output.Muted("%s", check.Hint))
+		}
+	}
+
+	output.HumanLn("")
+	failed, warned := 0, 0
+	for _, check := range resp.Checks {
+		switch check.Status {
+		case checkFail:
+			failed++
+		case checkWarn:
+			warned++
+		}
+	}
+	switch {
+	case failed > 0:
+		output.HumanLn("%s", output.Red("%d check(s) failed", failed))
+	case warned > 0:
+		output.HumanLn("%s", output.Yellow("Healthy, with %d warning(s)", warned))
+	default:
+		output.HumanLn("%s", output.Green("Healthy"))
+	}
+}
+
+// runStatus is the RunE of linear status without a subcommand
+func runStatus(cmd *cobra.Command, args []string) error {
+	resp := runHealthCheck(cmd.Context())
+	if IsHumanOutput() {
+		printHealthHuman(resp)
+		return nil
+	}
+	return output.JSON(resp)
+}
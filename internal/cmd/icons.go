@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/icons"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// IconsSearchResponse is the response for the icons search command
+type IconsSearchResponse struct {
+	Query   string       `json:"query"`
+	Icons   []icons.Icon `json:"icons"`
+	Count   int          `json:"count"`
+	HasMore bool         `json:"hasMore"`
+}
+
+// NewIconsCmd creates the icons command
+func NewIconsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "icons",
+		Short: "Find icons accepted by --icon",
+		Long: `Find the icons Linear accepts for projects and documents.
+
+--icon takes a named icon from Linear's icon picker, such as Rocket, or an
+emoji, given as the character or as a :shortcode: like :rocket:. Other
+values are rejected before anything is sent.
+
+Examples:
+  linear icons search rocket
+  linear icons search security --kind emoji
+  linear project update <project-id> --icon Rocket`,
+	}
+
+	cmd.AddCommand(newIconsSearchCmd())
+
+	return cmd
+}
+
+func newIconsSearchCmd() *cobra.Command {
+	var (
+		kind  string
+		limit int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "search [term]",
+		Short: "Search icons and emoji by name or keyword",
+		Long: `Search the named icons and emoji shortcodes accepted by --icon, matching
+names and keywords. Without a term, every icon is listed.
+
+Examples:
+  linear icons search rocket
+  linear icons search bug --kind icon
+  linear icons search --kind emoji --limit 0`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind = strings.ToLower(kind)
+			if kind != "" && kind != icons.KindIcon && kind != icons.KindEmoji {
+				msg := fmt.Sprintf("Invalid kind '%s' (use %s or %s)", kind, icons.KindIcon, icons.KindEmoji)
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+
+			query := ""
+			if len(args) == 1 {
+				query = args[0]
+			}
+			found := icons.Search(query, kind)
+			resp := IconsSearchResponse{Query: query, Icons: found, Count: len(found)}
+			if limit > 0 && len(found) > limit {
+				resp.Icons, resp.Count, resp.HasMore = found[:limit], limit, true
+			}
+
+			if IsHumanOutput() {
+				printIconsHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVar(&kind, "kind", "", "Only list named icons (icon) or emoji (emoji)")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 20, "Maximum results (0 for all)")
+
+	return cmd
+}
+
+func printIconsHuman(resp IconsSearchResponse) {
+	if len(resp.Icons) == 0 {
+		output.HumanLn("No icons match %q", resp.Query)
+		output.HumanLn("%s", output.Muted("An emoji character is accepted as an icon too"))
+		return
+	}
+	rows := make([][]string, len(resp.Icons))
+	for i, icon := range resp.Icons {
+		rows[i] = []string{icon.Name, icon.Kind, dashIfEmpty(icon.Emoji), strings.Join(icon.Keywords, ", ")}
+	}
+	output.TableWithColors([]string{"NAME", "KIND", "EMOJI", "KEYWORDS"}, rows)
+	if resp.HasMore {
+		output.HumanLn("\nShowing first %d results. Increase --limit to see more.", resp.Count)
+	}
+}
+
+// normalizeIconFlag checks an --icon value, replacing it with the value to
+// send, so an unknown icon fails here rather than at the API
+func normalizeIconFlag(icon *string) error {
+	value, err := icons.Normalize(*icon)
+	if err != nil {
+		return err
+	}
+	*icon = value
+	return nil
+}
+
+// iconError reports an invalid --icon value
+func iconError(err error) error {
+	hint := ""
+	var invalid *icons.InvalidError
+	if errors.As(err, &invalid) {
+		hint = invalid.Hint()
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanWithHint("INVALID_ICON", err.Error(), hint)
+		return nil
+	}
+	return output.ErrorWithHint("INVALID_ICON", err.Error(), hint)
+}
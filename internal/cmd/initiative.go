@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"time"
 
@@ -22,7 +21,10 @@ func NewInitiativeCmd() *cobra.Command {
 Examples:
   linear initiative list
   linear initiative view <initiative-id>
-  linear initiative create --name "Q1 Goals"`,
+  linear initiative create --name "Q1 Goals"
+  linear initiative set-parent <initiative-id> <parent-id>
+  linear initiative roadmap
+  linear initiative update-status create <initiative-id> --body "On track" --health onTrack`,
 	}
 
 	cmd.AddCommand(newInitiativeListCmd())
@@ -33,6 +35,10 @@ Examples:
 	cmd.AddCommand(newInitiativeRestoreCmd())
 	cmd.AddCommand(newInitiativeProjectAddCmd())
 	cmd.AddCommand(newInitiativeProjectRemoveCmd())
+	cmd.AddCommand(newDocLinkCmd(initiativeDocTarget))
+	cmd.AddCommand(newInitiativeSetParentCmd())
+	cmd.AddCommand(newInitiativeRoadmapCmd())
+	cmd.AddCommand(newInitiativeUpdateStatusCmd())
 
 	return cmd
 }
@@ -42,6 +48,8 @@ func newInitiativeListCmd() *cobra.Command {
 		status  string
 		ownerID string
 		limit   int
+		all     bool
+		after   string
 	)
 
 	cmd := &cobra.Command{
@@ -54,30 +62,44 @@ Status values: Planned, Active, Completed
 Examples:
   linear initiative list
   linear initiative list --status Active
-  linear initiative list --limit 20`,
+  linear initiative list --limit 20
+  linear initiative list --all`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
-			initiatives, err := client.GetInitiatives(ctx, status, ownerID, limit)
+			items, pageInfo, err := collectPages(after, all, func(after string) ([]api.InitiativeListItem, *api.PageInfo, error) {
+				page, err := client.GetInitiatives(ctx, status, ownerID, limit, after)
+				if err != nil {
+					return nil, nil, err
+				}
+				return page.Initiatives, page.PageInfo, nil
+			})
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			initiatives := &api.InitiativesResponse{
+				Initiatives: items,
+				Count:       len(items),
+				PageInfo:    pageInfo,
 			}
 
 			if IsHumanOutput() {
 				printInitiativesHuman(initiatives)
+				printPageHintHuman(initiatives.PageInfo)
 			} else {
 				output.JSON(initiatives)
 			}
@@ -88,7 +110,9 @@ Examples:
 
 	cmd.Flags().StringVarP(&status, "status", "s", "", "Filter by status (Planned, Active, Completed)")
 	cmd.Flags().StringVarP(&ownerID, "owner", "o", "", "Filter by owner ID")
-	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum initiatives to return")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum initiatives to return (page size with --all)")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch every page of results")
+	cmd.Flags().StringVar(&after, "after", "", "Start after this cursor (from pageInfo.endCursor)")
 
 	return cmd
 }
@@ -97,19 +121,20 @@ func newInitiativeViewCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "view <initiative-id>",
 		Short: "View initiative details",
-		Long: `View detailed information about an initiative.
+		Long: `View detailed information about an initiative, including its parent
+initiative and sub-initiatives.
 
 Examples:
   linear initiative view abc123`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -118,15 +143,15 @@ Examples:
 			initiative, err := client.GetInitiative(ctx, initiativeID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if initiative == nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Initiative '%s' not found", initiativeID))
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Initiative '%s' not found", initiativeID))
 					return nil
 				}
 				return output.Error("NOT_FOUND", fmt.Sprintf("Initiative '%s' not found", initiativeID))
@@ -169,18 +194,23 @@ Examples:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if name == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Initiative name is required. Use --name flag.")
+					output.ErrorHuman("MISSING_NAME", "Initiative name is required. Use --name flag.")
 					return nil
 				}
 				return output.Error("MISSING_NAME", "Initiative name is required")
 			}
 
-			ctx := context.Background()
+			resolved, err := resolveDateFlags(map[string]*string{"target-date": &targetDate})
+			if err != nil {
+				return dateError(err)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -198,22 +228,25 @@ Examples:
 			initiative, err := client.CreateInitiative(ctx, input)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Initiative created: %s", initiative.Name))
 				output.HumanLn("  ID: %s", initiative.ID)
 				output.HumanLn("  Status: %s", initiative.Status)
+				resolved.printHuman()
 			} else {
-				output.JSON(map[string]interface{}{
+				response := map[string]interface{}{
 					"success":    true,
 					"operation":  "create",
 					"initiative": initiative,
-				})
+				}
+				resolved.addTo(response)
+				output.JSON(response)
 			}
 
 			return nil
@@ -225,7 +258,7 @@ Examples:
 	cmd.Flags().StringVarP(&content, "content", "c", "", "Initiative content (markdown)")
 	cmd.Flags().StringVarP(&status, "status", "s", "", "Initiative status (Planned, Active, Completed)")
 	cmd.Flags().StringVarP(&ownerID, "owner", "o", "", "Owner user ID")
-	cmd.Flags().StringVarP(&targetDate, "target-date", "t", "", "Target date (YYYY-MM-DD)")
+	cmd.Flags().StringVarP(&targetDate, "target-date", "t", "", "Target date: "+dateFlagHelp)
 
 	return cmd
 }
@@ -261,18 +294,23 @@ Examples:
 				!cmd.Flags().Changed("owner") &&
 				!cmd.Flags().Changed("target-date") {
 				if IsHumanOutput() {
-					output.ErrorHuman("At least one field must be specified to update")
+					output.ErrorHuman("MISSING_FIELDS", "At least one field must be specified to update")
 					return nil
 				}
 				return output.Error("MISSING_FIELDS", "At least one field must be specified to update")
 			}
 
-			ctx := context.Background()
+			resolved, err := resolveDateFlags(map[string]*string{"target-date": &targetDate})
+			if err != nil {
+				return dateError(err)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -302,20 +340,23 @@ Examples:
 			initiative, err := client.UpdateInitiative(ctx, initiativeID, input)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Initiative updated: %s", initiative.Name))
+				resolved.printHuman()
 			} else {
-				output.JSON(map[string]interface{}{
+				response := map[string]interface{}{
 					"success":    true,
 					"operation":  "update",
 					"initiative": initiative,
-				})
+				}
+				resolved.addTo(response)
+				output.JSON(response)
 			}
 
 			return nil
@@ -327,7 +368,7 @@ Examples:
 	cmd.Flags().StringVarP(&content, "content", "c", "", "Initiative content (markdown)")
 	cmd.Flags().StringVarP(&status, "status", "s", "", "Initiative status (Planned, Active, Completed)")
 	cmd.Flags().StringVarP(&ownerID, "owner", "o", "", "Owner user ID")
-	cmd.Flags().StringVarP(&targetDate, "target-date", "t", "", "Target date (YYYY-MM-DD)")
+	cmd.Flags().StringVarP(&targetDate, "target-date", "t", "", "Target date: "+dateFlagHelp)
 
 	return cmd
 }
@@ -343,12 +384,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -357,10 +398,10 @@ Examples:
 			err = client.ArchiveInitiative(ctx, initiativeID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -391,12 +432,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -405,10 +446,10 @@ Examples:
 			err = client.RestoreInitiative(ctx, initiativeID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -440,12 +481,12 @@ Examples:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
 			projectID := args[1]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -454,10 +495,10 @@ Examples:
 			err = client.AddProjectToInitiative(ctx, initiativeID, projectID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -490,12 +531,12 @@ Examples:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
 			projectID := args[1]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -504,10 +545,10 @@ Examples:
 			err = client.RemoveProjectFromInitiative(ctx, initiativeID, projectID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -528,6 +569,92 @@ Examples:
 	return cmd
 }
 
+func newInitiativeSetParentCmd() *cobra.Command {
+	var none bool
+
+	cmd := &cobra.Command{
+		Use:   "set-parent <initiative-id> [parent-id]",
+		Short: "Nest an initiative under a parent initiative",
+		Long: `Make an initiative a sub-initiative of another initiative.
+
+Any existing parent is replaced. Use --none to detach the initiative
+so it becomes top-level again.
+
+Examples:
+  linear initiative set-parent abc123 def456
+  linear initiative set-parent abc123 --none`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			initiativeID := args[0]
+			parentID := ""
+			if len(args) == 2 {
+				parentID = args[1]
+			}
+
+			if (parentID == "") == !none {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"INVALID_INPUT",
+						"Specify either a parent initiative ID or --none",
+						"Pass the parent to nest under, or --none to make the initiative top-level",
+						"linear initiative set-parent abc123 def456",
+						"linear initiative set-parent abc123 --none",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"INVALID_INPUT",
+					"Specify either a parent initiative ID or --none",
+					"Pass the parent to nest under, or --none to make the initiative top-level",
+					"linear initiative set-parent abc123 def456",
+					"linear initiative set-parent abc123 --none",
+				)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			err = client.SetInitiativeParent(ctx, initiativeID, parentID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				if parentID == "" {
+					output.SuccessHuman("Initiative is now top-level")
+				} else {
+					output.SuccessHuman("Parent initiative set")
+				}
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":      true,
+					"operation":    "set-parent",
+					"initiativeId": initiativeID,
+					"parentId":     parentID,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&none, "none", false, "Remove the parent initiative")
+
+	return cmd
+}
+
 // Human output formatters
 
 func printInitiativesHuman(initiatives *api.InitiativesResponse) {
@@ -548,7 +675,7 @@ func printInitiativesHuman(initiatives *api.InitiativesResponse) {
 		targetDate := "-"
 		if init.TargetDate != "" {
 			if t, err := time.Parse("2006-01-02", init.TargetDate); err == nil {
-				targetDate = t.Format("Jan 02, 2006")
+				targetDate = display.FormatDay(t, "Jan 02, 2006")
 			} else {
 				targetDate = init.TargetDate
 			}
@@ -581,7 +708,7 @@ func printInitiativeDetailHuman(init *api.Initiative) {
 	if init.TargetDate != "" {
 		targetDate := init.TargetDate
 		if t, err := time.Parse("2006-01-02", init.TargetDate); err == nil {
-			targetDate = t.Format("Jan 02, 2006")
+			targetDate = display.FormatDay(t, "Jan 02, 2006")
 		}
 		output.HumanLn("Target Date: %s", targetDate)
 	}
@@ -605,6 +732,19 @@ func printInitiativeDetailHuman(init *api.Initiative) {
 	output.HumanLn("")
 	output.HumanLn("ID: %s", output.Muted("%s", init.ID))
 
+	if init.ParentInitiative != nil {
+		output.HumanLn("")
+		output.HumanLn("Parent: %s (%s)", init.ParentInitiative.Name, output.Muted("%s", init.ParentInitiative.ID))
+	}
+
+	if len(init.SubInitiatives) > 0 {
+		output.HumanLn("")
+		output.HumanLn("Sub-initiatives:")
+		for _, sub := range init.SubInitiatives {
+			output.HumanLn("  - %s [%s] (%s)", sub.Name, sub.Status, output.Muted("%s", sub.ID))
+		}
+	}
+
 	if len(init.Projects) > 0 {
 		output.HumanLn("")
 		output.HumanLn("Projects:")
@@ -0,0 +1,502 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// roadmapPageSize is the page size used when fetching all initiatives
+const roadmapPageSize = 100
+
+// RoadmapNode is an initiative with its sub-initiatives
+type RoadmapNode struct {
+	api.InitiativeListItem
+	Children []*RoadmapNode `json:"children"`
+}
+
+// RoadmapResponse is the response for the roadmap command
+type RoadmapResponse struct {
+	Roadmap []*RoadmapNode `json:"roadmap"`
+	Count   int            `json:"count"`
+}
+
+// RoadmapTimelineResponse is the response for roadmap --timeline. Start and
+// End bound every dated initiative and project.
+type RoadmapTimelineResponse struct {
+	Start       string                  `json:"start,omitempty"`
+	End         string                  `json:"end,omitempty"`
+	Initiatives []api.RoadmapInitiative `json:"initiatives"`
+	Count       int                     `json:"count"`
+}
+
+const (
+	// timelineLabelWidth is the width of the name column of the timeline
+	timelineLabelWidth = 32
+	// minTimelineWidth is the narrowest usable timeline
+	minTimelineWidth = 20
+)
+
+func newInitiativeRoadmapCmd() *cobra.Command {
+	var (
+		status   string
+		timeline bool
+		width    int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "roadmap",
+		Short: "Show initiatives as a hierarchy",
+		Long: `Show all initiatives as a tree of parent initiatives and sub-initiatives.
+
+Initiatives are ordered by target date within each level. When filtering
+by status, initiatives whose parent is filtered out are shown top-level.
+
+--timeline instead fetches each initiative's projects with their start and
+target dates, health and progress, and renders a Gantt-like timeline.
+Bars are colored by health; projects with only a target date are shown as
+a ◆ marker, and │ marks today.
+
+Examples:
+  linear initiative roadmap
+  linear initiative roadmap --status Active --human
+  linear initiative roadmap --timeline --human
+  linear initiative roadmap --timeline --status Active --width 80`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if timeline && width < minTimelineWidth {
+				msg := fmt.Sprintf("--width must be at least %d", minTimelineWidth)
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_WIDTH", msg)
+					return nil
+				}
+				return output.Error("INVALID_WIDTH", msg)
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			if timeline {
+				return runRoadmapTimeline(ctx, client, status, width)
+			}
+
+			items, _, err := collectPages("", true, func(after string) ([]api.InitiativeListItem, *api.PageInfo, error) {
+				page, err := client.GetInitiatives(ctx, status, "", roadmapPageSize, after)
+				if err != nil {
+					return nil, nil, err
+				}
+				return page.Initiatives, page.PageInfo, nil
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			response := &RoadmapResponse{
+				Roadmap: buildRoadmapTree(items),
+				Count:   len(items),
+			}
+
+			if IsHumanOutput() {
+				printRoadmapTreeHuman(response)
+			} else {
+				output.JSON(response)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&status, "status", "s", "", "Filter by status (Planned, Active, Completed)")
+	cmd.Flags().BoolVar(&timeline, "timeline", false, "Show a timeline of initiatives and their projects")
+	cmd.Flags().IntVarP(&width, "width", "w", 60, "Timeline width in columns")
+
+	return cmd
+}
+
+func runRoadmapTimeline(ctx context.Context, client *api.Client, status string, width int) error {
+	initiatives, _, err := collectPages("", true, func(after string) ([]api.RoadmapInitiative, *api.PageInfo, error) {
+		return client.GetRoadmapInitiatives(ctx, status, roadmapPageSize, after)
+	})
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHumanFrom(err, "API_ERROR")
+			return nil
+		}
+		return output.ErrorFrom(err, "API_ERROR")
+	}
+
+	sortRoadmapInitiatives(initiatives)
+	response := &RoadmapTimelineResponse{
+		Initiatives: initiatives,
+		Count:       len(initiatives),
+	}
+	start, end, ok := timelineBounds(initiatives)
+	if ok {
+		response.Start = start.Format("2006-01-02")
+		response.End = end.Format("2006-01-02")
+	}
+
+	if IsHumanOutput() {
+		printRoadmapTimelineHuman(response, width)
+		return nil
+	}
+	return output.JSON(response)
+}
+
+// sortRoadmapInitiatives orders initiatives by target date (undated last),
+// and each initiative's projects by start, then target date
+func sortRoadmapInitiatives(initiatives []api.RoadmapInitiative) {
+	sort.SliceStable(initiatives, func(i, j int) bool {
+		a, b := initiatives[i].TargetDate, initiatives[j].TargetDate
+		if a != b {
+			if a == "" || b == "" {
+				return b == ""
+			}
+			return a < b
+		}
+		return strings.ToLower(initiatives[i].Name) < strings.ToLower(initiatives[j].Name)
+	})
+	for _, init := range initiatives {
+		projects := init.Projects
+		sort.SliceStable(projects, func(i, j int) bool {
+			a, b := projectSpanStart(projects[i]), projectSpanStart(projects[j])
+			if a != b {
+				if a == "" || b == "" {
+					return b == ""
+				}
+				return a < b
+			}
+			return strings.ToLower(projects[i].Name) < strings.ToLower(projects[j].Name)
+		})
+	}
+}
+
+func projectSpanStart(p api.RoadmapProject) string {
+	if p.StartDate != "" {
+		return p.StartDate
+	}
+	return p.TargetDate
+}
+
+// timelineSpan is the start and end of a bar; start is zero for a
+// target-only marker
+type timelineSpan struct {
+	start, end time.Time
+}
+
+func parseDay(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+// projectSpan returns a project's bar, if it has any dates
+func projectSpan(p api.RoadmapProject) (timelineSpan, bool) {
+	start, end := parseDay(p.StartDate), parseDay(p.TargetDate)
+	switch {
+	case !start.IsZero() && !end.IsZero():
+		return timelineSpan{start, end}, true
+	case !end.IsZero():
+		return timelineSpan{end: end}, true
+	case !start.IsZero():
+		// Started without a target: a marker at the start
+		return timelineSpan{end: start}, true
+	}
+	return timelineSpan{}, false
+}
+
+// initiativeSpan runs from its earliest project to its target date, or to
+// its latest project when it has none
+func initiativeSpan(init api.RoadmapInitiative) (timelineSpan, bool) {
+	var span timelineSpan
+	for _, p := range init.Projects {
+		ps, ok := projectSpan(p)
+		if !ok {
+			continue
+		}
+		first := ps.start
+		if first.IsZero() {
+			first = ps.end
+		}
+		if span.start.IsZero() || first.Before(span.start) {
+			span.start = first
+		}
+		if ps.end.After(span.end) {
+			span.end = ps.end
+		}
+	}
+	if target := parseDay(init.TargetDate); !target.IsZero() {
+		span.end = target
+		if span.start.IsZero() || span.start.After(target) {
+			span.start = time.Time{}
+		}
+	}
+	if span.end.IsZero() {
+		return timelineSpan{}, false
+	}
+	if span.start.Equal(span.end) {
+		span.start = time.Time{}
+	}
+	return span, true
+}
+
+// timelineBounds returns the range covering every bar and today, widened
+// to whole months
+func timelineBounds(initiatives []api.RoadmapInitiative) (time.Time, time.Time, bool) {
+	var start, end time.Time
+	extend := func(t time.Time) {
+		if t.IsZero() {
+			return
+		}
+		if start.IsZero() || t.Before(start) {
+			start = t
+		}
+		if t.After(end) {
+			end = t
+		}
+	}
+	for _, init := range initiatives {
+		if span, ok := initiativeSpan(init); ok {
+			extend(span.start)
+			extend(span.end)
+		}
+		for _, p := range init.Projects {
+			if span, ok := projectSpan(p); ok {
+				extend(span.start)
+				extend(span.end)
+			}
+		}
+	}
+	if start.IsZero() {
+		return time.Time{}, time.Time{}, false
+	}
+	now := time.Now()
+	extend(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+
+	start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end = time.Date(end.Year(), end.Month()+1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	return start, end, true
+}
+
+// timeline maps dates in [start, end] onto width columns
+type timeline struct {
+	start, end time.Time
+	width      int
+}
+
+func (tl timeline) column(t time.Time) int {
+	total := tl.end.Sub(tl.start)
+	if total <= 0 {
+		return 0
+	}
+	col := int(float64(t.Sub(tl.start)) / float64(total) * float64(tl.width-1))
+	if col < 0 {
+		return 0
+	}
+	if col >= tl.width {
+		return tl.width - 1
+	}
+	return col
+}
+
+// axis renders month labels at the columns where months begin
+func (tl timeline) axis() string {
+	cells := []rune(strings.Repeat(" ", tl.width))
+	for m := tl.start; !m.After(tl.end); m = m.AddDate(0, 1, 0) {
+		label := m.Format("Jan")
+		if m.Month() == time.January || m.Equal(tl.start) {
+			label = m.Format("Jan '06")
+		}
+		col := tl.column(m)
+		if col+len(label) > tl.width || (col > 0 && cells[col-1] != ' ') {
+			continue
+		}
+		fits := true
+		for i := range label {
+			if cells[col+i] != ' ' {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			copy(cells[col:], []rune(label))
+		}
+	}
+	return string(cells)
+}
+
+// bar renders a span across the full width, with a │ at today's column
+// wherever the bar leaves room
+func (tl timeline) bar(span timelineSpan, health string) string {
+	cells := make([]string, tl.width)
+	for i := range cells {
+		cells[i] = " "
+	}
+	if today := tl.column(time.Now()); today >= 0 {
+		cells[today] = output.Muted("│")
+	}
+
+	to := tl.column(span.end)
+	if span.start.IsZero() {
+		cells[to] = healthColor(health, "◆")
+	} else {
+		for i := tl.column(span.start); i <= to; i++ {
+			cells[i] = healthColor(health, "█")
+		}
+	}
+	return strings.Join(cells, "")
+}
+
+// healthColor colors s by project or initiative health
+func healthColor(health, s string) string {
+	switch health {
+	case "onTrack":
+		return output.Green("%s", s)
+	case "atRisk":
+		return output.Yellow("%s", s)
+	case "offTrack":
+		return output.Red("%s", s)
+	}
+	return output.Cyan("%s", s)
+}
+
+// timelineLabel pads a name to the label column, truncating long names
+func timelineLabel(name string) string {
+	name = display.Truncate(name, timelineLabelWidth-1)
+	return name + strings.Repeat(" ", timelineLabelWidth-utf8.RuneCountInString(name))
+}
+
+func printRoadmapTimelineHuman(response *RoadmapTimelineResponse, width int) {
+	if len(response.Initiatives) == 0 {
+		output.HumanLn("No initiatives found")
+		return
+	}
+	if response.Start == "" {
+		output.HumanLn("No initiatives or projects have start or target dates")
+		return
+	}
+
+	tl := timeline{start: parseDay(response.Start), end: parseDay(response.End), width: width}
+	output.HumanLn("%s%s", strings.Repeat(" ", timelineLabelWidth), output.Muted("%s", tl.axis()))
+
+	undated := func() string {
+		return output.Muted("%-*s", width, "no dates")
+	}
+	for _, init := range response.Initiatives {
+		row := undated()
+		if span, ok := initiativeSpan(init); ok {
+			row = tl.bar(span, init.Health)
+		}
+		output.HumanLn("%s%s", output.Bold("%s", timelineLabel(init.Name)), row)
+
+		for _, p := range init.Projects {
+			row := undated()
+			if span, ok := projectSpan(p); ok {
+				row = tl.bar(span, p.Health)
+			}
+			label := timelineLabel("  " + p.Name)
+			output.HumanLn("%s%s %s", label, row, output.Muted("%.0f%%", p.Progress*100))
+		}
+	}
+
+	output.HumanLn("\n%d initiatives · %s → %s · %s on track  %s at risk  %s off track",
+		response.Count,
+		display.FormatDay(tl.start, "Jan 02, 2006"),
+		display.FormatDay(tl.end, "Jan 02, 2006"),
+		output.Green("█"), output.Yellow("█"), output.Red("█"))
+}
+
+// buildRoadmapTree nests initiatives under their parents. Initiatives whose
+// parent is not in the list become roots.
+func buildRoadmapTree(items []api.InitiativeListItem) []*RoadmapNode {
+	nodes := make(map[string]*RoadmapNode, len(items))
+	for _, item := range items {
+		nodes[item.ID] = &RoadmapNode{InitiativeListItem: item, Children: []*RoadmapNode{}}
+	}
+
+	roots := []*RoadmapNode{}
+	for _, item := range items {
+		node := nodes[item.ID]
+		if parent, ok := nodes[item.ParentID]; ok && item.ParentID != item.ID {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	sortRoadmapNodes(roots)
+	return roots
+}
+
+// sortRoadmapNodes orders nodes by target date (undated last), then name
+func sortRoadmapNodes(nodes []*RoadmapNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		a, b := nodes[i].TargetDate, nodes[j].TargetDate
+		if a != b {
+			if a == "" || b == "" {
+				return b == ""
+			}
+			return a < b
+		}
+		return strings.ToLower(nodes[i].Name) < strings.ToLower(nodes[j].Name)
+	})
+	for _, n := range nodes {
+		sortRoadmapNodes(n.Children)
+	}
+}
+
+func printRoadmapTreeHuman(response *RoadmapResponse) {
+	if len(response.Roadmap) == 0 {
+		output.HumanLn("No initiatives found")
+		return
+	}
+
+	for _, root := range response.Roadmap {
+		output.HumanLn("%s", roadmapNodeLabel(root))
+		printRoadmapChildrenHuman(root.Children, "")
+	}
+
+	output.HumanLn("\n%d initiatives", response.Count)
+}
+
+func printRoadmapChildrenHuman(children []*RoadmapNode, prefix string) {
+	for i, child := range children {
+		connector, indent := "├── ", "│   "
+		if i == len(children)-1 {
+			connector, indent = "└── ", "    "
+		}
+		output.HumanLn("%s%s", output.Muted("%s", prefix+connector), roadmapNodeLabel(child))
+		printRoadmapChildrenHuman(child.Children, prefix+indent)
+	}
+}
+
+// roadmapNodeLabel renders an initiative as "Name [Status] target"
+func roadmapNodeLabel(node *RoadmapNode) string {
+	target := ""
+	if node.TargetDate != "" {
+		target = node.TargetDate
+		if t, err := time.Parse("2006-01-02", node.TargetDate); err == nil {
+			target = display.FormatDay(t, "Jan 02, 2006")
+		}
+		target = " " + output.Muted("→ %s", target)
+	}
+	return output.Bold("%s", node.Name) + " " + output.Cyan("[%s]", node.Status) + target
+}
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+func newInitiativeUpdateStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-status",
+		Aliases: []string{"updates"},
+		Short:   "Manage initiative status updates",
+		Long: `Post and list initiative status updates.
+
+Examples:
+  linear initiative update-status list <initiative-id>
+  linear initiative update-status create <initiative-id> --body "Q3 goals on track" --health onTrack`,
+	}
+
+	cmd.AddCommand(newInitiativeUpdateStatusListCmd())
+	cmd.AddCommand(newInitiativeUpdateStatusCreateCmd())
+
+	return cmd
+}
+
+func newInitiativeUpdateStatusListCmd() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list <initiative-id>",
+		Short: "List status updates for an initiative",
+		Long: `List the latest status updates for an initiative, newest first.
+
+Examples:
+  linear initiative update-status list <initiative-id>
+  linear initiative update-status list <initiative-id> --limit 3 --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			updates, err := client.GetInitiativeUpdates(ctx, args[0], limit)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				printInitiativeUpdatesHuman(updates)
+				return nil
+			}
+			return output.JSON(updates)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 10, "Maximum updates to return")
+
+	return cmd
+}
+
+func newInitiativeUpdateStatusCreateCmd() *cobra.Command {
+	var (
+		body   string
+		health string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <initiative-id>",
+		Short: "Create a status update",
+		Long: `Post a status update on an initiative.
+
+Health values: onTrack, atRisk, offTrack
+
+Examples:
+  linear initiative update-status create <initiative-id> --body "All projects on schedule" --health onTrack
+  linear initiative update-status create <initiative-id> --editor`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if ok, err := applyEditor(cmd, "body", nil); !ok {
+				return err
+			}
+
+			if body == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_BODY", "Update body is required. Use --body flag.")
+					return nil
+				}
+				return output.Error("MISSING_BODY", "Update body is required")
+			}
+
+			if health != "" {
+				if !containsFold(projectHealths, health) {
+					msg := fmt.Sprintf("Invalid health '%s'", health)
+					hint := "Valid values: " + strings.Join(projectHealths, ", ")
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint("INVALID_HEALTH", msg, hint)
+						return nil
+					}
+					return output.ErrorWithHint("INVALID_HEALTH", msg, hint)
+				}
+				for _, h := range projectHealths {
+					if strings.EqualFold(h, health) {
+						health = h
+					}
+				}
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			update, err := client.CreateInitiativeUpdate(ctx, api.InitiativeUpdateCreateInput{
+				InitiativeID: args[0],
+				Body:         body,
+				Health:       health,
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Status update created")
+				output.HumanLn("  ID: %s", update.ID)
+				if update.URL != "" {
+					output.HumanLn("  URL: %s", update.URL)
+				}
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "create",
+				"update":    update,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&body, "body", "b", "", "Update body in markdown (required)")
+	cmd.Flags().Bool("editor", false, "Write the update body in $EDITOR")
+	cmd.Flags().StringVar(&health, "health", "", "Initiative health (onTrack, atRisk, offTrack)")
+
+	return cmd
+}
+
+func printInitiativeUpdatesHuman(updates *api.InitiativeUpdatesResponse) {
+	if len(updates.Updates) == 0 {
+		output.HumanLn("No status updates found")
+		return
+	}
+
+	for _, u := range updates.Updates {
+		createdAt := u.CreatedAt
+		if t, err := time.Parse(time.RFC3339, u.CreatedAt); err == nil {
+			createdAt = display.TimeAgo(t)
+		}
+
+		healthStr := ""
+		if u.Health != "" {
+			healthStr = " " + healthColor(u.Health, "["+u.Health+"]")
+		}
+
+		userName := "Unknown"
+		if u.User != nil {
+			userName = u.User.DisplayName
+		}
+
+		output.HumanLn("%s by %s%s", createdAt, userName, healthStr)
+		output.HumanLn("  %s", display.Truncate(u.Body, 80))
+		output.HumanLn("")
+	}
+
+	output.HumanLn("%d updates", updates.Count)
+}
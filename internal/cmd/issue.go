@@ -2,20 +2,29 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/breakdown"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
 	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
 	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/resolver"
+	"github.com/juanbermudez/agent-linear-cli/internal/templates"
 	"github.com/spf13/cobra"
 )
 
 // IssueListResponse is the response for issue list command
 type IssueListResponse struct {
-	Issues []api.IssueListItem `json:"issues"`
-	Count  int                 `json:"count"`
+	Issues   []api.IssueListItem `json:"issues"`
+	Count    int                 `json:"count"`
+	PageInfo *api.PageInfo       `json:"pageInfo,omitempty"`
 }
 
 // NewIssueCmd creates the issue command group
@@ -38,18 +47,40 @@ Examples:
 	cmd.AddCommand(newIssueViewCmd())
 	cmd.AddCommand(newIssueCreateCmd())
 	cmd.AddCommand(newIssueUpdateCmd())
+	cmd.AddCommand(newIssueReorderCmd())
 	cmd.AddCommand(newIssueDeleteCmd())
 	cmd.AddCommand(newIssueSearchCmd())
 	cmd.AddCommand(newIssueRelateCmd())
 	cmd.AddCommand(newIssueUnrelateCmd())
 	cmd.AddCommand(newIssueRelationsCmd())
+	cmd.AddCommand(newIssueGraphCmd())
 	cmd.AddCommand(newIssueCommentCmd())
 	cmd.AddCommand(newIssueAttachmentCmd())
+	cmd.AddCommand(newDocLinkCmd(issueDocTarget))
+	cmd.AddCommand(newIssueAttachPRCmd())
+	cmd.AddCommand(newIssueSedCmd())
+	cmd.AddCommand(newIssueExportCmd())
+	cmd.AddCommand(newIssueImportCmd())
+	cmd.AddCommand(newIssueReactCmd())
+	cmd.AddCommand(newIssueUnreactCmd())
+	cmd.AddCommand(newIssueWatchCmd())
+	cmd.AddCommand(newIssueSubscribeCmd())
+	cmd.AddCommand(newIssueUnsubscribeCmd())
+	cmd.AddCommand(newIssueSubscribersCmd())
+	cmd.AddCommand(newIssueCloneCmd())
+	cmd.AddCommand(newIssueSnoozeCmd())
+	cmd.AddCommand(newIssueUnsnoozeCmd())
 
 	// Utility commands
 	cmd.AddCommand(newIssueStartCmd())
+	cmd.AddCommand(newIssueAssignCmd())
+	cmd.AddCommand(newIssueUnassignCmd())
+	cmd.AddCommand(newIssueClaimCmd())
+	cmd.AddCommand(newIssueCloseCmd())
+	cmd.AddCommand(newIssueReopenCmd())
 	cmd.AddCommand(newIssueTitleCmd())
 	cmd.AddCommand(newIssueURLCmd())
+	cmd.AddCommand(newIssueCurrentCmd())
 	cmd.AddCommand(newIssueDescribeCmd())
 
 	return cmd
@@ -63,9 +94,24 @@ func newIssueListCmd() *cobra.Command {
 		allAssignees  bool
 		unassigned    bool
 		sortBy        string
+		reverse       bool
+		columnList    string
 		teamKey       string
 		projectID     string
 		limit         int
+		all           bool
+		after         string
+		labels        []string
+		priorities    []string
+		cycle         string
+		milestone     string
+		createdAfter  string
+		updatedAfter  string
+		dueBefore     string
+		parent        string
+		noProject     bool
+		blocked       bool
+		blocking      bool
 	)
 
 	cmd := &cobra.Command{
@@ -81,7 +127,39 @@ Examples:
   linear issue list --all-states
   linear issue list --assignee self
   linear issue list --unassigned
-  linear issue list --limit 100`,
+  linear issue list --limit 100
+  linear issue list --all
+  linear issue list --after <cursor>
+  linear issue list --label bug --priority urgent,high
+  linear issue list --cycle current --assignee self
+  linear issue list --milestone "Beta" --due-before +7d
+  linear issue list --updated-after -3d --no-project
+  linear issue list --parent ENG-100
+  linear issue list --blocked --columns id,title,blocked,state
+  linear issue list --sort priority
+  linear issue list --sort due --reverse
+  linear issue list --columns id,title,assignee,due --human
+
+Filters combine with AND. --label may be repeated (issues must have every
+label). --cycle takes current, next, previous or a cycle ID. Dates take
+YYYY-MM-DD, today, yesterday, +3d or past offsets such as -7d and
+"2 weeks ago".
+
+--sort orders by priority (urgent first), updated or created (newest
+first), due (soonest first), estimate (largest first) or state (workflow
+order); issues without the value come last. The API sorts by updated and
+created across pages; other orders sort the fetched results, so combine
+them with --all to sort everything.
+
+--blocked keeps issues waiting on an open issue, and --blocking those an
+open issue is waiting on; completed and canceled issues no longer block.
+Relations are fetched after each page of issues, so a filtered page may
+hold fewer than --limit issues; add --all to check every issue.
+
+--columns picks the columns of table and TSV output from `+columnNames(issueColumns)+`.
+The blocked and blocking columns list the issues on the other side of
+each relation. Save a preference with
+'linear config set columns.issue_list id,title,due'.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if teamKey == "" {
 				teamKey = GetTeamID()
@@ -89,6 +167,7 @@ Examples:
 			if teamKey == "" {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"MISSING_TEAM",
 						"Team is required",
 						"Specify a team using --team flag or set a default team",
 						"linear issue list --team ENG",
@@ -105,12 +184,89 @@ Examples:
 				)
 			}
 
-			ctx := context.Background()
+			filterErr := func(code string, err error) error {
+				if IsHumanOutput() {
+					output.ErrorHuman(code, err.Error())
+					return nil
+				}
+				return output.Error(code, err.Error())
+			}
+
+			if projectID != "" && noProject {
+				return filterErr("INVALID_FLAGS", fmt.Errorf("--project and --no-project cannot be used together"))
+			}
+
+			order, err := parseSort(issueSortKeys, sortBy, reverse)
+			if err != nil {
+				return filterErr("INVALID_SORT", err)
+			}
+
+			columns, custom, err := parseColumns(issueColumns, columnList, loadConfig().Columns.IssueList, issueDefaultColumns)
+			if err != nil {
+				return filterErr("INVALID_COLUMNS", err)
+			}
+			if custom {
+				setTSVColumns(columns)
+			}
+
+			priorityValues := []int{}
+			for _, p := range priorities {
+				for _, item := range splitImportList(p) {
+					value, err := parsePriority(item)
+					if err != nil {
+						return filterErr("INVALID_PRIORITY", err)
+					}
+					priorityValues = append(priorityValues, value)
+				}
+			}
+
+			dateFilters := map[string]*string{
+				"--created-after": &createdAfter,
+				"--updated-after": &updatedAfter,
+				"--due-before":    &dueBefore,
+			}
+			for flag, value := range dateFilters {
+				resolved, err := resolveFilterDate(*value)
+				if err != nil {
+					return filterErr("INVALID_DATE", fmt.Errorf("%s: %w", flag, err))
+				}
+				*value = resolved
+			}
+
+			if IsOffline() {
+				if len(labels) > 0 || len(priorityValues) > 0 || cycle != "" || milestone != "" || parent != "" || noProject ||
+					createdAfter != "" || updatedAfter != "" || dueBefore != "" || blocked || blocking {
+					return filterErr("INVALID_FLAGS", fmt.Errorf("only --team, --state and --all-states filters are supported with --offline"))
+				}
+				// The local store only holds the viewer's active assigned issues
+				synced, info, err := readOffline[api.IssuesResponse](cache.WorkspaceKey("my-issues"))
+				if err != nil {
+					return offlineError(err)
+				}
+				types := stateTypes
+				if allStates {
+					types = nil
+				}
+				items := filterOfflineIssues(synced.Issues, teamKey, types)
+				order.apply(items)
+				response := &IssueListResponse{
+					Issues: items,
+					Count:  len(items),
+				}
+				if IsHumanOutput() {
+					printIssuesHuman(response, strings.ToUpper(teamKey), columns)
+					printOfflineNoticeHuman(info)
+					return nil
+				}
+				return outputOfflineJSON(response, info)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -120,14 +276,14 @@ Examples:
 			team, err := client.GetTeamByKey(ctx, teamKey)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 			if team == nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
 					return nil
 				}
 				return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
@@ -135,8 +291,30 @@ Examples:
 
 			// Build filter
 			filter := api.IssueFilter{
-				TeamID:    team.ID,
-				ProjectID: projectID,
+				TeamID:       team.ID,
+				ProjectID:    projectID,
+				NoProject:    noProject,
+				Labels:       labels,
+				Priorities:   priorityValues,
+				Cycle:        cycle,
+				Milestone:    milestone,
+				CreatedAfter: createdAfter,
+				UpdatedAfter: updatedAfter,
+				DueBefore:    dueBefore,
+			}
+
+			if parent != "" {
+				filter.ParentID = parent
+				if !isUUID(parent) {
+					parentIssue, err := client.GetIssue(ctx, parent, false)
+					if err != nil {
+						return filterErr("API_ERROR", err)
+					}
+					if parentIssue == nil {
+						return filterErr("NOT_FOUND", fmt.Errorf("parent issue '%s' not found", parent))
+					}
+					filter.ParentID = parentIssue.ID
+				}
 			}
 
 			// Handle state filtering
@@ -157,7 +335,7 @@ Examples:
 					viewerID, err := client.GetViewerID(ctx)
 					if err != nil {
 						if IsHumanOutput() {
-							output.ErrorHuman("Failed to get current user: " + err.Error())
+							output.ErrorHuman("API_ERROR", "Failed to get current user: "+err.Error())
 							return nil
 						}
 						return output.Error("API_ERROR", "Failed to get current user: "+err.Error())
@@ -168,23 +346,55 @@ Examples:
 				}
 			}
 
-			issues, err := client.GetIssues(ctx, filter, limit, sortBy)
+			// Blocking relations take a second request per page, made only
+			// when a filter or column needs them
+			withBlocking := blocked || blocking
+			for _, c := range columns {
+				withBlocking = withBlocking || c.name == "blocked" || c.name == "blocking"
+			}
+
+			fetch := func(after string) ([]api.IssueListItem, *api.PageInfo, error) {
+				page, err := client.GetIssues(ctx, filter, limit, order.serverOrder(), after)
+				if err != nil {
+					return nil, nil, err
+				}
+				if withBlocking {
+					if err := addIssueBlocking(ctx, client, page.Issues); err != nil {
+						return nil, nil, err
+					}
+					page.Issues = filterIssueBlocking(page.Issues, blocked, blocking)
+				}
+				return page.Issues, page.PageInfo, nil
+			}
+			var (
+				items    []api.IssueListItem
+				pageInfo *api.PageInfo
+				streamed bool
+			)
+			if order.local() {
+				items, pageInfo, err = collectPages(after, all, fetch)
+			} else {
+				items, pageInfo, streamed, err = streamPages(after, all, fetch)
+			}
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
+			order.apply(items)
 
 			response := &IssueListResponse{
-				Issues: issues.Issues,
-				Count:  issues.Count,
+				Issues:   items,
+				Count:    len(items),
+				PageInfo: pageInfo,
 			}
 
 			if IsHumanOutput() {
-				printIssuesHuman(response, team.Key)
-			} else {
+				printIssuesHuman(response, team.Key, columns)
+				printPageHintHuman(response.PageInfo)
+			} else if !streamed {
 				output.JSON(response)
 			}
 
@@ -197,38 +407,124 @@ Examples:
 	cmd.Flags().StringVarP(&assignee, "assignee", "a", "", "Filter by assignee (use 'self' for yourself)")
 	cmd.Flags().BoolVarP(&allAssignees, "all-assignees", "A", false, "Show issues from all assignees")
 	cmd.Flags().BoolVarP(&unassigned, "unassigned", "U", false, "Show only unassigned issues")
-	cmd.Flags().StringVar(&sortBy, "sort", "manual", "Sort order (manual, priority)")
+	cmd.Flags().StringVar(&sortBy, "sort", "", "Sort by "+sortNames(issueSortKeys)+" (default: API order)")
+	cmd.Flags().BoolVar(&reverse, "reverse", false, "Reverse the sort order")
+	cmd.Flags().StringVar(&columnList, "columns", "", "Comma-separated columns for table and TSV output")
 	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
-	cmd.Flags().StringVar(&projectID, "project", "", "Filter by project ID")
-	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum number of issues to return")
+	cmd.Flags().StringVar(&projectID, "project", "", "Filter by project ID or name")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum number of issues to return (page size with --all)")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch every page of results")
+	cmd.Flags().StringVar(&after, "after", "", "Start after this cursor (from pageInfo.endCursor)")
+	cmd.Flags().StringSliceVarP(&labels, "label", "L", nil, "Filter by label name or ID (repeatable; all must match)")
+	cmd.Flags().StringSliceVarP(&priorities, "priority", "p", nil, "Filter by priority (urgent, high, medium, low, none or 0-4; comma-separated)")
+	cmd.Flags().StringVar(&cycle, "cycle", "", "Filter by cycle (current, next, previous or cycle ID)")
+	cmd.Flags().StringVar(&milestone, "milestone", "", "Filter by project milestone name or ID")
+	cmd.Flags().StringVar(&createdAfter, "created-after", "", "Only issues created after this date")
+	cmd.Flags().StringVar(&updatedAfter, "updated-after", "", "Only issues updated after this date")
+	cmd.Flags().StringVar(&dueBefore, "due-before", "", "Only issues due before this date")
+	cmd.Flags().StringVar(&parent, "parent", "", "Only sub-issues of this issue")
+	markRefFlag(cmd, "parent", resolver.KindIssue)
+	cmd.Flags().BoolVar(&noProject, "no-project", false, "Only issues without a project")
+	cmd.Flags().BoolVar(&blocked, "blocked", false, "Only issues blocked by an open issue")
+	cmd.Flags().BoolVar(&blocking, "blocking", false, "Only issues blocking an open issue")
 
 	return cmd
 }
 
+// addIssueBlocking fills in the issues each of items blocks and is blocked
+// by
+func addIssueBlocking(ctx context.Context, client *api.Client, items []api.IssueListItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	relations, err := client.GetIssueBlocking(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range items {
+		items[i].BlockedBy = relations[items[i].ID].BlockedBy
+		items[i].Blocking = relations[items[i].ID].Blocking
+	}
+	return nil
+}
+
+// filterIssueBlocking keeps the items that are blocked, blocking, or both
+// when both are set
+func filterIssueBlocking(items []api.IssueListItem, blocked, blocking bool) []api.IssueListItem {
+	if !blocked && !blocking {
+		return items
+	}
+	kept := items[:0]
+	for _, item := range items {
+		if (blocked && len(item.BlockedBy) == 0) || (blocking && len(item.Blocking) == 0) {
+			continue
+		}
+		kept = append(kept, item)
+	}
+	return kept
+}
+
 func newIssueViewCmd() *cobra.Command {
 	var (
 		noComments bool
+		expandList string
+		fieldList  string
 	)
 
 	cmd := &cobra.Command{
-		Use:   "view <issue-id>",
+		Use:   "view [issue-id]",
 		Short: "View issue details",
 		Long: `View detailed information about a specific issue.
 
-Issue ID can be an identifier (ENG-123) or UUID.
+Issue ID can be an identifier (ENG-123) or UUID. Without one, the issue is
+detected from the current git branch (see 'linear issue current').
+
+By default the issue is fetched with its children, relations and comments.
+--expand picks the connections to fetch instead (children, relations,
+comments, attachments, history, all or none); connections left out are not
+queried at all. --fields limits the JSON output to the named top-level keys
+and, without --expand, fetches only the connections among them.
 
 Examples:
   linear issue view ENG-123
-  linear issue view ENG-123 --no-comments`,
-		Args: cobra.ExactArgs(1),
+  linear issue view ENG-123 --no-comments
+  linear issue view ENG-123 --expand none
+  linear issue view ENG-123 --expand attachments,history
+  linear issue view ENG-123 --fields identifier,title,state,children`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
+
+			var fields []string
+			expand, err := parseIssueExpand(expandList, noComments)
+			if err == nil && fieldList != "" {
+				fields, err = parseIssueFields(fieldList)
+				if err == nil && !cmd.Flags().Changed("expand") {
+					expand = expandForFields(fields, noComments)
+				}
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", err.Error())
+			}
+
+			issueID, err := issueArgOrCurrent(ctx, args)
+			if err != nil {
+				return issueDetectError(err, "linear issue view ENG-123")
+			}
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"AUTH_ERROR",
 						err.Error(),
 						"Authentication failed. Make sure you're logged in",
 						"linear auth login --with-token",
@@ -243,10 +539,12 @@ Examples:
 				)
 			}
 
-			issue, err := client.GetIssue(ctx, issueID, !noComments)
+			issue, err := client.GetIssueExpanded(ctx, issueID, expand)
 			if err != nil {
+				code := output.CodeFrom(err, "API_ERROR")
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						code,
 						err.Error(),
 						"Issue not found or invalid ID. Use format TEAM-123 or UUID",
 						"linear issue view ENG-123",
@@ -255,16 +553,23 @@ Examples:
 					return nil
 				}
 				return output.ErrorWithHint(
-					"API_ERROR",
+					code,
 					err.Error(),
 					"Issue not found or invalid ID. Use format TEAM-123 or UUID",
 					"linear issue view ENG-123",
 					"linear issue search \"keyword\"",
 				)
 			}
+			recordIssue(issue.ID, issue.Identifier, issue.Title, issue.URL, history.ActionViewed)
 
 			if IsHumanOutput() {
 				printIssueDetailHuman(issue)
+			} else if len(fields) > 0 {
+				selected, err := selectIssueFields(issue, fields)
+				if err != nil {
+					return output.ErrorFrom(err, "INTERNAL_ERROR")
+				}
+				output.JSON(selected)
 			} else {
 				output.JSON(issue)
 			}
@@ -274,25 +579,33 @@ Examples:
 	}
 
 	cmd.Flags().BoolVar(&noComments, "no-comments", false, "Exclude comments from output")
+	cmd.Flags().StringVar(&expandList, "expand", "", "Connections to fetch: "+strings.Join(issueExpandNames, ", ")+", all or none (default children,relations,comments)")
+	cmd.Flags().StringVar(&fieldList, "fields", "", "Top-level JSON keys to output, comma-separated (e.g. identifier,title,state)")
 
 	return cmd
 }
 
 func newIssueCreateCmd() *cobra.Command {
 	var (
-		title       string
-		description string
-		priority    int
-		estimate    float64
-		assignee    string
-		labels      []string
-		projectID   string
-		stateID     string
-		teamKey     string
-		parentID    string
-		dueDate     string
-		cycleID     string
-		milestoneID string
+		title               string
+		description         string
+		priority            string
+		estimate            string
+		assignee            string
+		labels              []string
+		createMissingLabels bool
+		projectID           string
+		state               string
+		stateType           string
+		teamKey             string
+		parentID            string
+		dueDate             string
+		cycleID             string
+		milestoneID         string
+		tmplName            string
+		vars                []string
+		subtasksFrom        string
+		noDefaults          bool
 	)
 
 	cmd := &cobra.Command{
@@ -300,16 +613,87 @@ func newIssueCreateCmd() *cobra.Command {
 		Short: "Create a new issue",
 		Long: `Create a new issue in Linear.
 
-Priority values: 0=none, 1=urgent, 2=high, 3=medium, 4=low
+Priority values: urgent, high, medium, low, none, or 0-4 (0=none, 1=urgent)
+
+Estimates are checked against the team's estimation scale; teams using
+T-shirt sizes accept XS, S, M, L and XL as well as their point values.
+
+The team, labels and issue template default to team_key, labels and
+issue_template from the config, such as a repository's .linear.toml.
+The project and cycle default to default_project and default_cycle, or
+to those of the issue's team in a [teams.<KEY>] table:
+
+  default_project = "mobile-app-2f3c4d5e6a7b"
+
+  [teams.ENG]
+  default_cycle = "current"
+
+--no-defaults ignores the configured labels, template, project and cycle.
+--cycle takes current, next or a cycle ID.
 
 Examples:
   linear issue create --title "Fix login bug" --team ENG
-  linear issue create --title "Feature" --description "Details..." --priority 2 --team ENG
-  linear issue create --title "Subtask" --parent ENG-123 --team ENG`,
+  linear issue create --title "Feature" --description "Details..." --priority high --team ENG
+  linear issue create --title "Refactor" --estimate 5 --team ENG
+  linear issue create --title "Feature" --description-file spec.md --team ENG
+  linear issue create --title "Feature" --editor --team ENG
+  linear issue create --title "Subtask" --parent ENG-123 --team ENG
+  linear issue create --title "Hotfix" --state "In Progress" --team ENG
+  linear issue create --title "Crash on login" --template bug --var os=macOS --team ENG
+  linear issue create --subtasks-from plan.md --team ENG
+
+Sub-issues from a file:
+  --subtasks-from creates the issue and then a sub-issue for each task in
+  a markdown checklist, YAML or JSON file, in order, nested as in the
+  file. Sub-issues inherit the team, project, cycle and milestone.
+
+  A markdown file's "# Heading" and the text below it are the parent's
+  title and description unless --title/--description are given. List
+  items (- [ ] or plain bullets) are tasks; indent them to nest, and
+  indent text under an item for its description. "## Headings" group
+  the items below them into a sub-issue. Checked items are created done.
+
+  YAML and JSON files hold an optional title and description and a list
+  of subtasks; each task is a title or a mapping with title, description,
+  priority, estimate, assignee, labels, done and subtasks:
+
+    title: Launch billing v2
+    subtasks:
+      - title: Design schema
+        estimate: 3
+        subtasks: [Invoices table, Lines table]
+      - API endpoints`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if ok, err := applyContentFile(cmd, "description", "description-file"); !ok {
+				return err
+			}
+			if ok, err := applyEditor(cmd, "description", nil); !ok {
+				return err
+			}
+
+			var plan *breakdown.Plan
+			if subtasksFrom != "" {
+				var err error
+				plan, err = breakdown.Load(subtasksFrom)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("INVALID_FILE", err.Error())
+						return nil
+					}
+					return output.Error("INVALID_FILE", err.Error())
+				}
+				if title == "" {
+					title = plan.Title
+				}
+				if description == "" {
+					description = plan.Description
+				}
+			}
+
 			if title == "" {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"MISSING_TITLE",
 						"Title is required",
 						"Provide a title using the --title flag",
 						"linear issue create --title \"Fix bug\" --team ENG",
@@ -324,12 +708,25 @@ Examples:
 				)
 			}
 
+			priorityValue, err := parsePriority(priority)
+			if priority != "" && err != nil {
+				return priorityError(err)
+			}
+
 			if teamKey == "" {
 				teamKey = GetTeamID()
 			}
+			// Repository defaults from .linear.toml
+			if !noDefaults && !cmd.Flags().Changed("label") {
+				labels = loadConfig().Labels
+			}
+			if !noDefaults && tmplName == "" && description == "" {
+				tmplName = loadConfig().IssueTemplate
+			}
 			if teamKey == "" {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"MISSING_TEAM",
 						"Team is required",
 						"Specify a team using --team flag or set a default team",
 						"linear issue create --title \"Fix bug\" --team ENG",
@@ -346,12 +743,13 @@ Examples:
 				)
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"AUTH_ERROR",
 						err.Error(),
 						"Authentication failed. Make sure you're logged in",
 						"linear auth login --with-token",
@@ -372,14 +770,15 @@ Examples:
 			team, err := client.GetTeamByKey(ctx, teamKey)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 			if team == nil {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"NOT_FOUND",
 						fmt.Sprintf("Team '%s' not found", teamKey),
 						"Check available teams and use a valid team key",
 						"linear team list",
@@ -394,6 +793,90 @@ Examples:
 				)
 			}
 
+			resolved, err := resolveDateFlags(map[string]*string{"due-date": &dueDate})
+			if err != nil {
+				return dateError(err)
+			}
+
+			defaults := map[string]string{}
+			if !noDefaults {
+				defaultProject, defaultCycle := loadConfig().IssueDefaults(team.Key)
+				if projectID == "" && defaultProject != "" {
+					if projectID, err = resolveRef(resolver.KindProject, defaultProject); err != nil {
+						if IsHumanOutput() {
+							output.ErrorHuman("CONFIG_ERROR", err.Error())
+							return nil
+						}
+						return output.Error("CONFIG_ERROR", err.Error())
+					}
+					defaults["project"] = defaultProject
+				}
+				if cycleID == "" && defaultCycle != "" {
+					cycleID = defaultCycle
+					defaults["cycle"] = defaultCycle
+				}
+			}
+
+			// The state, labels, assignee, cycle and estimate scale are
+			// independent lookups, so resolve them concurrently
+			var stateID, viewerID string
+			var labelIDs []string
+			var estimateValue float64
+			err = api.Parallel(ctx,
+				func(ctx context.Context) (err error) {
+					if estimate != "" {
+						estimateValue, err = resolveEstimate(ctx, client, team.ID, estimate)
+					}
+					return err
+				},
+				func(ctx context.Context) (err error) {
+					stateID, err = resolveStateID(ctx, client, team.ID, state, stateType)
+					return err
+				},
+				func(ctx context.Context) (err error) {
+					labelIDs, err = resolveLabelIDs(ctx, client, team.ID, labels, createMissingLabels)
+					return err
+				},
+				func(ctx context.Context) (err error) {
+					cycleID, err = resolveCycleID(ctx, client, team.ID, cycleID)
+					return err
+				},
+				func(ctx context.Context) (err error) {
+					if assignee == "self" || assignee == "me" {
+						if viewerID, err = client.GetViewerID(ctx); err != nil {
+							return fmt.Errorf("Failed to get current user: %w", err)
+						}
+					}
+					return nil
+				},
+			)
+			if err != nil {
+				var labelErr *LabelResolveError
+				if errors.As(err, &labelErr) {
+					return labelError(err)
+				}
+				var estimateErr *EstimateError
+				if errors.As(err, &estimateErr) {
+					return estimateError(err)
+				}
+				var cycleErr *CycleResolveError
+				if errors.As(err, &cycleErr) {
+					return cycleError(err)
+				}
+				return stateError(err)
+			}
+
+			if tmplName != "" && description == "" {
+				description, err = renderIssueTemplate(tmplName, title, team.Key, vars)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+						return nil
+					}
+					return output.Error("TEMPLATE_ERROR", err.Error())
+				}
+			}
+
 			// Build input
 			input := api.IssueCreateInput{
 				Title:       title,
@@ -407,43 +890,58 @@ Examples:
 				ProjectMilestoneID: milestoneID,
 			}
 
-			if priority > 0 {
-				input.Priority = &priority
+			if priorityValue > 0 {
+				input.Priority = &priorityValue
 			}
 
-			if estimate > 0 {
-				input.Estimate = &estimate
+			if estimate != "" {
+				input.Estimate = &estimateValue
 			}
 
 			// Handle assignee
 			if assignee != "" {
 				if assignee == "self" || assignee == "me" {
-					viewerID, err := client.GetViewerID(ctx)
-					if err != nil {
-						if IsHumanOutput() {
-							output.ErrorHuman("Failed to get current user: " + err.Error())
-							return nil
-						}
-						return output.Error("API_ERROR", "Failed to get current user: "+err.Error())
-					}
 					input.AssigneeID = viewerID
 				} else {
 					input.AssigneeID = assignee
 				}
 			}
 
-			if len(labels) > 0 {
-				input.LabelIDs = labels
+			if len(labelIDs) > 0 {
+				input.LabelIDs = labelIDs
+			}
+
+			var planned []plannedSubtask
+			if plan != nil {
+				planned, err = planSubtasks(ctx, client, plan.Subtasks, subtaskDefaults{
+					teamID:              team.ID,
+					projectID:           projectID,
+					cycleID:             cycleID,
+					milestoneID:         milestoneID,
+					createMissingLabels: createMissingLabels,
+				})
+				if err != nil {
+					var labelErr *LabelResolveError
+					if errors.As(err, &labelErr) {
+						return labelError(err)
+					}
+					if IsHumanOutput() {
+						output.ErrorHuman("INVALID_INPUT", err.Error())
+						return nil
+					}
+					return output.Error("INVALID_INPUT", err.Error())
+				}
 			}
 
 			result, err := client.CreateIssue(ctx, input)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
+			recordIssue(result.ID, result.Identifier, input.Title, result.URL, history.ActionCreated)
 
 			response := map[string]interface{}{
 				"success": true,
@@ -456,9 +954,39 @@ Examples:
 					},
 				},
 			}
+			resolved.addTo(response)
+			if len(defaults) > 0 {
+				response["defaults"] = defaults
+			}
+
+			if plan != nil {
+				subtasks, err := createSubtasks(ctx, client, result.ID, planned)
+				if err != nil {
+					msg := fmt.Sprintf("Created %s and %d of %d sub-issues, then failed: %v", result.Identifier, countSubtaskNodes(subtasks), plan.Count(), err)
+					if IsHumanOutput() {
+						output.ErrorHuman("API_ERROR", msg)
+						printSubtaskTree(subtasks, "  ")
+						return nil
+					}
+					return output.Error("API_ERROR", msg)
+				}
+				response["subtasks"] = subtasks
+				response["created"] = 1 + countSubtaskNodes(subtasks)
+
+				if IsHumanOutput() {
+					output.SuccessHuman(fmt.Sprintf("Created issue %s with %d sub-issues: %s", result.Identifier, countSubtaskNodes(subtasks), result.URL))
+					output.HumanLn("  %s %s", output.Cyan("%s", result.Identifier), title)
+					printSubtaskTree(subtasks, "  ")
+				} else {
+					output.JSON(response)
+				}
+				return nil
+			}
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Created issue %s: %s", result.Identifier, result.URL))
+				resolved.printHuman()
+				printIssueDefaults(defaults)
 			} else {
 				output.JSON(response)
 			}
@@ -469,92 +997,173 @@ Examples:
 
 	cmd.Flags().StringVarP(&title, "title", "T", "", "Issue title (required)")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Issue description (markdown)")
-	cmd.Flags().IntVarP(&priority, "priority", "p", 0, "Priority (0=none, 1=urgent, 2=high, 3=medium, 4=low)")
-	cmd.Flags().Float64VarP(&estimate, "estimate", "e", 0, "Story points estimate")
+	cmd.Flags().String("description-file", "", "Read the description from a markdown file (- for stdin)")
+	cmd.Flags().Bool("editor", false, "Write the description in $EDITOR")
+	cmd.Flags().StringVarP(&priority, "priority", "p", "", "Priority: "+priorityUsage)
+	cmd.Flags().StringVarP(&estimate, "estimate", "e", "", "Estimate on the team's scale (points, or a T-shirt size)")
 	cmd.Flags().StringVarP(&assignee, "assignee", "a", "", "Assignee (use 'self' for yourself, or user ID)")
-	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Label IDs to apply")
+	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Label names or IDs to apply")
+	cmd.Flags().BoolVar(&createMissingLabels, "create-missing-labels", false, "Create labels that don't exist yet")
 	cmd.Flags().StringVar(&projectID, "project", "", "Project ID")
-	cmd.Flags().StringVarP(&stateID, "state", "s", "", "Workflow state ID")
+	cmd.Flags().StringVarP(&state, "state", "s", "", "Workflow state name or ID (e.g., \"In Progress\")")
+	cmd.Flags().StringVar(&stateType, "state-type", "", "Workflow state type (triage, backlog, unstarted, started, completed, canceled); ignored with --state")
 	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
 	cmd.Flags().StringVar(&parentID, "parent", "", "Parent issue ID for subtasks")
-	cmd.Flags().StringVar(&dueDate, "due-date", "", "Due date (YYYY-MM-DD)")
-	cmd.Flags().StringVar(&cycleID, "cycle", "", "Cycle ID")
+	markRefFlag(cmd, "parent", resolver.KindIssue)
+	cmd.Flags().StringVar(&dueDate, "due-date", "", "Due date: "+dateFlagHelp)
+	cmd.Flags().StringVar(&cycleID, "cycle", "", "Cycle: current, next or a cycle ID")
 	cmd.Flags().StringVar(&milestoneID, "milestone", "", "Project milestone ID")
+	cmd.Flags().StringVar(&tmplName, "template", "", "Issue template for the description (ignored if --description is set)")
+	cmd.Flags().BoolVar(&noDefaults, "no-defaults", false, "Ignore the configured default labels, template, project and cycle")
+	cmd.Flags().StringArrayVar(&vars, "var", nil, "Template variable as key=value (repeatable)")
+	cmd.Flags().StringVar(&subtasksFrom, "subtasks-from", "", "Create sub-issues from a markdown checklist, YAML or JSON file")
 
 	return cmd
 }
 
+// printIssueDefaults notes the configured defaults applied to a new issue
+func printIssueDefaults(defaults map[string]string) {
+	for _, field := range []string{"project", "cycle"} {
+		if value, ok := defaults[field]; ok {
+			output.KeyValue("default "+field, value)
+		}
+	}
+}
+
+// renderIssueTemplate renders a stored issue template for a new issue
+func renderIssueTemplate(name, title, teamKey string, pairs []string) (string, error) {
+	vars, err := templates.ParseVars(pairs)
+	if err != nil {
+		return "", err
+	}
+
+	tmpl, err := loadTemplate(templates.KindIssue, name)
+	if err != nil {
+		return "", err
+	}
+	if tmpl == nil {
+		return "", fmt.Errorf("issue template '%s' not found", name)
+	}
+
+	data := issueTemplateData(nil, vars)
+	data["Title"] = title
+	data["Team"] = teamKey
+
+	return templates.Render(tmpl.Name, tmpl.Body, data)
+}
+
 func newIssueUpdateCmd() *cobra.Command {
 	var (
-		title       string
-		description string
-		priority    int
-		estimate    float64
-		assignee    string
-		labels      []string
-		projectID   string
-		stateID     string
-		parentID    string
-		dueDate     string
-		cycleID     string
-		milestoneID string
+		title               string
+		description         string
+		priority            string
+		estimate            string
+		assignee            string
+		labels              []string
+		createMissingLabels bool
+		projectID           string
+		state               string
+		stateType           string
+		parentID            string
+		dueDate             string
+		cycleID             string
+		milestoneID         string
 	)
 
 	cmd := &cobra.Command{
-		Use:   "update <issue-id>",
-		Short: "Update an issue",
-		Long: `Update an existing issue.
+		Use:   "update <issue-id>...",
+		Short: "Update one or more issues",
+		Long: `Update existing issues.
 
-At least one field must be provided to update.
+At least one field must be provided to update. Several issues can be
+updated at once by listing them or with ranges and brace patterns such
+as ENG-100..ENG-120 or ENG-1{2,3}4. All issues are checked to exist
+before any is changed; gaps inside a range are skipped.
 
 Examples:
   linear issue update ENG-123 --title "New title"
-  linear issue update ENG-123 --priority 2
-  linear issue update ENG-123 --assignee self --state abc123`,
-		Args: cobra.ExactArgs(1),
+  linear issue update ENG-123 --priority urgent
+  linear issue update ENG-123 --estimate 3
+  linear issue update ENG-123 --description-file notes.md
+  linear issue update ENG-123 --editor
+  linear issue update ENG-123 --assignee self --state "In Progress"
+  linear issue update ENG-123 --state-type completed
+  linear issue update ENG-100..ENG-120 --state-type canceled
+  linear issue update ENG-1{2,3}4 ENG-200 --label <label-id>`,
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
 
+			if ok, err := applyContentFile(cmd, "description", "description-file"); !ok {
+				return err
+			}
+
+			// A single issue's current description is pre-populated in the
+			// editor
+			var current func() (string, error)
+			if !isBatchArgs(args) {
+				current = func() (string, error) {
+					ctx := cmd.Context()
+					client, err := api.NewClient(ctx)
+					if err != nil {
+						return "", err
+					}
+					issue, err := client.GetIssue(ctx, issueID, false)
+					if err != nil || issue == nil {
+						return "", err
+					}
+					return issue.Description, nil
+				}
+			}
+			if ok, err := applyEditor(cmd, "description", current); !ok {
+				return err
+			}
+
 			// Check that at least one field is provided
-			if title == "" && description == "" && priority == 0 && estimate == 0 &&
-				assignee == "" && len(labels) == 0 && projectID == "" && stateID == "" &&
+			if title == "" && description == "" && priority == "" && estimate == "" &&
+				assignee == "" && len(labels) == 0 && projectID == "" && state == "" && stateType == "" &&
 				parentID == "" && dueDate == "" && cycleID == "" && milestoneID == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("At least one field must be provided to update")
+					output.ErrorHuman("MISSING_FIELD", "At least one field must be provided to update")
 					return nil
 				}
 				return output.Error("MISSING_FIELD", "At least one field must be provided to update")
 			}
 
-			ctx := context.Background()
+			priorityValue, err := parsePriority(priority)
+			if priority != "" && err != nil {
+				return priorityError(err)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
+			resolved, err := resolveDateFlags(map[string]*string{"due-date": &dueDate})
+			if err != nil {
+				return dateError(err)
+			}
+
 			// Build input
 			input := api.IssueUpdateInput{
 				Title:              title,
-				Description:        description,
+				Description:        optionalString(description),
 				ProjectID:          projectID,
-				StateID:            stateID,
 				ParentID:           parentID,
 				DueDate:            dueDate,
 				CycleID:            cycleID,
 				ProjectMilestoneID: milestoneID,
 			}
 
-			if priority > 0 {
-				input.Priority = &priority
-			}
-
-			if estimate > 0 {
-				input.Estimate = &estimate
+			if priority != "" {
+				input.Priority = &priorityValue
 			}
 
 			// Handle assignee
@@ -563,7 +1172,7 @@ Examples:
 					viewerID, err := client.GetViewerID(ctx)
 					if err != nil {
 						if IsHumanOutput() {
-							output.ErrorHuman("Failed to get current user: " + err.Error())
+							output.ErrorHuman("API_ERROR", "Failed to get current user: "+err.Error())
 							return nil
 						}
 						return output.Error("API_ERROR", "Failed to get current user: "+err.Error())
@@ -574,18 +1183,50 @@ Examples:
 				}
 			}
 
-			if len(labels) > 0 {
-				input.LabelIDs = labels
+			// State and label names and the estimate scale are per-team,
+			// so they are resolved against each issue's team
+			resolver := newIssueFieldResolver(state, stateType, labels, createMissingLabels, estimate)
+
+			if isBatchArgs(args) {
+				refs, skipped, err := resolveIssueRefs(ctx, client, args)
+				if err != nil {
+					return issueRefsError(err)
+				}
+				return runIssueUpdateBatch(ctx, client, refs, skipped, input, resolver)
+			}
+
+			if resolver.needsTeam() {
+				issue, err := client.GetIssue(ctx, issueID, false)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if issue == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+				}
+				if err := resolver.apply(ctx, client, issue.Team.ID, &input); err != nil {
+					return resolver.error(err)
+				}
+			} else if err := resolver.apply(ctx, client, "", &input); err != nil {
+				return resolver.error(err)
 			}
 
 			result, err := client.UpdateIssue(ctx, issueID, input)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
+			recordIssue(result.ID, result.Identifier, input.Title, result.URL, history.ActionEdited)
 
 			response := map[string]interface{}{
 				"success":   true,
@@ -596,9 +1237,11 @@ Examples:
 					"url":        result.URL,
 				},
 			}
+			resolved.addTo(response)
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Updated issue %s", result.Identifier))
+				resolved.printHuman()
 			} else {
 				output.JSON(response)
 			}
@@ -609,14 +1252,19 @@ Examples:
 
 	cmd.Flags().StringVarP(&title, "title", "T", "", "New issue title")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "New issue description (markdown)")
-	cmd.Flags().IntVarP(&priority, "priority", "p", 0, "New priority (0=none, 1=urgent, 2=high, 3=medium, 4=low)")
-	cmd.Flags().Float64VarP(&estimate, "estimate", "e", 0, "New story points estimate")
+	cmd.Flags().String("description-file", "", "Read the new description from a markdown file (- for stdin)")
+	cmd.Flags().Bool("editor", false, "Edit the current description in $EDITOR")
+	cmd.Flags().StringVarP(&priority, "priority", "p", "", "New priority: "+priorityUsage)
+	cmd.Flags().StringVarP(&estimate, "estimate", "e", "", "New estimate on the team's scale (points, or a T-shirt size)")
 	cmd.Flags().StringVarP(&assignee, "assignee", "a", "", "New assignee (use 'self' for yourself, or user ID)")
-	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Label IDs to apply (replaces existing)")
+	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Label names or IDs to apply (replaces existing)")
+	cmd.Flags().BoolVar(&createMissingLabels, "create-missing-labels", false, "Create labels that don't exist yet")
 	cmd.Flags().StringVar(&projectID, "project", "", "New project ID")
-	cmd.Flags().StringVarP(&stateID, "state", "s", "", "New workflow state ID")
+	cmd.Flags().StringVarP(&state, "state", "s", "", "New workflow state name or ID (e.g., \"In Progress\")")
+	cmd.Flags().StringVar(&stateType, "state-type", "", "New workflow state type (e.g., started, completed); ignored with --state")
 	cmd.Flags().StringVar(&parentID, "parent", "", "New parent issue ID")
-	cmd.Flags().StringVar(&dueDate, "due-date", "", "New due date (YYYY-MM-DD)")
+	markRefFlag(cmd, "parent", resolver.KindIssue)
+	cmd.Flags().StringVar(&dueDate, "due-date", "", "New due date: "+dateFlagHelp)
 	cmd.Flags().StringVar(&cycleID, "cycle", "", "New cycle ID")
 	cmd.Flags().StringVar(&milestoneID, "milestone", "", "New project milestone ID")
 
@@ -625,33 +1273,46 @@ Examples:
 
 func newIssueDeleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delete <issue-id>",
-		Short: "Delete an issue",
-		Long: `Delete (trash) an issue.
+		Use:   "delete <issue-id>...",
+		Short: "Delete one or more issues",
+		Long: `Delete (trash) issues.
+
+Several issues can be deleted at once by listing them or with ranges and
+brace patterns such as ENG-100..ENG-120 or ENG-1{2,3}4. All issues are
+checked to exist before any is deleted; gaps inside a range are skipped.
 
 Examples:
-  linear issue delete ENG-123`,
-		Args: cobra.ExactArgs(1),
+  linear issue delete ENG-123
+  linear issue delete ENG-100..ENG-120`,
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
+			if isBatchArgs(args) {
+				refs, skipped, err := resolveIssueRefs(ctx, client, args)
+				if err != nil {
+					return issueRefsError(err)
+				}
+				return runIssueDeleteBatch(ctx, client, refs, skipped)
+			}
+
 			err = client.DeleteIssue(ctx, issueID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			response := map[string]interface{}{
@@ -694,12 +1355,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			query := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -711,10 +1372,10 @@ Examples:
 				team, err := client.GetTeamByKey(ctx, teamKey)
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHumanFrom(err, "API_ERROR")
 						return nil
 					}
-					return output.Error("API_ERROR", err.Error())
+					return output.ErrorFrom(err, "API_ERROR")
 				}
 				if team != nil {
 					teamID = team.ID
@@ -724,10 +1385,10 @@ Examples:
 			results, err := client.SearchIssues(ctx, query, limit, includeArchived, includeComments, teamID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -785,12 +1446,12 @@ Examples:
 				relationType = "duplicate"
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -799,10 +1460,10 @@ Examples:
 			err = client.CreateIssueRelation(ctx, issueID, relatedID, relationType)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			response := map[string]interface{}{
@@ -844,12 +1505,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			relationID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -858,10 +1519,10 @@ Examples:
 			err = client.DeleteIssueRelation(ctx, relationID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			response := map[string]interface{}{
@@ -894,12 +1555,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -908,10 +1569,10 @@ Examples:
 			issue, err := client.GetIssue(ctx, issueID, false)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			response := map[string]interface{}{
@@ -942,50 +1603,124 @@ func newIssueCommentCmd() *cobra.Command {
 
 	cmd.AddCommand(newIssueCommentCreateCmd())
 	cmd.AddCommand(newIssueCommentListCmd())
+	cmd.AddCommand(newIssueCommentUpdateCmd())
+	cmd.AddCommand(newIssueCommentDeleteCmd())
+	cmd.AddCommand(newIssueCommentReactCmd())
+	cmd.AddCommand(newIssueCommentUnreactCmd())
 
 	return cmd
 }
 
 func newIssueCommentCreateCmd() *cobra.Command {
-	var body string
+	var (
+		body         string
+		templateName string
+		vars         []string
+		parentID     string
+		attach       []string
+	)
 
 	cmd := &cobra.Command{
-		Use:   "create <issue-id>",
+		Use:   "create [issue-id]",
 		Short: "Add a comment to an issue",
 		Long: `Add a comment to an issue.
 
+The body can come from a comment template (see 'linear template'); issue
+fields like {{.Identifier}} and {{.Assignee}} and --var values are
+interpolated into it.
+
+Without an issue ID, the issue is detected from the current git branch.
+
+Use --parent to reply in the thread of an existing comment.
+
+Multi-paragraph bodies are easiest to pass with --body-file, which reads a
+markdown file, or stdin with "-". Each --attach file is uploaded to Linear
+and linked at the end of the comment; images are shown inline.
+
 Examples:
-  linear issue comment create ENG-123 --body "This is a comment"`,
-		Args: cobra.ExactArgs(1),
+  linear issue comment create ENG-123 --body "This is a comment"
+  linear issue comment create ENG-123 --body-file notes.md
+  git log -5 --format='- %s' | linear issue comment create ENG-123 --body-file -
+  linear issue comment create ENG-123 --body "Repro:" --attach ./screenshot.png
+  linear issue comment create ENG-123 --editor
+  linear issue comment create ENG-123 --template deploy-done --var version=1.4.2
+  linear issue comment create ENG-123 --parent <comment-id> --body "Done, thanks!"`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			issueID := args[0]
+			issueID, err := issueArgOrCurrent(cmd.Context(), args)
+			if err != nil {
+				return issueDetectError(err, "linear issue comment create ENG-123 --body \"...\"")
+			}
 
-			if body == "" {
+			if ok, err := applyContentFile(cmd, "body", "body-file"); !ok {
+				return err
+			}
+			if templateName == "" {
+				if ok, err := applyEditor(cmd, "body", nil); !ok {
+					return err
+				}
+			}
+
+			if body != "" && templateName != "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", "--body and --template cannot be used together")
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", "--body and --template cannot be used together")
+			}
+
+			if body == "" && templateName == "" && len(attach) == 0 {
 				if IsHumanOutput() {
-					output.ErrorHuman("Comment body is required. Use --body flag.")
+					output.ErrorHuman("MISSING_BODY", "Comment body is required. Use --body, --body-file, --template or --attach.")
 					return nil
 				}
-				return output.Error("MISSING_BODY", "Comment body is required. Use --body flag.")
+				return output.Error("MISSING_BODY", "Comment body is required. Use --body, --body-file, --template or --attach.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
-			comment, err := client.CreateComment(ctx, issueID, body)
+			if templateName != "" {
+				body, err = renderCommentTemplate(ctx, client, issueID, templateName, vars)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+						return nil
+					}
+					return output.Error("TEMPLATE_ERROR", err.Error())
+				}
+			}
+
+			var uploads []*api.UploadedFile
+			body, uploads, err = appendUploads(ctx, client, body, attach)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "UPLOAD_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "UPLOAD_ERROR")
+			}
+
+			var comment *api.Comment
+			if parentID != "" {
+				comment, err = client.CreateCommentReply(ctx, issueID, parentID, body)
+			} else {
+				comment, err = client.CreateComment(ctx, issueID, body)
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			response := map[string]interface{}{
@@ -993,9 +1728,16 @@ Examples:
 				"operation": "create",
 				"comment":   comment,
 			}
+			if len(uploads) > 0 {
+				response["uploads"] = uploads
+			}
 
 			if IsHumanOutput() {
-				output.SuccessHuman("Comment added")
+				if parentID != "" {
+					output.SuccessHuman("Reply added")
+				} else {
+					output.SuccessHuman("Comment added")
+				}
 			} else {
 				output.JSON(response)
 			}
@@ -1005,30 +1747,74 @@ Examples:
 	}
 
 	cmd.Flags().StringVarP(&body, "body", "b", "", "Comment body (markdown)")
+	cmd.Flags().String("body-file", "", "Read the body from a markdown file (- for stdin)")
+	cmd.Flags().Bool("editor", false, "Write the comment in $EDITOR")
+	cmd.Flags().StringVar(&templateName, "template", "", "Comment template name")
+	cmd.Flags().StringArrayVar(&vars, "var", nil, "Template variable as key=value (repeatable)")
+	cmd.Flags().StringVar(&parentID, "parent", "", "Comment ID to reply to (creates a threaded reply)")
+	cmd.Flags().StringArrayVar(&attach, "attach", nil, "Upload a file and link it in the comment (repeatable)")
 
 	return cmd
 }
 
+// renderCommentTemplate renders a stored comment template for an issue
+func renderCommentTemplate(ctx context.Context, client *api.Client, issueID, name string, pairs []string) (string, error) {
+	vars, err := templates.ParseVars(pairs)
+	if err != nil {
+		return "", err
+	}
+
+	tmpl, err := loadTemplate(templates.KindComment, name)
+	if err != nil {
+		return "", err
+	}
+	if tmpl == nil {
+		return "", fmt.Errorf("comment template '%s' not found", name)
+	}
+
+	issue, err := client.GetIssue(ctx, issueID, false)
+	if err != nil {
+		return "", err
+	}
+	if issue == nil {
+		return "", fmt.Errorf("issue '%s' not found", issueID)
+	}
+
+	return templates.Render(tmpl.Name, tmpl.Body, issueTemplateData(issue, vars))
+}
+
 func newIssueCommentListCmd() *cobra.Command {
-	var limit int
+	var (
+		limit    int
+		threadID string
+	)
 
 	cmd := &cobra.Command{
-		Use:   "list <issue-id>",
+		Use:   "list [issue-id]",
 		Short: "List comments on an issue",
-		Long: `List all comments on an issue.
+		Long: `List all comments on an issue. Without an issue ID, the issue is detected
+from the current git branch.
+
+Replies follow the comment they answer, indented in human output; JSON
+output gives each comment a replyCount. --thread shows only the thread
+holding a comment, from the comment that started it.
 
 Examples:
   linear issue comment list ENG-123
-  linear issue comment list ENG-123 --limit 100`,
-		Args: cobra.ExactArgs(1),
+  linear issue comment list ENG-123 --limit 100
+  linear issue comment list ENG-123 --thread <comment-id> --human`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
+			issueID, err := issueArgOrCurrent(ctx, args)
+			if err != nil {
+				return issueDetectError(err, "linear issue comment list ENG-123")
+			}
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -1037,19 +1823,108 @@ Examples:
 			comments, err := client.GetIssueComments(ctx, issueID, limit)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			threads := threadComments(comments)
+			if threadID != "" {
+				thread := findThread(threads, threadID)
+				if thread == nil {
+					message := fmt.Sprintf("Comment '%s' not found on %s", threadID, issueID)
+					hint := "List the issue's comments for their IDs, or raise --limit"
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint("NOT_FOUND", message, hint)
+						return nil
+					}
+					return output.ErrorWithHint("NOT_FOUND", message, hint)
+				}
+				threads = []*commentThread{thread}
+			}
+
+			items := commentListItems(threads)
+			response := &CommentListResponse{
+				Comments: items,
+				Count:    len(items),
+			}
+
+			if IsHumanOutput() {
+				if len(items) == 0 {
+					output.HumanLn("No comments")
+					return nil
+				}
+				printCommentThreadsHuman(threads)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum number of comments")
+	cmd.Flags().StringVar(&threadID, "thread", "", "Only show the thread holding this comment")
+
+	return cmd
+}
+
+func newIssueCommentUpdateCmd() *cobra.Command {
+	var body string
+
+	cmd := &cobra.Command{
+		Use:   "update <comment-id>",
+		Short: "Edit a comment",
+		Long: `Replace the body of an existing comment.
+
+Comment IDs are shown by 'linear issue comment list'.
+
+Examples:
+  linear issue comment update <comment-id> --body "Updated text"
+  linear issue comment update <comment-id> --body-file notes.md`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commentID := args[0]
+
+			if ok, err := applyContentFile(cmd, "body", "body-file"); !ok {
+				return err
+			}
+
+			if body == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_BODY", "Comment body is required. Use --body or --body-file.")
+					return nil
+				}
+				return output.Error("MISSING_BODY", "Comment body is required. Use --body or --body-file.")
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			comment, err := client.UpdateComment(ctx, commentID, body)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			response := map[string]interface{}{
-				"comments": comments,
-				"count":    len(comments),
+				"success":   true,
+				"operation": "update",
+				"comment":   comment,
 			}
 
 			if IsHumanOutput() {
-				printCommentsHuman(comments)
+				output.SuccessHuman("Comment updated")
 			} else {
 				output.JSON(response)
 			}
@@ -1058,77 +1933,86 @@ Examples:
 		},
 	}
 
-	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum number of comments")
+	cmd.Flags().StringVarP(&body, "body", "b", "", "New comment body (markdown)")
+	cmd.Flags().String("body-file", "", "Read the new body from a markdown file (- for stdin)")
 
 	return cmd
 }
 
-// Human output formatters
+func newIssueCommentDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <comment-id>",
+		Short: "Delete a comment",
+		Long: `Delete a comment.
 
-func printIssuesHuman(response *IssueListResponse, teamKey string) {
-	if len(response.Issues) == 0 {
-		output.HumanLn("No issues found for team %s", teamKey)
-		return
-	}
+Examples:
+  linear issue comment delete <comment-id>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commentID := args[0]
+			ctx := cmd.Context()
 
-	output.HumanLn("Issues for team %s:\n", teamKey)
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
 
-	headers := []string{"", "ID", "TITLE", "LABELS", "E", "A", "STATE", "UPDATED"}
-	rows := make([][]string, len(response.Issues))
+			if err := client.DeleteComment(ctx, commentID); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
 
-	for i, issue := range response.Issues {
-		// Priority icon
-		priorityIcon := display.PriorityIcon(issue.Priority)
+			response := map[string]interface{}{
+				"success":   true,
+				"operation": "delete",
+				"commentId": commentID,
+			}
 
-		// Labels
-		labelNames := make([]string, len(issue.Labels))
-		for j, l := range issue.Labels {
-			labelNames[j] = l.Name
-		}
-		labelsStr := strings.Join(labelNames, ", ")
-		if len(labelsStr) > 20 {
-			labelsStr = labelsStr[:17] + "..."
-		}
+			if IsHumanOutput() {
+				output.SuccessHuman("Comment deleted")
+			} else {
+				output.JSON(response)
+			}
 
-		// Estimate
-		estStr := ""
-		if issue.Estimate != nil {
-			estStr = fmt.Sprintf("%.0f", *issue.Estimate)
-		}
+			return nil
+		},
+	}
 
-		// Assignee
-		assigneeStr := ""
-		if issue.Assignee != nil {
-			assigneeStr = display.Initials(issue.Assignee.DisplayName)
-		}
+	return cmd
+}
 
-		// Time ago
-		updatedAt, _ := time.Parse(time.RFC3339, issue.UpdatedAt)
-		timeAgo := display.TimeAgo(updatedAt)
+// Human output formatters
 
-		rows[i] = []string{
-			priorityIcon,
-			issue.Identifier,
-			display.Truncate(issue.Title, 40),
-			labelsStr,
-			estStr,
-			assigneeStr,
-			issue.State.Name,
-			output.Muted("%s", timeAgo),
-		}
+func printIssuesHuman(response *IssueListResponse, teamKey string, columns []*listColumn[api.IssueListItem]) {
+	if len(response.Issues) == 0 {
+		output.HumanLn("No issues found for team %s", teamKey)
+		return
 	}
 
-	output.TableWithColors(headers, rows)
+	output.HumanLn("Issues for team %s:\n", teamKey)
+	printColumnTable(columns, response.Issues)
 	output.HumanLn("\n%d issues", response.Count)
 }
 
+// printIssueTableHuman prints the issue list table with the default columns
+func printIssueTableHuman(issues []api.IssueListItem) {
+	printColumnTable(defaultColumns(issueColumns, issueDefaultColumns), issues)
+}
+
 func printIssueDetailHuman(issue *api.IssueDetail) {
 	output.HumanLn("%s %s", output.Bold("%s", issue.Identifier), issue.Title)
 	output.HumanLn("%s", issue.URL)
 	output.HumanLn("")
 
 	// Metadata
-	output.HumanLn("%s: %s", output.Bold("Status"), issue.State.Name)
+	output.HumanLn("%s: %s", output.Bold("Status"), output.StateColor(issue.State.Type, issue.State.Name))
 	output.HumanLn("%s: %s", output.Bold("Team"), issue.Team.Name)
 
 	if issue.Assignee != nil {
@@ -1136,7 +2020,7 @@ func printIssueDetailHuman(issue *api.IssueDetail) {
 	}
 
 	if issue.Priority > 0 {
-		output.HumanLn("%s: %s %d", output.Bold("Priority"), display.PriorityIcon(issue.Priority), issue.Priority)
+		output.HumanLn("%s: %s %d", output.Bold("Priority"), output.PriorityColor(issue.Priority, display.PriorityIcon(issue.Priority)), issue.Priority)
 	}
 
 	if issue.Estimate != nil {
@@ -1144,7 +2028,15 @@ func printIssueDetailHuman(issue *api.IssueDetail) {
 	}
 
 	if issue.DueDate != "" {
-		output.HumanLn("%s: %s", output.Bold("Due Date"), issue.DueDate)
+		overdue := 0
+		if issue.State.Type != "completed" && issue.State.Type != "canceled" {
+			overdue = overdueBusinessDays(issue.DueDate)
+		}
+		if overdue > 0 {
+			output.HumanLn("%s: %s %s", output.Bold("Due Date"), display.FormatDueDate(issue.DueDate), output.Red("(overdue by %d business days)", overdue))
+		} else {
+			output.HumanLn("%s: %s", output.Bold("Due Date"), display.FormatDueDate(issue.DueDate))
+		}
 	}
 
 	if issue.Project != nil {
@@ -1197,23 +2089,87 @@ func printIssueDetailHuman(issue *api.IssueDetail) {
 		output.HumanLn("%s", issue.Description)
 	}
 
+	// Attachments
+	if len(issue.Attachments) > 0 {
+		output.HumanLn("")
+		output.HumanLn("%s (%d)", output.Bold("Attachments"), len(issue.Attachments))
+		for _, a := range issue.Attachments {
+			output.HumanLn("  • %s %s", a.Title, output.Muted("%s", a.URL))
+		}
+	}
+
+	// History
+	if len(issue.History) > 0 {
+		output.HumanLn("")
+		output.HumanLn("%s", output.Bold("History"))
+		for _, entry := range issue.History {
+			changes := issueHistoryChanges(entry)
+			if len(changes) == 0 {
+				continue
+			}
+			actor := "Someone"
+			if entry.Actor != "" {
+				actor = entry.Actor
+			}
+			createdAt, _ := time.Parse(time.RFC3339, entry.CreatedAt)
+			output.HumanLn("  %s %s %s", output.Muted("%s", display.TimeAgo(createdAt)), actor, strings.Join(changes, "; "))
+		}
+	}
+
 	// Comments
 	if len(issue.Comments) > 0 {
 		output.HumanLn("")
 		output.HumanLn("%s (%d)", output.Bold("Comments"), len(issue.Comments))
-		for _, comment := range issue.Comments {
-			author := "Unknown"
-			if comment.User != nil {
-				author = comment.User.DisplayName
-			}
-			createdAt, _ := time.Parse(time.RFC3339, comment.CreatedAt)
-			output.HumanLn("")
-			output.HumanLn("@%s commented %s", author, display.TimeAgo(createdAt))
-			output.HumanLn("%s", comment.Body)
+		for _, thread := range threadComments(issue.Comments) {
+			thread.walk(0, func(t *commentThread, depth int) {
+				comment := t.comment
+				indent := strings.Repeat("    ", depth)
+				author := "Unknown"
+				if comment.User != nil {
+					author = comment.User.DisplayName
+				}
+				verb := "commented"
+				if comment.Parent != nil {
+					verb = "replied"
+				}
+				createdAt, _ := time.Parse(time.RFC3339, comment.CreatedAt)
+				output.HumanLn("")
+				output.HumanLn("%s@%s %s %s", indent, author, verb, display.TimeAgo(createdAt))
+				output.HumanLn("%s%s", indent, strings.ReplaceAll(comment.Body, "\n", "\n"+indent))
+			})
 		}
 	}
 }
 
+// issueHistoryChanges describes what a history entry changed
+func issueHistoryChanges(entry api.IssueHistoryEntry) []string {
+	var changes []string
+	if entry.ToState != "" {
+		changes = append(changes, fmt.Sprintf("moved %s → %s", dashIfEmpty(entry.FromState), entry.ToState))
+	}
+	if entry.ToAssignee != "" {
+		changes = append(changes, "assigned "+entry.ToAssignee)
+	} else if entry.FromAssignee != "" {
+		changes = append(changes, "unassigned "+entry.FromAssignee)
+	}
+	if entry.FromPriority != nil && entry.ToPriority != nil {
+		changes = append(changes, fmt.Sprintf("priority %d → %d", *entry.FromPriority, *entry.ToPriority))
+	}
+	if entry.ToTitle != "" {
+		changes = append(changes, fmt.Sprintf("renamed to %q", entry.ToTitle))
+	}
+	if len(entry.AddedLabels) > 0 {
+		changes = append(changes, "added "+strings.Join(entry.AddedLabels, ", "))
+	}
+	if len(entry.RemovedLabels) > 0 {
+		changes = append(changes, "removed "+strings.Join(entry.RemovedLabels, ", "))
+	}
+	if entry.UpdatedDescription {
+		changes = append(changes, "edited the description")
+	}
+	return changes
+}
+
 func printSearchResultsHuman(results *api.SearchIssuesResponse) {
 	if len(results.Issues) == 0 {
 		output.HumanLn("No issues found matching '%s'", results.Query)
@@ -1280,18 +2236,7 @@ func printCommentsHuman(comments []api.Comment) {
 		return
 	}
 
-	for _, comment := range comments {
-		author := "Unknown"
-		if comment.User != nil {
-			author = comment.User.DisplayName
-		}
-		createdAt, _ := time.Parse(time.RFC3339, comment.CreatedAt)
-		output.HumanLn("@%s commented %s", author, display.TimeAgo(createdAt))
-		output.HumanLn("%s", comment.Body)
-		output.HumanLn("")
-	}
-
-	output.HumanLn("%d comments", len(comments))
+	printCommentThreadsHuman(threadComments(comments))
 }
 
 // Attachment commands
@@ -1306,6 +2251,7 @@ func newIssueAttachmentCmd() *cobra.Command {
 	cmd.AddCommand(newIssueAttachmentCreateCmd())
 	cmd.AddCommand(newIssueAttachmentListCmd())
 	cmd.AddCommand(newIssueAttachmentDeleteCmd())
+	cmd.AddCommand(newIssueAttachmentDownloadCmd())
 
 	return cmd
 }
@@ -1333,7 +2279,7 @@ Examples:
 
 			if title == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Attachment title is required. Use --title flag.")
+					output.ErrorHuman("MISSING_TITLE", "Attachment title is required. Use --title flag.")
 					return nil
 				}
 				return output.Error("MISSING_TITLE", "Attachment title is required. Use --title flag.")
@@ -1341,18 +2287,18 @@ Examples:
 
 			if url == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Attachment URL is required. Use --url flag.")
+					output.ErrorHuman("MISSING_URL", "Attachment URL is required. Use --url flag.")
 					return nil
 				}
 				return output.Error("MISSING_URL", "Attachment URL is required. Use --url flag.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -1366,10 +2312,10 @@ Examples:
 			attachment, err := client.CreateAttachment(ctx, issueID, title, url, subtitlePtr)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			response := map[string]interface{}{
@@ -1406,12 +2352,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -1420,10 +2366,10 @@ Examples:
 			attachments, err := client.GetIssueAttachments(ctx, issueID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -1452,12 +2398,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			attachmentID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -1466,10 +2412,10 @@ Examples:
 			err = client.DeleteAttachment(ctx, attachmentID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -1518,7 +2464,7 @@ func printAttachmentsHuman(attachments *api.AttachmentsResponse, issueID string)
 
 func newIssueStartCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "start <issue-id>",
+		Use:   "start [issue-id]",
 		Short: "Start working on an issue",
 		Long: `Mark an issue as started and optionally create a git branch.
 
@@ -1527,65 +2473,52 @@ This command:
   2. Assigns the issue to you if unassigned
   3. Prints the suggested branch name
 
+Without an issue ID, the issue is detected from the current git branch.
+Use 'linear branch create' to create the branch itself.
+
 Examples:
   linear issue start ENG-123
   linear issue start ENG-123 --human`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			issueID := args[0]
-			ctx := context.Background()
-
-			client, err := api.NewClient(ctx)
+			ctx := cmd.Context()
+			issueID, err := issueArgOrCurrent(ctx, args)
 			if err != nil {
-				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
-					return nil
-				}
-				return output.Error("AUTH_ERROR", err.Error())
+				return issueDetectError(err, "linear issue start ENG-123")
 			}
 
-			// Get current user for assignment
-			viewer, err := client.GetViewer(ctx)
+			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.Error("AUTH_ERROR", err.Error())
 			}
 
-			// Get the issue first to find the "started" state
-			issue, err := client.GetIssue(ctx, issueID, false)
+			// Fetch the viewer, the issue and its team's states in one request
+			work, err := client.GetIssueWorkContext(ctx, issueID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
-			if issue == nil {
+			issue := work.Issue
+			if issue == nil || issue.ID == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Issue '%s' not found", issueID))
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
 					return nil
 				}
 				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
 			}
 
-			// Get workflow states for the team
-			states, err := client.GetWorkflowStates(ctx, issue.Team.ID)
-			if err != nil {
-				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
-					return nil
-				}
-				return output.Error("API_ERROR", err.Error())
-			}
-
 			// Find a "started" state
 			var startedStateID string
 			var startedStateName string
-			for _, s := range states.WorkflowStates {
+			for _, s := range work.States {
 				if s.Type == "started" {
 					startedStateID = s.ID
 					startedStateName = s.Name
@@ -1595,7 +2528,7 @@ Examples:
 
 			if startedStateID == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("No 'started' state found for this team")
+					output.ErrorHuman("NO_STARTED_STATE", "No 'started' state found for this team")
 					return nil
 				}
 				return output.Error("NO_STARTED_STATE", "No 'started' state found for this team")
@@ -1608,16 +2541,16 @@ Examples:
 
 			// Assign to current user if unassigned
 			if issue.Assignee == nil {
-				updateInput.AssigneeID = viewer.Viewer.ID
+				updateInput.AssigneeID = work.Viewer.ID
 			}
 
 			result, err := client.UpdateIssue(ctx, issue.ID, updateInput)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			// Generate branch name
@@ -1627,7 +2560,7 @@ Examples:
 				output.SuccessHuman(fmt.Sprintf("Started %s: %s", result.Identifier, issue.Title))
 				output.HumanLn("")
 				output.HumanLn("State: %s", startedStateName)
-				output.HumanLn("Assignee: %s", viewer.Viewer.DisplayName)
+				output.HumanLn("Assignee: %s", work.Viewer.DisplayName)
 				output.HumanLn("")
 				output.HumanLn("Suggested branch:")
 				output.HumanLn("  git checkout -b %s", branchName)
@@ -1638,7 +2571,7 @@ Examples:
 					"identifier": result.Identifier,
 					"title":      issue.Title,
 					"state":      startedStateName,
-					"assignee":   viewer.Viewer.DisplayName,
+					"assignee":   work.Viewer.DisplayName,
 					"branchName": branchName,
 					"url":        result.URL,
 				})
@@ -1665,7 +2598,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1674,7 +2607,7 @@ Examples:
 
 			issue, err := client.GetIssue(ctx, issueID, false)
 			if err != nil {
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if issue == nil {
@@ -1691,20 +2624,24 @@ Examples:
 }
 
 func newIssueURLCmd() *cobra.Command {
+	var showQR bool
+
 	cmd := &cobra.Command{
 		Use:   "url <issue-id>",
 		Short: "Get issue URL",
 		Long: `Print the URL of an issue.
 
-Useful for scripts and sharing.
+Useful for scripts and sharing. With --qr, a QR code of the URL is printed
+above it so it can be scanned from a phone.
 
 Examples:
   linear issue url ENG-123
+  linear issue url ENG-123 --qr
   open $(linear issue url ENG-123)`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1713,22 +2650,42 @@ Examples:
 
 			issue, err := client.GetIssue(ctx, issueID, false)
 			if err != nil {
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if issue == nil {
 				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
 			}
 
+			if showQR {
+				if err := writeQR(os.Stdout, issue.URL); err != nil {
+					return output.Error("QR_ERROR", err.Error())
+				}
+				fmt.Println(issue.URL)
+				return nil
+			}
+
 			// Print without newline for scripting
 			fmt.Print(issue.URL)
 			return nil
 		},
 	}
 
+	cmd.Flags().BoolVar(&showQR, "qr", false, "Also print a terminal QR code of the URL")
+
 	return cmd
 }
 
+// writeQR writes a terminal QR code of url to w
+func writeQR(w io.Writer, url string) error {
+	qr, err := display.QR(url)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, qr)
+	return err
+}
+
 func newIssueDescribeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "describe <issue-id>",
@@ -1743,7 +2700,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1752,7 +2709,7 @@ Examples:
 
 			issue, err := client.GetIssue(ctx, issueID, false)
 			if err != nil {
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if issue == nil {
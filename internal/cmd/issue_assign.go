@@ -0,0 +1,303 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// IssueAssignResponse is the response for the assign, unassign and claim
+// commands
+type IssueAssignResponse struct {
+	Success   bool   `json:"success"`
+	Operation string `json:"operation"`
+	Issue     struct {
+		ID         string `json:"id"`
+		Identifier string `json:"identifier"`
+		Title      string `json:"title"`
+		URL        string `json:"url"`
+	} `json:"issue"`
+	// Assignee is nil after unassign
+	Assignee *api.IssueAssignee `json:"assignee"`
+	// Unchanged is set when claiming an issue already assigned to you, so
+	// nothing was updated
+	Unchanged bool `json:"unchanged,omitempty"`
+}
+
+func newIssueAssignResponse(operation string, issue *api.IssueAssignment) *IssueAssignResponse {
+	resp := &IssueAssignResponse{Success: true, Operation: operation, Assignee: issue.Assignee}
+	resp.Issue.ID = issue.ID
+	resp.Issue.Identifier = issue.Identifier
+	resp.Issue.Title = issue.Title
+	resp.Issue.URL = issue.URL
+	return resp
+}
+
+// printHuman prints the outcome of an assignment command
+func (r *IssueAssignResponse) printHuman() {
+	switch {
+	case r.Assignee == nil:
+		output.SuccessHuman(fmt.Sprintf("Unassigned %s", r.Issue.Identifier))
+	case r.Unchanged:
+		output.HumanLn("%s is already assigned to %s", r.Issue.Identifier, r.Assignee.DisplayName)
+	case r.Operation == "claim":
+		output.SuccessHuman(fmt.Sprintf("Claimed %s: %s", r.Issue.Identifier, r.Issue.Title))
+	default:
+		output.SuccessHuman(fmt.Sprintf("Assigned %s to %s", r.Issue.Identifier, r.Assignee.DisplayName))
+	}
+}
+
+func newIssueAssignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assign <issue-id> [user]",
+		Short: "Assign an issue to yourself or another user",
+		Long: `Assign an issue. The user is a name, display name, email, user ID or
+"me", and defaults to you. Any current assignee is replaced; use
+'linear issue claim' to only take issues nobody has.
+
+Examples:
+  linear issue assign ENG-123
+  linear issue assign ENG-123 alice
+  linear issue assign ENG-123 bob@example.com --human`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			user := "me"
+			if len(args) == 2 {
+				user = args[1]
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			userID, err := resolveUserID(ctx, client, user)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "INVALID_USER")
+					return nil
+				}
+				return output.ErrorFrom(err, "INVALID_USER")
+			}
+
+			issue, err := client.SetIssueAssignee(ctx, args[0], userID)
+			if err != nil {
+				return assignError(err)
+			}
+			recordIssue(issue.ID, issue.Identifier, issue.Title, issue.URL, history.ActionEdited)
+
+			resp := newIssueAssignResponse("assign", issue)
+			if IsHumanOutput() {
+				resp.printHuman()
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	return cmd
+}
+
+func newIssueUnassignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unassign [issue-id]",
+		Short: "Remove an issue's assignee",
+		Long: `Remove an issue's assignee, leaving it for anyone to claim.
+
+Without an issue ID, the issue is detected from the current git branch.
+
+Examples:
+  linear issue unassign ENG-123
+  linear issue unassign`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			issueID, err := issueArgOrCurrent(ctx, args)
+			if err != nil {
+				return issueDetectError(err, "linear issue unassign ENG-123")
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			issue, err := client.SetIssueAssignee(ctx, issueID, "")
+			if err != nil {
+				return assignError(err)
+			}
+			recordIssue(issue.ID, issue.Identifier, issue.Title, issue.URL, history.ActionEdited)
+
+			resp := newIssueAssignResponse("unassign", issue)
+			if IsHumanOutput() {
+				resp.printHuman()
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	return cmd
+}
+
+func newIssueClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [issue-id]",
+		Short: "Assign an issue to yourself if nobody has it",
+		Long: `Assign an issue to yourself, but only if it is unassigned, so several
+people or agents picking work from the same queue don't take the same issue.
+
+Linear has no conditional update, so a claim is not atomic. claim checks
+the assignee, assigns you, waits two seconds for racing claims to land,
+and reads the assignee back: if someone else was assigned by then, it
+fails with CONFLICT (exit status 6) and the issue stays theirs. A racing
+claim whose assignment lands after that read-back still wins without this
+claim noticing, so two claims can both succeed when one is delayed by
+more than the wait. Claiming an issue already assigned to you succeeds
+without changing it.
+
+Without an issue ID, the issue is detected from the current git branch.
+
+Examples:
+  linear issue claim ENG-123
+  linear issue claim ENG-123 || linear issue list --team ENG --unassigned`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			issueID, err := issueArgOrCurrent(ctx, args)
+			if err != nil {
+				return issueDetectError(err, "linear issue claim ENG-123")
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			resp, err := claimIssue(ctx, client, issueID)
+			if err != nil {
+				return assignError(err)
+			}
+
+			if IsHumanOutput() {
+				resp.printHuman()
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	return cmd
+}
+
+// ClaimConflictError reports an issue assigned to someone else, before or
+// during a claim
+type ClaimConflictError struct {
+	Issue    string
+	Assignee string
+	// Raced is set when the issue was unassigned when checked, but someone
+	// else was assigned by the time the claim was read back
+	Raced bool
+}
+
+func (e *ClaimConflictError) Error() string {
+	if e.Raced {
+		return fmt.Sprintf("%s was claimed by %s at the same time", e.Issue, e.Assignee)
+	}
+	return fmt.Sprintf("%s is already assigned to %s", e.Issue, e.Assignee)
+}
+
+// Hint suggests taking the issue over anyway
+func (e *ClaimConflictError) Hint() string {
+	return fmt.Sprintf("Pick another issue, or take this one over with 'linear issue assign %s'", e.Issue)
+}
+
+// assignError reports an error from an assignment command
+func assignError(err error) error {
+	var conflict *ClaimConflictError
+	if errors.As(err, &conflict) {
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("CONFLICT", conflict.Error(), conflict.Hint())
+			return nil
+		}
+		return output.ErrorWithHint("CONFLICT", conflict.Error(), conflict.Hint())
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil
+	}
+	return output.ErrorFrom(err, "API_ERROR")
+}
+
+// claimSettleDelay is how long claim waits after assigning before it
+// reads the assignee back
+const claimSettleDelay = 2 * time.Second
+
+// claimIssue assigns issueID to the viewer unless someone else has it
+func claimIssue(ctx context.Context, client *api.Client, issueID string) (*IssueAssignResponse, error) {
+	viewerID, err := client.GetViewerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := client.GetIssueAssignment(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Assignee != nil {
+		if issue.Assignee.ID != viewerID {
+			return nil, &ClaimConflictError{Issue: issue.Identifier, Assignee: issue.Assignee.DisplayName}
+		}
+		resp := newIssueAssignResponse("claim", issue)
+		resp.Unchanged = true
+		return resp, nil
+	}
+
+	if _, err := client.SetIssueAssignee(ctx, issue.ID, viewerID); err != nil {
+		return nil, err
+	}
+
+	// A claim racing ours may have landed after the check; whoever was
+	// assigned last holds the issue. Waiting before reading back lets a
+	// racing assignment sent at about the same time land first, so that
+	// both claims see the same winner. One landing later still goes
+	// unnoticed.
+	select {
+	case <-time.After(claimSettleDelay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	claimed, err := client.GetIssueAssignment(ctx, issue.ID)
+	if err != nil {
+		return nil, err
+	}
+	if claimed.Assignee == nil || claimed.Assignee.ID != viewerID {
+		assignee := "nobody"
+		if claimed.Assignee != nil {
+			assignee = claimed.Assignee.DisplayName
+		}
+		return nil, &ClaimConflictError{Issue: claimed.Identifier, Assignee: assignee, Raced: true}
+	}
+	recordIssue(claimed.ID, claimed.Identifier, claimed.Title, claimed.URL, history.ActionEdited)
+
+	return newIssueAssignResponse("claim", claimed), nil
+}
@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/vcs"
+	"github.com/spf13/cobra"
+)
+
+// reviewStateName is the state --review moves an issue to
+const reviewStateName = "In Review"
+
+func newIssueAttachPRCmd() *cobra.Command {
+	var (
+		title  string
+		state  string
+		review bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "attach-pr [issue-id] [pr-url]",
+		Short: "Link a GitHub pull request or GitLab merge request to an issue",
+		Long: `Attach a pull request (or merge request) to an issue, titled with the
+PR's title.
+
+Without a PR URL, the open PR for the current branch is found with the gh
+CLI, or glab when origin is on GitLab. Without an issue ID, the issue is
+detected from the current git branch. Titles of PRs given by URL are looked
+up with the same CLIs when installed, and otherwise default to "PR #42".
+
+--review moves the issue to "In Review"; --state moves it to any other
+workflow state.
+
+Examples:
+  linear issue attach-pr                      # current branch's issue and PR
+  linear issue attach-pr ENG-123 https://github.com/org/repo/pull/42
+  linear issue attach-pr ENG-123 --review
+  linear issue attach-pr https://gitlab.com/org/repo/-/merge_requests/7 --state "Code Review"`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if review && state != "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", "--review and --state cannot be used together")
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", "--review and --state cannot be used together")
+			}
+			if review {
+				state = reviewStateName
+			}
+
+			var prURL string
+			var issueArgs []string
+			for _, arg := range args {
+				if strings.HasPrefix(arg, "http://") || strings.HasPrefix(arg, "https://") {
+					prURL = arg
+				} else {
+					issueArgs = append(issueArgs, arg)
+				}
+			}
+			if len(issueArgs) > 1 {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_ARGS", "Expected one issue ID and one PR URL")
+					return nil
+				}
+				return output.Error("INVALID_ARGS", "Expected one issue ID and one PR URL")
+			}
+
+			issueID, err := issueArgOrCurrent(ctx, issueArgs)
+			if err != nil {
+				return issueDetectError(err, "linear issue attach-pr ENG-123 https://github.com/org/repo/pull/42")
+			}
+
+			pr, err := findPullRequest(ctx, prURL)
+			if err != nil {
+				code := "PR_NOT_FOUND"
+				if prURL != "" {
+					code = "INVALID_PR_URL"
+				}
+				hint := "Pass the PR URL, or install and authenticate gh (GitHub) or glab (GitLab)"
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(code, err.Error(), hint)
+					return nil
+				}
+				return output.ErrorWithHint(code, err.Error(), hint)
+			}
+			if title != "" {
+				pr.Title = title
+			}
+			if pr.Title == "" {
+				pr.Title = fmt.Sprintf("PR #%d", pr.Number)
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			issue, err := client.GetIssue(ctx, issueID, false)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			var target *api.WorkflowState
+			if state != "" {
+				states, err := teamWorkflowStates(ctx, client, issue.Team.ID)
+				if err != nil {
+					return stateError(err)
+				}
+				if target, err = resolveWorkflowState(states.WorkflowStates, state, ""); err != nil {
+					return stateError(err)
+				}
+			}
+
+			subtitle := pullRequestSubtitle(pr)
+			attachment, err := client.CreateAttachment(ctx, issue.ID, pr.Title, pr.URL, &subtitle)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			moved := target != nil && target.ID != issue.State.ID
+			if moved {
+				if _, err := client.UpdateIssue(ctx, issue.ID, api.IssueUpdateInput{StateID: target.ID}); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Attached %s to %s", pr.Title, issue.Identifier))
+				if moved {
+					output.HumanLn("  %s → %s", issue.State.Name, output.Green("%s", target.Name))
+				}
+				return nil
+			}
+
+			response := map[string]interface{}{
+				"success":     true,
+				"operation":   "attach-pr",
+				"issue":       issue.Identifier,
+				"pullRequest": pr,
+				"attachment":  attachment,
+			}
+			if moved {
+				response["state"] = map[string]string{"from": issue.State.Name, "to": target.Name}
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().StringVarP(&title, "title", "t", "", "Attachment title (default: the PR title)")
+	cmd.Flags().BoolVar(&review, "review", false, "Move the issue to \""+reviewStateName+"\"")
+	cmd.Flags().StringVarP(&state, "state", "s", "", "Move the issue to this workflow state")
+
+	return cmd
+}
+
+// findPullRequest resolves a PR URL, or the current branch's PR when url is
+// empty. Lookup failures for a valid URL are not fatal: the attachment just
+// goes without the PR title.
+func findPullRequest(ctx context.Context, url string) (*vcs.PullRequest, error) {
+	if url == "" {
+		return vcs.CurrentPullRequest(ctx)
+	}
+	pr, ok := vcs.ParsePullRequestURL(url)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a GitHub pull request or GitLab merge request URL", url)
+	}
+	if found, err := vcs.LookupPullRequest(ctx, pr.Provider, url); err == nil {
+		return found, nil
+	} else if errors.Is(err, vcs.ErrNoPullRequest) {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// pullRequestSubtitle describes a PR for the attachment subtitle, such as
+// "#42 · open"
+func pullRequestSubtitle(pr *vcs.PullRequest) string {
+	parts := []string{}
+	if pr.Number > 0 {
+		prefix := "#"
+		if pr.Provider == "gitlab" {
+			prefix = "!"
+		}
+		parts = append(parts, fmt.Sprintf("%s%d", prefix, pr.Number))
+	}
+	if pr.State != "" {
+		parts = append(parts, pr.State)
+	}
+	return strings.Join(parts, " · ")
+}
@@ -0,0 +1,347 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/identifiers"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// BatchIssueResult is the outcome of a batch operation on one issue
+type BatchIssueResult struct {
+	ID         string `json:"id,omitempty"`
+	Identifier string `json:"identifier"`
+	URL        string `json:"url,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// BatchResponse is the response for batch issue operations
+type BatchResponse struct {
+	Success   bool               `json:"success"`
+	Operation string             `json:"operation"`
+	Issues    []BatchIssueResult `json:"issues"`
+	Failed    []BatchIssueResult `json:"failed"`
+	Skipped   []string           `json:"skipped"`
+	Count     int                `json:"count"`
+}
+
+// errInvalidIdentifiers wraps identifier pattern expansion errors
+var errInvalidIdentifiers = errors.New("invalid identifiers")
+
+// MissingIssuesError lists explicitly named issues that do not exist
+type MissingIssuesError struct {
+	Identifiers []string
+}
+
+func (e *MissingIssuesError) Error() string {
+	return fmt.Sprintf("Issues not found: %s", strings.Join(e.Identifiers, ", "))
+}
+
+// isBatchArgs reports whether issue arguments name more than one issue
+func isBatchArgs(args []string) bool {
+	return len(args) > 1 || (len(args) == 1 && identifiers.IsPattern(args[0]))
+}
+
+// resolveIssueRefs expands identifier ranges and brace patterns, then checks
+// that the issues exist before anything is modified. Issues missing from a
+// pattern are gaps and returned as skipped; explicitly named issues that
+// are missing fail with a MissingIssuesError. Arguments that are not
+// TEAM-123 identifiers (such as UUIDs) are passed through unverified.
+func resolveIssueRefs(ctx context.Context, client *api.Client, args []string) ([]api.IssueRef, []string, error) {
+	type target struct {
+		id        string
+		parsed    identifiers.Identifier
+		ok        bool
+		fromRange bool
+	}
+
+	targets := []target{}
+	seen := map[string]bool{}
+	for _, arg := range args {
+		expanded, err := identifiers.Expand([]string{arg})
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", errInvalidIdentifiers, err)
+		}
+		for _, id := range expanded {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			parsed, ok := identifiers.Parse(id)
+			targets = append(targets, target{id: id, parsed: parsed, ok: ok, fromRange: identifiers.IsPattern(arg)})
+		}
+	}
+	if len(targets) > identifiers.MaxExpanded {
+		return nil, nil, fmt.Errorf("%w: arguments expand to more than %d issues", errInvalidIdentifiers, identifiers.MaxExpanded)
+	}
+
+	// Look up existing issues one team at a time
+	numbersByTeam := map[string][]int{}
+	for _, t := range targets {
+		if t.ok {
+			numbersByTeam[t.parsed.TeamKey] = append(numbersByTeam[t.parsed.TeamKey], t.parsed.Number)
+		}
+	}
+	found := map[string]api.IssueRef{}
+	for teamKey, numbers := range numbersByTeam {
+		refs, err := client.GetIssuesByNumbers(ctx, teamKey, numbers)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, ref := range refs {
+			found[strings.ToUpper(ref.Identifier)] = ref
+		}
+	}
+
+	refs := []api.IssueRef{}
+	skipped := []string{}
+	missing := []string{}
+	for _, t := range targets {
+		if !t.ok {
+			refs = append(refs, api.IssueRef{ID: t.id, Identifier: t.id})
+			continue
+		}
+		ref, exists := found[t.parsed.String()]
+		switch {
+		case exists:
+			refs = append(refs, ref)
+		case t.fromRange:
+			skipped = append(skipped, t.id)
+		default:
+			missing = append(missing, t.id)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, nil, &MissingIssuesError{Identifiers: missing}
+	}
+	return refs, skipped, nil
+}
+
+// issueRefsError reports a failure to resolve issue arguments
+func issueRefsError(err error) error {
+	code := "API_ERROR"
+	var missingErr *MissingIssuesError
+	if errors.As(err, &missingErr) {
+		code = "NOT_FOUND"
+	} else if errors.Is(err, errInvalidIdentifiers) {
+		code = "INVALID_IDENTIFIER"
+	}
+
+	if IsHumanOutput() {
+		output.ErrorHuman(code, err.Error())
+		return nil
+	}
+	return output.Error(code, err.Error())
+}
+
+// printBatchHuman prints the outcome of a batch operation
+func printBatchHuman(resp *BatchResponse, verb string) {
+	for _, r := range resp.Issues {
+		output.HumanLn("%s %s %s", output.Green("✓"), verb, r.Identifier)
+	}
+	for _, r := range resp.Failed {
+		output.HumanLn("%s %s: %s", output.Red("✗"), r.Identifier, r.Error)
+	}
+	if len(resp.Skipped) > 0 {
+		output.HumanLn("%s", output.Muted("Skipped (not found): %s", strings.Join(resp.Skipped, ", ")))
+	}
+	output.HumanLn("\n%d %s, %d failed", len(resp.Issues), strings.ToLower(verb), len(resp.Failed))
+}
+
+// optionalString returns nil for an empty string, for update inputs
+// where an empty value means "leave unchanged"
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// issueFieldResolver resolves --state, --state-type, --label and
+// --estimate values, which are scoped to a team, once per team
+type issueFieldResolver struct {
+	state         string
+	stateType     string
+	labels        []string
+	createMissing bool
+	estimate      string
+	stateIDs      map[string]string
+	labelIDs      map[string][]string
+	estimates     map[string]float64
+}
+
+func newIssueFieldResolver(state, stateType string, labels []string, createMissing bool, estimate string) *issueFieldResolver {
+	return &issueFieldResolver{
+		state:         state,
+		stateType:     stateType,
+		labels:        labels,
+		createMissing: createMissing,
+		estimate:      estimate,
+		stateIDs:      map[string]string{},
+		labelIDs:      map[string][]string{},
+		estimates:     map[string]float64{},
+	}
+}
+
+// needsTeam reports whether any value is a name that needs the issue's
+// team, or an estimate to check against the team's scale
+func (r *issueFieldResolver) needsTeam() bool {
+	if r.stateType != "" || (r.state != "" && !isUUID(r.state)) || r.estimate != "" {
+		return true
+	}
+	for _, l := range r.labels {
+		if !isUUID(l) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply sets the resolved state and label IDs for a team on input
+func (r *issueFieldResolver) apply(ctx context.Context, client *api.Client, teamID string, input *api.IssueUpdateInput) error {
+	if r.state != "" || r.stateType != "" {
+		stateID, ok := r.stateIDs[teamID]
+		if !ok {
+			var err error
+			stateID, err = resolveStateID(ctx, client, teamID, r.state, r.stateType)
+			if err != nil {
+				return err
+			}
+			r.stateIDs[teamID] = stateID
+		}
+		input.StateID = stateID
+	}
+
+	if len(r.labels) > 0 {
+		labelIDs, ok := r.labelIDs[teamID]
+		if !ok {
+			var err error
+			labelIDs, err = resolveLabelIDs(ctx, client, teamID, r.labels, r.createMissing)
+			if err != nil {
+				return err
+			}
+			r.labelIDs[teamID] = labelIDs
+		}
+		input.LabelIDs = labelIDs
+	}
+
+	if r.estimate != "" {
+		estimate, ok := r.estimates[teamID]
+		if !ok {
+			var err error
+			estimate, err = resolveEstimate(ctx, client, teamID, r.estimate)
+			if err != nil {
+				return err
+			}
+			r.estimates[teamID] = estimate
+		}
+		input.Estimate = &estimate
+	}
+
+	return nil
+}
+
+// error reports a resolution failure with the matching error code
+func (r *issueFieldResolver) error(err error) error {
+	var labelErr *LabelResolveError
+	if errors.As(err, &labelErr) {
+		return labelError(err)
+	}
+	var estimateErr *EstimateError
+	if errors.As(err, &estimateErr) {
+		return estimateError(err)
+	}
+	return stateError(err)
+}
+
+// runIssueUpdateBatch applies the same update to every issue. State and
+// label names are resolved once per team.
+func runIssueUpdateBatch(ctx context.Context, client *api.Client, refs []api.IssueRef, skipped []string, input api.IssueUpdateInput, resolver *issueFieldResolver) error {
+	resp := &BatchResponse{
+		Operation: "update",
+		Issues:    []BatchIssueResult{},
+		Failed:    []BatchIssueResult{},
+		Skipped:   skipped,
+	}
+
+	progress := output.NewProgress("Updating", len(refs))
+	for _, ref := range refs {
+		progress.Add(1)
+		issueInput := input
+
+		teamID := ref.Team.ID
+		if teamID == "" && resolver.needsTeam() {
+			issue, err := client.GetIssue(ctx, ref.ID, false)
+			if err != nil || issue == nil {
+				resp.Failed = append(resp.Failed, BatchIssueResult{Identifier: ref.Identifier, Error: "issue not found"})
+				continue
+			}
+			teamID = issue.Team.ID
+		}
+
+		if err := resolver.apply(ctx, client, teamID, &issueInput); err != nil {
+			resp.Failed = append(resp.Failed, BatchIssueResult{Identifier: ref.Identifier, Error: err.Error()})
+			continue
+		}
+
+		result, err := client.UpdateIssue(ctx, ref.ID, issueInput)
+		if err != nil {
+			resp.Failed = append(resp.Failed, BatchIssueResult{Identifier: ref.Identifier, Error: err.Error()})
+			continue
+		}
+		resp.Issues = append(resp.Issues, BatchIssueResult{ID: result.ID, Identifier: result.Identifier, URL: result.URL})
+	}
+	progress.Done()
+
+	resp.Count = len(resp.Issues)
+	resp.Success = len(resp.Failed) == 0
+	if !resp.Success {
+		output.Fail("UPDATE_FAILED")
+	}
+
+	if IsHumanOutput() {
+		printBatchHuman(resp, "Updated")
+	} else {
+		output.JSON(resp)
+	}
+	return nil
+}
+
+// runIssueDeleteBatch deletes every issue
+func runIssueDeleteBatch(ctx context.Context, client *api.Client, refs []api.IssueRef, skipped []string) error {
+	resp := &BatchResponse{
+		Operation: "delete",
+		Issues:    []BatchIssueResult{},
+		Failed:    []BatchIssueResult{},
+		Skipped:   skipped,
+	}
+
+	progress := output.NewProgress("Deleting", len(refs))
+	for _, ref := range refs {
+		progress.Add(1)
+		if err := client.DeleteIssue(ctx, ref.ID); err != nil {
+			resp.Failed = append(resp.Failed, BatchIssueResult{Identifier: ref.Identifier, Error: err.Error()})
+			continue
+		}
+		resp.Issues = append(resp.Issues, BatchIssueResult{ID: ref.ID, Identifier: ref.Identifier})
+	}
+	progress.Done()
+
+	resp.Count = len(resp.Issues)
+	resp.Success = len(resp.Failed) == 0
+	if !resp.Success {
+		output.Fail("DELETE_FAILED")
+	}
+
+	if IsHumanOutput() {
+		printBatchHuman(resp, "Deleted")
+	} else {
+		output.JSON(resp)
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// IssueTransitionResponse is the response for the close and reopen
+// commands
+type IssueTransitionResponse struct {
+	Success       bool           `json:"success"`
+	Operation     string         `json:"operation"`
+	Identifier    string         `json:"identifier"`
+	Title         string         `json:"title"`
+	URL           string         `json:"url"`
+	State         api.IssueState `json:"state"`
+	PreviousState api.IssueState `json:"previousState"`
+	// Unchanged is set when the issue was already closed, or already open,
+	// so nothing was updated
+	Unchanged bool `json:"unchanged,omitempty"`
+}
+
+// issueTransition describes closing or reopening an issue: the state
+// types it may move the issue to, and the ones it picks without --state,
+// in order of preference
+type issueTransition struct {
+	operation string
+	types     []string
+	defaults  []string
+}
+
+var (
+	closeTransition  = issueTransition{"close", []string{"completed", "canceled"}, []string{"completed"}}
+	reopenTransition = issueTransition{"reopen", []string{"triage", "backlog", "unstarted", "started"}, []string{"unstarted", "backlog"}}
+)
+
+// allows reports whether the transition may move an issue to stateType
+func (t issueTransition) allows(stateType string) bool {
+	for _, allowed := range t.types {
+		if allowed == stateType {
+			return true
+		}
+	}
+	return false
+}
+
+// target picks the state to move the issue to among its team's states:
+// the first state of the type value names, else the state it names by name
+// or ID, or without a value the first state of the default types
+func (t issueTransition) target(states []api.WorkflowState, value string) (*api.WorkflowState, error) {
+	var candidates []api.WorkflowState
+	for _, s := range states {
+		if t.allows(s.Type) {
+			candidates = append(candidates, s)
+		}
+	}
+
+	if value == "" {
+		for _, stateType := range t.defaults {
+			if state, err := resolveWorkflowState(candidates, "", stateType); err == nil {
+				return state, nil
+			}
+		}
+		return nil, &StateResolveError{
+			Message: fmt.Sprintf("The team has no %s state", strings.Join(t.defaults, " or ")),
+			Valid:   t.types,
+		}
+	}
+
+	stateType := strings.ToLower(value)
+	if stateType == "cancelled" {
+		stateType = "canceled"
+	}
+	if t.allows(stateType) {
+		return resolveWorkflowState(candidates, "", stateType)
+	}
+	state, err := resolveWorkflowState(candidates, value, "")
+	var resolveErr *StateResolveError
+	if errors.As(err, &resolveErr) {
+		resolveErr.Valid = append(resolveErr.Valid, t.types...)
+	}
+	return state, err
+}
+
+func newIssueCloseCmd() *cobra.Command {
+	return newIssueTransitionCmd(closeTransition)
+}
+
+func newIssueReopenCmd() *cobra.Command {
+	return newIssueTransitionCmd(reopenTransition)
+}
+
+// newIssueTransitionCmd builds the close/reopen command
+func newIssueTransitionCmd(t issueTransition) *cobra.Command {
+	var stateName string
+
+	short := "Close an issue as done or canceled"
+	long := `Move an issue to its team's first completed state. --state picks another
+completed or canceled state, by name (Done, Duplicate) or by type
+(completed, canceled). Closing an issue that is already closed changes
+nothing.`
+	examples := `  linear issue close ENG-123
+  linear issue close ENG-123 --state Canceled
+  linear issue close ENG-123 --state canceled --human`
+	if t.operation == "reopen" {
+		short = "Reopen a closed issue"
+		long = `Move a completed or canceled issue back to its team's first unstarted
+state, or backlog state if the team has none. --state picks another open
+state, by name or by type (triage, backlog, unstarted, started).
+Reopening an issue that is already open changes nothing.`
+		examples = `  linear issue reopen ENG-123
+  linear issue reopen ENG-123 --state Backlog
+  linear issue reopen ENG-123 --state started --human`
+	}
+
+	cmd := &cobra.Command{
+		Use:   t.operation + " [issue-id]",
+		Short: short,
+		Long: long + `
+
+Without an issue ID, the issue is detected from the current git branch.
+
+Examples:
+` + examples,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			issueID, err := issueArgOrCurrent(ctx, args)
+			if err != nil {
+				return issueDetectError(err, "linear issue "+t.operation+" ENG-123")
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			// Fetch the issue and its team's states in one request
+			work, err := client.GetIssueWorkContext(ctx, issueID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			issue := work.Issue
+			if issue == nil || issue.ID == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+			}
+
+			resp := &IssueTransitionResponse{
+				Success:       true,
+				Operation:     t.operation,
+				Identifier:    issue.Identifier,
+				Title:         issue.Title,
+				URL:           issue.URL,
+				State:         issue.State,
+				PreviousState: issue.State,
+			}
+
+			// Without --state, an issue already on this side of the
+			// workflow is left where it is
+			if stateName == "" && t.allows(issue.State.Type) {
+				resp.Unchanged = true
+			} else {
+				target, err := t.target(work.States, stateName)
+				if err != nil {
+					return stateError(err)
+				}
+				resp.State = api.IssueState{ID: target.ID, Name: target.Name, Type: target.Type, Color: target.Color}
+				resp.Unchanged = target.ID == issue.State.ID
+			}
+
+			if !resp.Unchanged {
+				if _, err := client.UpdateIssue(ctx, issue.ID, api.IssueUpdateInput{StateID: resp.State.ID}); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				recordIssue(issue.ID, issue.Identifier, issue.Title, issue.URL, history.ActionEdited)
+			}
+
+			if IsHumanOutput() {
+				resp.printHuman()
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	if t.operation == "reopen" {
+		cmd.Flags().StringVarP(&stateName, "state", "s", "", "Open state to move the issue to, by name or type (default: the first unstarted state)")
+	} else {
+		cmd.Flags().StringVarP(&stateName, "state", "s", "", "Completed or canceled state, by name or type (default: the first completed state)")
+	}
+
+	return cmd
+}
+
+// printHuman prints the outcome of closing or reopening an issue
+func (r *IssueTransitionResponse) printHuman() {
+	if r.Unchanged {
+		which := "closed"
+		if r.Operation == "reopen" {
+			which = "open"
+		}
+		output.HumanLn("%s is already %s (%s)", r.Identifier, which, r.State.Name)
+		return
+	}
+	verb := "Closed"
+	if r.Operation == "reopen" {
+		verb = "Reopened"
+	}
+	output.SuccessHuman(fmt.Sprintf("%s %s: %s", verb, r.Identifier, r.Title))
+	output.HumanLn("State: %s → %s", r.PreviousState.Name, r.State.Name)
+}
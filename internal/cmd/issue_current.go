@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/vcs"
+	"github.com/spf13/cobra"
+)
+
+// issueArgOrCurrent returns the issue ID argument, or the issue detected
+// from the current git branch and commits when none was given
+func issueArgOrCurrent(ctx context.Context, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	detected, err := vcs.DetectIssue(ctx)
+	if err != nil {
+		return "", err
+	}
+	return detected.Identifier, nil
+}
+
+// issueDetectError reports that no issue ID was given and none could be
+// detected from git
+func issueDetectError(err error, example string) error {
+	code := "NO_ISSUE_DETECTED"
+	if errors.Is(err, vcs.ErrNotRepository) {
+		code = "NOT_A_REPOSITORY"
+	}
+	hint := "Pass an issue ID, or work on a branch named like user/eng-123-title or add a 'Linear-Issue: ENG-123' commit trailer"
+	if IsHumanOutput() {
+		output.ErrorHumanWithHint(code, err.Error(), hint, example)
+		return nil
+	}
+	return output.ErrorWithHint(code, err.Error(), hint, example)
+}
+
+func newIssueCurrentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current",
+		Short: "Show the issue for the current git branch",
+		Long: `Detect the issue being worked on from the current git branch name, or
+failing that from trailers such as "Linear-Issue: ENG-123", "Fixes: ENG-123"
+or "Refs: ENG-123" on recent commits, and show it.
+
+'issue view', 'issue start' and 'issue comment' use the same detection when
+no issue ID is given.
+
+Examples:
+  linear issue current
+  linear issue current --human
+  linear issue view            # views the detected issue`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			detected, err := vcs.DetectIssue(ctx)
+			if err != nil {
+				return issueDetectError(err, "linear issue view ENG-123")
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			issue, err := client.GetIssue(ctx, detected.Identifier, false)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				output.HumanLn("%s %s", output.Bold("%s", issue.Identifier), issue.Title)
+				output.HumanLn("%s: %s", output.Bold("State"), issue.State.Name)
+				if issue.Assignee != nil {
+					output.HumanLn("%s: %s", output.Bold("Assignee"), issue.Assignee.DisplayName)
+				}
+				source := "branch " + detected.Branch
+				if detected.Source == "commit" {
+					source = "commit " + detected.Commit[:min(len(detected.Commit), 12)]
+				}
+				output.HumanLn("%s", output.Muted("Detected from %s", source))
+				output.HumanLn("%s", output.Muted("%s", issue.URL))
+				return nil
+			}
+
+			return output.JSON(map[string]interface{}{
+				"detected": detected,
+				"issue":    issue,
+			})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,332 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// exportPageSize is how many issues are fetched per request when exporting
+const exportPageSize = 100
+
+// ExportFormats lists the supported issue export formats
+var ExportFormats = []string{"csv", "md", "json"}
+
+// exportColumns are the CSV columns, in order
+var exportColumns = []string{
+	"identifier", "title", "team", "state", "state_type", "priority", "estimate",
+	"assignee", "creator", "project", "cycle", "parent", "labels", "due_date",
+	"created_at", "updated_at", "started_at", "completed_at", "canceled_at",
+	"url", "description",
+}
+
+// IssueExport is the JSON export document
+type IssueExport struct {
+	Team       string            `json:"team"`
+	ExportedAt string            `json:"exportedAt"`
+	Count      int               `json:"count"`
+	Issues     []api.ExportIssue `json:"issues"`
+}
+
+func newIssueExportCmd() *cobra.Command {
+	var (
+		teamKey    string
+		format     string
+		outputPath string
+		stateTypes []string
+		assignee   string
+		projectID  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export issues to CSV, Markdown or JSON",
+		Long: `Export a team's issues, following every page of results.
+
+The export includes state, priority, estimate, assignee, creator, project,
+cycle, parent, labels, dates and URLs. All states are included unless
+--state is given.
+
+The format defaults to the --output file extension (.csv, .md, .json),
+or CSV when writing to stdout.
+
+Examples:
+  linear issue export --team ENG --output issues.csv
+  linear issue export --team ENG --format md --output report.md
+  linear issue export --team ENG --state started --state unstarted --format json
+  linear issue export --assignee self --format md`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+			if teamKey == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_TEAM",
+						"Team is required",
+						"Specify a team using --team flag or set a default team",
+						"linear issue export --team ENG --output issues.csv",
+						"linear config set team_key ENG",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_TEAM",
+					"Team is required",
+					"Specify a team using --team flag or set a default team",
+					"linear issue export --team ENG --output issues.csv",
+					"linear config set team_key ENG",
+				)
+			}
+
+			if format == "" {
+				format = exportFormatFromPath(outputPath)
+			}
+			format = strings.ToLower(format)
+			if format == "markdown" {
+				format = "md"
+			}
+			if !containsFold(ExportFormats, format) {
+				msg := fmt.Sprintf("Invalid format '%s'", format)
+				hint := "Valid formats: " + strings.Join(ExportFormats, ", ")
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint("INVALID_FORMAT", msg, hint)
+					return nil
+				}
+				return output.ErrorWithHint("INVALID_FORMAT", msg, hint)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			team, err := client.GetTeamByKey(ctx, teamKey)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if team == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+			}
+
+			filter := api.IssueFilter{
+				TeamID:     team.ID,
+				ProjectID:  projectID,
+				StateTypes: stateTypes,
+			}
+			if assignee == "self" || assignee == "me" {
+				viewerID, err := client.GetViewerID(ctx)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("API_ERROR", "Failed to get current user: "+err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", "Failed to get current user: "+err.Error())
+				}
+				filter.AssigneeID = viewerID
+			} else {
+				filter.AssigneeID = assignee
+			}
+
+			issues, _, err := collectPages("", true, func(after string) ([]api.ExportIssue, *api.PageInfo, error) {
+				return client.GetIssuesForExport(ctx, filter, exportPageSize, after)
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			var w io.Writer = os.Stdout
+			if outputPath != "" {
+				f, err := os.Create(outputPath)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("FILE_ERROR", err.Error())
+						return nil
+					}
+					return output.Error("FILE_ERROR", err.Error())
+				}
+				defer f.Close()
+				w = f
+			}
+
+			if err := writeIssueExport(w, format, team.Key, issues, time.Now()); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("FILE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("FILE_ERROR", err.Error())
+			}
+
+			// Exports to stdout are the output; only report on file exports
+			if outputPath == "" {
+				return nil
+			}
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Exported %d issues to %s", len(issues), outputPath))
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":   true,
+					"operation": "export",
+					"format":    format,
+					"path":      outputPath,
+					"count":     len(issues),
+				})
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
+	cmd.Flags().StringVarP(&format, "format", "f", "", "Export format (csv, md, json)")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write the export to a file instead of stdout")
+	cmd.Flags().StringSliceVarP(&stateTypes, "state", "s", nil, "Filter by state type (triage, backlog, unstarted, started, completed, canceled)")
+	cmd.Flags().StringVarP(&assignee, "assignee", "a", "", "Filter by assignee (use 'self' for yourself)")
+	cmd.Flags().StringVar(&projectID, "project", "", "Filter by project ID or name")
+
+	return cmd
+}
+
+// exportFormatFromPath picks a format from a file extension, defaulting to CSV
+func exportFormatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown":
+		return "md"
+	case ".json":
+		return "json"
+	default:
+		return "csv"
+	}
+}
+
+// writeIssueExport writes issues in the given format
+func writeIssueExport(w io.Writer, format, teamKey string, issues []api.ExportIssue, now time.Time) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(IssueExport{
+			Team:       teamKey,
+			ExportedAt: now.UTC().Format(time.RFC3339),
+			Count:      len(issues),
+			Issues:     issues,
+		})
+	case "md":
+		return writeIssuesMarkdown(w, teamKey, issues, now)
+	default:
+		return writeIssuesCSV(w, issues)
+	}
+}
+
+// writeIssuesCSV writes one row per issue with exportColumns as the header
+func writeIssuesCSV(w io.Writer, issues []api.ExportIssue) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(exportColumns); err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		estimate, cycle := "", ""
+		if issue.Estimate != nil {
+			estimate = strconv.FormatFloat(*issue.Estimate, 'f', -1, 64)
+		}
+		if issue.Cycle != nil {
+			cycle = strconv.Itoa(*issue.Cycle)
+		}
+		record := []string{
+			issue.Identifier, issue.Title, issue.Team, issue.State, issue.StateType,
+			display.PriorityName(issue.Priority), estimate, issue.Assignee, issue.Creator,
+			issue.Project, cycle, issue.Parent, strings.Join(issue.Labels, "; "), issue.DueDate,
+			issue.CreatedAt, issue.UpdatedAt, issue.StartedAt, issue.CompletedAt, issue.CanceledAt,
+			issue.URL, issue.Description,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeIssuesMarkdown writes a report with a summary and one table per
+// state type, in workflow order from active work to closed issues
+func writeIssuesMarkdown(w io.Writer, teamKey string, issues []api.ExportIssue, now time.Time) error {
+	groups := map[string][]api.ExportIssue{}
+	for _, issue := range issues {
+		groups[issue.StateType] = append(groups[issue.StateType], issue)
+	}
+	order := []string{"started", "unstarted", "backlog", "triage", "completed", "canceled"}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s issues\n\n", teamKey)
+	fmt.Fprintf(&b, "Exported %s · %d issues\n\n", now.Format("2006-01-02 15:04"), len(issues))
+
+	b.WriteString("| State | Issues |\n|---|---|\n")
+	for _, stateType := range order {
+		if n := len(groups[stateType]); n > 0 {
+			fmt.Fprintf(&b, "| %s | %d |\n", stateType, n)
+		}
+	}
+
+	for _, stateType := range order {
+		group := groups[stateType]
+		if len(group) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", strings.ToUpper(stateType[:1])+stateType[1:])
+		b.WriteString("| ID | Title | State | Priority | Estimate | Assignee | Labels | Due |\n")
+		b.WriteString("|---|---|---|---|---|---|---|---|\n")
+		for _, issue := range group {
+			estimate := ""
+			if issue.Estimate != nil {
+				estimate = strconv.FormatFloat(*issue.Estimate, 'f', -1, 64)
+			}
+			fmt.Fprintf(&b, "| [%s](%s) | %s | %s | %s | %s | %s | %s | %s |\n",
+				issue.Identifier, issue.URL,
+				markdownCell(issue.Title),
+				markdownCell(issue.State),
+				display.PriorityName(issue.Priority),
+				estimate,
+				markdownCell(issue.Assignee),
+				markdownCell(strings.Join(issue.Labels, ", ")),
+				issue.DueDate,
+			)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownCell escapes text for use in a Markdown table cell
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+)
+
+// issueExpandNames are the --expand choices of issue view, in the order
+// help and errors list them
+var issueExpandNames = []string{"children", "relations", "comments", "attachments", "history"}
+
+// parseIssueExpand picks the connections issue view fetches. Without
+// --expand it fetches children, relations and comments, as it always has;
+// "all" fetches every connection and "none" only the issue itself.
+func parseIssueExpand(value string, noComments bool) (api.IssueExpand, error) {
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(value) {
+	case "":
+		return api.IssueExpand{Children: true, Relations: true, Comments: !noComments}, nil
+	case "all":
+		return api.IssueExpand{Children: true, Relations: true, Comments: !noComments, Attachments: true, History: true}, nil
+	case "none":
+		return api.IssueExpand{}, nil
+	}
+
+	var expand api.IssueExpand
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !setIssueExpand(&expand, name) {
+			return api.IssueExpand{}, fmt.Errorf("unknown expansion %q (use %s, all or none)", name, strings.Join(issueExpandNames, ", "))
+		}
+	}
+	if noComments {
+		expand.Comments = false
+	}
+	return expand, nil
+}
+
+// setIssueExpand turns on the connection named name, reporting whether
+// it is one
+func setIssueExpand(expand *api.IssueExpand, name string) bool {
+	switch name {
+	case "children":
+		expand.Children = true
+	case "relations":
+		expand.Relations = true
+	case "comments":
+		expand.Comments = true
+	case "attachments":
+		expand.Attachments = true
+	case "history":
+		expand.History = true
+	default:
+		return false
+	}
+	return true
+}
+
+// issueFieldNames lists the top-level JSON keys of an issue, which are
+// the --fields choices of issue view
+func issueFieldNames() []string {
+	t := reflect.TypeOf(api.IssueDetail{})
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// parseIssueFields checks the comma-separated --fields value against the
+// issue's JSON keys, matching them case-insensitively
+func parseIssueFields(value string) ([]string, error) {
+	known := issueFieldNames()
+	var fields []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		field := ""
+		for _, k := range known {
+			if strings.EqualFold(k, name) {
+				field = k
+				break
+			}
+		}
+		if field == "" {
+			return nil, fmt.Errorf("unknown field %q (use %s)", name, strings.Join(known, ", "))
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// expandForFields fetches only the connections fields names, so that
+// --fields alone keeps the query as small as the output
+func expandForFields(fields []string, noComments bool) api.IssueExpand {
+	var expand api.IssueExpand
+	for _, field := range fields {
+		setIssueExpand(&expand, field)
+	}
+	if noComments {
+		expand.Comments = false
+	}
+	return expand
+}
+
+// selectIssueFields keeps only fields of the issue's JSON. A field the
+// issue has no value for is kept as null, so every requested key appears.
+func selectIssueFields(issue *api.IssueDetail, fields []string) (map[string]interface{}, error) {
+	data, err := json.Marshal(issue)
+	if err != nil {
+		return nil, err
+	}
+	var all map[string]interface{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	selected := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		selected[field] = all[field]
+	}
+	return selected, nil
+}
@@ -0,0 +1,389 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// Graph edge types besides Linear's relation types (blocks, related,
+// duplicate, similar)
+const graphEdgeSubtask = "subtask"
+
+// GraphNode is an issue in a dependency graph
+type GraphNode struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	StateType  string `json:"stateType"`
+	// Depth is the number of links from the root issue
+	Depth int `json:"depth"`
+}
+
+// GraphEdge is a link between two issues. For subtask edges From is the
+// parent; for blocks edges From blocks To; for duplicate edges From is a
+// duplicate of To.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+// IssueGraphResponse is the response for 'issue graph'
+type IssueGraphResponse struct {
+	Root  string      `json:"root"`
+	Depth int         `json:"depth"`
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+	// Truncated is set when --limit stopped the walk early
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+func newIssueGraphCmd() *cobra.Command {
+	var (
+		depth int
+		limit int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "graph <issue-id>",
+		Short: "Show an issue's dependency graph",
+		Long: `Walk an issue's parent, sub-issues and relations (blocks, related,
+duplicate) up to --depth links away, and show the graph.
+
+The default JSON output lists nodes and edges. --format dot and --format
+mermaid render the graph for Graphviz or for embedding in markdown
+documents; --human (or --format tree) prints it as a tree from the root
+issue.
+
+Examples:
+  linear issue graph ENG-123 --human
+  linear issue graph ENG-123 --depth 3 --format mermaid
+  linear issue graph ENG-123 --format dot | dot -Tsvg > graph.svg`,
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{namedFormatsAnnotation: "dot,mermaid,tree"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if depth < 1 {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_DEPTH", "--depth must be at least 1")
+					return nil
+				}
+				return output.Error("INVALID_DEPTH", "--depth must be at least 1")
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			graph, err := walkIssueGraph(ctx, client, args[0], depth, limit)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if graph == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", args[0]))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", args[0]))
+			}
+
+			switch namedFormat(cmd) {
+			case "dot":
+				fmt.Print(graphDOT(graph))
+				return nil
+			case "mermaid":
+				fmt.Print(graphMermaid(graph))
+				return nil
+			case "tree":
+				printGraphTree(graph)
+				return nil
+			}
+			if IsHumanOutput() {
+				printGraphTree(graph)
+				return nil
+			}
+			return output.JSON(graph)
+		},
+	}
+
+	cmd.Flags().IntVar(&depth, "depth", 2, "How many links to follow from the issue")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 100, "Maximum number of issues to fetch")
+
+	return cmd
+}
+
+// walkIssueGraph fetches issues breadth-first from the root, one request
+// per issue, until depth links away or limit issues have been fetched.
+// Issues at the edge of the walk are included but not expanded. It
+// returns nil when the root issue does not exist.
+func walkIssueGraph(ctx context.Context, client *api.Client, rootID string, depth, limit int) (*IssueGraphResponse, error) {
+	root, err := client.GetIssueLinks(ctx, rootID)
+	if err != nil || root == nil {
+		return nil, err
+	}
+
+	graph := &IssueGraphResponse{Root: root.Identifier, Depth: depth, Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	nodes := map[string]int{}
+	edges := map[string]bool{}
+
+	addNode := func(issue api.GraphIssue, d int) bool {
+		if _, ok := nodes[issue.Identifier]; ok {
+			return false
+		}
+		nodes[issue.Identifier] = len(graph.Nodes)
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			ID:         issue.ID,
+			Identifier: issue.Identifier,
+			Title:      issue.Title,
+			State:      issue.State.Name,
+			StateType:  issue.State.Type,
+			Depth:      d,
+		})
+		return true
+	}
+	addEdge := func(from, to, edgeType string) {
+		// Related and similar links have no direction
+		if (edgeType == "related" || edgeType == "similar") && to < from {
+			from, to = to, from
+		}
+		key := from + " " + edgeType + " " + to
+		if edges[key] {
+			return
+		}
+		edges[key] = true
+		graph.Edges = append(graph.Edges, GraphEdge{From: from, To: to, Type: edgeType})
+	}
+
+	addNode(root.GraphIssue, 0)
+	queue := []*api.IssueLinks{root}
+	fetched := 1
+	for len(queue) > 0 {
+		issue := queue[0]
+		queue = queue[1:]
+		d := graph.Nodes[nodes[issue.Identifier]].Depth
+
+		var linked []api.GraphIssue
+		if issue.Parent != nil {
+			addEdge(issue.Parent.Identifier, issue.Identifier, graphEdgeSubtask)
+			linked = append(linked, *issue.Parent)
+		}
+		for _, child := range issue.Children {
+			addEdge(issue.Identifier, child.Identifier, graphEdgeSubtask)
+			linked = append(linked, child)
+		}
+		for _, rel := range issue.Relations {
+			addEdge(issue.Identifier, rel.Issue.Identifier, rel.Type)
+			linked = append(linked, rel.Issue)
+		}
+		for _, rel := range issue.InverseRelations {
+			addEdge(rel.Issue.Identifier, issue.Identifier, rel.Type)
+			linked = append(linked, rel.Issue)
+		}
+
+		for _, next := range linked {
+			if !addNode(next, d+1) || d+1 >= depth {
+				continue
+			}
+			if fetched >= limit {
+				graph.Truncated = true
+				continue
+			}
+			links, err := client.GetIssueLinks(ctx, next.ID)
+			if err != nil {
+				return nil, err
+			}
+			fetched++
+			if links != nil {
+				queue = append(queue, links)
+			}
+		}
+	}
+
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return nodes[a.From] < nodes[b.From]
+		}
+		return nodes[a.To] < nodes[b.To]
+	})
+	return graph, nil
+}
+
+// graphDone reports whether a node is completed or canceled
+func graphDone(n GraphNode) bool {
+	return n.StateType == "completed" || n.StateType == "canceled"
+}
+
+// graphDOT renders the graph in Graphviz DOT. Finished issues are dashed
+// and blocking links are red.
+func graphDOT(g *IssueGraphResponse) string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace
+	quote := func(s string) string { return `"` + escape(s) + `"` }
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", quote(g.Root))
+	b.WriteString("  rankdir=LR;\n  node [shape=box, style=rounded];\n")
+	for _, n := range g.Nodes {
+		style := "rounded"
+		if graphDone(n) {
+			style += ",dashed"
+		}
+		if n.Identifier == g.Root {
+			style += ",bold"
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, style=%q];\n", quote(n.Identifier), `"`+escape(n.Identifier)+`\n`+escape(n.Title)+`\n[`+escape(n.State)+`]"`, style)
+	}
+	for _, e := range g.Edges {
+		attrs := fmt.Sprintf("label=%q", e.Type)
+		switch e.Type {
+		case "blocks":
+			attrs += ", color=red"
+		case "related", "similar":
+			attrs += ", dir=none, style=dotted"
+		case "duplicate":
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", quote(e.From), quote(e.To), attrs)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// graphMermaid renders the graph as a Mermaid flowchart
+func graphMermaid(g *IssueGraphResponse) string {
+	id := func(identifier string) string {
+		return strings.ReplaceAll(identifier, "-", "_")
+	}
+	label := strings.NewReplacer(`"`, "#quot;")
+
+	var b strings.Builder
+	b.WriteString("graph LR\n")
+	done := []string{}
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&b, "  %s[\"%s: %s\"]\n", id(n.Identifier), n.Identifier, label.Replace(n.Title))
+		if graphDone(n) {
+			done = append(done, id(n.Identifier))
+		}
+	}
+	for _, e := range g.Edges {
+		switch e.Type {
+		case "related", "similar":
+			fmt.Fprintf(&b, "  %s -. %s .- %s\n", id(e.From), e.Type, id(e.To))
+		default:
+			fmt.Fprintf(&b, "  %s -->|%s| %s\n", id(e.From), e.Type, id(e.To))
+		}
+	}
+	fmt.Fprintf(&b, "  style %s stroke-width:3px\n", id(g.Root))
+	if len(done) > 0 {
+		b.WriteString("  classDef done fill:#eee,color:#888,stroke-dasharray:4\n")
+		fmt.Fprintf(&b, "  class %s done\n", strings.Join(done, ","))
+	}
+	return b.String()
+}
+
+// graphEdgeLabel describes an edge as seen from one of its ends
+func graphEdgeLabel(e GraphEdge, from string) string {
+	outgoing := e.From == from
+	switch e.Type {
+	case graphEdgeSubtask:
+		if outgoing {
+			return "sub-issue"
+		}
+		return "parent"
+	case "blocks":
+		if outgoing {
+			return "blocks"
+		}
+		return "blocked by"
+	case "duplicate":
+		if outgoing {
+			return "duplicate of"
+		}
+		return "duplicated by"
+	}
+	return e.Type
+}
+
+// printGraphTree prints the graph as a tree from the root issue. The link
+// back to the issue a branch came from is left out, and an issue reached
+// a second time is listed without its links.
+func printGraphTree(g *IssueGraphResponse) {
+	byID := map[string]GraphNode{}
+	for _, n := range g.Nodes {
+		byID[n.Identifier] = n
+	}
+	adjacent := map[string][]GraphEdge{}
+	for _, e := range g.Edges {
+		adjacent[e.From] = append(adjacent[e.From], e)
+		adjacent[e.To] = append(adjacent[e.To], e)
+	}
+
+	describe := func(n GraphNode) string {
+		state := n.State
+		if graphDone(n) {
+			state = output.Muted("%s", state)
+		}
+		return fmt.Sprintf("%s %s [%s]", output.Cyan("%s", n.Identifier), n.Title, state)
+	}
+
+	visited := map[string]bool{g.Root: true}
+	output.HumanLn("%s", describe(byID[g.Root]))
+
+	var walk func(identifier, from, prefix string)
+	walk = func(identifier, from, prefix string) {
+		type branch struct {
+			label string
+			node  GraphNode
+		}
+		branches := []branch{}
+		for _, e := range adjacent[identifier] {
+			other := e.To
+			if other == identifier {
+				other = e.From
+			}
+			if other == from {
+				continue
+			}
+			branches = append(branches, branch{graphEdgeLabel(e, identifier), byID[other]})
+		}
+
+		for i, br := range branches {
+			mark, next := "├─ ", "│  "
+			if i == len(branches)-1 {
+				mark, next = "└─ ", "   "
+			}
+			seen := visited[br.node.Identifier]
+			note := ""
+			if seen {
+				note = output.Muted(" (shown above)")
+			}
+			output.HumanLn("%s%s%s %s%s", prefix, mark, output.Muted("%s", br.label), describe(br.node), note)
+			if !seen {
+				visited[br.node.Identifier] = true
+				walk(br.node.Identifier, identifier, prefix+next)
+			}
+		}
+	}
+	walk(g.Root, "", "")
+
+	if g.Truncated {
+		output.HumanLn("\n%s", output.Muted("Stopped at --limit; some issues were not expanded"))
+	}
+}
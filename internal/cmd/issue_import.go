@@ -0,0 +1,534 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// ImportFields lists the issue fields an import can set
+var ImportFields = []string{"title", "description", "priority", "estimate", "labels", "assignee", "state", "due_date"}
+
+// importAliases maps normalized column names to import fields. Names are
+// normalized by lower-casing and dropping spaces, dashes and underscores,
+// so the columns written by 'linear issue export' import as-is.
+var importAliases = map[string]string{
+	"title":       "title",
+	"summary":     "title",
+	"name":        "title",
+	"description": "description",
+	"body":        "description",
+	"priority":    "priority",
+	"estimate":    "estimate",
+	"points":      "estimate",
+	"labels":      "labels",
+	"label":       "labels",
+	"tags":        "labels",
+	"assignee":    "assignee",
+	"owner":       "assignee",
+	"state":       "state",
+	"status":      "state",
+	"duedate":     "due_date",
+	"due":         "due_date",
+}
+
+// importRecord is one source row, keyed by import field
+type importRecord struct {
+	Row    int
+	Fields map[string]string
+}
+
+// ImportResult is the outcome of importing one row
+type ImportResult struct {
+	Row        int    `json:"row"`
+	Title      string `json:"title"`
+	ID         string `json:"id,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// ImportResponse is the response for 'linear issue import'
+type ImportResponse struct {
+	Success bool           `json:"success"`
+	DryRun  bool           `json:"dryRun"`
+	Team    string         `json:"team"`
+	Created []ImportResult `json:"created"`
+	Failed  []ImportResult `json:"failed"`
+	Count   int            `json:"count"`
+}
+
+func newIssueImportCmd() *cobra.Command {
+	var (
+		filePath            string
+		teamKey             string
+		mappings            []string
+		dryRun              bool
+		createMissingLabels bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create issues in bulk from a CSV or JSON file",
+		Long: `Create issues from a CSV file with a header row, or a JSON array of
+objects (an export from 'linear issue export --format json' also works).
+
+Columns map to fields by name: title, description, priority, estimate,
+labels, assignee, state and due_date. Common alternatives such as
+summary, points, tags, owner and status are recognized, and --map
+renames any other column.
+
+  priority   urgent, high, medium, low, none or 0-4
+  labels     names separated by ";" or "," (or a JSON array)
+  assignee   email, name, display name, user ID or "self"
+  state      workflow state name
+  due_date   YYYY-MM-DD or an expression like "next friday"
+
+Every row is validated before any issue is created. Rows that fail are
+reported and the rest are still imported. Use --dry-run to preview.
+
+Examples:
+  linear issue import --file issues.csv --team ENG --dry-run
+  linear issue import --file issues.csv --team ENG
+  linear issue import --file backlog.json --team ENG --create-missing-labels
+  linear issue import --file jira.csv --team ENG --map Summary=title --map "Story Points=estimate"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filePath == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_FILE",
+						"Import file is required",
+						"Provide a CSV or JSON file using the --file flag",
+						"linear issue import --file issues.csv --team ENG",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_FILE",
+					"Import file is required",
+					"Provide a CSV or JSON file using the --file flag",
+					"linear issue import --file issues.csv --team ENG",
+				)
+			}
+
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+			if teamKey == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_TEAM",
+						"Team is required",
+						"Specify a team using --team flag or set a default team",
+						"linear issue import --file issues.csv --team ENG",
+						"linear config set team_key ENG",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_TEAM",
+					"Team is required",
+					"Specify a team using --team flag or set a default team",
+					"linear issue import --file issues.csv --team ENG",
+					"linear config set team_key ENG",
+				)
+			}
+
+			columnMap, err := parseImportMappings(mappings)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_MAPPING", err.Error())
+					return nil
+				}
+				return output.ErrorWithHint("INVALID_MAPPING", err.Error(), "Valid fields: "+strings.Join(ImportFields, ", "))
+			}
+
+			records, err := readImportFile(filePath, columnMap)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FILE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_FILE", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			team, err := client.GetTeamByKey(ctx, teamKey)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if team == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+			}
+
+			resp := &ImportResponse{
+				DryRun:  dryRun,
+				Team:    team.Key,
+				Created: []ImportResult{},
+				Failed:  []ImportResult{},
+			}
+
+			// Validate every row before creating any issue
+			inputs := make([]*api.IssueCreateInput, len(records))
+			for i, record := range records {
+				input, err := buildImportInput(ctx, client, team.ID, record, createMissingLabels && !dryRun)
+				var labelErr *LabelResolveError
+				if dryRun && createMissingLabels && errors.As(err, &labelErr) {
+					// The labels would be created by a real run
+					input, err = buildImportInput(ctx, client, team.ID, withoutLabels(record), false)
+				}
+				if err != nil {
+					resp.Failed = append(resp.Failed, ImportResult{Row: record.Row, Title: record.Fields["title"], Error: err.Error()})
+					continue
+				}
+				inputs[i] = input
+			}
+
+			for i, input := range inputs {
+				if input == nil {
+					continue
+				}
+				row := records[i].Row
+
+				if dryRun {
+					resp.Created = append(resp.Created, ImportResult{Row: row, Title: input.Title})
+					continue
+				}
+
+				result, err := client.CreateIssue(ctx, *input)
+				if err != nil {
+					resp.Failed = append(resp.Failed, ImportResult{Row: row, Title: input.Title, Error: err.Error()})
+					if IsHumanOutput() {
+						output.HumanLn("[%d/%d] %s row %d: %s", i+1, len(inputs), output.Red("✗"), row, err.Error())
+					}
+					continue
+				}
+				resp.Created = append(resp.Created, ImportResult{
+					Row:        row,
+					Title:      input.Title,
+					ID:         result.ID,
+					Identifier: result.Identifier,
+					URL:        result.URL,
+				})
+				if IsHumanOutput() {
+					output.HumanLn("[%d/%d] %s %s %s", i+1, len(inputs), output.Green("✓"), result.Identifier, display.Truncate(input.Title, 60))
+				}
+			}
+
+			resp.Count = len(resp.Created)
+			resp.Success = len(resp.Failed) == 0
+			if !resp.Success {
+				output.Fail("IMPORT_FAILED")
+			}
+
+			if IsHumanOutput() {
+				printImportHuman(resp)
+			} else {
+				output.JSON(resp)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "CSV or JSON file to import")
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
+	cmd.Flags().StringArrayVar(&mappings, "map", nil, "Map a source column to a field (e.g., Summary=title)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate and preview without creating issues")
+	cmd.Flags().BoolVar(&createMissingLabels, "create-missing-labels", false, "Create labels that don't exist yet")
+
+	return cmd
+}
+
+// parseImportMappings parses --map Column=field values into a map keyed by
+// normalized column name
+func parseImportMappings(mappings []string) (map[string]string, error) {
+	columnMap := map[string]string{}
+	for _, m := range mappings {
+		column, field, ok := strings.Cut(m, "=")
+		field = strings.ToLower(strings.TrimSpace(field))
+		if !ok || strings.TrimSpace(column) == "" {
+			return nil, fmt.Errorf("invalid mapping '%s' (use Column=field)", m)
+		}
+		if !containsFold(ImportFields, field) {
+			return nil, fmt.Errorf("invalid field '%s' in mapping '%s'", field, m)
+		}
+		columnMap[normalizeImportColumn(column)] = field
+	}
+	return columnMap, nil
+}
+
+// normalizeImportColumn lower-cases a column name and drops separators
+func normalizeImportColumn(column string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(column)))
+}
+
+// importField returns the field a column maps to, if any
+func importField(column string, columnMap map[string]string) (string, bool) {
+	key := normalizeImportColumn(column)
+	if field, ok := columnMap[key]; ok {
+		return field, true
+	}
+	field, ok := importAliases[key]
+	return field, ok
+}
+
+// readImportFile reads CSV or JSON records, chosen by file extension
+func readImportFile(path string, columnMap map[string]string) ([]importRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []importRecord
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		records, err = readImportJSON(f, columnMap)
+	} else {
+		records, err = readImportCSV(f, columnMap)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no issues found in %s", path)
+	}
+	return records, nil
+}
+
+// readImportCSV reads rows from a CSV file with a header row. Row numbers
+// are file line numbers, counting the header as line 1.
+func readImportCSV(r io.Reader, columnMap map[string]string) ([]importRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]string, len(header))
+	hasTitle := false
+	for i, column := range header {
+		fields[i], _ = importField(strings.TrimPrefix(column, "\ufeff"), columnMap)
+		hasTitle = hasTitle || fields[i] == "title"
+	}
+	if !hasTitle {
+		return nil, fmt.Errorf("no title column (use --map <column>=title)")
+	}
+
+	records := []importRecord{}
+	for row := 2; ; row++ {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		record := importRecord{Row: row, Fields: map[string]string{}}
+		blank := true
+		for i, value := range values {
+			value = strings.TrimSpace(value)
+			blank = blank && value == ""
+			if i < len(fields) && fields[i] != "" {
+				record.Fields[fields[i]] = value
+			}
+		}
+		if !blank {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// readImportJSON reads an array of objects, or an object with an "issues"
+// array as written by 'linear issue export'. Row numbers are 1-based
+// positions in the array.
+func readImportJSON(r io.Reader, columnMap map[string]string) ([]importRecord, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []map[string]interface{}
+	if err := json.Unmarshal(data, &items); err != nil {
+		var wrapped struct {
+			Issues []map[string]interface{} `json:"issues"`
+		}
+		if wrappedErr := json.Unmarshal(data, &wrapped); wrappedErr != nil || wrapped.Issues == nil {
+			return nil, err
+		}
+		items = wrapped.Issues
+	}
+
+	records := make([]importRecord, 0, len(items))
+	for i, item := range items {
+		record := importRecord{Row: i + 1, Fields: map[string]string{}}
+		for key, value := range item {
+			field, ok := importField(key, columnMap)
+			if !ok {
+				continue
+			}
+			record.Fields[field] = jsonImportValue(value)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// jsonImportValue flattens a JSON value to the string form used in CSV
+func jsonImportValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			if s := jsonImportValue(item); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, "; ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// withoutLabels returns a copy of record with no labels
+func withoutLabels(record importRecord) importRecord {
+	fields := make(map[string]string, len(record.Fields))
+	for k, v := range record.Fields {
+		if k != "labels" {
+			fields[k] = v
+		}
+	}
+	return importRecord{Row: record.Row, Fields: fields}
+}
+
+// buildImportInput validates a record and resolves its names to IDs
+func buildImportInput(ctx context.Context, client *api.Client, teamID string, record importRecord, createMissingLabels bool) (*api.IssueCreateInput, error) {
+	f := record.Fields
+	if f["title"] == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+
+	input := &api.IssueCreateInput{
+		Title:       f["title"],
+		TeamID:      teamID,
+		Description: f["description"],
+	}
+
+	if f["priority"] != "" {
+		priority, err := parsePriority(f["priority"])
+		if err != nil {
+			return nil, err
+		}
+		input.Priority = &priority
+	}
+
+	if f["estimate"] != "" {
+		estimate, err := strconv.ParseFloat(f["estimate"], 64)
+		if err != nil || estimate < 0 {
+			return nil, fmt.Errorf("invalid estimate '%s'", f["estimate"])
+		}
+		input.Estimate = &estimate
+	}
+
+	dueDate, err := resolveDueDate(f["due_date"])
+	if err != nil {
+		return nil, err
+	}
+	input.DueDate = dueDate
+
+	input.StateID, err = resolveStateID(ctx, client, teamID, f["state"], "")
+	if err != nil {
+		return nil, err
+	}
+
+	input.AssigneeID, err = resolveUserID(ctx, client, f["assignee"])
+	if err != nil {
+		return nil, err
+	}
+
+	if labels := splitImportList(f["labels"]); len(labels) > 0 {
+		input.LabelIDs, err = resolveLabelIDs(ctx, client, teamID, labels, createMissingLabels)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return input, nil
+}
+
+// splitImportList splits a list of names on ";" or ","
+func splitImportList(s string) []string {
+	items := []string{}
+	for _, item := range strings.FieldsFunc(s, func(r rune) bool { return r == ';' || r == ',' }) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// printImportHuman prints the import summary
+func printImportHuman(resp *ImportResponse) {
+	if resp.DryRun {
+		output.HumanLn("%s", output.Bold("Dry run: no issues created"))
+		for _, r := range resp.Created {
+			output.HumanLn("  %s row %d: %s", output.Green("✓"), r.Row, display.Truncate(r.Title, 60))
+		}
+	}
+	if len(resp.Failed) > 0 {
+		output.HumanLn("\n%s", output.Bold("Failed"))
+		for _, r := range resp.Failed {
+			output.HumanLn("  %s row %d: %s", output.Red("✗"), r.Row, r.Error)
+		}
+	}
+
+	verb := "created"
+	if resp.DryRun {
+		verb = "would be created"
+	}
+	output.HumanLn("\n%d issues %s in %s, %d failed", len(resp.Created), verb, resp.Team, len(resp.Failed))
+	if !resp.DryRun && len(resp.Created) > 0 {
+		ids := make([]string, len(resp.Created))
+		for i, r := range resp.Created {
+			ids[i] = r.Identifier
+		}
+		output.HumanLn("%s", output.Muted("%s", strings.Join(ids, ", ")))
+	}
+}
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+// IssueReorderResponse is the response for the issue reorder command
+type IssueReorderResponse struct {
+	Success   bool   `json:"success"`
+	Operation string `json:"operation"`
+	Issue     string `json:"issue"`
+	State     string `json:"state"`
+	// Position is top, bottom, before or after
+	Position string `json:"position"`
+	// Target is the issue placed against with before or after
+	Target    string  `json:"target,omitempty"`
+	SortOrder float64 `json:"sortOrder"`
+	// Index is the issue's 0-based place in the state's column
+	Index int `json:"index"`
+}
+
+func newIssueReorderCmd() *cobra.Command {
+	var (
+		before string
+		after  string
+		top    bool
+		bottom bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "reorder <issue-id>",
+		Short: "Move an issue within its workflow state's column",
+		Long: `Move an issue within its workflow state's board column by changing its
+sort order, so scripts can keep a column in priority order.
+
+--before and --after place the issue next to another issue in the same
+state; --top and --bottom move it to either end of the column.
+
+Examples:
+  linear issue reorder ENG-123 --top
+  linear issue reorder ENG-123 --before ENG-100
+  linear issue reorder ENG-123 --after ENG-100
+  linear issue reorder ENG-123 --bottom`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			set := 0
+			for _, on := range []bool{before != "", after != "", top, bottom} {
+				if on {
+					set++
+				}
+			}
+			if set != 1 {
+				msg := "Pass exactly one of --before, --after, --top or --bottom"
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			resp, err := reorderIssue(ctx, client, args[0], before, after, top)
+			if err != nil {
+				return reorderError(err)
+			}
+
+			if IsHumanOutput() {
+				where := resp.Position
+				if resp.Target != "" {
+					where += " " + resp.Target
+				}
+				output.SuccessHuman(fmt.Sprintf("Moved %s %s in %s (position %d)", resp.Issue, where, resp.State, resp.Index+1))
+			} else {
+				output.JSON(resp)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&before, "before", "", "Place the issue just above this issue")
+	cmd.Flags().StringVar(&after, "after", "", "Place the issue just below this issue")
+	cmd.Flags().BoolVar(&top, "top", false, "Move the issue to the top of its column")
+	cmd.Flags().BoolVar(&bottom, "bottom", false, "Move the issue to the bottom of its column")
+	markRefFlag(cmd, "before", resolver.KindIssue)
+	markRefFlag(cmd, "after", resolver.KindIssue)
+
+	return cmd
+}
+
+// ReorderTargetError reports a --before or --after issue the issue can't
+// be placed against
+type ReorderTargetError struct {
+	Issue, Target string
+	// State and TargetState differ when the issues are in different states
+	State, TargetState string
+}
+
+func (e *ReorderTargetError) Error() string {
+	if e.Issue == e.Target {
+		return fmt.Sprintf("cannot place %s relative to itself", e.Issue)
+	}
+	return fmt.Sprintf("%s is in %s but %s is in %s", e.Target, e.TargetState, e.Issue, e.State)
+}
+
+// Hint suggests moving the issue to the target's state first
+func (e *ReorderTargetError) Hint() string {
+	if e.Issue == e.Target {
+		return "Pass a different issue to --before or --after"
+	}
+	return fmt.Sprintf("Issues are ordered within a state; move %s first with 'linear issue update %s --state \"%s\"'", e.Issue, e.Issue, e.TargetState)
+}
+
+// reorderError reports an error from reorderIssue
+func reorderError(err error) error {
+	var targetErr *ReorderTargetError
+	if errors.As(err, &targetErr) {
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("INVALID_TARGET", targetErr.Error(), targetErr.Hint())
+			return nil
+		}
+		return output.ErrorWithHint("INVALID_TARGET", targetErr.Error(), targetErr.Hint())
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil
+	}
+	return output.ErrorFrom(err, "API_ERROR")
+}
+
+// reorderIssue moves issueID before or after another issue of its state,
+// or to the top or bottom of the state when neither is set
+func reorderIssue(ctx context.Context, client *api.Client, issueID, before, after string, top bool) (*IssueReorderResponse, error) {
+	issue, err := client.GetIssueOrder(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &IssueReorderResponse{
+		Success:   true,
+		Operation: "reorder",
+		Issue:     issue.Identifier,
+		State:     issue.State.Name,
+	}
+
+	var target *api.IssueOrder
+	switch {
+	case before != "" || after != "":
+		ref := before
+		resp.Position = "before"
+		if after != "" {
+			ref = after
+			resp.Position = "after"
+		}
+		target, err = client.GetIssueOrder(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		resp.Target = target.Identifier
+		if target.ID == issue.ID || target.State.ID != issue.State.ID {
+			return nil, &ReorderTargetError{
+				Issue:       issue.Identifier,
+				Target:      target.Identifier,
+				State:       issue.State.Name,
+				TargetState: target.State.Name,
+			}
+		}
+	case top:
+		resp.Position = "top"
+	default:
+		resp.Position = "bottom"
+	}
+
+	column, _, err := collectPages("", true, func(after string) ([]api.IssueOrder, *api.PageInfo, error) {
+		return client.GetStateIssueOrders(ctx, issue.State.ID, 250, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	others := make([]api.IssueOrder, 0, len(column))
+	for _, other := range column {
+		if other.ID != issue.ID {
+			others = append(others, other)
+		}
+	}
+	sort.SliceStable(others, func(i, j int) bool { return others[i].SortOrder < others[j].SortOrder })
+
+	orders := make([]float64, len(others))
+	targetIndex := -1
+	for i, other := range others {
+		orders[i] = other.SortOrder
+		if target != nil && other.ID == target.ID {
+			targetIndex = i
+		}
+	}
+	sortOrder, index := placeSortOrder(orders, resp.Position, targetIndex)
+	resp.SortOrder = sortOrder
+	resp.Index = index
+
+	if sortOrder != issue.SortOrder {
+		if _, err := client.UpdateIssue(ctx, issue.ID, api.IssueUpdateInput{SortOrder: &sortOrder}); err != nil {
+			return nil, err
+		}
+	}
+	recordIssue(issue.ID, issue.Identifier, issue.Title, "", history.ActionEdited)
+	return resp, nil
+}
+
+// placeSortOrder picks the sort order putting an item at position among
+// orders, the ascending sort orders of the other items it is ordered with,
+// and returns it with the item's resulting index. target is the index in
+// orders of the item placed before or after. Between two items it takes
+// the midpoint; at either end it steps a whole unit past the outermost item.
+func placeSortOrder(orders []float64, position string, target int) (float64, int) {
+	if len(orders) == 0 {
+		return 0, 0
+	}
+	i := target
+	switch position {
+	case "top":
+		i = 0
+	case "bottom":
+		i = len(orders)
+	case "after":
+		i++
+	}
+	// The item goes between orders[i-1] and orders[i]
+	switch {
+	case i <= 0:
+		return orders[0] - 1, 0
+	case i >= len(orders):
+		return orders[len(orders)-1] + 1, len(orders)
+	}
+	return (orders[i-1] + orders[i]) / 2, i
+}
@@ -0,0 +1,324 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// sedPageSize is the number of issues fetched per page while scanning
+const sedPageSize = 50
+
+// SedIssueResult describes the outcome of a replacement for a single issue
+type SedIssueResult struct {
+	ID          string   `json:"id"`
+	Identifier  string   `json:"identifier"`
+	Title       string   `json:"title"`
+	URL         string   `json:"url"`
+	Occurrences int      `json:"occurrences"`
+	Diff        []string `json:"diff"`
+	Updated     bool     `json:"updated"`
+	Error       string   `json:"error,omitempty"`
+}
+
+// SedResponse is the response for the issue sed command
+type SedResponse struct {
+	Success bool             `json:"success"`
+	DryRun  bool             `json:"dryRun"`
+	Team    string           `json:"team"`
+	Match   string           `json:"match"`
+	Replace string           `json:"replace"`
+	Regex   bool             `json:"regex"`
+	Scanned int              `json:"scanned"`
+	Matched int              `json:"matched"`
+	Updated int              `json:"updated"`
+	Failed  int              `json:"failed"`
+	Issues  []SedIssueResult `json:"issues"`
+}
+
+func newIssueSedCmd() *cobra.Command {
+	var (
+		teamKey  string
+		match    string
+		replace  string
+		useRegex bool
+		dryRun   bool
+		apply    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sed",
+		Short: "Search and replace text across issue descriptions",
+		Long: `Search and replace text across all issue descriptions in a team.
+
+Descriptions are scanned page by page. By default the command runs as a
+dry run and only reports the per-issue diffs; pass --apply to write the
+changes back to Linear. A description left empty by the replacement is
+cleared.
+
+With --regex, ${1}, ${2} and ${name} in --replace expand to the match's
+capture groups. Write ${1} rather than $1 when letters, digits or an
+underscore follow: $1x refers to a group named "1x", which expands to
+nothing.
+
+Examples:
+  linear issue sed --team ENG --match 'old-domain.com' --replace 'new-domain.com'
+  linear issue sed --team ENG --match 'old-domain.com' --replace 'new-domain.com' --apply
+  linear issue sed --team ENG --match 'v(\d+)\.old' --replace 'v${1}.new' --regex --apply`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if match == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_FIELD",
+						"Match text is required",
+						"Provide the text to search for using --match",
+						"linear issue sed --team ENG --match 'old' --replace 'new'",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_FIELD",
+					"Match text is required",
+					"Provide the text to search for using --match",
+					"linear issue sed --team ENG --match 'old' --replace 'new'",
+				)
+			}
+
+			if dryRun && apply {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", "--dry-run and --apply cannot be used together")
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", "--dry-run and --apply cannot be used together")
+			}
+
+			var re *regexp.Regexp
+			if useRegex {
+				compiled, err := regexp.Compile(match)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("INVALID_REGEX", fmt.Sprintf("Invalid regular expression: %s", err.Error()))
+						return nil
+					}
+					return output.Error("INVALID_REGEX", fmt.Sprintf("Invalid regular expression: %s", err.Error()))
+				}
+				re = compiled
+			}
+
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+			if teamKey == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_TEAM",
+						"Team is required",
+						"Specify a team using --team flag or set a default team",
+						"linear issue sed --team ENG --match 'old' --replace 'new'",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_TEAM",
+					"Team is required",
+					"Specify a team using --team flag or set a default team",
+					"linear issue sed --team ENG --match 'old' --replace 'new'",
+				)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			team, err := client.GetTeamByKey(ctx, teamKey)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if team == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+			}
+
+			// Literal matches can be narrowed server-side; regex matches
+			// require scanning every description in the team.
+			contains := match
+			if useRegex {
+				contains = ""
+			}
+
+			response := &SedResponse{
+				Success: true,
+				DryRun:  !apply,
+				Team:    team.Key,
+				Match:   match,
+				Replace: replace,
+				Regex:   useRegex,
+				Issues:  []SedIssueResult{},
+			}
+
+			after := ""
+			for {
+				page, err := client.GetIssueDescriptions(ctx, team.ID, contains, sedPageSize, after)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+
+				for _, issue := range page.Issues {
+					response.Scanned++
+
+					var (
+						updated     string
+						occurrences int
+					)
+					if re != nil {
+						occurrences = len(re.FindAllStringIndex(issue.Description, -1))
+						updated = re.ReplaceAllString(issue.Description, replace)
+					} else {
+						occurrences = strings.Count(issue.Description, match)
+						updated = strings.ReplaceAll(issue.Description, match, replace)
+					}
+					if occurrences == 0 || updated == issue.Description {
+						continue
+					}
+
+					result := SedIssueResult{
+						ID:          issue.ID,
+						Identifier:  issue.Identifier,
+						Title:       issue.Title,
+						URL:         issue.URL,
+						Occurrences: occurrences,
+						Diff:        lineDiff(issue.Description, updated),
+					}
+					response.Matched++
+
+					if apply {
+						_, err := client.UpdateIssue(ctx, issue.ID, api.IssueUpdateInput{Description: &updated})
+						if err != nil {
+							result.Error = err.Error()
+							response.Failed++
+						} else {
+							result.Updated = true
+							response.Updated++
+						}
+					}
+
+					response.Issues = append(response.Issues, result)
+				}
+
+				if !page.HasNextPage || page.EndCursor == "" {
+					break
+				}
+				after = page.EndCursor
+			}
+
+			if response.Failed > 0 {
+				response.Success = false
+				output.Fail("UPDATE_FAILED")
+			}
+
+			if IsHumanOutput() {
+				printSedResultsHuman(response)
+			} else {
+				output.JSON(response)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
+	cmd.Flags().StringVarP(&match, "match", "m", "", "Text to search for (required)")
+	cmd.Flags().StringVarP(&replace, "replace", "r", "", "Replacement text")
+	cmd.Flags().BoolVar(&useRegex, "regex", false, "Treat --match as a regular expression (${1} etc. expand in --replace)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report matches without updating issues (default)")
+	cmd.Flags().BoolVar(&apply, "apply", false, "Write the replacements back to Linear")
+
+	return cmd
+}
+
+// lineDiff returns a minimal line-oriented diff between two texts. Lines
+// shared by both texts at the start and end are elided, and the changed
+// block in between is reported as "-" and "+" lines prefixed with their
+// 1-based line number.
+func lineDiff(before, after string) []string {
+	a := strings.Split(before, "\n")
+	b := strings.Split(after, "\n")
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	diff := []string{}
+	for i := prefix; i < len(a)-suffix; i++ {
+		diff = append(diff, fmt.Sprintf("-%d: %s", i+1, a[i]))
+	}
+	for i := prefix; i < len(b)-suffix; i++ {
+		diff = append(diff, fmt.Sprintf("+%d: %s", i+1, b[i]))
+	}
+
+	return diff
+}
+
+func printSedResultsHuman(response *SedResponse) {
+	if response.Matched == 0 {
+		output.HumanLn("No matches found in %d issues", response.Scanned)
+		return
+	}
+
+	for _, issue := range response.Issues {
+		status := ""
+		if issue.Error != "" {
+			status = output.Red("  failed: %s", issue.Error)
+		} else if issue.Updated {
+			status = output.Green("  updated")
+		}
+
+		output.HumanLn("%s %s (%d)%s", output.Bold("%s", issue.Identifier), issue.Title, issue.Occurrences, status)
+		for _, line := range issue.Diff {
+			if strings.HasPrefix(line, "-") {
+				output.HumanLn("  %s", output.Red("%s", line))
+			} else {
+				output.HumanLn("  %s", output.Green("%s", line))
+			}
+		}
+		output.HumanLn("")
+	}
+
+	if response.DryRun {
+		output.HumanLn("%d of %d issues would be updated (dry run, pass --apply to write changes)", response.Matched, response.Scanned)
+		return
+	}
+
+	output.HumanLn("%d of %d issues updated", response.Updated, response.Scanned)
+	if response.Failed > 0 {
+		output.HumanLn("%s", output.Red("%d updates failed", response.Failed))
+	}
+}
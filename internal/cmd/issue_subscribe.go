@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+func newIssueSubscribeCmd() *cobra.Command {
+	return newIssueSubscriptionCmd(true)
+}
+
+func newIssueUnsubscribeCmd() *cobra.Command {
+	return newIssueSubscriptionCmd(false)
+}
+
+// newIssueSubscriptionCmd builds the subscribe/unsubscribe command
+func newIssueSubscriptionCmd(subscribe bool) *cobra.Command {
+	var users []string
+
+	use, short, done := "subscribe", "Subscribe to an issue's notifications", "Subscribed %s to %s"
+	if !subscribe {
+		use, short, done = "unsubscribe", "Unsubscribe from an issue's notifications", "Unsubscribed %s from %s"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " <issue-id>",
+		Short: short,
+		Long: fmt.Sprintf(`%s. Without --user this applies to you; --user
+takes a name, email, user ID or "me" and may be repeated.
+
+Examples:
+  linear issue %s ENG-123
+  linear issue %s ENG-123 --user alice@example.com --user bob`, short, use, use),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			if len(users) == 0 {
+				users = []string{"me"}
+			}
+			userIDs := make([]string, len(users))
+			for i, user := range users {
+				if userIDs[i], err = resolveUserID(ctx, client, user); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "INVALID_USER")
+						return nil
+					}
+					return output.ErrorFrom(err, "INVALID_USER")
+				}
+			}
+
+			for _, userID := range userIDs {
+				if err := client.SetIssueSubscription(ctx, issueID, userID, subscribe); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf(done, strings.Join(users, ", "), issueID))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": use,
+				"issueId":   issueID,
+				"userIds":   userIDs,
+			})
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&users, "user", nil, "User to "+use+" instead of you (name, email, ID or me); repeatable")
+
+	return cmd
+}
+
+func newIssueSubscribersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribers <issue-id>",
+		Short: "List the users subscribed to an issue",
+		Long: `List the users who get notified about changes to an issue.
+
+Examples:
+  linear issue subscribers ENG-123
+  linear issue subscribers ENG-123 --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			subscribers, err := client.GetIssueSubscribers(ctx, issueID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if subscribers == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+			}
+
+			if IsHumanOutput() {
+				printSubscribersHuman(issueID, subscribers)
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"issueId":     issueID,
+				"subscribers": subscribers,
+				"count":       len(subscribers),
+			})
+		},
+	}
+
+	return cmd
+}
+
+func printSubscribersHuman(issueID string, subscribers []api.User) {
+	if len(subscribers) == 0 {
+		output.HumanLn("No subscribers on %s", issueID)
+		return
+	}
+
+	output.HumanLn("Subscribers of %s:\n", issueID)
+
+	headers := []string{"NAME", "DISPLAY NAME", "EMAIL"}
+	rows := make([][]string, len(subscribers))
+	for i, user := range subscribers {
+		rows[i] = []string{user.Name, user.DisplayName, user.Email}
+	}
+	output.TableWithColors(headers, rows)
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/breakdown"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// SubtaskNode is a created sub-issue and its own sub-issues
+type SubtaskNode struct {
+	ID         string        `json:"id"`
+	Identifier string        `json:"identifier"`
+	Title      string        `json:"title"`
+	URL        string        `json:"url"`
+	Subtasks   []SubtaskNode `json:"subtasks,omitempty"`
+}
+
+// subtaskDefaults are the fields sub-issues inherit from the parent
+type subtaskDefaults struct {
+	teamID              string
+	projectID           string
+	cycleID             string
+	milestoneID         string
+	createMissingLabels bool
+}
+
+// plannedSubtask is a breakdown task resolved to create input
+type plannedSubtask struct {
+	input    api.IssueCreateInput
+	subtasks []plannedSubtask
+}
+
+// planSubtasks resolves every task's priority, assignee, labels and state
+// up front, so a bad entry fails before any issue is created
+func planSubtasks(ctx context.Context, client *api.Client, tasks []*breakdown.Task, defaults subtaskDefaults) ([]plannedSubtask, error) {
+	planned := make([]plannedSubtask, 0, len(tasks))
+	for _, task := range tasks {
+		input := api.IssueCreateInput{
+			Title:              task.Title,
+			Description:        task.Description,
+			TeamID:             defaults.teamID,
+			ProjectID:          defaults.projectID,
+			CycleID:            defaults.cycleID,
+			ProjectMilestoneID: defaults.milestoneID,
+		}
+
+		if task.Priority != "" {
+			priority, err := parsePriority(task.Priority)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", task.Title, err)
+			}
+			input.Priority = &priority
+		}
+		if task.Estimate > 0 {
+			estimate := task.Estimate
+			input.Estimate = &estimate
+		}
+		if task.Assignee != "" {
+			assigneeID, err := resolveUserID(ctx, client, task.Assignee)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", task.Title, err)
+			}
+			input.AssigneeID = assigneeID
+		}
+		if len(task.Labels) > 0 {
+			labelIDs, err := resolveLabelIDs(ctx, client, defaults.teamID, task.Labels, defaults.createMissingLabels)
+			if err != nil {
+				return nil, err
+			}
+			input.LabelIDs = labelIDs
+		}
+		if task.Done {
+			stateID, err := resolveStateID(ctx, client, defaults.teamID, "", "completed")
+			if err != nil {
+				return nil, err
+			}
+			input.StateID = stateID
+		}
+
+		children, err := planSubtasks(ctx, client, task.Subtasks, defaults)
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, plannedSubtask{input: input, subtasks: children})
+	}
+	return planned, nil
+}
+
+// createSubtasks creates planned sub-issues under parentID in order,
+// depth first. On failure it returns the issues created so far.
+func createSubtasks(ctx context.Context, client *api.Client, parentID string, planned []plannedSubtask) ([]SubtaskNode, error) {
+	nodes := make([]SubtaskNode, 0, len(planned))
+	for _, p := range planned {
+		input := p.input
+		input.ParentID = parentID
+
+		result, err := client.CreateIssue(ctx, input)
+		if err != nil {
+			return nodes, fmt.Errorf("creating '%s': %w", input.Title, err)
+		}
+
+		node := SubtaskNode{
+			ID:         result.ID,
+			Identifier: result.Identifier,
+			Title:      input.Title,
+			URL:        result.URL,
+		}
+		node.Subtasks, err = createSubtasks(ctx, client, result.ID, p.subtasks)
+		nodes = append(nodes, node)
+		if err != nil {
+			return nodes, err
+		}
+	}
+	return nodes, nil
+}
+
+// countSubtaskNodes counts created sub-issues at every level
+func countSubtaskNodes(nodes []SubtaskNode) int {
+	n := len(nodes)
+	for _, node := range nodes {
+		n += countSubtaskNodes(node.Subtasks)
+	}
+	return n
+}
+
+// printSubtaskTree prints created sub-issues as an indented tree
+func printSubtaskTree(nodes []SubtaskNode, prefix string) {
+	for i, node := range nodes {
+		branch, next := "├─ ", "│  "
+		if i == len(nodes)-1 {
+			branch, next = "└─ ", "   "
+		}
+		output.HumanLn("%s%s%s %s", prefix, branch, output.Cyan("%s", node.Identifier), node.Title)
+		printSubtaskTree(node.Subtasks, prefix+next)
+	}
+}
@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// minWatchInterval keeps polling within Linear's rate limits
+const minWatchInterval = 5 * time.Second
+
+// issueWatchEvent is one line of 'issue watch' output
+type issueWatchEvent struct {
+	Event   string       `json:"event"`
+	Issue   string       `json:"issue"`
+	At      string       `json:"at"`
+	From    string       `json:"from,omitempty"`
+	To      string       `json:"to,omitempty"`
+	State   string       `json:"state,omitempty"`
+	Comment *api.Comment `json:"comment,omitempty"`
+}
+
+func newIssueWatchCmd() *cobra.Command {
+	var (
+		interval time.Duration
+		until    []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch <issue-id>",
+		Short: "Stream state, assignee and comment changes on an issue",
+		Long: `Poll an issue and print its state changes, new comments and assignee
+changes as they happen, until interrupted.
+
+Events are written one JSON object per line ("watching" first, then
+"state", "assignee" and "comment"), or as a readable log with --human.
+
+With --until, watching stops once the issue reaches one of the given
+states, matched by name or type. This lets an agent block until a human
+finishes a review. For push delivery of every workspace event, see
+'linear webhook listen'.
+
+Examples:
+  linear issue watch ENG-123
+  linear issue watch ENG-123 --interval 10s --human
+  linear issue watch ENG-123 --until Done --until canceled`,
+		Annotations: map[string]string{noTimeoutAnnotation: "true"},
+		Args:        cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if interval < minWatchInterval {
+				msg := fmt.Sprintf("--interval must be at least %s", minWatchInterval)
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_INTERVAL", msg)
+					return nil
+				}
+				return output.Error("INVALID_INTERVAL", msg)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			issue, err := client.GetIssue(ctx, args[0], true)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			emit := issueWatchPrinter()
+			emit(issueWatchEvent{Event: "watching", Issue: issue.Identifier, State: issue.State.Name})
+			if issueInStates(issue, until) {
+				return nil
+			}
+
+			seen := map[string]bool{}
+			for _, c := range issue.Comments {
+				seen[c.ID] = true
+			}
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+
+				current, err := client.GetIssue(ctx, issue.ID, true)
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil
+					}
+					// Keep watching through transient failures
+					fmt.Fprintf(os.Stderr, "watch: %v\n", err)
+					continue
+				}
+
+				for _, event := range diffIssue(issue, current, seen) {
+					emit(event)
+				}
+				issue = current
+				if issueInStates(issue, until) {
+					return nil
+				}
+			}
+		},
+	}
+
+	cmd.Flags().DurationVar(&interval, "interval", 30*time.Second, "Time between polls (minimum 5s)")
+	cmd.Flags().StringArrayVar(&until, "until", nil, "Stop when the issue reaches this state name or type (repeatable)")
+
+	return cmd
+}
+
+// diffIssue returns the events between two snapshots of an issue. seen
+// holds the IDs of comments already reported and is updated.
+func diffIssue(prev, cur *api.IssueDetail, seen map[string]bool) []issueWatchEvent {
+	var events []issueWatchEvent
+	if prev.State.ID != cur.State.ID {
+		events = append(events, issueWatchEvent{
+			Event: "state", Issue: cur.Identifier,
+			From: prev.State.Name, To: cur.State.Name,
+		})
+	}
+	if from, to := assigneeName(prev.Assignee), assigneeName(cur.Assignee); from != to {
+		events = append(events, issueWatchEvent{
+			Event: "assignee", Issue: cur.Identifier,
+			From: from, To: to,
+		})
+	}
+	for i := range cur.Comments {
+		c := cur.Comments[i]
+		if seen[c.ID] {
+			continue
+		}
+		seen[c.ID] = true
+		events = append(events, issueWatchEvent{Event: "comment", Issue: cur.Identifier, Comment: &c})
+	}
+	return events
+}
+
+func assigneeName(a *api.IssueAssignee) string {
+	if a == nil {
+		return ""
+	}
+	return a.DisplayName
+}
+
+// issueInStates reports whether the issue's state matches one of states by
+// name or type
+func issueInStates(issue *api.IssueDetail, states []string) bool {
+	return containsFold(states, issue.State.Name) || containsFold(states, issue.State.Type)
+}
+
+// issueWatchPrinter returns a callback that writes watch events to stdout
+// as they occur
+func issueWatchPrinter() func(issueWatchEvent) {
+	encoder := json.NewEncoder(os.Stdout)
+
+	return func(event issueWatchEvent) {
+		event.At = time.Now().UTC().Format(time.RFC3339)
+		if !IsHumanOutput() {
+			encoder.Encode(event)
+			return
+		}
+
+		var text string
+		switch event.Event {
+		case "watching":
+			text = fmt.Sprintf("Watching %s (%s). Press Ctrl+C to stop", event.Issue, event.State)
+		case "state":
+			text = fmt.Sprintf("%s → %s", event.From, output.Green("%s", event.To))
+		case "assignee":
+			text = fmt.Sprintf("%s → %s", orNone(event.From), output.Green("%s", orNone(event.To)))
+		case "comment":
+			author := "Unknown"
+			if event.Comment.User != nil {
+				author = event.Comment.User.DisplayName
+			}
+			body := strings.Join(strings.Fields(event.Comment.Body), " ")
+			if len([]rune(body)) > 80 {
+				body = string([]rune(body)[:77]) + "..."
+			}
+			text = fmt.Sprintf("%s: %s", output.Bold("%s", author), body)
+		}
+		output.HumanLn("%s %s %s",
+			output.Muted("%s", time.Now().Format("15:04:05")),
+			output.Cyan("%-8s", event.Event),
+			text,
+		)
+	}
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
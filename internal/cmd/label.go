@@ -40,13 +40,16 @@ Labels are team-scoped and can be organized hierarchically with parent labels.
 
 Examples:
   linear label list --team ENG
-  linear label create --name "bug" --color "#FF0000" --team ENG`,
+  linear label create --name "bug" --color "#FF0000" --team ENG
+  linear label audit --team ENG`,
 	}
 
 	cmd.AddCommand(newLabelListCmd())
 	cmd.AddCommand(newLabelCreateCmd())
 	cmd.AddCommand(newLabelUpdateCmd())
 	cmd.AddCommand(newLabelDeleteCmd())
+	cmd.AddCommand(newLabelAuditCmd())
+	cmd.AddCommand(newLabelMergeCmd())
 
 	return cmd
 }
@@ -55,7 +58,6 @@ func newLabelListCmd() *cobra.Command {
 	var (
 		teamKey string
 		plain   bool
-		refresh bool
 	)
 
 	cmd := &cobra.Command{
@@ -76,18 +78,36 @@ Examples:
 			}
 			if teamKey == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Team is required. Use --team flag or configure default team.")
+					output.ErrorHuman("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 					return nil
 				}
 				return output.Error("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 			}
 
-			ctx := context.Background()
+			if IsOffline() {
+				team, err := offlineTeam(teamKey)
+				if err != nil {
+					return offlineError(err)
+				}
+				labels, info, err := readOffline[api.LabelsResponse](cache.TeamKey("labels", team.ID))
+				if err != nil {
+					return offlineError(err)
+				}
+				response := newLabelsListResponse(labels)
+				if IsHumanOutput() {
+					printLabelsHuman(response, team.Key, plain)
+					printOfflineNoticeHuman(info)
+					return nil
+				}
+				return outputOfflineJSON(response, info)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -97,14 +117,14 @@ Examples:
 			team, err := client.GetTeamByKey(ctx, teamKey)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 			if team == nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
 					return nil
 				}
 				return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
@@ -116,7 +136,7 @@ Examples:
 			cacheManager, _ := cache.NewManager()
 			cacheKey := cache.TeamKey("labels", team.ID)
 
-			if !refresh && cacheManager != nil {
+			if cacheManager != nil {
 				cached, _ := cache.Read[api.LabelsResponse](cacheManager, cacheKey)
 				if cached != nil {
 					labels = cached
@@ -128,10 +148,10 @@ Examples:
 				labels, err = client.GetLabels(ctx, team.ID)
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHumanFrom(err, "API_ERROR")
 						return nil
 					}
-					return output.Error("API_ERROR", err.Error())
+					return output.ErrorFrom(err, "API_ERROR")
 				}
 
 				// Cache the results
@@ -140,26 +160,7 @@ Examples:
 				}
 			}
 
-			// Sort alphabetically
-			sort.Slice(labels.Labels, func(i, j int) bool {
-				return labels.Labels[i].Name < labels.Labels[j].Name
-			})
-
-			// Convert to response format
-			response := &LabelsListResponse{
-				Labels: make([]LabelResponse, len(labels.Labels)),
-				Count:  len(labels.Labels),
-			}
-			for i, l := range labels.Labels {
-				response.Labels[i] = LabelResponse{
-					ID:    l.ID,
-					Name:  l.Name,
-					Color: l.Color,
-				}
-				if l.ParentID != "" {
-					response.Labels[i].ParentID = &l.ParentID
-				}
-			}
+			response := newLabelsListResponse(labels)
 
 			if IsHumanOutput() {
 				printLabelsHuman(response, team.Key, plain)
@@ -173,11 +174,34 @@ Examples:
 
 	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
 	cmd.Flags().BoolVar(&plain, "plain", false, "Plain output without colors")
-	cmd.Flags().BoolVar(&refresh, "refresh", false, "Bypass cache and fetch fresh data")
 
 	return cmd
 }
 
+// newLabelsListResponse sorts labels alphabetically and converts them to
+// the response format
+func newLabelsListResponse(labels *api.LabelsResponse) *LabelsListResponse {
+	sort.Slice(labels.Labels, func(i, j int) bool {
+		return labels.Labels[i].Name < labels.Labels[j].Name
+	})
+
+	response := &LabelsListResponse{
+		Labels: make([]LabelResponse, len(labels.Labels)),
+		Count:  len(labels.Labels),
+	}
+	for i, l := range labels.Labels {
+		response.Labels[i] = LabelResponse{
+			ID:    l.ID,
+			Name:  l.Name,
+			Color: l.Color,
+		}
+		if l.ParentID != "" {
+			response.Labels[i].ParentID = &l.ParentID
+		}
+	}
+	return response
+}
+
 func newLabelCreateCmd() *cobra.Command {
 	var (
 		name        string
@@ -204,7 +228,7 @@ Examples:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if name == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Label name is required. Use --name flag.")
+					output.ErrorHuman("MISSING_NAME", "Label name is required. Use --name flag.")
 					return nil
 				}
 				return output.Error("MISSING_NAME", "Label name is required. Use --name flag.")
@@ -215,18 +239,18 @@ Examples:
 			}
 			if teamKey == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Team is required. Use --team flag or configure default team.")
+					output.ErrorHuman("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 					return nil
 				}
 				return output.Error("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -236,14 +260,14 @@ Examples:
 			team, err := client.GetTeamByKey(ctx, teamKey)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 			if team == nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
 					return nil
 				}
 				return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
@@ -253,10 +277,10 @@ Examples:
 			label, err := createLabel(ctx, client, team.ID, name, description, color, parentID, isGroup)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			// Clear cache
@@ -318,18 +342,18 @@ Examples:
 			// Check that at least one field is provided
 			if name == "" && description == "" && color == "" && parentID == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("At least one field must be provided to update (--name, --description, --color, --parent)")
+					output.ErrorHuman("MISSING_FIELD", "At least one field must be provided to update (--name, --description, --color, --parent)")
 					return nil
 				}
 				return output.Error("MISSING_FIELD", "At least one field must be provided to update")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -339,10 +363,10 @@ Examples:
 			label, err := updateLabel(ctx, client, labelID, name, description, color, parentID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			response := map[string]interface{}{
@@ -384,12 +408,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			labelID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -399,10 +423,10 @@ Examples:
 			err = deleteLabel(ctx, client, labelID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -422,6 +446,14 @@ Examples:
 	return cmd
 }
 
+// IssueLabelCreateInput and IssueLabelUpdateInput hold label mutation
+// input. The GraphQL client names a variable's type after its Go type, so
+// they must match Linear's input type names.
+type (
+	IssueLabelCreateInput map[string]interface{}
+	IssueLabelUpdateInput map[string]interface{}
+)
+
 // createLabel creates a new label via GraphQL
 func createLabel(ctx context.Context, client *api.Client, teamID, name, description, color, parentID string, isGroup bool) (*LabelResponse, error) {
 	var mutation struct {
@@ -435,7 +467,7 @@ func createLabel(ctx context.Context, client *api.Client, teamID, name, descript
 		} `graphql:"issueLabelCreate(input: $input)"`
 	}
 
-	input := map[string]interface{}{
+	input := IssueLabelCreateInput{
 		"name":   name,
 		"teamId": teamID,
 	}
@@ -484,7 +516,7 @@ func updateLabel(ctx context.Context, client *api.Client, labelID, name, descrip
 		} `graphql:"issueLabelUpdate(id: $id, input: $input)"`
 	}
 
-	input := map[string]interface{}{}
+	input := IssueLabelUpdateInput{}
 	if name != "" {
 		input["name"] = name
 	}
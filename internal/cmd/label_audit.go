@@ -0,0 +1,439 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// labelAuditPageSize is the page size when listing labels for an audit
+const labelAuditPageSize = 250
+
+// Reasons labels are near-duplicates, from closest to loosest
+const (
+	duplicateCase    = "case"
+	duplicateSpacing = "spacing"
+	duplicateTypo    = "typo"
+)
+
+// duplicateRank orders duplicate reasons from closest to loosest
+var duplicateRank = map[string]int{duplicateCase: 0, duplicateSpacing: 1, duplicateTypo: 2}
+
+// LabelDuplicateGroup is a set of labels that look like the same label
+type LabelDuplicateGroup struct {
+	// Reason is the loosest match within the group: "case" (names differ
+	// only in case), "spacing" (also in spaces, dashes or underscores) or
+	// "typo" (a small edit distance apart)
+	Reason string               `json:"reason"`
+	Labels []api.WorkspaceLabel `json:"labels"`
+}
+
+// LabelAuditResponse is the response for 'label audit'
+type LabelAuditResponse struct {
+	Team    string                `json:"team,omitempty"`
+	Scanned int                   `json:"scanned"`
+	Groups  []LabelDuplicateGroup `json:"groups"`
+	Count   int                   `json:"count"`
+}
+
+// LabelMergeResponse is the response for 'label merge'
+type LabelMergeResponse struct {
+	Success   bool               `json:"success"`
+	Operation string             `json:"operation"`
+	DryRun    bool               `json:"dryRun,omitempty"`
+	From      api.WorkspaceLabel `json:"from"`
+	To        api.WorkspaceLabel `json:"to"`
+	Issues    []BatchIssueResult `json:"issues"`
+	Failed    []BatchIssueResult `json:"failed"`
+	Count     int                `json:"count"`
+	// Archived reports whether the merged label was archived; it is kept
+	// when any issue failed to be relabeled
+	Archived bool `json:"archived"`
+}
+
+func newLabelAuditCmd() *cobra.Command {
+	var teamKey string
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Find near-duplicate labels",
+		Long: `Find labels that look like the same label: names that differ only in
+case, in spacing and separators ("Bug fix", "bug-fix", "bugfix"), or by a
+typo of one or two characters.
+
+With --team, the team's labels and the workspace labels it uses are
+audited; without it, every label in the workspace. Labels of different
+teams are only grouped through a workspace label, since an issue can't
+carry another team's label.
+
+Fix a group with 'linear label merge <from-id> <to-id>'.
+
+Examples:
+  linear label audit --team ENG --human
+  linear label audit`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			teamID := ""
+			if teamKey != "" {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+				}
+				teamID = team.ID
+				teamKey = team.Key
+			}
+
+			labels, _, err := collectPages("", true, func(after string) ([]api.WorkspaceLabel, *api.PageInfo, error) {
+				return client.GetWorkspaceLabels(ctx, teamID, labelAuditPageSize, after)
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			groups := findDuplicateLabels(labels)
+			resp := &LabelAuditResponse{Team: teamKey, Scanned: len(labels), Groups: groups, Count: len(groups)}
+
+			if IsHumanOutput() {
+				printLabelAuditHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (default: the whole workspace)")
+
+	return cmd
+}
+
+func newLabelMergeCmd() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "merge <from-id> <to-id>",
+		Short: "Move issues from one label to another and archive it",
+		Long: `Relabel every issue that has the first label with the second, then
+archive the first label. Issues are listed before any is changed, so the
+merge covers all of them however many pages there are.
+
+The first label is kept when any issue fails to be relabeled; run the
+merge again to retry them. A team label can only absorb labels of its own
+team.
+
+Examples:
+  linear label audit --team ENG
+  linear label merge <from-id> <to-id> --dry-run
+  linear label merge <from-id> <to-id>`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromID, toID := args[0], args[1]
+			mergeErr := func(code, msg string) error {
+				if IsHumanOutput() {
+					output.ErrorHuman(code, msg)
+					return nil
+				}
+				return output.Error(code, msg)
+			}
+
+			if fromID == toID {
+				return mergeErr("INVALID_INPUT", "Cannot merge a label into itself")
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				return mergeErr("AUTH_ERROR", err.Error())
+			}
+
+			var from, to *api.WorkspaceLabel
+			err = api.Parallel(ctx,
+				func(ctx context.Context) (err error) {
+					from, err = client.GetLabel(ctx, fromID)
+					return err
+				},
+				func(ctx context.Context) (err error) {
+					to, err = client.GetLabel(ctx, toID)
+					return err
+				},
+			)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if from == nil {
+				return mergeErr("NOT_FOUND", fmt.Sprintf("Label '%s' not found", fromID))
+			}
+			if to == nil {
+				return mergeErr("NOT_FOUND", fmt.Sprintf("Label '%s' not found", toID))
+			}
+			if to.TeamID != "" && from.TeamID != to.TeamID {
+				return mergeErr("INVALID_INPUT", fmt.Sprintf("Cannot merge '%s' into '%s': it belongs to team %s, and issues outside it can't use it", from.Name, to.Name, to.TeamKey))
+			}
+
+			issues, _, err := collectPages("", true, func(after string) ([]api.IssueListItem, *api.PageInfo, error) {
+				page, err := client.GetIssues(ctx, api.IssueFilter{Labels: []string{from.ID}}, reportPageSize, "", after)
+				if err != nil {
+					return nil, nil, err
+				}
+				return page.Issues, page.PageInfo, nil
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			resp := &LabelMergeResponse{
+				Operation: "merge",
+				DryRun:    dryRun,
+				From:      *from,
+				To:        *to,
+				Issues:    []BatchIssueResult{},
+				Failed:    []BatchIssueResult{},
+			}
+
+			progress := output.NewProgress("Relabeling", len(issues))
+			for _, issue := range issues {
+				progress.Add(1)
+				result := BatchIssueResult{ID: issue.ID, Identifier: issue.Identifier}
+				if !dryRun {
+					err := client.AddIssueLabel(ctx, issue.ID, to.ID)
+					if err == nil {
+						err = client.RemoveIssueLabel(ctx, issue.ID, from.ID)
+					}
+					if err != nil {
+						result.Error = err.Error()
+						resp.Failed = append(resp.Failed, result)
+						continue
+					}
+				}
+				resp.Issues = append(resp.Issues, result)
+			}
+			progress.Done()
+
+			resp.Count = len(resp.Issues)
+			resp.Success = len(resp.Failed) == 0
+			if !dryRun && resp.Success {
+				if err := deleteLabel(ctx, client, from.ID); err != nil {
+					resp.Success = false
+					resp.Failed = append(resp.Failed, BatchIssueResult{Identifier: from.Name, Error: fmt.Sprintf("failed to archive label: %v", err)})
+				} else {
+					resp.Archived = true
+				}
+			}
+
+			// Cached label lists and issue labels are now out of date
+			if !dryRun {
+				if cacheManager, _ := cache.NewManager(); cacheManager != nil {
+					for _, teamID := range []string{from.TeamID, to.TeamID} {
+						if teamID != "" {
+							cacheManager.Clear(cache.TeamKey("labels", teamID))
+						}
+					}
+				}
+			}
+
+			if !resp.Success {
+				output.Fail("MERGE_FAILED")
+			}
+
+			if IsHumanOutput() {
+				printLabelMergeHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the issues that would be relabeled without changing anything")
+
+	return cmd
+}
+
+// normalizeLabelName folds case and drops spaces and separators, so
+// "Bug fix", "bug-fix" and "bugfix" compare equal
+func normalizeLabelName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch r {
+		case ' ', '-', '_', '.', '/', ':':
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// duplicateReason returns why two label names look like the same label,
+// or "" if they don't. Short names need an exact match after
+// normalizing, since "bug" and "bag" are different words.
+func duplicateReason(a, b string) string {
+	if strings.EqualFold(a, b) {
+		return duplicateCase
+	}
+	na, nb := normalizeLabelName(a), normalizeLabelName(b)
+	if na == nb {
+		return duplicateSpacing
+	}
+	shortest := min(len([]rune(na)), len([]rune(nb)))
+	allowed := 0
+	switch {
+	case shortest >= 8:
+		allowed = 2
+	case shortest >= 5:
+		allowed = 1
+	}
+	if allowed > 0 && editDistance(na, nb) <= allowed {
+		return duplicateTypo
+	}
+	return ""
+}
+
+// labelsCompatible reports whether one label could replace the other:
+// both belong to the same team, or one is a workspace label
+func labelsCompatible(a, b api.WorkspaceLabel) bool {
+	return a.TeamID == "" || b.TeamID == "" || a.TeamID == b.TeamID
+}
+
+// findDuplicateLabels groups labels whose names look alike, linking each
+// near-duplicate pair so chains such as "bug fix", "bugfix", "bugfx" form
+// one group. Groups are ordered by their first label's name.
+func findDuplicateLabels(labels []api.WorkspaceLabel) []LabelDuplicateGroup {
+	parent := make([]int, len(labels))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	reasons := map[int]string{}
+	for i := range labels {
+		for j := i + 1; j < len(labels); j++ {
+			if !labelsCompatible(labels[i], labels[j]) {
+				continue
+			}
+			reason := duplicateReason(labels[i].Name, labels[j].Name)
+			if reason == "" {
+				continue
+			}
+			ri, rj := find(i), find(j)
+			loosest := reason
+			for _, r := range []string{reasons[ri], reasons[rj]} {
+				if r != "" && duplicateRank[r] > duplicateRank[loosest] {
+					loosest = r
+				}
+			}
+			parent[rj] = ri
+			reasons[ri] = loosest
+		}
+	}
+
+	members := map[int][]api.WorkspaceLabel{}
+	for i, label := range labels {
+		root := find(i)
+		members[root] = append(members[root], label)
+	}
+
+	groups := []LabelDuplicateGroup{}
+	for root, group := range members {
+		if len(group) < 2 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool { return strings.ToLower(group[i].Name) < strings.ToLower(group[j].Name) })
+		groups = append(groups, LabelDuplicateGroup{Reason: reasons[root], Labels: group})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return strings.ToLower(groups[i].Labels[0].Name) < strings.ToLower(groups[j].Labels[0].Name)
+	})
+	return groups
+}
+
+// printLabelAuditHuman lists each group of near-duplicate labels
+func printLabelAuditHuman(resp *LabelAuditResponse) {
+	scope := "the workspace"
+	if resp.Team != "" {
+		scope = "team " + resp.Team
+	}
+	if resp.Count == 0 {
+		output.HumanLn("No near-duplicate labels among %d labels in %s", resp.Scanned, scope)
+		return
+	}
+
+	output.HumanLn("%s", output.Bold("%d groups of near-duplicate labels in %s", resp.Count, scope))
+	for _, g := range resp.Groups {
+		output.HumanLn("\n  %s", output.Muted("%s", g.Reason))
+		for _, l := range g.Labels {
+			team := "workspace"
+			if l.TeamKey != "" {
+				team = l.TeamKey
+			}
+			output.HumanLn("    %-30s %-10s %s", l.Name, team, output.Muted("%s", l.ID))
+		}
+	}
+	output.HumanLn("\nMerge a label into another with 'linear label merge <from-id> <to-id>'")
+}
+
+// printLabelMergeHuman reports the relabeled issues and the archived label
+func printLabelMergeHuman(resp *LabelMergeResponse) {
+	verb := "Relabeled"
+	if resp.DryRun {
+		verb = "Would relabel"
+	}
+	for _, r := range resp.Issues {
+		output.HumanLn("%s %s %s", output.Green("✓"), verb, r.Identifier)
+	}
+	for _, r := range resp.Failed {
+		output.HumanLn("%s %s: %s", output.Red("✗"), r.Identifier, r.Error)
+	}
+	output.HumanLn("\n%d issues moved from '%s' to '%s', %d failed", len(resp.Issues), resp.From.Name, resp.To.Name, len(resp.Failed))
+	switch {
+	case resp.DryRun:
+	case resp.Archived:
+		output.SuccessHuman(fmt.Sprintf("Archived label '%s'", resp.From.Name))
+	default:
+		output.HumanLn("%s", output.Muted("Label '%s' was kept; run the merge again to retry", resp.From.Name))
+	}
+}
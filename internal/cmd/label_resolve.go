@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// maxLabelSuggestions caps how many close matches an error suggests
+const maxLabelSuggestions = 3
+
+// teamLabels returns a team's labels, using the 24-hour cache shared with
+// 'linear label list'
+func teamLabels(ctx context.Context, client *api.Client, teamID string) (*api.LabelsResponse, error) {
+	cacheManager, _ := cache.NewManager()
+	cacheKey := cache.TeamKey("labels", teamID)
+
+	if cacheManager != nil {
+		if cached, _ := cache.Read[api.LabelsResponse](cacheManager, cacheKey); cached != nil {
+			return cached, nil
+		}
+	}
+
+	labels, err := client.GetLabels(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if cacheManager != nil {
+		cache.Write(cacheManager, cacheKey, *labels)
+	}
+	return labels, nil
+}
+
+// LabelResolveError reports label names that matched no team label
+type LabelResolveError struct {
+	Missing     []string
+	Suggestions map[string][]string
+}
+
+func (e *LabelResolveError) Error() string {
+	return fmt.Sprintf("Labels not found: %s", strings.Join(e.Missing, ", "))
+}
+
+// Hint suggests close matches, or creating the labels
+func (e *LabelResolveError) Hint() string {
+	parts := []string{}
+	for _, name := range e.Missing {
+		if s := e.Suggestions[name]; len(s) > 0 {
+			parts = append(parts, fmt.Sprintf("'%s': did you mean %s?", name, strings.Join(s, ", ")))
+		}
+	}
+	parts = append(parts, "Use --create-missing-labels to create them")
+	return strings.Join(parts, "\n")
+}
+
+// resolveLabelIDs turns label names (case-insensitive) or IDs into label IDs
+// for a team. Missing names are created when createMissing is set;
+// otherwise they fail with a LabelResolveError carrying suggestions.
+func resolveLabelIDs(ctx context.Context, client *api.Client, teamID string, names []string, createMissing bool) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(names))
+	pending := []string{}
+	for _, name := range names {
+		if isUUID(name) {
+			ids = append(ids, name)
+		} else {
+			pending = append(pending, strings.TrimSpace(name))
+		}
+	}
+	if len(pending) == 0 {
+		return ids, nil
+	}
+
+	labels, err := teamLabels(ctx, client, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := []string{}
+	for _, name := range pending {
+		if label := findLabel(labels.Labels, name); label != nil {
+			ids = append(ids, label.ID)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) == 0 {
+		return ids, nil
+	}
+
+	if !createMissing {
+		resolveErr := &LabelResolveError{Missing: missing, Suggestions: map[string][]string{}}
+		for _, name := range missing {
+			resolveErr.Suggestions[name] = suggestLabels(labels.Labels, name)
+		}
+		return nil, resolveErr
+	}
+
+	for _, name := range missing {
+		created, err := createLabel(ctx, client, teamID, name, "", "", "", false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create label '%s': %w", name, err)
+		}
+		ids = append(ids, created.ID)
+	}
+
+	// The cached label list is now out of date
+	if cacheManager, _ := cache.NewManager(); cacheManager != nil {
+		cacheManager.Clear(cache.TeamKey("labels", teamID))
+	}
+
+	return ids, nil
+}
+
+// findLabel returns the label with the given name, case-insensitively
+func findLabel(labels []api.Label, name string) *api.Label {
+	for i, l := range labels {
+		if strings.EqualFold(l.Name, name) {
+			return &labels[i]
+		}
+	}
+	return nil
+}
+
+// suggestLabels returns label names close to name: those containing it or
+// within a small edit distance, closest first
+func suggestLabels(labels []api.Label, name string) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+
+	lower := strings.ToLower(name)
+	candidates := []candidate{}
+	for _, l := range labels {
+		labelLower := strings.ToLower(l.Name)
+		d := editDistance(lower, labelLower)
+		if strings.Contains(labelLower, lower) || strings.Contains(lower, labelLower) {
+			d = 0
+		}
+		if d <= 2 {
+			candidates = append(candidates, candidate{l.Name, d})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+
+	suggestions := []string{}
+	for i := 0; i < len(candidates) && i < maxLabelSuggestions; i++ {
+		suggestions = append(suggestions, candidates[i].name)
+	}
+	return suggestions
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+// labelError reports a failed label resolution with suggestions
+func labelError(err error) error {
+	var resolveErr *LabelResolveError
+	if errors.As(err, &resolveErr) {
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("INVALID_LABEL", resolveErr.Error(), resolveErr.Hint())
+			return nil
+		}
+		return output.ErrorWithHint("INVALID_LABEL", resolveErr.Error(), resolveErr.Hint())
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil
+	}
+	return output.ErrorFrom(err, "API_ERROR")
+}
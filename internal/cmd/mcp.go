@@ -0,0 +1,560 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/mcp"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+// mcpDefaultLimit is the page size of list and search tools without a limit
+const mcpDefaultLimit = 25
+
+// NewMCPCmd creates the mcp command group
+func NewMCPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mcp",
+		Short: "Serve the CLI as a Model Context Protocol server",
+		Long: `Expose Linear to AI agents as a Model Context Protocol (MCP) tool
+provider, using the CLI's authentication and configuration.`,
+	}
+
+	cmd.AddCommand(newMCPServeCmd())
+
+	return cmd
+}
+
+func newMCPServeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "Run an MCP server over stdio",
+		Long: `Run a Model Context Protocol server on stdin and stdout. Agent frameworks
+start it as a subprocess and call its tools:
+
+  list_teams, list_issues, get_issue, search_issues, create_issue,
+  update_issue, list_comments, add_comment, list_projects, get_project,
+  list_documents, get_document, search_documents, create_document
+
+Issue arguments accept identifiers, Linear URLs and bare numbers with the
+default team. Tool results are the same JSON the commands print.
+
+Example client configuration:
+
+  {
+    "mcpServers": {
+      "linear": {"command": "linear", "args": ["mcp", "serve"]}
+    }
+  }`,
+		Annotations: map[string]string{noTimeoutAnnotation: "true"},
+		Args:        cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			server := mcp.NewServer("linear", cmd.Root().Version)
+			for _, tool := range mcpTools(client) {
+				server.AddTool(tool)
+			}
+
+			if err := server.Serve(ctx, os.Stdin, os.Stdout); err != nil && !errors.Is(err, context.Canceled) {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+				return output.Error("MCP_ERROR", err.Error())
+			}
+			return nil
+		},
+	}
+}
+
+// mcpTools returns the tools served by 'linear mcp serve'
+func mcpTools(client *api.Client) []mcp.Tool {
+	return []mcp.Tool{
+		{
+			Name:        "list_teams",
+			Description: "List the teams in the Linear workspace with their keys.",
+			InputSchema: mcp.Object(map[string]interface{}{}),
+			Handler: func(ctx context.Context, _ json.RawMessage) (interface{}, error) {
+				return client.GetTeams(ctx)
+			},
+		},
+		{
+			Name:        "list_issues",
+			Description: "List issues filtered by team, assignee, state type, project or labels.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"team":        mcp.String("Team key such as ENG (default: the configured team)"),
+				"assignee":    mcp.String("Assignee email, name or \"self\""),
+				"state_types": mcp.Strings("State types: triage, backlog, unstarted, started, completed, canceled"),
+				"project":     mcp.String("Project ID"),
+				"labels":      mcp.Strings("Label names the issues must all have"),
+				"limit":       mcp.Integer("Maximum issues to return (default 25)"),
+			}),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					Team       string   `json:"team"`
+					Assignee   string   `json:"assignee"`
+					StateTypes []string `json:"state_types"`
+					Project    string   `json:"project"`
+					Labels     []string `json:"labels"`
+					Limit      int      `json:"limit"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				teamID, err := mcpTeamID(ctx, client, args.Team, false)
+				if err != nil {
+					return nil, err
+				}
+				assigneeID, err := resolveUserID(ctx, client, args.Assignee)
+				if err != nil {
+					return nil, err
+				}
+				filter := api.IssueFilter{
+					TeamID:     teamID,
+					AssigneeID: assigneeID,
+					StateTypes: args.StateTypes,
+					ProjectID:  args.Project,
+					Labels:     args.Labels,
+				}
+				return client.GetIssues(ctx, filter, mcpLimit(args.Limit), "", "")
+			},
+		},
+		{
+			Name:        "get_issue",
+			Description: "Get an issue's details by identifier (ENG-123), URL or ID.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"id":               mcp.String("Issue identifier, Linear URL or ID"),
+				"include_comments": mcp.Boolean("Include the issue's comments"),
+			}, "id"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					ID              string `json:"id"`
+					IncludeComments bool   `json:"include_comments"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				id, err := mcpIssueRef(args.ID)
+				if err != nil {
+					return nil, err
+				}
+				return client.GetIssue(ctx, id, args.IncludeComments)
+			},
+		},
+		{
+			Name:        "search_issues",
+			Description: "Full-text search issues by title and description.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"query":            mcp.String("Search text"),
+				"team":             mcp.String("Only issues in this team key"),
+				"include_comments": mcp.Boolean("Also search comments"),
+				"limit":            mcp.Integer("Maximum results (default 25)"),
+			}, "query"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					Query           string `json:"query"`
+					Team            string `json:"team"`
+					IncludeComments bool   `json:"include_comments"`
+					Limit           int    `json:"limit"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				teamID, err := mcpTeamID(ctx, client, args.Team, false)
+				if err != nil {
+					return nil, err
+				}
+				return client.SearchIssues(ctx, args.Query, mcpLimit(args.Limit), false, args.IncludeComments, teamID)
+			},
+		},
+		{
+			Name:        "create_issue",
+			Description: "Create an issue. State, labels and assignee are resolved by name.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"title":       mcp.String("Issue title"),
+				"team":        mcp.String("Team key (default: the configured team)"),
+				"description": mcp.String("Markdown description"),
+				"assignee":    mcp.String("Assignee email, name or \"self\""),
+				"priority":    mcpPriority,
+				"state":       mcp.String("Workflow state name such as \"In Progress\""),
+				"labels":      mcp.Strings("Label names"),
+				"project":     mcp.String("Project ID"),
+				"parent":      mcp.String("Parent issue identifier for a sub-issue"),
+				"due_date":    mcp.String("Due date: YYYY-MM-DD or an expression like \"next friday\""),
+			}, "title"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					mcpIssueFields
+					Team string `json:"team"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				if strings.TrimSpace(args.Title) == "" {
+					return nil, errors.New("title is required")
+				}
+				teamID, err := mcpTeamID(ctx, client, args.Team, true)
+				if err != nil {
+					return nil, err
+				}
+				fields, err := args.resolve(ctx, client, teamID)
+				if err != nil {
+					return nil, err
+				}
+				return client.CreateIssue(ctx, api.IssueCreateInput{
+					Title:       args.Title,
+					TeamID:      teamID,
+					Description: args.Description,
+					AssigneeID:  fields.AssigneeID,
+					Priority:    (*int)(args.Priority),
+					DueDate:     fields.DueDate,
+					LabelIDs:    fields.LabelIDs,
+					ProjectID:   args.Project,
+					StateID:     fields.StateID,
+					ParentID:    fields.ParentID,
+				})
+			},
+		},
+		{
+			Name:        "update_issue",
+			Description: "Update an issue's fields. Only the given fields change; labels replace the existing labels.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"id":          mcp.String("Issue identifier, Linear URL or ID"),
+				"title":       mcp.String("New title"),
+				"description": mcp.String("New markdown description"),
+				"assignee":    mcp.String("Assignee email, name or \"self\""),
+				"priority":    mcpPriority,
+				"state":       mcp.String("Workflow state name such as \"Done\""),
+				"labels":      mcp.Strings("Label names"),
+				"project":     mcp.String("Project ID"),
+				"parent":      mcp.String("Parent issue identifier"),
+				"due_date":    mcp.String("Due date: YYYY-MM-DD or an expression like \"next friday\""),
+			}, "id"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					mcpIssueFields
+					ID string `json:"id"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				if args.empty() {
+					return nil, errors.New("no fields to update")
+				}
+				id, err := mcpIssueRef(args.ID)
+				if err != nil {
+					return nil, err
+				}
+				issue, err := client.GetIssue(ctx, id, false)
+				if err != nil {
+					return nil, err
+				}
+				fields, err := args.resolve(ctx, client, issue.Team.ID)
+				if err != nil {
+					return nil, err
+				}
+				input := api.IssueUpdateInput{
+					Title:       args.Title,
+					Description: optionalString(args.Description),
+					AssigneeID:  fields.AssigneeID,
+					Priority:    (*int)(args.Priority),
+					DueDate:     fields.DueDate,
+					LabelIDs:    fields.LabelIDs,
+					ProjectID:   args.Project,
+					StateID:     fields.StateID,
+					ParentID:    fields.ParentID,
+				}
+				return client.UpdateIssue(ctx, issue.ID, input)
+			},
+		},
+		{
+			Name:        "list_comments",
+			Description: "List an issue's comments, oldest first.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"issue": mcp.String("Issue identifier, Linear URL or ID"),
+				"limit": mcp.Integer("Maximum comments (default 25)"),
+			}, "issue"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					Issue string `json:"issue"`
+					Limit int    `json:"limit"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				id, err := mcpIssueRef(args.Issue)
+				if err != nil {
+					return nil, err
+				}
+				comments, err := client.GetIssueComments(ctx, id, mcpLimit(args.Limit))
+				if err != nil {
+					return nil, err
+				}
+				return map[string]interface{}{"comments": comments, "count": len(comments)}, nil
+			},
+		},
+		{
+			Name:        "add_comment",
+			Description: "Comment on an issue.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"issue": mcp.String("Issue identifier, Linear URL or ID"),
+				"body":  mcp.String("Markdown comment"),
+			}, "issue", "body"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					Issue string `json:"issue"`
+					Body  string `json:"body"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				if strings.TrimSpace(args.Body) == "" {
+					return nil, errors.New("body is required")
+				}
+				id, err := mcpIssueRef(args.Issue)
+				if err != nil {
+					return nil, err
+				}
+				return client.CreateComment(ctx, id, args.Body)
+			},
+		},
+		{
+			Name:        "list_projects",
+			Description: "List projects, optionally only a team's.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"team":  mcp.String("Team key"),
+				"limit": mcp.Integer("Maximum projects (default 25)"),
+			}),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					Team  string `json:"team"`
+					Limit int    `json:"limit"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				teamID, err := mcpTeamID(ctx, client, args.Team, false)
+				if err != nil {
+					return nil, err
+				}
+				return client.GetProjects(ctx, teamID, mcpLimit(args.Limit), "", "")
+			},
+		},
+		{
+			Name:        "get_project",
+			Description: "Get a project's details by ID, slug ID or Linear URL.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"id": mcp.String("Project ID, slug ID or Linear URL"),
+			}, "id"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					ID string `json:"id"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				id, err := resolver.Entity(resolver.KindProject, args.ID)
+				if err != nil {
+					return nil, err
+				}
+				return client.GetProject(ctx, id)
+			},
+		},
+		{
+			Name:        "list_documents",
+			Description: "List documents, optionally only a project's.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"project": mcp.String("Project ID"),
+				"limit":   mcp.Integer("Maximum documents (default 25)"),
+			}),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					Project string `json:"project"`
+					Limit   int    `json:"limit"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				return client.GetDocuments(ctx, args.Project, mcpLimit(args.Limit), "", "")
+			},
+		},
+		{
+			Name:        "get_document",
+			Description: "Get a document with its content by ID, slug ID or Linear URL.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"id": mcp.String("Document ID, slug ID or Linear URL"),
+			}, "id"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					ID string `json:"id"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				id, err := resolver.Entity(resolver.KindDocument, args.ID)
+				if err != nil {
+					return nil, err
+				}
+				return client.GetDocument(ctx, id)
+			},
+		},
+		{
+			Name:        "search_documents",
+			Description: "Full-text search documents.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"query": mcp.String("Search text"),
+				"limit": mcp.Integer("Maximum results (default 25)"),
+			}, "query"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					Query string `json:"query"`
+					Limit int    `json:"limit"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				return client.SearchDocuments(ctx, args.Query, mcpLimit(args.Limit))
+			},
+		},
+		{
+			Name:        "create_document",
+			Description: "Create a markdown document in a project or team.",
+			InputSchema: mcp.Object(map[string]interface{}{
+				"title":   mcp.String("Document title"),
+				"content": mcp.String("Markdown content"),
+				"project": mcp.String("Project ID to attach the document to"),
+				"team":    mcp.String("Team key, when not in a project"),
+			}, "title"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+				var args struct {
+					Title   string `json:"title"`
+					Content string `json:"content"`
+					Project string `json:"project"`
+					Team    string `json:"team"`
+				}
+				if err := json.Unmarshal(raw, &args); err != nil {
+					return nil, err
+				}
+				input := api.DocumentCreateInput{Title: args.Title, Content: args.Content, ProjectID: args.Project}
+				if args.Project == "" {
+					teamID, err := mcpTeamID(ctx, client, args.Team, true)
+					if err != nil {
+						return nil, err
+					}
+					input.TeamID = teamID
+				}
+				return client.CreateDocument(ctx, input)
+			},
+		},
+	}
+}
+
+// mcpPriority is the schema of the priority argument, a name or number
+var mcpPriority = map[string]interface{}{
+	"type":        []string{"string", "integer"},
+	"description": "Priority: urgent, high, medium, low, none, or 0-4 (0 none, 1 urgent)",
+}
+
+// mcpIssueFields are the issue fields shared by create_issue and
+// update_issue
+type mcpIssueFields struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Assignee    string       `json:"assignee"`
+	Priority    *priorityArg `json:"priority"`
+	State       string       `json:"state"`
+	Labels      []string     `json:"labels"`
+	Project     string       `json:"project"`
+	Parent      string       `json:"parent"`
+	DueDate     string       `json:"due_date"`
+}
+
+// empty reports whether no field is set
+func (f mcpIssueFields) empty() bool {
+	return f.Title == "" && f.Description == "" && f.Assignee == "" && f.Priority == nil &&
+		f.State == "" && len(f.Labels) == 0 && f.Project == "" && f.Parent == "" && f.DueDate == ""
+}
+
+// resolvedIssueFields are the IDs the names in mcpIssueFields resolve to
+type resolvedIssueFields struct {
+	AssigneeID string
+	StateID    string
+	LabelIDs   []string
+	ParentID   string
+	DueDate    string
+}
+
+// resolve turns the assignee, state, label and parent references into IDs
+// for the issue's team, and the due date into YYYY-MM-DD
+func (f mcpIssueFields) resolve(ctx context.Context, client *api.Client, teamID string) (*resolvedIssueFields, error) {
+	r := &resolvedIssueFields{}
+	var err error
+	if r.AssigneeID, err = resolveUserID(ctx, client, f.Assignee); err != nil {
+		return nil, err
+	}
+	if r.StateID, err = resolveStateID(ctx, client, teamID, f.State, ""); err != nil {
+		return nil, err
+	}
+	if r.LabelIDs, err = resolveLabelIDs(ctx, client, teamID, f.Labels, false); err != nil {
+		return nil, err
+	}
+	if f.Parent != "" {
+		if r.ParentID, err = mcpIssueRef(f.Parent); err != nil {
+			return nil, err
+		}
+	}
+	if r.DueDate, err = resolveDueDate(f.DueDate); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// mcpIssueRef normalizes an issue argument like positional issue arguments
+func mcpIssueRef(ref string) (string, error) {
+	if strings.TrimSpace(ref) == "" {
+		return "", errors.New("issue is required")
+	}
+	return resolveRef(resolver.KindIssue, ref)
+}
+
+// mcpTeamID resolves a team key or ID, falling back to the configured
+// team. Without either, required fails and otherwise no team is returned.
+func mcpTeamID(ctx context.Context, client *api.Client, key string, required bool) (string, error) {
+	if key == "" {
+		if !required {
+			return "", nil
+		}
+		key = GetTeamID()
+	}
+	if key == "" {
+		return "", errors.New("team is required: pass team or configure a default team")
+	}
+	if isUUID(key) {
+		return key, nil
+	}
+	team, err := client.GetTeamByKey(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	if team == nil {
+		return "", fmt.Errorf("team '%s' not found", key)
+	}
+	return team.ID, nil
+}
+
+func mcpLimit(limit int) int {
+	if limit <= 0 {
+		return mcpDefaultLimit
+	}
+	return limit
+}
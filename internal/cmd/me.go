@@ -0,0 +1,308 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/snooze"
+	"github.com/spf13/cobra"
+)
+
+// dashboardBarWidth is the width of cycle progress bars
+const dashboardBarWidth = 20
+
+// stateTypeOrder ranks open state types for grouping, most active first
+var stateTypeOrder = map[string]int{"started": 0, "unstarted": 1, "backlog": 2, "triage": 3}
+
+// DashboardStateGroup is the viewer's assigned issues in one state
+type DashboardStateGroup struct {
+	State  string               `json:"state"`
+	Type   string               `json:"type"`
+	Count  int                  `json:"count"`
+	Issues []api.DashboardIssue `json:"issues"`
+}
+
+// DashboardDueIssue is an assigned issue due by the end of the week
+type DashboardDueIssue struct {
+	api.DashboardIssue
+	Overdue bool `json:"overdue"`
+}
+
+// DashboardResponse is the response for 'linear me'
+type DashboardResponse struct {
+	User struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"user"`
+	Assigned struct {
+		Count   int                   `json:"count"`
+		ByState []DashboardStateGroup `json:"byState"`
+	} `json:"assigned"`
+	AwaitingTriage struct {
+		Count  int                  `json:"count"`
+		Issues []api.DashboardIssue `json:"issues"`
+	} `json:"awaitingTriage"`
+	DueThisWeek struct {
+		Through string              `json:"through"`
+		Count   int                 `json:"count"`
+		Issues  []DashboardDueIssue `json:"issues"`
+	} `json:"dueThisWeek"`
+	Cycles []api.DashboardCycle `json:"cycles"`
+	// Snoozed counts the assigned issues hidden by 'linear issue snooze';
+	// Woken are the snoozes that ended since the last check
+	Snoozed struct {
+		Count int           `json:"count"`
+		Woken []WokenSnooze `json:"woken"`
+	} `json:"snoozed"`
+}
+
+// NewMeCmd creates the me command
+func NewMeCmd() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:     "me",
+		Aliases: []string{"dashboard"},
+		Short:   "Show your work at a glance",
+		Long: `Show a personal dashboard, fetched in a single request:
+
+  - open issues assigned to you, grouped by state
+  - assigned issues due by the end of this week (including overdue ones)
+  - issues you created that are still awaiting triage
+  - progress of your teams' active cycles
+
+Issues snoozed with 'linear issue snooze' are left out until they wake;
+snoozes that have ended are woken, posting their comments, and listed
+under "Back from snooze".
+
+Examples:
+  linear me --human
+  linear dashboard`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			dashboard, err := client.GetDashboard(ctx, limit)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			now := time.Now()
+			store, err := snooze.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			woken, err := wakeSnoozes(ctx, client, store, now)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			snoozed, err := store.List()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			resp := buildDashboard(hideSnoozed(dashboard, snoozed), now)
+			resp.Snoozed.Count = len(dashboard.Assigned) - resp.Assigned.Count
+			resp.Snoozed.Woken = woken
+
+			if IsHumanOutput() {
+				printDashboardHuman(resp)
+			} else {
+				output.JSON(resp)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 100, "Maximum number of assigned and created issues to fetch")
+
+	return cmd
+}
+
+// buildDashboard groups assigned issues by state and picks those due by
+// the end of the week containing now
+func buildDashboard(d *api.Dashboard, now time.Time) *DashboardResponse {
+	resp := &DashboardResponse{Cycles: d.Cycles}
+	resp.User.ID = d.Viewer.ID
+	resp.User.Name = d.Viewer.Name
+	resp.User.DisplayName = d.Viewer.DisplayName
+
+	groups := map[string]*DashboardStateGroup{}
+	order := []string{}
+	for _, issue := range d.Assigned {
+		g, ok := groups[issue.State.Name]
+		if !ok {
+			g = &DashboardStateGroup{State: issue.State.Name, Type: issue.State.Type, Issues: []api.DashboardIssue{}}
+			groups[issue.State.Name] = g
+			order = append(order, issue.State.Name)
+		}
+		g.Issues = append(g.Issues, issue)
+		g.Count++
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return stateTypeOrder[groups[order[i]].Type] < stateTypeOrder[groups[order[j]].Type]
+	})
+	resp.Assigned.ByState = []DashboardStateGroup{}
+	for _, name := range order {
+		resp.Assigned.ByState = append(resp.Assigned.ByState, *groups[name])
+	}
+	resp.Assigned.Count = len(d.Assigned)
+
+	resp.AwaitingTriage.Issues = d.Triage
+	resp.AwaitingTriage.Count = len(d.Triage)
+
+	today := now.Format("2006-01-02")
+	weekEnd := now.AddDate(0, 0, (7-int(now.Weekday()))%7).Format("2006-01-02")
+	resp.DueThisWeek.Through = weekEnd
+	resp.DueThisWeek.Issues = []DashboardDueIssue{}
+	for _, issue := range d.Assigned {
+		if issue.DueDate == "" || issue.DueDate > weekEnd {
+			continue
+		}
+		resp.DueThisWeek.Issues = append(resp.DueThisWeek.Issues, DashboardDueIssue{
+			DashboardIssue: issue,
+			Overdue:        issue.DueDate < today,
+		})
+	}
+	sort.SliceStable(resp.DueThisWeek.Issues, func(i, j int) bool {
+		return resp.DueThisWeek.Issues[i].DueDate < resp.DueThisWeek.Issues[j].DueDate
+	})
+	resp.DueThisWeek.Count = len(resp.DueThisWeek.Issues)
+
+	return resp
+}
+
+// hideSnoozed returns d without the assigned issues that are snoozed
+func hideSnoozed(d *api.Dashboard, snoozed []*snooze.Snooze) *api.Dashboard {
+	if len(snoozed) == 0 {
+		return d
+	}
+	hidden := map[string]bool{}
+	for _, s := range snoozed {
+		hidden[s.ID] = true
+	}
+	visible := *d
+	visible.Assigned = make([]api.DashboardIssue, 0, len(d.Assigned))
+	for _, issue := range d.Assigned {
+		if !hidden[issue.ID] {
+			visible.Assigned = append(visible.Assigned, issue)
+		}
+	}
+	return &visible
+}
+
+// printDashboardHuman renders the dashboard as compact sections
+func printDashboardHuman(resp *DashboardResponse) {
+	name := resp.User.DisplayName
+	if resp.User.Name != "" {
+		name = resp.User.Name
+	}
+	output.HumanLn("%s", output.Bold("Dashboard for %s", name))
+
+	if len(resp.Snoozed.Woken) > 0 {
+		output.HumanLn("\n%s", output.Bold("Back from snooze (%d)", len(resp.Snoozed.Woken)))
+		printWokenHuman(resp.Snoozed.Woken)
+	}
+
+	output.HumanLn("\n%s", output.Bold("Assigned to me (%d)", resp.Assigned.Count))
+	if resp.Snoozed.Count > 0 {
+		output.HumanLn("  %s", output.Muted("%d snoozed", resp.Snoozed.Count))
+	}
+	if resp.Assigned.Count == 0 {
+		output.HumanLn("  %s", output.Muted("Nothing assigned"))
+	}
+	for _, g := range resp.Assigned.ByState {
+		output.HumanLn("  %s %s", g.State, output.Muted("(%d)", g.Count))
+		for _, issue := range g.Issues {
+			printDashboardIssue(issue, output.Muted("%s", display.TimeAgoShort(parseDashboardTime(issue.UpdatedAt))))
+		}
+	}
+
+	output.HumanLn("\n%s", output.Bold("Due this week (%d)", resp.DueThisWeek.Count))
+	if resp.DueThisWeek.Count == 0 {
+		output.HumanLn("  %s", output.Muted("Nothing due"))
+	}
+	for _, issue := range resp.DueThisWeek.Issues {
+		due := display.FormatDueDate(issue.DueDate)
+		if issue.Overdue {
+			due = output.Red("%s overdue", due)
+		} else {
+			due = output.Yellow("%s", due)
+		}
+		printDashboardIssue(issue.DashboardIssue, due)
+	}
+
+	output.HumanLn("\n%s", output.Bold("Awaiting triage (%d)", resp.AwaitingTriage.Count))
+	if resp.AwaitingTriage.Count == 0 {
+		output.HumanLn("  %s", output.Muted("Nothing you created is in triage"))
+	}
+	for _, issue := range resp.AwaitingTriage.Issues {
+		printDashboardIssue(issue, output.Muted("%s", display.TimeAgoShort(parseDashboardTime(issue.UpdatedAt))))
+	}
+
+	output.HumanLn("\n%s", output.Bold("Active cycles"))
+	if len(resp.Cycles) == 0 {
+		output.HumanLn("  %s", output.Muted("No active cycles"))
+	}
+	for _, c := range resp.Cycles {
+		label := fmt.Sprintf("Cycle %d", c.Number)
+		if c.Name != "" {
+			label = c.Name
+		}
+		ends := ""
+		if t, err := display.ParseISO(c.EndsAt); err == nil {
+			ends = "ends " + display.FormatDay(t, "Jan 02")
+		}
+		output.HumanLn("  %-5s %-12s %s %3.0f%%  %d/%d issues  %s",
+			c.TeamKey, display.Truncate(label, 12), progressBar(c.Progress, dashboardBarWidth), c.Progress*100,
+			c.CompletedIssueCount, c.IssueCount, output.Muted("%s", ends))
+	}
+}
+
+// printDashboardIssue prints one issue line with a trailing note
+func printDashboardIssue(issue api.DashboardIssue, note string) {
+	output.HumanLn("    %-10s %s %s  %s", issue.Identifier, display.PriorityIcon(issue.Priority), display.Truncate(issue.Title, 50), note)
+}
+
+// parseDashboardTime parses an API timestamp, returning the zero time on error
+func parseDashboardTime(s string) time.Time {
+	t, _ := display.ParseISO(s)
+	return t
+}
+
+// progressBar renders a fraction between 0 and 1 as a bar of width cells
+func progressBar(fraction float64, width int) string {
+	fraction = min(max(fraction, 0), 1)
+	filled := int(fraction*float64(width) + 0.5)
+	return output.Green("%s", strings.Repeat("█", filled)) + output.Muted("%s", strings.Repeat("░", width-filled))
+}
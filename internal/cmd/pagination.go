@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// collectPages fetches a page starting at the after cursor and, when all is
+// set, keeps following endCursor until the list is exhausted, showing
+// progress on stderr. The returned PageInfo describes the last page fetched.
+func collectPages[T any](after string, all bool, fetch func(after string) ([]T, *api.PageInfo, error)) ([]T, *api.PageInfo, error) {
+	return fetchPages(after, all, fetch, nil)
+}
+
+// streamPages is collectPages for list commands that output the items as
+// fetched. With --all and NDJSON output each page is written as it
+// arrives; streamed then reports that the caller has nothing left to write.
+func streamPages[T any](after string, all bool, fetch func(after string) ([]T, *api.PageInfo, error)) (items []T, pageInfo *api.PageInfo, streamed bool, err error) {
+	if !all || IsHumanOutput() || !output.CanStream() {
+		items, pageInfo, err = collectPages(after, all, fetch)
+		return items, pageInfo, false, err
+	}
+	items, pageInfo, err = fetchPages(after, all, fetch, func(page []T) error {
+		return output.StreamRecords(page)
+	})
+	return items, pageInfo, err == nil, err
+}
+
+// fetchPages follows pages as collectPages does, passing each page to
+// each when it is set
+func fetchPages[T any](after string, all bool, fetch func(after string) ([]T, *api.PageInfo, error), each func([]T) error) ([]T, *api.PageInfo, error) {
+	var progress *output.Progress
+	if all {
+		progress = output.NewProgress("Fetching", 0)
+		defer progress.Done()
+	}
+
+	items := []T{}
+	for {
+		page, pageInfo, err := fetch(after)
+		if err != nil {
+			if reason := stopReason(err); reason != "" && len(items) > 0 {
+				// Keep what was fetched; the cursor resumes where it stopped
+				reportPartial(reason, len(items), after)
+				return items, &api.PageInfo{HasNextPage: true, EndCursor: after}, nil
+			}
+			return nil, nil, err
+		}
+		items = append(items, page...)
+		if each != nil {
+			if err := each(page); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if !all || pageInfo == nil || !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			return items, pageInfo, nil
+		}
+		progress.Add(len(page))
+		after = pageInfo.EndCursor
+	}
+}
+
+// stopReason returns "timeout" or "interrupted" when err is from --timeout
+// expiring or an interrupt, and "" for other errors
+func stopReason(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "interrupted"
+	}
+	return ""
+}
+
+// reportPartial marks paginated output that stopped early for reason,
+// warning on stderr how to fetch the rest
+func reportPartial(reason string, count int, after string) {
+	output.SetPartial(reason)
+	if reason == "timeout" {
+		output.Fail("TIMEOUT")
+	} else {
+		output.Fail("INTERRUPTED")
+	}
+	fmt.Fprintf(os.Stderr, "Stopped after %d results (%s); continue with --after %s --all\n", count, reason, after)
+}
+
+// printPageHintHuman tells the user how to fetch the next page, if any
+func printPageHintHuman(pageInfo *api.PageInfo) {
+	if pageInfo == nil || !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+		return
+	}
+	output.HumanLn("%s", output.Muted("More results available: --after %s (or --all)", pageInfo.EndCursor))
+}
@@ -0,0 +1,427 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// pokerMarker identifies the comment that opens a poker round
+	pokerMarker = "Planning poker"
+
+	// pokerCommentLimit is how many comments are scanned when tallying
+	pokerCommentLimit = 250
+)
+
+// defaultPokerTemplate is the comment posted to open a round. It can be
+// replaced with a comment template passed to --template.
+const defaultPokerTemplate = `🃏 **Planning poker** for {{.Identifier}}: {{.Title}}
+
+{{if .Participants}}Participants: {{.Participants}}
+
+{{end}}Reply to this comment with your estimate as a number (e.g. ` + "`3`" + `).
+Votes are counted with ` + "`linear poker tally {{.Identifier}}`" + `.`
+
+var (
+	pokerVotePattern         = regexp.MustCompile(`^\s*(\d+(?:\.\d+)?)\b`)
+	pokerParticipantsPattern = regexp.MustCompile(`(?m)^Participants:[ \t]*(.+)$`)
+)
+
+// PokerVote is a participant's estimate
+type PokerVote struct {
+	User      string  `json:"user"`
+	Vote      float64 `json:"vote"`
+	CommentID string  `json:"commentId"`
+}
+
+// PokerTally is the result of counting a poker round
+type PokerTally struct {
+	Issue        string         `json:"issue"`
+	RoundID      string         `json:"roundId"`
+	Votes        []PokerVote    `json:"votes"`
+	Distribution map[string]int `json:"distribution"`
+	Missing      []string       `json:"missing"`
+	Min          *float64       `json:"min,omitempty"`
+	Max          *float64       `json:"max,omitempty"`
+	Median       *float64       `json:"median,omitempty"`
+	Consensus    bool           `json:"consensus"`
+	Applied      bool           `json:"applied"`
+	Participants []string       `json:"participants"`
+}
+
+// NewPokerCmd creates the poker command
+func NewPokerCmd() *cobra.Command {
+	var (
+		participants []string
+		tmplName     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "poker <issue-id>",
+		Short: "Run async planning poker through issue comments",
+		Long: `Open a planning poker round by posting an estimation comment on an issue.
+
+Participants reply to the comment with a number; 'linear poker tally'
+counts the votes and can set the median as the issue's estimate.
+
+The comment can be replaced with a comment template (see 'linear template')
+that uses {{.Participants}} and the usual issue fields.
+
+Examples:
+  linear poker ENG-123 --participants alice,bob,carol
+  linear poker tally ENG-123
+  linear poker tally ENG-123 --apply`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			issue, err := client.GetIssue(ctx, issueID, false)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if issue == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+			}
+
+			body, err := renderPokerComment(issue, participants, tmplName)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("TEMPLATE_ERROR", err.Error())
+			}
+
+			comment, err := client.CreateComment(ctx, issue.ID, body)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Poker round opened on %s", issue.Identifier))
+				output.HumanLn("  Tally with: linear poker tally %s", issue.Identifier)
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":      true,
+					"operation":    "poker",
+					"issue":        issue.Identifier,
+					"roundId":      comment.ID,
+					"participants": participants,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&participants, "participants", "p", nil, "Comma-separated participant names")
+	cmd.Flags().StringVar(&tmplName, "template", "", "Comment template to post instead of the default")
+
+	cmd.AddCommand(newPokerTallyCmd())
+
+	return cmd
+}
+
+func newPokerTallyCmd() *cobra.Command {
+	var apply bool
+
+	cmd := &cobra.Command{
+		Use:   "tally <issue-id>",
+		Short: "Count votes for the latest poker round",
+		Long: `Count the votes replied to the latest poker round on an issue.
+
+A vote is a reply (or later comment) starting with a number. Each user's
+latest vote counts. When the round lists participants, only their votes
+are counted and missing voters are reported.
+
+Examples:
+  linear poker tally ENG-123
+  linear poker tally ENG-123 --apply`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			comments, err := client.GetIssueComments(ctx, issueID, pokerCommentLimit)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			tally := tallyPoker(comments)
+			if tally == nil {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"NOT_FOUND",
+						fmt.Sprintf("No poker round found on %s", issueID),
+						"Open a round first",
+						fmt.Sprintf("linear poker %s --participants alice,bob", issueID),
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"NOT_FOUND",
+					fmt.Sprintf("No poker round found on %s", issueID),
+					"Open a round first",
+					fmt.Sprintf("linear poker %s --participants alice,bob", issueID),
+				)
+			}
+			tally.Issue = issueID
+
+			if apply {
+				if tally.Median == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("NO_VOTES", "No votes to apply")
+						return nil
+					}
+					return output.Error("NO_VOTES", "No votes to apply")
+				}
+				estimate := *tally.Median
+				if _, err := client.UpdateIssue(ctx, issueID, api.IssueUpdateInput{Estimate: &estimate}); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				tally.Applied = true
+			}
+
+			if IsHumanOutput() {
+				printPokerTallyHuman(tally)
+			} else {
+				output.JSON(tally)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&apply, "apply", false, "Set the median vote as the issue estimate")
+
+	return cmd
+}
+
+// renderPokerComment renders the round-opening comment, from a comment
+// template when one is named
+func renderPokerComment(issue *api.IssueDetail, participants []string, tmplName string) (string, error) {
+	name, body := "poker", defaultPokerTemplate
+	if tmplName != "" {
+		tmpl, err := loadTemplate(templates.KindComment, tmplName)
+		if err != nil {
+			return "", err
+		}
+		if tmpl == nil {
+			return "", fmt.Errorf("comment template '%s' not found", tmplName)
+		}
+		name, body = tmpl.Name, tmpl.Body
+	}
+
+	data := issueTemplateData(issue, nil)
+	data["Participants"] = strings.Join(participants, ", ")
+
+	rendered, err := templates.Render(name, body, data)
+	if err != nil {
+		return "", err
+	}
+	// Custom templates still need the marker so tally can find the round
+	if !strings.Contains(rendered, pokerMarker) {
+		rendered = "**" + pokerMarker + "**\n\n" + rendered
+	}
+	return rendered, nil
+}
+
+// tallyPoker finds the latest poker round in comments and counts the
+// votes cast after it. It returns nil if there is no round.
+func tallyPoker(comments []api.Comment) *PokerTally {
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].CreatedAt < comments[j].CreatedAt })
+
+	round := -1
+	for i, c := range comments {
+		if strings.Contains(c.Body, pokerMarker) {
+			round = i
+		}
+	}
+	if round < 0 {
+		return nil
+	}
+	roundComment := comments[round]
+
+	participants := []string{}
+	if m := pokerParticipantsPattern.FindStringSubmatch(roundComment.Body); m != nil {
+		for _, p := range strings.Split(m[1], ",") {
+			if p = strings.TrimPrefix(strings.TrimSpace(p), "@"); p != "" {
+				participants = append(participants, p)
+			}
+		}
+	}
+
+	// Latest vote per user, from replies to the round or later top-level comments
+	latest := map[string]PokerVote{}
+	order := []string{}
+	for _, c := range comments[round+1:] {
+		if c.User == nil {
+			continue
+		}
+		if c.Parent != nil && c.Parent.ID != roundComment.ID {
+			continue
+		}
+		m := pokerVotePattern.FindStringSubmatch(c.Body)
+		if m == nil {
+			continue
+		}
+		vote, err := strconv.ParseFloat(m[1], 64)
+		if err != nil {
+			continue
+		}
+
+		user := c.User.DisplayName
+		if len(participants) > 0 {
+			matched := matchParticipant(participants, c.User.DisplayName, c.User.Name)
+			if matched == "" {
+				continue
+			}
+			user = matched
+		}
+
+		if _, ok := latest[user]; !ok {
+			order = append(order, user)
+		}
+		latest[user] = PokerVote{User: user, Vote: vote, CommentID: c.ID}
+	}
+
+	tally := &PokerTally{
+		RoundID:      roundComment.ID,
+		Votes:        []PokerVote{},
+		Distribution: map[string]int{},
+		Missing:      []string{},
+		Participants: participants,
+	}
+	values := []float64{}
+	for _, user := range order {
+		v := latest[user]
+		tally.Votes = append(tally.Votes, v)
+		tally.Distribution[strconv.FormatFloat(v.Vote, 'f', -1, 64)]++
+		values = append(values, v.Vote)
+	}
+	for _, p := range participants {
+		if _, ok := latest[p]; !ok {
+			tally.Missing = append(tally.Missing, p)
+		}
+	}
+
+	if len(values) > 0 {
+		sort.Float64s(values)
+		lo, hi := values[0], values[len(values)-1]
+		median := values[len(values)/2]
+		if len(values)%2 == 0 {
+			median = (values[len(values)/2-1] + values[len(values)/2]) / 2
+		}
+		tally.Min, tally.Max, tally.Median = &lo, &hi, &median
+		tally.Consensus = lo == hi
+	}
+
+	return tally
+}
+
+// matchParticipant returns the participant matching a user's display name
+// or name, case-insensitively
+func matchParticipant(participants []string, names ...string) string {
+	for _, p := range participants {
+		for _, n := range names {
+			if n != "" && strings.EqualFold(p, n) {
+				return p
+			}
+		}
+	}
+	return ""
+}
+
+func printPokerTallyHuman(tally *PokerTally) {
+	output.HumanLn("%s", output.Bold("Poker results for %s", tally.Issue))
+	output.HumanLn("")
+
+	if len(tally.Votes) == 0 {
+		output.HumanLn("No votes yet")
+	} else {
+		headers := []string{"USER", "VOTE"}
+		rows := make([][]string, len(tally.Votes))
+		for i, v := range tally.Votes {
+			rows[i] = []string{v.User, strconv.FormatFloat(v.Vote, 'f', -1, 64)}
+		}
+		output.TableWithColors(headers, rows)
+
+		keys := make([]string, 0, len(tally.Distribution))
+		for k := range tally.Distribution {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			a, _ := strconv.ParseFloat(keys[i], 64)
+			b, _ := strconv.ParseFloat(keys[j], 64)
+			return a < b
+		})
+		output.HumanLn("")
+		for _, k := range keys {
+			output.HumanLn("  %4s %s %d", k, strings.Repeat("█", tally.Distribution[k]), tally.Distribution[k])
+		}
+
+		output.HumanLn("")
+		output.HumanLn("Median: %s  (min %s, max %s)",
+			strconv.FormatFloat(*tally.Median, 'f', -1, 64),
+			strconv.FormatFloat(*tally.Min, 'f', -1, 64),
+			strconv.FormatFloat(*tally.Max, 'f', -1, 64),
+		)
+		if tally.Consensus {
+			output.HumanLn("%s", output.Green("Consensus reached"))
+		}
+	}
+
+	if len(tally.Missing) > 0 {
+		output.HumanLn("%s", output.Yellow("Waiting on: %s", strings.Join(tally.Missing, ", ")))
+	}
+	if tally.Applied {
+		output.SuccessHuman(fmt.Sprintf("Estimate set to %s", strconv.FormatFloat(*tally.Median, 'f', -1, 64)))
+	}
+}
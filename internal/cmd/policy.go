@@ -0,0 +1,335 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/auth"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// PolicyCheck is the simulated outcome of one command
+type PolicyCheck struct {
+	Command   string `json:"command"`
+	Operation string `json:"operation"`
+	Write     bool   `json:"write"`
+	Verdict   string `json:"verdict"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// PolicyCredential describes the credential being simulated
+type PolicyCredential struct {
+	Method string `json:"method"`
+	Source string `json:"source"`
+	User   string `json:"user"`
+	Email  string `json:"email"`
+	Admin  bool   `json:"admin"`
+}
+
+// PolicySimulationResponse is the response for 'linear policy simulate'
+type PolicySimulationResponse struct {
+	Credential PolicyCredential             `json:"credential"`
+	Team       string                       `json:"team,omitempty"`
+	Checks     []PolicyCheck                `json:"checks"`
+	Matrix     map[string]map[string]string `json:"matrix"`
+	Allowed    int                          `json:"allowed"`
+	Denied     int                          `json:"denied"`
+	Unknown    int                          `json:"unknown"`
+}
+
+// NewPolicyCmd creates the policy command group
+func NewPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Inspect what the current credential is allowed to do",
+		Long: `Check which commands the configured credential may run, to help set up
+least-privilege credentials for agents and automation.`,
+	}
+
+	cmd.AddCommand(newPolicySimulateCmd())
+
+	return cmd
+}
+
+func newPolicySimulateCmd() *cobra.Command {
+	var (
+		commands string
+		teamKey  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Dry-run commands to see which the credential permits",
+		Long: `Dry-run the API operation behind each command and report whether the
+current credential is permitted to perform it.
+
+Mutations are sent with placeholder IDs that match nothing, so nothing is
+created or changed: the server authorizes the operation and then fails to
+find the entity, which counts as allowed. Refusals (forbidden, missing
+scope, admin only) count as denied; anything else is reported as unknown
+with the server's message.
+
+List, view and other read commands run against the team given by --team
+(or the configured default team), so private-team access is checked too.
+
+--commands takes a comma-separated list of commands, or a resource name
+such as "issue" for all of its commands. Without it every known command
+is checked.
+
+Examples:
+  linear policy simulate
+  linear policy simulate --commands "issue update,comment create" --team ENG
+  linear policy simulate --commands issue,label --human`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			probes, err := selectPermissionProbes(commands)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_INPUT", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_INPUT", err.Error())
+			}
+
+			status, err := auth.NewManager().GetStatus(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			viewer, err := client.GetViewer(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+			teamID := ""
+			if teamKey != "" {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found (or not visible to this credential)", teamKey))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found (or not visible to this credential)", teamKey))
+				}
+				teamID = team.ID
+			}
+
+			resp := &PolicySimulationResponse{
+				Credential: PolicyCredential{
+					Method: string(status.Method),
+					Source: status.Source,
+					User:   viewer.Viewer.Name,
+					Email:  viewer.Viewer.Email,
+					Admin:  viewer.Viewer.Admin,
+				},
+				Team:   teamKey,
+				Checks: []PolicyCheck{},
+				Matrix: map[string]map[string]string{},
+			}
+
+			for _, probe := range probes {
+				result := api.ProbeResult{Verdict: api.ProbeUnknown, Reason: "no team selected (use --team)"}
+				if !probe.TeamScoped || teamID != "" {
+					result = client.ProbePermission(ctx, probe, teamID)
+				}
+				resp.addCheck(probe, result)
+			}
+
+			if IsHumanOutput() {
+				printPolicySimulationHuman(resp)
+			} else {
+				output.JSON(resp)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&commands, "commands", "", "Comma-separated commands or resources to check (default: all)")
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team for read checks (default: configured team)")
+
+	return cmd
+}
+
+// addCheck records a probe result in the check list, matrix and totals
+func (r *PolicySimulationResponse) addCheck(probe api.PermissionProbe, result api.ProbeResult) {
+	r.Checks = append(r.Checks, PolicyCheck{
+		Command:   probe.Command,
+		Operation: probe.Operation,
+		Write:     probe.Write,
+		Verdict:   result.Verdict,
+		Reason:    result.Reason,
+	})
+
+	resource, action := splitPolicyCommand(probe.Command)
+	if r.Matrix[resource] == nil {
+		r.Matrix[resource] = map[string]string{}
+	}
+	r.Matrix[resource][action] = result.Verdict
+
+	switch result.Verdict {
+	case api.ProbeAllowed:
+		r.Allowed++
+	case api.ProbeDenied:
+		r.Denied++
+	default:
+		r.Unknown++
+	}
+}
+
+// selectPermissionProbes resolves a --commands value to probes, keeping
+// the catalog order
+func selectPermissionProbes(commands string) ([]api.PermissionProbe, error) {
+	if strings.TrimSpace(commands) == "" {
+		return api.PermissionProbes, nil
+	}
+
+	wanted := map[string]bool{}
+	for _, c := range strings.Split(commands, ",") {
+		c = strings.ToLower(strings.Join(strings.Fields(c), " "))
+		if c == "" {
+			continue
+		}
+		known := false
+		for _, probe := range api.PermissionProbes {
+			resource, _ := splitPolicyCommand(probe.Command)
+			if probe.Command == c || resource == c {
+				known = true
+				break
+			}
+		}
+		if !known {
+			available := make([]string, len(api.PermissionProbes))
+			for i, probe := range api.PermissionProbes {
+				available[i] = probe.Command
+			}
+			return nil, fmt.Errorf("unknown command '%s' (available: %s)", c, strings.Join(available, ", "))
+		}
+		wanted[c] = true
+	}
+
+	probes := []api.PermissionProbe{}
+	for _, probe := range api.PermissionProbes {
+		resource, _ := splitPolicyCommand(probe.Command)
+		if wanted[probe.Command] || wanted[resource] {
+			probes = append(probes, probe)
+		}
+	}
+	return probes, nil
+}
+
+// splitPolicyCommand splits "issue update" into its resource and action
+func splitPolicyCommand(command string) (string, string) {
+	resource, action, _ := strings.Cut(command, " ")
+	return resource, action
+}
+
+// printPolicySimulationHuman prints the resource × action matrix followed by
+// the reasons for anything not allowed
+func printPolicySimulationHuman(resp *PolicySimulationResponse) {
+	cred := resp.Credential
+	role := "member"
+	if cred.Admin {
+		role = "admin"
+	}
+	output.HumanLn("%s", output.Bold("Permission simulation"))
+	output.HumanLn("%s", output.Muted("%s (%s) via %s from %s", cred.User, role, cred.Method, cred.Source))
+	if resp.Team != "" {
+		output.HumanLn("%s", output.Muted("Read checks against team %s", resp.Team))
+	}
+	output.HumanLn("")
+
+	actions := []string{}
+	seenAction := map[string]bool{}
+	resources := []string{}
+	seenResource := map[string]bool{}
+	for _, c := range resp.Checks {
+		resource, action := splitPolicyCommand(c.Command)
+		if !seenResource[resource] {
+			seenResource[resource] = true
+			resources = append(resources, resource)
+		}
+		if !seenAction[action] {
+			seenAction[action] = true
+			actions = append(actions, action)
+		}
+	}
+	sort.SliceStable(actions, func(i, j int) bool { return policyActionRank(actions[i]) < policyActionRank(actions[j]) })
+
+	headers := append([]string{"RESOURCE"}, make([]string, len(actions))...)
+	for i, a := range actions {
+		headers[i+1] = strings.ToUpper(a)
+	}
+	rows := make([][]string, 0, len(resources))
+	for _, resource := range resources {
+		row := []string{resource}
+		for _, action := range actions {
+			row = append(row, policyVerdictMark(resp.Matrix[resource][action]))
+		}
+		rows = append(rows, row)
+	}
+	output.TableWithColors(headers, rows)
+
+	output.HumanLn("\n%d allowed, %d denied, %d unknown", resp.Allowed, resp.Denied, resp.Unknown)
+
+	for _, c := range resp.Checks {
+		if c.Verdict == api.ProbeAllowed {
+			continue
+		}
+		output.HumanLn("  %s %s: %s", policyVerdictMark(c.Verdict), c.Command, c.Reason)
+	}
+}
+
+// policyActionRank orders matrix columns from reads to destructive writes
+func policyActionRank(action string) int {
+	for i, a := range []string{"list", "view", "create", "update", "relate", "delete"} {
+		if a == action {
+			return i
+		}
+	}
+	return 100
+}
+
+// policyVerdictMark renders a verdict as a colored symbol
+func policyVerdictMark(verdict string) string {
+	switch verdict {
+	case api.ProbeAllowed:
+		return output.Green("✓")
+	case api.ProbeDenied:
+		return output.Red("✗")
+	case "":
+		return output.Muted("-")
+	default:
+		return output.Yellow("?")
+	}
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// priorityUsage describes the values --priority accepts
+const priorityUsage = "urgent, high, medium, low, none or 0-4"
+
+// parsePriority accepts 0-4, urgent/high/medium/low/none, or the names
+// written by 'linear issue export' such as "No priority"
+func parsePriority(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 && n <= 4 {
+		return n, nil
+	}
+	for value, name := range display.PriorityNames {
+		if strings.EqualFold(name, s) {
+			return value, nil
+		}
+	}
+	if strings.EqualFold(s, "none") {
+		return 0, nil
+	}
+	return 0, fmt.Errorf("invalid priority '%s' (use %s)", s, priorityUsage)
+}
+
+// priorityError reports an invalid --priority value
+func priorityError(err error) error {
+	if IsHumanOutput() {
+		output.ErrorHuman("INVALID_PRIORITY", err.Error())
+		return nil
+	}
+	return output.Error("INVALID_PRIORITY", err.Error())
+}
+
+// priorityArg is a priority in JSON input, given as a number or a name
+type priorityArg int
+
+// UnmarshalJSON accepts what parsePriority does, as a string or number
+func (p *priorityArg) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	priority, err := parsePriority(fmt.Sprint(value))
+	if err != nil {
+		return err
+	}
+	*p = priorityArg(priority)
+	return nil
+}
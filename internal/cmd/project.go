@@ -1,13 +1,14 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
 	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
 	"github.com/juanbermudez/agent-linear-cli/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -32,17 +33,25 @@ Examples:
 	cmd.AddCommand(newProjectUpdateCmd())
 	cmd.AddCommand(newProjectDeleteCmd())
 	cmd.AddCommand(newProjectRestoreCmd())
+	cmd.AddCommand(newProjectCloneCmd())
+	cmd.AddCommand(newProjectMembersCmd())
 	cmd.AddCommand(newProjectSearchCmd())
 	cmd.AddCommand(newProjectMilestoneCmd())
 	cmd.AddCommand(newProjectUpdateStatusCmd())
+	cmd.AddCommand(newProjectBurndownCmd())
 
 	return cmd
 }
 
 func newProjectListCmd() *cobra.Command {
 	var (
-		teamKey string
-		limit   int
+		teamKey    string
+		limit      int
+		all        bool
+		after      string
+		sortBy     string
+		reverse    bool
+		columnList string
 	)
 
 	cmd := &cobra.Command{
@@ -53,14 +62,47 @@ func newProjectListCmd() *cobra.Command {
 Examples:
   linear project list
   linear project list --team ENG
-  linear project list --limit 20`,
+  linear project list --limit 20
+  linear project list --all
+  linear project list --after <cursor>
+  linear project list --sort target
+  linear project list --columns name,status,target --human
+
+--sort orders by updated or created (newest first), target date (soonest
+first), name, progress (most complete first) or state. The API sorts by
+updated and created across pages; other orders sort the fetched results,
+so combine them with --all to sort everything.
+
+--columns picks the columns of table and TSV output from ` + columnNames(projectColumns) + `.
+Save a preference with 'linear config set columns.project_list name,lead'.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
+
+			order, err := parseSort(projectSortKeys, sortBy, reverse)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_SORT", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_SORT", err.Error())
+			}
+
+			columns, custom, err := parseColumns(projectColumns, columnList, loadConfig().Columns.ProjectList, projectDefaultColumns)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_COLUMNS", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_COLUMNS", err.Error())
+			}
+			if custom {
+				setTSVColumns(columns)
+			}
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -72,14 +114,14 @@ Examples:
 				team, err := client.GetTeamByKey(ctx, teamKey)
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHumanFrom(err, "API_ERROR")
 						return nil
 					}
-					return output.Error("API_ERROR", err.Error())
+					return output.ErrorFrom(err, "API_ERROR")
 				}
 				if team == nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+						output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
 						return nil
 					}
 					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
@@ -87,18 +129,42 @@ Examples:
 				teamID = team.ID
 			}
 
-			projects, err := client.GetProjects(ctx, teamID, limit)
+			fetch := func(after string) ([]api.ProjectListItem, *api.PageInfo, error) {
+				page, err := client.GetProjects(ctx, teamID, limit, order.serverOrder(), after)
+				if err != nil {
+					return nil, nil, err
+				}
+				return page.Projects, page.PageInfo, nil
+			}
+			var (
+				items    []api.ProjectListItem
+				pageInfo *api.PageInfo
+				streamed bool
+			)
+			if order.local() {
+				items, pageInfo, err = collectPages(after, all, fetch)
+			} else {
+				items, pageInfo, streamed, err = streamPages(after, all, fetch)
+			}
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			order.apply(items)
+
+			projects := &api.ProjectsResponse{
+				Projects: items,
+				Count:    len(items),
+				PageInfo: pageInfo,
 			}
 
 			if IsHumanOutput() {
-				printProjectsHuman(projects)
-			} else {
+				printProjectsHuman(projects, columns)
+				printPageHintHuman(projects.PageInfo)
+			} else if !streamed {
 				output.JSON(projects)
 			}
 
@@ -107,29 +173,40 @@ Examples:
 	}
 
 	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Filter by team key (e.g., ENG)")
-	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum projects to return")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum projects to return (page size with --all)")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch every page of results")
+	cmd.Flags().StringVar(&after, "after", "", "Start after this cursor (from pageInfo.endCursor)")
+	cmd.Flags().StringVar(&sortBy, "sort", "", "Sort by "+sortNames(projectSortKeys)+" (default: API order)")
+	cmd.Flags().BoolVar(&reverse, "reverse", false, "Reverse the sort order")
+	cmd.Flags().StringVar(&columnList, "columns", "", "Comma-separated columns for table and TSV output")
 
 	return cmd
 }
 
 func newProjectViewCmd() *cobra.Command {
+	var showQR bool
+
 	cmd := &cobra.Command{
 		Use:   "view <project-id>",
 		Short: "View project details",
 		Long: `View detailed information about a project.
 
+With --qr, a QR code of the project URL is printed after the details
+(on stderr in JSON mode, so stdout stays valid JSON).
+
 Examples:
   linear project view abc123
-  linear project view abc123 --human`,
+  linear project view abc123 --human
+  linear project view abc123 --human --qr`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -138,19 +215,20 @@ Examples:
 			project, err := client.GetProject(ctx, projectID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if project == nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Project '%s' not found", projectID))
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Project '%s' not found", projectID))
 					return nil
 				}
 				return output.Error("NOT_FOUND", fmt.Sprintf("Project '%s' not found", projectID))
 			}
+			recordProject(project, history.ActionViewed)
 
 			if IsHumanOutput() {
 				printProjectDetailHuman(project)
@@ -158,10 +236,27 @@ Examples:
 				output.JSON(project)
 			}
 
+			if showQR {
+				w := os.Stderr
+				if IsHumanOutput() {
+					w = os.Stdout
+					fmt.Fprintln(w)
+				}
+				if err := writeQR(w, project.URL); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("QR_ERROR", err.Error())
+						return nil
+					}
+					return output.Error("QR_ERROR", err.Error())
+				}
+			}
+
 			return nil
 		},
 	}
 
+	cmd.Flags().BoolVar(&showQR, "qr", false, "Print a terminal QR code of the project URL")
+
 	return cmd
 }
 
@@ -172,12 +267,14 @@ func newProjectCreateCmd() *cobra.Command {
 		content     string
 		teamKeys    []string
 		statusID    string
+		status      string
 		leadID      string
+		members     []string
 		icon        string
 		color       string
 		startDate   string
 		targetDate  string
-		priority    int
+		priority    string
 	)
 
 	cmd := &cobra.Command{
@@ -188,11 +285,28 @@ func newProjectCreateCmd() *cobra.Command {
 Examples:
   linear project create --name "Q1 Feature Development" --team ENG
   linear project create --name "Auth Refactor" --team ENG --team BACKEND
-  linear project create --name "Feature" --description "Description here" --target-date 2025-03-01`,
+  linear project create --name "Feature" --status "In Progress" --team ENG
+  linear project create --name "Feature" --description "Description here" --target-date 2025-03-01
+  linear project create --name "Launch" --priority high --team ENG
+  linear project create --name "Billing v2" --lead alice@example.com --member bob --member carol --team ENG`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("status") && cmd.Flags().Changed("status-id") {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", "--status and --status-id cannot be used together")
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", "--status and --status-id cannot be used together")
+			}
+
+			priorityValue, err := parsePriority(priority)
+			if cmd.Flags().Changed("priority") && err != nil {
+				return priorityError(err)
+			}
+
 			if name == "" {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"MISSING_NAME",
 						"Project name is required",
 						"Provide a name using the --name flag",
 						"linear project create --name \"My Project\" --team ENG",
@@ -215,6 +329,7 @@ Examples:
 				} else {
 					if IsHumanOutput() {
 						output.ErrorHumanWithHint(
+							"MISSING_TEAM",
 							"At least one team is required",
 							"Specify a team using --team flag or set a default team",
 							"linear project create --name \"My Project\" --team ENG",
@@ -232,12 +347,22 @@ Examples:
 				}
 			}
 
-			ctx := context.Background()
+			resolved, err := resolveDateFlags(map[string]*string{"start-date": &startDate, "target-date": &targetDate})
+			if err != nil {
+				return dateError(err)
+			}
+
+			if err := normalizeIconFlag(&icon); err != nil {
+				return iconError(err)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
 					output.ErrorHumanWithHint(
+						"AUTH_ERROR",
 						err.Error(),
 						"Authentication failed. Make sure you're logged in",
 						"linear auth login --with-token",
@@ -252,20 +377,44 @@ Examples:
 				)
 			}
 
+			if status != "" {
+				if statusID, err = resolveProjectStatusID(ctx, client, status); err != nil {
+					return projectStatusError(err)
+				}
+			}
+
+			if leadID, err = resolveUserID(ctx, client, leadID); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "INVALID_USER")
+					return nil
+				}
+				return output.ErrorFrom(err, "INVALID_USER")
+			}
+			memberIDs := make([]string, len(members))
+			for i, member := range members {
+				if memberIDs[i], err = resolveUserID(ctx, client, member); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "INVALID_USER")
+						return nil
+					}
+					return output.ErrorFrom(err, "INVALID_USER")
+				}
+			}
+
 			// Resolve team keys to IDs
 			teamIDs := make([]string, 0, len(teamKeys))
 			for _, key := range teamKeys {
 				team, err := client.GetTeamByKey(ctx, key)
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHumanFrom(err, "API_ERROR")
 						return nil
 					}
-					return output.Error("API_ERROR", err.Error())
+					return output.ErrorFrom(err, "API_ERROR")
 				}
 				if team == nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(fmt.Sprintf("Team '%s' not found", key))
+						output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", key))
 						return nil
 					}
 					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", key))
@@ -280,6 +429,7 @@ Examples:
 				TeamIDs:     teamIDs,
 				StatusID:    statusID,
 				LeadID:      leadID,
+				MemberIDs:   memberIDs,
 				Icon:        icon,
 				Color:       color,
 				StartDate:   startDate,
@@ -287,28 +437,31 @@ Examples:
 			}
 
 			if cmd.Flags().Changed("priority") {
-				input.Priority = &priority
+				input.Priority = &priorityValue
 			}
 
 			project, err := client.CreateProject(ctx, input)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Project created: %s", project.Name))
 				output.HumanLn("  ID: %s", project.ID)
 				output.HumanLn("  URL: %s", project.URL)
+				resolved.printHuman()
 			} else {
-				output.JSON(map[string]interface{}{
+				response := map[string]interface{}{
 					"success":   true,
 					"operation": "create",
 					"project":   project,
-				})
+				}
+				resolved.addTo(response)
+				output.JSON(response)
 			}
 
 			return nil
@@ -319,13 +472,15 @@ Examples:
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Project description")
 	cmd.Flags().StringVar(&content, "content", "", "Project content (markdown)")
 	cmd.Flags().StringArrayVarP(&teamKeys, "team", "t", nil, "Team key (can be specified multiple times)")
+	cmd.Flags().StringVarP(&status, "status", "s", "", "Project status name, such as \"In Progress\" (see 'linear status list')")
 	cmd.Flags().StringVar(&statusID, "status-id", "", "Project status ID")
-	cmd.Flags().StringVar(&leadID, "lead", "", "Project lead user ID")
-	cmd.Flags().StringVar(&icon, "icon", "", "Project icon")
+	cmd.Flags().StringVar(&leadID, "lead", "", "Project lead: email, name, user ID or 'me'")
+	cmd.Flags().StringSliceVar(&members, "member", nil, "Project member: email, name, user ID or 'me' (repeatable)")
+	cmd.Flags().StringVar(&icon, "icon", "", "Project icon: a named icon or emoji (see 'linear icons search')")
 	cmd.Flags().StringVar(&color, "color", "", "Project color (#RRGGBB)")
-	cmd.Flags().StringVar(&startDate, "start-date", "", "Project start date (YYYY-MM-DD)")
-	cmd.Flags().StringVar(&targetDate, "target-date", "", "Project target date (YYYY-MM-DD)")
-	cmd.Flags().IntVar(&priority, "priority", 0, "Project priority (0-4)")
+	cmd.Flags().StringVar(&startDate, "start-date", "", "Project start date: "+dateFlagHelp)
+	cmd.Flags().StringVar(&targetDate, "target-date", "", "Project target date: "+dateFlagHelp)
+	cmd.Flags().StringVar(&priority, "priority", "", "Project priority: "+priorityUsage)
 
 	return cmd
 }
@@ -336,12 +491,13 @@ func newProjectUpdateCmd() *cobra.Command {
 		description string
 		content     string
 		statusID    string
+		status      string
 		leadID      string
 		icon        string
 		color       string
 		startDate   string
 		targetDate  string
-		priority    int
+		priority    string
 	)
 
 	cmd := &cobra.Command{
@@ -352,16 +508,28 @@ func newProjectUpdateCmd() *cobra.Command {
 Examples:
   linear project update abc123 --name "New Name"
   linear project update abc123 --description "Updated description"
-  linear project update abc123 --target-date 2025-06-01`,
+  linear project update abc123 --status Completed
+  linear project update abc123 --target-date 2025-06-01
+  linear project update abc123 --priority urgent
+  linear project update abc123 --lead alice@example.com`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
 
+			if cmd.Flags().Changed("status") && cmd.Flags().Changed("status-id") {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", "--status and --status-id cannot be used together")
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", "--status and --status-id cannot be used together")
+			}
+
 			// Check if at least one field is being updated
 			if !cmd.Flags().Changed("name") &&
 				!cmd.Flags().Changed("description") &&
 				!cmd.Flags().Changed("content") &&
 				!cmd.Flags().Changed("status-id") &&
+				!cmd.Flags().Changed("status") &&
 				!cmd.Flags().Changed("lead") &&
 				!cmd.Flags().Changed("icon") &&
 				!cmd.Flags().Changed("color") &&
@@ -369,18 +537,32 @@ Examples:
 				!cmd.Flags().Changed("target-date") &&
 				!cmd.Flags().Changed("priority") {
 				if IsHumanOutput() {
-					output.ErrorHuman("At least one field must be specified to update")
+					output.ErrorHuman("MISSING_FIELDS", "At least one field must be specified to update")
 					return nil
 				}
 				return output.Error("MISSING_FIELDS", "At least one field must be specified to update")
 			}
 
-			ctx := context.Background()
+			priorityValue, err := parsePriority(priority)
+			if cmd.Flags().Changed("priority") && err != nil {
+				return priorityError(err)
+			}
+
+			resolved, err := resolveDateFlags(map[string]*string{"start-date": &startDate, "target-date": &targetDate})
+			if err != nil {
+				return dateError(err)
+			}
+
+			if err := normalizeIconFlag(&icon); err != nil {
+				return iconError(err)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -400,8 +582,19 @@ Examples:
 			if cmd.Flags().Changed("status-id") {
 				input.StatusID = statusID
 			}
+			if cmd.Flags().Changed("status") {
+				if input.StatusID, err = resolveProjectStatusID(ctx, client, status); err != nil {
+					return projectStatusError(err)
+				}
+			}
 			if cmd.Flags().Changed("lead") {
-				input.LeadID = leadID
+				if input.LeadID, err = resolveUserID(ctx, client, leadID); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "INVALID_USER")
+						return nil
+					}
+					return output.ErrorFrom(err, "INVALID_USER")
+				}
 			}
 			if cmd.Flags().Changed("icon") {
 				input.Icon = icon
@@ -416,26 +609,30 @@ Examples:
 				input.TargetDate = targetDate
 			}
 			if cmd.Flags().Changed("priority") {
-				input.Priority = &priority
+				input.Priority = &priorityValue
 			}
 
 			project, err := client.UpdateProject(ctx, projectID, input)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
+			recordProject(project, history.ActionEdited)
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Project updated: %s", project.Name))
+				resolved.printHuman()
 			} else {
-				output.JSON(map[string]interface{}{
+				response := map[string]interface{}{
 					"success":   true,
 					"operation": "update",
 					"project":   project,
-				})
+				}
+				resolved.addTo(response)
+				output.JSON(response)
 			}
 
 			return nil
@@ -445,13 +642,14 @@ Examples:
 	cmd.Flags().StringVarP(&name, "name", "n", "", "Project name")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Project description")
 	cmd.Flags().StringVar(&content, "content", "", "Project content (markdown)")
+	cmd.Flags().StringVarP(&status, "status", "s", "", "Project status name, such as \"In Progress\" (see 'linear status list')")
 	cmd.Flags().StringVar(&statusID, "status-id", "", "Project status ID")
-	cmd.Flags().StringVar(&leadID, "lead", "", "Project lead user ID")
-	cmd.Flags().StringVar(&icon, "icon", "", "Project icon")
+	cmd.Flags().StringVar(&leadID, "lead", "", "Project lead: email, name, user ID or 'me'")
+	cmd.Flags().StringVar(&icon, "icon", "", "Project icon: a named icon or emoji (see 'linear icons search')")
 	cmd.Flags().StringVar(&color, "color", "", "Project color (#RRGGBB)")
-	cmd.Flags().StringVar(&startDate, "start-date", "", "Project start date (YYYY-MM-DD)")
-	cmd.Flags().StringVar(&targetDate, "target-date", "", "Project target date (YYYY-MM-DD)")
-	cmd.Flags().IntVar(&priority, "priority", 0, "Project priority (0-4)")
+	cmd.Flags().StringVar(&startDate, "start-date", "", "Project start date: "+dateFlagHelp)
+	cmd.Flags().StringVar(&targetDate, "target-date", "", "Project target date: "+dateFlagHelp)
+	cmd.Flags().StringVar(&priority, "priority", "", "Project priority: "+priorityUsage)
 
 	return cmd
 }
@@ -467,12 +665,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -481,10 +679,10 @@ Examples:
 			err = client.DeleteProject(ctx, projectID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -515,12 +713,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -529,10 +727,10 @@ Examples:
 			err = client.RestoreProject(ctx, projectID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -572,12 +770,12 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			query := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -586,10 +784,10 @@ Examples:
 			results, err := client.SearchProjects(ctx, query, limit, includeArchived, includeComments)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -662,16 +860,20 @@ func newProjectMilestoneCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "milestone",
 		Short: "Manage project milestones",
-		Long: `Create, list, update, and delete project milestones.
+		Long: `Create, list, update, reorder, and delete project milestones.
 
 Examples:
   linear project milestone list <project-id>
-  linear project milestone create <project-id> --name "Beta Release"`,
+  linear project milestone create <project-id> --name "Beta Release"
+  linear project milestone bulk-create <project-id> --file milestones.yaml
+  linear project milestone reorder <project-id> "Beta Release" --top`,
 	}
 
 	cmd.AddCommand(newProjectMilestoneListCmd())
 	cmd.AddCommand(newProjectMilestoneCreateCmd())
+	cmd.AddCommand(newProjectMilestoneBulkCreateCmd())
 	cmd.AddCommand(newProjectMilestoneUpdateCmd())
+	cmd.AddCommand(newProjectMilestoneReorderCmd())
 	cmd.AddCommand(newProjectMilestoneDeleteCmd())
 
 	return cmd
@@ -684,12 +886,12 @@ func newProjectMilestoneListCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -698,10 +900,10 @@ func newProjectMilestoneListCmd() *cobra.Command {
 			milestones, err := client.GetProjectMilestones(ctx, projectID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -736,40 +938,53 @@ Examples:
 
 			if name == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Milestone name is required. Use --name flag.")
+					output.ErrorHuman("MISSING_NAME", "Milestone name is required. Use --name flag.")
 					return nil
 				}
 				return output.Error("MISSING_NAME", "Milestone name is required")
 			}
 
-			ctx := context.Background()
+			resolved, err := resolveDateFlags(map[string]*string{"target-date": &targetDate})
+			if err != nil {
+				return dateError(err)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
-			milestone, err := client.CreateProjectMilestone(ctx, projectID, name, description, targetDate)
+			milestone, err := client.CreateProjectMilestone(ctx, api.ProjectMilestoneCreateInput{
+				ProjectID:   projectID,
+				Name:        name,
+				Description: description,
+				TargetDate:  targetDate,
+			})
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Milestone created: %s", milestone.Name))
+				resolved.printHuman()
 			} else {
-				output.JSON(map[string]interface{}{
+				response := map[string]interface{}{
 					"success":   true,
 					"operation": "create",
 					"milestone": milestone,
-				})
+				}
+				resolved.addTo(response)
+				output.JSON(response)
 			}
 
 			return nil
@@ -778,7 +993,7 @@ Examples:
 
 	cmd.Flags().StringVarP(&name, "name", "n", "", "Milestone name (required)")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Milestone description")
-	cmd.Flags().StringVar(&targetDate, "target-date", "", "Target date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&targetDate, "target-date", "", "Target date: "+dateFlagHelp)
 
 	return cmd
 }
@@ -801,51 +1016,59 @@ func newProjectMilestoneUpdateCmd() *cobra.Command {
 				!cmd.Flags().Changed("description") &&
 				!cmd.Flags().Changed("target-date") {
 				if IsHumanOutput() {
-					output.ErrorHuman("At least one field must be specified to update")
+					output.ErrorHuman("MISSING_FIELDS", "At least one field must be specified to update")
 					return nil
 				}
 				return output.Error("MISSING_FIELDS", "At least one field must be specified to update")
 			}
 
-			ctx := context.Background()
+			resolved, err := resolveDateFlags(map[string]*string{"target-date": &targetDate})
+			if err != nil {
+				return dateError(err)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
-			var namePtr, descPtr, datePtr *string
+			var input api.ProjectMilestoneUpdateInput
 			if cmd.Flags().Changed("name") {
-				namePtr = &name
+				input.Name = &name
 			}
 			if cmd.Flags().Changed("description") {
-				descPtr = &description
+				input.Description = &description
 			}
 			if cmd.Flags().Changed("target-date") {
-				datePtr = &targetDate
+				input.TargetDate = &targetDate
 			}
 
-			milestone, err := client.UpdateProjectMilestone(ctx, milestoneID, namePtr, descPtr, datePtr)
+			milestone, err := client.UpdateProjectMilestone(ctx, milestoneID, input)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Milestone updated: %s", milestone.Name))
+				resolved.printHuman()
 			} else {
-				output.JSON(map[string]interface{}{
+				response := map[string]interface{}{
 					"success":   true,
 					"operation": "update",
 					"milestone": milestone,
-				})
+				}
+				resolved.addTo(response)
+				output.JSON(response)
 			}
 
 			return nil
@@ -854,7 +1077,7 @@ func newProjectMilestoneUpdateCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&name, "name", "n", "", "Milestone name")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Milestone description")
-	cmd.Flags().StringVar(&targetDate, "target-date", "", "Target date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&targetDate, "target-date", "", "Target date: "+dateFlagHelp)
 
 	return cmd
 }
@@ -866,12 +1089,12 @@ func newProjectMilestoneDeleteCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			milestoneID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -880,10 +1103,10 @@ func newProjectMilestoneDeleteCmd() *cobra.Command {
 			err = client.DeleteProjectMilestone(ctx, milestoneID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -906,15 +1129,24 @@ func newProjectUpdateStatusCmd() *cobra.Command {
 		Use:     "update-status",
 		Aliases: []string{"updates"},
 		Short:   "Manage project status updates",
-		Long: `Create and list project status updates (changelog).
+		Long: `Post, discuss and manage project status updates (changelog).
 
 Examples:
   linear project update-status list <project-id>
-  linear project update-status create <project-id> --body "Progress update"`,
+  linear project update-status create <project-id> --body "Progress update"
+  linear project update-status view <update-id>
+  linear project update-status comment <update-id> --body "Nice progress!"
+  linear project update-status react <update-id> --emoji tada`,
 	}
 
 	cmd.AddCommand(newProjectUpdateStatusListCmd())
 	cmd.AddCommand(newProjectUpdateStatusCreateCmd())
+	cmd.AddCommand(newProjectUpdateStatusViewCmd())
+	cmd.AddCommand(newProjectUpdateStatusCommentCmd())
+	cmd.AddCommand(newProjectUpdateStatusEditCmd())
+	cmd.AddCommand(newProjectUpdateStatusDeleteCmd())
+	cmd.AddCommand(newProjectUpdateReactCmd())
+	cmd.AddCommand(newProjectUpdateUnreactCmd())
 
 	return cmd
 }
@@ -928,12 +1160,12 @@ func newProjectUpdateStatusListCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -942,10 +1174,10 @@ func newProjectUpdateStatusListCmd() *cobra.Command {
 			updates, err := client.GetProjectUpdates(ctx, projectID, limit)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -978,25 +1210,30 @@ Health values: onTrack, atRisk, offTrack
 
 Examples:
   linear project update-status create abc123 --body "All tasks completed for sprint 1"
-  linear project update-status create abc123 --body "Delayed due to dependencies" --health atRisk`,
+  linear project update-status create abc123 --body "Delayed due to dependencies" --health atRisk
+  linear project update-status create abc123 --editor --health onTrack`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
 
+			if ok, err := applyEditor(cmd, "body", nil); !ok {
+				return err
+			}
+
 			if body == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Update body is required. Use --body flag.")
+					output.ErrorHuman("MISSING_BODY", "Update body is required. Use --body flag.")
 					return nil
 				}
 				return output.Error("MISSING_BODY", "Update body is required")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -1010,10 +1247,10 @@ Examples:
 			update, err := client.CreateProjectUpdate(ctx, projectID, body, healthPtr)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			if IsHumanOutput() {
@@ -1032,6 +1269,7 @@ Examples:
 	}
 
 	cmd.Flags().StringVarP(&body, "body", "b", "", "Update body (required)")
+	cmd.Flags().Bool("editor", false, "Write the update body in $EDITOR")
 	cmd.Flags().StringVar(&health, "health", "", "Project health (onTrack, atRisk, offTrack)")
 
 	return cmd
@@ -1039,54 +1277,13 @@ Examples:
 
 // Human output formatters
 
-func printProjectsHuman(projects *api.ProjectsResponse) {
+func printProjectsHuman(projects *api.ProjectsResponse, columns []*listColumn[api.ProjectListItem]) {
 	if len(projects.Projects) == 0 {
 		output.HumanLn("No projects found")
 		return
 	}
 
-	headers := []string{"NAME", "STATUS", "PROGRESS", "LEAD", "TEAMS", "TARGET", "ID"}
-	rows := make([][]string, len(projects.Projects))
-
-	for i, p := range projects.Projects {
-		statusName := "-"
-		if p.Status != nil {
-			statusName = p.Status.Name
-		}
-
-		leadName := "-"
-		if p.Lead != nil {
-			leadName = p.Lead.DisplayName
-		}
-
-		teamKeys := make([]string, len(p.Teams))
-		for j, t := range p.Teams {
-			teamKeys[j] = t.Key
-		}
-		teamsStr := strings.Join(teamKeys, ", ")
-		if teamsStr == "" {
-			teamsStr = "-"
-		}
-
-		targetDate := "-"
-		if p.TargetDate != "" {
-			targetDate = p.TargetDate
-		}
-
-		progress := fmt.Sprintf("%.0f%%", p.Progress*100)
-
-		rows[i] = []string{
-			display.Truncate(p.Name, 40),
-			statusName,
-			progress,
-			leadName,
-			teamsStr,
-			targetDate,
-			output.Muted("%s", p.ID),
-		}
-	}
-
-	output.TableWithColors(headers, rows)
+	printColumnTable(columns, projects.Projects)
 	output.HumanLn("\n%d projects", projects.Count)
 }
 
@@ -1117,11 +1314,11 @@ func printProjectDetailHuman(p *api.ProjectDetail) {
 	}
 
 	if p.StartDate != "" {
-		output.HumanLn("Start Date: %s", p.StartDate)
+		output.HumanLn("Start Date: %s", display.FormatDueDate(p.StartDate))
 	}
 
 	if p.TargetDate != "" {
-		output.HumanLn("Target Date: %s", p.TargetDate)
+		output.HumanLn("Target Date: %s", display.FormatDueDate(p.TargetDate))
 	}
 
 	output.HumanLn("")
@@ -0,0 +1,391 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/dates"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// BurndownPoint is a project's scope and completed work at the end of a day
+type BurndownPoint struct {
+	Date            string  `json:"date"`
+	ScopePoints     float64 `json:"scopePoints"`
+	CompletedPoints float64 `json:"completedPoints"`
+	RemainingPoints float64 `json:"remainingPoints"`
+	ScopeIssues     int     `json:"scopeIssues"`
+	CompletedIssues int     `json:"completedIssues"`
+}
+
+// ProjectBurndownResponse is the response for 'linear project burndown'
+type ProjectBurndownResponse struct {
+	Project    string          `json:"project"`
+	ProjectID  string          `json:"projectId"`
+	TargetDate string          `json:"targetDate,omitempty"`
+	Interval   string          `json:"interval"`
+	Points     []BurndownPoint `json:"points"`
+	Count      int             `json:"count"`
+	// Unestimated counts the issues in scope at the end without an
+	// estimate, which add no points
+	Unestimated int `json:"unestimated"`
+}
+
+// burndownChartHeight is the number of rows of the burndown chart
+const burndownChartHeight = 8
+
+// burndownCSVColumns is the header of --format csv
+var burndownCSVColumns = []string{"date", "scope_points", "completed_points", "remaining_points", "scope_issues", "completed_issues"}
+
+func newProjectBurndownCmd() *cobra.Command {
+	var (
+		since    string
+		until    string
+		interval string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "burndown <project-id>",
+		Short: "Scope and completed points over time",
+		Long: `Show how a project's scope and completed points changed over time, from
+its start date (or its first issue) until today.
+
+Each point counts the issues in the project at the end of that day: an
+issue adds to scope from when it was created and to completed work from
+when it was completed, with its current estimate. Canceled issues are
+left out from when they were canceled. Issues moved into the project
+count from their creation, and estimates changed since are not replayed.
+
+--interval picks daily or weekly points; by default spans of up to 60
+days are daily. --human draws a chart of completed (█) and remaining (░)
+points, or issues when none are estimated; --format csv writes one row
+per point for spreadsheets.
+
+Examples:
+  linear project burndown <project-id> --human
+  linear project burndown <project-id> --since -4w --interval day
+  linear project burndown <project-id> --format csv > burndown.csv`,
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{namedFormatsAnnotation: "csv"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			flagErr := func(code string, err error) error {
+				if IsHumanOutput() {
+					output.ErrorHuman(code, err.Error())
+					return nil
+				}
+				return output.Error(code, err.Error())
+			}
+
+			if interval != "" && interval != "day" && interval != "week" {
+				return flagErr("INVALID_INTERVAL", fmt.Errorf("invalid interval %q (use day or week)", interval))
+			}
+			for flag, value := range map[string]*string{"--since": &since, "--until": &until} {
+				resolved, err := resolveFilterDate(*value)
+				if err != nil {
+					return flagErr("INVALID_DATE", fmt.Errorf("%s: %w", flag, err))
+				}
+				*value = resolved
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			project, err := client.GetProject(ctx, args[0])
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if project == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Project '%s' not found", args[0]))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Project '%s' not found", args[0]))
+			}
+
+			issues, _, err := collectPages("", true, func(after string) ([]api.IssueEstimate, *api.PageInfo, error) {
+				return client.GetIssueEstimates(ctx, api.IssueFilter{ProjectID: project.ID}, reportPageSize, after)
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			from, to, err := burndownSpan(project, issues, since, until)
+			if err != nil {
+				return flagErr("INVALID_DATE", err)
+			}
+			if interval == "" {
+				interval = "week"
+				if to.Sub(from) <= 60*24*time.Hour {
+					interval = "day"
+				}
+			}
+
+			points := buildBurndown(issues, burndownDays(from, to, interval))
+			resp := &ProjectBurndownResponse{
+				Project:    project.Name,
+				ProjectID:  project.ID,
+				TargetDate: project.TargetDate,
+				Interval:   interval,
+				Points:     points,
+				Count:      len(points),
+			}
+			for _, issue := range issues {
+				if issue.Estimate == nil && burndownInScope(issue, to.AddDate(0, 0, 1)) {
+					resp.Unestimated++
+				}
+			}
+
+			if namedFormat(cmd) == "csv" {
+				if err := writeBurndownCSV(os.Stdout, points); err != nil {
+					return flagErr("WRITE_ERROR", err)
+				}
+				return nil
+			}
+			if IsHumanOutput() {
+				printBurndownHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "", "First day of the burndown (default: the project's start date)")
+	cmd.Flags().StringVar(&until, "until", "", "Last day of the burndown (default: today)")
+	cmd.Flags().StringVar(&interval, "interval", "", "Days between points: day or week (default: day for up to 60 days)")
+
+	return cmd
+}
+
+// burndownSpan resolves the first and last day of a burndown. Without
+// since it starts on the project's start date, or the day its first issue
+// was created; without until it ends today.
+func burndownSpan(project *api.ProjectDetail, issues []api.IssueEstimate, since, until string) (from, to time.Time, err error) {
+	if since == "" {
+		since = project.StartDate
+	}
+	if since == "" {
+		first := time.Now()
+		for _, issue := range issues {
+			if created, err := display.ParseISO(issue.CreatedAt); err == nil && created.Before(first) {
+				first = created
+			}
+		}
+		since = first.Local().Format(dates.DateLayout)
+	}
+	if until == "" {
+		until = time.Now().Format(dates.DateLayout)
+	}
+
+	if from, err = time.ParseInLocation(dates.DateLayout, since[:min(len(since), 10)], time.Local); err != nil {
+		return from, to, fmt.Errorf("--since: %w", err)
+	}
+	if to, err = time.ParseInLocation(dates.DateLayout, until[:min(len(until), 10)], time.Local); err != nil {
+		return from, to, fmt.Errorf("--until: %w", err)
+	}
+	if to.Before(from) {
+		return from, to, fmt.Errorf("the burndown ends on %s, before it starts on %s", until, since)
+	}
+	return from, to, nil
+}
+
+// burndownDays returns the days from one to another interval apart,
+// always ending with the last day
+func burndownDays(from, to time.Time, interval string) []time.Time {
+	step := 1
+	if interval == "week" {
+		step = 7
+	}
+	var days []time.Time
+	for day := from; day.Before(to); day = day.AddDate(0, 0, step) {
+		days = append(days, day)
+	}
+	return append(days, to)
+}
+
+// burndownInScope reports whether issue was part of the project's scope
+// before end
+func burndownInScope(issue api.IssueEstimate, end time.Time) bool {
+	if created, err := display.ParseISO(issue.CreatedAt); err == nil && !created.Before(end) {
+		return false
+	}
+	if issue.CanceledAt == "" {
+		// Without a cancellation time, a canceled issue is left out
+		// throughout, as in the point reports
+		return issue.StateType != "canceled"
+	}
+	canceled, err := display.ParseISO(issue.CanceledAt)
+	return err == nil && !canceled.Before(end)
+}
+
+// buildBurndown counts the scope and completed work at the end of each day
+func buildBurndown(issues []api.IssueEstimate, days []time.Time) []BurndownPoint {
+	points := make([]BurndownPoint, len(days))
+	for i, day := range days {
+		end := day.AddDate(0, 0, 1)
+		p := BurndownPoint{Date: day.Format(dates.DateLayout)}
+		for _, issue := range issues {
+			if !burndownInScope(issue, end) {
+				continue
+			}
+			estimate := 0.0
+			if issue.Estimate != nil {
+				estimate = *issue.Estimate
+			}
+			p.ScopeIssues++
+			p.ScopePoints += estimate
+			if completed, err := display.ParseISO(issue.CompletedAt); err == nil && completed.Before(end) {
+				p.CompletedIssues++
+				p.CompletedPoints += estimate
+			}
+		}
+		p.RemainingPoints = p.ScopePoints - p.CompletedPoints
+		points[i] = p
+	}
+	return points
+}
+
+// writeBurndownCSV writes one row per point with burndownCSVColumns as the
+// header
+func writeBurndownCSV(w io.Writer, points []BurndownPoint) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(burndownCSVColumns); err != nil {
+		return err
+	}
+	for _, p := range points {
+		record := []string{
+			p.Date, formatPoints(p.ScopePoints), formatPoints(p.CompletedPoints), formatPoints(p.RemainingPoints),
+			strconv.Itoa(p.ScopeIssues), strconv.Itoa(p.CompletedIssues),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// burndownChart draws scope and completed values as columns: completed
+// work solid, the rest of the scope shaded, with the first and last
+// labels under the axis
+func burndownChart(scope, completed []float64, first, last string) []string {
+	top := 0.0
+	for _, v := range scope {
+		top = max(top, v)
+	}
+	if top == 0 {
+		top = 1
+	}
+	width := max(1, min(3, 48/len(scope)))
+	topLabel, midLabel := formatPoints(top), formatPoints(top/2)
+	labelWidth := max(len(topLabel), len(midLabel))
+
+	lines := make([]string, 0, burndownChartHeight+2)
+	for row := burndownChartHeight; row >= 1; row-- {
+		// A column fills a row once its value reaches the row's middle
+		level := top * (float64(row) - 0.5) / burndownChartHeight
+		label := ""
+		switch row {
+		case burndownChartHeight:
+			label = topLabel
+		case burndownChartHeight / 2:
+			label = midLabel
+		}
+		var b strings.Builder
+		for i := range scope {
+			switch {
+			case completed[i] > 0 && completed[i] >= level:
+				b.WriteString(output.Green("%s", strings.Repeat("█", width)))
+			case scope[i] > 0 && scope[i] >= level:
+				b.WriteString(output.Muted("%s", strings.Repeat("░", width)))
+			default:
+				b.WriteString(strings.Repeat(" ", width))
+			}
+		}
+		lines = append(lines, strings.TrimRight(fmt.Sprintf("%*s ┤%s", labelWidth, label, b.String()), " "))
+	}
+
+	span := len(scope) * width
+	lines = append(lines, fmt.Sprintf("%*s └%s", labelWidth, "0", strings.Repeat("─", span)))
+	axis := first
+	if gap := span - len(first) - len(last); gap > 0 && first != last {
+		axis = first + strings.Repeat(" ", gap) + last
+	} else if first != last {
+		axis = first + " – " + last
+	}
+	lines = append(lines, strings.Repeat(" ", labelWidth+2)+axis)
+	return lines
+}
+
+func printBurndownHuman(resp *ProjectBurndownResponse) {
+	last := resp.Points[len(resp.Points)-1]
+	unit := "points"
+	scope := make([]float64, len(resp.Points))
+	completed := make([]float64, len(resp.Points))
+	for i, p := range resp.Points {
+		scope[i], completed[i] = p.ScopePoints, p.CompletedPoints
+	}
+	if last.ScopePoints == 0 && last.ScopeIssues > 0 {
+		// Nothing is estimated, so chart issue counts instead
+		unit = "issues"
+		for i, p := range resp.Points {
+			scope[i], completed[i] = float64(p.ScopeIssues), float64(p.CompletedIssues)
+		}
+	}
+
+	output.HumanLn("%s", output.Bold("Burndown for %s", resp.Project))
+	every := "daily"
+	if resp.Interval == "week" {
+		every = "weekly"
+	}
+	output.HumanLn("%s", output.Muted("%s, %s", unit, every))
+	output.HumanLn("")
+
+	day := func(s string) string {
+		t, err := time.Parse(dates.DateLayout, s)
+		if err != nil {
+			return s
+		}
+		return display.FormatDay(t, "Jan 02")
+	}
+	for _, line := range burndownChart(scope, completed, day(resp.Points[0].Date), day(last.Date)) {
+		output.HumanLn("%s", line)
+	}
+	output.HumanLn("%s completed  %s remaining", output.Green("█"), output.Muted("░"))
+	output.HumanLn("")
+
+	total, done := scope[len(scope)-1], completed[len(completed)-1]
+	share := 0.0
+	if total > 0 {
+		share = done / total * 100
+	}
+	output.HumanLn("Scope:     %s %s (%d issues, %d unestimated)", formatPoints(total), unit, last.ScopeIssues, resp.Unestimated)
+	output.HumanLn("Completed: %s %s (%.0f%%)", formatPoints(done), unit, share)
+	output.HumanLn("Remaining: %s %s", formatPoints(total-done), unit)
+	if resp.TargetDate != "" {
+		output.HumanLn("Target:    %s", display.FormatDueDate(resp.TargetDate))
+	}
+}
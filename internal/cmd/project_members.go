@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+func newProjectMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members <project-id>",
+		Short: "List a project's lead and members",
+		Long: `List the members of a project with their role: "lead" for the project
+lead and "member" for everyone else.
+
+Leads and members are set by email, name or user ID with --lead and
+--member on 'linear project create', and --lead on 'linear project update'.
+
+Examples:
+  linear project members abc123
+  linear project members abc123 --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			members, err := client.GetProjectMembers(ctx, projectID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if members == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Project '%s' not found", projectID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Project '%s' not found", projectID))
+			}
+
+			if IsHumanOutput() {
+				printProjectMembersHuman(members)
+				return nil
+			}
+			return output.JSON(members)
+		},
+	}
+
+	return cmd
+}
+
+func printProjectMembersHuman(resp *api.ProjectMembersResponse) {
+	output.HumanLn("%s", output.Bold("%s", resp.Project.Name))
+	if len(resp.Members) == 0 {
+		output.HumanLn("No members")
+		return
+	}
+	output.HumanLn("")
+
+	rows := make([][]string, len(resp.Members))
+	for i, m := range resp.Members {
+		role := m.Role
+		if role == "lead" {
+			role = output.Cyan("lead")
+		}
+		name := m.DisplayName
+		if !m.Active {
+			name += output.Muted(" (inactive)")
+		}
+		rows[i] = []string{name, m.Name, m.Email, role}
+	}
+	output.TableWithColors([]string{"USER", "NAME", "EMAIL", "ROLE"}, rows)
+}
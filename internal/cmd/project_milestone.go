@@ -0,0 +1,583 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/breakdown"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// MilestoneReorderResponse is the response for the milestone reorder command
+type MilestoneReorderResponse struct {
+	Success     bool   `json:"success"`
+	Operation   string `json:"operation"`
+	Milestone   string `json:"milestone"`
+	MilestoneID string `json:"milestoneId"`
+	// Position is top, bottom, before or after
+	Position string `json:"position"`
+	// Target is the milestone placed against with before or after
+	Target    string  `json:"target,omitempty"`
+	SortOrder float64 `json:"sortOrder"`
+	// Index is the milestone's 0-based place in the project
+	Index int `json:"index"`
+}
+
+// MilestoneBulkItem is one milestone of a bulk-create file
+type MilestoneBulkItem struct {
+	Name       string `json:"name"`
+	TargetDate string `json:"targetDate,omitempty"`
+	// Status is created, planned (dry run), exists or failed
+	Status string `json:"status"`
+	ID     string `json:"id,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// MilestoneBulkCreateResponse is the response for the milestone
+// bulk-create command
+type MilestoneBulkCreateResponse struct {
+	Success    bool                `json:"success"`
+	Operation  string              `json:"operation"`
+	DryRun     bool                `json:"dryRun"`
+	ProjectID  string              `json:"projectId"`
+	Milestones []MilestoneBulkItem `json:"milestones"`
+	Created    int                 `json:"created"`
+	Existing   int                 `json:"existing"`
+	Failed     int                 `json:"failed"`
+}
+
+func newProjectMilestoneReorderCmd() *cobra.Command {
+	var (
+		before string
+		after  string
+		top    bool
+		bottom bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "reorder <project-id> <milestone>",
+		Short: "Move a milestone within its project",
+		Long: `Move a milestone within its project by changing its sort order.
+Milestones are named by ID or by name.
+
+--before and --after place the milestone next to another milestone of the
+project; --top and --bottom move it to either end.
+
+Examples:
+  linear project milestone reorder abc123 "Beta Release" --top
+  linear project milestone reorder abc123 "GA" --after "Beta Release"
+  linear project milestone reorder abc123 "Alpha" --bottom`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			set := 0
+			for _, on := range []bool{before != "", after != "", top, bottom} {
+				if on {
+					set++
+				}
+			}
+			if set != 1 {
+				msg := "Pass exactly one of --before, --after, --top or --bottom"
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FLAGS", msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			resp, err := reorderMilestone(ctx, client, args[0], args[1], before, after, top)
+			if err != nil {
+				return milestoneError(err)
+			}
+
+			if IsHumanOutput() {
+				where := resp.Position
+				if resp.Target != "" {
+					where += " " + resp.Target
+				}
+				output.SuccessHuman(fmt.Sprintf("Moved %s %s (position %d)", resp.Milestone, where, resp.Index+1))
+			} else {
+				output.JSON(resp)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&before, "before", "", "Place the milestone just before this milestone")
+	cmd.Flags().StringVar(&after, "after", "", "Place the milestone just after this milestone")
+	cmd.Flags().BoolVar(&top, "top", false, "Move the milestone to the start of the project")
+	cmd.Flags().BoolVar(&bottom, "bottom", false, "Move the milestone to the end of the project")
+
+	return cmd
+}
+
+func newProjectMilestoneBulkCreateCmd() *cobra.Command {
+	var (
+		filePath string
+		dryRun   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "bulk-create <project-id>",
+		Short: "Create milestones from a YAML or JSON file",
+		Long: `Create a project's milestones from a YAML or JSON file, in file order,
+after any milestones the project already has. Milestones whose name the
+project already uses are left alone, so the file can be applied again.
+
+Target dates accept the same expressions as --target-date.
+
+File format:
+  milestones:
+    - name: Alpha
+      target_date: 2025-03-01
+    - name: Beta
+      target_date: in 6 weeks
+      description: Feature complete
+
+The milestones key may be left out, leaving just the list; a plain string
+item is a milestone with only a name.
+
+Examples:
+  linear project milestone bulk-create abc123 --file milestones.yaml
+  linear project milestone bulk-create abc123 -f milestones.yaml --dry-run
+  generate-milestones | linear project milestone bulk-create abc123 -f -`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectID := args[0]
+
+			if filePath == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_FILE",
+						"Milestones file is required",
+						"Provide a YAML or JSON file using the --file flag, or - for stdin",
+						"linear project milestone bulk-create <project-id> -f milestones.yaml",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_FILE",
+					"Milestones file is required",
+					"Provide a YAML or JSON file using the --file flag, or - for stdin",
+					"linear project milestone bulk-create <project-id> -f milestones.yaml",
+				)
+			}
+
+			specs, err := loadMilestoneFile(filePath)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FILE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_FILE", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			existing, err := client.GetProjectMilestones(ctx, projectID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			resp := bulkCreateMilestones(ctx, client, projectID, existing.Milestones, specs, dryRun)
+			if resp.Failed > 0 {
+				output.Fail("CREATE_FAILED")
+			}
+
+			if IsHumanOutput() {
+				printMilestoneBulkHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "YAML or JSON milestones file (- for stdin)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would be created without changing anything")
+
+	return cmd
+}
+
+// MilestoneNotFoundError reports a milestone name or ID the project
+// doesn't have
+type MilestoneNotFoundError struct {
+	Ref, ProjectID string
+}
+
+func (e *MilestoneNotFoundError) Error() string {
+	return fmt.Sprintf("Milestone '%s' not found in project %s", e.Ref, e.ProjectID)
+}
+
+// Hint points at the command listing the project's milestones
+func (e *MilestoneNotFoundError) Hint() string {
+	return fmt.Sprintf("List the project's milestones with 'linear project milestone list %s'", e.ProjectID)
+}
+
+// milestoneError reports an error from reorderMilestone
+func milestoneError(err error) error {
+	var notFound *MilestoneNotFoundError
+	if errors.As(err, &notFound) {
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("MILESTONE_NOT_FOUND", notFound.Error(), notFound.Hint())
+			return nil
+		}
+		return output.ErrorWithHint("MILESTONE_NOT_FOUND", notFound.Error(), notFound.Hint())
+	}
+	var targetErr *ReorderTargetError
+	if errors.As(err, &targetErr) {
+		hint := "Pass a different milestone to --before or --after"
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("INVALID_TARGET", targetErr.Error(), hint)
+			return nil
+		}
+		return output.ErrorWithHint("INVALID_TARGET", targetErr.Error(), hint)
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil
+	}
+	return output.ErrorFrom(err, "API_ERROR")
+}
+
+// findMilestone finds a milestone by ID, then by case-insensitive name
+func findMilestone(milestones []api.Milestone, ref string) *api.Milestone {
+	for i := range milestones {
+		if milestones[i].ID == ref {
+			return &milestones[i]
+		}
+	}
+	for i := range milestones {
+		if strings.EqualFold(milestones[i].Name, ref) {
+			return &milestones[i]
+		}
+	}
+	return nil
+}
+
+// reorderMilestone moves a milestone of projectID before or after another
+// of its milestones, or to the start or end of the project when neither
+// is set
+func reorderMilestone(ctx context.Context, client *api.Client, projectID, ref, before, after string, top bool) (*MilestoneReorderResponse, error) {
+	list, err := client.GetProjectMilestones(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	milestone := findMilestone(list.Milestones, ref)
+	if milestone == nil {
+		return nil, &MilestoneNotFoundError{Ref: ref, ProjectID: projectID}
+	}
+
+	resp := &MilestoneReorderResponse{
+		Success:     true,
+		Operation:   "reorder",
+		Milestone:   milestone.Name,
+		MilestoneID: milestone.ID,
+	}
+
+	var target *api.Milestone
+	switch {
+	case before != "" || after != "":
+		targetRef := before
+		resp.Position = "before"
+		if after != "" {
+			targetRef = after
+			resp.Position = "after"
+		}
+		if target = findMilestone(list.Milestones, targetRef); target == nil {
+			return nil, &MilestoneNotFoundError{Ref: targetRef, ProjectID: projectID}
+		}
+		resp.Target = target.Name
+		if target.ID == milestone.ID {
+			return nil, &ReorderTargetError{Issue: milestone.Name, Target: target.Name}
+		}
+	case top:
+		resp.Position = "top"
+	default:
+		resp.Position = "bottom"
+	}
+
+	others := make([]api.Milestone, 0, len(list.Milestones))
+	for _, other := range list.Milestones {
+		if other.ID != milestone.ID {
+			others = append(others, other)
+		}
+	}
+	sort.SliceStable(others, func(i, j int) bool { return others[i].SortOrder < others[j].SortOrder })
+
+	orders := make([]float64, len(others))
+	targetIndex := -1
+	for i, other := range others {
+		orders[i] = other.SortOrder
+		if target != nil && other.ID == target.ID {
+			targetIndex = i
+		}
+	}
+	sortOrder, index := placeSortOrder(orders, resp.Position, targetIndex)
+	resp.SortOrder = sortOrder
+	resp.Index = index
+
+	if sortOrder != milestone.SortOrder {
+		if _, err := client.UpdateProjectMilestone(ctx, milestone.ID, api.ProjectMilestoneUpdateInput{SortOrder: &sortOrder}); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// milestoneSpec is one milestone of a bulk-create file, with its target
+// date resolved
+type milestoneSpec struct {
+	Name        string
+	Description string
+	TargetDate  string
+}
+
+// loadMilestoneFile reads a YAML or JSON milestones file, chosen by file
+// extension; "-" reads standard input, which may be either. Every target
+// date is resolved up front so a bad entry fails before anything is created.
+func loadMilestoneFile(path string) ([]milestoneSpec, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+		path = "stdin"
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	trimmed := strings.TrimSpace(string(data))
+	switch ext := strings.ToLower(filepath.Ext(path)); {
+	case ext == ".json", path == "stdin" && (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")):
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	default:
+		if value, err = breakdown.ParseYAML(string(data)); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	specs, err := milestoneSpecsFromValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return specs, nil
+}
+
+// milestoneSpecsFromValue converts a decoded YAML or JSON document, a list
+// of milestones or a mapping with a milestones key, into milestone specs
+func milestoneSpecsFromValue(value interface{}) ([]milestoneSpec, error) {
+	if doc, ok := value.(map[string]interface{}); ok {
+		for key := range doc {
+			if key != "milestones" {
+				return nil, fmt.Errorf("unknown key %q", key)
+			}
+		}
+		value = doc["milestones"]
+	}
+	items, ok := value.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("expected a list of milestones")
+	}
+
+	specs := make([]milestoneSpec, 0, len(items))
+	seen := map[string]bool{}
+	for i, item := range items {
+		var spec milestoneSpec
+		switch v := item.(type) {
+		case string:
+			spec.Name = v
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				field := v[key]
+				switch key {
+				case "name":
+					spec.Name = milestoneScalar(field)
+				case "description":
+					spec.Description = milestoneScalar(field)
+				case "target_date", "targetDate":
+					spec.TargetDate = milestoneScalar(field)
+				default:
+					return nil, fmt.Errorf("milestone %d: unknown key %q (use name, description or target_date)", i+1, key)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("milestone %d: expected a name or a mapping", i+1)
+		}
+
+		spec.Name = strings.TrimSpace(spec.Name)
+		if spec.Name == "" {
+			return nil, fmt.Errorf("milestone %d: name is required", i+1)
+		}
+		if seen[strings.ToLower(spec.Name)] {
+			return nil, fmt.Errorf("milestone %d: '%s' is listed twice", i+1, spec.Name)
+		}
+		seen[strings.ToLower(spec.Name)] = true
+
+		date, err := resolveDueDate(strings.TrimSpace(spec.TargetDate))
+		if err != nil {
+			return nil, fmt.Errorf("milestone '%s': target_date: %w", spec.Name, err)
+		}
+		spec.TargetDate = date
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// milestoneScalar renders a decoded scalar as a string
+func milestoneScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// bulkCreateMilestones creates the milestones of specs the project doesn't
+// have yet, in order after its existing milestones, and carries on past a
+// failed milestone so one bad entry doesn't stop the rest
+func bulkCreateMilestones(ctx context.Context, client *api.Client, projectID string, existing []api.Milestone, specs []milestoneSpec, dryRun bool) *MilestoneBulkCreateResponse {
+	resp := &MilestoneBulkCreateResponse{
+		Operation:  "bulk-create",
+		DryRun:     dryRun,
+		ProjectID:  projectID,
+		Milestones: []MilestoneBulkItem{},
+	}
+
+	byName := map[string]string{}
+	next := 0.0
+	for i, m := range existing {
+		byName[strings.ToLower(m.Name)] = m.ID
+		if i == 0 || m.SortOrder >= next {
+			next = m.SortOrder + 1
+		}
+	}
+
+	var progress *output.Progress
+	if !dryRun {
+		progress = output.NewProgress("Creating", len(specs))
+	}
+	for _, spec := range specs {
+		item := MilestoneBulkItem{Name: spec.Name, TargetDate: spec.TargetDate}
+		if id, ok := byName[strings.ToLower(spec.Name)]; ok {
+			item.Status, item.ID = scaffoldExists, id
+			resp.Milestones = append(resp.Milestones, item)
+			if progress != nil {
+				progress.Add(1)
+			}
+			continue
+		}
+
+		if dryRun {
+			item.Status = scaffoldPlanned
+		} else {
+			progress.Add(1)
+			sortOrder := next
+			created, err := client.CreateProjectMilestone(ctx, api.ProjectMilestoneCreateInput{
+				ProjectID:   projectID,
+				Name:        spec.Name,
+				Description: spec.Description,
+				TargetDate:  spec.TargetDate,
+				SortOrder:   &sortOrder,
+			})
+			if err != nil {
+				item.Status, item.Error = scaffoldFailed, err.Error()
+			} else {
+				item.Status, item.ID = scaffoldCreated, created.ID
+			}
+		}
+		next++
+		resp.Milestones = append(resp.Milestones, item)
+	}
+	if progress != nil {
+		progress.Done()
+	}
+
+	for _, item := range resp.Milestones {
+		switch item.Status {
+		case scaffoldCreated, scaffoldPlanned:
+			resp.Created++
+		case scaffoldExists:
+			resp.Existing++
+		case scaffoldFailed:
+			resp.Failed++
+		}
+	}
+	resp.Success = resp.Failed == 0
+	return resp
+}
+
+func printMilestoneBulkHuman(resp *MilestoneBulkCreateResponse) {
+	rows := make([][]string, len(resp.Milestones))
+	for i, item := range resp.Milestones {
+		status := item.Status
+		switch item.Status {
+		case scaffoldCreated:
+			status = output.Green("%s", item.Status)
+		case scaffoldPlanned:
+			status = output.Yellow("%s", item.Status)
+		case scaffoldExists:
+			status = output.Muted("%s", item.Status)
+		case scaffoldFailed:
+			status = output.Red("%s: %s", item.Status, item.Error)
+		}
+		rows[i] = []string{item.Name, dashIfEmpty(item.TargetDate), status}
+	}
+	output.TableWithColors([]string{"NAME", "TARGET DATE", "STATUS"}, rows)
+
+	verb := "created"
+	if resp.DryRun {
+		verb = "to create"
+	}
+	output.HumanLn("\n%d %s, %d already existed, %d failed", resp.Created, verb, resp.Existing, resp.Failed)
+}
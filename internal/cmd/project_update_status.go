@@ -0,0 +1,283 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// projectHealths are the health values of a project status update
+var projectHealths = []string{"onTrack", "atRisk", "offTrack"}
+
+func newProjectUpdateStatusViewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view <update-id>",
+		Short: "View a status update with its comments and reactions",
+		Long: `View a project status update with its comment thread and reactions.
+
+Examples:
+  linear project update-status view <update-id>
+  linear project update-status view <update-id> --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			update, err := client.GetProjectUpdate(ctx, args[0])
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				printProjectUpdateDetailHuman(update)
+				return nil
+			}
+			return output.JSON(update)
+		},
+	}
+
+	return cmd
+}
+
+func newProjectUpdateStatusCommentCmd() *cobra.Command {
+	var (
+		body     string
+		parentID string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "comment <update-id>",
+		Short: "Comment on a status update",
+		Long: `Comment on a project status update, or reply to one of its comments
+with --parent.
+
+Examples:
+  linear project update-status comment <update-id> --body "Is the API blocker resolved?"
+  linear project update-status comment <update-id> --body "Yes, shipped today" --parent <comment-id>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if body == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_BODY", "Comment body is required. Use --body flag.")
+					return nil
+				}
+				return output.Error("MISSING_BODY", "Comment body is required")
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			comment, err := client.CreateProjectUpdateComment(ctx, args[0], parentID, body)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Comment added")
+				output.HumanLn("  ID: %s", comment.ID)
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "comment",
+				"updateId":  args[0],
+				"comment":   comment,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&body, "body", "b", "", "Comment body in markdown (required)")
+	cmd.Flags().StringVar(&parentID, "parent", "", "Comment ID to reply to")
+
+	return cmd
+}
+
+func newProjectUpdateStatusEditCmd() *cobra.Command {
+	var (
+		body   string
+		health string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit <update-id>",
+		Short: "Edit a status update",
+		Long: `Edit a project status update's body or health.
+
+Health values: onTrack, atRisk, offTrack
+
+Examples:
+  linear project update-status edit <update-id> --body "Corrected: launch moved to Friday"
+  linear project update-status edit <update-id> --health offTrack`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var bodyPtr, healthPtr *string
+			if cmd.Flags().Changed("body") {
+				bodyPtr = &body
+			}
+			if cmd.Flags().Changed("health") {
+				if !containsFold(projectHealths, health) {
+					msg := fmt.Sprintf("Invalid health '%s'", health)
+					hint := "Valid values: " + strings.Join(projectHealths, ", ")
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint("INVALID_HEALTH", msg, hint)
+						return nil
+					}
+					return output.ErrorWithHint("INVALID_HEALTH", msg, hint)
+				}
+				for _, h := range projectHealths {
+					if strings.EqualFold(h, health) {
+						health = h
+					}
+				}
+				healthPtr = &health
+			}
+			if bodyPtr == nil && healthPtr == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_FIELDS", "At least one of --body or --health is required")
+					return nil
+				}
+				return output.Error("MISSING_FIELDS", "At least one of --body or --health is required")
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			update, err := client.UpdateProjectUpdate(ctx, args[0], bodyPtr, healthPtr)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Status update edited")
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "edit",
+				"update":    update,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&body, "body", "b", "", "New update body")
+	cmd.Flags().StringVar(&health, "health", "", "New project health (onTrack, atRisk, offTrack)")
+
+	return cmd
+}
+
+func newProjectUpdateStatusDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <update-id>",
+		Aliases: []string{"archive"},
+		Short:   "Delete (archive) a status update",
+		Long: `Delete (archive) a project status update.
+
+Examples:
+  linear project update-status delete <update-id>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			if err := client.ArchiveProjectUpdate(ctx, args[0]); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Status update deleted")
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "delete",
+				"updateId":  args[0],
+			})
+		},
+	}
+
+	return cmd
+}
+
+func printProjectUpdateDetailHuman(u *api.ProjectUpdateDetail) {
+	author := "Unknown"
+	if u.User != nil {
+		author = u.User.DisplayName
+	}
+	createdAt := u.CreatedAt
+	if t, err := time.Parse(time.RFC3339, u.CreatedAt); err == nil {
+		createdAt = display.TimeAgo(t)
+	}
+
+	header := fmt.Sprintf("Update by %s %s", author, createdAt)
+	if u.Project != nil {
+		header = fmt.Sprintf("%s update by %s %s", u.Project.Name, author, createdAt)
+	}
+	output.HumanLn("%s", output.Bold("%s", header))
+	if u.Health != "" {
+		output.HumanLn("Health: %s", healthColor(u.Health, u.Health))
+	}
+	output.HumanLn("")
+	output.HumanLn("%s", u.Body)
+	if len(u.Reactions) > 0 {
+		output.HumanLn("\n%s", reactionSummary(u.Reactions))
+	}
+	if u.URL != "" {
+		output.HumanLn("\n%s", output.Muted("%s", u.URL))
+	}
+
+	output.HumanLn("\n%s\n", output.Bold("Comments"))
+	printCommentsHuman(u.Comments)
+}
@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// reactionTarget is what a reaction command applies to
+type reactionTarget string
+
+const (
+	reactionOnComment       reactionTarget = "comment"
+	reactionOnIssue         reactionTarget = "issue"
+	reactionOnProjectUpdate reactionTarget = "update"
+)
+
+func newIssueCommentReactCmd() *cobra.Command {
+	return newReactionCmd(reactionOnComment, true)
+}
+
+func newIssueCommentUnreactCmd() *cobra.Command {
+	return newReactionCmd(reactionOnComment, false)
+}
+
+func newIssueReactCmd() *cobra.Command {
+	return newReactionCmd(reactionOnIssue, true)
+}
+
+func newIssueUnreactCmd() *cobra.Command {
+	return newReactionCmd(reactionOnIssue, false)
+}
+
+func newProjectUpdateReactCmd() *cobra.Command {
+	return newReactionCmd(reactionOnProjectUpdate, true)
+}
+
+func newProjectUpdateUnreactCmd() *cobra.Command {
+	return newReactionCmd(reactionOnProjectUpdate, false)
+}
+
+// newReactionCmd builds the react/unreact command for comments, issues or
+// project updates
+func newReactionCmd(target reactionTarget, add bool) *cobra.Command {
+	var emoji string
+
+	use, short := "react", "Add an emoji reaction to "
+	if !add {
+		use, short = "unreact", "Remove your emoji reaction from "
+	}
+	var parent, example string
+	switch target {
+	case reactionOnComment:
+		parent, example = "linear issue comment", "<comment-id>"
+		short += "a comment"
+	case reactionOnProjectUpdate:
+		parent, example = "linear project update-status", "<update-id>"
+		short += "a project update"
+	default:
+		parent, example = "linear issue", "ENG-123"
+		short += "an issue"
+	}
+
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s <%s-id>", use, target),
+		Short: short,
+		Long: fmt.Sprintf(`%s.
+
+The emoji may be the character itself, a :shortcode: or a name such as
++1, eyes or tada.
+
+Examples:
+  %s %s %s --emoji 👍
+  %s %s %s --emoji eyes`, short, parent, use, example, parent, use, example),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := args[0]
+
+			if emoji == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_EMOJI", "Emoji is required. Use --emoji flag.")
+					return nil
+				}
+				return output.Error("MISSING_EMOJI", "Emoji is required. Use --emoji flag.")
+			}
+			name := display.EmojiName(emoji)
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			if target == reactionOnIssue && !isUUID(id) {
+				issue, err := client.GetIssue(ctx, id, false)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if issue == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", id))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", id))
+				}
+				id = issue.ID
+			}
+
+			if add {
+				input := api.ReactionCreateInput{Emoji: name}
+				switch target {
+				case reactionOnComment:
+					input.CommentID = id
+				case reactionOnProjectUpdate:
+					input.ProjectUpdateID = id
+				default:
+					input.IssueID = id
+				}
+				reaction, err := client.CreateReaction(ctx, input)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+
+				if IsHumanOutput() {
+					output.SuccessHuman(fmt.Sprintf("Reacted %s", display.EmojiGlyph(name)))
+				} else {
+					output.JSON(map[string]interface{}{
+						"success":   true,
+						"operation": "react",
+						"reaction":  reaction,
+					})
+				}
+				return nil
+			}
+
+			removed, err := removeOwnReactions(ctx, client, target, id, name)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if removed == 0 {
+				msg := fmt.Sprintf("You have not reacted %s to this %s", display.EmojiGlyph(name), target)
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", msg)
+					return nil
+				}
+				return output.Error("NOT_FOUND", msg)
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Removed %s", display.EmojiGlyph(name)))
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":   true,
+					"operation": "unreact",
+					"emoji":     name,
+					"removed":   removed,
+				})
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&emoji, "emoji", "", "Emoji to react with (👍, :eyes:, tada)")
+
+	return cmd
+}
+
+// removeOwnReactions deletes the viewer's reactions with the given emoji
+func removeOwnReactions(ctx context.Context, client *api.Client, target reactionTarget, id, name string) (int, error) {
+	var viewerID string
+	var reactions []api.Reaction
+	err := api.Parallel(ctx,
+		func(ctx context.Context) (err error) {
+			viewerID, err = client.GetViewerID(ctx)
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			switch target {
+			case reactionOnComment:
+				reactions, err = client.GetCommentReactions(ctx, id)
+			case reactionOnProjectUpdate:
+				var update *api.ProjectUpdateDetail
+				if update, err = client.GetProjectUpdate(ctx, id); err == nil {
+					reactions = update.Reactions
+				}
+			default:
+				reactions, err = client.GetIssueReactions(ctx, id)
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, r := range reactions {
+		if r.Emoji != name || r.User == nil || r.User.ID != viewerID {
+			continue
+		}
+		if err := client.DeleteReaction(ctx, r.ID); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// reactionSummary renders reaction counts such as "👍 2  🎉 1", most
+// frequent first
+func reactionSummary(reactions []api.Reaction) string {
+	counts := map[string]int{}
+	order := []string{}
+	for _, r := range reactions {
+		if counts[r.Emoji] == 0 {
+			order = append(order, r.Emoji)
+		}
+		counts[r.Emoji]++
+	}
+	sort.SliceStable(order, func(i, j int) bool { return counts[order[i]] > counts[order[j]] })
+
+	parts := make([]string, len(order))
+	for i, name := range order {
+		parts[i] = fmt.Sprintf("%s %d", display.EmojiGlyph(name), counts[name])
+	}
+	return strings.Join(parts, "  ")
+}
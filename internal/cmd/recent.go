@@ -0,0 +1,416 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+// HistoryResponse is the response for 'linear recent' and 'linear fav list'
+type HistoryResponse struct {
+	Items []history.Item `json:"items"`
+	Count int            `json:"count"`
+}
+
+// recordRecent notes item in the local history. History is a convenience,
+// so failures are ignored.
+func recordRecent(item history.Item) {
+	if !history.Enabled() {
+		return
+	}
+	store, err := history.NewStore()
+	if err != nil {
+		return
+	}
+	store.Touch(item)
+}
+
+// recordIssue notes an issue in the local history
+func recordIssue(id, identifier, title, url, action string) {
+	recordRecent(history.Item{Kind: history.KindIssue, ID: id, Key: identifier, Title: title, URL: url, Action: action})
+}
+
+// recordProject notes a project in the local history
+func recordProject(p *api.ProjectDetail, action string) {
+	recordRecent(history.Item{Kind: history.KindProject, ID: p.ID, Key: p.SlugID, Title: p.Name, URL: p.URL, Action: action})
+}
+
+// recordDocument notes a document in the local history
+func recordDocument(d *api.Document, action string) {
+	recordRecent(history.Item{Kind: history.KindDocument, ID: d.ID, Key: d.SlugID, Title: d.Title, URL: d.URL, Action: action})
+}
+
+// NewRecentCmd creates the recent command
+func NewRecentCmd() *cobra.Command {
+	var (
+		kind  string
+		limit int
+		clear bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "recent",
+		Short: "List recently viewed and edited items",
+		Long: `List the issues, projects and documents you recently viewed, created or
+edited with this CLI, most recent first.
+
+History is kept in $XDG_STATE_HOME/agent-linear-cli (~/.local/state by
+default). Set LINEAR_HISTORY=off to stop recording.
+
+Commands that take an issue accept '-' for the last issue you touched:
+  linear issue view -
+  linear issue update - --state "In Review"
+
+Examples:
+  linear recent --human
+  linear recent --kind document
+  linear recent --clear`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if kind != "" && !containsFold(history.Kinds, kind) {
+				msg := fmt.Sprintf("Invalid kind '%s'", kind)
+				hint := "Valid kinds: " + strings.Join(history.Kinds, ", ")
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint("INVALID_KIND", msg, hint)
+					return nil
+				}
+				return output.ErrorWithHint("INVALID_KIND", msg, hint)
+			}
+
+			store, err := history.NewStore()
+			if err == nil && clear {
+				err = store.ClearRecent()
+			}
+			var items []history.Item
+			if err == nil && !clear {
+				items, err = store.Recent()
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("HISTORY_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("HISTORY_ERROR", err.Error())
+			}
+
+			if clear {
+				if IsHumanOutput() {
+					output.SuccessHuman("Recent history cleared")
+					return nil
+				}
+				return output.JSON(map[string]interface{}{
+					"success":   true,
+					"operation": "clear",
+				})
+			}
+
+			items = filterHistory(items, kind, limit)
+			response := &HistoryResponse{Items: items, Count: len(items)}
+
+			if IsHumanOutput() {
+				printHistoryHuman(response, "No recent items", true)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().StringVarP(&kind, "kind", "k", "", "Only items of this kind (issue, project, document)")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 20, "Maximum number of items to show")
+	cmd.Flags().BoolVar(&clear, "clear", false, "Forget all recent items")
+
+	return cmd
+}
+
+// NewFavCmd creates the fav command group
+func NewFavCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fav",
+		Aliases: []string{"favorite", "favorites"},
+		Short:   "Manage local favorites",
+		Long: `Keep a local list of favorite issues, projects and documents.
+
+Examples:
+  linear fav add ENG-123
+  linear fav add -                       # the last issue you touched
+  linear fav add <project-id> --kind project
+  linear fav list --human
+  linear fav remove ENG-123`,
+	}
+
+	cmd.AddCommand(newFavAddCmd())
+	cmd.AddCommand(newFavRemoveCmd())
+	cmd.AddCommand(newFavListCmd())
+
+	return cmd
+}
+
+func newFavAddCmd() *cobra.Command {
+	var kind string
+
+	cmd := &cobra.Command{
+		Use:   "add <issue-id | project-id | document-id>",
+		Short: "Add a favorite",
+		Long: `Add an issue, project or document to your favorites. The item is looked
+up so the list shows its current title and URL.
+
+Examples:
+  linear fav add ENG-123
+  linear fav add https://linear.app/acme/project/q3-launch-0a1b2c3d4e5f
+  linear fav add <project-id> --kind project
+  linear fav add <document-id> --kind document`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// A Linear URL names its own kind
+			if u, ok := resolver.ParseURL(args[0]); ok && !cmd.Flags().Changed("kind") {
+				kind = u.Kind
+			}
+			if !containsFold(history.Kinds, kind) {
+				msg := fmt.Sprintf("Invalid kind '%s'", kind)
+				hint := "Valid kinds: " + strings.Join(history.Kinds, ", ")
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint("INVALID_KIND", msg, hint)
+					return nil
+				}
+				return output.ErrorWithHint("INVALID_KIND", msg, hint)
+			}
+			kind = strings.ToLower(kind)
+
+			ref, err := resolveRef(kind, args[0])
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_IDENTIFIER", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_IDENTIFIER", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			item, err := lookupHistoryItem(ctx, client, kind, ref)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if item == nil {
+				msg := fmt.Sprintf("%s '%s' not found", strings.ToUpper(kind[:1])+kind[1:], args[0])
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", msg)
+					return nil
+				}
+				return output.Error("NOT_FOUND", msg)
+			}
+
+			store, err := history.NewStore()
+			var added bool
+			if err == nil {
+				added, err = store.AddFavorite(*item)
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("HISTORY_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("HISTORY_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				if added {
+					output.SuccessHuman(fmt.Sprintf("Added %s to favorites", item.Ref()))
+				} else {
+					output.SuccessHuman(fmt.Sprintf("%s is already a favorite", item.Ref()))
+				}
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "add",
+				"added":     added,
+				"item":      item,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&kind, "kind", "k", history.KindIssue, "Kind of item (issue, project, document)")
+
+	return cmd
+}
+
+// lookupHistoryItem fetches an issue, project or document as a history
+// item, or nil when it does not exist
+func lookupHistoryItem(ctx context.Context, client *api.Client, kind, ref string) (*history.Item, error) {
+	switch kind {
+	case history.KindProject:
+		p, err := client.GetProject(ctx, ref)
+		if err != nil || p == nil {
+			return nil, err
+		}
+		return &history.Item{Kind: kind, ID: p.ID, Key: p.SlugID, Title: p.Name, URL: p.URL}, nil
+	case history.KindDocument:
+		d, err := client.GetDocument(ctx, ref)
+		if err != nil || d == nil {
+			return nil, err
+		}
+		return &history.Item{Kind: kind, ID: d.ID, Key: d.SlugID, Title: d.Title, URL: d.URL}, nil
+	}
+	issue, err := client.GetIssue(ctx, ref, false)
+	if err != nil || issue == nil {
+		return nil, err
+	}
+	return &history.Item{Kind: kind, ID: issue.ID, Key: issue.Identifier, Title: issue.Title, URL: issue.URL}, nil
+}
+
+func newFavRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <issue-id | id>",
+		Aliases: []string{"rm"},
+		Short:   "Remove a favorite",
+		Long: `Remove a favorite by identifier, slug or ID.
+
+Examples:
+  linear fav remove ENG-123
+  linear fav rm <project-id>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := history.NewStore()
+			var removed []history.Item
+			if err == nil {
+				removed, err = store.RemoveFavorite(args[0])
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("HISTORY_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("HISTORY_ERROR", err.Error())
+			}
+			if len(removed) == 0 {
+				msg := fmt.Sprintf("'%s' is not a favorite", args[0])
+				hint := "List favorites with 'linear fav list'"
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint("NOT_FOUND", msg, hint)
+					return nil
+				}
+				return output.ErrorWithHint("NOT_FOUND", msg, hint)
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Removed %s from favorites", removed[0].Ref()))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "remove",
+				"items":     removed,
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newFavListCmd() *cobra.Command {
+	var kind string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List favorites",
+		Long: `List your favorite issues, projects and documents in the order they were
+added.
+
+Examples:
+  linear fav list --human
+  linear fav list --kind project`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := history.NewStore()
+			var items []history.Item
+			if err == nil {
+				items, err = store.Favorites()
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("HISTORY_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("HISTORY_ERROR", err.Error())
+			}
+
+			items = filterHistory(items, kind, 0)
+			response := &HistoryResponse{Items: items, Count: len(items)}
+
+			if IsHumanOutput() {
+				printHistoryHuman(response, "No favorites yet; add one with 'linear fav add ENG-123'", false)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().StringVarP(&kind, "kind", "k", "", "Only items of this kind (issue, project, document)")
+
+	return cmd
+}
+
+// filterHistory keeps items of kind, if set, up to limit items when limit
+// is positive
+func filterHistory(items []history.Item, kind string, limit int) []history.Item {
+	filtered := []history.Item{}
+	for _, item := range items {
+		if kind != "" && !strings.EqualFold(item.Kind, kind) {
+			continue
+		}
+		if limit > 0 && len(filtered) == limit {
+			break
+		}
+		filtered = append(filtered, item)
+	}
+	return filtered
+}
+
+func printHistoryHuman(response *HistoryResponse, empty string, recent bool) {
+	if response.Count == 0 {
+		output.HumanLn("%s", empty)
+		return
+	}
+
+	headers := []string{"KIND", "REF", "TITLE", "URL"}
+	if recent {
+		headers = []string{"KIND", "REF", "TITLE", "ACTION", "WHEN"}
+	}
+	rows := make([][]string, len(response.Items))
+
+	for i, item := range response.Items {
+		title := item.Title
+		if title == "" {
+			title = "-"
+		}
+		rows[i] = []string{item.Kind, item.Ref(), display.Truncate(title, 50)}
+		if recent {
+			rows[i] = append(rows[i], item.Action, display.TimeAgo(item.At))
+		} else {
+			rows[i] = append(rows[i], output.Muted("%s", item.URL))
+		}
+	}
+
+	output.TableWithColors(headers, rows)
+}
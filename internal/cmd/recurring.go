@@ -0,0 +1,673 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/dates"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/recurring"
+	"github.com/juanbermudez/agent-linear-cli/internal/templates"
+	"github.com/spf13/cobra"
+)
+
+// Recurring run statuses
+const (
+	recurringCreated = "created"
+	recurringExists  = "exists"
+	recurringNotDue  = "not_due"
+	recurringPlanned = "planned"
+	recurringFailed  = "failed"
+)
+
+// RecurringRule is a rule with its next occurrence, for 'recurring list'
+type RecurringRule struct {
+	*recurring.Rule
+	NextRun *time.Time `json:"nextRun,omitempty"`
+}
+
+// RecurringRunResult is the outcome of one rule in 'recurring run'
+type RecurringRunResult struct {
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	Occurrence *time.Time `json:"occurrence,omitempty"`
+	Title      string     `json:"title,omitempty"`
+	Issue      string     `json:"issue,omitempty"`
+	URL        string     `json:"url,omitempty"`
+	NextRun    *time.Time `json:"nextRun,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// RecurringRunResponse is the response for 'recurring run'
+type RecurringRunResponse struct {
+	Success bool                 `json:"success"`
+	DryRun  bool                 `json:"dryRun,omitempty"`
+	Results []RecurringRunResult `json:"results"`
+	Created int                  `json:"created"`
+	Failed  int                  `json:"failed"`
+}
+
+// NewRecurringCmd creates the recurring command group
+func NewRecurringCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recurring",
+		Short: "Create issues on a schedule",
+		Long: `Define issues that recur on a cron schedule, such as a weekly standup
+or a monthly release checklist, and create them with 'linear recurring run'.
+
+Rules are kept in $XDG_STATE_HOME/agent-linear-cli/recurring.json, or the
+file named by LINEAR_RECURRING_FILE. Run 'linear recurring run' from cron
+or CI as often as you like: each occurrence creates one issue, and runs
+that find the issue already exists record it instead of creating another.`,
+	}
+
+	cmd.AddCommand(newRecurringAddCmd())
+	cmd.AddCommand(newRecurringListCmd())
+	cmd.AddCommand(newRecurringRemoveCmd())
+	cmd.AddCommand(newRecurringRunCmd())
+
+	return cmd
+}
+
+func newRecurringAddCmd() *cobra.Command {
+	var (
+		cronExpr string
+		timezone string
+		teamKey  string
+		title    string
+		tmplName string
+		vars     []string
+		labels   []string
+		assignee string
+		priority string
+		project  string
+		dueIn    string
+		replace  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add a recurring issue rule",
+		Long: `Add a rule that creates an issue each time its cron schedule fires.
+
+The schedule has five fields (minute hour day month weekday) and also
+accepts @daily, @weekly and @monthly. It runs in --timezone, or the
+configured time zone, or the local one.
+
+The title is a template: {{.Date}} (YYYY-MM-DD), {{.Weekday}} and {{.Week}}
+(2025-W07) describe the occurrence. It defaults to the rule name followed
+by the date. The description comes from an issue template (see 'linear
+template'), which also sees these fields and any --var.
+
+Examples:
+  linear recurring add standup --cron "0 9 * * MON" --template standup --team ENG
+  linear recurring add release --cron "0 10 1 * *" --team ENG \
+    --title "Release checklist {{.Date}}" --template release --label release --due-in "+5d"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := strings.TrimSpace(args[0])
+			ruleErr := func(code, msg string) error {
+				if IsHumanOutput() {
+					output.ErrorHuman(code, msg)
+					return nil
+				}
+				return output.Error(code, msg)
+			}
+
+			if cronExpr == "" {
+				return ruleErr("MISSING_CRON", "Schedule is required. Use --cron flag.")
+			}
+			if _, err := recurring.ParseCron(cronExpr); err != nil {
+				return ruleErr("INVALID_CRON", err.Error())
+			}
+			if timezone != "" {
+				if _, err := time.LoadLocation(timezone); err != nil {
+					return ruleErr("INVALID_TIMEZONE", fmt.Sprintf("invalid time zone '%s'", timezone))
+				}
+			}
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+			if teamKey == "" {
+				return ruleErr("MISSING_TEAM", "Team is required. Use --team flag or set a default team.")
+			}
+
+			if title == "" {
+				title = name + " {{.Date}}"
+			}
+			if _, err := renderRecurringTitle(title, time.Now()); err != nil {
+				return ruleErr("INVALID_TITLE", err.Error())
+			}
+			if tmplName != "" {
+				tmpl, err := loadTemplate(templates.KindIssue, tmplName)
+				if err != nil {
+					return ruleErr("TEMPLATE_ERROR", err.Error())
+				}
+				if tmpl == nil {
+					return ruleErr("NOT_FOUND", fmt.Sprintf("issue template '%s' not found", tmplName))
+				}
+			}
+			if priority != "" {
+				if _, err := parsePriority(priority); err != nil {
+					return ruleErr("INVALID_PRIORITY", err.Error())
+				}
+			}
+			parsedVars, err := templates.ParseVars(vars)
+			if err != nil {
+				return ruleErr("INVALID_VAR", err.Error())
+			}
+
+			store, err := recurring.NewStore()
+			if err != nil {
+				return ruleErr("CONFIG_ERROR", err.Error())
+			}
+			existing, err := store.Get(name)
+			if err != nil {
+				return ruleErr("CONFIG_ERROR", err.Error())
+			}
+			if existing != nil && !replace {
+				return ruleErr("ALREADY_EXISTS", fmt.Sprintf("Recurring rule '%s' already exists (use --replace to overwrite it)", name))
+			}
+
+			rule := &recurring.Rule{
+				Name:      name,
+				Cron:      cronExpr,
+				Timezone:  timezone,
+				Team:      strings.ToUpper(teamKey),
+				Title:     title,
+				Template:  tmplName,
+				Labels:    labels,
+				Assignee:  assignee,
+				Priority:  priority,
+				Project:   project,
+				DueIn:     dueIn,
+				CreatedAt: time.Now().UTC(),
+			}
+			if len(parsedVars) > 0 {
+				rule.Vars = parsedVars
+			}
+			// Replacing a rule keeps its history, so past occurrences are
+			// not created again
+			if existing != nil {
+				rule.CreatedAt = existing.CreatedAt
+				rule.LastRun = existing.LastRun
+				rule.LastIssue = existing.LastIssue
+			}
+			if err := store.Save(rule); err != nil {
+				return ruleErr("CONFIG_ERROR", err.Error())
+			}
+
+			listed := recurringRuleWithNext(rule, time.Now())
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Added recurring rule %s", name))
+				if listed.NextRun != nil {
+					output.HumanLn("  Next run: %s", listed.NextRun.Format("2006-01-02 15:04 MST"))
+				}
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success": true,
+				"rule":    listed,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&cronExpr, "cron", "", "Cron schedule, e.g. \"0 9 * * MON\" (required)")
+	cmd.Flags().StringVar(&timezone, "timezone", "", "IANA time zone for the schedule (default: configured or local)")
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (default: configured team)")
+	cmd.Flags().StringVar(&title, "title", "", "Issue title template (default: \"<name> {{.Date}}\")")
+	cmd.Flags().StringVar(&tmplName, "template", "", "Issue template for the description")
+	cmd.Flags().StringArrayVar(&vars, "var", nil, "Template variable as key=value (repeatable)")
+	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Label name (repeatable)")
+	cmd.Flags().StringVar(&assignee, "assignee", "", "Assignee (name, email, ID or me)")
+	cmd.Flags().StringVar(&priority, "priority", "", "Priority: urgent, high, medium, low, none or 0-4")
+	cmd.Flags().StringVar(&project, "project", "", "Project ID")
+	cmd.Flags().StringVar(&dueIn, "due-in", "", "Due date relative to each occurrence, e.g. +2d or \"in 3 business days\"")
+	cmd.Flags().BoolVar(&replace, "replace", false, "Overwrite an existing rule with the same name")
+
+	return cmd
+}
+
+func newRecurringListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List recurring issue rules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := recurring.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			rules, err := store.List()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			now := time.Now()
+			listed := make([]RecurringRule, len(rules))
+			for i, rule := range rules {
+				listed[i] = recurringRuleWithNext(rule, now)
+			}
+
+			if IsHumanOutput() {
+				printRecurringRulesHuman(listed)
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"rules": listed,
+				"count": len(listed),
+				"file":  store.Path(),
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newRecurringRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <name>",
+		Aliases: []string{"rm"},
+		Short:   "Remove a recurring issue rule",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			store, err := recurring.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			removed, err := store.Remove(name)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			if !removed {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Recurring rule '%s' not found", name))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Recurring rule '%s' not found", name))
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Removed recurring rule %s", name))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "remove",
+				"name":      name,
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newRecurringRunCmd() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "run [name...]",
+		Short: "Create the issues that are due",
+		Long: `Create an issue for every rule whose schedule fired since it last ran.
+Only the latest missed occurrence is created, so a run after downtime does
+not flood the team.
+
+Before creating an issue, the team is checked for an issue with the same
+title created since the occurrence; if one exists it is recorded and no
+duplicate is made. This keeps runs idempotent even when the local state
+is lost, such as on a fresh CI runner.
+
+Examples:
+  linear recurring run
+  linear recurring run standup --dry-run
+
+  # crontab: check every 15 minutes
+  */15 * * * * linear recurring run >> ~/.local/state/linear-recurring.log`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := recurring.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			rules, err := store.List()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			if len(args) > 0 {
+				selected := []*recurring.Rule{}
+				for _, name := range args {
+					found := false
+					for _, rule := range rules {
+						if rule.Name == name {
+							selected = append(selected, rule)
+							found = true
+						}
+					}
+					if !found {
+						if IsHumanOutput() {
+							output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Recurring rule '%s' not found", name))
+							return nil
+						}
+						return output.Error("NOT_FOUND", fmt.Sprintf("Recurring rule '%s' not found", name))
+					}
+				}
+				rules = selected
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			runner := &recurringRunner{client: client, dryRun: dryRun, now: time.Now(), teams: map[string]*api.Team{}}
+			response := &RecurringRunResponse{Success: true, DryRun: dryRun, Results: []RecurringRunResult{}}
+			for _, rule := range rules {
+				result := runner.run(ctx, rule)
+				switch result.Status {
+				case recurringCreated, recurringPlanned:
+					response.Created++
+				case recurringFailed:
+					response.Failed++
+					response.Success = false
+				}
+				response.Results = append(response.Results, result)
+
+				// The rule ran for this occurrence whether the issue was
+				// created now or found from an earlier run
+				if !dryRun && (result.Status == recurringCreated || result.Status == recurringExists) {
+					rule.LastRun = result.Occurrence
+					rule.LastIssue = result.Issue
+					if err := store.Save(rule); err != nil {
+						if IsHumanOutput() {
+							output.ErrorHuman("CONFIG_ERROR", err.Error())
+							return nil
+						}
+						return output.Error("CONFIG_ERROR", err.Error())
+					}
+				}
+			}
+
+			if !response.Success {
+				output.Fail("CREATE_FAILED")
+			}
+
+			if IsHumanOutput() {
+				printRecurringRunHuman(response)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show which issues would be created without creating them")
+
+	return cmd
+}
+
+// recurringRunner creates the issues for due rules
+type recurringRunner struct {
+	client *api.Client
+	dryRun bool
+	now    time.Time
+	teams  map[string]*api.Team
+}
+
+func (r *recurringRunner) run(ctx context.Context, rule *recurring.Rule) RecurringRunResult {
+	result := RecurringRunResult{Name: rule.Name}
+	fail := func(err error) RecurringRunResult {
+		result.Status = recurringFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	loc, err := rule.Location(defaultRecurringLocation())
+	if err != nil {
+		return fail(fmt.Errorf("invalid time zone '%s'", rule.Timezone))
+	}
+	if next, err := rule.Next(r.now, loc); err == nil && !next.IsZero() {
+		result.NextRun = &next
+	}
+	occurrence, err := rule.Due(r.now, loc)
+	if err != nil {
+		return fail(err)
+	}
+	if occurrence.IsZero() {
+		result.Status = recurringNotDue
+		return result
+	}
+	result.Occurrence = &occurrence
+
+	title, err := renderRecurringTitle(rule.Title, occurrence)
+	if err != nil {
+		return fail(err)
+	}
+	result.Title = title
+
+	team, err := r.team(ctx, rule.Team)
+	if err != nil {
+		return fail(err)
+	}
+
+	// An issue with this title created since the occurrence means an
+	// earlier run (perhaps elsewhere) already handled it
+	existing, err := r.client.GetIssues(ctx, api.IssueFilter{
+		TeamID:       team.ID,
+		CreatedAfter: occurrence.UTC().Format(time.RFC3339),
+	}, 100, "", "")
+	if err != nil {
+		return fail(err)
+	}
+	for _, issue := range existing.Issues {
+		if issue.Title == title {
+			result.Status = recurringExists
+			result.Issue = issue.Identifier
+			return result
+		}
+	}
+
+	input := api.IssueCreateInput{Title: title, TeamID: team.ID, ProjectID: rule.Project}
+	if rule.Template != "" {
+		if input.Description, err = renderIssueTemplate(rule.Template, title, team.Key, recurringVars(rule, occurrence)); err != nil {
+			return fail(err)
+		}
+	}
+	if rule.Priority != "" {
+		priority, err := parsePriority(rule.Priority)
+		if err != nil {
+			return fail(err)
+		}
+		input.Priority = &priority
+	}
+	if rule.DueIn != "" {
+		cal, err := loadCalendar()
+		if err != nil {
+			return fail(err)
+		}
+		due, err := cal.Parse(rule.DueIn, occurrence)
+		if err != nil {
+			return fail(fmt.Errorf("due date: %w", err))
+		}
+		input.DueDate = due.Format(dates.DateLayout)
+	}
+	if input.AssigneeID, err = resolveUserID(ctx, r.client, rule.Assignee); err != nil {
+		return fail(err)
+	}
+	if input.LabelIDs, err = resolveLabelIDs(ctx, r.client, team.ID, rule.Labels, false); err != nil {
+		return fail(err)
+	}
+
+	if r.dryRun {
+		result.Status = recurringPlanned
+		return result
+	}
+
+	created, err := r.client.CreateIssue(ctx, input)
+	if err != nil {
+		return fail(err)
+	}
+	recordIssue(created.ID, created.Identifier, title, created.URL, history.ActionCreated)
+	result.Status = recurringCreated
+	result.Issue = created.Identifier
+	result.URL = created.URL
+	return result
+}
+
+func (r *recurringRunner) team(ctx context.Context, key string) (*api.Team, error) {
+	if team, ok := r.teams[key]; ok {
+		return team, nil
+	}
+	team, err := r.client.GetTeamByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, fmt.Errorf("team '%s' not found", key)
+	}
+	r.teams[key] = team
+	return team, nil
+}
+
+// defaultRecurringLocation is the configured time zone, or the local one
+func defaultRecurringLocation() *time.Location {
+	if tz := loadConfig().Timezone; tz != "" {
+		if loc, err := time.LoadLocation(tz); err == nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+// occurrenceData describes an occurrence to title and description templates
+func occurrenceData(t time.Time) map[string]string {
+	year, week := t.ISOWeek()
+	return map[string]string{
+		"Date":    t.Format(dates.DateLayout),
+		"Weekday": t.Weekday().String(),
+		"Week":    fmt.Sprintf("%d-W%02d", year, week),
+	}
+}
+
+func renderRecurringTitle(title string, occurrence time.Time) (string, error) {
+	data := map[string]interface{}{}
+	for k, v := range occurrenceData(occurrence) {
+		data[k] = v
+	}
+	rendered, err := templates.Render("title", title, data)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(rendered), nil
+}
+
+// recurringVars returns the template variables for an occurrence as
+// key=value pairs: the rule's variables and the occurrence fields
+func recurringVars(rule *recurring.Rule, occurrence time.Time) []string {
+	pairs := []string{}
+	for k, v := range rule.Vars {
+		pairs = append(pairs, k+"="+v)
+	}
+	for k, v := range occurrenceData(occurrence) {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return pairs
+}
+
+// recurringRuleWithNext pairs a rule with its next occurrence
+func recurringRuleWithNext(rule *recurring.Rule, now time.Time) RecurringRule {
+	listed := RecurringRule{Rule: rule}
+	loc, err := rule.Location(defaultRecurringLocation())
+	if err != nil {
+		return listed
+	}
+	if next, err := rule.Next(now, loc); err == nil && !next.IsZero() {
+		listed.NextRun = &next
+	}
+	return listed
+}
+
+func printRecurringRulesHuman(rules []RecurringRule) {
+	if len(rules) == 0 {
+		output.HumanLn("No recurring rules. Add one with 'linear recurring add'.")
+		return
+	}
+
+	headers := []string{"NAME", "SCHEDULE", "TEAM", "TITLE", "LAST ISSUE", "NEXT RUN"}
+	rows := make([][]string, len(rules))
+	for i, rule := range rules {
+		next := output.Muted("-")
+		if rule.NextRun != nil {
+			next = rule.NextRun.Format("2006-01-02 15:04 MST")
+		}
+		last := rule.LastIssue
+		if last == "" {
+			last = output.Muted("-")
+		}
+		rows[i] = []string{rule.Name, rule.Cron, rule.Team, display.Truncate(rule.Title, 40), last, next}
+	}
+	output.TableWithColors(headers, rows)
+}
+
+func printRecurringRunHuman(resp *RecurringRunResponse) {
+	for _, r := range resp.Results {
+		switch r.Status {
+		case recurringCreated:
+			output.HumanLn("%s %s: created %s %s", output.Green("✓"), r.Name, r.Issue, r.Title)
+		case recurringPlanned:
+			output.HumanLn("%s %s: would create %s", output.Cyan("+"), r.Name, r.Title)
+		case recurringExists:
+			output.HumanLn("%s %s: %s already exists", output.Muted("="), r.Name, r.Issue)
+		case recurringNotDue:
+			next := ""
+			if r.NextRun != nil {
+				next = ", next " + r.NextRun.Format("2006-01-02 15:04 MST")
+			}
+			output.HumanLn("%s %s: not due%s", output.Muted("-"), r.Name, next)
+		case recurringFailed:
+			output.HumanLn("%s %s: %s", output.Red("✗"), r.Name, r.Error)
+		}
+	}
+
+	if resp.DryRun {
+		output.HumanLn("\nDry run: %d issues would be created, %d failed", resp.Created, resp.Failed)
+		return
+	}
+	output.HumanLn("\n%d created, %d failed", resp.Created, resp.Failed)
+}
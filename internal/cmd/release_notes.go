@@ -0,0 +1,317 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/dates"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/templates"
+	"github.com/spf13/cobra"
+)
+
+// otherGroup collects completed issues whose labels match no group
+const otherGroup = "Other"
+
+// defaultReleaseGroups are the changelog sections and the labels that put
+// an issue in them, in order; an issue goes in the first group it matches
+var defaultReleaseGroups = []string{
+	"Features=feature,enhancement,improvement",
+	"Fixes=bug,fix,regression",
+	"Chores=chore,refactor,tech debt,dependencies",
+}
+
+// defaultReleaseNotesTemplate renders the changelog as markdown
+const defaultReleaseNotesTemplate = `## {{.Title}}
+{{range .Groups}}
+### {{.Name}}
+{{range .Issues}}
+- {{.Title}} ([{{.Identifier}}]({{.URL}}))
+{{- end}}
+{{end}}`
+
+// ReleaseNoteIssue is a completed issue in the release notes
+type ReleaseNoteIssue struct {
+	ID          string   `json:"id"`
+	Identifier  string   `json:"identifier"`
+	Title       string   `json:"title"`
+	URL         string   `json:"url"`
+	Labels      []string `json:"labels"`
+	Assignee    string   `json:"assignee,omitempty"`
+	Project     string   `json:"project,omitempty"`
+	CompletedAt string   `json:"completedAt"`
+}
+
+// ReleaseNoteGroup is one section of the release notes
+type ReleaseNoteGroup struct {
+	Name   string             `json:"name"`
+	Issues []ReleaseNoteIssue `json:"issues"`
+}
+
+// ReleaseNotesResponse is the response for 'linear release-notes'
+type ReleaseNotesResponse struct {
+	Title    string             `json:"title"`
+	Since    string             `json:"since,omitempty"`
+	Until    string             `json:"until,omitempty"`
+	Groups   []ReleaseNoteGroup `json:"groups"`
+	Count    int                `json:"count"`
+	Markdown string             `json:"markdown"`
+}
+
+// releaseGroup is a parsed --group flag
+type releaseGroup struct {
+	name   string
+	labels []string
+}
+
+// NewReleaseNotesCmd creates the release-notes command
+func NewReleaseNotesCmd() *cobra.Command {
+	var (
+		teamKey      string
+		projectID    string
+		cycle        string
+		since        string
+		until        string
+		title        string
+		groups       []string
+		templateFile string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "release-notes",
+		Aliases: []string{"changelog"},
+		Short:   "Generate a changelog from completed issues",
+		Long: `Generate release notes from the issues completed in a project, a cycle,
+or since a date (such as the date of the last release tag).
+
+Issues are grouped by label: by default Features (feature, enhancement,
+improvement), Fixes (bug, fix, regression) and Chores (chore, refactor,
+tech debt, dependencies), with everything else under Other. Replace the
+groups with --group "Name=label,label"; an issue goes in the first group
+whose labels it has.
+
+The notes render as markdown with issue links. --template-file renders
+them with your own Go template instead; it sees .Title, .Since, .Until,
+.Count, .Groups (each with .Name and .Issues) and, on each issue,
+.Identifier, .Title, .URL, .Labels, .Assignee, .Project and .CompletedAt.
+
+Examples:
+  linear release-notes --project "Mobile app" --since 2025-03-01 --human
+  linear release-notes --team ENG --since "$(git log -1 --format=%as v1.4.0)" --title v1.5.0 --human
+  linear release-notes --team ENG --cycle previous --group "New=feature" --group "Fixed=bug"
+  linear release-notes --team ENG --since -14d --template-file changelog.tmpl --human`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if projectID == "" && cycle == "" && since == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_RANGE", "Choose the issues with --project, --cycle or --since.")
+					return nil
+				}
+				return output.Error("MISSING_RANGE", "Choose the issues with --project, --cycle or --since.")
+			}
+
+			parsedGroups, err := parseReleaseGroups(groups)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_GROUP", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_GROUP", err.Error())
+			}
+
+			tmplBody := defaultReleaseNotesTemplate
+			if templateFile != "" {
+				data, err := os.ReadFile(templateFile)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("FILE_ERROR", err.Error())
+						return nil
+					}
+					return output.Error("FILE_ERROR", err.Error())
+				}
+				tmplBody = string(data)
+			}
+
+			filter := api.IssueFilter{
+				ProjectID:  projectID,
+				Cycle:      cycle,
+				StateTypes: []string{"completed"},
+			}
+			if filter.CompletedAfter, err = resolveFilterDate(since); err == nil {
+				filter.CompletedBefore, err = resolveFilterDate(until)
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_DATE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_DATE", err.Error())
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			// A project spans teams, so the default team only narrows
+			// cycle and date ranges
+			if teamKey == "" && projectID == "" {
+				teamKey = GetTeamID()
+			}
+			if teamKey != "" {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+						return nil
+					}
+					return output.Error("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+				}
+				filter.TeamID = team.ID
+			}
+
+			issues, _, err := collectPages("", true, func(after string) ([]api.ExportIssue, *api.PageInfo, error) {
+				return client.GetIssuesForExport(ctx, filter, exportPageSize, after)
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			if title == "" {
+				title = "Release notes " + time.Now().Format(dates.DateLayout)
+			}
+			resp := buildReleaseNotes(issues, parsedGroups, title)
+			resp.Since = filter.CompletedAfter
+			resp.Until = filter.CompletedBefore
+
+			resp.Markdown, err = renderReleaseNotes(tmplBody, resp)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("TEMPLATE_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.HumanLn("%s", resp.Markdown)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (default: configured team, unless --project is set)")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Project ID or name")
+	cmd.Flags().StringVar(&cycle, "cycle", "", "Cycle (current, next, previous or cycle ID)")
+	cmd.Flags().StringVar(&since, "since", "", "Include issues completed after this date, e.g. the last release tag's date")
+	cmd.Flags().StringVar(&until, "until", "", "Include issues completed before this date")
+	cmd.Flags().StringVar(&title, "title", "", "Heading, such as the version (default: \"Release notes <date>\")")
+	cmd.Flags().StringArrayVar(&groups, "group", nil, "Section as Name=label,label (repeatable, in order; replaces the defaults)")
+	cmd.Flags().StringVar(&templateFile, "template-file", "", "Render with a Go template file instead of the default markdown")
+
+	return cmd
+}
+
+// parseReleaseGroups parses Name=label,label flags, falling back to the
+// default groups when none are given
+func parseReleaseGroups(flags []string) ([]releaseGroup, error) {
+	if len(flags) == 0 {
+		flags = defaultReleaseGroups
+	}
+	groups := make([]releaseGroup, 0, len(flags))
+	for _, flag := range flags {
+		name, labels, ok := strings.Cut(flag, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || strings.TrimSpace(labels) == "" {
+			return nil, fmt.Errorf("invalid group %q (use Name=label,label)", flag)
+		}
+		groups = append(groups, releaseGroup{name: name, labels: splitImportList(labels)})
+	}
+	return groups, nil
+}
+
+// buildReleaseNotes sorts completed issues into groups by label. Groups
+// keep their order, empty ones are left out, and issues within a group
+// are in completion order.
+func buildReleaseNotes(issues []api.ExportIssue, groups []releaseGroup, title string) *ReleaseNotesResponse {
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].CompletedAt < issues[j].CompletedAt })
+
+	byGroup := make([][]ReleaseNoteIssue, len(groups)+1)
+	for _, issue := range issues {
+		note := ReleaseNoteIssue{
+			ID:          issue.ID,
+			Identifier:  issue.Identifier,
+			Title:       issue.Title,
+			URL:         issue.URL,
+			Labels:      issue.Labels,
+			Assignee:    issue.Assignee,
+			Project:     issue.Project,
+			CompletedAt: issue.CompletedAt,
+		}
+		if note.Labels == nil {
+			note.Labels = []string{}
+		}
+
+		slot := len(groups)
+	match:
+		for i, g := range groups {
+			for _, label := range issue.Labels {
+				if containsFold(g.labels, label) {
+					slot = i
+					break match
+				}
+			}
+		}
+		byGroup[slot] = append(byGroup[slot], note)
+	}
+
+	resp := &ReleaseNotesResponse{Title: title, Groups: []ReleaseNoteGroup{}, Count: len(issues)}
+	for i, notes := range byGroup {
+		if len(notes) == 0 {
+			continue
+		}
+		name := otherGroup
+		if i < len(groups) {
+			name = groups[i].name
+		}
+		resp.Groups = append(resp.Groups, ReleaseNoteGroup{Name: name, Issues: notes})
+	}
+	return resp
+}
+
+// renderReleaseNotes executes the release notes template
+func renderReleaseNotes(body string, resp *ReleaseNotesResponse) (string, error) {
+	rendered, err := templates.Render("release-notes", body, map[string]interface{}{
+		"Title":  resp.Title,
+		"Since":  resp.Since,
+		"Until":  resp.Until,
+		"Count":  resp.Count,
+		"Groups": resp.Groups,
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(rendered), nil
+}
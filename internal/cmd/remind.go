@@ -0,0 +1,368 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/dates"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/notify"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// Reminder reasons
+const (
+	reminderOverdue = "overdue"
+	reminderDue     = "due"
+	reminderSLA     = "sla"
+)
+
+// Reminder is an assigned issue that is overdue, due soon or about to
+// breach its SLA
+type Reminder struct {
+	ID            string `json:"id"`
+	Identifier    string `json:"identifier"`
+	Title         string `json:"title"`
+	State         string `json:"state"`
+	DueDate       string `json:"dueDate,omitempty"`
+	SLABreachesAt string `json:"slaBreachesAt,omitempty"`
+	// Reason is "overdue", "due" or "sla"
+	Reason string `json:"reason"`
+	// DaysLeft is the number of days until the due date, negative when
+	// overdue
+	DaysLeft  *int   `json:"daysLeft,omitempty"`
+	Commented bool   `json:"commented,omitempty"`
+	Error     string `json:"error,omitempty"`
+
+	deadline time.Time
+}
+
+// RemindResponse is the response for 'linear remind'
+type RemindResponse struct {
+	Within      string     `json:"within"`
+	Through     string     `json:"through"`
+	Reminders   []Reminder `json:"reminders"`
+	Count       int        `json:"count"`
+	Overdue     int        `json:"overdue"`
+	Notified    bool       `json:"notified,omitempty"`
+	NotifyError string     `json:"notifyError,omitempty"`
+}
+
+// NewRemindCmd creates the remind command
+func NewRemindCmd() *cobra.Command {
+	var (
+		within  string
+		teamKey string
+		comment bool
+		message string
+		notifyF bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "remind",
+		Short: "Remind me of issues that are due soon or overdue",
+		Long: `List open issues assigned to you that are overdue, due within --within,
+or about to breach their SLA, soonest first.
+
+--comment posts a reminder comment on each issue and --notify shows a
+desktop notification (osascript on macOS, notify-send on Linux, PowerShell
+on Windows). The JSON output suits cron jobs and other alerting; the
+command exits 1 when any reminder comment could not be posted.
+
+--within takes a number of hours, days or weeks, such as 12h, 3d or 2w.
+
+Examples:
+  linear remind --human
+  linear remind --within 1w --team ENG
+  linear remind --within 1d --notify
+  linear remind --comment --message "Friendly reminder: this is due soon"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			window, err := parseWithin(within)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_WITHIN", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_WITHIN", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			viewerID, err := client.GetViewerID(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			filter := api.IssueFilter{
+				AssigneeID: viewerID,
+				StateTypes: openStateTypes,
+			}
+			if teamKey != "" {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+						return nil
+					}
+					return output.Error("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+				}
+				filter.TeamID = team.ID
+			}
+
+			issues, _, err := collectPages("", true, func(after string) ([]api.IssueListItem, *api.PageInfo, error) {
+				page, err := client.GetIssues(ctx, filter, reportPageSize, "", after)
+				if err != nil {
+					return nil, nil, err
+				}
+				return page.Issues, page.PageInfo, nil
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			resp := buildReminders(issues, within, window, time.Now())
+
+			if comment {
+				commentReminders(ctx, client, resp.Reminders, message)
+			}
+			if notifyF && resp.Count > 0 {
+				if err := notify.Send(reminderNotification(resp)); err != nil {
+					resp.NotifyError = err.Error()
+				} else {
+					resp.Notified = true
+				}
+			}
+
+			if IsHumanOutput() {
+				printRemindersHuman(resp, comment)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&within, "within", "w", "3d", "Remind of issues due within this window (e.g. 12h, 3d, 2w)")
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Only issues in this team")
+	cmd.Flags().BoolVar(&comment, "comment", false, "Post a reminder comment on each issue")
+	cmd.Flags().StringVar(&message, "message", "", "Comment posted by --comment (default names the due date)")
+	cmd.Flags().BoolVar(&notifyF, "notify", false, "Show a desktop notification")
+
+	return cmd
+}
+
+var withinPattern = regexp.MustCompile(`^(\d+)\s*(h|hours?|d|days?|w|weeks?)?$`)
+
+// parseWithin parses a reminder window such as "12h", "3d" or "2w"; a bare
+// number is days
+func parseWithin(s string) (time.Duration, error) {
+	m := withinPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if m == nil {
+		return 0, fmt.Errorf("invalid --within '%s': use hours, days or weeks such as 12h, 3d or 2w", s)
+	}
+	n, _ := strconv.Atoi(m[1])
+	switch {
+	case strings.HasPrefix(m[2], "h"):
+		return time.Duration(n) * time.Hour, nil
+	case strings.HasPrefix(m[2], "w"):
+		return time.Duration(n) * 7 * 24 * time.Hour, nil
+	}
+	return time.Duration(n) * 24 * time.Hour, nil
+}
+
+// buildReminders picks the issues that are overdue, due by now+window, or
+// breach their SLA by then, soonest deadline first
+func buildReminders(issues []api.IssueListItem, within string, window time.Duration, now time.Time) *RemindResponse {
+	until := now.Add(window)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	through := time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, time.Local)
+
+	resp := &RemindResponse{
+		Within:    within,
+		Through:   through.Format(dates.DateLayout),
+		Reminders: []Reminder{},
+	}
+
+	for _, issue := range issues {
+		r := Reminder{
+			ID:            issue.ID,
+			Identifier:    issue.Identifier,
+			Title:         issue.Title,
+			State:         issue.State.Name,
+			DueDate:       issue.DueDate,
+			SLABreachesAt: issue.SLABreachesAt,
+		}
+
+		if due, err := time.ParseInLocation(dates.DateLayout, issue.DueDate, time.Local); err == nil && !due.After(through) {
+			days := int(math.Round(due.Sub(today).Hours() / 24))
+			r.DaysLeft = &days
+			r.Reason = reminderDue
+			if days < 0 {
+				r.Reason = reminderOverdue
+			}
+			r.deadline = due
+		}
+		if sla, err := display.ParseISO(issue.SLABreachesAt); err == nil && !sla.After(until) {
+			if r.Reason == "" || (r.Reason != reminderOverdue && sla.Before(r.deadline)) {
+				r.Reason = reminderSLA
+				r.deadline = sla
+			}
+		}
+		if r.Reason == "" {
+			continue
+		}
+
+		if r.Reason == reminderOverdue {
+			resp.Overdue++
+		}
+		resp.Reminders = append(resp.Reminders, r)
+	}
+
+	sort.SliceStable(resp.Reminders, func(i, j int) bool {
+		return resp.Reminders[i].deadline.Before(resp.Reminders[j].deadline)
+	})
+	resp.Count = len(resp.Reminders)
+	return resp
+}
+
+// reminderWhen describes when a reminder is due, such as "in 2 days"
+func reminderWhen(r Reminder) string {
+	if r.Reason == reminderSLA {
+		left := time.Until(r.deadline)
+		switch {
+		case left <= 0:
+			return "SLA breached"
+		case left < 24*time.Hour:
+			return fmt.Sprintf("SLA breach in %dh", int(left.Hours())+1)
+		}
+		return fmt.Sprintf("SLA breach in %d days", int(left.Hours()/24))
+	}
+	switch days := *r.DaysLeft; {
+	case days < -1:
+		return fmt.Sprintf("overdue by %d days", -days)
+	case days == -1:
+		return "overdue by 1 day"
+	case days == 0:
+		return "due today"
+	case days == 1:
+		return "due tomorrow"
+	default:
+		return fmt.Sprintf("due in %d days", days)
+	}
+}
+
+// commentReminders posts message, or a note naming the deadline, on each
+// issue, recording failures on the reminder and in the exit status
+func commentReminders(ctx context.Context, client *api.Client, reminders []Reminder, message string) {
+	for i := range reminders {
+		r := &reminders[i]
+		body := message
+		if body == "" {
+			switch r.Reason {
+			case reminderOverdue:
+				body = fmt.Sprintf("Reminder: this issue was due %s and is overdue.", r.DueDate)
+			case reminderDue:
+				body = fmt.Sprintf("Reminder: this issue is due %s.", r.DueDate)
+			default:
+				body = fmt.Sprintf("Reminder: this issue breaches its SLA at %s.", r.deadline.Local().Format("2006-01-02 15:04"))
+			}
+		}
+		if _, err := client.CreateComment(ctx, r.ID, body); err != nil {
+			r.Error = err.Error()
+			output.Fail("API_ERROR")
+			continue
+		}
+		r.Commented = true
+	}
+}
+
+// reminderNotification returns the title and message of the desktop
+// notification for resp, naming the first few issues
+func reminderNotification(resp *RemindResponse) (string, string) {
+	title := fmt.Sprintf("Linear: %d issues need attention", resp.Count)
+	if resp.Count == 1 {
+		title = "Linear: 1 issue needs attention"
+	}
+	if resp.Overdue > 0 {
+		title += fmt.Sprintf(" (%d overdue)", resp.Overdue)
+	}
+
+	const shown = 3
+	lines := []string{}
+	for i, r := range resp.Reminders {
+		if i == shown {
+			lines = append(lines, fmt.Sprintf("and %d more", resp.Count-shown))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%s %s: %s", r.Identifier, reminderWhen(r), display.Truncate(r.Title, 40)))
+	}
+	return title, strings.Join(lines, "\n")
+}
+
+func printRemindersHuman(resp *RemindResponse, commented bool) {
+	if resp.Count == 0 {
+		output.HumanLn("Nothing due through %s", resp.Through)
+		return
+	}
+
+	headers := []string{"ID", "TITLE", "STATE", "WHEN"}
+	if commented {
+		headers = append(headers, "COMMENTED")
+	}
+	rows := make([][]string, len(resp.Reminders))
+
+	for i, r := range resp.Reminders {
+		when := reminderWhen(r)
+		if r.Reason == reminderOverdue || strings.HasPrefix(when, "SLA breached") {
+			when = output.Red("%s", when)
+		} else if r.DaysLeft != nil && *r.DaysLeft == 0 {
+			when = output.Yellow("%s", when)
+		}
+		rows[i] = []string{r.Identifier, display.Truncate(r.Title, 50), r.State, when}
+		if commented {
+			status := output.Green("yes")
+			if r.Error != "" {
+				status = output.Red("failed: %s", r.Error)
+			}
+			rows[i] = append(rows[i], status)
+		}
+	}
+
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d issues, %d overdue", resp.Count, resp.Overdue)
+	if resp.NotifyError != "" {
+		output.HumanLn("%s", output.Yellow("Notification failed: %s", resp.NotifyError))
+	}
+}
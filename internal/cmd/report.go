@@ -0,0 +1,451 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// reportPageSize is how many issues are fetched per request for reports
+const reportPageSize = 100
+
+// PointTotals sums issue counts and estimates. Canceled issues are left out.
+type PointTotals struct {
+	Issues          int     `json:"issues"`
+	Unestimated     int     `json:"unestimated"`
+	ScopePoints     float64 `json:"scopePoints"`
+	CompletedIssues int     `json:"completedIssues"`
+	CompletedPoints float64 `json:"completedPoints"`
+}
+
+func (t *PointTotals) add(issue api.IssueEstimate) {
+	if issue.StateType == "canceled" {
+		return
+	}
+	t.Issues++
+	points := 0.0
+	if issue.Estimate != nil {
+		points = *issue.Estimate
+	} else {
+		t.Unestimated++
+	}
+	t.ScopePoints += points
+	if issue.StateType == "completed" {
+		t.CompletedIssues++
+		t.CompletedPoints += points
+	}
+}
+
+func (t *PointTotals) merge(other PointTotals) {
+	t.Issues += other.Issues
+	t.Unestimated += other.Unestimated
+	t.ScopePoints += other.ScopePoints
+	t.CompletedIssues += other.CompletedIssues
+	t.CompletedPoints += other.CompletedPoints
+}
+
+// completion is the completed share of scope points
+func (t PointTotals) completion() float64 {
+	if t.ScopePoints == 0 {
+		return 0
+	}
+	return t.CompletedPoints / t.ScopePoints
+}
+
+// VelocityCycle is one cycle's row in a velocity report
+type VelocityCycle struct {
+	ID       string `json:"id"`
+	Number   int    `json:"number"`
+	Name     string `json:"name,omitempty"`
+	StartsAt string `json:"startsAt"`
+	EndsAt   string `json:"endsAt"`
+	PointTotals
+}
+
+// VelocityResponse is the response for 'linear report velocity'
+type VelocityResponse struct {
+	Cycles                 []VelocityCycle `json:"cycles"`
+	Team                   string          `json:"team"`
+	Count                  int             `json:"count"`
+	AverageCompletedPoints float64         `json:"averageCompletedPoints"`
+	AverageScopePoints     float64         `json:"averageScopePoints"`
+}
+
+// ScopeMilestone is one milestone's row in a scope report
+type ScopeMilestone struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	TargetDate string `json:"targetDate,omitempty"`
+	PointTotals
+}
+
+// ScopeResponse is the response for 'linear report scope'
+type ScopeResponse struct {
+	Milestones []ScopeMilestone `json:"milestones"`
+	Project    string           `json:"project"`
+	ProjectID  string           `json:"projectId"`
+	Total      PointTotals      `json:"total"`
+}
+
+// NewReportCmd creates the report command group
+func NewReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Estimate, point and staleness reports",
+		Long: `Aggregate issue estimates into velocity and scope reports, and find
+stale issues.
+
+Reports are tables with --human, and JSON otherwise; use --output tsv for
+spreadsheets. Canceled issues are not counted.
+
+Examples:
+  linear report velocity --team ENG --last 6
+  linear report scope --project <project-id> --output tsv
+  linear report stale --team ENG --days 14`,
+	}
+
+	cmd.AddCommand(newReportVelocityCmd())
+	cmd.AddCommand(newReportScopeCmd())
+	cmd.AddCommand(newReportStaleCmd())
+
+	return cmd
+}
+
+func newReportVelocityCmd() *cobra.Command {
+	var (
+		teamKey string
+		last    int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "velocity",
+		Short: "Completed points per cycle",
+		Long: `Show scope and completed points for a team's most recent completed
+cycles, oldest first, with the average velocity.
+
+Examples:
+  linear report velocity --team ENG
+  linear report velocity --team ENG --last 12 --output tsv`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+			if teamKey == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_TEAM",
+						"Team is required",
+						"Specify a team using --team flag",
+						"linear report velocity --team ENG",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_TEAM",
+					"Team is required",
+					"Specify a team using --team flag",
+					"linear report velocity --team ENG",
+				)
+			}
+			if last < 1 {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_LAST", "--last must be at least 1")
+					return nil
+				}
+				return output.Error("INVALID_LAST", "--last must be at least 1")
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			team, err := client.GetTeamByKey(ctx, teamKey)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if team == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+					return nil
+				}
+				return output.Error("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+			}
+
+			// Upcoming cycles are created ahead of time, so fetch extra
+			cycles, err := client.GetCycles(ctx, team.ID, last+10)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			completed := []api.Cycle{}
+			for _, c := range cycles.Cycles {
+				if c.CompletedAt != "" {
+					completed = append(completed, c)
+				}
+			}
+			sort.Slice(completed, func(i, j int) bool { return completed[i].Number > completed[j].Number })
+			completed = completed[:min(last, len(completed))]
+
+			rows := make([]VelocityCycle, len(completed))
+			fetches := make([]func(context.Context) error, len(completed))
+			for i, c := range completed {
+				rows[len(completed)-1-i] = VelocityCycle{ID: c.ID, Number: c.Number, Name: c.Name, StartsAt: c.StartsAt, EndsAt: c.EndsAt}
+				fetches[i] = func(ctx context.Context) error {
+					totals, err := issuePointTotals(ctx, client, api.IssueFilter{TeamID: team.ID, Cycle: c.ID})
+					if err != nil {
+						return err
+					}
+					rows[len(completed)-1-i].PointTotals = totals
+					return nil
+				}
+			}
+			if err := api.Parallel(ctx, fetches...); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			resp := &VelocityResponse{Cycles: rows, Team: team.Key, Count: len(rows)}
+			if len(rows) > 0 {
+				for _, r := range rows {
+					resp.AverageCompletedPoints += r.CompletedPoints
+					resp.AverageScopePoints += r.ScopePoints
+				}
+				resp.AverageCompletedPoints /= float64(len(rows))
+				resp.AverageScopePoints /= float64(len(rows))
+			}
+
+			if IsHumanOutput() {
+				printVelocityHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
+	cmd.Flags().IntVar(&last, "last", 6, "Number of completed cycles to include")
+
+	return cmd
+}
+
+func newReportScopeCmd() *cobra.Command {
+	var projectArg string
+
+	cmd := &cobra.Command{
+		Use:   "scope",
+		Short: "Scope and completed points per project milestone",
+		Long: `Show scope and completed points for each milestone of a project, in
+milestone order, with issues outside any milestone listed last.
+
+Examples:
+  linear report scope --project <project-id>
+  linear report scope --project <project-id> --output tsv > scope.tsv`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if projectArg == "" {
+				projectArg = GetProjectID()
+			}
+			if projectArg == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_PROJECT",
+						"Project is required",
+						"Specify a project using --project flag",
+						"linear report scope --project <project-id>",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_PROJECT",
+					"Project is required",
+					"Specify a project using --project flag",
+					"linear report scope --project <project-id>",
+				)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			project, err := client.GetProject(ctx, projectArg)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			var milestones *api.MilestonesResponse
+			var issues []api.IssueEstimate
+			err = api.Parallel(ctx,
+				func(ctx context.Context) (err error) {
+					milestones, err = client.GetProjectMilestones(ctx, project.ID)
+					return err
+				},
+				func(ctx context.Context) (err error) {
+					issues, _, err = collectPages("", true, func(after string) ([]api.IssueEstimate, *api.PageInfo, error) {
+						return client.GetIssueEstimates(ctx, api.IssueFilter{ProjectID: project.ID}, reportPageSize, after)
+					})
+					return err
+				},
+			)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			resp := buildScopeReport(project, milestones.Milestones, issues)
+
+			if IsHumanOutput() {
+				printScopeHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVar(&projectArg, "project", "", "Project ID")
+
+	return cmd
+}
+
+// issuePointTotals sums the estimates of every issue matching filter
+func issuePointTotals(ctx context.Context, client *api.Client, filter api.IssueFilter) (PointTotals, error) {
+	var totals PointTotals
+	issues, _, err := collectPages("", true, func(after string) ([]api.IssueEstimate, *api.PageInfo, error) {
+		return client.GetIssueEstimates(ctx, filter, reportPageSize, after)
+	})
+	if err != nil {
+		return totals, err
+	}
+	for _, issue := range issues {
+		totals.add(issue)
+	}
+	return totals, nil
+}
+
+// buildScopeReport groups a project's issues by milestone
+func buildScopeReport(project *api.ProjectDetail, milestones []api.Milestone, issues []api.IssueEstimate) *ScopeResponse {
+	sorted := append([]api.Milestone{}, milestones...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SortOrder < sorted[j].SortOrder })
+
+	rows := make([]ScopeMilestone, 0, len(sorted)+1)
+	index := map[string]int{}
+	for _, m := range sorted {
+		index[m.ID] = len(rows)
+		rows = append(rows, ScopeMilestone{ID: m.ID, Name: m.Name, TargetDate: m.TargetDate})
+	}
+
+	var none ScopeMilestone
+	none.Name = "No milestone"
+	for _, issue := range issues {
+		if i, ok := index[issue.MilestoneID]; ok {
+			rows[i].add(issue)
+		} else {
+			none.add(issue)
+		}
+	}
+	if none.Issues > 0 {
+		rows = append(rows, none)
+	}
+
+	resp := &ScopeResponse{Milestones: rows, Project: project.Name, ProjectID: project.ID}
+	for _, r := range rows {
+		resp.Total.merge(r.PointTotals)
+	}
+	return resp
+}
+
+// pointRow renders the shared columns of a point report row
+func pointRow(t PointTotals) []string {
+	return []string{
+		fmt.Sprintf("%d", t.Issues),
+		formatPoints(t.ScopePoints),
+		formatPoints(t.CompletedPoints),
+		fmt.Sprintf("%s %3.0f%%", progressBar(t.completion(), dashboardBarWidth/2), t.completion()*100),
+		fmt.Sprintf("%d", t.Unestimated),
+	}
+}
+
+var pointHeaders = []string{"ISSUES", "SCOPE", "DONE", "COMPLETE", "UNESTIMATED"}
+
+func formatPoints(p float64) string {
+	return fmt.Sprintf("%g", p)
+}
+
+func printVelocityHuman(resp *VelocityResponse) {
+	output.HumanLn("%s", output.Bold("Velocity for %s", resp.Team))
+	if resp.Count == 0 {
+		output.HumanLn("%s", output.Muted("No completed cycles"))
+		return
+	}
+
+	headers := append([]string{"CYCLE", "DATES"}, pointHeaders...)
+	rows := make([][]string, 0, len(resp.Cycles))
+	for _, c := range resp.Cycles {
+		label := fmt.Sprintf("%d", c.Number)
+		if c.Name != "" {
+			label = fmt.Sprintf("%d %s", c.Number, display.Truncate(c.Name, 20))
+		}
+		dates := ""
+		starts, err1 := display.ParseISO(c.StartsAt)
+		ends, err2 := display.ParseISO(c.EndsAt)
+		if err1 == nil && err2 == nil {
+			dates = display.FormatDay(starts, "Jan 02") + " – " + display.FormatDay(ends, "Jan 02")
+		}
+		rows = append(rows, append([]string{label, dates}, pointRow(c.PointTotals)...))
+	}
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\nAverage velocity: %s points per cycle (scope %.1f)",
+		output.Bold("%.1f", resp.AverageCompletedPoints), resp.AverageScopePoints)
+}
+
+func printScopeHuman(resp *ScopeResponse) {
+	output.HumanLn("%s", output.Bold("Scope for %s", resp.Project))
+	if len(resp.Milestones) == 0 {
+		output.HumanLn("%s", output.Muted("No issues"))
+		return
+	}
+
+	headers := append([]string{"MILESTONE", "TARGET"}, pointHeaders...)
+	rows := make([][]string, 0, len(resp.Milestones)+1)
+	for _, m := range resp.Milestones {
+		rows = append(rows, append([]string{display.Truncate(m.Name, 30), m.TargetDate}, pointRow(m.PointTotals)...))
+	}
+	rows = append(rows, append([]string{output.Bold("Total"), ""}, pointRow(resp.Total)...))
+	output.TableWithColors(headers, rows)
+}
@@ -0,0 +1,368 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// defaultStaleLabel is the label applied by --label-stale
+	defaultStaleLabel = "stale"
+
+	// defaultNudgeMessage is the comment posted by --nudge; %d is the
+	// number of days without updates
+	defaultNudgeMessage = "This issue has not been updated in %d days. Is it still relevant?"
+)
+
+// openStateTypes are the state types of issues that can go stale
+var openStateTypes = []string{"triage", "backlog", "unstarted", "started"}
+
+// StaleIssue is an open issue that has not been updated recently
+type StaleIssue struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	StateType  string `json:"stateType"`
+	Assignee   string `json:"assignee,omitempty"`
+	UpdatedAt  string `json:"updatedAt"`
+	DaysStale  int    `json:"daysStale"`
+	Nudged     bool   `json:"nudged,omitempty"`
+	Labeled    bool   `json:"labeled,omitempty"`
+	Error      string `json:"error,omitempty"`
+
+	labels []api.IssueLabel
+}
+
+// StaleGroup lists the stale issues of one assignee in one state
+type StaleGroup struct {
+	Assignee string   `json:"assignee"`
+	State    string   `json:"state"`
+	Count    int      `json:"count"`
+	Issues   []string `json:"issues"`
+}
+
+// StaleReportResponse is the response for 'linear report stale'
+type StaleReportResponse struct {
+	Team   string       `json:"team"`
+	Days   int          `json:"days"`
+	Cutoff string       `json:"cutoff"`
+	Issues []StaleIssue `json:"issues"`
+	Groups []StaleGroup `json:"groups"`
+	Count  int          `json:"count"`
+}
+
+func newReportStaleCmd() *cobra.Command {
+	var (
+		teamKey    string
+		days       int
+		nudge      bool
+		message    string
+		labelStale bool
+		staleLabel string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "stale",
+		Short: "Open issues not updated in N days",
+		Long: `Find a team's open issues that have not been updated in the given number
+of days, grouped by assignee and state, oldest first.
+
+--nudge comments on each stale issue and --label-stale adds a label
+(created if missing). Both change the issues, which in turn resets their
+updated time. The command exits 1 when either fails on any issue.
+
+Examples:
+  linear report stale --team ENG
+  linear report stale --team ENG --days 30 --human
+  linear report stale --team ENG --days 14 --nudge
+  linear report stale --team ENG --label-stale --stale-label "needs triage"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+			if teamKey == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_TEAM",
+						"Team is required",
+						"Specify a team using --team flag",
+						"linear report stale --team ENG",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_TEAM",
+					"Team is required",
+					"Specify a team using --team flag",
+					"linear report stale --team ENG",
+				)
+			}
+			if days < 1 {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_DAYS", "--days must be at least 1")
+					return nil
+				}
+				return output.Error("INVALID_DAYS", "--days must be at least 1")
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			team, err := client.GetTeamByKey(ctx, teamKey)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if team == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+					return nil
+				}
+				return output.Error("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+			}
+
+			now := time.Now()
+			cutoff := now.AddDate(0, 0, -days).UTC().Format(time.RFC3339)
+			filter := api.IssueFilter{
+				TeamID:        team.ID,
+				StateTypes:    openStateTypes,
+				UpdatedBefore: cutoff,
+			}
+			issues, _, err := collectPages("", true, func(after string) ([]api.IssueListItem, *api.PageInfo, error) {
+				page, err := client.GetIssues(ctx, filter, reportPageSize, "", after)
+				if err != nil {
+					return nil, nil, err
+				}
+				return page.Issues, page.PageInfo, nil
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			resp := buildStaleReport(team.Key, days, cutoff, issues, now)
+
+			if nudge {
+				if !cmd.Flags().Changed("message") {
+					message = fmt.Sprintf(defaultNudgeMessage, days)
+				}
+				nudgeStaleIssues(ctx, client, resp.Issues, message)
+			}
+			if labelStale {
+				labelStaleIssues(ctx, client, team.ID, resp.Issues, staleLabel)
+			}
+			for _, issue := range resp.Issues {
+				if issue.Error != "" {
+					output.Fail("API_ERROR")
+					break
+				}
+			}
+
+			if IsHumanOutput() {
+				printStaleReportHuman(resp, nudge, labelStale)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
+	cmd.Flags().IntVar(&days, "days", 14, "Days without updates before an issue is stale")
+	cmd.Flags().BoolVar(&nudge, "nudge", false, "Comment on each stale issue")
+	cmd.Flags().StringVar(&message, "message", "", "Comment posted by --nudge (default asks whether the issue is still relevant)")
+	cmd.Flags().BoolVar(&labelStale, "label-stale", false, "Add a label to each stale issue")
+	cmd.Flags().StringVar(&staleLabel, "stale-label", defaultStaleLabel, "Label used by --label-stale (created if missing)")
+
+	return cmd
+}
+
+// buildStaleReport sorts stale issues oldest first and groups them by
+// assignee and state, with unassigned issues last
+func buildStaleReport(team string, days int, cutoff string, issues []api.IssueListItem, now time.Time) *StaleReportResponse {
+	resp := &StaleReportResponse{
+		Team:   team,
+		Days:   days,
+		Cutoff: cutoff,
+		Issues: make([]StaleIssue, 0, len(issues)),
+		Groups: []StaleGroup{},
+	}
+
+	for _, issue := range issues {
+		stale := StaleIssue{
+			ID:         issue.ID,
+			Identifier: issue.Identifier,
+			Title:      issue.Title,
+			State:      issue.State.Name,
+			StateType:  issue.State.Type,
+			UpdatedAt:  issue.UpdatedAt,
+			labels:     issue.Labels,
+		}
+		if issue.Assignee != nil {
+			stale.Assignee = issue.Assignee.DisplayName
+		}
+		if t, err := display.ParseISO(issue.UpdatedAt); err == nil {
+			stale.DaysStale = int(now.Sub(t).Hours() / 24)
+		}
+		resp.Issues = append(resp.Issues, stale)
+	}
+
+	sort.SliceStable(resp.Issues, func(i, j int) bool {
+		a, b := resp.Issues[i], resp.Issues[j]
+		if (a.Assignee == "") != (b.Assignee == "") {
+			return b.Assignee == ""
+		}
+		if !strings.EqualFold(a.Assignee, b.Assignee) {
+			return strings.ToLower(a.Assignee) < strings.ToLower(b.Assignee)
+		}
+		if a.State != b.State {
+			return a.State < b.State
+		}
+		return a.DaysStale > b.DaysStale
+	})
+
+	for _, issue := range resp.Issues {
+		assignee := issue.Assignee
+		if assignee == "" {
+			assignee = "Unassigned"
+		}
+		last := len(resp.Groups) - 1
+		if last < 0 || resp.Groups[last].Assignee != assignee || resp.Groups[last].State != issue.State {
+			resp.Groups = append(resp.Groups, StaleGroup{Assignee: assignee, State: issue.State, Issues: []string{}})
+			last++
+		}
+		resp.Groups[last].Issues = append(resp.Groups[last].Issues, issue.Identifier)
+		resp.Groups[last].Count++
+	}
+
+	resp.Count = len(resp.Issues)
+	return resp
+}
+
+// nudgeStaleIssues comments message on each stale issue, recording
+// failures on the issue
+func nudgeStaleIssues(ctx context.Context, client *api.Client, issues []StaleIssue, message string) {
+	for i := range issues {
+		if _, err := client.CreateComment(ctx, issues[i].ID, message); err != nil {
+			issues[i].Error = err.Error()
+			continue
+		}
+		issues[i].Nudged = true
+	}
+}
+
+// labelStaleIssues adds the stale label to each issue that does not
+// already have it
+func labelStaleIssues(ctx context.Context, client *api.Client, teamID string, issues []StaleIssue, labelName string) {
+	ids, err := resolveLabelIDs(ctx, client, teamID, []string{labelName}, true)
+	if err != nil {
+		for i := range issues {
+			issues[i].Error = err.Error()
+		}
+		return
+	}
+	labelID := ids[0]
+
+	for i := range issues {
+		issue := &issues[i]
+		for _, l := range issue.labels {
+			if l.ID == labelID {
+				issue.Labeled = true
+			}
+		}
+		if issue.Labeled {
+			continue
+		}
+		if err := client.AddIssueLabel(ctx, issue.ID, labelID); err != nil {
+			issue.Error = err.Error()
+			continue
+		}
+		issue.Labeled = true
+	}
+}
+
+// printStaleReportHuman prints one table per assignee, grouped by state
+func printStaleReportHuman(resp *StaleReportResponse, nudged, labeled bool) {
+	output.HumanLn("%s", output.Bold("Stale issues in %s", resp.Team))
+	output.HumanLn("%s\n", output.Muted("Open issues not updated in %d days", resp.Days))
+
+	if resp.Count == 0 {
+		output.SuccessHuman("No stale issues")
+		return
+	}
+
+	headers := []string{"ID", "STATE", "STALE", "TITLE"}
+	if nudged || labeled {
+		headers = append(headers, "ACTION")
+	}
+
+	byID := map[string]StaleIssue{}
+	for _, issue := range resp.Issues {
+		byID[issue.Identifier] = issue
+	}
+
+	var rows [][]string
+	for i, group := range resp.Groups {
+		if i == 0 || resp.Groups[i-1].Assignee != group.Assignee {
+			if rows != nil {
+				output.TableWithColors(headers, rows)
+				output.HumanLn("")
+			}
+			rows = [][]string{}
+			output.HumanLn("%s", output.Bold("%s", group.Assignee))
+		}
+		for _, id := range group.Issues {
+			issue := byID[id]
+			row := []string{
+				issue.Identifier,
+				issue.State,
+				output.Yellow("%dd", issue.DaysStale),
+				display.Truncate(issue.Title, 50),
+			}
+			if nudged || labeled {
+				row = append(row, staleAction(issue, nudged, labeled))
+			}
+			rows = append(rows, row)
+		}
+	}
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d stale issues", resp.Count)
+}
+
+// staleAction describes what was done to a stale issue
+func staleAction(issue StaleIssue, nudged, labeled bool) string {
+	if issue.Error != "" {
+		return output.Red("✗ %s", issue.Error)
+	}
+	done := []string{}
+	if nudged && issue.Nudged {
+		done = append(done, "nudged")
+	}
+	if labeled && issue.Labeled {
+		done = append(done, "labeled")
+	}
+	return output.Green("✓ %s", strings.Join(done, ", "))
+}
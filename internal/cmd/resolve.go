@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
+	"github.com/juanbermudez/agent-linear-cli/internal/resolver"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// lastIssueArg is the issue argument meaning the last issue touched
+const lastIssueArg = "-"
+
+// refKindAnnotation marks a flag whose value is an entity reference, so it
+// is normalized like positional arguments
+const refKindAnnotation = "linear_ref_kind"
+
+// placeholderPattern matches the <arg> and [arg] placeholders of a usage
+// line, with a trailing ... for repeated arguments
+var placeholderPattern = regexp.MustCompile(`[<\[]([^>\]]+)[>\]](\.\.\.)?`)
+
+// placeholderKinds maps usage placeholders to the entity they name
+var placeholderKinds = map[string]string{
+	"issue-id":      resolver.KindIssue,
+	"related-id":    resolver.KindIssue,
+	"project-id":    resolver.KindProject,
+	"document-id":   resolver.KindDocument,
+	"initiative-id": resolver.KindInitiative,
+}
+
+// markRefFlag normalizes the value of flag name as a reference to kind
+func markRefFlag(cmd *cobra.Command, name, kind string) {
+	_ = cmd.Flags().SetAnnotation(name, refKindAnnotation, []string{kind})
+}
+
+// argKinds returns the kind of each positional argument of cmd, judging by
+// its usage line. A placeholder naming several kinds, such as
+// <issue-id | project-id>, only accepts '-' and is otherwise left to the
+// command; arguments that are not references have no kind.
+func argKinds(cmd *cobra.Command, n int) (kinds []string, lastOK []bool) {
+	kinds = make([]string, n)
+	lastOK = make([]bool, n)
+
+	matches := placeholderPattern.FindAllStringSubmatch(cmd.Use, -1)
+	for i := 0; i < n; i++ {
+		var m []string
+		switch {
+		case i < len(matches):
+			m = matches[i]
+		case len(matches) > 0 && matches[len(matches)-1][2] != "":
+			m = matches[len(matches)-1]
+		default:
+			return kinds, lastOK
+		}
+		name := strings.TrimSpace(m[1])
+		if strings.Contains(name, "|") {
+			lastOK[i] = strings.Contains(name, "issue-id")
+			continue
+		}
+		kinds[i] = placeholderKinds[name]
+		lastOK[i] = kinds[i] == resolver.KindIssue
+	}
+	return kinds, lastOK
+}
+
+// resolveArgs normalizes the entity references among cmd's arguments and
+// annotated flags in place: '-' becomes the last issue touched, Linear URLs
+// become identifiers or slug IDs, and bare issue numbers take the default
+// team
+func resolveArgs(cmd *cobra.Command, args []string) error {
+	kinds, lastOK := argKinds(cmd, len(args))
+	for i, arg := range args {
+		if arg == lastIssueArg {
+			if !lastOK[i] {
+				continue
+			}
+			last, err := lastIssueRef()
+			if err != nil {
+				return err
+			}
+			args[i] = last
+			continue
+		}
+		if kinds[i] == "" {
+			continue
+		}
+		ref, err := resolveRef(kinds[i], arg)
+		if err != nil {
+			return usageError{err}
+		}
+		args[i] = ref
+	}
+
+	var flagErr error
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		kind := f.Annotations[refKindAnnotation]
+		if flagErr != nil || len(kind) == 0 {
+			return
+		}
+		value := f.Value.String()
+		if value == lastIssueArg && kind[0] == resolver.KindIssue {
+			last, err := lastIssueRef()
+			if err != nil {
+				flagErr = err
+				return
+			}
+			value = last
+		}
+		ref, err := resolveRef(kind[0], value)
+		if err != nil {
+			flagErr = usageError{err}
+			return
+		}
+		flagErr = f.Value.Set(ref)
+	})
+	return flagErr
+}
+
+// resolveRef normalizes ref as a reference to kind, completing bare issue
+// numbers with the default team key
+func resolveRef(kind, ref string) (string, error) {
+	team := ""
+	if kind == resolver.KindIssue {
+		if t := GetTeamID(); !isUUID(t) {
+			team = t
+		}
+	}
+	return resolver.Resolve(kind, ref, team)
+}
+
+// lastIssueRef returns the last issue viewed, created or edited, for '-'
+func lastIssueRef() (string, error) {
+	store, err := history.NewStore()
+	if err != nil {
+		return "", err
+	}
+	last, err := store.Last(history.KindIssue)
+	if err != nil {
+		return "", err
+	}
+	if last == nil {
+		return "", usageError{errors.New("no recent issue for '-': view, create or edit an issue first")}
+	}
+	return last.Ref(), nil
+}
@@ -1,18 +1,53 @@
 package cmd
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/config"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
 	"github.com/spf13/cobra"
 )
 
 var (
 	// Global flags
-	humanOutput bool
-	teamID      string
-	projectID   string
+	humanOutput  bool
+	outputFormat string
+	formatTmpl   string
+	jqExpr       string
+	idsOnly      bool
+	quietOutput  bool
+	colorMode    string
+	teamID       string
+	projectID    string
+	offline      bool
+	refreshCache bool
+	utcTimes     bool
+	isoTimes     bool
+	maxRetries   int
+	retryDelay   time.Duration
+	actorName    string
+	debugLog     bool
+	proxyURL     string
+	insecureTLS  bool
+	timeout      time.Duration
+)
+
+// deadline is the context --timeout bounds commands with, if set
+var (
+	deadline       context.Context
+	cancelDeadline context.CancelFunc = func() {}
 )
 
 // NewRootCmd creates the root command for the Linear CLI
@@ -35,16 +70,61 @@ Quick Start:
   linear project list    List all projects
   linear document list   List documents`,
 		Version: fmt.Sprintf("%s (commit: %s, built: %s)", version, commit, date),
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			// Load configuration before each command
-			// This will be implemented in config package
+			configureTimeDisplay()
+			if err := configureColor(cmd); err != nil {
+				return err
+			}
+			if err := configureTimeout(cmd); err != nil {
+				return err
+			}
+			configureRetries(cmd)
+			if err := configureNetwork(cmd); err != nil {
+				return err
+			}
+			if err := configureEndpoint(); err != nil {
+				return err
+			}
+			configureCache()
+			configureLogging()
+			configureActor(cmd)
+			if err := configureOutput(cmd); err != nil {
+				return err
+			}
+			if schemaOnly {
+				cmd.Run = nil
+				cmd.RunE = func(*cobra.Command, []string) error { return nil }
+				return printCommandSchema(cmd)
+			}
+			return resolveArgs(cmd, args)
 		},
 	}
 
 	// Global flags
 	rootCmd.PersistentFlags().BoolVar(&humanOutput, "human", false, "Output in human-readable format (default: JSON)")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", "", "Output format: table, json, yaml, ndjson or tsv (env: LINEAR_OUTPUT; --human is table)")
+	rootCmd.PersistentFlags().StringVar(&formatTmpl, "format", "", "Render each result with a Go template, e.g. '{{.identifier}} {{.state.name}}'")
+	rootCmd.PersistentFlags().StringVar(&jqExpr, "jq", "", "Filter JSON output with a jq expression, e.g. '.issues[] | .identifier'")
+	rootCmd.PersistentFlags().BoolVar(&idsOnly, "ids-only", false, "Print only the identifier or ID of each result, one per line")
+	rootCmd.PersistentFlags().BoolVar(&quietOutput, "quiet", false, "Print nothing on success; failures still print their error")
+	rootCmd.PersistentFlags().StringVar(&colorMode, "color", "", "Color human output: auto, always or never (default: auto; honors NO_COLOR)")
 	rootCmd.PersistentFlags().StringVar(&teamID, "team", "", "Team ID or key (overrides config)")
 	rootCmd.PersistentFlags().StringVar(&projectID, "project", "", "Project ID (overrides VCS detection)")
+	rootCmd.PersistentFlags().BoolVar(&offline, "offline", false, "Read from the local store populated by 'linear sync' instead of the API")
+	rootCmd.PersistentFlags().BoolVar(&refreshCache, "refresh", false, "Bypass cached data and fetch fresh data, updating the cache")
+	rootCmd.PersistentFlags().BoolVar(&utcTimes, "utc", false, "Show times in UTC instead of the configured or local time zone")
+	rootCmd.PersistentFlags().BoolVar(&isoTimes, "iso", false, "Show ISO 8601 timestamps instead of relative times and custom date formats")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", defaultTimeout, "Give up on the command after this long; 0 waits forever (env: LINEAR_TIMEOUT)")
+	rootCmd.PersistentFlags().IntVar(&maxRetries, "max-retries", api.DefaultRetryOptions.MaxRetries, "Retries after rate limiting or server errors (env: LINEAR_MAX_RETRIES)")
+	rootCmd.PersistentFlags().DurationVar(&retryDelay, "retry-delay", api.DefaultRetryOptions.BaseDelay, "Initial retry backoff, doubled on each retry (env: LINEAR_RETRY_DELAY)")
+	rootCmd.PersistentFlags().StringVar(&proxyURL, "proxy", "", "Send requests through this proxy URL (default: HTTPS_PROXY, HTTP_PROXY and NO_PROXY)")
+	rootCmd.PersistentFlags().BoolVar(&insecureTLS, "insecure", false, "Skip TLS certificate verification; prefer LINEAR_CA_BUNDLE (env: LINEAR_INSECURE)")
+	rootCmd.PersistentFlags().BoolVar(&debugLog, "debug", false, "Log each API request to stderr with latency, rate limits and retries (env: LINEAR_LOG=debug|trace)")
+	rootCmd.PersistentFlags().BoolVar(&debugLog, "verbose", false, "Alias for --debug")
+	rootCmd.PersistentFlags().MarkHidden("verbose")
+	rootCmd.PersistentFlags().StringVar(&actorName, "actor", "", "With an app token, create issues and comments on behalf of this name, or 'app' as the app (env: LINEAR_ACTOR)")
+	rootCmd.PersistentFlags().BoolVar(&schemaOnly, "schema", false, "Print the JSON Schema of the command's output instead of running it")
 
 	// Add command groups
 	rootCmd.AddCommand(NewAuthCmd())
@@ -52,22 +132,251 @@ Quick Start:
 	rootCmd.AddCommand(NewProjectCmd())
 	rootCmd.AddCommand(NewDocumentCmd())
 	rootCmd.AddCommand(NewLabelCmd())
+	rootCmd.AddCommand(NewIconsCmd())
 	rootCmd.AddCommand(NewWorkflowCmd())
+	rootCmd.AddCommand(NewStateCmd())
 	rootCmd.AddCommand(NewStatusCmd())
 	rootCmd.AddCommand(NewUserCmd())
 	rootCmd.AddCommand(NewTeamCmd())
 	rootCmd.AddCommand(NewInitiativeCmd())
+	rootCmd.AddCommand(NewViewCmd())
+	rootCmd.AddCommand(NewCustomerCmd())
+	rootCmd.AddCommand(NewTemplateCmd())
+	rootCmd.AddCommand(NewSyncCmd())
+	rootCmd.AddCommand(NewCacheCmd())
+	rootCmd.AddCommand(NewWebhookCmd())
+	rootCmd.AddCommand(NewPokerCmd())
+	rootCmd.AddCommand(NewSLACmd())
+	rootCmd.AddCommand(NewReportCmd())
+	rootCmd.AddCommand(NewReleaseNotesCmd())
+	rootCmd.AddCommand(NewContextCmd())
+	rootCmd.AddCommand(NewPolicyCmd())
+	rootCmd.AddCommand(NewMeCmd())
+	rootCmd.AddCommand(NewStandupCmd())
+	rootCmd.AddCommand(NewRemindCmd())
+	rootCmd.AddCommand(NewSnoozedCmd())
+	rootCmd.AddCommand(NewWatchlistCmd())
+	rootCmd.AddCommand(NewRecurringCmd())
+	rootCmd.AddCommand(NewRecentCmd())
+	rootCmd.AddCommand(NewFavCmd())
+	rootCmd.AddCommand(NewApplyCmd())
+	rootCmd.AddCommand(NewScaffoldCmd())
+	rootCmd.AddCommand(NewSearchCmd())
+	rootCmd.AddCommand(NewAPICmd())
+	rootCmd.AddCommand(NewMCPCmd())
+	rootCmd.AddCommand(NewDaemonCmd())
 	rootCmd.AddCommand(NewConfigCmd())
 	rootCmd.AddCommand(NewWhoamiCmd())
+	rootCmd.AddCommand(NewBranchCmd())
+	rootCmd.AddCommand(NewCompletionCmd())
+	rootCmd.AddCommand(NewAliasCmd())
+	rootCmd.AddCommand(NewSchemaCmd())
+
+	// Help topics
+	rootCmd.AddCommand(newExitCodesTopic())
+
+	markUsageErrors(rootCmd)
+	registerCompletions(rootCmd)
 
 	return rootCmd
 }
 
+// usageError marks command line mistakes so they exit with
+// output.ExitUsage
+type usageError struct {
+	error
+}
+
+func (e usageError) Unwrap() error {
+	return e.error
+}
+
+// markUsageErrors wraps flag parsing and argument validation errors of cmd
+// and all its subcommands as usage errors
+func markUsageErrors(cmd *cobra.Command) {
+	cmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		return usageError{err}
+	})
+	if validate := cmd.Args; validate != nil {
+		cmd.Args = func(c *cobra.Command, args []string) error {
+			// --schema needs no arguments
+			if schemaOnly {
+				return nil
+			}
+			if err := validate(c, args); err != nil {
+				return usageError{err}
+			}
+			return nil
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		markUsageErrors(sub)
+	}
+}
+
+// Execute runs root with the command line arguments, expanding a leading
+// alias first, and returns the process exit status
+func Execute(root *cobra.Command) int {
+	args := os.Args[1:]
+	expanded, script, err := expandAlias(root, loadConfig().Aliases, args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		return output.ExitUsage
+	}
+	if script != "" {
+		return runShellAlias(args[0], script, expanded)
+	}
+	root.SetArgs(expanded)
+	ctx, stop := signalContext()
+	err = root.ExecuteContext(ctx)
+	interrupted := ctx.Err() != nil
+	timedOut := deadline != nil && errors.Is(deadline.Err(), context.DeadlineExceeded)
+	stop()
+	cancelDeadline()
+	api.SaveReadCacheStats()
+	if m, merr := cache.NewManager(); merr == nil {
+		// Responses are written by the api package, past Write's pruning
+		m.Prune()
+	}
+
+	// However the command reported it, running out of time or being
+	// interrupted exits with its own status
+	switch {
+	case interrupted:
+		return output.ExitInterrupted
+	case timedOut:
+		return output.ExitTimeout
+	}
+	return ExitCode(err)
+}
+
+// signalContext returns a context canceled by the first interrupt or
+// SIGTERM. Default handling is restored then, so a second one kills a
+// command stuck somewhere that doesn't watch its context, such as a prompt.
+func signalContext() (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	finished := make(chan struct{})
+	go func() {
+		select {
+		case <-signals:
+			signal.Stop(signals)
+			cancel(errInterrupted)
+			fmt.Fprintln(os.Stderr, "\nInterrupted, stopping (press Ctrl-C again to quit now)")
+		case <-finished:
+		}
+	}()
+	return ctx, func() {
+		close(finished)
+		signal.Stop(signals)
+		cancel(nil)
+	}
+}
+
+// errInterrupted is why requests fail after an interrupt. The HTTP client
+// reports the cause of a canceled context, so it wraps context.Canceled
+// for errors.Is.
+var errInterrupted = fmt.Errorf("interrupted: %w", context.Canceled)
+
+// defaultTimeout bounds commands when neither --timeout nor LINEAR_TIMEOUT
+// is set, so a hung network call cannot block forever
+const defaultTimeout = 30 * time.Second
+
+// noTimeoutAnnotation marks commands that run until stopped or wait for
+// the user, such as 'daemon start' and 'auth login'. defaultTimeout does
+// not apply to them, nor to commands run with --editor; an explicit
+// --timeout or LINEAR_TIMEOUT still does.
+const noTimeoutAnnotation = "noTimeout"
+
+// configureTimeout bounds the command with --timeout or LINEAR_TIMEOUT.
+// Requests in flight when it expires fail, and paginated commands return
+// the pages fetched so far.
+func configureTimeout(cmd *cobra.Command) error {
+	d, explicit := timeout, false
+	if env := os.Getenv("LINEAR_TIMEOUT"); env != "" {
+		parsed, err := time.ParseDuration(env)
+		if err != nil || parsed < 0 {
+			return usageError{fmt.Errorf("invalid LINEAR_TIMEOUT %q: use a duration like 30s or 2m", env)}
+		}
+		d, explicit = parsed, true
+	}
+	if cmd.Root().PersistentFlags().Changed("timeout") {
+		if timeout < 0 {
+			return usageError{fmt.Errorf("--timeout must not be negative")}
+		}
+		d, explicit = timeout, true
+	}
+	if !explicit {
+		_, waits := cmd.Annotations[noTimeoutAnnotation]
+		if edit, _ := cmd.Flags().GetBool("editor"); waits || edit {
+			d = 0
+		}
+	}
+	if d == 0 {
+		return nil
+	}
+	// Wrapped like errInterrupted
+	cause := fmt.Errorf("timed out after %s (--timeout): %w", d, context.DeadlineExceeded)
+	ctx, cancel := context.WithTimeoutCause(cmd.Context(), d, cause)
+	deadline, cancelDeadline = ctx, cancel
+	cmd.SetContext(ctx)
+	return nil
+}
+
+// ExitCode returns the process exit status after Execute returned err: the
+// status for the first error a command reported, or a usage or generic
+// failure status for errors returned to cobra
+func ExitCode(err error) int {
+	if err == nil {
+		return output.ExitCode()
+	}
+	var usage usageError
+	// cobra reports unknown subcommands with a plain error
+	if errors.As(err, &usage) || strings.HasPrefix(err.Error(), "unknown command") {
+		return output.ExitUsage
+	}
+	return output.ExitError
+}
+
+func newExitCodesTopic() *cobra.Command {
+	return &cobra.Command{
+		Use:   "exit-codes",
+		Short: "Exit statuses and what they mean",
+		Long: `Every command exits with a status scripts and agents can branch on:
+
+    0  Success
+    1  Generic failure (API error, I/O error, failed operation)
+    2  Usage error: unknown flag or command, wrong arguments, missing or
+       invalid input (MISSING_* and INVALID_* codes, VALIDATION_ERROR)
+    3  Authentication or permission error (AUTH_ERROR, UNAUTHORIZED,
+       FORBIDDEN, NOT_AUTHENTICATED)
+    4  Not found (NOT_FOUND, TEAM_NOT_FOUND)
+    5  Rate limited, after automatic retries (RATE_LIMITED)
+    6  Conflict: someone else changed it first, e.g. 'issue claim' on a
+       taken issue (CONFLICT)
+  124  --timeout expired (TIMEOUT)
+  130  Interrupted with Ctrl-C or SIGTERM (INTERRUPTED)
+
+A paginated command stopped by either prints the results fetched so far,
+marked "_partial": "timeout" or "interrupted", with a cursor to resume from.
+Commands that act on many items, such as 'linear apply', print a result
+for each item and exit 1 when any of them failed. A --jq or --format filter
+that fails on the output, such as error("boom") or iterating over a null
+field, prints its error to stderr and exits 1 (FILTER_ERROR).
+
+The same applies with --human. In JSON mode the error object's "code"
+tells the failure apart in more detail:
+
+  linear issue view ENG-999 || echo "exit $?"
+  {"success": false, "error": {"code": "NOT_FOUND", ...}}
+  exit 4`,
+	}
+}
+
 // OutputJSON outputs data as JSON (default mode)
 func OutputJSON(data interface{}) error {
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(data)
+	return output.JSON(data)
 }
 
 // OutputHuman outputs data in human-readable format
@@ -77,15 +386,344 @@ func OutputHuman(format string, args ...interface{}) {
 
 // IsHumanOutput returns whether human output mode is enabled
 func IsHumanOutput() bool {
-	return humanOutput
+	return output.CurrentFormat() == output.FormatTable
+}
+
+// IsOffline returns whether offline mode is enabled
+func IsOffline() bool {
+	return offline
 }
 
 // GetTeamID returns the team ID from flag or config
 func GetTeamID() string {
-	return teamID
+	if teamID != "" {
+		return teamID
+	}
+	cfg := loadConfig()
+	if cfg.TeamKey != "" {
+		return cfg.TeamKey
+	}
+	return cfg.TeamID
 }
 
-// GetProjectID returns the project ID from flag or VCS detection
+// GetProjectID returns the project ID from flag or config
 func GetProjectID() string {
-	return projectID
+	if projectID != "" {
+		return projectID
+	}
+	return loadConfig().ProjectID
+}
+
+// appConfig is the layered home and repository config, loaded once
+var appConfig *config.Config
+
+// loadConfig returns the layered config. A missing or invalid config is
+// treated as empty rather than failing every command.
+func loadConfig() *config.Config {
+	if appConfig != nil {
+		return appConfig
+	}
+	appConfig = &config.Config{}
+	if manager, err := config.NewManager(); err == nil {
+		if cfg, err := manager.Load(); err == nil {
+			appConfig = cfg
+		}
+	}
+	return appConfig
+}
+
+// configureTimeDisplay applies the date_format and timezone settings and the
+// --utc and --iso flags to human output. Invalid settings fall back to the
+// defaults rather than failing every command.
+func configureTimeDisplay() {
+	opts := display.TimeOptions{ISO: isoTimes}
+
+	cfg := loadConfig()
+	if cfg.DateFormat != "" {
+		if layout, err := display.ParseDateFormat(cfg.DateFormat); err == nil {
+			opts.DateLayout = layout
+		}
+	}
+	if cfg.Timezone != "" {
+		if loc, err := time.LoadLocation(cfg.Timezone); err == nil {
+			opts.Location = loc
+		}
+	}
+
+	if utcTimes {
+		opts.Location = time.UTC
+	}
+
+	display.SetTimeOptions(opts)
+}
+
+// configureColor applies --color and the theme settings. --color overrides
+// theme.color, which overrides NO_COLOR; auto colors only terminals.
+// Invalid theme settings fall back to the defaults, like time settings.
+func configureColor(cmd *cobra.Command) error {
+	cfg := loadConfig().Theme
+
+	mode := output.ColorAuto
+	if m, err := output.ParseColorMode(cfg.Color); err == nil {
+		mode = m
+	}
+	if cmd.Root().PersistentFlags().Changed("color") {
+		m, err := output.ParseColorMode(colorMode)
+		if err != nil {
+			return usageError{err}
+		}
+		mode = m
+	}
+	output.SetColorMode(mode)
+
+	theme := output.DefaultTheme()
+	if cfg.Depth != "" {
+		if depth, err := output.ParseColorDepth(cfg.Depth); err == nil {
+			theme.Depth = depth
+		}
+	}
+	for name, spec := range cfg.Priority {
+		if priority, err := parsePriority(name); err == nil && output.ValidateColor(spec) == nil {
+			theme.Priority[priority] = spec
+		}
+	}
+	for stateType, spec := range cfg.State {
+		if output.ValidateColor(spec) == nil {
+			theme.State[strings.ToLower(stateType)] = spec
+		}
+	}
+	output.SetTheme(theme)
+	return nil
+}
+
+// configureOutput selects the output format from --output, --human or the
+// LINEAR_OUTPUT environment variable, in that order of precedence, and
+// applies --jq and --format
+func configureOutput(cmd *cobra.Command) error {
+	value := os.Getenv("LINEAR_OUTPUT")
+	if humanOutput {
+		value = string(output.FormatTable)
+	}
+	// Compare with the root flag: 'issue export' has its own --output
+	if cmd.Root().PersistentFlags().Changed("output") {
+		value = outputFormat
+	}
+
+	format := output.FormatJSON
+	if value != "" {
+		var err error
+		if format, err = output.ParseFormat(value); err != nil {
+			return usageError{err}
+		}
+	}
+	output.SetFormat(format)
+	return configureFilter(cmd)
+}
+
+// configureFilter compiles --jq and --format and applies --ids-only and
+// --quiet. The first three replace the selected format, so they cannot be
+// combined with each other or with table output chosen on the command line;
+// a table format from LINEAR_OUTPUT gives way. --quiet switches table output
+// to JSON, whose errors it still prints.
+func configureFilter(cmd *cobra.Command) error {
+	flags := cmd.Root().PersistentFlags()
+	// 'issue export' has its own --format
+	tmpl := ""
+	if flags.Changed("format") && !isNamedFormat(cmd, formatTmpl) {
+		tmpl = formatTmpl
+	}
+	output.SetIDsOnly(idsOnly)
+	output.SetQuiet(quietOutput)
+	if quietOutput && output.CurrentFormat() == output.FormatTable {
+		// Commands print tables themselves, so only JSON output can be
+		// silenced
+		output.SetFormat(output.FormatJSON)
+	}
+	if tmpl == "" && jqExpr == "" && !idsOnly {
+		output.SetJQ("")
+		output.SetTemplate("")
+		return nil
+	}
+
+	set := 0
+	for _, on := range []bool{tmpl != "", jqExpr != "", idsOnly} {
+		if on {
+			set++
+		}
+	}
+	if set > 1 {
+		return usageError{fmt.Errorf("--format, --jq and --ids-only cannot be used together")}
+	}
+	if output.CurrentFormat() == output.FormatTable {
+		if humanOutput || flags.Changed("output") {
+			return usageError{fmt.Errorf("--format, --jq and --ids-only cannot be used with table output")}
+		}
+		output.SetFormat(output.FormatJSON)
+	}
+	if err := output.SetJQ(jqExpr); err != nil {
+		return usageError{err}
+	}
+	if err := output.SetTemplate(tmpl); err != nil {
+		return usageError{err}
+	}
+	return nil
+}
+
+// namedFormatsAnnotation lists the --format names a command renders itself,
+// such as "mention" for 'user list', instead of treating them as templates
+const namedFormatsAnnotation = "namedFormats"
+
+// isNamedFormat reports whether value is one of cmd's named formats
+func isNamedFormat(cmd *cobra.Command, value string) bool {
+	names, ok := cmd.Annotations[namedFormatsAnnotation]
+	if !ok {
+		return false
+	}
+	for _, name := range strings.Split(names, ",") {
+		if name == value {
+			return true
+		}
+	}
+	return false
+}
+
+// namedFormat returns the named format selected with --format, or ""
+func namedFormat(cmd *cobra.Command) string {
+	if cmd.Root().PersistentFlags().Changed("format") && isNamedFormat(cmd, formatTmpl) {
+		return formatTmpl
+	}
+	return ""
+}
+
+// configureLogging applies LINEAR_LOG and --debug; --debug raises the
+// level to at least debug, so LINEAR_LOG=trace keeps variables. An invalid
+// LINEAR_LOG is ignored.
+func configureLogging() {
+	level, _ := api.ParseLogLevel(os.Getenv("LINEAR_LOG"))
+	if debugLog && level < api.LogDebug {
+		level = api.LogDebug
+	}
+	api.SetLogLevel(level)
+}
+
+// configureActor applies --actor, LINEAR_ACTOR or the actor config, in
+// that order of precedence. "app" creates issues and comments as the app.
+func configureActor(cmd *cobra.Command) {
+	cfg := loadConfig()
+	a := api.Actor{Name: cfg.Actor, IconURL: cfg.ActorIconURL}
+
+	if name := os.Getenv("LINEAR_ACTOR"); name != "" {
+		a.Name = name
+	}
+	if cmd.Flags().Changed("actor") {
+		a.Name = actorName
+	}
+	if strings.EqualFold(a.Name, "app") {
+		a = api.Actor{}
+	}
+
+	api.SetActor(a)
+}
+
+// configureCache applies --refresh and the cache policy from the config to
+// the local cache and the API response cache, which is shared with other
+// processes through the cache directory. Invalid values keep the defaults.
+func configureCache() {
+	policy := cachePolicy()
+	cache.SetPolicy(policy)
+	cache.SetRefresh(refreshCache)
+
+	opts := api.ReadCacheOptions{TTL: policy.ResponseTTL, Refresh: refreshCache}
+	if m, err := cache.NewManager(); err == nil {
+		opts.Dir = filepath.Join(m.Dir(), cache.ResponsesDir)
+	}
+	api.SetReadCacheOptions(opts)
+}
+
+// cachePolicy reads the [cache] config section over the defaults
+func cachePolicy() cache.Policy {
+	policy := cache.Policy{TTL: cache.DefaultTTL, ResponseTTL: api.DefaultReadCacheTTL}
+	cfg := loadConfig().Cache
+	if d, err := time.ParseDuration(cfg.TTL); err == nil && d > 0 {
+		policy.TTL = d
+	}
+	if d, err := time.ParseDuration(cfg.ResponseTTL); err == nil && d >= 0 {
+		policy.ResponseTTL = d
+	}
+	if n, err := config.ParseSize(cfg.MaxSize); err == nil {
+		policy.MaxSize = n
+	}
+	return policy
+}
+
+// configureRetries applies the retry flags, falling back to the
+// LINEAR_MAX_RETRIES, LINEAR_RETRY_DELAY and LINEAR_RETRY_MAX_DELAY
+// environment variables. Invalid values keep the defaults.
+func configureRetries(cmd *cobra.Command) {
+	opts := api.DefaultRetryOptions
+
+	if v, err := strconv.Atoi(os.Getenv("LINEAR_MAX_RETRIES")); err == nil && v >= 0 {
+		opts.MaxRetries = v
+	}
+	if d, err := time.ParseDuration(os.Getenv("LINEAR_RETRY_DELAY")); err == nil && d > 0 {
+		opts.BaseDelay = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("LINEAR_RETRY_MAX_DELAY")); err == nil && d > 0 {
+		opts.MaxDelay = d
+	}
+
+	if cmd.Flags().Changed("max-retries") && maxRetries >= 0 {
+		opts.MaxRetries = maxRetries
+	}
+	if cmd.Flags().Changed("retry-delay") && retryDelay > 0 {
+		opts.BaseDelay = retryDelay
+	}
+
+	api.SetRetryOptions(opts)
+}
+
+// configureEndpoint points clients at the api_endpoint config key or
+// LINEAR_API_ENDPOINT, and records their requests as fixtures in
+// LINEAR_API_RECORD for replaying with the apitest package
+func configureEndpoint() error {
+	if endpoint := loadConfig().APIEndpoint; endpoint != "" {
+		if err := api.SetEndpoint(endpoint); err != nil {
+			return usageError{err}
+		}
+	}
+	if dir := os.Getenv("LINEAR_API_RECORD"); dir != "" {
+		if err := api.SetRecordDir(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configureNetwork applies --proxy, LINEAR_CA_BUNDLE and --insecure to
+// every connection. Skipping verification exposes the API token to anyone
+// on the path, so it warns on each run.
+func configureNetwork(cmd *cobra.Command) error {
+	opts := api.TransportOptions{
+		Proxy:    proxyURL,
+		CABundle: os.Getenv("LINEAR_CA_BUNDLE"),
+	}
+	if v, err := strconv.ParseBool(os.Getenv("LINEAR_INSECURE")); err == nil {
+		opts.Insecure = v
+	}
+	if cmd.Root().PersistentFlags().Changed("insecure") {
+		opts.Insecure = insecureTLS
+	}
+	if opts.Proxy == "" && opts.CABundle == "" && !opts.Insecure {
+		return nil
+	}
+
+	if err := api.SetTransportOptions(opts); err != nil {
+		return usageError{err}
+	}
+	if opts.Insecure {
+		fmt.Fprintln(os.Stderr, output.Red("WARNING: TLS certificate verification is disabled (--insecure or LINEAR_INSECURE)."))
+		fmt.Fprintln(os.Stderr, output.Red("WARNING: Your API token and data can be read or changed by anyone between you and Linear."))
+		fmt.Fprintln(os.Stderr, output.Red("WARNING: Set LINEAR_CA_BUNDLE to your proxy's CA certificate instead."))
+	}
+	return nil
 }
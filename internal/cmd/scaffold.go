@@ -0,0 +1,595 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/scaffold"
+	"github.com/juanbermudez/agent-linear-cli/internal/templates"
+	"github.com/spf13/cobra"
+)
+
+// ProjectStatusTypes are the types a project status may have
+var ProjectStatusTypes = []string{"backlog", "planned", "started", "paused", "completed", "canceled"}
+
+// Statuses of a scaffolded resource
+const (
+	scaffoldCreated = "created"
+	scaffoldUpdated = "updated"
+	scaffoldExists  = "exists"
+	scaffoldPlanned = "planned"
+	scaffoldFailed  = "failed"
+)
+
+// scaffoldProjectLimit caps how many of the team's projects are checked
+// for existing names
+const scaffoldProjectLimit = 250
+
+// ScaffoldItem is the outcome for one resource in a workspace file
+type ScaffoldItem struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	ID     string `json:"id,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ScaffoldResponse is the response for 'linear scaffold'
+type ScaffoldResponse struct {
+	Success  bool           `json:"success"`
+	DryRun   bool           `json:"dryRun"`
+	Team     string         `json:"team"`
+	Items    []ScaffoldItem `json:"items"`
+	Created  int            `json:"created"`
+	Existing int            `json:"existing"`
+	Failed   int            `json:"failed"`
+}
+
+// NewScaffoldCmd creates the scaffold command
+func NewScaffoldCmd() *cobra.Command {
+	var (
+		filePath string
+		teamKey  string
+		dryRun   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "scaffold",
+		Short: "Provision a team's labels, states, statuses, templates and projects",
+		Long: `Set up a team from a YAML or JSON workspace file, so team setup is
+reproducible and reviewable like code.
+
+The file lists what the team should have. Anything that already exists,
+matched by name (case-insensitive), is left as it is, so running scaffold
+again only creates what is missing. Templates are local: they are written
+to the template directory, replacing a template of the same name.
+
+  team              key, or a mapping with key, name and description. A
+                    team that doesn't exist is created when name is set.
+  labels            names, or mappings with name, color, description and
+                    children (a label group)
+  states            workflow states: name, type, color, description
+  project_statuses  workspace project statuses: name, type (backlog,
+                    planned, started, paused, completed, canceled), color
+  templates         issue and comment templates: kind -> name -> body
+  projects          names, or mappings with name, description, content,
+                    status, lead, icon, color, start_date, target_date
+                    and priority
+
+Quote colors, as an unquoted # starts a YAML comment.
+
+Example file:
+  team:
+    key: MOB
+    name: Mobile
+  labels:
+    - name: bug
+      color: "#eb5757"
+    - name: Platform
+      children: [ios, android]
+  states:
+    - name: In Review
+      type: started
+      color: "#f2c94c"
+  templates:
+    issue:
+      bug: |
+        ## Steps to reproduce
+  projects:
+    - name: App Store launch
+      status: Planned
+      lead: self
+
+Examples:
+  linear scaffold --file workspace.yaml --dry-run
+  linear scaffold --file workspace.yaml --human`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filePath == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_FILE",
+						"Workspace file is required",
+						"Provide a YAML or JSON workspace file using the --file flag",
+						"linear scaffold --file workspace.yaml",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_FILE",
+					"Workspace file is required",
+					"Provide a YAML or JSON workspace file using the --file flag",
+					"linear scaffold --file workspace.yaml",
+				)
+			}
+
+			ws, err := scaffold.Load(filePath)
+			if err == nil {
+				if err = validateScaffold(ws); err != nil {
+					err = fmt.Errorf("%s: %w", filePath, err)
+				}
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_FILE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_FILE", err.Error())
+			}
+
+			if teamKey != "" {
+				ws.Team.Key = strings.ToUpper(teamKey)
+			}
+			if ws.Team.Key == "" {
+				ws.Team.Key = GetTeamID()
+			}
+			if ws.Team.Key == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_TEAM", "Team is required. Set team in the file, use --team or configure a default team.")
+					return nil
+				}
+				return output.Error("MISSING_TEAM", "Team is required. Set team in the file, use --team or configure a default team.")
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			s := &scaffolder{client: client, dryRun: dryRun, statusIDs: map[string]string{}}
+			resp := &ScaffoldResponse{DryRun: dryRun, Team: ws.Team.Key, Items: []ScaffoldItem{}}
+
+			team, err := client.GetTeamByKey(ctx, ws.Team.Key)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if team == nil {
+				if ws.Team.Name == "" {
+					msg := fmt.Sprintf("Team '%s' not found", ws.Team.Key)
+					hint := "Set team.name in the workspace file to create the team"
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint("TEAM_NOT_FOUND", msg, hint)
+						return nil
+					}
+					return output.ErrorWithHint("TEAM_NOT_FOUND", msg, hint)
+				}
+				item := ScaffoldItem{Kind: "team", Name: ws.Team.Key, Status: scaffoldPlanned}
+				if !dryRun {
+					team, err = client.CreateTeam(ctx, api.TeamCreateInput{Key: ws.Team.Key, Name: ws.Team.Name, Description: ws.Team.Description})
+					if err != nil {
+						if IsHumanOutput() {
+							output.ErrorHumanFrom(err, "API_ERROR")
+							return nil
+						}
+						return output.ErrorFrom(err, "API_ERROR")
+					}
+					if cacheManager, _ := cache.NewManager(); cacheManager != nil {
+						cacheManager.Clear(cache.WorkspaceKey("teams"))
+					}
+					item.Status, item.ID = scaffoldCreated, team.ID
+				}
+				resp.Items = append(resp.Items, item)
+			} else {
+				resp.Items = append(resp.Items, ScaffoldItem{Kind: "team", Name: team.Key, Status: scaffoldExists, ID: team.ID})
+			}
+			if team != nil {
+				s.teamID = team.ID
+			}
+
+			// Project statuses come first so projects can use them
+			resp.Items = append(resp.Items, s.projectStatuses(ctx, ws.ProjectStatuses)...)
+			resp.Items = append(resp.Items, s.states(ctx, ws.States)...)
+			resp.Items = append(resp.Items, s.labels(ctx, ws.Labels)...)
+			resp.Items = append(resp.Items, s.templates(ws.Templates)...)
+			resp.Items = append(resp.Items, s.projects(ctx, ws.Projects)...)
+
+			for _, item := range resp.Items {
+				switch item.Status {
+				case scaffoldCreated, scaffoldUpdated, scaffoldPlanned:
+					resp.Created++
+				case scaffoldExists:
+					resp.Existing++
+				case scaffoldFailed:
+					resp.Failed++
+				}
+			}
+			resp.Success = resp.Failed == 0
+			if resp.Failed > 0 {
+				output.Fail("SCAFFOLD_FAILED")
+			}
+
+			if IsHumanOutput() {
+				printScaffoldHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "YAML or JSON workspace file")
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key, overriding the file's team")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would be created without changing anything")
+
+	return cmd
+}
+
+// validateScaffold checks the values the workspace file package cannot,
+// so a bad entry fails before anything is created
+func validateScaffold(ws *scaffold.Workspace) error {
+	for _, s := range ws.States {
+		if !containsFold(StateTypes, s.Type) {
+			return fmt.Errorf("state '%s': invalid type '%s' (use %s)", s.Name, s.Type, strings.Join(StateTypes, ", "))
+		}
+	}
+	for _, s := range ws.ProjectStatuses {
+		if !containsFold(ProjectStatusTypes, s.Type) {
+			return fmt.Errorf("project status '%s': invalid type '%s' (use %s)", s.Name, s.Type, strings.Join(ProjectStatusTypes, ", "))
+		}
+	}
+	for _, t := range ws.Templates {
+		if err := templates.ValidateKind(t.Kind); err != nil {
+			return fmt.Errorf("template '%s': %w", t.Name, err)
+		}
+	}
+	for _, p := range ws.Projects {
+		if p.Priority != "" {
+			if _, err := parsePriority(p.Priority); err != nil {
+				return fmt.Errorf("project '%s': %w", p.Name, err)
+			}
+		}
+		if err := normalizeIconFlag(&p.Icon); err != nil {
+			return fmt.Errorf("project '%s': %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// scaffolder creates the resources of a workspace file that don't exist
+type scaffolder struct {
+	client *api.Client
+	dryRun bool
+	// teamID is empty when a dry run would create the team
+	teamID string
+	// statusIDs maps lower-cased project status names to IDs, including
+	// statuses created by this run
+	statusIDs map[string]string
+}
+
+// item returns the outcome for a resource that may need creating: exists
+// when existingID is set, planned in a dry run, and otherwise the result
+// of create
+func (s *scaffolder) item(kind, name, existingID string, create func() (string, error)) ScaffoldItem {
+	item := ScaffoldItem{Kind: kind, Name: name}
+	switch {
+	case existingID != "":
+		item.Status, item.ID = scaffoldExists, existingID
+	case s.dryRun:
+		item.Status = scaffoldPlanned
+	default:
+		id, err := create()
+		if err != nil {
+			item.Status, item.Error = scaffoldFailed, err.Error()
+		} else {
+			item.Status, item.ID = scaffoldCreated, id
+		}
+	}
+	return item
+}
+
+// failAll reports every named resource as failed with err
+func failAll(kind string, names []string, err error) []ScaffoldItem {
+	items := make([]ScaffoldItem, len(names))
+	for i, name := range names {
+		items[i] = ScaffoldItem{Kind: kind, Name: name, Status: scaffoldFailed, Error: err.Error()}
+	}
+	return items
+}
+
+func (s *scaffolder) projectStatuses(ctx context.Context, statuses []*scaffold.Status) []ScaffoldItem {
+	if len(statuses) == 0 {
+		return nil
+	}
+	existing, err := s.client.GetProjectStatuses(ctx)
+	if err != nil {
+		names := make([]string, len(statuses))
+		for i, st := range statuses {
+			names[i] = st.Name
+		}
+		return failAll("project_status", names, err)
+	}
+
+	position := 0
+	for _, st := range existing.ProjectStatuses {
+		s.statusIDs[strings.ToLower(st.Name)] = st.ID
+		if st.Position > position {
+			position = st.Position
+		}
+	}
+
+	items := []ScaffoldItem{}
+	created := false
+	for _, st := range statuses {
+		item := s.item("project_status", st.Name, s.statusIDs[strings.ToLower(st.Name)], func() (string, error) {
+			position++
+			status, err := s.client.CreateProjectStatus(ctx, api.ProjectStatusCreateInput{
+				Name:        st.Name,
+				Type:        st.Type,
+				Color:       st.Color,
+				Description: st.Description,
+				Position:    float64(position),
+			})
+			if err != nil {
+				return "", err
+			}
+			created = true
+			return status.ID, nil
+		})
+		if item.ID != "" {
+			s.statusIDs[strings.ToLower(st.Name)] = item.ID
+		}
+		items = append(items, item)
+	}
+	if created {
+		if cacheManager, _ := cache.NewManager(); cacheManager != nil {
+			cacheManager.Clear(cache.WorkspaceKey("statuses"))
+		}
+	}
+	return items
+}
+
+func (s *scaffolder) states(ctx context.Context, states []*scaffold.Status) []ScaffoldItem {
+	if len(states) == 0 {
+		return nil
+	}
+
+	byName := map[string]string{}
+	if s.teamID != "" {
+		existing, err := s.client.GetWorkflowStates(ctx, s.teamID)
+		if err != nil {
+			names := make([]string, len(states))
+			for i, st := range states {
+				names[i] = st.Name
+			}
+			return failAll("state", names, err)
+		}
+		for _, st := range existing.WorkflowStates {
+			byName[strings.ToLower(st.Name)] = st.ID
+		}
+	}
+
+	items := []ScaffoldItem{}
+	for _, st := range states {
+		items = append(items, s.item("state", st.Name, byName[strings.ToLower(st.Name)], func() (string, error) {
+			state, err := s.client.CreateWorkflowState(ctx, api.WorkflowStateCreateInput{
+				TeamID:      s.teamID,
+				Name:        st.Name,
+				Type:        st.Type,
+				Color:       st.Color,
+				Description: st.Description,
+			})
+			if err != nil {
+				return "", err
+			}
+			return state.ID, nil
+		}))
+	}
+	if !s.dryRun {
+		clearWorkflowCache(s.teamID)
+	}
+	return items
+}
+
+func (s *scaffolder) labels(ctx context.Context, labels []*scaffold.Label) []ScaffoldItem {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	byName := map[string]string{}
+	if s.teamID != "" {
+		existing, err := s.client.GetLabels(ctx, s.teamID)
+		if err != nil {
+			names := []string{}
+			for _, l := range labels {
+				names = append(names, l.Name)
+				for _, child := range l.Children {
+					names = append(names, child.Name)
+				}
+			}
+			return failAll("label", names, err)
+		}
+		for _, l := range existing.Labels {
+			byName[strings.ToLower(l.Name)] = l.ID
+		}
+	}
+
+	items := []ScaffoldItem{}
+	for _, l := range labels {
+		group := s.item("label", l.Name, byName[strings.ToLower(l.Name)], func() (string, error) {
+			label, err := createLabel(ctx, s.client, s.teamID, l.Name, l.Description, l.Color, "", len(l.Children) > 0)
+			if err != nil {
+				return "", err
+			}
+			return label.ID, nil
+		})
+		items = append(items, group)
+
+		for _, child := range l.Children {
+			name := l.Name + "/" + child.Name
+			if group.Status == scaffoldFailed {
+				items = append(items, ScaffoldItem{Kind: "label", Name: name, Status: scaffoldFailed, Error: "label group was not created"})
+				continue
+			}
+			items = append(items, s.item("label", name, byName[strings.ToLower(child.Name)], func() (string, error) {
+				label, err := createLabel(ctx, s.client, s.teamID, child.Name, child.Description, child.Color, group.ID, false)
+				if err != nil {
+					return "", err
+				}
+				return label.ID, nil
+			}))
+		}
+	}
+	if !s.dryRun {
+		if cacheManager, _ := cache.NewManager(); cacheManager != nil {
+			cacheManager.Clear(cache.TeamKey("labels", s.teamID))
+		}
+	}
+	return items
+}
+
+func (s *scaffolder) templates(list []*scaffold.Template) []ScaffoldItem {
+	if len(list) == 0 {
+		return nil
+	}
+	store, err := templateStore()
+	if err != nil {
+		names := make([]string, len(list))
+		for i, t := range list {
+			names[i] = t.Kind + "/" + t.Name
+		}
+		return failAll("template", names, err)
+	}
+
+	items := []ScaffoldItem{}
+	for _, t := range list {
+		item := ScaffoldItem{Kind: "template", Name: t.Kind + "/" + t.Name, Status: scaffoldCreated}
+		if current, err := store.Get(t.Kind, t.Name); err == nil && current != nil {
+			if strings.TrimSpace(current.Body) == strings.TrimSpace(t.Body) {
+				item.Status = scaffoldExists
+				items = append(items, item)
+				continue
+			}
+			item.Status = scaffoldUpdated
+		}
+		if s.dryRun {
+			if item.Status == scaffoldCreated {
+				item.Status = scaffoldPlanned
+			}
+			items = append(items, item)
+			continue
+		}
+		if _, err := store.Save(t.Kind, t.Name, t.Body); err != nil {
+			item.Status, item.Error = scaffoldFailed, err.Error()
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func (s *scaffolder) projects(ctx context.Context, projects []*scaffold.Project) []ScaffoldItem {
+	if len(projects) == 0 {
+		return nil
+	}
+
+	byName := map[string]string{}
+	if s.teamID != "" {
+		existing, err := s.client.GetProjects(ctx, s.teamID, scaffoldProjectLimit, "", "")
+		if err != nil {
+			names := make([]string, len(projects))
+			for i, p := range projects {
+				names[i] = p.Name
+			}
+			return failAll("project", names, err)
+		}
+		for _, p := range existing.Projects {
+			byName[strings.ToLower(p.Name)] = p.ID
+		}
+	}
+
+	items := []ScaffoldItem{}
+	for _, p := range projects {
+		items = append(items, s.item("project", p.Name, byName[strings.ToLower(p.Name)], func() (string, error) {
+			input := api.ProjectCreateInput{
+				Name:        p.Name,
+				Description: p.Description,
+				Content:     p.Content,
+				TeamIDs:     []string{s.teamID},
+				Icon:        p.Icon,
+				Color:       p.Color,
+			}
+			var err error
+			if id, ok := s.statusIDs[strings.ToLower(p.Status)]; ok {
+				input.StatusID = id
+			} else if input.StatusID, err = resolveProjectStatusID(ctx, s.client, p.Status); err != nil {
+				return "", err
+			}
+			if input.LeadID, err = resolveUserID(ctx, s.client, p.Lead); err != nil {
+				return "", err
+			}
+			if input.StartDate, err = resolveDueDate(p.StartDate); err != nil {
+				return "", err
+			}
+			if input.TargetDate, err = resolveDueDate(p.TargetDate); err != nil {
+				return "", err
+			}
+			if p.Priority != "" {
+				priority, _ := parsePriority(p.Priority)
+				input.Priority = &priority
+			}
+			project, err := s.client.CreateProject(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return project.ID, nil
+		}))
+	}
+	return items
+}
+
+func printScaffoldHuman(resp *ScaffoldResponse) {
+	rows := make([][]string, len(resp.Items))
+	for i, item := range resp.Items {
+		status := item.Status
+		switch item.Status {
+		case scaffoldCreated, scaffoldUpdated:
+			status = output.Green("%s", item.Status)
+		case scaffoldPlanned:
+			status = output.Yellow("%s", item.Status)
+		case scaffoldExists:
+			status = output.Muted("%s", item.Status)
+		case scaffoldFailed:
+			status = output.Red("%s: %s", item.Status, item.Error)
+		}
+		rows[i] = []string{strings.ReplaceAll(item.Kind, "_", " "), item.Name, status}
+	}
+	output.TableWithColors([]string{"KIND", "NAME", "STATUS"}, rows)
+
+	verb := "created"
+	if resp.DryRun {
+		verb = "to create"
+	}
+	output.HumanLn("\n%s: %d %s, %d already existed, %d failed", resp.Team, resp.Created, verb, resp.Existing, resp.Failed)
+}
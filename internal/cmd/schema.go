@@ -0,0 +1,489 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/auth"
+	"github.com/juanbermudez/agent-linear-cli/internal/daemon"
+	"github.com/juanbermudez/agent-linear-cli/internal/history"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/schema"
+	"github.com/juanbermudez/agent-linear-cli/internal/snooze"
+	"github.com/juanbermudez/agent-linear-cli/internal/templates"
+	"github.com/juanbermudez/agent-linear-cli/internal/vcs"
+	"github.com/juanbermudez/agent-linear-cli/internal/watchlist"
+	"github.com/juanbermudez/agent-linear-cli/internal/webhook"
+	"github.com/spf13/cobra"
+)
+
+// schemaOnly is set by --schema: print the command's output schema instead
+// of running it
+var schemaOnly bool
+
+// Samples shared by several command outputs
+var (
+	teamRef         = schema.Object{"id": "", "key": "", "name": ""}
+	userRef         = schema.Object{"id": "", "displayName": "", "email": ""}
+	authLoginOutput = schema.Object{"success": true, "method": "", "storage": "", "storagePath": ""}
+	unreactOutput   = schema.Object{"success": true, "operation": schema.Const("unreact"), "emoji": "", "removed": 0}
+)
+
+// mutation is the output of a command that creates or changes an entity:
+// {"success": true, "operation": op, key: entity}
+func mutation(op, key string, entity interface{}) schema.Object {
+	return schema.Object{"success": true, "operation": schema.Const(op), key: entity}
+}
+
+// removal is the output of a command that deletes, archives or restores an
+// entity by ID: {"success": true, "operation": op, idKey: "..."}
+func removal(op, idKey string) schema.Object {
+	return schema.Object{"success": true, "operation": schema.Const(op), idKey: ""}
+}
+
+// withDates adds the "dates" that commands with date flags echo back
+func withDates(response schema.Object) schema.Object {
+	response["dates?"] = resolvedDates{}
+	return response
+}
+
+// offlineCapable is a response that gains an "offline" field with --offline
+func offlineCapable(response interface{}) schema.Extended {
+	return schema.Extended{Value: response, Fields: schema.Object{"offline?": (*OfflineInfo)(nil)}}
+}
+
+// docLinkOutput is the output of doc add and doc remove
+func docLinkOutput(op string, target docLinkTarget) schema.Object {
+	return schema.Object{"success": true, "operation": schema.Const(op), target.idKey(): "", "documentIds": []string{}}
+}
+
+// docLinkListOutput is the output of doc list
+func docLinkListOutput(target docLinkTarget) schema.Object {
+	return schema.Object{target.idKey(): "", "documents": []api.DocumentListItem{}, "count": 0}
+}
+
+// commandOutputs describes the JSON each command writes on success, keyed
+// by command path. Keep entries in step with the responses the commands
+// build, and bump output.SchemaVersion when a change would break a reader.
+var commandOutputs = map[string]interface{}{
+	"alias delete": schema.Object{"success": true, "name": "", "path": ""},
+	"alias list":   AliasListResponse{},
+	"alias set":    schema.Object{"success": true, "alias": Alias{}, "replaced": true, "path": ""},
+
+	"api ratelimit": schema.Object{"rateLimit": (*api.RateLimit)(nil)},
+
+	"apply": ApplyResponse{},
+
+	"auth":        authLoginOutput,
+	"auth login":  authLoginOutput,
+	"auth logout": schema.Object{"success": true, "message": ""},
+	"auth status": auth.AuthStatus{},
+
+	"branch create": schema.Object{"success": true, "branch": "", "created": true, "issue": ""},
+
+	"cache clear": CacheClearResponse{},
+	"cache list":  CacheListResponse{},
+	"cache path":  schema.Object{"path": ""},
+	"cache stats": CacheStatsResponse{},
+
+	"config get":  schema.Object{"key": "", "value": nil, "source": ""},
+	"config list": schema.Object{"path": "", "files": map[string]string{}, "config": map[string]interface{}{}, "sources": map[string]string{}, "env": map[string]string{}},
+	"config path": schema.Object{"path": ""},
+	"config set":  schema.Object{"success": true, "key": "", "path": ""},
+	"config setup": schema.AnyOf{
+		schema.Object{"success": true, "user": userRef, "team?": "", "availableTeams?": schema.ArrayOf{Item: teamRef}},
+		schema.Object{"valid": true, "user": userRef},
+	},
+
+	"context": ContextBundle{},
+
+	"customer attach":   mutation("attach", "request", (*api.CustomerNeed)(nil)),
+	"customer list":     CustomerListResponse{},
+	"customer requests": CustomerRequestsResponse{},
+	"customer view":     CustomerViewResponse{},
+
+	"daemon start":  schema.Object{"success": true, "pid": 0, "socket": ""},
+	"daemon status": schema.Object{"running": true, "daemon": (*daemon.Status)(nil)},
+	"daemon stop":   schema.Object{"success": true},
+
+	"document create":  mutation("create", "document", (*api.Document)(nil)),
+	"document delete":  removal("delete", "documentId"),
+	"document export":  schema.Object{"success": true, "operation": schema.Const("export"), "documentId": "", "title": "", "path": "", "bytes": 0},
+	"document list":    api.DocumentsResponse{},
+	"document restore": removal("restore", "documentId"),
+	"document search":  api.DocumentSearchResponse{},
+	"document update":  mutation("update", "document", (*api.Document)(nil)),
+	"document view":    api.Document{},
+
+	"fav add":    schema.Object{"success": true, "operation": schema.Const("add"), "added": true, "item": (*history.Item)(nil)},
+	"fav list":   HistoryResponse{},
+	"fav remove": schema.Object{"success": true, "operation": schema.Const("remove"), "items": []history.Item{}},
+
+	"icons search": IconsSearchResponse{},
+
+	"initiative archive":              removal("archive", "initiativeId"),
+	"initiative create":               withDates(mutation("create", "initiative", (*api.Initiative)(nil))),
+	"initiative doc add":              docLinkOutput("doc-add", initiativeDocTarget),
+	"initiative doc list":             docLinkListOutput(initiativeDocTarget),
+	"initiative doc remove":           docLinkOutput("doc-remove", initiativeDocTarget),
+	"initiative list":                 api.InitiativesResponse{},
+	"initiative project-add":          schema.Object{"success": true, "operation": schema.Const("project-add"), "initiativeId": "", "projectId": ""},
+	"initiative project-remove":       schema.Object{"success": true, "operation": schema.Const("project-remove"), "initiativeId": "", "projectId": ""},
+	"initiative restore":              removal("restore", "initiativeId"),
+	"initiative roadmap":              schema.AnyOf{RoadmapResponse{}, RoadmapTimelineResponse{}},
+	"initiative set-parent":           schema.Object{"success": true, "operation": schema.Const("set-parent"), "initiativeId": "", "parentId": ""},
+	"initiative update":               withDates(mutation("update", "initiative", (*api.Initiative)(nil))),
+	"initiative update-status create": mutation("create", "update", (*api.InitiativeUpdate)(nil)),
+	"initiative update-status list":   api.InitiativeUpdatesResponse{},
+	"initiative view":                 api.Initiative{},
+
+	"issue assign": IssueAssignResponse{},
+	"issue attach-pr": schema.Object{
+		"success": true, "operation": schema.Const("attach-pr"), "issue": "",
+		"pullRequest": (*vcs.PullRequest)(nil), "attachment": (*api.Attachment)(nil),
+		"state?": schema.Object{"from": "", "to": ""},
+	},
+	"issue attachment create":   mutation("create", "attachment", (*api.Attachment)(nil)),
+	"issue attachment delete":   removal("delete", "attachmentId"),
+	"issue attachment download": AttachmentDownloadResponse{},
+	"issue attachment list":     api.AttachmentsResponse{},
+	"issue claim":               IssueAssignResponse{},
+	"issue clone":               IssueCloneResponse{},
+	"issue close":               IssueTransitionResponse{},
+	"issue comment create": schema.Object{
+		"success": true, "operation": schema.Const("create"), "comment": (*api.Comment)(nil),
+		// With --attach
+		"uploads?": []api.UploadedFile{},
+	},
+	"issue comment delete":  removal("delete", "commentId"),
+	"issue comment list":    CommentListResponse{},
+	"issue comment react":   mutation("react", "reaction", (*api.Reaction)(nil)),
+	"issue comment unreact": unreactOutput,
+	"issue comment update":  mutation("update", "comment", (*api.Comment)(nil)),
+	"issue create": schema.Object{
+		"success": true,
+		"issue":   schema.Object{"id": "", "identifier": "", "url": "", "team": schema.Object{"key": ""}},
+		// With --subtasks
+		"subtasks?": []SubtaskNode{},
+		"created?":  0,
+		"dates?":    resolvedDates{},
+		// When default_project or default_cycle applied
+		"defaults?": map[string]string{},
+	},
+	"issue current":    schema.Object{"detected": (*vcs.Detection)(nil), "issue": (*api.IssueDetail)(nil)},
+	"issue delete":     schema.AnyOf{removal("delete", "issueId"), BatchResponse{}},
+	"issue doc add":    docLinkOutput("doc-add", issueDocTarget),
+	"issue doc list":   docLinkListOutput(issueDocTarget),
+	"issue doc remove": docLinkOutput("doc-remove", issueDocTarget),
+	"issue export":     schema.Object{"success": true, "operation": schema.Const("export"), "format": "", "path": "", "count": 0},
+	"issue graph":      IssueGraphResponse{},
+	"issue import":     ImportResponse{},
+	"issue list":       offlineCapable(IssueListResponse{}),
+	"issue react":      mutation("react", "reaction", (*api.Reaction)(nil)),
+	"issue relate":     schema.Object{"success": true, "operation": schema.Const("relate"), "issueId": "", "relatedId": "", "type": ""},
+	"issue relations":  schema.Object{"issueId": "", "identifier": "", "relations": []api.IssueRelation{}, "count": 0},
+	"issue reopen":     IssueTransitionResponse{},
+	"issue reorder":    IssueReorderResponse{},
+	"issue search":     api.SearchIssuesResponse{},
+	"issue sed":        SedResponse{},
+	"issue snooze":     mutation("snooze", "snooze", (*snooze.Snooze)(nil)),
+	"issue start": schema.Object{
+		"success": true, "operation": schema.Const("start"), "identifier": "", "title": "",
+		"state": "", "assignee": "", "branchName": "", "url": "",
+	},
+	"issue subscribe":   schema.Object{"success": true, "operation": schema.Const("subscribe"), "issueId": "", "userIds": []string{}},
+	"issue subscribers": schema.Object{"issueId": "", "subscribers": []api.User{}, "count": 0},
+	"issue unassign":    IssueAssignResponse{},
+	"issue unreact":     unreactOutput,
+	"issue unrelate":    removal("unrelate", "relationId"),
+	"issue unsnooze":    schema.Object{"success": true, "operation": schema.Const("unsnooze"), "issue": ""},
+	"issue unsubscribe": schema.Object{"success": true, "operation": schema.Const("unsubscribe"), "issueId": "", "userIds": []string{}},
+	"issue update": schema.AnyOf{
+		withDates(schema.Object{"success": true, "operation": schema.Const("update"), "issue": schema.Object{"id": "", "identifier": "", "url": ""}}),
+		BatchResponse{},
+	},
+	"issue view": api.IssueDetail{},
+
+	"label audit":  LabelAuditResponse{},
+	"label create": mutation("create", "label", (*LabelResponse)(nil)),
+	"label delete": removal("delete", "labelId"),
+	"label list":   offlineCapable(LabelsListResponse{}),
+	"label merge":  LabelMergeResponse{},
+	"label update": mutation("update", "label", (*LabelResponse)(nil)),
+
+	"me": DashboardResponse{},
+
+	"poker": schema.Object{
+		"success": true, "operation": schema.Const("poker"), "issue": "", "roundId": "", "participants": []string{},
+	},
+	"poker tally": PokerTally{},
+
+	"policy simulate": PolicySimulationResponse{},
+
+	"project burndown":              ProjectBurndownResponse{},
+	"project clone":                 ProjectCloneResponse{},
+	"project create":                withDates(mutation("create", "project", (*api.ProjectDetail)(nil))),
+	"project delete":                removal("delete", "projectId"),
+	"project list":                  api.ProjectsResponse{},
+	"project members":               api.ProjectMembersResponse{},
+	"project milestone bulk-create": MilestoneBulkCreateResponse{},
+	"project milestone create":      withDates(mutation("create", "milestone", (*api.Milestone)(nil))),
+	"project milestone delete":      removal("delete", "milestoneId"),
+	"project milestone list":        api.MilestonesResponse{},
+	"project milestone reorder":     MilestoneReorderResponse{},
+	"project milestone update":      withDates(mutation("update", "milestone", (*api.Milestone)(nil))),
+	"project restore":               removal("restore", "projectId"),
+	"project search":                api.SearchProjectsResponse{},
+	"project update":                withDates(mutation("update", "project", (*api.ProjectDetail)(nil))),
+	"project update-status comment": schema.Object{"success": true, "operation": schema.Const("comment"), "updateId": "", "comment": (*api.Comment)(nil)},
+	"project update-status create":  mutation("create", "update", (*api.ProjectUpdate)(nil)),
+	"project update-status delete":  removal("delete", "updateId"),
+	"project update-status edit":    mutation("edit", "update", (*api.ProjectUpdate)(nil)),
+	"project update-status list":    api.ProjectUpdatesResponse{},
+	"project update-status react":   mutation("react", "reaction", (*api.Reaction)(nil)),
+	"project update-status unreact": unreactOutput,
+	"project update-status view":    api.ProjectUpdateDetail{},
+	"project view":                  api.ProjectDetail{},
+
+	"recent": schema.AnyOf{HistoryResponse{}, schema.Object{"success": true, "operation": schema.Const("clear")}},
+
+	"recurring add":    schema.Object{"success": true, "rule": RecurringRule{}},
+	"recurring list":   schema.Object{"rules": []RecurringRule{}, "count": 0, "file": ""},
+	"recurring remove": schema.Object{"success": true, "operation": schema.Const("remove"), "name": ""},
+	"recurring run":    RecurringRunResponse{},
+
+	"release-notes": ReleaseNotesResponse{},
+	"remind":        RemindResponse{},
+
+	"report scope":    ScopeResponse{},
+	"report stale":    StaleReportResponse{},
+	"report velocity": VelocityResponse{},
+
+	"scaffold":     ScaffoldResponse{},
+	"search":       SearchResponse{},
+	"sla report":   SLAReportResponse{},
+	"snoozed list": schema.Object{"snoozed": []SnoozedIssue{}, "count": 0, "file": ""},
+	"snoozed wake": SnoozeWakeResponse{},
+	"standup":      StandupResponse{},
+
+	"state create": schema.Object{"success": true, "operation": schema.Const("create"), "team": "", "state": (*api.WorkflowState)(nil)},
+	"state delete": schema.Object{"success": true, "operation": schema.Const("delete"), "team": "", "stateId": "", "name": ""},
+	"state update": schema.Object{"success": true, "operation": schema.Const("update"), "team": "", "state": (*api.WorkflowState)(nil)},
+
+	"status":       HealthResponse{},
+	"status cache": schema.Object{"success": true, "message": "", "count": 0},
+	"status list":  api.ProjectStatusesResponse{},
+
+	"sync": SyncResponse{},
+
+	"team list":    offlineCapable(api.TeamsResponse{}),
+	"team members": UserListResponse{},
+	"team states":  api.WorkflowStatesResponse{},
+	"team view":    api.TeamDetail{},
+
+	"template create": mutation("create", "template", (*templates.Template)(nil)),
+	"template delete": schema.Object{"success": true, "operation": schema.Const("delete"), "kind": "", "name": ""},
+	"template list":   schema.Object{"templates": []templates.Template{}, "count": 0, "dir": ""},
+	"template view":   templates.Template{},
+
+	"user list":   offlineCapable(UserListResponse{}),
+	"user search": UserSearchResponse{},
+	"user view":   schema.Object{"user": (*api.User)(nil), "mention": ""},
+
+	"view issues": ViewIssuesResponse{},
+	"view list":   ViewListResponse{},
+
+	"watchlist add":    schema.Object{"success": true, "operation": schema.Const("add"), "added": true, "entry": (*watchlist.Entry)(nil)},
+	"watchlist check":  WatchlistCheckResponse{},
+	"watchlist list":   schema.Object{"watchlist": []watchlist.Entry{}, "count": 0, "file": ""},
+	"watchlist remove": schema.Object{"success": true, "operation": schema.Const("remove"), "issue": ""},
+
+	"whoami": schema.AnyOf{WhoamiResponse{}, schema.Object{"authenticated": false, "error": ""}},
+
+	"workflow cache": schema.Object{"success": true, "message": "", "team": "", "count": 0},
+	"workflow list":  offlineCapable(api.WorkflowStatesResponse{}),
+}
+
+// streamOutputs describes commands that write one JSON object per line as
+// events arrive, keyed by command path
+var streamOutputs = map[string]interface{}{
+	"api paginate":   nil,
+	"issue watch":    issueWatchEvent{},
+	"webhook listen": webhook.Event{},
+}
+
+// plainOutputs lists commands whose output is not JSON, with what they
+// write instead
+var plainOutputs = map[string]string{
+	"api graphql":    "the GraphQL response body as returned by the API",
+	"auth token":     "the access token as plain text",
+	"completion":     "a shell completion script",
+	"issue describe": "a commit message as plain text",
+	"issue title":    "the issue title as plain text",
+	"issue url":      "the issue URL as plain text",
+	"mcp serve":      "MCP JSON-RPC messages",
+	"schema":         "a JSON Schema document",
+}
+
+// NewSchemaCmd creates the schema command
+func NewSchemaCmd() *cobra.Command {
+	var list bool
+
+	cmd := &cobra.Command{
+		Use:   "schema <command>...",
+		Short: "Print the JSON Schema of a command's output",
+		Long: fmt.Sprintf(`Print the JSON Schema (draft 2020-12) of the JSON a command writes, so
+integrations can validate responses.
+
+The schema covers both the success output and the error response. Every
+JSON or YAML object the CLI writes carries "%[1]s", currently "%[2]s";
+the version changes only when a field is removed, renamed or changes type.
+New fields may appear without a version change.
+
+Streaming commands such as 'issue watch' write one JSON object per line;
+their schema describes a single line, which carries no version.
+
+Examples:
+  linear schema issue list
+  linear schema issue comment create
+  linear issue view --schema
+  linear schema --list`, output.SchemaVersionKey, output.SchemaVersion),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if list {
+				return listSchemas(cmd.Root())
+			}
+			if len(args) == 0 {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint("MISSING_COMMAND", "Name the command to describe", "List the commands with a schema:", "linear schema --list")
+					return nil
+				}
+				return output.ErrorWithHint("MISSING_COMMAND", "Name the command to describe", "List the commands with a schema:", "linear schema --list")
+			}
+
+			target, rest, err := cmd.Root().Find(args)
+			if err != nil || len(rest) > 0 || target == cmd.Root() {
+				msg := fmt.Sprintf("Unknown command '%s'", strings.Join(args, " "))
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_COMMAND", msg)
+					return nil
+				}
+				return output.Error("INVALID_COMMAND", msg)
+			}
+			return printCommandSchema(target)
+		},
+	}
+
+	cmd.Flags().BoolVar(&list, "list", false, "List every command and the kind of output it writes")
+
+	return cmd
+}
+
+// commandKey is a command's path without the root, such as "issue list"
+func commandKey(cmd *cobra.Command) string {
+	return strings.TrimPrefix(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()), " ")
+}
+
+// commandSchema returns the schema document of a command's output, or an
+// error explaining why it has none
+func commandSchema(cmd *cobra.Command) (*schema.Document, error) {
+	key := commandKey(cmd)
+	id := fmt.Sprintf("https://github.com/juanbermudez/agent-linear-cli/schema/v%s/%s.json",
+		output.SchemaVersion, strings.ReplaceAll(key, " ", "-"))
+	title := "linear " + key
+	g := schema.NewGenerator()
+
+	if sample, ok := commandOutputs[key]; ok {
+		return g.Document(id, title, output.SchemaVersionKey, output.SchemaVersion, sample, output.ErrorResponse{}), nil
+	}
+	if sample, ok := streamOutputs[key]; ok {
+		return g.Stream(id, title, sample), nil
+	}
+	if what, ok := plainOutputs[key]; ok {
+		return nil, fmt.Errorf("'%s' writes %s, not JSON", title, what)
+	}
+	if !cmd.Runnable() {
+		return nil, fmt.Errorf("'%s' is a command group; name one of its subcommands", title)
+	}
+	return nil, fmt.Errorf("no schema is defined for '%s'", title)
+}
+
+// printCommandSchema writes the schema of cmd's output
+func printCommandSchema(cmd *cobra.Command) error {
+	doc, err := commandSchema(cmd)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman("NO_SCHEMA", err.Error())
+			return nil
+		}
+		return output.Error("NO_SCHEMA", err.Error())
+	}
+	return OutputSchema(doc)
+}
+
+// OutputSchema writes a schema document as indented JSON, without the
+// version stamp or output filters applied to command responses
+func OutputSchema(doc *schema.Document) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+// SchemaListEntry is a command in 'linear schema --list'
+type SchemaListEntry struct {
+	Command string `json:"command"`
+	Output  string `json:"output"`
+}
+
+// SchemaListResponse is the output of 'linear schema --list'
+type SchemaListResponse struct {
+	Version  string            `json:"version"`
+	Commands []SchemaListEntry `json:"commands"`
+	Count    int               `json:"count"`
+}
+
+// listSchemas lists every runnable command with the kind of output it
+// writes: json, ndjson or text
+func listSchemas(root *cobra.Command) error {
+	resp := &SchemaListResponse{Version: output.SchemaVersion, Commands: []SchemaListEntry{}}
+	var walk func(*cobra.Command)
+	walk = func(c *cobra.Command) {
+		if c.Runnable() && c != root && !c.Hidden && c.Name() != "help" {
+			key := commandKey(c)
+			kind := "none"
+			switch {
+			case commandOutputs[key] != nil:
+				kind = "json"
+			case hasKey(streamOutputs, key):
+				kind = "ndjson"
+			case plainOutputs[key] != "":
+				kind = "text"
+			}
+			resp.Commands = append(resp.Commands, SchemaListEntry{Command: key, Output: kind})
+		}
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(root)
+	sort.Slice(resp.Commands, func(i, j int) bool { return resp.Commands[i].Command < resp.Commands[j].Command })
+	resp.Count = len(resp.Commands)
+
+	if IsHumanOutput() {
+		rows := make([][]string, len(resp.Commands))
+		for i, c := range resp.Commands {
+			rows[i] = []string{c.Command, c.Output}
+		}
+		output.TableWithColors([]string{"COMMAND", "OUTPUT"}, rows)
+		return nil
+	}
+	return output.JSON(resp)
+}
+
+func hasKey(m map[string]interface{}, key string) bool {
+	_, ok := m[key]
+	return ok
+}
@@ -0,0 +1,565 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/query"
+	"github.com/spf13/cobra"
+)
+
+// SearchResult is one issue, project or document matching a search
+type SearchResult struct {
+	Type string `json:"type"`
+	// Rank is the result's position among results of its type, from 1
+	Rank       int    `json:"rank"`
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	Status     string `json:"status,omitempty"`
+	Owner      string `json:"owner,omitempty"`
+	URL        string `json:"url,omitempty"`
+	UpdatedAt  string `json:"updatedAt"`
+}
+
+// SearchResponse is the response for 'linear search'
+type SearchResponse struct {
+	Query      string              `json:"query"`
+	Text       string              `json:"text,omitempty"`
+	Qualifiers map[string][]string `json:"qualifiers,omitempty"`
+	Types      []string            `json:"types"`
+	Results    []SearchResult      `json:"results"`
+	Count      int                 `json:"count"`
+}
+
+// NewSearchCmd creates the top-level search command
+func NewSearchCmd() *cobra.Command {
+	var (
+		types           []string
+		limit           int
+		includeArchived bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "search <query>...",
+		Short: "Search issues, projects and documents",
+		Long: `Search issues, projects and documents with one query.
+
+A query mixes free text with key:value qualifiers:
+
+  assignee:<user>    me, a name, email or ID; none for unassigned
+  state:<state>      a state type (started, completed, ...) or state name
+  label:<name>       issues must have every label given
+  team:<key>         team key
+  project:<project>  project ID or name; none for issues without one
+  priority:<p>       urgent, high, medium, low, none or 0-4
+  cycle:<cycle>      current, next, previous or a cycle ID
+  milestone:<name>   project milestone name or ID
+  parent:<issue>     parent issue
+  lead:<user>        project lead
+  created:>DATE      created after DATE
+  updated:>DATE      updated after DATE (or updated:<DATE for before)
+  due:<DATE          due before DATE
+  type:<type>        issue, project or document (same as --type)
+
+Quote values with spaces (label:"needs design") and quote whole words to
+search for text containing a colon. Comma-separated values and repeated
+qualifiers accumulate; states and priorities match any value given.
+Dates take the forms 'linear issue list' accepts, such as -7d or today.
+
+Qualifiers narrow the search to the types that support them: issues
+support all but lead, projects support state, team and lead, and documents
+support project. Projects and documents are only searched with free text.
+Results from each type are ranked by relevance and interleaved, so the best
+match of every type comes first.
+
+Examples:
+  linear search "login timeout"
+  linear search assignee:me state:started label:bug
+  linear search 'team:ENG priority:urgent,high "rate limit"'
+  linear search onboarding --type project,document
+  linear search updated:>-7d project:"Mobile App" crash`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			raw := searchQuery(args)
+			searchErr := func(err error, fallback string) error {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, fallback)
+					return nil
+				}
+				return output.ErrorFrom(err, fallback)
+			}
+
+			q, err := query.Parse(raw)
+			if err != nil {
+				return searchErr(err, "INVALID_QUERY")
+			}
+			requested, err := query.ParseTypes(types)
+			if err != nil {
+				return searchErr(err, "INVALID_TYPE")
+			}
+			if len(requested) > 0 && len(q.Types) > 0 {
+				return searchErr(fmt.Errorf("use either --type or type: in the query, not both"), "INVALID_QUERY")
+			}
+			if len(requested) == 0 {
+				requested = q.Types
+			}
+
+			if len(q.Get(query.KeyTeam)) == 0 && cmd.Flags().Changed("team") {
+				q.Qualifiers[query.KeyTeam] = []string{GetTeamID()}
+			}
+
+			resultTypes, err := q.ResultTypes(requested)
+			if err != nil {
+				return searchErr(err, "INVALID_QUERY")
+			}
+			if q.Text == "" {
+				// Project and document search needs text to rank by
+				searchable := []string{}
+				for _, t := range resultTypes {
+					if t == query.TypeIssue {
+						searchable = append(searchable, t)
+					}
+				}
+				if len(searchable) == 0 {
+					return searchErr(fmt.Errorf("%s search needs search text besides qualifiers", strings.Join(resultTypes, " and ")), "MISSING_QUERY")
+				}
+				resultTypes = searchable
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			s := &searcher{client: client, query: q, limit: limit, includeArchived: includeArchived}
+			groups := make([][]SearchResult, 0, len(resultTypes))
+			for _, t := range resultTypes {
+				var results []SearchResult
+				switch t {
+				case query.TypeIssue:
+					results, err = s.issues(ctx)
+				case query.TypeProject:
+					results, err = s.projects(ctx)
+				case query.TypeDocument:
+					results, err = s.documents(ctx)
+				}
+				if err != nil {
+					return searchErr(err, "API_ERROR")
+				}
+				groups = append(groups, results)
+			}
+
+			results := interleaveResults(groups, limit)
+			response := &SearchResponse{
+				Query:      raw,
+				Text:       q.Text,
+				Qualifiers: q.Qualifiers,
+				Types:      resultTypes,
+				Results:    results,
+				Count:      len(results),
+			}
+
+			if IsHumanOutput() {
+				printSearchHuman(response)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&types, "type", nil, "Result types to search: issue, project, document (default: all the query supports)")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 25, "Maximum number of results")
+	cmd.Flags().BoolVar(&includeArchived, "include-archived", false, "Include archived issues and projects")
+
+	return cmd
+}
+
+// searchQuery joins the arguments into one query. A lone argument is the
+// query as typed; of several, those with spaces were quoted in the shell,
+// as in label:"needs design", so they are quoted again.
+func searchQuery(args []string) string {
+	if len(args) == 1 {
+		return args[0]
+	}
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = arg
+		if !strings.ContainsAny(arg, " \t") || strings.Contains(arg, `"`) {
+			continue
+		}
+		if key, value, ok := strings.Cut(arg, ":"); ok && !strings.Contains(key, " ") {
+			parts[i] = key + `:"` + value + `"`
+		} else {
+			parts[i] = `"` + arg + `"`
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// searcher runs a parsed query against each result type
+type searcher struct {
+	client          *api.Client
+	query           *query.Query
+	limit           int
+	includeArchived bool
+
+	team *api.Team
+}
+
+// resolveTeam returns the team named by the team: qualifier, or nil
+func (s *searcher) resolveTeam(ctx context.Context) (*api.Team, error) {
+	key, err := s.query.One(query.KeyTeam)
+	if err != nil {
+		return nil, &qualifierError{err}
+	}
+	if key == "" || s.team != nil {
+		return s.team, nil
+	}
+	team, err := s.client.GetTeamByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, &qualifierError{fmt.Errorf("team: no team with key '%s'", key)}
+	}
+	s.team = team
+	return team, nil
+}
+
+// qualifierError marks a qualifier value that could not be resolved
+type qualifierError struct {
+	err error
+}
+
+func (e *qualifierError) Error() string     { return e.err.Error() }
+func (e *qualifierError) Unwrap() error     { return e.err }
+func (e *qualifierError) ErrorCode() string { return "INVALID_QUERY" }
+
+// invalidQualifier wraps err as a qualifierError naming key
+func invalidQualifier(key string, err error) error {
+	return &qualifierError{fmt.Errorf("%s: %w", key, err)}
+}
+
+// issueFilter converts the query's qualifiers into an issue filter
+func (s *searcher) issueFilter(ctx context.Context) (api.IssueFilter, error) {
+	q := s.query
+	filter := api.IssueFilter{Labels: q.Get(query.KeyLabel)}
+
+	team, err := s.resolveTeam(ctx)
+	if err != nil {
+		return filter, err
+	}
+	if team != nil {
+		filter.TeamID = team.ID
+	}
+
+	for _, state := range q.Get(query.KeyState) {
+		if containsFold(StateTypes, state) {
+			filter.StateTypes = append(filter.StateTypes, strings.ToLower(state))
+		} else {
+			filter.States = append(filter.States, state)
+		}
+	}
+	if len(filter.StateTypes) > 0 && len(filter.States) > 0 {
+		return filter, invalidQualifier(query.KeyState, fmt.Errorf("use either state types or state names, not both"))
+	}
+
+	assignee, err := q.One(query.KeyAssignee)
+	if err != nil {
+		return filter, &qualifierError{err}
+	}
+	switch strings.ToLower(assignee) {
+	case "":
+	case "none", "unassigned":
+		filter.Unassigned = true
+	default:
+		if filter.AssigneeID, err = resolveUserID(ctx, s.client, assignee); err != nil {
+			return filter, invalidQualifier(query.KeyAssignee, err)
+		}
+	}
+
+	project, err := q.One(query.KeyProject)
+	if err != nil {
+		return filter, &qualifierError{err}
+	}
+	if strings.EqualFold(project, "none") {
+		filter.NoProject = true
+	} else {
+		filter.ProjectID = project
+	}
+
+	for _, p := range q.Get(query.KeyPriority) {
+		value, err := parsePriority(p)
+		if err != nil {
+			return filter, invalidQualifier(query.KeyPriority, err)
+		}
+		filter.Priorities = append(filter.Priorities, value)
+	}
+
+	if filter.Cycle, err = q.One(query.KeyCycle); err != nil {
+		return filter, &qualifierError{err}
+	}
+	if filter.Milestone, err = q.One(query.KeyMilestone); err != nil {
+		return filter, &qualifierError{err}
+	}
+
+	parent, err := q.One(query.KeyParent)
+	if err != nil {
+		return filter, &qualifierError{err}
+	}
+	if parent != "" {
+		filter.ParentID = parent
+		if !isUUID(parent) {
+			issue, err := s.client.GetIssue(ctx, parent, false)
+			if err != nil {
+				return filter, err
+			}
+			if issue == nil {
+				return filter, invalidQualifier(query.KeyParent, fmt.Errorf("issue '%s' not found", parent))
+			}
+			filter.ParentID = issue.ID
+		}
+	}
+
+	// Each date qualifier compares one way by default; a leading > or <
+	// picks the other where the filter supports it
+	dates := []struct {
+		key    string
+		def    string
+		after  *string
+		before *string
+	}{
+		{query.KeyCreated, ">", &filter.CreatedAfter, nil},
+		{query.KeyUpdated, ">", &filter.UpdatedAfter, &filter.UpdatedBefore},
+		{query.KeyDue, "<", nil, &filter.DueBefore},
+	}
+	for _, d := range dates {
+		for _, value := range q.Get(d.key) {
+			op, expr := query.Comparison(value, d.def)
+			target := d.after
+			if op == "<" {
+				target = d.before
+			}
+			if target == nil {
+				return filter, invalidQualifier(d.key, fmt.Errorf("only %s%s is supported", d.key+":", d.def))
+			}
+			date, err := resolveFilterDate(expr)
+			if err != nil {
+				return filter, invalidQualifier(d.key, err)
+			}
+			*target = date
+		}
+	}
+
+	return filter, nil
+}
+
+func (s *searcher) issues(ctx context.Context) ([]SearchResult, error) {
+	filter, err := s.issueFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []api.IssueListItem
+	if s.query.Text != "" {
+		resp, err := s.client.SearchIssuesWithFilter(ctx, s.query.Text, filter, s.limit, s.includeArchived)
+		if err != nil {
+			return nil, err
+		}
+		issues = resp.Issues
+	} else {
+		// Without text there is nothing to rank by, so the most recently
+		// updated issues come first
+		resp, err := s.client.GetIssues(ctx, filter, s.limit, "updated", "")
+		if err != nil {
+			return nil, err
+		}
+		issues = resp.Issues
+	}
+
+	results := make([]SearchResult, len(issues))
+	for i, issue := range issues {
+		results[i] = SearchResult{
+			Type:       query.TypeIssue,
+			Rank:       i + 1,
+			ID:         issue.ID,
+			Identifier: issue.Identifier,
+			Title:      issue.Title,
+			Status:     issue.State.Name,
+			UpdatedAt:  issue.UpdatedAt,
+		}
+		if issue.Assignee != nil {
+			results[i].Owner = issue.Assignee.DisplayName
+		}
+	}
+	return results, nil
+}
+
+// projects searches projects, applying qualifiers to the ranked results
+// since project search takes no filter
+func (s *searcher) projects(ctx context.Context) ([]SearchResult, error) {
+	team, err := s.resolveTeam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lead, err := s.query.One(query.KeyLead)
+	if err != nil {
+		return nil, &qualifierError{err}
+	}
+	leadID := ""
+	if lead != "" {
+		if leadID, err = resolveUserID(ctx, s.client, lead); err != nil {
+			return nil, invalidQualifier(query.KeyLead, err)
+		}
+	}
+	states := s.query.Get(query.KeyState)
+
+	resp, err := s.client.SearchProjects(ctx, s.query.Text, s.limit, s.includeArchived, false)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []SearchResult{}
+	for _, p := range resp.Projects {
+		if team != nil && !projectHasTeam(p, team.Key) {
+			continue
+		}
+		if leadID != "" && (p.Lead == nil || p.Lead.ID != leadID) {
+			continue
+		}
+		status := p.State
+		if p.Status != nil {
+			status = p.Status.Name
+		}
+		if len(states) > 0 && !containsFold(states, status) && (p.Status == nil || !containsFold(states, p.Status.Type)) {
+			continue
+		}
+
+		result := SearchResult{
+			Type:       query.TypeProject,
+			Rank:       len(results) + 1,
+			ID:         p.ID,
+			Identifier: p.SlugID,
+			Title:      p.Name,
+			Status:     status,
+			URL:        p.URL,
+			UpdatedAt:  p.UpdatedAt,
+		}
+		if p.Lead != nil {
+			result.Owner = p.Lead.DisplayName
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func projectHasTeam(p api.ProjectListItem, key string) bool {
+	for _, t := range p.Teams {
+		if strings.EqualFold(t.Key, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// documents searches documents, applying the project qualifier to the
+// ranked results since document search takes no filter
+func (s *searcher) documents(ctx context.Context) ([]SearchResult, error) {
+	project, err := s.query.One(query.KeyProject)
+	if err != nil {
+		return nil, &qualifierError{err}
+	}
+
+	resp, err := s.client.SearchDocuments(ctx, s.query.Text, s.limit)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []SearchResult{}
+	for _, d := range resp.Documents {
+		if project != "" {
+			if strings.EqualFold(project, "none") {
+				if d.Project != nil {
+					continue
+				}
+			} else if d.Project == nil || (d.Project.ID != project && !strings.EqualFold(d.Project.Name, project)) {
+				continue
+			}
+		}
+
+		result := SearchResult{
+			Type:       query.TypeDocument,
+			Rank:       len(results) + 1,
+			ID:         d.ID,
+			Identifier: d.SlugID,
+			Title:      d.Title,
+			URL:        d.URL,
+			UpdatedAt:  d.UpdatedAt,
+		}
+		if d.Project != nil {
+			result.Status = d.Project.Name
+		}
+		if d.Creator != nil {
+			result.Owner = d.Creator.DisplayName
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// interleaveResults merges per-type results by rank, so every type's best
+// match comes before any type's second best, and keeps the first limit
+func interleaveResults(groups [][]SearchResult, limit int) []SearchResult {
+	results := []SearchResult{}
+	for rank := 0; len(results) < limit; rank++ {
+		added := false
+		for _, group := range groups {
+			if rank < len(group) && len(results) < limit {
+				results = append(results, group[rank])
+				added = true
+			}
+		}
+		if !added {
+			break
+		}
+	}
+	return results
+}
+
+func printSearchHuman(resp *SearchResponse) {
+	if len(resp.Results) == 0 {
+		output.HumanLn("No results found matching '%s'", resp.Query)
+		return
+	}
+
+	output.HumanLn("Search results for '%s':\n", resp.Query)
+
+	headers := []string{"TYPE", "ID", "TITLE", "STATUS", "OWNER"}
+	rows := make([][]string, len(resp.Results))
+	for i, r := range resp.Results {
+		owner := r.Owner
+		if owner == "" {
+			owner = output.Muted("-")
+		}
+		rows[i] = []string{
+			r.Type,
+			r.Identifier,
+			display.Truncate(r.Title, 50),
+			r.Status,
+			owner,
+		}
+	}
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d results", resp.Count)
+}
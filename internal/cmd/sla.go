@@ -0,0 +1,353 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/sla"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// defaultBreachLabel is the label applied by --label-breaches
+	defaultBreachLabel = "SLA breach"
+
+	// slaPageSize is how many issues are fetched per request
+	slaPageSize = 50
+)
+
+// SLABreach is an open issue that has been in its state longer than allowed
+type SLABreach struct {
+	ID           string  `json:"id"`
+	Identifier   string  `json:"identifier"`
+	Title        string  `json:"title"`
+	URL          string  `json:"url"`
+	Team         string  `json:"team"`
+	Priority     int     `json:"priority"`
+	State        string  `json:"state"`
+	StateType    string  `json:"stateType"`
+	EnteredAt    string  `json:"enteredAt"`
+	Rule         string  `json:"rule"`
+	LimitHours   float64 `json:"limitHours"`
+	InStateHours float64 `json:"inStateHours"`
+	OverHours    float64 `json:"overHours"`
+	Labeled      bool    `json:"labeled"`
+	LabelError   string  `json:"labelError,omitempty"`
+
+	overBy time.Duration
+	limit  time.Duration
+	age    time.Duration
+	teamID string
+	labels []api.LabelSummary
+}
+
+// SLAReportResponse is the response for 'linear sla report'
+type SLAReportResponse struct {
+	Policy    string      `json:"policy"`
+	Evaluated int         `json:"evaluated"`
+	Covered   int         `json:"covered"`
+	Breaches  []SLABreach `json:"breaches"`
+	Count     int         `json:"count"`
+}
+
+// NewSLACmd creates the sla command group
+func NewSLACmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sla",
+		Short: "Check issues against time-in-state SLA policies",
+		Long: `Evaluate open issues against service-level policies that limit how long
+an issue of a given priority may stay in a workflow state.`,
+	}
+
+	cmd.AddCommand(newSLAReportCmd())
+
+	return cmd
+}
+
+func newSLAReportCmd() *cobra.Command {
+	var (
+		policyPath    string
+		teamKey       string
+		labelBreaches bool
+		breachLabel   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report open issues that breach an SLA policy",
+		Long: `Report open issues that have spent longer in their current state than the
+policy allows for their priority.
+
+Time in state is measured from the latest history event that moved the
+issue into its current state (or its creation, if it never moved).
+
+The policy is a TOML, JSON or YAML file. Rules are checked in order and
+the first rule matching an issue's priority and state applies. State may
+be a state type (triage, backlog, unstarted, started) or a state name;
+"*" matches any priority or state. With --label-breaches the command exits
+1 when any breach could not be labeled.
+
+  name = "Support"
+
+  [[rules]]
+  priority = "urgent"
+  state = "triage"
+  max = "4h"
+
+  [[rules]]
+  priority = "high"
+  state = "started"
+  max = "3d"
+
+Examples:
+  linear sla report --policy sla.toml
+  linear sla report --policy sla.yaml --team ENG
+  linear sla report --policy sla.toml --label-breaches`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if policyPath == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"MISSING_POLICY",
+						"Policy file is required",
+						"Provide a policy using the --policy flag",
+						"linear sla report --policy sla.toml",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_POLICY",
+					"Policy file is required",
+					"Provide a policy using the --policy flag",
+					"linear sla report --policy sla.toml",
+				)
+			}
+
+			ctx := cmd.Context()
+
+			policy, err := sla.Load(policyPath)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_POLICY", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_POLICY", err.Error())
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			teamID := ""
+			if teamKey != "" {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+				}
+				teamID = team.ID
+			}
+
+			issues, _, err := collectPages("", true, func(after string) ([]api.IssueStateAge, *api.PageInfo, error) {
+				return client.GetIssueStateAges(ctx, teamID, slaPageSize, after)
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			resp := evaluateSLA(policy, issues, time.Now())
+
+			if labelBreaches {
+				labelSLABreaches(ctx, client, resp.Breaches, breachLabel)
+				for _, b := range resp.Breaches {
+					if b.LabelError != "" {
+						output.Fail("API_ERROR")
+						break
+					}
+				}
+			}
+
+			if IsHumanOutput() {
+				printSLAReportHuman(resp, labelBreaches)
+			} else {
+				output.JSON(resp)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&policyPath, "policy", "", "Policy file (TOML, JSON or YAML)")
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Only evaluate issues of this team")
+	cmd.Flags().BoolVar(&labelBreaches, "label-breaches", false, "Add a label to breaching issues")
+	cmd.Flags().StringVar(&breachLabel, "breach-label", defaultBreachLabel, "Label used by --label-breaches (created if missing)")
+
+	return cmd
+}
+
+// evaluateSLA checks each issue against the first policy rule matching its
+// priority and state, worst breaches first
+func evaluateSLA(policy *sla.Policy, issues []api.IssueStateAge, now time.Time) *SLAReportResponse {
+	resp := &SLAReportResponse{
+		Policy:    policy.Name,
+		Evaluated: len(issues),
+		Breaches:  []SLABreach{},
+	}
+
+	for _, issue := range issues {
+		rule := policy.Match(issue.Priority, issue.State.Name, issue.State.Type)
+		if rule == nil {
+			continue
+		}
+		resp.Covered++
+
+		enteredAt, err := display.ParseISO(issue.StateEnteredAt)
+		if err != nil {
+			continue
+		}
+		age := now.Sub(enteredAt)
+		if age <= rule.Limit() {
+			continue
+		}
+
+		resp.Breaches = append(resp.Breaches, SLABreach{
+			ID:           issue.ID,
+			Identifier:   issue.Identifier,
+			Title:        issue.Title,
+			URL:          issue.URL,
+			Team:         issue.Team.Key,
+			Priority:     issue.Priority,
+			State:        issue.State.Name,
+			StateType:    issue.State.Type,
+			EnteredAt:    issue.StateEnteredAt,
+			Rule:         rule.String(),
+			LimitHours:   hours(rule.Limit()),
+			InStateHours: hours(age),
+			OverHours:    hours(age - rule.Limit()),
+			overBy:       age - rule.Limit(),
+			limit:        rule.Limit(),
+			age:          age,
+			teamID:       issue.Team.ID,
+			labels:       issue.Labels,
+		})
+	}
+
+	sort.SliceStable(resp.Breaches, func(i, j int) bool {
+		return resp.Breaches[i].overBy > resp.Breaches[j].overBy
+	})
+	resp.Count = len(resp.Breaches)
+	return resp
+}
+
+// labelSLABreaches adds the breach label to each breaching issue that does
+// not already have it. Labels are team-scoped, so the label is resolved (and
+// created if missing) once per team.
+func labelSLABreaches(ctx context.Context, client *api.Client, breaches []SLABreach, labelName string) {
+	labelIDs := map[string]string{}
+	labelErrs := map[string]error{}
+
+	for i := range breaches {
+		b := &breaches[i]
+
+		labelID, resolved := labelIDs[b.teamID]
+		if err, failed := labelErrs[b.teamID]; failed {
+			b.LabelError = err.Error()
+			continue
+		}
+		if !resolved {
+			ids, err := resolveLabelIDs(ctx, client, b.teamID, []string{labelName}, true)
+			if err != nil {
+				labelErrs[b.teamID] = err
+				b.LabelError = err.Error()
+				continue
+			}
+			labelID = ids[0]
+			labelIDs[b.teamID] = labelID
+		}
+
+		for _, l := range b.labels {
+			if l.ID == labelID {
+				b.Labeled = true
+			}
+		}
+		if b.Labeled {
+			continue
+		}
+
+		if err := client.AddIssueLabel(ctx, b.ID, labelID); err != nil {
+			b.LabelError = err.Error()
+			continue
+		}
+		b.Labeled = true
+	}
+}
+
+// printSLAReportHuman prints breaches as a table
+func printSLAReportHuman(resp *SLAReportResponse, labeled bool) {
+	title := "SLA report"
+	if resp.Policy != "" {
+		title = fmt.Sprintf("SLA report: %s", resp.Policy)
+	}
+	output.HumanLn("%s", output.Bold("%s", title))
+	output.HumanLn("%s\n", output.Muted("%d open issues, %d covered by the policy", resp.Evaluated, resp.Covered))
+
+	if len(resp.Breaches) == 0 {
+		output.SuccessHuman("No SLA breaches")
+		return
+	}
+
+	headers := []string{"ID", "PRIORITY", "STATE", "IN STATE", "LIMIT", "OVER", "TITLE"}
+	if labeled {
+		headers = append(headers, "LABELED")
+	}
+	rows := make([][]string, 0, len(resp.Breaches))
+	for _, b := range resp.Breaches {
+		row := []string{
+			b.Identifier,
+			display.PriorityName(b.Priority),
+			b.State,
+			sla.FormatDuration(b.age),
+			sla.FormatDuration(b.limit),
+			output.Red("+%s", sla.FormatDuration(b.overBy)),
+			display.Truncate(b.Title, 40),
+		}
+		if labeled {
+			mark := output.Green("✓")
+			if !b.Labeled {
+				mark = output.Red("✗ %s", b.LabelError)
+			}
+			row = append(row, mark)
+		}
+		rows = append(rows, row)
+	}
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d breaches", resp.Count)
+}
+
+// hours converts a duration to hours rounded to one decimal place
+func hours(d time.Duration) float64 {
+	return math.Round(d.Hours()*10) / 10
+}
@@ -0,0 +1,429 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/dates"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/snooze"
+	"github.com/spf13/cobra"
+)
+
+// SnoozedIssue is a snooze with whether it has ended, for 'snoozed list'
+type SnoozedIssue struct {
+	*snooze.Snooze
+	Due bool `json:"due"`
+}
+
+// WokenSnooze is a snooze that ended, with the outcome of its comment
+type WokenSnooze struct {
+	*snooze.Snooze
+	Commented bool   `json:"commented,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// SnoozeWakeResponse is the response for 'snoozed wake'
+type SnoozeWakeResponse struct {
+	Success bool          `json:"success"`
+	Woken   []WokenSnooze `json:"woken"`
+	Count   int           `json:"count"`
+	Failed  int           `json:"failed"`
+}
+
+func newIssueSnoozeCmd() *cobra.Command {
+	var (
+		until   string
+		comment bool
+		message string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "snooze <issue-id>",
+		Short: "Hide an issue until later",
+		Long: `Snooze an issue until a date or for a while. Snoozed issues are left out
+of 'linear me' until they wake, then listed under "Back from snooze".
+
+Snoozes are kept locally in $XDG_STATE_HOME/agent-linear-cli/snoozes.json,
+or the file named by LINEAR_SNOOZE_FILE; Linear itself is not changed.
+They wake when 'linear me' or 'linear snoozed wake' runs after --until,
+so run the latter from cron to be reminded on time.
+
+--until takes a date (YYYY-MM-DD, tomorrow, "next monday", "in 2 weeks"),
+which wakes at the start of that day in the configured time zone, or a
+number of hours, days or weeks such as 4h, 3d or 2w. --comment posts a
+comment on the issue when it wakes, so the reminder reaches you wherever
+you read Linear notifications.
+
+Examples:
+  linear issue snooze ENG-123 --until "next monday"
+  linear issue snooze ENG-123 --until 3d --comment
+  linear issue snooze ENG-123 --until 2025-09-01 --comment --message "Revisit after the launch"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+
+			if until == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_UNTIL", "Snooze end is required. Use --until flag.")
+					return nil
+				}
+				return output.Error("MISSING_UNTIL", "Snooze end is required. Use --until flag.")
+			}
+			now := time.Now()
+			wakeAt, err := parseSnoozeUntil(until, now)
+			if err != nil {
+				return dateError(err)
+			}
+			if !wakeAt.After(now) {
+				msg := fmt.Sprintf("--until '%s' is not in the future", until)
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_DATE", msg)
+					return nil
+				}
+				return output.Error("INVALID_DATE", msg)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			issue, err := client.GetIssue(ctx, issueID, false)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if issue == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+			}
+
+			s := &snooze.Snooze{
+				ID:         issue.ID,
+				Identifier: issue.Identifier,
+				Title:      issue.Title,
+				URL:        issue.URL,
+				Until:      wakeAt,
+				CreatedAt:  now,
+			}
+			if comment {
+				s.Comment = message
+				if s.Comment == "" {
+					s.Comment = fmt.Sprintf("Back from snooze: this issue was snoozed on %s until %s.",
+						now.Format(dates.DateLayout), wakeAt.Format("2006-01-02 15:04"))
+				}
+			}
+
+			store, err := snooze.NewStore()
+			if err == nil {
+				err = store.Save(s)
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Snoozed %s until %s", s.Identifier, wakeAt.Format("Mon Jan 02 15:04")))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "snooze",
+				"snooze":    s,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&until, "until", "", "When to wake: a date such as \"next monday\", or a duration such as 3d (required)")
+	cmd.Flags().BoolVar(&comment, "comment", false, "Comment on the issue when it wakes")
+	cmd.Flags().StringVar(&message, "message", "", "Comment posted by --comment (default names the snooze dates)")
+
+	return cmd
+}
+
+func newIssueUnsnoozeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsnooze <issue-id>",
+		Short: "Cancel an issue's snooze",
+		Long: `Cancel the snooze of an issue without posting its wake comment.
+
+Examples:
+  linear issue unsnooze ENG-123`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+
+			store, err := snooze.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			removed, err := store.Remove(issueID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			if removed == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' is not snoozed", issueID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' is not snoozed", issueID))
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Unsnoozed %s", removed.Identifier))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "unsnooze",
+				"issue":     removed.Identifier,
+			})
+		},
+	}
+
+	return cmd
+}
+
+// NewSnoozedCmd creates the snoozed command group
+func NewSnoozedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snoozed",
+		Short: "List and wake snoozed issues",
+		Long: `List the issues snoozed with 'linear issue snooze', and wake the ones
+whose snooze has ended.
+
+Examples:
+  linear snoozed list --human
+  linear snoozed wake
+
+  # crontab: wake snoozes and post their comments every 15 minutes
+  */15 * * * * linear snoozed wake >> ~/.local/state/linear-snooze.log`,
+	}
+
+	cmd.AddCommand(newSnoozedListCmd())
+	cmd.AddCommand(newSnoozedWakeCmd())
+
+	return cmd
+}
+
+func newSnoozedListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List snoozed issues",
+		Long: `List snoozed issues, soonest to wake first. Issues whose snooze has
+ended but that have not been woken yet are marked due.
+
+Examples:
+  linear snoozed list --human`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := snooze.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			snoozes, err := store.List()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			now := time.Now()
+			listed := make([]SnoozedIssue, len(snoozes))
+			for i, s := range snoozes {
+				listed[i] = SnoozedIssue{Snooze: s, Due: s.Due(now)}
+			}
+
+			if IsHumanOutput() {
+				printSnoozedHuman(listed)
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"snoozed": listed,
+				"count":   len(listed),
+				"file":    store.Path(),
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newSnoozedWakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wake",
+		Short: "Wake the issues whose snooze has ended",
+		Long: `Remove the snoozes that have ended and post their wake comments.
+'linear me' does the same each time it runs.
+
+Examples:
+  linear snoozed wake --human`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := snooze.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			woken, err := wakeSnoozes(ctx, client, store, time.Now())
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			resp := &SnoozeWakeResponse{Success: true, Woken: woken, Count: len(woken)}
+			for _, w := range woken {
+				if w.Error != "" {
+					resp.Failed++
+					resp.Success = false
+				}
+			}
+			if !resp.Success {
+				output.Fail("COMMENT_FAILED")
+			}
+
+			if IsHumanOutput() {
+				if len(woken) == 0 {
+					output.HumanLn("No snoozes have ended")
+					return nil
+				}
+				printWokenHuman(woken)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	return cmd
+}
+
+// parseSnoozeUntil turns a duration such as 3d, or a date expression that
+// wakes at the start of its day in the configured time zone, into a time
+func parseSnoozeUntil(expr string, now time.Time) (time.Time, error) {
+	if withinPattern.MatchString(expr) {
+		d, err := parseWithin(expr)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(d), nil
+	}
+
+	cal, err := loadCalendar()
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc := defaultRecurringLocation()
+	day, err := cal.Parse(expr, now.In(loc))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc), nil
+}
+
+// wakeSnoozes removes the snoozes that ended by now from store and posts
+// their comments, recording failures on the woken snooze
+func wakeSnoozes(ctx context.Context, client *api.Client, store *snooze.Store, now time.Time) ([]WokenSnooze, error) {
+	ended, err := store.Wake(now)
+	if err != nil {
+		return nil, err
+	}
+	woken := make([]WokenSnooze, len(ended))
+	for i, s := range ended {
+		woken[i] = WokenSnooze{Snooze: s}
+		if s.Comment == "" {
+			continue
+		}
+		if _, err := client.CreateComment(ctx, s.ID, s.Comment); err != nil {
+			woken[i].Error = err.Error()
+			continue
+		}
+		woken[i].Commented = true
+	}
+	return woken, nil
+}
+
+// printSnoozedHuman renders snoozed issues with when they wake
+func printSnoozedHuman(snoozed []SnoozedIssue) {
+	if len(snoozed) == 0 {
+		output.HumanLn("No snoozed issues")
+		return
+	}
+	output.HumanLn("%s", output.Bold("Snoozed issues (%d)", len(snoozed)))
+	for _, s := range snoozed {
+		wake := output.Muted("wakes %s", s.Until.Local().Format("Mon Jan 02 15:04"))
+		if s.Due {
+			wake = output.Yellow("due since %s", display.TimeAgoShort(s.Until))
+		}
+		note := ""
+		if s.Comment != "" {
+			note = output.Muted(" (comment)")
+		}
+		output.HumanLn("  %-10s %s  %s%s", s.Identifier, display.Truncate(s.Title, 50), wake, note)
+	}
+}
+
+// printWokenHuman lists woken snoozes with the outcome of their comments
+func printWokenHuman(woken []WokenSnooze) {
+	for _, w := range woken {
+		note := ""
+		switch {
+		case w.Error != "":
+			note = output.Red("comment failed: %s", w.Error)
+		case w.Commented:
+			note = output.Muted("commented")
+		}
+		output.HumanLn("  %-10s %s  %s", w.Identifier, display.Truncate(w.Title, 50), note)
+	}
+}
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+)
+
+// sortKey is a --sort order for a list of T
+type sortKey[T any] struct {
+	name    string
+	aliases []string
+	// server is the order passed to the API, which applies it across
+	// pages; empty when only the client can sort
+	server string
+	// compare orders two items in the key's natural direction
+	compare func(a, b T) int
+	// missing reports items without a value, which sort last either way
+	missing func(T) bool
+}
+
+// listSort is a parsed --sort flag
+type listSort[T any] struct {
+	key     *sortKey[T]
+	reverse bool
+}
+
+// parseSort looks value up among keys. An empty value or "manual" keeps
+// the API's order.
+func parseSort[T any](keys []sortKey[T], value string, reverse bool) (listSort[T], error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" || value == "manual" {
+		return listSort[T]{reverse: reverse}, nil
+	}
+	names := []string{}
+	for i := range keys {
+		k := &keys[i]
+		if value == k.name || containsFold(k.aliases, value) {
+			return listSort[T]{key: k, reverse: reverse}, nil
+		}
+		names = append(names, k.name)
+	}
+	return listSort[T]{}, fmt.Errorf("unknown sort order %q (use %s)", value, strings.Join(names, ", "))
+}
+
+// serverOrder is the order to request from the API
+func (s listSort[T]) serverOrder() string {
+	if s.key == nil {
+		return ""
+	}
+	return s.key.server
+}
+
+// local reports whether the client reorders the items, so they cannot be
+// output until every page is fetched
+func (s listSort[T]) local() bool {
+	return s.reverse || (s.key != nil && s.key.server == "")
+}
+
+// apply sorts items in place, keeping the API's order between equal items
+func (s listSort[T]) apply(items []T) {
+	if s.key == nil {
+		if s.reverse {
+			for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+				items[i], items[j] = items[j], items[i]
+			}
+		}
+		return
+	}
+	key := s.key
+	sort.SliceStable(items, func(i, j int) bool {
+		if key.missing != nil {
+			mi, mj := key.missing(items[i]), key.missing(items[j])
+			if mi != mj {
+				return mj
+			}
+			if mi {
+				return false
+			}
+		}
+		c := key.compare(items[i], items[j])
+		if s.reverse {
+			c = -c
+		}
+		return c < 0
+	})
+}
+
+// sortNames lists the keys for flag help
+func sortNames[T any](keys []sortKey[T]) string {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = k.name
+	}
+	return strings.Join(names, ", ")
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// newestFirst orders RFC 3339 timestamps, latest first
+func newestFirst(a, b string) int {
+	return strings.Compare(b, a)
+}
+
+// issueStateRank is the workflow order of issue state types
+var issueStateRank = map[string]int{"triage": 0, "backlog": 1, "unstarted": 2, "started": 3, "completed": 4, "canceled": 5}
+
+// projectStateRank is the order of project status types
+var projectStateRank = map[string]int{"backlog": 0, "planned": 1, "started": 2, "paused": 3, "completed": 4, "canceled": 5}
+
+// issueSortKeys are the --sort orders of issue lists
+var issueSortKeys = []sortKey[api.IssueListItem]{
+	{
+		// Urgent first; no priority (0) sorts last
+		name:    "priority",
+		compare: func(a, b api.IssueListItem) int { return compareInts(a.Priority, b.Priority) },
+		missing: func(i api.IssueListItem) bool { return i.Priority == 0 },
+	},
+	{
+		name:    "updated",
+		aliases: []string{"updatedat"},
+		server:  "updated",
+		compare: func(a, b api.IssueListItem) int { return newestFirst(a.UpdatedAt, b.UpdatedAt) },
+	},
+	{
+		name:    "created",
+		aliases: []string{"createdat"},
+		server:  "created",
+		compare: func(a, b api.IssueListItem) int { return newestFirst(a.CreatedAt, b.CreatedAt) },
+		missing: func(i api.IssueListItem) bool { return i.CreatedAt == "" },
+	},
+	{
+		// Soonest first
+		name:    "due",
+		aliases: []string{"duedate"},
+		compare: func(a, b api.IssueListItem) int { return strings.Compare(a.DueDate, b.DueDate) },
+		missing: func(i api.IssueListItem) bool { return i.DueDate == "" },
+	},
+	{
+		// Largest first
+		name:    "estimate",
+		compare: func(a, b api.IssueListItem) int { return -compareFloats(*a.Estimate, *b.Estimate) },
+		missing: func(i api.IssueListItem) bool { return i.Estimate == nil },
+	},
+	{
+		// Workflow order, from triage to canceled
+		name: "state",
+		compare: func(a, b api.IssueListItem) int {
+			return compareInts(issueStateRank[a.State.Type], issueStateRank[b.State.Type])
+		},
+	},
+}
+
+// projectSortKeys are the --sort orders of project lists
+var projectSortKeys = []sortKey[api.ProjectListItem]{
+	{
+		name:    "updated",
+		aliases: []string{"updatedat"},
+		server:  "updated",
+		compare: func(a, b api.ProjectListItem) int { return newestFirst(a.UpdatedAt, b.UpdatedAt) },
+	},
+	{
+		name:    "created",
+		aliases: []string{"createdat"},
+		server:  "created",
+		compare: func(a, b api.ProjectListItem) int { return newestFirst(a.CreatedAt, b.CreatedAt) },
+		missing: func(p api.ProjectListItem) bool { return p.CreatedAt == "" },
+	},
+	{
+		// Soonest target date first
+		name:    "target",
+		aliases: []string{"targetdate", "due"},
+		compare: func(a, b api.ProjectListItem) int { return strings.Compare(a.TargetDate, b.TargetDate) },
+		missing: func(p api.ProjectListItem) bool { return p.TargetDate == "" },
+	},
+	{
+		name: "name",
+		compare: func(a, b api.ProjectListItem) int {
+			return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+		},
+	},
+	{
+		// Most complete first
+		name:    "progress",
+		compare: func(a, b api.ProjectListItem) int { return -compareFloats(a.Progress, b.Progress) },
+	},
+	{
+		// Status order, from backlog to canceled
+		name:    "state",
+		aliases: []string{"status"},
+		compare: func(a, b api.ProjectListItem) int {
+			return compareInts(projectStateRank[projectStateType(a)], projectStateRank[projectStateType(b)])
+		},
+	},
+}
+
+// documentSortKeys are the --sort orders of document lists
+var documentSortKeys = []sortKey[api.DocumentListItem]{
+	{
+		name:    "updated",
+		aliases: []string{"updatedat"},
+		server:  "updated",
+		compare: func(a, b api.DocumentListItem) int { return newestFirst(a.UpdatedAt, b.UpdatedAt) },
+	},
+	{
+		name:    "created",
+		aliases: []string{"createdat"},
+		server:  "created",
+		compare: func(a, b api.DocumentListItem) int { return newestFirst(a.CreatedAt, b.CreatedAt) },
+		missing: func(d api.DocumentListItem) bool { return d.CreatedAt == "" },
+	},
+	{
+		name:    "title",
+		aliases: []string{"name"},
+		compare: func(a, b api.DocumentListItem) int {
+			return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		},
+	},
+}
+
+// projectStateType is a project's status type, falling back to its state
+func projectStateType(p api.ProjectListItem) string {
+	if p.Status != nil && p.Status.Type != "" {
+		return p.Status.Type
+	}
+	return p.State
+}
@@ -0,0 +1,321 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/dates"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// blockedLabel marks an open issue as blocked even without a blocking relation
+const blockedLabel = "blocked"
+
+// StandupItem is one issue in a standup section
+type StandupItem struct {
+	ID          string   `json:"id"`
+	Identifier  string   `json:"identifier"`
+	Title       string   `json:"title"`
+	URL         string   `json:"url"`
+	State       string   `json:"state"`
+	FromState   string   `json:"fromState,omitempty"`
+	CompletedAt string   `json:"completedAt,omitempty"`
+	Comments    int      `json:"comments,omitempty"`
+	BlockedBy   []string `json:"blockedBy,omitempty"`
+}
+
+// StandupResponse is the response for 'linear standup'
+type StandupResponse struct {
+	User struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"user"`
+	Since      string        `json:"since"`
+	Completed  []StandupItem `json:"completed"`
+	Progressed []StandupItem `json:"progressed"`
+	Commented  []StandupItem `json:"commented"`
+	InProgress []StandupItem `json:"inProgress"`
+	Blocked    []StandupItem `json:"blocked"`
+	Markdown   string        `json:"markdown"`
+}
+
+// NewStandupCmd creates the standup command
+func NewStandupCmd() *cobra.Command {
+	var (
+		user  string
+		since string
+		limit int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "standup",
+		Short: "Summarize recent work for a standup",
+		Long: `Summarize what a user did since the last working day and what they are
+working on now, ready to paste into Slack:
+
+  - issues they completed
+  - issues whose state moved (e.g. Todo → In Review)
+  - issues they commented on
+  - their issues in progress
+  - their open issues that are blocked, by a blocking issue or a
+    "blocked" label
+
+The period starts at the beginning of the previous working day (so a
+Monday standup covers Friday), or at --since. The JSON output includes
+the same summary as markdown in the "markdown" field; --human prints
+just the markdown.
+
+Examples:
+  linear standup --human
+  linear standup --user jane@example.com --since "-3d"
+  linear standup --since 2025-03-10 --jq .markdown | pbcopy`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			start, err := standupSince(since, time.Now())
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("INVALID_DATE", err.Error())
+					return nil
+				}
+				return output.Error("INVALID_DATE", err.Error())
+			}
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			userID, err := resolveUserID(ctx, client, user)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "INVALID_USER")
+					return nil
+				}
+				return output.ErrorFrom(err, "INVALID_USER")
+			}
+
+			standup, err := client.GetStandup(ctx, userID, start.UTC().Format(time.RFC3339), limit)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if standup == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("User '%s' not found", user))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("User '%s' not found", user))
+			}
+
+			resp := buildStandup(standup, start)
+
+			if IsHumanOutput() {
+				output.HumanLn("%s", resp.Markdown)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().StringVarP(&user, "user", "u", "self", "User (name, email, ID or self)")
+	cmd.Flags().StringVar(&since, "since", "", "Start of the period, e.g. yesterday, -3d or 2025-03-10 (default: previous working day)")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 100, "Maximum number of issues and comments to fetch")
+
+	return cmd
+}
+
+// standupSince resolves --since to a time; empty means the start of the
+// previous working day in the configured calendar
+func standupSince(expr string, now time.Time) (time.Time, error) {
+	if expr == "" {
+		cal, err := loadCalendar()
+		if err != nil {
+			return time.Time{}, err
+		}
+		y, m, d := now.Date()
+		return cal.AddBusinessDays(time.Date(y, m, d, 0, 0, 0, 0, now.Location()), -1), nil
+	}
+
+	resolved, err := resolveFilterDate(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if t, err := time.Parse(time.RFC3339, resolved); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation(dates.DateLayout, resolved, now.Location())
+}
+
+// buildStandup sorts the user's issues and comments into standup sections
+func buildStandup(s *api.Standup, since time.Time) *StandupResponse {
+	resp := &StandupResponse{
+		Since:      since.Format(time.RFC3339),
+		Completed:  []StandupItem{},
+		Progressed: []StandupItem{},
+		Commented:  []StandupItem{},
+		InProgress: []StandupItem{},
+		Blocked:    []StandupItem{},
+	}
+	resp.User.ID = s.User.ID
+	resp.User.Name = s.User.Name
+	if resp.User.Name == "" {
+		resp.User.Name = s.User.DisplayName
+	}
+
+	for _, issue := range s.Updated {
+		item := standupItem(issue)
+		if issue.State.Type == "completed" {
+			if completed, err := display.ParseISO(issue.CompletedAt); err == nil && !completed.Before(since) {
+				resp.Completed = append(resp.Completed, item)
+			}
+			continue
+		}
+		if issue.State.Type == "canceled" {
+			continue
+		}
+		// The earliest state change in the period gives where it started
+		var moved *api.StandupHistoryEntry
+		for i, entry := range issue.History.Nodes {
+			at, err := display.ParseISO(entry.CreatedAt)
+			if err != nil || at.Before(since) || entry.ToState == nil {
+				continue
+			}
+			if moved == nil || entry.CreatedAt < moved.CreatedAt {
+				moved = &issue.History.Nodes[i]
+			}
+		}
+		if moved != nil && moved.FromState != nil && moved.FromState.Name != issue.State.Name {
+			item.FromState = moved.FromState.Name
+			resp.Progressed = append(resp.Progressed, item)
+		}
+	}
+
+	commented := map[string]int{}
+	for _, c := range s.Comments {
+		if c.Issue == nil {
+			continue
+		}
+		if _, ok := commented[c.Issue.ID]; !ok {
+			commented[c.Issue.ID] = len(resp.Commented)
+			resp.Commented = append(resp.Commented, StandupItem{
+				ID:         c.Issue.ID,
+				Identifier: c.Issue.Identifier,
+				Title:      c.Issue.Title,
+				URL:        c.Issue.URL,
+				State:      c.Issue.State.Name,
+			})
+		}
+		resp.Commented[commented[c.Issue.ID]].Comments++
+	}
+
+	for _, issue := range s.Open {
+		item := standupItem(issue)
+		if item.BlockedBy = blockedBy(issue); item.BlockedBy != nil {
+			resp.Blocked = append(resp.Blocked, item)
+			continue
+		}
+		if issue.State.Type == "started" {
+			resp.InProgress = append(resp.InProgress, item)
+		}
+	}
+
+	for _, section := range [][]StandupItem{resp.Completed, resp.Progressed, resp.Commented, resp.InProgress, resp.Blocked} {
+		sort.SliceStable(section, func(i, j int) bool { return section[i].Identifier < section[j].Identifier })
+	}
+
+	resp.Markdown = standupMarkdown(resp, since)
+	return resp
+}
+
+func standupItem(issue api.StandupIssue) StandupItem {
+	return StandupItem{
+		ID:          issue.ID,
+		Identifier:  issue.Identifier,
+		Title:       issue.Title,
+		URL:         issue.URL,
+		State:       issue.State.Name,
+		CompletedAt: issue.CompletedAt,
+	}
+}
+
+// blockedBy returns the unfinished issues blocking an issue, or an empty
+// list when it only carries the blocked label; nil means it is not blocked
+func blockedBy(issue api.StandupIssue) []string {
+	var blockers []string
+	for _, rel := range issue.InverseRelations.Nodes {
+		if rel.Type != "blocks" {
+			continue
+		}
+		if t := rel.Issue.State.Type; t == "completed" || t == "canceled" {
+			continue
+		}
+		blockers = append(blockers, rel.Issue.Identifier)
+	}
+	if blockers != nil {
+		return blockers
+	}
+	for _, label := range issue.Labels.Nodes {
+		if strings.EqualFold(label.Name, blockedLabel) {
+			return []string{}
+		}
+	}
+	return nil
+}
+
+// standupMarkdown renders the sections as markdown that pastes cleanly
+// into Slack; empty sections are left out
+func standupMarkdown(resp *StandupResponse, since time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**Standup: %s** (since %s)\n", resp.User.Name, display.FormatDay(since, "Mon Jan 2"))
+
+	section := func(title string, items []StandupItem, note func(StandupItem) string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n**%s**\n", title)
+		for _, item := range items {
+			fmt.Fprintf(&b, "- [%s](%s) %s", item.Identifier, item.URL, item.Title)
+			if n := note(item); n != "" {
+				fmt.Fprintf(&b, " (%s)", n)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	section("Done", resp.Completed, func(StandupItem) string { return "" })
+	section("Moved", resp.Progressed, func(item StandupItem) string {
+		return item.FromState + " → " + item.State
+	})
+	section("Commented on", resp.Commented, func(item StandupItem) string {
+		if item.Comments == 1 {
+			return "1 comment"
+		}
+		return fmt.Sprintf("%d comments", item.Comments)
+	})
+	section("In progress", resp.InProgress, func(item StandupItem) string { return item.State })
+	section("Blocked", resp.Blocked, func(item StandupItem) string {
+		if len(item.BlockedBy) == 0 {
+			return "labeled blocked"
+		}
+		return "blocked by " + strings.Join(item.BlockedBy, ", ")
+	})
+
+	if len(resp.Completed)+len(resp.Progressed)+len(resp.Commented)+len(resp.InProgress)+len(resp.Blocked) == 0 {
+		b.WriteString("\nNo activity\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
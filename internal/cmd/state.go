@@ -0,0 +1,349 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// hexColorPattern matches the #RRGGBB colors Linear accepts
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// NewStateCmd creates the state command group
+func NewStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Create, update and delete workflow states",
+		Long: `Manage a team's workflow states (issue statuses).
+
+Use 'linear workflow list' to list states. States are identified by name
+or ID; the workflow state cache is refreshed after each change.
+
+Examples:
+  linear state create "In Review" --type started --color "#f2c94c" --team ENG
+  linear state update "In Review" --name "Code Review" --team ENG
+  linear state delete "Code Review" --team ENG`,
+	}
+
+	cmd.AddCommand(newStateCreateCmd())
+	cmd.AddCommand(newStateUpdateCmd())
+	cmd.AddCommand(newStateDeleteCmd())
+
+	return cmd
+}
+
+func newStateCreateCmd() *cobra.Command {
+	var (
+		teamKey     string
+		stateType   string
+		color       string
+		description string
+		position    float64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a workflow state",
+		Long: `Create a workflow state for a team.
+
+Type is one of: triage, backlog, unstarted, started, completed, canceled.
+Position orders states within the workflow; without it, Linear places the
+state after the others of its type.
+
+Examples:
+  linear state create "In Review" --type started --color "#f2c94c" --team ENG
+  linear state create "Won't Fix" --type canceled --color "#95a2b3" --position 5`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			stateType = strings.ToLower(stateType)
+			if !containsFold(StateTypes, stateType) {
+				msg := fmt.Sprintf("Invalid state type '%s'", stateType)
+				hint := "Valid types: " + strings.Join(StateTypes, ", ")
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint("INVALID_STATE_TYPE", msg, hint)
+					return nil
+				}
+				return output.ErrorWithHint("INVALID_STATE_TYPE", msg, hint)
+			}
+			if !hexColorPattern.MatchString(color) {
+				return stateColorError(color)
+			}
+
+			client, team, err := stateTeam(ctx, teamKey)
+			if team == nil {
+				return err
+			}
+
+			input := api.WorkflowStateCreateInput{
+				TeamID:      team.ID,
+				Name:        args[0],
+				Type:        stateType,
+				Color:       color,
+				Description: description,
+			}
+			if cmd.Flags().Changed("position") {
+				input.Position = &position
+			}
+
+			state, err := client.CreateWorkflowState(ctx, input)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			clearWorkflowCache(team.ID)
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Created state %s (%s) in %s", state.Name, formatWorkflowType(state.Type), team.Key))
+				output.HumanLn("  ID: %s", output.Muted("%s", state.ID))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "create",
+				"team":      team.Key,
+				"state":     state,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
+	cmd.Flags().StringVar(&stateType, "type", "", "State type: triage, backlog, unstarted, started, completed, canceled (required)")
+	cmd.Flags().StringVarP(&color, "color", "c", "", "Hex color, such as #f2c94c (required)")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "State description")
+	cmd.Flags().Float64Var(&position, "position", 0, "Position in the workflow")
+	cmd.MarkFlagRequired("type")
+	cmd.MarkFlagRequired("color")
+
+	return cmd
+}
+
+func newStateUpdateCmd() *cobra.Command {
+	var (
+		teamKey     string
+		name        string
+		color       string
+		description string
+		position    float64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update <state>",
+		Short: "Update a workflow state",
+		Long: `Update a workflow state's name, color, description or position.
+
+A state's type cannot be changed; create a new state and move issues to it
+instead.
+
+Examples:
+  linear state update "In Review" --name "Code Review" --team ENG
+  linear state update "Code Review" --color "#4ea7fc" --position 3`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var input api.WorkflowStateUpdateInput
+			if cmd.Flags().Changed("name") {
+				input.Name = &name
+			}
+			if cmd.Flags().Changed("color") {
+				if !hexColorPattern.MatchString(color) {
+					return stateColorError(color)
+				}
+				input.Color = &color
+			}
+			if cmd.Flags().Changed("description") {
+				input.Description = &description
+			}
+			if cmd.Flags().Changed("position") {
+				input.Position = &position
+			}
+			if input.Name == nil && input.Color == nil && input.Description == nil && input.Position == nil {
+				msg := "No updates specified. Use --name, --color, --description or --position"
+				if IsHumanOutput() {
+					output.ErrorHuman("NO_UPDATES", msg)
+					return nil
+				}
+				return output.Error("NO_UPDATES", msg)
+			}
+
+			client, team, err := stateTeam(ctx, teamKey)
+			if team == nil {
+				return err
+			}
+
+			current, err := findTeamState(ctx, client, team.ID, args[0])
+			if err != nil {
+				return stateError(err)
+			}
+
+			state, err := client.UpdateWorkflowState(ctx, current.ID, input)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			clearWorkflowCache(team.ID)
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Updated state %s", state.Name))
+				if state.Name != current.Name {
+					output.HumanLn("  %s → %s", current.Name, output.Green("%s", state.Name))
+				}
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "update",
+				"team":      team.Key,
+				"state":     state,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "New name")
+	cmd.Flags().StringVarP(&color, "color", "c", "", "New hex color, such as #4ea7fc")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "New description")
+	cmd.Flags().Float64Var(&position, "position", 0, "New position in the workflow")
+
+	return cmd
+}
+
+func newStateDeleteCmd() *cobra.Command {
+	var teamKey string
+
+	cmd := &cobra.Command{
+		Use:     "delete <state>",
+		Aliases: []string{"archive"},
+		Short:   "Delete (archive) a workflow state",
+		Long: `Delete (archive) a workflow state.
+
+Linear refuses to archive a state that still has issues, or a team's only
+state of its type. Move the issues to another state first.
+
+Examples:
+  linear state delete "Code Review" --team ENG`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, team, err := stateTeam(ctx, teamKey)
+			if team == nil {
+				return err
+			}
+
+			state, err := findTeamState(ctx, client, team.ID, args[0])
+			if err != nil {
+				return stateError(err)
+			}
+
+			if err := client.ArchiveWorkflowState(ctx, state.ID); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			clearWorkflowCache(team.ID)
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Deleted state %s from %s", state.Name, team.Key))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "delete",
+				"team":      team.Key,
+				"stateId":   state.ID,
+				"name":      state.Name,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
+
+	return cmd
+}
+
+// stateTeam creates a client and resolves the --team flag, falling back to
+// the default team. A nil team means the error has been reported and the
+// returned error should be returned from RunE.
+func stateTeam(ctx context.Context, teamKey string) (*api.Client, *api.Team, error) {
+	if teamKey == "" {
+		teamKey = GetTeamID()
+	}
+	if teamKey == "" {
+		if IsHumanOutput() {
+			output.ErrorHuman("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
+			return nil, nil, nil
+		}
+		return nil, nil, output.Error("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
+	}
+
+	client, err := api.NewClient(ctx)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman("AUTH_ERROR", err.Error())
+			return nil, nil, nil
+		}
+		return nil, nil, output.Error("AUTH_ERROR", err.Error())
+	}
+
+	team, err := client.GetTeamByKey(ctx, teamKey)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHumanFrom(err, "API_ERROR")
+			return nil, nil, nil
+		}
+		return nil, nil, output.ErrorFrom(err, "API_ERROR")
+	}
+	if team == nil {
+		if IsHumanOutput() {
+			output.ErrorHuman("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+			return nil, nil, nil
+		}
+		return nil, nil, output.Error("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+	}
+	return client, team, nil
+}
+
+// findTeamState resolves a state name or ID against fresh workflow states,
+// so states created or renamed since the cache was written are found
+func findTeamState(ctx context.Context, client *api.Client, teamID, nameOrID string) (*api.WorkflowState, error) {
+	states, err := client.GetWorkflowStates(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return resolveWorkflowState(states.WorkflowStates, nameOrID, "")
+}
+
+// clearWorkflowCache drops a team's cached workflow states after a change
+func clearWorkflowCache(teamID string) {
+	if cacheManager, _ := cache.NewManager(); cacheManager != nil {
+		cacheManager.Clear(cache.TeamKey("workflows", teamID))
+	}
+}
+
+// stateColorError reports an invalid --color
+func stateColorError(color string) error {
+	msg := fmt.Sprintf("Invalid color '%s'", color)
+	hint := "Use a hex color such as #f2c94c"
+	if IsHumanOutput() {
+		output.ErrorHumanWithHint("INVALID_COLOR", msg, hint)
+		return nil
+	}
+	return output.ErrorWithHint("INVALID_COLOR", msg, hint)
+}
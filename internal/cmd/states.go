@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// StateTypes lists Linear's workflow state types in workflow order
+var StateTypes = []string{"triage", "backlog", "unstarted", "started", "completed", "canceled"}
+
+// isUUID reports whether s looks like a Linear entity ID
+func isUUID(s string) bool {
+	return api.IsUUID(s)
+}
+
+// teamWorkflowStates returns a team's workflow states, using the 24-hour
+// cache shared with 'linear workflow list'
+func teamWorkflowStates(ctx context.Context, client *api.Client, teamID string) (*api.WorkflowStatesResponse, error) {
+	cacheManager, _ := cache.NewManager()
+	cacheKey := cache.TeamKey("workflows", teamID)
+
+	if cacheManager != nil {
+		if cached, _ := cache.Read[api.WorkflowStatesResponse](cacheManager, cacheKey); cached != nil {
+			return cached, nil
+		}
+	}
+
+	states, err := client.GetWorkflowStates(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if cacheManager != nil {
+		cache.Write(cacheManager, cacheKey, *states)
+	}
+	return states, nil
+}
+
+// StateResolveError reports a state name or type that did not resolve to
+// exactly one workflow state
+type StateResolveError struct {
+	Message string
+	Valid   []string
+}
+
+func (e *StateResolveError) Error() string {
+	return e.Message
+}
+
+// Hint lists the valid choices
+func (e *StateResolveError) Hint() string {
+	return "Valid values: " + strings.Join(e.Valid, ", ")
+}
+
+// resolveWorkflowState finds the state matching name (a state ID or name,
+// case-insensitive) or, when name is empty, stateType. A state type
+// resolves to the first state of that type in workflow order.
+func resolveWorkflowState(states []api.WorkflowState, name, stateType string) (*api.WorkflowState, error) {
+	sorted := make([]api.WorkflowState, len(states))
+	copy(sorted, states)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	names := make([]string, len(sorted))
+	for i, s := range sorted {
+		names[i] = s.Name
+	}
+
+	if name != "" {
+		for i, s := range sorted {
+			if s.ID == name {
+				return &sorted[i], nil
+			}
+		}
+
+		var matches []api.WorkflowState
+		for _, s := range sorted {
+			if strings.EqualFold(s.Name, name) {
+				matches = append(matches, s)
+			}
+		}
+		// Fall back to a unique prefix so "prog" finds "In Progress"
+		// only when nothing matches exactly
+		if len(matches) == 0 {
+			for _, s := range sorted {
+				if strings.HasPrefix(strings.ToLower(s.Name), strings.ToLower(name)) {
+					matches = append(matches, s)
+				}
+			}
+		}
+
+		switch len(matches) {
+		case 1:
+			return &matches[0], nil
+		case 0:
+			return nil, &StateResolveError{
+				Message: fmt.Sprintf("No workflow state named '%s'", name),
+				Valid:   names,
+			}
+		default:
+			ambiguous := make([]string, len(matches))
+			for i, s := range matches {
+				ambiguous[i] = s.Name
+			}
+			return nil, &StateResolveError{
+				Message: fmt.Sprintf("State '%s' is ambiguous", name),
+				Valid:   ambiguous,
+			}
+		}
+	}
+
+	stateType = strings.ToLower(stateType)
+	for i, s := range sorted {
+		if s.Type == stateType {
+			return &sorted[i], nil
+		}
+	}
+	return nil, &StateResolveError{
+		Message: fmt.Sprintf("No workflow state of type '%s'", stateType),
+		Valid:   StateTypes,
+	}
+}
+
+// resolveStateID resolves a --state/--state-type pair to a state ID for a
+// team. Raw state IDs are passed through without an API call.
+func resolveStateID(ctx context.Context, client *api.Client, teamID, name, stateType string) (string, error) {
+	if name == "" && stateType == "" {
+		return "", nil
+	}
+	if isUUID(name) {
+		return name, nil
+	}
+
+	states, err := teamWorkflowStates(ctx, client, teamID)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := resolveWorkflowState(states.WorkflowStates, name, stateType)
+	if err != nil {
+		return "", err
+	}
+	return state.ID, nil
+}
+
+// stateError reports a failed state resolution, listing valid choices when
+// the name or type did not match
+func stateError(err error) error {
+	var resolveErr *StateResolveError
+	if errors.As(err, &resolveErr) {
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("INVALID_STATE", resolveErr.Error(), resolveErr.Hint())
+			return nil
+		}
+		return output.ErrorWithHint("INVALID_STATE", resolveErr.Error(), resolveErr.Hint())
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil
+	}
+	return output.ErrorFrom(err, "API_ERROR")
+}
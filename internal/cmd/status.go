@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
 	"github.com/juanbermudez/agent-linear-cli/internal/cache"
@@ -11,33 +13,29 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// ProjectStatusesResponse is the response for project statuses
-type ProjectStatusesResponse struct {
-	ProjectStatuses []ProjectStatus `json:"projectStatuses"`
-	Count           int             `json:"count"`
-}
-
-// ProjectStatus represents a project status
-type ProjectStatus struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Type     string `json:"type"`
-	Position int    `json:"position"`
-}
-
 // NewStatusCmd creates the status command group
 func NewStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status",
-		Short: "Manage project statuses",
-		Long: `List and manage project statuses.
-
-Project statuses are workspace-wide and include states like:
-planned, backlog, started, paused, completed, canceled.
+		Short: "Check the CLI's health, and manage project statuses",
+		Long: `Without a subcommand, check that the CLI can work: authentication,
+token expiry, the organization, the default team, API reachability and
+latency, the rate limit budget, and the freshness of the local cache.
+Each check passes, warns, fails or is skipped when an earlier check it
+depends on failed. The command exits non-zero when any check fails, so
+scripts and agents can run it before starting work.
+
+The list and cache subcommands manage project statuses, which are
+workspace-wide and include states like planned, backlog, started,
+paused, completed, canceled.
 
 Examples:
+  linear status
+  linear status --human
   linear status list
   linear status cache`,
+		Args: cobra.NoArgs,
+		RunE: runStatus,
 	}
 
 	cmd.AddCommand(newStatusListCmd())
@@ -47,8 +45,6 @@ Examples:
 }
 
 func newStatusListCmd() *cobra.Command {
-	var refresh bool
-
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List project statuses",
@@ -62,25 +58,25 @@ Examples:
   linear status list --refresh
   linear status list --human`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
-			var statuses *ProjectStatusesResponse
+			var statuses *api.ProjectStatusesResponse
 
 			// Try cache first
 			cacheManager, _ := cache.NewManager()
 			cacheKey := cache.WorkspaceKey("statuses")
 
-			if !refresh && cacheManager != nil {
-				cached, _ := cache.Read[ProjectStatusesResponse](cacheManager, cacheKey)
+			if cacheManager != nil {
+				cached, _ := cache.Read[api.ProjectStatusesResponse](cacheManager, cacheKey)
 				if cached != nil {
 					statuses = cached
 				}
@@ -88,13 +84,13 @@ Examples:
 
 			// Fetch if not cached
 			if statuses == nil {
-				statuses, err = fetchProjectStatuses(ctx, client)
+				statuses, err = client.GetProjectStatuses(ctx)
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHumanFrom(err, "API_ERROR")
 						return nil
 					}
-					return output.Error("API_ERROR", err.Error())
+					return output.ErrorFrom(err, "API_ERROR")
 				}
 
 				// Cache the results
@@ -118,8 +114,6 @@ Examples:
 		},
 	}
 
-	cmd.Flags().BoolVar(&refresh, "refresh", false, "Bypass cache and fetch fresh data")
-
 	return cmd
 }
 
@@ -134,25 +128,25 @@ This fetches fresh data from Linear and updates the local cache.
 Examples:
   linear status cache`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
 			// Fetch fresh data
-			statuses, err := fetchProjectStatuses(ctx, client)
+			statuses, err := client.GetProjectStatuses(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			// Update cache
@@ -179,42 +173,7 @@ Examples:
 	return cmd
 }
 
-// fetchProjectStatuses fetches project statuses from the API
-// Linear has a fixed set of project statuses
-func fetchProjectStatuses(ctx context.Context, client *api.Client) (*ProjectStatusesResponse, error) {
-	// Project statuses in Linear are fixed/predefined
-	// We query the organization for available project statuses
-	var query struct {
-		ProjectStatuses []struct {
-			ID          string  `graphql:"id"`
-			Name        string  `graphql:"name"`
-			Type        string  `graphql:"type"`
-			Position    float64 `graphql:"position"`
-			Description string  `graphql:"description"`
-		} `graphql:"projectStatuses"`
-	}
-
-	if err := client.Query(ctx, &query, nil); err != nil {
-		return nil, err
-	}
-
-	statuses := make([]ProjectStatus, len(query.ProjectStatuses))
-	for i, s := range query.ProjectStatuses {
-		statuses[i] = ProjectStatus{
-			ID:       s.ID,
-			Name:     s.Name,
-			Type:     s.Type,
-			Position: int(s.Position),
-		}
-	}
-
-	return &ProjectStatusesResponse{
-		ProjectStatuses: statuses,
-		Count:           len(statuses),
-	}, nil
-}
-
-func printProjectStatusesHuman(statuses *ProjectStatusesResponse) {
+func printProjectStatusesHuman(statuses *api.ProjectStatusesResponse) {
 	if len(statuses.ProjectStatuses) == 0 {
 		output.HumanLn("No project statuses found")
 		return
@@ -235,3 +194,81 @@ func printProjectStatusesHuman(statuses *ProjectStatusesResponse) {
 	output.TableWithColors(headers, rows)
 	output.HumanLn("\n%d statuses", statuses.Count)
 }
+
+// projectStatuses returns the workspace's project statuses, using the
+// 24-hour cache shared with 'linear status list'
+func projectStatuses(ctx context.Context, client *api.Client) (*api.ProjectStatusesResponse, error) {
+	cacheManager, _ := cache.NewManager()
+	cacheKey := cache.WorkspaceKey("statuses")
+
+	if cacheManager != nil {
+		if cached, _ := cache.Read[api.ProjectStatusesResponse](cacheManager, cacheKey); cached != nil {
+			return cached, nil
+		}
+	}
+
+	statuses, err := client.GetProjectStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cacheManager != nil {
+		cache.Write(cacheManager, cacheKey, *statuses)
+	}
+	return statuses, nil
+}
+
+// resolveProjectStatusID turns a project status ID, name or type (all
+// case-insensitive) into a status ID. Names take precedence over types;
+// a type resolves only when one status has it.
+func resolveProjectStatusID(ctx context.Context, client *api.Client, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || isUUID(value) {
+		return value, nil
+	}
+
+	statuses, err := projectStatuses(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	sorted := make([]api.ProjectStatus, len(statuses.ProjectStatuses))
+	copy(sorted, statuses.ProjectStatuses)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	names := make([]string, len(sorted))
+	var byType []api.ProjectStatus
+	for i, s := range sorted {
+		if strings.EqualFold(s.Name, value) {
+			return s.ID, nil
+		}
+		if strings.EqualFold(s.Type, value) {
+			byType = append(byType, s)
+		}
+		names[i] = s.Name
+	}
+	if len(byType) == 1 {
+		return byType[0].ID, nil
+	}
+	return "", &StateResolveError{
+		Message: fmt.Sprintf("No project status named '%s'", value),
+		Valid:   names,
+	}
+}
+
+// projectStatusError reports a failed project status resolution, listing
+// the available statuses when the name did not match
+func projectStatusError(err error) error {
+	var resolveErr *StateResolveError
+	if errors.As(err, &resolveErr) {
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("INVALID_STATUS", resolveErr.Error(), resolveErr.Hint())
+			return nil
+		}
+		return output.ErrorWithHint("INVALID_STATUS", resolveErr.Error(), resolveErr.Hint())
+	}
+	if IsHumanOutput() {
+		output.ErrorHumanFrom(err, "API_ERROR")
+		return nil
+	}
+	return output.ErrorFrom(err, "API_ERROR")
+}
@@ -0,0 +1,344 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// syncIssuePageSize is the page size used when pulling assigned issues
+const syncIssuePageSize = 100
+
+// syncCycleLimit is the number of cycles pulled per team
+const syncCycleLimit = 50
+
+// errNotSynced is returned by offline reads when 'linear sync' has not
+// populated the requested data yet
+var errNotSynced = errors.New("no offline data available; run 'linear sync' while online first")
+
+// SyncInfo records what the last sync pulled
+type SyncInfo struct {
+	SyncedAt time.Time      `json:"syncedAt"`
+	Teams    []string       `json:"teams"`
+	ViewerID string         `json:"viewerId"`
+	Counts   map[string]int `json:"counts"`
+}
+
+// SyncResponse is the response for the sync command
+type SyncResponse struct {
+	Success  bool           `json:"success"`
+	SyncedAt time.Time      `json:"syncedAt"`
+	Teams    []string       `json:"teams"`
+	Counts   map[string]int `json:"counts"`
+	Dir      string         `json:"dir"`
+}
+
+// OfflineInfo describes the age of data served in offline mode
+type OfflineInfo struct {
+	SyncedAt time.Time `json:"syncedAt"`
+	Age      string    `json:"age"`
+	Stale    bool      `json:"stale"`
+}
+
+// NewSyncCmd creates the sync command
+func NewSyncCmd() *cobra.Command {
+	var teams []string
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Pull workspace data into the local store for offline use",
+		Long: `Pull teams, workflow states, labels, users, cycles, and your assigned
+issues into the local store.
+
+Read commands accept --offline to use this data instead of calling the
+API. Offline output includes when the data was synced and whether it is
+older than the cache TTL (24h).
+
+Examples:
+  linear sync
+  linear sync --team ENG --team DES
+  linear issue list --offline
+  linear label list --team ENG --offline --human`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			cacheManager, err := cache.NewManager()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CACHE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CACHE_ERROR", err.Error())
+			}
+
+			info, err := runSync(ctx, client, cacheManager, teams)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("SYNC_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("SYNC_ERROR", err.Error())
+			}
+
+			resp := SyncResponse{
+				Success:  true,
+				SyncedAt: info.SyncedAt,
+				Teams:    info.Teams,
+				Counts:   info.Counts,
+				Dir:      cacheManager.Dir(),
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Synced %s", strings.Join(info.Teams, ", ")))
+				for _, key := range []string{"teams", "users", "workflowStates", "labels", "cycles", "issues"} {
+					output.KeyValue(key, fmt.Sprintf("%d", info.Counts[key]))
+				}
+				output.HumanLn("%s", output.Muted("Stored in %s", cacheManager.Dir()))
+			} else {
+				output.JSON(resp)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&teams, "team", "t", nil, "Team key to sync (repeatable, default: all teams)")
+
+	return cmd
+}
+
+// runSync pulls workspace data into the local store
+func runSync(ctx context.Context, client *api.Client, m *cache.Manager, teamKeys []string) (*SyncInfo, error) {
+	ctx = api.Fresh(ctx)
+	info := &SyncInfo{
+		Teams:  []string{},
+		Counts: map[string]int{},
+	}
+
+	allTeams, err := client.GetTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch teams: %w", err)
+	}
+
+	teams := allTeams.Teams
+	if len(teamKeys) > 0 {
+		teams = []api.Team{}
+		for _, key := range teamKeys {
+			team := findTeam(allTeams.Teams, key)
+			if team == nil {
+				return nil, fmt.Errorf("team '%s' not found", key)
+			}
+			teams = append(teams, *team)
+		}
+	}
+
+	if err := cache.Write(m, cache.WorkspaceKey("teams"), allTeams); err != nil {
+		return nil, err
+	}
+	info.Counts["teams"] = len(allTeams.Teams)
+
+	for _, team := range teams {
+		states, err := client.GetWorkflowStates(ctx, team.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch workflow states for %s: %w", team.Key, err)
+		}
+		if err := cache.Write(m, cache.TeamKey("workflows", team.ID), states); err != nil {
+			return nil, err
+		}
+
+		labels, err := client.GetLabels(ctx, team.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch labels for %s: %w", team.Key, err)
+		}
+		if err := cache.Write(m, cache.TeamKey("labels", team.ID), labels); err != nil {
+			return nil, err
+		}
+
+		cycles, err := client.GetCycles(ctx, team.ID, syncCycleLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch cycles for %s: %w", team.Key, err)
+		}
+		if err := cache.Write(m, cache.TeamKey("cycles", team.ID), cycles); err != nil {
+			return nil, err
+		}
+
+		info.Teams = append(info.Teams, team.Key)
+		info.Counts["workflowStates"] += states.Count
+		info.Counts["labels"] += labels.Count
+		info.Counts["cycles"] += cycles.Count
+	}
+
+	users, err := client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	if err := cache.Write(m, cache.WorkspaceKey("users"), users); err != nil {
+		return nil, err
+	}
+	info.Counts["users"] = users.Count
+
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch viewer: %w", err)
+	}
+	if err := cache.Write(m, cache.WorkspaceKey("viewer"), viewer); err != nil {
+		return nil, err
+	}
+	info.ViewerID = viewer.Viewer.ID
+
+	filter := api.IssueFilter{
+		AssigneeID: viewer.Viewer.ID,
+		StateTypes: []string{"triage", "backlog", "unstarted", "started"},
+	}
+	issues, _, err := collectPages("", true, func(after string) ([]api.IssueListItem, *api.PageInfo, error) {
+		page, err := client.GetIssues(ctx, filter, syncIssuePageSize, "updated", after)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Issues, page.PageInfo, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch assigned issues: %w", err)
+	}
+	if err := cache.Write(m, cache.WorkspaceKey("my-issues"), api.IssuesResponse{Issues: issues, Count: len(issues)}); err != nil {
+		return nil, err
+	}
+	info.Counts["issues"] = len(issues)
+
+	info.SyncedAt = time.Now()
+	if err := cache.Write(m, cache.WorkspaceKey("sync"), info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// findTeam returns the team matching a key or ID, case-insensitively
+func findTeam(teams []api.Team, key string) *api.Team {
+	for i, t := range teams {
+		if strings.EqualFold(t.Key, key) || t.ID == key {
+			return &teams[i]
+		}
+	}
+	return nil
+}
+
+// readOffline reads synced data from the local store regardless of age
+func readOffline[T any](key string) (*T, *OfflineInfo, error) {
+	m, err := cache.NewManager()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entry, err := cache.ReadEntry[T](m, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if entry == nil {
+		return nil, nil, errNotSynced
+	}
+
+	return &entry.Data, &OfflineInfo{
+		SyncedAt: entry.Timestamp,
+		Age:      display.TimeAgo(entry.Timestamp),
+		Stale:    time.Since(entry.Timestamp) > m.TTL(),
+	}, nil
+}
+
+// offlineTeam resolves a team key from the synced team list
+func offlineTeam(key string) (*api.Team, error) {
+	teams, _, err := readOffline[api.TeamsResponse](cache.WorkspaceKey("teams"))
+	if err != nil {
+		return nil, err
+	}
+	team := findTeam(teams.Teams, key)
+	if team == nil {
+		return nil, fmt.Errorf("team '%s' not found in offline data", key)
+	}
+	return team, nil
+}
+
+// filterOfflineIssues narrows synced issues to a team key and, when given,
+// a set of state types
+func filterOfflineIssues(issues []api.IssueListItem, teamKey string, stateTypes []string) []api.IssueListItem {
+	prefix := strings.ToUpper(teamKey) + "-"
+	filtered := []api.IssueListItem{}
+	for _, issue := range issues {
+		if !strings.HasPrefix(strings.ToUpper(issue.Identifier), prefix) {
+			continue
+		}
+		if len(stateTypes) > 0 && !containsFold(stateTypes, issue.State.Type) {
+			continue
+		}
+		filtered = append(filtered, issue)
+	}
+	return filtered
+}
+
+// containsFold reports whether list contains s, case-insensitively
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// offlineError reports a failed offline read
+func offlineError(err error) error {
+	if IsHumanOutput() {
+		if errors.Is(err, errNotSynced) {
+			output.ErrorHumanWithHint("OFFLINE_UNAVAILABLE", err.Error(), "Populate the local store, then retry with --offline.", "linear sync")
+			return nil
+		}
+		output.ErrorHuman("OFFLINE_ERROR", err.Error())
+		return nil
+	}
+	if errors.Is(err, errNotSynced) {
+		return output.ErrorWithHint("OFFLINE_UNAVAILABLE", err.Error(), "Populate the local store, then retry with --offline.", "linear sync")
+	}
+	return output.Error("OFFLINE_ERROR", err.Error())
+}
+
+// outputOfflineJSON writes data as JSON with an added "offline" key
+// describing its age
+func outputOfflineJSON(data interface{}, info *OfflineInfo) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return err
+	}
+	obj["offline"] = info
+	return output.JSON(obj)
+}
+
+// printOfflineNoticeHuman prints when offline data was synced
+func printOfflineNoticeHuman(info *OfflineInfo) {
+	if info.Stale {
+		output.HumanLn("%s", output.Yellow("Offline data synced %s (stale, run 'linear sync')", info.Age))
+		return
+	}
+	output.HumanLn("%s", output.Muted("Offline data synced %s", info.Age))
+}
@@ -2,9 +2,13 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
 	"github.com/juanbermudez/agent-linear-cli/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -15,14 +19,20 @@ func NewTeamCmd() *cobra.Command {
 		Use:     "team",
 		Aliases: []string{"t"},
 		Short:   "Manage Linear teams",
-		Long: `List and manage Linear teams in your workspace.
+		Long: `List and inspect Linear teams in your workspace.
 
 Examples:
   linear team list
-  linear team list --human`,
+  linear team list --human
+  linear team view ENG --human
+  linear team members ENG
+  linear team states ENG`,
 	}
 
 	cmd.AddCommand(newTeamListCmd())
+	cmd.AddCommand(newTeamViewCmd())
+	cmd.AddCommand(newTeamMembersCmd())
+	cmd.AddCommand(newTeamStatesCmd())
 
 	return cmd
 }
@@ -40,12 +50,28 @@ Examples:
   linear team list
   linear team list --human`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			if IsOffline() {
+				teams, info, err := readOffline[api.TeamsResponse](cache.WorkspaceKey("teams"))
+				if err != nil {
+					return offlineError(err)
+				}
+				sort.Slice(teams.Teams, func(i, j int) bool {
+					return teams.Teams[i].Name < teams.Teams[j].Name
+				})
+				if IsHumanOutput() {
+					printTeamsHuman(teams)
+					printOfflineNoticeHuman(info)
+					return nil
+				}
+				return outputOfflineJSON(teams, info)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -54,10 +80,10 @@ Examples:
 			teams, err := client.GetTeams(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			// Sort teams alphabetically by name
@@ -98,3 +124,196 @@ func printTeamsHuman(teams *api.TeamsResponse) {
 	output.TableWithColors(headers, rows)
 	output.HumanLn("\n%d teams", teams.Count)
 }
+
+// fetchTeamDetail loads a team by key, reporting auth, API and not-found
+// errors itself. A nil team means the error has been reported and the
+// returned error should be returned from RunE.
+func fetchTeamDetail(ctx context.Context, key string) (*api.TeamDetail, error) {
+	client, err := api.NewClient(ctx)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman("AUTH_ERROR", err.Error())
+			return nil, nil
+		}
+		return nil, output.Error("AUTH_ERROR", err.Error())
+	}
+
+	team, err := client.GetTeamDetail(ctx, strings.ToUpper(key))
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHumanFrom(err, "API_ERROR")
+			return nil, nil
+		}
+		return nil, output.ErrorFrom(err, "API_ERROR")
+	}
+	if team == nil {
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", key), "List team keys with 'linear team list'")
+			return nil, nil
+		}
+		return nil, output.ErrorWithHint("TEAM_NOT_FOUND", fmt.Sprintf("Team '%s' not found", key), "List team keys with 'linear team list'")
+	}
+
+	sort.Slice(team.States, func(i, j int) bool {
+		return team.States[i].Position < team.States[j].Position
+	})
+	sort.Slice(team.Members, func(i, j int) bool {
+		return strings.ToLower(team.Members[i].DisplayName) < strings.ToLower(team.Members[j].DisplayName)
+	})
+	return team, nil
+}
+
+func newTeamViewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view <key>",
+		Short: "View team details",
+		Long: `View a team's settings, active cycle, label count, members and workflow
+states.
+
+Settings include cycle length, estimation type (notUsed, exponential,
+fibonacci, linear or tShirt), triage and auto-archive/close periods.
+
+Examples:
+  linear team view ENG
+  linear team view ENG --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			team, err := fetchTeamDetail(cmd.Context(), args[0])
+			if team == nil {
+				return err
+			}
+
+			if IsHumanOutput() {
+				printTeamDetailHuman(team)
+				return nil
+			}
+			return output.JSON(team)
+		},
+	}
+
+	return cmd
+}
+
+func newTeamMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members <key>",
+		Short: "List team members",
+		Long: `List the members of a team.
+
+Examples:
+  linear team members ENG
+  linear team members ENG --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			team, err := fetchTeamDetail(cmd.Context(), args[0])
+			if team == nil {
+				return err
+			}
+
+			response := &UserListResponse{Users: team.Members, Count: len(team.Members)}
+			if IsHumanOutput() {
+				output.HumanLn("Members of team %s:\n", team.Key)
+				printUsersHuman(response)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	return cmd
+}
+
+func newTeamStatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "states <key>",
+		Short: "List a team's workflow states",
+		Long: `List a team's workflow states in board order. Unlike 'workflow list',
+this always fetches fresh data.
+
+Examples:
+  linear team states ENG
+  linear team states ENG --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			team, err := fetchTeamDetail(cmd.Context(), args[0])
+			if team == nil {
+				return err
+			}
+
+			response := &api.WorkflowStatesResponse{WorkflowStates: team.States, Count: len(team.States)}
+			if IsHumanOutput() {
+				printWorkflowStatesHuman(response, team.Key)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	return cmd
+}
+
+func printTeamDetailHuman(team *api.TeamDetail) {
+	output.HumanLn("%s %s", output.Bold("%s", team.Key), team.Name)
+	if team.Description != "" {
+		output.HumanLn("%s", team.Description)
+	}
+	output.HumanLn("")
+
+	visibility := "Public"
+	if team.Private {
+		visibility = "Private"
+	}
+	output.HumanLn("%s: %s", output.Bold("Visibility"), visibility)
+	output.HumanLn("%s: %s", output.Bold("Timezone"), team.Timezone)
+
+	settings := team.Settings
+	estimation := settings.EstimationType
+	if settings.EstimationExtended {
+		estimation += " (extended)"
+	}
+	output.HumanLn("%s: %s", output.Bold("Estimates"), estimation)
+	output.HumanLn("%s: %s", output.Bold("Triage"), display.BoolToCheckmark(settings.TriageEnabled))
+
+	if settings.CyclesEnabled {
+		output.HumanLn("%s: every %d weeks", output.Bold("Cycles"), settings.CycleDuration)
+	} else {
+		output.HumanLn("%s: disabled", output.Bold("Cycles"))
+	}
+	if c := team.ActiveCycle; c != nil {
+		label := fmt.Sprintf("Cycle %d", c.Number)
+		if c.Name != "" {
+			label = c.Name
+		}
+		ends := ""
+		if t, err := display.ParseISO(c.EndsAt); err == nil {
+			ends = "ends " + display.FormatDay(t, "Jan 02")
+		}
+		output.HumanLn("%s: %s %s %3.0f%%  %s", output.Bold("Active cycle"), label,
+			progressBar(c.Progress, dashboardBarWidth), c.Progress*100, output.Muted("%s", ends))
+	}
+
+	archive := fmt.Sprintf("after %g months", settings.AutoArchiveMonths)
+	if settings.AutoArchiveMonths == 0 {
+		archive = "never"
+	}
+	closeAfter := "never"
+	if settings.AutoCloseMonths != nil && *settings.AutoCloseMonths > 0 {
+		closeAfter = fmt.Sprintf("after %g months", *settings.AutoCloseMonths)
+	}
+	output.HumanLn("%s: archive %s, close stale issues %s", output.Bold("Automation"), archive, closeAfter)
+	output.HumanLn("%s: %d", output.Bold("Labels"), team.LabelCount)
+
+	output.HumanLn("\n%s", output.Bold("Workflow (%d states)", len(team.States)))
+	for _, s := range team.States {
+		output.HumanLn("  %s", formatWorkflowType(s.Type)+"  "+s.Name)
+	}
+
+	output.HumanLn("\n%s", output.Bold("Members (%d)", len(team.Members)))
+	for _, m := range team.Members {
+		line := fmt.Sprintf("  %-24s %s", m.DisplayName, output.Muted("%s", m.Email))
+		if m.Admin {
+			line += " " + output.Yellow("admin")
+		}
+		output.HumanLn("%s", line)
+	}
+}
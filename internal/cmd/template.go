@@ -0,0 +1,342 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/templates"
+	"github.com/spf13/cobra"
+)
+
+// NewTemplateCmd creates the template command group
+func NewTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "template",
+		Aliases: []string{"tpl"},
+		Short:   "Manage local issue and comment templates",
+		Long: `Manage local markdown templates for issues and comments.
+
+Templates are Go templates stored in ~/.config/agent-linear-cli/templates
+(override with LINEAR_TEMPLATES_DIR). Issue fields such as {{.Identifier}},
+{{.Title}}, {{.Assignee}}, {{.State}} and {{.URL}} are available, as are
+variables passed with --var (e.g., {{.version}}).
+
+Examples:
+  linear template list --kind comment
+  linear template create deploy-done --kind comment --body "Deployed {{.Identifier}} in v{{.version}}"
+  linear issue comment create ENG-123 --template deploy-done --var version=1.4.2`,
+	}
+
+	cmd.AddCommand(newTemplateListCmd())
+	cmd.AddCommand(newTemplateViewCmd())
+	cmd.AddCommand(newTemplateCreateCmd())
+	cmd.AddCommand(newTemplateDeleteCmd())
+
+	return cmd
+}
+
+func newTemplateListCmd() *cobra.Command {
+	var kind string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List templates",
+		Long: `List stored templates.
+
+Examples:
+  linear template list
+  linear template list --kind comment`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := templateStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("TEMPLATE_ERROR", err.Error())
+			}
+
+			kinds := templates.Kinds
+			if kind != "" {
+				kinds = []string{kind}
+			}
+
+			list := []templates.Template{}
+			for _, k := range kinds {
+				items, err := store.List(k)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+						return nil
+					}
+					return output.Error("TEMPLATE_ERROR", err.Error())
+				}
+				list = append(list, items...)
+			}
+
+			if IsHumanOutput() {
+				if len(list) == 0 {
+					output.HumanLn("No templates found in %s", store.Dir())
+					return nil
+				}
+				headers := []string{"KIND", "NAME", "PATH"}
+				rows := make([][]string, len(list))
+				for i, t := range list {
+					rows[i] = []string{t.Kind, t.Name, output.Muted("%s", t.Path)}
+				}
+				output.TableWithColors(headers, rows)
+				output.HumanLn("\n%d templates", len(list))
+			} else {
+				output.JSON(map[string]interface{}{
+					"templates": list,
+					"count":     len(list),
+					"dir":       store.Dir(),
+				})
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&kind, "kind", "k", "", "Template kind (issue, comment)")
+
+	return cmd
+}
+
+func newTemplateViewCmd() *cobra.Command {
+	var kind string
+
+	cmd := &cobra.Command{
+		Use:   "view <name>",
+		Short: "Show a template",
+		Long: `Show the body of a template.
+
+Examples:
+  linear template view deploy-done --kind comment`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			tmpl, err := loadTemplate(kind, name)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("TEMPLATE_ERROR", err.Error())
+			}
+			if tmpl == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Template '%s' not found", name))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Template '%s' not found", name))
+			}
+
+			if IsHumanOutput() {
+				output.HumanLn("%s %s", output.Bold("%s", tmpl.Name), output.Muted("(%s)", tmpl.Path))
+				output.HumanLn("")
+				output.HumanLn("%s", tmpl.Body)
+			} else {
+				output.JSON(tmpl)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&kind, "kind", "k", templates.KindComment, "Template kind (issue, comment)")
+
+	return cmd
+}
+
+func newTemplateCreateCmd() *cobra.Command {
+	var (
+		kind string
+		body string
+		file string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create or replace a template",
+		Long: `Create or replace a template.
+
+Examples:
+  linear template create deploy-done --kind comment --body "Deployed in v{{.version}}"
+  linear template create bug --kind issue --file ./bug.md`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			if file != "" {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("FILE_ERROR", err.Error())
+						return nil
+					}
+					return output.Error("FILE_ERROR", err.Error())
+				}
+				body = string(data)
+			}
+
+			if body == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("MISSING_BODY", "Template body is required. Use --body or --file flag.")
+					return nil
+				}
+				return output.Error("MISSING_BODY", "Template body is required. Use --body or --file flag.")
+			}
+
+			store, err := templateStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("TEMPLATE_ERROR", err.Error())
+			}
+
+			tmpl, err := store.Save(kind, name, body)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("TEMPLATE_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Template saved: %s", tmpl.Name))
+				output.HumanLn("  Path: %s", tmpl.Path)
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":   true,
+					"operation": "create",
+					"template":  tmpl,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&kind, "kind", "k", templates.KindComment, "Template kind (issue, comment)")
+	cmd.Flags().StringVarP(&body, "body", "b", "", "Template body")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Read template body from file")
+
+	return cmd
+}
+
+func newTemplateDeleteCmd() *cobra.Command {
+	var kind string
+
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a template",
+		Long: `Delete a template.
+
+Examples:
+  linear template delete deploy-done --kind comment`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			store, err := templateStore()
+			if err == nil {
+				err = store.Delete(kind, name)
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("TEMPLATE_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("TEMPLATE_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Template deleted: %s", name))
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":   true,
+					"operation": "delete",
+					"kind":      kind,
+					"name":      name,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&kind, "kind", "k", templates.KindComment, "Template kind (issue, comment)")
+
+	return cmd
+}
+
+// templateStore returns the store in templates_dir when configured, and
+// the default store otherwise. LINEAR_TEMPLATES_DIR takes precedence over
+// both.
+func templateStore() (*templates.Store, error) {
+	if dir := loadConfig().TemplatesDir; dir != "" && os.Getenv("LINEAR_TEMPLATES_DIR") == "" {
+		return templates.NewStoreIn(dir), nil
+	}
+	return templates.NewStore()
+}
+
+// loadTemplate reads a template from the configured store
+func loadTemplate(kind, name string) (*templates.Template, error) {
+	store, err := templateStore()
+	if err != nil {
+		return nil, err
+	}
+	return store.Get(kind, name)
+}
+
+// issueTemplateData builds the data available to templates rendered for an
+// issue. Variables are added first so issue fields always take precedence;
+// all variables are also reachable under .Vars.
+func issueTemplateData(issue *api.IssueDetail, vars map[string]string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for k, v := range vars {
+		data[k] = v
+	}
+	data["Vars"] = vars
+
+	if issue == nil {
+		return data
+	}
+
+	assignee := ""
+	if issue.Assignee != nil {
+		assignee = issue.Assignee.DisplayName
+	}
+	project := ""
+	if issue.Project != nil {
+		project = issue.Project.Name
+	}
+	labels := make([]string, len(issue.Labels))
+	for i, l := range issue.Labels {
+		labels[i] = l.Name
+	}
+
+	data["ID"] = issue.ID
+	data["Identifier"] = issue.Identifier
+	data["Title"] = issue.Title
+	data["Description"] = issue.Description
+	data["URL"] = issue.URL
+	data["BranchName"] = issue.BranchName
+	data["State"] = issue.State.Name
+	data["Assignee"] = assignee
+	data["Team"] = issue.Team.Key
+	data["Project"] = project
+	data["Priority"] = issue.Priority
+	data["DueDate"] = issue.DueDate
+	data["Labels"] = strings.Join(labels, ", ")
+
+	return data
+}
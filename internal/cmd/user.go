@@ -1,7 +1,7 @@
 package cmd
 
 import (
-	"context"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -31,15 +31,20 @@ func NewUserCmd() *cobra.Command {
 		Use:     "user",
 		Aliases: []string{"u"},
 		Short:   "Manage Linear users",
-		Long: `List and search users in your Linear workspace.
+		Long: `List, search and view users in your Linear workspace.
+
+--format mention prints each user's @-mention for use in comment and
+issue markdown.
 
 Examples:
   linear user list
-  linear user search "john"`,
+  linear user search "john"
+  linear user view john@example.com --format mention`,
 	}
 
 	cmd.AddCommand(newUserListCmd())
 	cmd.AddCommand(newUserSearchCmd())
+	cmd.AddCommand(newUserViewCmd())
 
 	return cmd
 }
@@ -48,7 +53,7 @@ func newUserListCmd() *cobra.Command {
 	var (
 		activeOnly bool
 		adminsOnly bool
-		refresh    bool
+		domains    []string
 	)
 
 	cmd := &cobra.Command{
@@ -62,14 +67,35 @@ Examples:
   linear user list
   linear user list --active-only
   linear user list --admins-only
+  linear user list --domain example.com --domain example.org
+  linear user list --active-only --format mention
   linear user list --refresh`,
+		Annotations: map[string]string{namedFormatsAnnotation: "mention"},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			if IsOffline() {
+				users, info, err := readOffline[api.UsersResponse](cache.WorkspaceKey("users"))
+				if err != nil {
+					return offlineError(err)
+				}
+				response := newUserListResponse(users.Users, activeOnly, adminsOnly, domains)
+				if namedFormat(cmd) == "mention" {
+					printUserMentions(response.Users)
+					return nil
+				}
+				if IsHumanOutput() {
+					printUsersHuman(response)
+					printOfflineNoticeHuman(info)
+					return nil
+				}
+				return outputOfflineJSON(response, info)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -81,7 +107,7 @@ Examples:
 			cacheManager, _ := cache.NewManager()
 			cacheKey := cache.WorkspaceKey("users")
 
-			if !refresh && cacheManager != nil {
+			if cacheManager != nil {
 				cached, _ := cache.Read[api.UsersResponse](cacheManager, cacheKey)
 				if cached != nil {
 					users = cached
@@ -93,10 +119,10 @@ Examples:
 				users, err = client.GetUsers(ctx)
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHumanFrom(err, "API_ERROR")
 						return nil
 					}
-					return output.Error("API_ERROR", err.Error())
+					return output.ErrorFrom(err, "API_ERROR")
 				}
 
 				// Cache the results
@@ -105,19 +131,12 @@ Examples:
 				}
 			}
 
-			// Apply filters
-			filteredUsers := filterUsers(users.Users, activeOnly, adminsOnly)
+			response := newUserListResponse(users.Users, activeOnly, adminsOnly, domains)
 
-			// Sort by display name
-			sort.Slice(filteredUsers, func(i, j int) bool {
-				return filteredUsers[i].DisplayName < filteredUsers[j].DisplayName
-			})
-
-			response := &UserListResponse{
-				Users: filteredUsers,
-				Count: len(filteredUsers),
+			if namedFormat(cmd) == "mention" {
+				printUserMentions(response.Users)
+				return nil
 			}
-
 			if IsHumanOutput() {
 				printUsersHuman(response)
 			} else {
@@ -130,7 +149,7 @@ Examples:
 
 	cmd.Flags().BoolVar(&activeOnly, "active-only", false, "Show only active users")
 	cmd.Flags().BoolVar(&adminsOnly, "admins-only", false, "Show only admin users")
-	cmd.Flags().BoolVar(&refresh, "refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().StringArrayVar(&domains, "domain", nil, "Show only users with emails in this domain (repeatable)")
 
 	return cmd
 }
@@ -138,7 +157,7 @@ Examples:
 func newUserSearchCmd() *cobra.Command {
 	var (
 		activeOnly bool
-		refresh    bool
+		domains    []string
 	)
 
 	cmd := &cobra.Command{
@@ -151,16 +170,18 @@ The search is case-insensitive and matches partial strings.
 Examples:
   linear user search "john"
   linear user search "example.com"
-  linear user search "john" --active-only`,
-		Args: cobra.ExactArgs(1),
+  linear user search "john" --active-only
+  linear user search "john" --format mention`,
+		Annotations: map[string]string{namedFormatsAnnotation: "mention"},
+		Args:        cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			query := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -172,7 +193,7 @@ Examples:
 			cacheManager, _ := cache.NewManager()
 			cacheKey := cache.WorkspaceKey("users")
 
-			if !refresh && cacheManager != nil {
+			if cacheManager != nil {
 				cached, _ := cache.Read[api.UsersResponse](cacheManager, cacheKey)
 				if cached != nil {
 					users = cached
@@ -184,10 +205,10 @@ Examples:
 				users, err = client.GetUsers(ctx)
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHumanFrom(err, "API_ERROR")
 						return nil
 					}
-					return output.Error("API_ERROR", err.Error())
+					return output.ErrorFrom(err, "API_ERROR")
 				}
 
 				// Cache the results
@@ -198,9 +219,7 @@ Examples:
 
 			// Search and filter
 			matchedUsers := searchUsers(users.Users, query)
-			if activeOnly {
-				matchedUsers = filterUsers(matchedUsers, true, false)
-			}
+			matchedUsers = filterUsers(matchedUsers, activeOnly, false, domains)
 
 			// Sort by display name
 			sort.Slice(matchedUsers, func(i, j int) bool {
@@ -213,6 +232,10 @@ Examples:
 				Query: query,
 			}
 
+			if namedFormat(cmd) == "mention" {
+				printUserMentions(response.Users)
+				return nil
+			}
 			if IsHumanOutput() {
 				printUserSearchHuman(response)
 			} else {
@@ -224,13 +247,144 @@ Examples:
 	}
 
 	cmd.Flags().BoolVar(&activeOnly, "active-only", false, "Show only active users")
-	cmd.Flags().BoolVar(&refresh, "refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().StringArrayVar(&domains, "domain", nil, "Show only users with emails in this domain (repeatable)")
+
+	return cmd
+}
+
+func newUserViewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view <user>",
+		Short: "View a user",
+		Long: `View a user by email, name, display name, ID, or "me".
+
+Examples:
+  linear user view me
+  linear user view john@example.com
+  linear user view "John Doe" --format mention`,
+		Annotations: map[string]string{namedFormatsAnnotation: "mention"},
+		Args:        cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			users, err := workspaceUsers(ctx, client)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			var user *api.User
+			if value := args[0]; value == "me" || value == "self" || isUUID(value) {
+				if !isUUID(value) {
+					if value, err = client.GetViewerID(ctx); err != nil {
+						if IsHumanOutput() {
+							output.ErrorHumanFrom(err, "API_ERROR")
+							return nil
+						}
+						return output.ErrorFrom(err, "API_ERROR")
+					}
+				}
+				for i, u := range users.Users {
+					if u.ID == value {
+						user = &users.Users[i]
+						break
+					}
+				}
+			} else {
+				user = findUser(users.Users, value)
+			}
+			if user == nil {
+				msg := fmt.Sprintf("User '%s' not found", args[0])
+				hint := "Find users with 'linear user search <name>'"
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint("NOT_FOUND", msg, hint)
+					return nil
+				}
+				return output.ErrorWithHint("NOT_FOUND", msg, hint)
+			}
+
+			if namedFormat(cmd) == "mention" {
+				printUserMentions([]api.User{*user})
+				return nil
+			}
+			if IsHumanOutput() {
+				printUserHuman(user)
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"user":    user,
+				"mention": userMention(*user),
+			})
+		},
+	}
 
 	return cmd
 }
 
-func filterUsers(users []api.User, activeOnly, adminsOnly bool) []api.User {
-	if !activeOnly && !adminsOnly {
+// userMention returns the markdown that mentions a user in Linear: their
+// profile URL, which Linear renders as an @-mention. Users cached before
+// profile URLs were fetched fall back to @displayName.
+func userMention(u api.User) string {
+	if u.URL != "" {
+		return u.URL
+	}
+	return "@" + u.DisplayName
+}
+
+// printUserMentions prints one @-mention per line
+func printUserMentions(users []api.User) {
+	for _, u := range users {
+		fmt.Println(userMention(u))
+	}
+}
+
+func printUserHuman(u *api.User) {
+	output.HumanLn("%s", output.Bold("%s", u.DisplayName))
+	output.HumanLn("")
+	output.HumanLn("  Name:    %s", u.Name)
+	output.HumanLn("  Email:   %s", u.Email)
+	status := "Active"
+	if !u.Active {
+		status = output.Muted("Inactive")
+	}
+	output.HumanLn("  Status:  %s", status)
+	if u.Admin {
+		output.HumanLn("  Admin:   %s", display.BoolToCheckmark(true))
+	}
+	output.HumanLn("  Mention: %s", userMention(*u))
+	output.HumanLn("  ID:      %s", output.Muted("%s", u.ID))
+}
+
+// newUserListResponse filters users and sorts them by display name
+func newUserListResponse(users []api.User, activeOnly, adminsOnly bool, domains []string) *UserListResponse {
+	filteredUsers := filterUsers(users, activeOnly, adminsOnly, domains)
+
+	sort.Slice(filteredUsers, func(i, j int) bool {
+		return filteredUsers[i].DisplayName < filteredUsers[j].DisplayName
+	})
+
+	return &UserListResponse{
+		Users: filteredUsers,
+		Count: len(filteredUsers),
+	}
+}
+
+// filterUsers keeps active users, admins, or users whose email is in one
+// of domains, as requested
+func filterUsers(users []api.User, activeOnly, adminsOnly bool, domains []string) []api.User {
+	if !activeOnly && !adminsOnly && len(domains) == 0 {
 		return users
 	}
 
@@ -242,11 +396,30 @@ func filterUsers(users []api.User, activeOnly, adminsOnly bool) []api.User {
 		if adminsOnly && !u.Admin {
 			continue
 		}
+		if len(domains) > 0 && !emailInDomains(u.Email, domains) {
+			continue
+		}
 		filtered = append(filtered, u)
 	}
 	return filtered
 }
 
+// emailInDomains reports whether email's domain is one of domains, or a
+// subdomain of one
+func emailInDomains(email string, domains []string) bool {
+	_, domain, ok := strings.Cut(strings.ToLower(email), "@")
+	if !ok {
+		return false
+	}
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d), "@"))
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
 func searchUsers(users []api.User, query string) []api.User {
 	query = strings.ToLower(query)
 	matched := make([]api.User, 0)
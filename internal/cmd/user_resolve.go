@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/cache"
+)
+
+// maxUserSuggestions caps how many close matches an error suggests
+const maxUserSuggestions = 3
+
+// workspaceUsers returns the workspace's users, using the 24-hour cache
+// shared with 'linear user list'
+func workspaceUsers(ctx context.Context, client *api.Client) (*api.UsersResponse, error) {
+	cacheManager, _ := cache.NewManager()
+	cacheKey := cache.WorkspaceKey("users")
+
+	if cacheManager != nil {
+		if cached, _ := cache.Read[api.UsersResponse](cacheManager, cacheKey); cached != nil {
+			return cached, nil
+		}
+	}
+
+	users, err := client.GetUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cacheManager != nil {
+		cache.Write(cacheManager, cacheKey, *users)
+	}
+	return users, nil
+}
+
+// resolveUserID turns "self"/"me", a user ID, an email, or a name or
+// display name (case-insensitive) into a user ID
+func resolveUserID(ctx context.Context, client *api.Client, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	if value == "self" || value == "me" {
+		return client.GetViewerID(ctx)
+	}
+	if isUUID(value) {
+		return value, nil
+	}
+
+	users, err := workspaceUsers(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	if user := findUser(users.Users, value); user != nil {
+		return user.ID, nil
+	}
+
+	candidates := []string{}
+	for _, u := range searchUsers(users.Users, value) {
+		if len(candidates) == maxUserSuggestions {
+			break
+		}
+		candidates = append(candidates, u.Email)
+	}
+	if len(candidates) > 0 {
+		return "", fmt.Errorf("no user matching '%s' (did you mean %s?)", value, strings.Join(candidates, ", "))
+	}
+	return "", fmt.Errorf("no user matching '%s'", value)
+}
+
+// findUser returns the user whose email, name or display name equals value,
+// case-insensitively
+func findUser(users []api.User, value string) *api.User {
+	for i, u := range users {
+		if strings.EqualFold(u.Email, value) || strings.EqualFold(u.Name, value) || strings.EqualFold(u.DisplayName, value) {
+			return &users[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// ViewListResponse is the response for the view list command
+type ViewListResponse struct {
+	Views    []api.CustomView `json:"views"`
+	Count    int              `json:"count"`
+	PageInfo *api.PageInfo    `json:"pageInfo,omitempty"`
+}
+
+// ViewIssuesResponse is the response for the view issues command
+type ViewIssuesResponse struct {
+	View     *api.CustomView     `json:"view"`
+	Issues   []api.IssueListItem `json:"issues"`
+	Count    int                 `json:"count"`
+	PageInfo *api.PageInfo       `json:"pageInfo,omitempty"`
+}
+
+// NewViewCmd creates the view command group
+func NewViewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Use custom views saved in Linear",
+		Long: `List custom views and fetch the issues they match, reusing filters the
+team already maintains in the Linear web app.
+
+Examples:
+  linear view list
+  linear view issues "Current sprint"
+  linear view issues 2f7d8c3a-1b4e-4d8f-9a6c-5e2b1f0d3c7a --all`,
+	}
+
+	cmd.AddCommand(newViewListCmd())
+	cmd.AddCommand(newViewIssuesCmd())
+
+	return cmd
+}
+
+func newViewListCmd() *cobra.Command {
+	var (
+		teamKey    string
+		sharedOnly bool
+		limit      int
+		after      string
+		all        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List custom views",
+		Long: `List the custom views visible to you, including each view's filter
+definition (filterData).
+
+Examples:
+  linear view list
+  linear view list --team ENG --shared
+  linear view list --all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			views, pageInfo, err := collectPages(after, all, func(after string) ([]api.CustomView, *api.PageInfo, error) {
+				return client.GetCustomViews(ctx, limit, after)
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			views = filterViews(views, teamKey, sharedOnly)
+			sort.SliceStable(views, func(i, j int) bool {
+				return strings.ToLower(views[i].Name) < strings.ToLower(views[j].Name)
+			})
+
+			response := &ViewListResponse{
+				Views:    views,
+				Count:    len(views),
+				PageInfo: pageInfo,
+			}
+
+			if IsHumanOutput() {
+				printViewsHuman(response)
+				printPageHintHuman(response.PageInfo)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Show only views for this team")
+	cmd.Flags().BoolVar(&sharedOnly, "shared", false, "Show only views shared with the workspace")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum number of views to return (page size with --all)")
+	cmd.Flags().StringVar(&after, "after", "", "Start after this cursor (from pageInfo.endCursor)")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch every page of results")
+
+	return cmd
+}
+
+func newViewIssuesCmd() *cobra.Command {
+	var (
+		limit int
+		after string
+		all   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "issues <view>",
+		Short: "List the issues matching a custom view",
+		Long: `List the issues matching a custom view's filters. The view is given by ID
+or by name (case-insensitive).
+
+Examples:
+  linear view issues "Current sprint"
+  linear view issues "Bugs triage" --limit 100
+  linear view issues 2f7d8c3a-1b4e-4d8f-9a6c-5e2b1f0d3c7a --all`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			viewID := args[0]
+			if !isUUID(viewID) {
+				view, err := findViewByName(ctx, client, viewID)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+				if view == nil {
+					msg := fmt.Sprintf("Custom view '%s' not found", viewID)
+					hint := "List views with 'linear view list'"
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint("NOT_FOUND", msg, hint)
+						return nil
+					}
+					return output.ErrorWithHint("NOT_FOUND", msg, hint)
+				}
+				viewID = view.ID
+			}
+
+			var view *api.CustomView
+			issues, pageInfo, err := collectPages(after, all, func(after string) ([]api.IssueListItem, *api.PageInfo, error) {
+				v, page, err := client.GetCustomViewIssues(ctx, viewID, limit, after)
+				if err != nil {
+					return nil, nil, err
+				}
+				view = v
+				return page.Issues, page.PageInfo, nil
+			})
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+
+			response := &ViewIssuesResponse{
+				View:     view,
+				Issues:   issues,
+				Count:    len(issues),
+				PageInfo: pageInfo,
+			}
+
+			if IsHumanOutput() {
+				printViewIssuesHuman(response)
+				printPageHintHuman(response.PageInfo)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum number of issues to return (page size with --all)")
+	cmd.Flags().StringVar(&after, "after", "", "Start after this cursor (from pageInfo.endCursor)")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch every page of results")
+
+	return cmd
+}
+
+// findViewByName returns the custom view named name, case-insensitively,
+// or nil when there is none
+func findViewByName(ctx context.Context, client *api.Client, name string) (*api.CustomView, error) {
+	views, _, err := collectPages("", true, func(after string) ([]api.CustomView, *api.PageInfo, error) {
+		return client.GetCustomViews(ctx, 100, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range views {
+		if strings.EqualFold(v.Name, name) {
+			return &views[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// filterViews keeps the views for teamKey, and only shared views when
+// sharedOnly is set
+func filterViews(views []api.CustomView, teamKey string, sharedOnly bool) []api.CustomView {
+	if teamKey == "" && !sharedOnly {
+		return views
+	}
+	filtered := []api.CustomView{}
+	for _, v := range views {
+		if teamKey != "" && (v.Team == nil || !strings.EqualFold(v.Team.Key, teamKey)) {
+			continue
+		}
+		if sharedOnly && !v.Shared {
+			continue
+		}
+		filtered = append(filtered, v)
+	}
+	return filtered
+}
+
+func printViewsHuman(response *ViewListResponse) {
+	if len(response.Views) == 0 {
+		output.HumanLn("No custom views found")
+		return
+	}
+
+	headers := []string{"NAME", "TEAM", "OWNER", "SHARED", "ID"}
+	rows := make([][]string, len(response.Views))
+
+	for i, v := range response.Views {
+		team := output.Muted("workspace")
+		if v.Team != nil {
+			team = v.Team.Key
+		}
+		shared := ""
+		if v.Shared {
+			shared = display.BoolToCheckmark(true)
+		}
+		rows[i] = []string{
+			display.Truncate(v.Name, 40),
+			team,
+			v.Owner,
+			shared,
+			output.Muted("%s", v.ID),
+		}
+	}
+
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d views", response.Count)
+}
+
+func printViewIssuesHuman(response *ViewIssuesResponse) {
+	name := ""
+	if response.View != nil {
+		name = response.View.Name
+	}
+	if len(response.Issues) == 0 {
+		output.HumanLn("No issues match view %s", name)
+		return
+	}
+
+	output.HumanLn("Issues in view %s:\n", output.Bold("%s", name))
+	printIssueTableHuman(response.Issues)
+	output.HumanLn("\n%d issues", response.Count)
+}
@@ -0,0 +1,483 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/watchlist"
+	"github.com/spf13/cobra"
+)
+
+// WatchedIssueChanges is what changed on a watched issue since it was
+// last checked
+type WatchedIssueChanges struct {
+	Issue   string            `json:"issue"`
+	Title   string            `json:"title"`
+	URL     string            `json:"url,omitempty"`
+	Since   time.Time         `json:"since"`
+	Changes []issueWatchEvent `json:"changes"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// WatchlistCheckResponse is the response for 'watchlist check'. Issues
+// holds only the watched issues that changed or could not be checked.
+type WatchlistCheckResponse struct {
+	Success bool                  `json:"success"`
+	Issues  []WatchedIssueChanges `json:"issues"`
+	Count   int                   `json:"count"`
+	Checked int                   `json:"checked"`
+	Failed  int                   `json:"failed"`
+}
+
+// NewWatchlistCmd creates the watchlist command group
+func NewWatchlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watchlist",
+		Short: "Track changes on a list of issues",
+		Long: `Keep a local list of issues and ask what changed on them since you last
+looked: state changes, assignee changes and new comments. This is a
+lightweight alternative to Linear notifications for agents, which can run
+'linear watchlist check' at the start of each session.
+
+The watchlist is kept in $XDG_STATE_HOME/agent-linear-cli/watchlist.json,
+or the file named by LINEAR_WATCHLIST_FILE, with each issue as it was last
+seen. Your Linear subscriptions are not changed.
+
+Examples:
+  linear watchlist add ENG-123
+  linear watchlist check --human
+  linear watchlist list
+  linear watchlist remove ENG-123`,
+	}
+
+	cmd.AddCommand(newWatchlistAddCmd())
+	cmd.AddCommand(newWatchlistRemoveCmd())
+	cmd.AddCommand(newWatchlistListCmd())
+	cmd.AddCommand(newWatchlistCheckCmd())
+
+	return cmd
+}
+
+func newWatchlistAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <issue-id>",
+		Short: "Watch an issue for changes",
+		Long: `Add an issue to the watchlist. Changes are reported by 'linear watchlist
+check' from now on; adding an issue that is already watched keeps the
+changes not yet checked.
+
+Examples:
+  linear watchlist add ENG-123`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+
+			store, err := watchlist.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			issue, err := client.GetIssue(ctx, issueID, false)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanFrom(err, "API_ERROR")
+					return nil
+				}
+				return output.ErrorFrom(err, "API_ERROR")
+			}
+			if issue == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' not found", issueID))
+			}
+
+			entry, err := store.Find(issue.ID)
+			added := err == nil && entry == nil
+			if added {
+				now := time.Now().UTC()
+				entry = watchedEntry(issue, now)
+				entry.AddedAt = now
+				err = store.Save(entry)
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				if !added {
+					output.HumanLn("Already watching %s", entry.Identifier)
+					return nil
+				}
+				output.SuccessHuman(fmt.Sprintf("Watching %s", entry.Identifier))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "add",
+				"added":     added,
+				"entry":     entry,
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newWatchlistRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <issue-id>",
+		Short: "Stop watching an issue",
+		Long: `Remove an issue from the watchlist.
+
+Examples:
+  linear watchlist remove ENG-123`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+
+			store, err := watchlist.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			removed, err := store.Remove(issueID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			if removed == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Issue '%s' is not on the watchlist", issueID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Issue '%s' is not on the watchlist", issueID))
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Stopped watching %s", removed.Identifier))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "remove",
+				"issue":     removed.Identifier,
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newWatchlistListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List watched issues",
+		Long: `List watched issues as they were last seen, in the order they were added.
+
+Examples:
+  linear watchlist list --human`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := watchlist.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			entries, err := store.List()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				printWatchlistHuman(entries)
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"watchlist": entries,
+				"count":     len(entries),
+				"file":      store.Path(),
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newWatchlistCheckCmd() *cobra.Command {
+	var peek bool
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Report changes on watched issues since the last check",
+		Long: `Report the state changes, assignee changes and new comments on each
+watched issue since it was last checked, then mark them seen. Only issues
+with changes are listed. State and assignee changes compare the issue
+with how it was last seen, so a change that was undone in between is not
+reported.
+
+--peek reports the changes without marking them seen. An issue that
+cannot be fetched is reported with its error and checked again next time;
+the command then exits 1 after printing the changes on the others.
+
+Examples:
+  linear watchlist check
+  linear watchlist check --human
+  linear watchlist check --peek --jq '.issues[].changes[] | select(.event == "comment")'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := watchlist.NewStore()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+			entries, err := store.List()
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("CONFIG_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("CONFIG_ERROR", err.Error())
+			}
+
+			resp := &WatchlistCheckResponse{Success: true, Issues: []WatchedIssueChanges{}, Checked: len(entries)}
+			if len(entries) == 0 {
+				if IsHumanOutput() {
+					output.HumanLn("No watched issues. Add one with 'linear watchlist add <issue-id>'")
+					return nil
+				}
+				return output.JSON(resp)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman("AUTH_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			results, seen := checkWatchlist(ctx, client, entries, time.Now().UTC())
+			for _, r := range results {
+				if r.Error != "" {
+					resp.Failed++
+					resp.Success = false
+				}
+				if r.Error != "" || len(r.Changes) > 0 {
+					resp.Issues = append(resp.Issues, r)
+				}
+			}
+			resp.Count = len(resp.Issues) - resp.Failed
+			if resp.Failed > 0 {
+				output.Fail("CHECK_FAILED")
+			}
+
+			if !peek && len(seen) > 0 {
+				if err := store.Save(seen...); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("CONFIG_ERROR", err.Error())
+						return nil
+					}
+					return output.Error("CONFIG_ERROR", err.Error())
+				}
+			}
+
+			if IsHumanOutput() {
+				printWatchlistCheckHuman(resp)
+				return nil
+			}
+			return output.JSON(resp)
+		},
+	}
+
+	cmd.Flags().BoolVar(&peek, "peek", false, "Report changes without marking them seen")
+
+	return cmd
+}
+
+// checkWatchlist fetches the watched issues and diffs each against its
+// entry. It returns a result per entry, in order, and the entries updated
+// to now for the issues that could be fetched.
+func checkWatchlist(ctx context.Context, client *api.Client, entries []*watchlist.Entry, now time.Time) ([]WatchedIssueChanges, []*watchlist.Entry) {
+	results := make([]WatchedIssueChanges, len(entries))
+	issues := make([]*api.IssueDetail, len(entries))
+	fns := make([]func(ctx context.Context) error, len(entries))
+	for i, entry := range entries {
+		fns[i] = func(ctx context.Context) error {
+			issue, err := client.GetIssue(ctx, entry.ID, true)
+			switch {
+			case err != nil:
+				results[i].Error = err.Error()
+			case issue == nil:
+				results[i].Error = "issue not found"
+			default:
+				issues[i] = issue
+			}
+			// Failures are reported per issue rather than stopping the rest
+			return nil
+		}
+	}
+	api.Parallel(ctx, fns...)
+
+	var seen []*watchlist.Entry
+	for i, entry := range entries {
+		results[i].Issue = entry.Identifier
+		results[i].Title = entry.Title
+		results[i].URL = entry.URL
+		results[i].Since = entry.SeenAt
+		results[i].Changes = []issueWatchEvent{}
+
+		issue := issues[i]
+		if issue == nil {
+			continue
+		}
+		results[i].Title = issue.Title
+		results[i].Changes = watchlistChanges(entry, issue, now)
+
+		updated := watchedEntry(issue, now)
+		updated.AddedAt = entry.AddedAt
+		seen = append(seen, updated)
+	}
+	return results, seen
+}
+
+// watchlistChanges diffs an issue against its watchlist entry. Comments
+// posted after the entry was last seen are new.
+func watchlistChanges(entry *watchlist.Entry, issue *api.IssueDetail, now time.Time) []issueWatchEvent {
+	prev := &api.IssueDetail{
+		Identifier: entry.Identifier,
+		State:      api.IssueState{ID: entry.StateID, Name: entry.State},
+	}
+	if entry.Assignee != "" {
+		prev.Assignee = &api.IssueAssignee{DisplayName: entry.Assignee}
+	}
+
+	seen := map[string]bool{}
+	for _, c := range issue.Comments {
+		createdAt, err := time.Parse(time.RFC3339, c.CreatedAt)
+		if err == nil && !createdAt.After(entry.SeenAt) {
+			seen[c.ID] = true
+		}
+	}
+
+	changes := diffIssue(prev, issue, seen)
+	for i := range changes {
+		changes[i].At = now.Format(time.RFC3339)
+		if changes[i].Comment != nil {
+			changes[i].At = changes[i].Comment.CreatedAt
+		}
+	}
+	if changes == nil {
+		changes = []issueWatchEvent{}
+	}
+	return changes
+}
+
+// watchedEntry records an issue as seen at now
+func watchedEntry(issue *api.IssueDetail, now time.Time) *watchlist.Entry {
+	return &watchlist.Entry{
+		ID:         issue.ID,
+		Identifier: issue.Identifier,
+		Title:      issue.Title,
+		URL:        issue.URL,
+		StateID:    issue.State.ID,
+		State:      issue.State.Name,
+		Assignee:   assigneeName(issue.Assignee),
+		SeenAt:     now,
+	}
+}
+
+// printWatchlistHuman renders watched issues as they were last seen
+func printWatchlistHuman(entries []*watchlist.Entry) {
+	if len(entries) == 0 {
+		output.HumanLn("No watched issues")
+		return
+	}
+	output.HumanLn("%s", output.Bold("Watched issues (%d)", len(entries)))
+	for _, e := range entries {
+		output.HumanLn("  %-10s %s  %s  %s",
+			e.Identifier,
+			display.Truncate(e.Title, 50),
+			e.State,
+			output.Muted("checked %s", display.TimeAgoShort(e.SeenAt)),
+		)
+	}
+}
+
+// printWatchlistCheckHuman lists each changed issue with its changes
+func printWatchlistCheckHuman(resp *WatchlistCheckResponse) {
+	if len(resp.Issues) == 0 {
+		output.HumanLn("No changes on %d watched issues", resp.Checked)
+		return
+	}
+	for _, issue := range resp.Issues {
+		output.HumanLn("%s %s", output.Bold("%s", issue.Issue), display.Truncate(issue.Title, 60))
+		if issue.Error != "" {
+			output.HumanLn("  %s", output.Red("check failed: %s", issue.Error))
+			output.HumanLn("")
+			continue
+		}
+		for _, change := range issue.Changes {
+			var text string
+			switch change.Event {
+			case "state":
+				text = fmt.Sprintf("%s → %s", change.From, output.Green("%s", change.To))
+			case "assignee":
+				text = fmt.Sprintf("%s → %s", orNone(change.From), output.Green("%s", orNone(change.To)))
+			case "comment":
+				author := "Unknown"
+				if change.Comment.User != nil {
+					author = change.Comment.User.DisplayName
+				}
+				text = fmt.Sprintf("%s: %s", output.Bold("%s", author), display.Truncate(strings.Join(strings.Fields(change.Comment.Body), " "), 80))
+			}
+			output.HumanLn("  %s %s", output.Cyan("%-8s", change.Event), text)
+		}
+		output.HumanLn("")
+	}
+	output.HumanLn("%d of %d watched issues changed", resp.Count, resp.Checked)
+}
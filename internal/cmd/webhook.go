@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/webhook"
+	"github.com/spf13/cobra"
+)
+
+// NewWebhookCmd creates the webhook command group
+func NewWebhookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Receive Linear webhooks locally",
+		Long: `Receive Linear webhook events on a local HTTP server.
+
+Examples:
+  linear webhook listen --secret $LINEAR_WEBHOOK_SECRET
+  linear webhook listen --register https://abc.ngrok.app --team ENG`,
+	}
+
+	cmd.AddCommand(newWebhookListenCmd())
+
+	return cmd
+}
+
+func newWebhookListenCmd() *cobra.Command {
+	var (
+		host      string
+		port      int
+		path      string
+		secret    string
+		register  string
+		teamKey   string
+		resources []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "listen",
+		Short: "Stream webhook events to stdout",
+		Long: `Start a local HTTP server that receives Linear webhooks and streams
+events to stdout, one JSON object per line (or a readable log with --human).
+
+Signatures are verified with the webhook's signing secret, taken from
+--secret or LINEAR_WEBHOOK_SECRET. Deliveries with a bad signature or a
+timestamp more than a minute off are rejected.
+
+With --register, a webhook pointing at the given public URL (for example
+a tunnel forwarding to this server) is created for the team, or for all
+public teams when no team is set, and deleted again on exit. A signing
+secret is generated if none is given.
+
+Examples:
+  linear webhook listen --port 8787 --secret s3cret
+  linear webhook listen --register https://abc.ngrok.app --team ENG
+  linear webhook listen --resource Issue --resource Comment --human`,
+		Annotations: map[string]string{noTimeoutAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if secret == "" {
+				secret = os.Getenv("LINEAR_WEBHOOK_SECRET")
+			}
+			if len(resources) == 0 {
+				resources = []string{"Issue", "Comment", "Project"}
+			}
+			for _, r := range resources {
+				if !containsFold(webhook.ResourceTypes, r) {
+					msg := fmt.Sprintf("Unknown resource type '%s' (use %s)", r, strings.Join(webhook.ResourceTypes, ", "))
+					if IsHumanOutput() {
+						output.ErrorHuman("INVALID_RESOURCE", msg)
+						return nil
+					}
+					return output.Error("INVALID_RESOURCE", msg)
+				}
+			}
+			if !strings.HasPrefix(path, "/") {
+				path = "/" + path
+			}
+
+			ctx := cmd.Context()
+
+			var registered *api.Webhook
+			var client *api.Client
+			if register != "" {
+				if secret == "" {
+					generated, err := generateWebhookSecret()
+					if err != nil {
+						if IsHumanOutput() {
+							output.ErrorHuman("WEBHOOK_ERROR", err.Error())
+							return nil
+						}
+						return output.Error("WEBHOOK_ERROR", err.Error())
+					}
+					secret = generated
+				}
+
+				var err error
+				client, err = api.NewClient(ctx)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman("AUTH_ERROR", err.Error())
+						return nil
+					}
+					return output.Error("AUTH_ERROR", err.Error())
+				}
+
+				input := api.WebhookCreateInput{
+					URL:           strings.TrimSuffix(register, "/") + path,
+					Label:         "linear webhook listen",
+					Secret:        secret,
+					ResourceTypes: resources,
+				}
+
+				if teamKey == "" {
+					teamKey = GetTeamID()
+				}
+				if teamKey != "" {
+					team, err := client.GetTeamByKey(ctx, teamKey)
+					if err != nil {
+						if IsHumanOutput() {
+							output.ErrorHumanFrom(err, "API_ERROR")
+							return nil
+						}
+						return output.ErrorFrom(err, "API_ERROR")
+					}
+					if team == nil {
+						if IsHumanOutput() {
+							output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+							return nil
+						}
+						return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+					}
+					input.TeamID = team.ID
+				} else {
+					input.AllPublicTeams = true
+				}
+
+				registered, err = client.CreateWebhook(ctx, input)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanFrom(err, "API_ERROR")
+						return nil
+					}
+					return output.ErrorFrom(err, "API_ERROR")
+				}
+			}
+
+			listener, err := net.Listen("tcp", net.JoinHostPort(host, fmt.Sprintf("%d", port)))
+			if err != nil {
+				if registered != nil {
+					client.DeleteWebhook(cmd.Context(), registered.ID)
+				}
+				if IsHumanOutput() {
+					output.ErrorHuman("LISTEN_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("LISTEN_ERROR", err.Error())
+			}
+
+			mux := http.NewServeMux()
+			mux.Handle(path, webhook.Handler(secret, webhookEventPrinter(resources), func(err error) {
+				fmt.Fprintf(os.Stderr, "webhook: rejected delivery: %v\n", err)
+			}))
+			server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+			printWebhookListening(listener.Addr().String(), path, secret != "", registered)
+
+			serveErr := make(chan error, 1)
+			go func() { serveErr <- server.Serve(listener) }()
+
+			select {
+			case <-ctx.Done():
+			case err = <-serveErr:
+			}
+
+			shutdownCtx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
+			defer cancel()
+			server.Shutdown(shutdownCtx)
+
+			if registered != nil {
+				if delErr := client.DeleteWebhook(shutdownCtx, registered.ID); delErr != nil {
+					fmt.Fprintf(os.Stderr, "webhook: failed to delete webhook %s: %v\n", registered.ID, delErr)
+				}
+			}
+
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				if IsHumanOutput() {
+					output.ErrorHuman("LISTEN_ERROR", err.Error())
+					return nil
+				}
+				return output.Error("LISTEN_ERROR", err.Error())
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&host, "host", "127.0.0.1", "Address to bind")
+	cmd.Flags().IntVarP(&port, "port", "p", 8787, "Port to listen on")
+	cmd.Flags().StringVar(&path, "path", "/", "URL path that receives deliveries")
+	cmd.Flags().StringVar(&secret, "secret", "", "Webhook signing secret (default: $LINEAR_WEBHOOK_SECRET)")
+	cmd.Flags().StringVar(&register, "register", "", "Public URL to register as a webhook for the duration of the session")
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key for --register (default: all public teams)")
+	cmd.Flags().StringArrayVarP(&resources, "resource", "r", nil, "Resource type to receive (repeatable, default: Issue, Comment, Project)")
+
+	return cmd
+}
+
+// webhookEventPrinter returns a callback that writes events of the given
+// resource types to stdout. Deliveries may arrive concurrently, so writes
+// are serialized.
+func webhookEventPrinter(resources []string) func(*webhook.Event) {
+	var mu sync.Mutex
+	encoder := json.NewEncoder(os.Stdout)
+
+	return func(event *webhook.Event) {
+		if !containsFold(resources, event.Type) {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !IsHumanOutput() {
+			encoder.Encode(event)
+			return
+		}
+
+		action := event.Action
+		switch action {
+		case "create":
+			action = output.Green("%s", action)
+		case "remove":
+			action = output.Red("%s", action)
+		default:
+			action = output.Yellow("%s", action)
+		}
+		output.HumanLn("%s %s %s %s",
+			output.Muted("%s", time.Now().Format("15:04:05")),
+			output.Cyan("%-8s", event.Type),
+			action,
+			webhook.Summary(event),
+		)
+	}
+}
+
+// printWebhookListening reports where the server listens. In JSON mode
+// this goes to stderr so stdout carries only events.
+func printWebhookListening(addr, path string, verifying bool, registered *api.Webhook) {
+	if IsHumanOutput() {
+		output.HumanLn("Listening on http://%s%s", addr, path)
+		if registered != nil {
+			output.HumanLn("Registered webhook %s → %s", output.Muted("%s", registered.ID), registered.URL)
+		}
+		if !verifying {
+			output.HumanLn("%s", output.Yellow("No signing secret set; signatures are not verified"))
+		}
+		output.HumanLn("%s", output.Muted("Press Ctrl+C to stop"))
+		return
+	}
+
+	status := map[string]interface{}{
+		"listening": fmt.Sprintf("http://%s%s", addr, path),
+		"verifying": verifying,
+	}
+	if registered != nil {
+		status["webhook"] = registered
+	}
+	json.NewEncoder(os.Stderr).Encode(status)
+}
+
+// generateWebhookSecret returns a random signing secret
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/fatih/color"
@@ -15,6 +14,16 @@ type WhoamiResponse struct {
 	User         *api.Viewer       `json:"user"`
 	Organization *api.Organization `json:"organization"`
 	Auth         *AuthInfo         `json:"auth"`
+	Actor        *ActorInfo        `json:"actor"`
+}
+
+// ActorInfo is who issues and comments are created as: "user" for
+// personal tokens, "app" for app tokens, with the name they are created on
+// behalf of when an actor is configured
+type ActorInfo struct {
+	Type     string `json:"type"`
+	OnBehalf string `json:"onBehalf,omitempty"`
+	IconURL  string `json:"iconUrl,omitempty"`
 }
 
 // AuthInfo represents authentication information in whoami output
@@ -35,12 +44,17 @@ Shows:
   - User details (name, email, admin status)
   - Organization/workspace information
   - Authentication method and source
+  - Actor: whether issues and comments are created as you or as an app,
+    and on whose behalf (see --actor)
+
+For a full check of the CLI's setup, including the default team, API
+latency and rate limit budget, use 'linear status'.
 
 Examples:
   linear whoami
   linear whoami --human`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			// Get auth status first
 			authManager := auth.NewManager()
@@ -83,6 +97,11 @@ Examples:
 					Method: string(authStatus.Method),
 					Source: authStatus.Source,
 				},
+				Actor: &ActorInfo{Type: "user"},
+			}
+			if viewer.Viewer.App {
+				actor := api.CurrentActor()
+				response.Actor = &ActorInfo{Type: "app", OnBehalf: actor.Name, IconURL: actor.IconURL}
 			}
 
 			if authStatus.ExpiresAt != nil {
@@ -126,4 +145,16 @@ func printHumanWhoami(r *WhoamiResponse) {
 	if r.Auth.ExpiresAt != nil {
 		fmt.Printf("  Expires: %s\n", *r.Auth.ExpiresAt)
 	}
+	fmt.Println()
+
+	// Actor section
+	color.Cyan("Actor")
+	switch {
+	case r.Actor.Type != "app":
+		fmt.Printf("  Creates as: %s\n", r.User.DisplayName)
+	case r.Actor.OnBehalf != "":
+		fmt.Printf("  Creates as: %s (via %s)\n", r.Actor.OnBehalf, r.User.DisplayName)
+	default:
+		fmt.Printf("  Creates as: %s (app)\n", r.User.DisplayName)
+	}
 }
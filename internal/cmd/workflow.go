@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"sort"
 
@@ -34,10 +33,7 @@ Examples:
 }
 
 func newWorkflowListCmd() *cobra.Command {
-	var (
-		teamKey string
-		refresh bool
-	)
+	var teamKey string
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -57,18 +53,38 @@ Examples:
 			}
 			if teamKey == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Team is required. Use --team flag or configure default team.")
+					output.ErrorHuman("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 					return nil
 				}
 				return output.Error("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 			}
 
-			ctx := context.Background()
+			if IsOffline() {
+				team, err := offlineTeam(teamKey)
+				if err != nil {
+					return offlineError(err)
+				}
+				states, info, err := readOffline[api.WorkflowStatesResponse](cache.TeamKey("workflows", team.ID))
+				if err != nil {
+					return offlineError(err)
+				}
+				sort.Slice(states.WorkflowStates, func(i, j int) bool {
+					return states.WorkflowStates[i].Position < states.WorkflowStates[j].Position
+				})
+				if IsHumanOutput() {
+					printWorkflowStatesHuman(states, team.Key)
+					printOfflineNoticeHuman(info)
+					return nil
+				}
+				return outputOfflineJSON(states, info)
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -78,14 +94,14 @@ Examples:
 			team, err := client.GetTeamByKey(ctx, teamKey)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 			if team == nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
 					return nil
 				}
 				return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
@@ -97,7 +113,7 @@ Examples:
 			cacheManager, _ := cache.NewManager()
 			cacheKey := cache.TeamKey("workflows", team.ID)
 
-			if !refresh && cacheManager != nil {
+			if cacheManager != nil {
 				cached, _ := cache.Read[api.WorkflowStatesResponse](cacheManager, cacheKey)
 				if cached != nil {
 					states = cached
@@ -109,10 +125,10 @@ Examples:
 				states, err = client.GetWorkflowStates(ctx, team.ID)
 				if err != nil {
 					if IsHumanOutput() {
-						output.ErrorHuman(err.Error())
+						output.ErrorHumanFrom(err, "API_ERROR")
 						return nil
 					}
-					return output.Error("API_ERROR", err.Error())
+					return output.ErrorFrom(err, "API_ERROR")
 				}
 
 				// Cache the results
@@ -137,7 +153,6 @@ Examples:
 	}
 
 	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Team key (e.g., ENG)")
-	cmd.Flags().BoolVar(&refresh, "refresh", false, "Bypass cache and fetch fresh data")
 
 	return cmd
 }
@@ -160,18 +175,18 @@ Examples:
 			}
 			if teamKey == "" {
 				if IsHumanOutput() {
-					output.ErrorHuman("Team is required. Use --team flag or configure default team.")
+					output.ErrorHuman("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 					return nil
 				}
 				return output.Error("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHuman("AUTH_ERROR", err.Error())
 					return nil
 				}
 				return output.Error("AUTH_ERROR", err.Error())
@@ -181,27 +196,27 @@ Examples:
 			team, err := client.GetTeamByKey(ctx, teamKey)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 			if team == nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+					output.ErrorHuman("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
 					return nil
 				}
 				return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
 			}
 
 			// Fetch fresh data
-			states, err := client.GetWorkflowStates(ctx, team.ID)
+			states, err := client.GetWorkflowStates(api.Fresh(ctx), team.ID)
 			if err != nil {
 				if IsHumanOutput() {
-					output.ErrorHuman(err.Error())
+					output.ErrorHumanFrom(err, "API_ERROR")
 					return nil
 				}
-				return output.Error("API_ERROR", err.Error())
+				return output.ErrorFrom(err, "API_ERROR")
 			}
 
 			// Update cache
@@ -2,8 +2,13 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/pelletier/go-toml/v2"
 )
@@ -13,71 +18,439 @@ const (
 	ConfigFileName = ".linear.toml"
 )
 
+// Sources of configuration values, from lowest to highest precedence
+const (
+	SourceHome = "home"
+	SourceRepo = "repo"
+	SourceEnv  = "env"
+)
+
 // Config represents the CLI configuration
 type Config struct {
 	APIKey  string `toml:"api_key"`
 	TeamID  string `toml:"team_id"`
 	TeamKey string `toml:"team_key"`
+
+	// DateFormat is a named format (iso, us, eu, long) or Go layout for dates
+	DateFormat string `toml:"date_format,omitempty"`
+	// Timezone is an IANA time zone (e.g., Europe/Berlin) for displayed times
+	Timezone string `toml:"timezone,omitempty"`
+
+	// ProjectID is the default project for commands that take --project
+	ProjectID string `toml:"project_id,omitempty"`
+	// DefaultProject is the project new issues are created in without
+	// --project
+	DefaultProject string `toml:"default_project,omitempty"`
+	// DefaultCycle is the cycle new issues are added to without --cycle:
+	// current, next or a cycle ID
+	DefaultCycle string `toml:"default_cycle,omitempty"`
+	// Teams are the new-issue defaults of a team by team key, overriding
+	// DefaultProject and DefaultCycle for issues created in that team
+	Teams map[string]TeamConfig `toml:"teams,omitempty"`
+	// Labels are label names added to new issues without --label
+	Labels []string `toml:"labels,omitempty"`
+	// IssueTemplate is the issue template used when creating issues without
+	// --description or --template
+	IssueTemplate string `toml:"issue_template,omitempty"`
+	// TemplatesDir is the template store directory; relative paths are
+	// resolved against the directory of the config file that sets it
+	TemplatesDir string `toml:"templates_dir,omitempty"`
+
+	// Actor is the name issues and comments are created on behalf of with
+	// an app token; "app" or empty creates them as the app
+	Actor string `toml:"actor,omitempty"`
+	// ActorIconURL is the avatar shown for Actor
+	ActorIconURL string `toml:"actor_icon_url,omitempty"`
+
+	// APIEndpoint is the GraphQL endpoint requests are sent to, such as a
+	// mock server or a proxy in front of the API; empty means Linear's
+	APIEndpoint string `toml:"api_endpoint,omitempty"`
+
+	Calendar CalendarConfig `toml:"calendar,omitempty"`
+
+	// Columns are the preferred table columns of list commands
+	Columns ColumnsConfig `toml:"columns,omitempty"`
+
+	Theme ThemeConfig `toml:"theme,omitempty"`
+
+	Cache CacheConfig `toml:"cache,omitempty"`
+
+	// Aliases are user-defined commands by name: an expansion such as
+	// "issue list --label bug", or a shell command prefixed with "!"
+	Aliases map[string]string `toml:"aliases,omitempty"`
+}
+
+// Value returns the configuration value for key, with lists joined by
+// commas
+func (c *Config) Value(key string) (string, error) {
+	switch key {
+	case "api_key":
+		return c.APIKey, nil
+	case "team_id":
+		return c.TeamID, nil
+	case "team_key":
+		return c.TeamKey, nil
+	case "date_format":
+		return c.DateFormat, nil
+	case "timezone":
+		return c.Timezone, nil
+	case "project_id":
+		return c.ProjectID, nil
+	case "default_project":
+		return c.DefaultProject, nil
+	case "default_cycle":
+		return c.DefaultCycle, nil
+	case "labels":
+		return strings.Join(c.Labels, ","), nil
+	case "issue_template":
+		return c.IssueTemplate, nil
+	case "templates_dir":
+		return c.TemplatesDir, nil
+	case "actor":
+		return c.Actor, nil
+	case "actor_icon_url":
+		return c.ActorIconURL, nil
+	case "api_endpoint":
+		return c.APIEndpoint, nil
+	case "calendar.workdays":
+		return strings.Join(c.Calendar.Workdays, ","), nil
+	case "calendar.holidays":
+		return strings.Join(c.Calendar.Holidays, ","), nil
+	case "columns.issue_list":
+		return strings.Join(c.Columns.IssueList, ","), nil
+	case "columns.project_list":
+		return strings.Join(c.Columns.ProjectList, ","), nil
+	case "columns.document_list":
+		return strings.Join(c.Columns.DocumentList, ","), nil
+	case "theme.color":
+		return c.Theme.Color, nil
+	case "theme.depth":
+		return c.Theme.Depth, nil
+	case "cache.ttl":
+		return c.Cache.TTL, nil
+	case "cache.response_ttl":
+		return c.Cache.ResponseTTL, nil
+	case "cache.max_size":
+		return c.Cache.MaxSize, nil
+	default:
+		return "", fmt.Errorf("unknown config key: %s", key)
+	}
+}
+
+// IssueDefaults returns the default project and cycle of new issues in the
+// team with key teamKey, preferring the team's own defaults
+func (c *Config) IssueDefaults(teamKey string) (project, cycle string) {
+	project, cycle = c.DefaultProject, c.DefaultCycle
+	for key, team := range c.Teams {
+		if !strings.EqualFold(key, teamKey) {
+			continue
+		}
+		if team.DefaultProject != "" {
+			project = team.DefaultProject
+		}
+		if team.DefaultCycle != "" {
+			cycle = team.DefaultCycle
+		}
+	}
+	return project, cycle
+}
+
+// TeamConfig holds the new-issue defaults of one team
+type TeamConfig struct {
+	DefaultProject string `toml:"default_project,omitempty" json:"default_project,omitempty"`
+	DefaultCycle   string `toml:"default_cycle,omitempty" json:"default_cycle,omitempty"`
+}
+
+// CalendarConfig describes the organization's working calendar used for
+// business-day date math
+type CalendarConfig struct {
+	// Workdays are weekday abbreviations (mon, tue, ...). Empty means Monday-Friday.
+	Workdays []string `toml:"workdays,omitempty" json:"workdays,omitempty"`
+	// Holidays are non-working dates in YYYY-MM-DD format
+	Holidays []string `toml:"holidays,omitempty" json:"holidays,omitempty"`
+	// Blackouts are date ranges treated as non-working, such as release freezes
+	Blackouts []BlackoutConfig `toml:"blackouts,omitempty" json:"blackouts,omitempty"`
+}
+
+// BlackoutConfig is an inclusive range of non-working dates
+type BlackoutConfig struct {
+	Name  string `toml:"name,omitempty" json:"name,omitempty"`
+	Start string `toml:"start" json:"start"`
+	End   string `toml:"end" json:"end"`
+}
+
+// ColumnsConfig holds the columns shown by list commands in table and TSV
+// output, by column name, in place of their defaults
+type ColumnsConfig struct {
+	IssueList    []string `toml:"issue_list,omitempty" json:"issue_list,omitempty"`
+	ProjectList  []string `toml:"project_list,omitempty" json:"project_list,omitempty"`
+	DocumentList []string `toml:"document_list,omitempty" json:"document_list,omitempty"`
+}
+
+// ThemeConfig sets the colors of human output
+type ThemeConfig struct {
+	// Color is auto, always or never, like --color
+	Color string `toml:"color,omitempty" json:"color,omitempty"`
+	// Depth is 16, 256 or truecolor; empty detects it from the terminal
+	Depth string `toml:"depth,omitempty" json:"depth,omitempty"`
+	// Priority colors priority icons by priority name (urgent, high,
+	// medium, low, none)
+	Priority map[string]string `toml:"priority,omitempty" json:"priority,omitempty"`
+	// State colors states by state type (triage, backlog, unstarted,
+	// started, completed, canceled)
+	State map[string]string `toml:"state,omitempty" json:"state,omitempty"`
+}
+
+// CacheConfig sets the local cache policy
+type CacheConfig struct {
+	// TTL is how long cached workspace data is used, as a duration (24h)
+	TTL string `toml:"ttl,omitempty" json:"ttl,omitempty"`
+	// ResponseTTL is how long API responses are reused (1m); 0 disables
+	// the response cache
+	ResponseTTL string `toml:"response_ttl,omitempty" json:"response_ttl,omitempty"`
+	// MaxSize caps the cache directory, such as 50MB; empty means no limit
+	MaxSize string `toml:"max_size,omitempty" json:"max_size,omitempty"`
+}
+
+// Keys lists the configuration keys that can be read and set by name
+var Keys = []string{
+	"api_key",
+	"team_id",
+	"team_key",
+	"date_format",
+	"timezone",
+	"project_id",
+	"default_project",
+	"default_cycle",
+	"labels",
+	"issue_template",
+	"templates_dir",
+	"actor",
+	"actor_icon_url",
+	"api_endpoint",
+	"calendar.workdays",
+	"calendar.holidays",
+	"columns.issue_list",
+	"columns.project_list",
+	"columns.document_list",
+	"theme.color",
+	"theme.depth",
+	"cache.ttl",
+	"cache.response_ttl",
+	"cache.max_size",
 }
 
-// Manager handles configuration loading and saving
+// Manager handles configuration loading and saving.
+//
+// Configuration is layered: the home config (~/.linear.toml) is overridden
+// by a repository config, the nearest .linear.toml found walking up from
+// the working directory, which is overridden by environment variables.
 type Manager struct {
 	config     *Config
+	sources    map[string]string
 	configPath string
+	homePath   string
+	repoPath   string
 }
 
-// NewManager creates a new configuration manager
+// NewManager creates a new configuration manager. Set writes to the
+// repository config when there is one, then to the home config if it
+// exists, and otherwise creates ./.linear.toml.
 func NewManager() (*Manager, error) {
-	// Look for config in current directory, then home directory
-	paths := []string{
-		filepath.Join(".", ConfigFileName),
+	m := &Manager{}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		m.homePath = filepath.Join(home, ConfigFileName)
+	}
+	if wd, err := os.Getwd(); err == nil {
+		m.repoPath = FindRepoConfig(wd, m.homePath)
 	}
 
-	home, err := os.UserHomeDir()
-	if err == nil {
-		paths = append(paths, filepath.Join(home, ConfigFileName))
+	switch {
+	case m.repoPath != "":
+		m.configPath = m.repoPath
+	case m.homePath != "" && fileExists(m.homePath):
+		m.configPath = m.homePath
+	default:
+		m.configPath = filepath.Join(".", ConfigFileName)
 	}
 
-	for _, path := range paths {
-		if _, err := os.Stat(path); err == nil {
-			return &Manager{configPath: path}, nil
+	return m, nil
+}
+
+// FindRepoConfig returns the nearest .linear.toml in dir or its parents,
+// like git's discovery of .git, or "" if there is none. The home config
+// (homePath) is not a repository config and is skipped.
+func FindRepoConfig(dir, homePath string) string {
+	for {
+		path := filepath.Join(dir, ConfigFileName)
+		if path != homePath && fileExists(path) {
+			return path
 		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
 	}
+}
 
-	// Default to current directory if no config found
-	return &Manager{configPath: paths[0]}, nil
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
 }
 
-// Load loads the configuration from disk
+// Load loads the home and repository configs and environment overrides
 func (m *Manager) Load() (*Config, error) {
 	if m.config != nil {
 		return m.config, nil
 	}
 
-	data, err := os.ReadFile(m.configPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			m.config = &Config{}
-			return m.config, nil
-		}
-		return nil, fmt.Errorf("failed to read config: %w", err)
+	cfg := &Config{}
+	sources := map[string]string{}
+	layers := []struct{ path, source string }{
+		{m.homePath, SourceHome},
+		{m.repoPath, SourceRepo},
 	}
-
-	var cfg Config
-	if err := toml.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("failed to parse config: %w", err)
+	for _, layer := range layers {
+		if layer.path == "" {
+			continue
+		}
+		if err := loadLayer(cfg, sources, layer.path, layer.source); err != nil {
+			return nil, err
+		}
 	}
 
 	// Also check environment variables
 	if apiKey := os.Getenv("LINEAR_API_KEY"); apiKey != "" {
 		cfg.APIKey = apiKey
+		sources["api_key"] = SourceEnv
+	}
+	if endpoint := os.Getenv("LINEAR_API_ENDPOINT"); endpoint != "" {
+		cfg.APIEndpoint = endpoint
+		sources["api_endpoint"] = SourceEnv
 	}
 
-	m.config = &cfg
+	m.config = cfg
+	m.sources = sources
 	return m.config, nil
 }
 
-// Save saves the configuration to disk
+// loadLayer applies the config file at path over cfg, recording source for
+// each key the file sets. A missing file is skipped.
+func loadLayer(cfg *Config, sources map[string]string, path, source string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if source == SourceRepo {
+		if data, err = dropUserOnly(data, path); err != nil {
+			return err
+		}
+	}
+
+	var layer Config
+	if err := toml.Unmarshal(data, &layer); err != nil {
+		return fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	// Unmarshal into the merged config only replaces the keys present
+	if err := toml.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	if layer.TemplatesDir != "" && !filepath.IsAbs(layer.TemplatesDir) {
+		cfg.TemplatesDir = filepath.Join(filepath.Dir(path), layer.TemplatesDir)
+	}
+	for _, key := range Keys {
+		if value, _ := layer.Value(key); value != "" {
+			sources[key] = source
+		}
+	}
+	if len(layer.Calendar.Blackouts) > 0 {
+		sources["calendar.blackouts"] = source
+	}
+	if len(layer.Theme.Priority) > 0 {
+		sources["theme.priority"] = source
+	}
+	if len(layer.Theme.State) > 0 {
+		sources["theme.state"] = source
+	}
+	if len(layer.Aliases) > 0 {
+		sources["aliases"] = source
+	}
+	if len(layer.Teams) > 0 {
+		sources["teams"] = source
+	}
+	return nil
+}
+
+// userOnlyKeys are the keys a repository config cannot set. They decide
+// where requests and the API key go, whose name actions carry and, for
+// shell aliases, what runs, so a repository someone clones must not be
+// able to change them.
+var userOnlyKeys = []string{"api_key", "api_endpoint", "actor", "actor_icon_url", "aliases"}
+
+// warnedRepoConfigs holds the repository configs whose user-only keys have
+// been warned about
+var warnedRepoConfigs sync.Map
+
+// UserOnly reports whether key is only read from the home config and the
+// environment, never from a repository config
+func UserOnly(key string) bool {
+	for _, k := range userOnlyKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// dropUserOnly removes the user-only keys from the repository config
+// data, warning about each one it ignores
+func dropUserOnly(data []byte, path string) ([]byte, error) {
+	var raw map[string]interface{}
+	if err := toml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	var dropped []string
+	for _, key := range userOnlyKeys {
+		if _, ok := raw[key]; ok {
+			delete(raw, key)
+			dropped = append(dropped, key)
+		}
+	}
+	if len(dropped) == 0 {
+		return data, nil
+	}
+	// A command may load the config more than once; warn the first time
+	if _, warned := warnedRepoConfigs.LoadOrStore(path, true); !warned {
+		fmt.Fprintf(os.Stderr, "warning: ignoring %s in %s: only ~/.linear.toml and the environment can set them\n",
+			strings.Join(dropped, ", "), path)
+	}
+	return toml.Marshal(raw)
+}
+
+// readFile reads a single config file without layering
+func readFile(path string) (*Config, error) {
+	var cfg Config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &cfg, nil
+		}
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := toml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// Save saves cfg to the config file written by Set
 func (m *Manager) Save(cfg *Config) error {
 	data, err := toml.Marshal(cfg)
 	if err != nil {
@@ -88,7 +461,8 @@ func (m *Manager) Save(cfg *Config) error {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
 
-	m.config = cfg
+	// Reload the layers on next use
+	m.config = nil
 	return nil
 }
 
@@ -98,22 +472,22 @@ func (m *Manager) Get(key string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	return cfg.Value(key)
+}
 
-	switch key {
-	case "api_key":
-		return cfg.APIKey, nil
-	case "team_id":
-		return cfg.TeamID, nil
-	case "team_key":
-		return cfg.TeamKey, nil
-	default:
-		return "", fmt.Errorf("unknown config key: %s", key)
+// Source returns where key's value comes from (SourceHome, SourceRepo or
+// SourceEnv), or "" if it is not set
+func (m *Manager) Source(key string) string {
+	if _, err := m.Load(); err != nil {
+		return ""
 	}
+	return m.sources[key]
 }
 
-// Set sets a configuration value
+// Set sets a configuration value in the config file it writes to, leaving
+// values from other layers out of that file
 func (m *Manager) Set(key, value string) error {
-	cfg, err := m.Load()
+	cfg, err := readFile(m.configPath)
 	if err != nil {
 		return err
 	}
@@ -125,6 +499,69 @@ func (m *Manager) Set(key, value string) error {
 		cfg.TeamID = value
 	case "team_key":
 		cfg.TeamKey = value
+	case "date_format":
+		cfg.DateFormat = value
+	case "project_id":
+		cfg.ProjectID = value
+	case "default_project":
+		cfg.DefaultProject = value
+	case "default_cycle":
+		if !validCycle(value) {
+			return fmt.Errorf("invalid cycle %q: use current, next or a cycle ID", value)
+		}
+		cfg.DefaultCycle = value
+	case "labels":
+		cfg.Labels = splitList(value)
+	case "issue_template":
+		cfg.IssueTemplate = value
+	case "templates_dir":
+		cfg.TemplatesDir = value
+	case "actor":
+		cfg.Actor = value
+	case "actor_icon_url":
+		cfg.ActorIconURL = value
+	case "api_endpoint":
+		if value != "" {
+			if u, err := url.Parse(value); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+				return fmt.Errorf("invalid api_endpoint %q: use an http or https URL such as https://api.linear.app/graphql", value)
+			}
+		}
+		cfg.APIEndpoint = value
+	case "timezone":
+		if _, err := time.LoadLocation(value); err != nil {
+			return fmt.Errorf("invalid timezone %q: use an IANA name like Europe/Berlin or UTC", value)
+		}
+		cfg.Timezone = value
+	case "calendar.workdays":
+		cfg.Calendar.Workdays = splitList(value)
+	case "calendar.holidays":
+		cfg.Calendar.Holidays = splitList(value)
+	case "columns.issue_list":
+		cfg.Columns.IssueList = splitList(value)
+	case "columns.project_list":
+		cfg.Columns.ProjectList = splitList(value)
+	case "columns.document_list":
+		cfg.Columns.DocumentList = splitList(value)
+	case "theme.color":
+		cfg.Theme.Color = value
+	case "theme.depth":
+		cfg.Theme.Depth = value
+	case "cache.ttl", "cache.response_ttl":
+		if value != "" {
+			if d, err := time.ParseDuration(value); err != nil || d < 0 {
+				return fmt.Errorf("invalid %s %q: use a duration like 30s, 5m or 24h", key, value)
+			}
+		}
+		if key == "cache.ttl" {
+			cfg.Cache.TTL = value
+		} else {
+			cfg.Cache.ResponseTTL = value
+		}
+	case "cache.max_size":
+		if _, err := ParseSize(value); err != nil {
+			return err
+		}
+		cfg.Cache.MaxSize = value
 	default:
 		return fmt.Errorf("unknown config key: %s", key)
 	}
@@ -132,11 +569,95 @@ func (m *Manager) Set(key, value string) error {
 	return m.Save(cfg)
 }
 
-// Path returns the configuration file path
+// sizePattern matches a size such as 512KB, 50MB or 1GB
+var sizePattern = regexp.MustCompile(`(?i)^\s*(\d+)\s*(b|kb|mb|gb)?\s*$`)
+
+// ParseSize parses a size such as 50MB into bytes, with 1KB = 1024 bytes.
+// A bare number is bytes and "" is 0.
+func ParseSize(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	m := sizePattern.FindStringSubmatch(value)
+	if m == nil {
+		return 0, fmt.Errorf("invalid size %q: use a size like 512KB, 50MB or 1GB", value)
+	}
+	var n int64
+	fmt.Sscan(m[1], &n)
+	switch strings.ToLower(m[2]) {
+	case "kb":
+		n <<= 10
+	case "mb":
+		n <<= 20
+	case "gb":
+		n <<= 30
+	}
+	return n, nil
+}
+
+// cycleIDPattern matches a cycle ID
+var cycleIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validCycle reports whether value is a default cycle: current, next or a
+// cycle ID
+func validCycle(value string) bool {
+	return value == "" || value == "current" || value == "next" || cycleIDPattern.MatchString(value)
+}
+
+// SetAlias defines or replaces alias name in the home config, the only
+// one aliases are read from, and reports whether it replaced an existing
+// alias
+func (m *Manager) SetAlias(name, expansion string) (bool, error) {
+	m.UseHome()
+	cfg, err := readFile(m.configPath)
+	if err != nil {
+		return false, err
+	}
+	if cfg.Aliases == nil {
+		cfg.Aliases = map[string]string{}
+	}
+	_, replaced := cfg.Aliases[name]
+	cfg.Aliases[name] = expansion
+	return replaced, m.Save(cfg)
+}
+
+// DeleteAlias removes alias name from the home config, and reports
+// whether it was defined there
+func (m *Manager) DeleteAlias(name string) (bool, error) {
+	m.UseHome()
+	cfg, err := readFile(m.configPath)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := cfg.Aliases[name]; !ok {
+		return false, nil
+	}
+	delete(cfg.Aliases, name)
+	return true, m.Save(cfg)
+}
+
+// Path returns the configuration file path written by Set
 func (m *Manager) Path() string {
 	return m.configPath
 }
 
+// HomePath returns the home config path
+func (m *Manager) HomePath() string {
+	return m.homePath
+}
+
+// RepoPath returns the discovered repository config path, or ""
+func (m *Manager) RepoPath() string {
+	return m.repoPath
+}
+
+// UseHome makes Set write to the home config even inside a repository
+func (m *Manager) UseHome() {
+	if m.homePath != "" {
+		m.configPath = m.homePath
+	}
+}
+
 // IsConfigured returns whether the CLI is properly configured
 func (m *Manager) IsConfigured() bool {
 	cfg, err := m.Load()
@@ -145,3 +666,14 @@ func (m *Manager) IsConfigured() bool {
 	}
 	return cfg.APIKey != "" && cfg.TeamKey != ""
 }
+
+// splitList parses a comma-separated config value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,372 @@
+// Package daemon runs a long-lived process that keeps an authenticated
+// connection to Linear warm and serves API requests for CLI invocations
+// over a unix socket, so each invocation skips the credential lookup and
+// TLS handshake.
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// dirName is the directory holding the socket
+	dirName = "agent-linear-cli"
+
+	// socketName is the socket file name
+	socketName = "daemon.sock"
+
+	// hostHeader carries the Linear host a proxied request is for
+	hostHeader = "X-Linear-Host"
+
+	// statusPath and stopPath are the daemon's own endpoints
+	statusPath = "/_daemon/status"
+	stopPath   = "/_daemon/stop"
+
+	// dialTimeout bounds how long detecting the daemon may take
+	dialTimeout = 200 * time.Millisecond
+)
+
+// ProxiedHosts are the hosts whose requests go through the daemon. Other
+// hosts are never sent the daemon's credentials.
+var ProxiedHosts = []string{"api.linear.app", "uploads.linear.app"}
+
+// ErrNotRunning is returned when no daemon is listening on the socket
+var ErrNotRunning = errors.New("the daemon is not running")
+
+// SocketPath returns the daemon socket: $LINEAR_DAEMON_SOCKET, or
+// daemon.sock in $XDG_RUNTIME_DIR/agent-linear-cli or a per-user
+// directory under the system temp directory
+func SocketPath() string {
+	if path := os.Getenv("LINEAR_DAEMON_SOCKET"); path != "" {
+		return path
+	}
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return filepath.Join(dir, dirName, socketName)
+	}
+	return filepath.Join(os.TempDir(), fmt.Sprintf("%s-%d", dirName, os.Getuid()), socketName)
+}
+
+// Enabled reports whether the CLI may use a running daemon. Set
+// LINEAR_DAEMON=off to always call the API directly.
+func Enabled() bool {
+	switch strings.ToLower(os.Getenv("LINEAR_DAEMON")) {
+	case "off", "0", "false", "no":
+		return false
+	}
+	return true
+}
+
+func isProxied(host string) bool {
+	for _, h := range ProxiedHosts {
+		if host == h {
+			return true
+		}
+	}
+	return false
+}
+
+func dial(ctx context.Context, socket string) (net.Conn, error) {
+	var d net.Dialer
+	return d.DialContext(ctx, "unix", socket)
+}
+
+// Running reports whether a daemon is accepting connections on the socket.
+// A socket that fails checkSocket, such as one another user created under
+// the system temp directory, is never used.
+func Running() bool {
+	socket := SocketPath()
+	if err := checkSocket(socket); err != nil {
+		return false
+	}
+	conn, err := net.DialTimeout("unix", socket, dialTimeout)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// Transport returns a transport that sends Linear requests through the
+// running daemon and others directly, or nil when the daemon is disabled
+// or not running
+func Transport() http.RoundTripper {
+	if !Enabled() || !Running() {
+		return nil
+	}
+	return newTransport(SocketPath())
+}
+
+// transport rewrites requests for Linear hosts to the daemon socket
+type transport struct {
+	daemon http.RoundTripper
+	direct http.RoundTripper
+}
+
+func newTransport(socket string) *transport {
+	return &transport{
+		daemon: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dial(ctx, socket)
+			},
+			MaxIdleConns:    4,
+			IdleConnTimeout: 30 * time.Second,
+		},
+		direct: http.DefaultTransport,
+	}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isProxied(req.URL.Hostname()) {
+		return t.direct.RoundTrip(req)
+	}
+	out := req.Clone(req.Context())
+	out.Header.Set(hostHeader, req.URL.Host)
+	out.URL.Scheme = "http"
+	out.URL.Host = "daemon"
+	out.Host = "daemon"
+	return t.daemon.RoundTrip(out)
+}
+
+// Status describes a running daemon
+type Status struct {
+	PID          int        `json:"pid"`
+	Socket       string     `json:"socket"`
+	StartedAt    time.Time  `json:"startedAt"`
+	Requests     int64      `json:"requests"`
+	LastRefresh  *time.Time `json:"lastRefresh,omitempty"`
+	RefreshError string     `json:"refreshError,omitempty"`
+}
+
+// Server proxies requests to Linear with its credentials
+type Server struct {
+	// Token authorizes requests that do not carry their own Authorization
+	Token string
+	// Refresh, when set, is called every Interval to refresh the cache
+	Refresh  func(ctx context.Context) error
+	Interval time.Duration
+
+	startedAt time.Time
+	requests  atomic.Int64
+
+	mu           sync.Mutex
+	lastRefresh  time.Time
+	refreshError string
+
+	stop chan struct{}
+}
+
+// Serve listens on the socket until ctx is done or a client asks the
+// daemon to stop. It fails if another daemon is already running.
+func (s *Server) Serve(ctx context.Context) error {
+	socket := SocketPath()
+	if Running() {
+		return fmt.Errorf("a daemon is already running on %s", socket)
+	}
+	if err := os.MkdirAll(filepath.Dir(socket), 0700); err != nil {
+		return err
+	}
+	if os.Getenv("LINEAR_DAEMON_SOCKET") == "" {
+		// MkdirAll leaves an existing directory as it is, even one another
+		// user created first
+		if err := checkSocketDir(filepath.Dir(socket)); err != nil {
+			return fmt.Errorf("refusing to listen on %s: %w", socket, err)
+		}
+	}
+	// A socket left by a daemon that did not shut down cleanly
+	os.Remove(socket)
+
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(socket)
+	if err := os.Chmod(socket, 0600); err != nil {
+		listener.Close()
+		return err
+	}
+
+	s.startedAt = time.Now()
+	s.stop = make(chan struct{})
+	var stopOnce sync.Once
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(statusPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(s.status(socket))
+	})
+	mux.HandleFunc(stopPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		stopOnce.Do(func() { close(s.stop) })
+	})
+	mux.Handle("/", s.proxy())
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go s.refreshLoop(ctx)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-s.stop:
+		}
+		shutdownCtx, done := context.WithTimeout(context.Background(), 10*time.Second)
+		defer done()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// proxy forwards requests to the Linear host they name, over pooled
+// keep-alive connections
+func (s *Server) proxy() http.Handler {
+	upstream := http.DefaultTransport.(*http.Transport).Clone()
+	upstream.MaxIdleConnsPerHost = 16
+
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			host := r.In.Header.Get(hostHeader)
+			r.Out.URL.Scheme = "https"
+			r.Out.URL.Host = host
+			r.Out.Host = host
+			r.Out.Header.Del(hostHeader)
+			if r.Out.Header.Get("Authorization") == "" {
+				r.Out.Header.Set("Authorization", s.Token)
+			}
+		},
+		Transport: upstream,
+		// Report upstream failures to the client instead of the daemon's log
+		ErrorLog: log.New(io.Discard, "", 0),
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, "daemon: "+err.Error(), http.StatusBadGateway)
+		},
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isProxied(strings.Split(r.Header.Get(hostHeader), ":")[0]) {
+			http.Error(w, "unsupported host", http.StatusBadRequest)
+			return
+		}
+		s.requests.Add(1)
+		proxy.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) refreshLoop(ctx context.Context) {
+	if s.Refresh == nil || s.Interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		err := s.Refresh(ctx)
+		s.mu.Lock()
+		s.lastRefresh = time.Now()
+		s.refreshError = ""
+		if err != nil {
+			s.refreshError = err.Error()
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) status(socket string) Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := Status{
+		PID:          os.Getpid(),
+		Socket:       socket,
+		StartedAt:    s.startedAt,
+		Requests:     s.requests.Load(),
+		RefreshError: s.refreshError,
+	}
+	if !s.lastRefresh.IsZero() {
+		last := s.lastRefresh
+		status.LastRefresh = &last
+	}
+	return status
+}
+
+// controlClient talks to the daemon's own endpoints
+func controlClient() *http.Client {
+	socket := SocketPath()
+	return &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dial(ctx, socket)
+			},
+		},
+	}
+}
+
+// GetStatus asks the running daemon for its status
+func GetStatus(ctx context.Context) (*Status, error) {
+	if !Running() {
+		return nil, ErrNotRunning
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://daemon"+statusPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := controlClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var status Status
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("invalid daemon status: %w", err)
+	}
+	return &status, nil
+}
+
+// Stop asks the running daemon to shut down
+func Stop(ctx context.Context) error {
+	if !Running() {
+		return ErrNotRunning
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://daemon"+stopPath, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := controlClient().Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("daemon refused to stop: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+//go:build !unix
+
+package daemon
+
+import "os"
+
+// checkSocket makes sure socket exists. File ownership and modes are not
+// checked on this platform.
+func checkSocket(socket string) error {
+	_, err := os.Lstat(socket)
+	return err
+}
+
+// checkSocketDir accepts any directory on this platform
+func checkSocketDir(dir string) error {
+	return nil
+}
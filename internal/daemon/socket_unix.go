@@ -0,0 +1,58 @@
+//go:build unix
+
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// checkSocket makes sure socket is a socket owned by the current user in a
+// directory only that user can use, so another user on the machine cannot
+// stand in for the daemon and receive the requests, and the credentials,
+// the CLI sends it. The directory is not checked for a socket chosen with
+// $LINEAR_DAEMON_SOCKET.
+func checkSocket(socket string) error {
+	if os.Getenv("LINEAR_DAEMON_SOCKET") == "" {
+		if err := checkSocketDir(filepath.Dir(socket)); err != nil {
+			return err
+		}
+	}
+	info, err := os.Lstat(socket)
+	if err != nil {
+		return err
+	}
+	if info.Mode().Type() != os.ModeSocket {
+		return fmt.Errorf("%s is not a socket", socket)
+	}
+	return checkOwner(socket, info)
+}
+
+// checkSocketDir makes sure dir is a directory owned by the current user
+// with mode 0700
+func checkSocketDir(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	if err := checkOwner(dir, info); err != nil {
+		return err
+	}
+	if perm := info.Mode().Perm(); perm != 0700 {
+		return fmt.Errorf("%s has mode %#o; it must be 0700", dir, perm)
+	}
+	return nil
+}
+
+func checkOwner(path string, info os.FileInfo) error {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || int(stat.Uid) != os.Getuid() {
+		return fmt.Errorf("%s is not owned by the current user", path)
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+// Package dates provides calendar-aware date math shared by commands that
+// parse due dates, forecast delivery, or measure issue age.
+package dates
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/config"
+)
+
+// DateLayout is the date format used by Linear for date-only fields
+const DateLayout = "2006-01-02"
+
+// DefaultWorkdays are the working weekdays used when none are configured
+var DefaultWorkdays = []time.Weekday{
+	time.Monday,
+	time.Tuesday,
+	time.Wednesday,
+	time.Thursday,
+	time.Friday,
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Blackout is an inclusive range of non-working days
+type Blackout struct {
+	Name  string    `json:"name,omitempty"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Calendar knows which days are working days
+type Calendar struct {
+	workdays  map[time.Weekday]bool
+	holidays  map[string]bool
+	blackouts []Blackout
+}
+
+// Default returns a Monday-Friday calendar with no holidays
+func Default() *Calendar {
+	cal := &Calendar{
+		workdays: map[time.Weekday]bool{},
+		holidays: map[string]bool{},
+	}
+	for _, d := range DefaultWorkdays {
+		cal.workdays[d] = true
+	}
+	return cal
+}
+
+// New builds a calendar from configuration
+func New(cfg config.CalendarConfig) (*Calendar, error) {
+	cal := Default()
+
+	if len(cfg.Workdays) > 0 {
+		cal.workdays = map[time.Weekday]bool{}
+		for _, name := range cfg.Workdays {
+			key := strings.ToLower(strings.TrimSpace(name))
+			if len(key) > 3 {
+				key = key[:3]
+			}
+			day, ok := weekdayNames[key]
+			if !ok {
+				return nil, fmt.Errorf("invalid workday %q (use mon, tue, wed, thu, fri, sat, sun)", name)
+			}
+			cal.workdays[day] = true
+		}
+	}
+
+	for _, h := range cfg.Holidays {
+		day, err := time.Parse(DateLayout, strings.TrimSpace(h))
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday %q (use YYYY-MM-DD)", h)
+		}
+		cal.holidays[day.Format(DateLayout)] = true
+	}
+
+	for _, b := range cfg.Blackouts {
+		start, err := time.Parse(DateLayout, b.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blackout start %q (use YYYY-MM-DD)", b.Start)
+		}
+		end, err := time.Parse(DateLayout, b.End)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blackout end %q (use YYYY-MM-DD)", b.End)
+		}
+		if end.Before(start) {
+			return nil, fmt.Errorf("blackout %q ends before it starts", b.Name)
+		}
+		cal.blackouts = append(cal.blackouts, Blackout{Name: b.Name, Start: start, End: end})
+	}
+
+	return cal, nil
+}
+
+// Load builds the calendar from the user's config file. If the config cannot
+// be read or is invalid, the default calendar is returned with the error.
+func Load() (*Calendar, error) {
+	manager, err := config.NewManager()
+	if err != nil {
+		return Default(), err
+	}
+	cfg, err := manager.Load()
+	if err != nil {
+		return Default(), err
+	}
+	cal, err := New(cfg.Calendar)
+	if err != nil {
+		return Default(), err
+	}
+	return cal, nil
+}
+
+// IsHoliday reports whether t falls on a configured holiday
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	return c.holidays[t.Format(DateLayout)]
+}
+
+// Blackout returns the blackout period containing t, if any
+func (c *Calendar) Blackout(t time.Time) (Blackout, bool) {
+	// Compare calendar dates rather than instants so the location of t
+	// does not shift it across a blackout boundary
+	day := t.Format(DateLayout)
+	for _, b := range c.blackouts {
+		if day >= b.Start.Format(DateLayout) && day <= b.End.Format(DateLayout) {
+			return b, true
+		}
+	}
+	return Blackout{}, false
+}
+
+// IsWorkday reports whether t is a working day: a configured weekday that is
+// neither a holiday nor inside a blackout period
+func (c *Calendar) IsWorkday(t time.Time) bool {
+	if !c.workdays[t.Weekday()] || c.IsHoliday(t) {
+		return false
+	}
+	_, blackout := c.Blackout(t)
+	return !blackout
+}
+
+// NextWorkday returns t if it is a working day, otherwise the next one
+func (c *Calendar) NextWorkday(t time.Time) time.Time {
+	if len(c.workdays) == 0 {
+		return t
+	}
+	// Bound the search so a calendar blacked out for years cannot loop forever
+	for i := 0; i < 3660 && !c.IsWorkday(t); i++ {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t
+}
+
+// AddBusinessDays moves t forward (or backward for negative n) by n working days
+func (c *Calendar) AddBusinessDays(t time.Time, n int) time.Time {
+	if len(c.workdays) == 0 {
+		return t.AddDate(0, 0, n)
+	}
+
+	step := 1
+	if n < 0 {
+		step = -1
+		n = -n
+	}
+	// Bound consecutive non-working days so a calendar blacked out for
+	// years cannot loop forever
+	for skipped := 0; n > 0 && skipped < 3660; {
+		t = t.AddDate(0, 0, step)
+		if c.IsWorkday(t) {
+			n--
+			skipped = 0
+		} else {
+			skipped++
+		}
+	}
+	return t
+}
+
+// BusinessDaysBetween counts working days after from up to and including to.
+// The result is negative when to is before from.
+func (c *Calendar) BusinessDaysBetween(from, to time.Time) int {
+	from, to = truncateDay(from), truncateDay(to)
+	sign := 1
+	if to.Before(from) {
+		from, to = to, from
+		sign = -1
+	}
+
+	count := 0
+	for d := from.AddDate(0, 0, 1); !d.After(to); d = d.AddDate(0, 0, 1) {
+		if c.IsWorkday(d) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+var relativeOffsetPattern = regexp.MustCompile(`^(?:in\s+)?\+?(\d+)\s*(d|days?|bd|business\s+days?|w|weeks?|mo|months?)$`)
+
+var weekdayPattern = regexp.MustCompile(`^(this\s+|next\s+)?([a-z]+)$`)
+
+// monthDayLayouts are the accepted month-and-day forms, tried with and
+// without a year
+var monthDayLayouts = []string{"Jan 2", "January 2", "2 Jan", "2 January"}
+
+// Parse resolves a date expression relative to now. Supported forms are
+// YYYY-MM-DD, "today", "tomorrow", "eod", "+3d", "in 3 days", "+2w",
+// "in 2 months", "+3bd", "in 3 business days", weekdays ("friday",
+// "next fri"), "next week", "next month", "end of week", "end of month"
+// and month days ("mar 15", "March 15, 2026"). Business-day offsets skip
+// weekends, holidays, and blackout periods.
+//
+// A bare or "this" weekday is its next occurrence, today included; "next"
+// skips today. "next week" is the first workday of next week, and "end of
+// week" and "end of month" are the last workday of the period.
+func (c *Calendar) Parse(expr string, now time.Time) (time.Time, error) {
+	s := strings.Join(strings.Fields(strings.ToLower(expr)), " ")
+	today := truncateDay(now)
+
+	switch s {
+	case "":
+		return time.Time{}, fmt.Errorf("empty date")
+	case "today", "eod", "end of day", "tonight":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "next business day", "next workday":
+		return c.AddBusinessDays(today, 1), nil
+	case "eow", "end of week", "end of the week":
+		return c.endOfWeek(today), nil
+	case "next week":
+		return c.NextWorkday(weekStart(today).AddDate(0, 0, 7)), nil
+	case "eom", "end of month", "end of the month":
+		return c.endOfMonth(today), nil
+	case "next month":
+		return time.Date(today.Year(), today.Month()+1, 1, 0, 0, 0, 0, today.Location()), nil
+	}
+
+	if t, err := time.ParseInLocation(DateLayout, s, now.Location()); err == nil {
+		return t, nil
+	}
+
+	if m := relativeOffsetPattern.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		unit := m[2]
+		switch {
+		case unit == "bd" || strings.HasPrefix(unit, "business"):
+			return c.AddBusinessDays(today, n), nil
+		case unit == "w" || strings.HasPrefix(unit, "week"):
+			return today.AddDate(0, 0, 7*n), nil
+		case strings.HasPrefix(unit, "mo"):
+			return today.AddDate(0, n, 0), nil
+		default:
+			return today.AddDate(0, 0, n), nil
+		}
+	}
+
+	if m := weekdayPattern.FindStringSubmatch(s); m != nil {
+		if day, ok := parseWeekday(m[2]); ok {
+			days := (int(day) - int(today.Weekday()) + 7) % 7
+			if days == 0 && strings.HasPrefix(m[1], "next") {
+				days = 7
+			}
+			return today.AddDate(0, 0, days), nil
+		}
+	}
+
+	if t, ok := parseMonthDay(s, today); ok {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized date %q (use YYYY-MM-DD, today, tomorrow, friday, \"next week\", +3d, \"in 2 weeks\", or \"in 3 business days\")", expr)
+}
+
+// parseWeekday matches a weekday by its full or three-letter name
+func parseWeekday(s string) (time.Weekday, bool) {
+	for name, day := range weekdayNames {
+		if s == name || s == strings.ToLower(day.String()) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// parseMonthDay parses forms like "mar 15" or "march 15, 2026". Without a
+// year it is the next such day, today included.
+func parseMonthDay(s string, today time.Time) (time.Time, bool) {
+	s = strings.ReplaceAll(s, ",", "")
+	for _, layout := range monthDayLayouts {
+		if t, err := time.ParseInLocation(layout+" 2006", s, today.Location()); err == nil {
+			return t, true
+		}
+		if t, err := time.ParseInLocation(layout, s, today.Location()); err == nil {
+			t = time.Date(today.Year(), t.Month(), t.Day(), 0, 0, 0, 0, today.Location())
+			if t.Before(today) {
+				t = t.AddDate(1, 0, 0)
+			}
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// weekStart returns the Monday of t's week
+func weekStart(t time.Time) time.Time {
+	return t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+}
+
+// endOfWeek returns the last workday of t's week, or of the next week when
+// this week has none left
+func (c *Calendar) endOfWeek(t time.Time) time.Time {
+	end := weekStart(t).AddDate(0, 0, 7)
+	if day, ok := c.lastWorkday(t, end); ok {
+		return day
+	}
+	day, _ := c.lastWorkday(end, end.AddDate(0, 0, 7))
+	return day
+}
+
+// endOfMonth returns the last workday of t's month, or its last day when
+// no workday is left
+func (c *Calendar) endOfMonth(t time.Time) time.Time {
+	day, _ := c.lastWorkday(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location()))
+	return day
+}
+
+// lastWorkday returns the last workday from from up to the day before end.
+// When there is none it returns the day before end and false.
+func (c *Calendar) lastWorkday(from, end time.Time) (time.Time, bool) {
+	for d := end.AddDate(0, 0, -1); !d.Before(from); d = d.AddDate(0, 0, -1) {
+		if c.IsWorkday(d) {
+			return d, true
+		}
+	}
+	return end.AddDate(0, 0, -1), false
+}
+
+// truncateDay strips the time of day, keeping the location
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
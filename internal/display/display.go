@@ -41,8 +41,13 @@ func PriorityName(priority int) string {
 	return "Unknown"
 }
 
-// TimeAgo returns a human-readable relative time string
+// TimeAgo returns a human-readable relative time string, or an absolute
+// timestamp when ISO output is enabled
 func TimeAgo(t time.Time) string {
+	if timeOptions.ISO {
+		return FormatISO(t)
+	}
+
 	now := time.Now()
 	diff := now.Sub(t)
 
@@ -88,8 +93,13 @@ func TimeAgo(t time.Time) string {
 	}
 }
 
-// TimeAgoShort returns a short relative time string
+// TimeAgoShort returns a short relative time string, or an absolute
+// timestamp when ISO output is enabled
 func TimeAgoShort(t time.Time) string {
+	if timeOptions.ISO {
+		return FormatISO(t)
+	}
+
 	now := time.Now()
 	diff := now.Sub(t)
 
@@ -111,19 +121,24 @@ func TimeAgoShort(t time.Time) string {
 	}
 }
 
-// FormatDate formats a time as a date string
+// FormatDate formats a time as a date string in the configured time zone
+// and date format
 func FormatDate(t time.Time) string {
-	return t.Format("2006-01-02")
+	return localize(t).Format(dateLayout("2006-01-02"))
 }
 
-// FormatDateTime formats a time as a datetime string
+// FormatDateTime formats a time as a datetime string in the configured
+// time zone and date format
 func FormatDateTime(t time.Time) string {
-	return t.Format("2006-01-02 15:04")
+	if timeOptions.ISO {
+		return FormatISO(t)
+	}
+	return localize(t).Format(dateLayout("2006-01-02") + " 15:04")
 }
 
-// FormatISO formats a time as ISO 8601
+// FormatISO formats a time as ISO 8601 in the configured time zone
 func FormatISO(t time.Time) string {
-	return t.Format(time.RFC3339)
+	return localize(t).Format(time.RFC3339)
 }
 
 // ParseISO parses an ISO 8601 string
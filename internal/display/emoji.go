@@ -0,0 +1,62 @@
+package display
+
+import "strings"
+
+// emojiNames maps common reaction emoji to the names Linear stores
+var emojiNames = map[string]string{
+	"👍":  "+1",
+	"👎":  "-1",
+	"❤️": "heart",
+	"❤":  "heart",
+	"🎉":  "tada",
+	"👀":  "eyes",
+	"🚀":  "rocket",
+	"😄":  "smile",
+	"😂":  "joy",
+	"😕":  "confused",
+	"🙏":  "pray",
+	"🔥":  "fire",
+	"✅":  "white_check_mark",
+	"💯":  "100",
+	"👏":  "clap",
+	"🤔":  "thinking_face",
+}
+
+// emojiAliases maps alternative names to the stored name
+var emojiAliases = map[string]string{
+	"thumbsup":   "+1",
+	"thumbs_up":  "+1",
+	"thumbsdown": "-1",
+	"check":      "white_check_mark",
+	"thinking":   "thinking_face",
+	"hooray":     "tada",
+	"laugh":      "smile",
+}
+
+// EmojiName normalizes a reaction emoji given as a character, a
+// :shortcode: or a name to the name Linear stores
+func EmojiName(emoji string) string {
+	emoji = strings.TrimSpace(emoji)
+	if name, ok := emojiNames[emoji]; ok {
+		return name
+	}
+	name := strings.ToLower(strings.Trim(emoji, ":"))
+	if alias, ok := emojiAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+// EmojiGlyph returns the character for a stored emoji name, or the name
+// as :name: when it is not a known reaction
+func EmojiGlyph(name string) string {
+	for glyph, n := range emojiNames {
+		if n == name && glyph != "❤" {
+			return glyph
+		}
+	}
+	if strings.ContainsFunc(name, func(r rune) bool { return r > 0x7f }) {
+		return name
+	}
+	return ":" + name + ":"
+}
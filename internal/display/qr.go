@@ -0,0 +1,497 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QR codes are encoded in byte mode at error correction level M, versions
+// 1-10, which holds up to 213 bytes: enough for any Linear URL.
+
+// qrQuietZone is the light border around the code, in modules. The QR
+// specification requires four; scanners often fail to find a code with less.
+const qrQuietZone = 4
+
+// qrVersion describes the codeword layout of a QR version at level M
+type qrVersion struct {
+	ecPerBlock int
+	groups     [][2]int // {block count, data codewords per block}
+	alignment  []int
+	remainder  int
+}
+
+var qrVersions = []qrVersion{
+	1:  {10, [][2]int{{1, 16}}, nil, 0},
+	2:  {16, [][2]int{{1, 28}}, []int{6, 18}, 7},
+	3:  {26, [][2]int{{1, 44}}, []int{6, 22}, 7},
+	4:  {18, [][2]int{{2, 32}}, []int{6, 26}, 7},
+	5:  {24, [][2]int{{2, 43}}, []int{6, 30}, 7},
+	6:  {16, [][2]int{{4, 27}}, []int{6, 34}, 7},
+	7:  {18, [][2]int{{4, 31}}, []int{6, 22, 38}, 0},
+	8:  {22, [][2]int{{2, 38}, {2, 39}}, []int{6, 24, 42}, 0},
+	9:  {22, [][2]int{{3, 36}, {2, 37}}, []int{6, 26, 46}, 0},
+	10: {26, [][2]int{{4, 43}, {1, 44}}, []int{6, 28, 50}, 0},
+}
+
+// dataCodewords returns the number of data codewords in the version
+func (v qrVersion) dataCodewords() int {
+	n := 0
+	for _, g := range v.groups {
+		n += g[0] * g[1]
+	}
+	return n
+}
+
+// qrCode is a square grid of modules; true is dark
+type qrCode struct {
+	size     int
+	modules  [][]bool
+	function [][]bool
+}
+
+// QR renders text as a QR code using Unicode half blocks, two module rows
+// per line. Light modules are drawn as blocks so the code scans on the
+// usual light-on-dark terminal.
+func QR(text string) (string, error) {
+	code, err := encodeQR([]byte(text))
+	if err != nil {
+		return "", err
+	}
+
+	light := func(x, y int) bool {
+		if x < 0 || y < 0 || x >= code.size || y >= code.size {
+			return true
+		}
+		return !code.modules[y][x]
+	}
+
+	var b strings.Builder
+	for y := -qrQuietZone; y < code.size+qrQuietZone; y += 2 {
+		for x := -qrQuietZone; x < code.size+qrQuietZone; x++ {
+			top, bottom := light(x, y), light(x, y+1)
+			switch {
+			case top && bottom:
+				b.WriteString("█")
+			case top:
+				b.WriteString("▀")
+			case bottom:
+				b.WriteString("▄")
+			default:
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String(), nil
+}
+
+// encodeQR builds the smallest QR code that holds data
+func encodeQR(data []byte) (*qrCode, error) {
+	version := 0
+	for v := 1; v < len(qrVersions); v++ {
+		if qrDataBits(v, len(data)) <= qrVersions[v].dataCodewords()*8 {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("text is too long for a QR code (%d bytes)", len(data))
+	}
+
+	codewords := qrAddErrorCorrection(qrDataCodewords(version, data), qrVersions[version])
+
+	code := newQRCode(version)
+	code.placeData(codewords)
+
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		code.applyMask(mask)
+		code.drawFormatBits(mask)
+		if p := code.penalty(); bestPenalty < 0 || p < bestPenalty {
+			best, bestPenalty = mask, p
+		}
+		code.applyMask(mask) // masking is an XOR, so this undoes it
+	}
+	code.applyMask(best)
+	code.drawFormatBits(best)
+
+	return code, nil
+}
+
+// qrDataBits is the encoded size of n bytes in byte mode
+func qrDataBits(version, n int) int {
+	return 4 + qrCountBits(version) + 8*n
+}
+
+// qrCountBits is the width of the byte mode character count
+func qrCountBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+	return 16
+}
+
+// qrDataCodewords encodes data in byte mode, padded to the version's capacity
+func qrDataCodewords(version int, data []byte) []byte {
+	capacity := qrVersions[version].dataCodewords()
+	bits := &qrBitBuffer{}
+	bits.append(0x4, 4)
+	bits.append(len(data), qrCountBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+	bits.append(0, min(4, capacity*8-len(*bits)))
+	bits.append(0, (8-len(*bits)%8)%8)
+
+	codewords := make([]byte, 0, capacity)
+	for i := 0; i < len(*bits); i += 8 {
+		var b byte
+		for j := 0; j < 8; j++ {
+			if (*bits)[i+j] {
+				b |= 1 << (7 - j)
+			}
+		}
+		codewords = append(codewords, b)
+	}
+	for pad := byte(0xEC); len(codewords) < capacity; pad ^= 0xEC ^ 0x11 {
+		codewords = append(codewords, pad)
+	}
+	return codewords
+}
+
+// qrBitBuffer is a sequence of bits, most significant first
+type qrBitBuffer []bool
+
+func (b *qrBitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*b = append(*b, (value>>i)&1 == 1)
+	}
+}
+
+// qrAddErrorCorrection splits data into blocks, computes each block's
+// Reed-Solomon codewords and interleaves the result
+func qrAddErrorCorrection(data []byte, v qrVersion) []byte {
+	divisor := rsDivisor(v.ecPerBlock)
+
+	var blocks, ecBlocks [][]byte
+	offset := 0
+	for _, g := range v.groups {
+		for i := 0; i < g[0]; i++ {
+			block := data[offset : offset+g[1]]
+			offset += g[1]
+			blocks = append(blocks, block)
+			ecBlocks = append(ecBlocks, rsRemainder(block, divisor))
+		}
+	}
+
+	result := []byte{}
+	for i := 0; ; i++ {
+		wrote := false
+		for _, block := range blocks {
+			if i < len(block) {
+				result = append(result, block[i])
+				wrote = true
+			}
+		}
+		if !wrote {
+			break
+		}
+	}
+	for i := 0; i < v.ecPerBlock; i++ {
+		for _, ec := range ecBlocks {
+			result = append(result, ec[i])
+		}
+	}
+	return result
+}
+
+// gfMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1
+func gfMultiply(x, y byte) byte {
+	var z int
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+// rsDivisor returns the Reed-Solomon generator polynomial of a degree,
+// highest coefficient first, without the leading 1
+func rsDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+// rsRemainder returns the error correction codewords for data
+func rsRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, d := range divisor {
+			result[i] ^= gfMultiply(d, factor)
+		}
+	}
+	return result
+}
+
+// newQRCode draws the function patterns of a version
+func newQRCode(version int) *qrCode {
+	size := version*4 + 17
+	code := &qrCode{size: size, modules: make([][]bool, size), function: make([][]bool, size)}
+	for i := range code.modules {
+		code.modules[i] = make([]bool, size)
+		code.function[i] = make([]bool, size)
+	}
+
+	for i := 0; i < size; i++ {
+		code.set(6, i, i%2 == 0)
+		code.set(i, 6, i%2 == 0)
+	}
+
+	code.drawFinder(3, 3)
+	code.drawFinder(size-4, 3)
+	code.drawFinder(3, size-4)
+
+	align := qrVersions[version].alignment
+	last := len(align) - 1
+	for i, y := range align {
+		for j, x := range align {
+			// Skip the corners occupied by finder patterns
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			code.drawAlignment(x, y)
+		}
+	}
+
+	// Reserve the format areas; the bits are drawn once a mask is chosen
+	code.drawFormatBits(0)
+	code.drawVersionBits(version)
+
+	return code
+}
+
+// set sets a function module at column x, row y
+func (c *qrCode) set(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.function[y][x] = true
+}
+
+func (c *qrCode) drawFinder(cx, cy int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			x, y := cx+dx, cy+dy
+			if x < 0 || y < 0 || x >= c.size || y >= c.size {
+				continue
+			}
+			dist := max(abs(dx), abs(dy))
+			c.set(x, y, dist != 2 && dist != 4)
+		}
+	}
+}
+
+func (c *qrCode) drawAlignment(cx, cy int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.set(cx+dx, cy+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// drawFormatBits draws both copies of the level M format information
+func (c *qrCode) drawFormatBits(mask int) {
+	data := mask // level M is 00
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+	bit := func(i int) bool { return (bits>>i)&1 == 1 }
+
+	for i := 0; i <= 5; i++ {
+		c.set(8, i, bit(i))
+	}
+	c.set(8, 7, bit(6))
+	c.set(8, 8, bit(7))
+	c.set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		c.set(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		c.set(c.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		c.set(8, c.size-15+i, bit(i))
+	}
+	c.set(8, c.size-8, true)
+}
+
+// drawVersionBits draws the version information used from version 7 on
+func (c *qrCode) drawVersionBits(version int) {
+	if version < 7 {
+		return
+	}
+	rem := version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	bits := version<<12 | rem
+	for i := 0; i < 18; i++ {
+		dark := (bits>>i)&1 == 1
+		a, b := c.size-11+i%3, i/3
+		c.set(a, b, dark)
+		c.set(b, a, dark)
+	}
+}
+
+// placeData fills the non-function modules in the standard zigzag order
+func (c *qrCode) placeData(codewords []byte) {
+	i := 0
+	for right := c.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < c.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = c.size - 1 - vert
+				}
+				if !c.function[y][x] && i < len(codewords)*8 {
+					c.modules[y][x] = (codewords[i>>3]>>(7-i&7))&1 == 1
+					i++
+				}
+			}
+		}
+	}
+}
+
+// applyMask XORs a mask pattern over the data modules
+func (c *qrCode) applyMask(mask int) {
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			if c.function[y][x] {
+				continue
+			}
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			c.modules[y][x] = c.modules[y][x] != invert
+		}
+	}
+}
+
+// penalty scores how hard the code is to scan; lower is better
+func (c *qrCode) penalty() int {
+	score := 0
+	dark := 0
+
+	line := make([]bool, c.size)
+	for _, horizontal := range []bool{true, false} {
+		for i := 0; i < c.size; i++ {
+			for j := 0; j < c.size; j++ {
+				if horizontal {
+					line[j] = c.modules[i][j]
+				} else {
+					line[j] = c.modules[j][i]
+				}
+			}
+			score += qrLinePenalty(line)
+		}
+	}
+
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			if c.modules[y][x] {
+				dark++
+			}
+			if x+1 < c.size && y+1 < c.size {
+				m := c.modules[y][x]
+				if m == c.modules[y][x+1] && m == c.modules[y+1][x] && m == c.modules[y+1][x+1] {
+					score += 3
+				}
+			}
+		}
+	}
+
+	percent := dark * 100 / (c.size * c.size)
+	score += abs(percent-50) / 5 * 10
+	return score
+}
+
+// qrFinderLike is the 1:1:3:1:1 pattern with light space on one side
+var qrFinderLike = [][]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+// qrLinePenalty scores runs of five or more same-colored modules and
+// finder-like patterns in a row or column
+func qrLinePenalty(line []bool) int {
+	score := 0
+	run := 1
+	for i := 1; i <= len(line); i++ {
+		if i < len(line) && line[i] == line[i-1] {
+			run++
+			continue
+		}
+		if run >= 5 {
+			score += run - 2
+		}
+		run = 1
+	}
+
+	for i := 0; i+len(qrFinderLike[0]) <= len(line); i++ {
+		for _, pattern := range qrFinderLike {
+			match := true
+			for j, p := range pattern {
+				if line[i+j] != p {
+					match = false
+					break
+				}
+			}
+			if match {
+				score += 40
+			}
+		}
+	}
+	return score
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
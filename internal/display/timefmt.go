@@ -0,0 +1,89 @@
+package display
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DateFormats are the named date formats accepted by the date_format
+// setting, as Go layouts. Any other value must be a Go layout itself.
+var DateFormats = map[string]string{
+	"iso":  "2006-01-02",
+	"us":   "01/02/2006",
+	"eu":   "02/01/2006",
+	"long": "Jan 02, 2006",
+}
+
+// TimeOptions control how times are rendered in human output
+type TimeOptions struct {
+	// Location is the time zone times are shown in; nil means local time
+	Location *time.Location
+	// DateLayout is the Go layout for dates; empty keeps each caller's default
+	DateLayout string
+	// ISO replaces relative times and custom layouts with RFC 3339
+	// timestamps and YYYY-MM-DD dates, for machine-stable output
+	ISO bool
+}
+
+var timeOptions TimeOptions
+
+// SetTimeOptions sets how times are rendered
+func SetTimeOptions(opts TimeOptions) {
+	timeOptions = opts
+}
+
+// ParseDateFormat resolves a date_format value (a name from DateFormats or
+// a Go layout such as "02.01.2006") to a Go layout
+func ParseDateFormat(format string) (string, error) {
+	format = strings.TrimSpace(format)
+	if layout, ok := DateFormats[strings.ToLower(format)]; ok {
+		return layout, nil
+	}
+	if strings.Contains(format, "2006") || strings.Contains(format, "06") {
+		return format, nil
+	}
+
+	names := make([]string, 0, len(DateFormats))
+	for name := range DateFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return "", fmt.Errorf("invalid date format %q (use %s, or a Go layout like 02.01.2006)", format, strings.Join(names, ", "))
+}
+
+// FormatDay formats a calendar date using the configured date format, or
+// defaultLayout when none is set. Dates are not shifted between time zones.
+func FormatDay(t time.Time, defaultLayout string) string {
+	return t.Format(dateLayout(defaultLayout))
+}
+
+// FormatDueDate formats a YYYY-MM-DD date such as an issue due date.
+// Values that do not parse are returned unchanged.
+func FormatDueDate(s string) string {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return s
+	}
+	return FormatDay(t, "2006-01-02")
+}
+
+// localize converts t to the configured time zone
+func localize(t time.Time) time.Time {
+	if timeOptions.Location != nil {
+		return t.In(timeOptions.Location)
+	}
+	return t.Local()
+}
+
+// dateLayout returns the configured date layout, or defaultLayout
+func dateLayout(defaultLayout string) string {
+	if timeOptions.ISO {
+		return "2006-01-02"
+	}
+	if timeOptions.DateLayout != "" {
+		return timeOptions.DateLayout
+	}
+	return defaultLayout
+}
@@ -0,0 +1,214 @@
+// Package history keeps a local record of recently viewed and edited
+// issues, projects and documents, and of the user's favorites.
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/state"
+)
+
+const (
+	// MaxRecent bounds the number of recent items kept
+	MaxRecent = 100
+
+	// DisableEnv turns off recording when set to "off" (or "0", "false")
+	DisableEnv = "LINEAR_HISTORY"
+
+	recentFile    = "recent.json"
+	favoritesFile = "favorites.json"
+)
+
+// Item kinds
+const (
+	KindIssue    = "issue"
+	KindProject  = "project"
+	KindDocument = "document"
+)
+
+// Actions recorded on items
+const (
+	ActionViewed  = "viewed"
+	ActionCreated = "created"
+	ActionEdited  = "edited"
+)
+
+// Kinds lists the valid item kinds
+var Kinds = []string{KindIssue, KindProject, KindDocument}
+
+// Item is an issue, project or document the user touched or favorited
+type Item struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+	// Key is the issue identifier (ENG-123) or slug, if any
+	Key   string `json:"key,omitempty"`
+	Title string `json:"title,omitempty"`
+	URL   string `json:"url,omitempty"`
+	// Action is what was last done: viewed, created or edited
+	Action string    `json:"action,omitempty"`
+	At     time.Time `json:"at"`
+}
+
+// Ref returns the most readable reference to the item
+func (i Item) Ref() string {
+	if i.Key != "" {
+		return i.Key
+	}
+	return i.ID
+}
+
+// Matches reports whether ref names the item by ID or key
+func (i Item) Matches(ref string) bool {
+	return strings.EqualFold(i.ID, ref) || (i.Key != "" && strings.EqualFold(i.Key, ref))
+}
+
+// Store reads and writes history files in a directory
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store in $XDG_STATE_HOME/agent-linear-cli, falling
+// back to ~/.local/state
+func NewStore() (*Store, error) {
+	dir, err := state.Home()
+	if err != nil {
+		return nil, err
+	}
+	return &Store{dir: dir}, nil
+}
+
+// Enabled reports whether recording is on; LINEAR_HISTORY=off disables it
+func Enabled() bool {
+	switch strings.ToLower(os.Getenv(DisableEnv)) {
+	case "off", "0", "false", "no":
+		return false
+	}
+	return true
+}
+
+// Recent returns recently touched items, most recent first
+func (s *Store) Recent() ([]Item, error) {
+	return s.read(recentFile)
+}
+
+// Touch records item as the most recent, replacing any earlier entry for it
+func (s *Store) Touch(item Item) error {
+	items, err := s.read(recentFile)
+	if err != nil {
+		return err
+	}
+	if item.At.IsZero() {
+		item.At = time.Now().UTC()
+	}
+	for _, existing := range items {
+		if existing.Kind == item.Kind && existing.ID == item.ID {
+			// Keep what an update response could not tell us
+			if item.Title == "" {
+				item.Title = existing.Title
+			}
+			if item.Key == "" {
+				item.Key = existing.Key
+			}
+			if item.URL == "" {
+				item.URL = existing.URL
+			}
+		}
+	}
+
+	recent := []Item{item}
+	for _, existing := range items {
+		if existing.Kind == item.Kind && existing.ID == item.ID {
+			continue
+		}
+		if len(recent) == MaxRecent {
+			break
+		}
+		recent = append(recent, existing)
+	}
+	return s.write(recentFile, recent)
+}
+
+// ClearRecent forgets all recent items
+func (s *Store) ClearRecent() error {
+	err := os.Remove(filepath.Join(s.dir, recentFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Last returns the most recent item of kind, or nil when there is none
+func (s *Store) Last(kind string) (*Item, error) {
+	items, err := s.read(recentFile)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		if items[i].Kind == kind {
+			return &items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Favorites returns favorited items in the order they were added
+func (s *Store) Favorites() ([]Item, error) {
+	return s.read(favoritesFile)
+}
+
+// AddFavorite adds item to the favorites, updating it if already there.
+// It reports whether the item is new.
+func (s *Store) AddFavorite(item Item) (bool, error) {
+	items, err := s.read(favoritesFile)
+	if err != nil {
+		return false, err
+	}
+	if item.At.IsZero() {
+		item.At = time.Now().UTC()
+	}
+	for i, existing := range items {
+		if existing.Kind == item.Kind && existing.ID == item.ID {
+			item.At = existing.At
+			items[i] = item
+			return false, s.write(favoritesFile, items)
+		}
+	}
+	return true, s.write(favoritesFile, append(items, item))
+}
+
+// RemoveFavorite removes the favorites matching ref by ID or key and
+// returns them
+func (s *Store) RemoveFavorite(ref string) ([]Item, error) {
+	items, err := s.read(favoritesFile)
+	if err != nil {
+		return nil, err
+	}
+	kept := []Item{}
+	removed := []Item{}
+	for _, item := range items {
+		if item.Matches(ref) {
+			removed = append(removed, item)
+			continue
+		}
+		kept = append(kept, item)
+	}
+	if len(removed) == 0 {
+		return removed, nil
+	}
+	return removed, s.write(favoritesFile, kept)
+}
+
+func (s *Store) read(name string) ([]Item, error) {
+	items := []Item{}
+	if err := state.ReadJSON(filepath.Join(s.dir, name), &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s *Store) write(name string, items []Item) error {
+	return state.WriteJSON(filepath.Join(s.dir, name), items)
+}
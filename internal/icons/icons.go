@@ -0,0 +1,602 @@
+// Package icons validates and searches the icons Linear accepts for
+// projects, documents and teams: the named icons of Linear's icon picker,
+// and emoji, given as the character itself or as a :shortcode:.
+package icons
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Kinds of icon
+const (
+	KindIcon  = "icon"
+	KindEmoji = "emoji"
+)
+
+// Icon is an icon or emoji Linear accepts
+type Icon struct {
+	// Name is the value to pass to --icon: the icon name, or the emoji's
+	// :shortcode:
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	// Emoji is the character an emoji shortcode stands for
+	Emoji    string   `json:"emoji,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// namedIcons are the icons of Linear's icon picker, each followed by the
+// words it can be found by
+const namedIcons = `
+Airplane travel flight plane
+Alert warning attention
+Anchor marine stable
+Archive box storage
+Atom science physics
+Basketball sport game
+Bell notification alert
+Bike cycle ride
+Book docs reading documentation
+Bookmark save
+Briefcase business work
+Bug defect error issue
+Building office company
+Calendar date schedule
+Camera photo
+Car drive vehicle
+Chart graph analytics metrics
+Chat message conversation
+Checklist todo tasks
+Chip hardware cpu
+Clock time schedule
+Cloud infrastructure hosting
+Code development programming
+Coffee break
+Compass navigation direction
+Computer desktop
+Cone construction
+Creditcard payment billing card
+Crown premium
+Cube 3d package
+Database data storage sql
+Diamond gem premium
+Dollar money finance pricing
+Download install
+Education learning school
+Email mail inbox
+Eye view visibility
+Factory manufacturing
+Feather light writing
+Flag milestone goal
+Flame fire hot
+Flask lab experiment
+Folder files directory
+Gamepad gaming game
+Gears settings configuration
+Gift present
+Globe world international web
+Hammer build tools
+Handshake partnership deal
+Headphones support audio
+Heart health favorite love
+Home house
+Image picture media
+Inbox triage
+Key security access
+Lab research experiment
+Laptop computer
+Leaf nature green sustainability
+Lightbulb idea innovation
+Lightning fast performance
+Link url connection
+Lock security privacy
+Magic ai sparkle
+Map location plan
+Megaphone marketing announcement
+Microphone podcast audio
+Mobile phone app
+Moon night dark
+Mountain goal challenge
+Music audio
+Notebook notes
+Package box release shipping
+Paint design brush art
+Paperclip attachment
+People team users
+Phone call
+Pin location
+Planet space
+Plug integration
+Present gift
+Puzzle integration plugin
+Robot automation bot ai
+Rocket launch ship release
+Ruler measure design
+Scale legal balance
+Search find
+Server backend infrastructure
+Shield security protection
+Shop store ecommerce
+Sparkle magic new
+Star favorite rating
+Sun day light
+Tag label
+Target goal objective
+Terminal cli shell command
+Ticket support
+Tool wrench maintenance
+Trophy win award
+Truck delivery shipping logistics
+Umbrella insurance coverage
+User person account
+Video film
+Wallet payment
+Wrench fix maintenance
+`
+
+// emojiShortcodes are common emoji by shortcode, each followed by the
+// character and the words it can be found by
+const emojiShortcodes = `
+rocket 🚀 launch ship release
+sparkles ✨ new magic
+fire 🔥 hot urgent
+bug 🐛 defect error
+beetle 🪲 bug insect
+zap ⚡ lightning fast performance
+star ⭐ favorite
+star2 🌟 glowing star
+tada 🎉 celebration party release
+trophy 🏆 win award
+dart 🎯 target goal
+bulb 💡 idea
+gear ⚙️ settings configuration
+wrench 🔧 fix tool
+hammer 🔨 build tool
+hammer_and_wrench 🛠️ tools maintenance
+toolbox 🧰 tools
+lock 🔒 security
+key 🔑 access security
+shield 🛡️ security protection
+package 📦 box release
+books 📚 docs documentation
+book 📖 docs reading
+memo 📝 notes writing
+page_facing_up 📄 document
+clipboard 📋 checklist
+pushpin 📌 pin
+round_pushpin 📍 location pin
+calendar 📅 date schedule
+date 📅 calendar
+spiral_calendar 🗓️ calendar schedule
+chart_with_upwards_trend 📈 growth metrics
+chart_with_downwards_trend 📉 decline metrics
+bar_chart 📊 analytics metrics
+mag 🔍 search
+globe_with_meridians 🌐 web international
+earth_americas 🌎 world globe
+computer 💻 laptop development
+desktop_computer 🖥️ computer
+iphone 📱 mobile phone app
+robot 🤖 automation bot ai
+brain 🧠 ai thinking
+test_tube 🧪 experiment test
+microscope 🔬 research
+telescope 🔭 research discovery
+satellite 🛰️ space
+cloud ☁️ infrastructure
+floppy_disk 💾 save storage
+cd 💿 disk
+electric_plug 🔌 integration
+link 🔗 url connection
+paperclip 📎 attachment
+email 📧 mail
+envelope ✉️ mail
+inbox_tray 📥 inbox
+outbox_tray 📤 outbox send
+mailbox 📫 mail
+speech_balloon 💬 chat comment
+loudspeaker 📢 announcement
+mega 📣 marketing announcement
+bell 🔔 notification
+warning ⚠️ alert
+rotating_light 🚨 incident alert
+construction 🚧 wip work in progress
+no_entry ⛔ blocked
+x ❌ cancel failed
+white_check_mark ✅ done complete
+heavy_check_mark ✔️ done
+ballot_box_with_check ☑️ done checklist
+question ❓ question
+exclamation ❗ important
+hourglass ⏳ waiting
+stopwatch ⏱️ time performance
+alarm_clock ⏰ deadline
+moneybag 💰 money finance
+dollar 💵 money pricing
+credit_card 💳 payment billing
+gem 💎 premium diamond
+gift 🎁 present
+art 🎨 design
+paintbrush 🖌️ design
+lipstick 💄 ui style
+pencil2 ✏️ edit writing
+triangular_ruler 📐 design measure
+straight_ruler 📏 measure
+jigsaw 🧩 puzzle integration plugin
+seedling 🌱 growth new
+herb 🌿 nature
+deciduous_tree 🌳 tree nature
+evergreen_tree 🌲 tree
+leaves 🍃 nature
+four_leaf_clover 🍀 luck
+sunflower 🌻 flower
+rainbow 🌈 diversity
+sunny ☀️ sun
+crescent_moon 🌙 moon night
+snowflake ❄️ freeze winter
+ocean 🌊 wave water
+mountain ⛰️ challenge
+snow_capped_mountain 🏔️ mountain goal
+volcano 🌋 eruption
+house 🏠 home
+office 🏢 building company
+factory 🏭 manufacturing
+hospital 🏥 health
+school 🏫 education
+bank 🏦 finance
+shopping_cart 🛒 ecommerce shop
+shopping_bags 🛍️ shop store
+truck 🚚 delivery shipping
+airplane ✈️ travel flight
+car 🚗 drive
+bike 🚲 cycle
+ship 🚢 boat shipping
+anchor ⚓ marine
+compass 🧭 navigation direction
+world_map 🗺️ map plan
+crown 👑 premium
+medal 🏅 award
+sports_medal 🏅 award
+1st_place_medal 🥇 first gold
+video_game 🎮 gaming
+joystick 🕹️ gaming
+game_die 🎲 chance
+musical_note 🎵 music audio
+headphones 🎧 support audio
+microphone 🎤 audio podcast
+movie_camera 🎥 video film
+camera 📷 photo
+tv 📺 television media
+bookmark 🔖 save
+label 🏷️ tag
+ticket 🎫 support
+receipt 🧾 invoice
+scroll 📜 history legal
+balance_scale ⚖️ legal
+judge 🧑‍⚖️ legal
+busts_in_silhouette 👥 team people
+bust_in_silhouette 👤 user person
+handshake 🤝 partnership deal
+wave 👋 hello onboarding
+eyes 👀 review watch
+thumbsup 👍 approve
+raised_hands 🙌 celebrate
+muscle 💪 strength
+heart ❤️ love health
+blue_heart 💙 love
+green_heart 💚 love
+purple_heart 💜 love
+yellow_heart 💛 love
+orange_heart 🧡 love
+black_heart 🖤 love
+broken_heart 💔 broken
+coffee ☕ break
+pizza 🍕 food
+cake 🍰 celebration
+cookie 🍪 cookies
+apple 🍎 fruit
+lemon 🍋 fruit
+unicorn 🦄 magic
+dragon 🐉 legendary
+owl 🦉 wisdom
+fox_face 🦊 fox
+cat 🐱 animal
+dog 🐶 animal
+penguin 🐧 linux
+turtle 🐢 slow
+snail 🐌 slow
+rabbit 🐰 fast
+octopus 🐙 github
+whale 🐳 docker
+crab 🦀 rust
+snake 🐍 python
+elephant 🐘 php postgres
+bee 🐝 busy
+butterfly 🦋 change
+lady_beetle 🐞 bug
+hedgehog 🦔 animal
+sparkler 🎇 celebration
+balloon 🎈 party
+confetti_ball 🎊 celebration
+100 💯 perfect
+recycle ♻️ refactor
+infinity ♾️ forever
+atom_symbol ⚛️ science react
+dna 🧬 biology
+pill 💊 health
+stethoscope 🩺 health
+syringe 💉 health
+magnet 🧲 attraction
+bricks 🧱 foundation
+building_construction 🏗️ construction build
+traffic_light 🚦 status
+checkered_flag 🏁 finish milestone
+triangular_flag_on_post 🚩 flag milestone
+white_flag 🏳️ flag
+black_flag 🏴 flag
+red_circle 🔴 red
+orange_circle 🟠 orange
+yellow_circle 🟡 yellow
+green_circle 🟢 green
+large_blue_circle 🔵 blue
+purple_circle 🟣 purple
+black_circle ⚫ black
+white_circle ⚪ white
+`
+
+var (
+	catalog []Icon
+	byName  = map[string]Icon{}
+)
+
+func init() {
+	for _, line := range strings.Split(strings.TrimSpace(namedIcons), "\n") {
+		fields := strings.Fields(line)
+		icon := Icon{Name: fields[0], Kind: KindIcon, Keywords: fields[1:]}
+		catalog = append(catalog, icon)
+		byName[strings.ToLower(icon.Name)] = icon
+	}
+	for _, line := range strings.Split(strings.TrimSpace(emojiShortcodes), "\n") {
+		fields := strings.Fields(line)
+		icon := Icon{Name: ":" + fields[0] + ":", Kind: KindEmoji, Emoji: fields[1], Keywords: fields[2:]}
+		catalog = append(catalog, icon)
+		byName[strings.ToLower(icon.Name)] = icon
+	}
+}
+
+// All returns every known icon and emoji, icons first
+func All() []Icon {
+	return append([]Icon(nil), catalog...)
+}
+
+// InvalidError reports an icon value Linear would reject
+type InvalidError struct {
+	Value string
+	// Suggestions are the closest known icons, best first
+	Suggestions []string
+}
+
+func (e *InvalidError) Error() string {
+	msg := fmt.Sprintf("unknown icon '%s'", e.Value)
+	if len(e.Suggestions) > 0 {
+		msg += fmt.Sprintf(" (did you mean %s?)", strings.Join(e.Suggestions, ", "))
+	}
+	return msg
+}
+
+// Hint points at the icon search command
+func (e *InvalidError) Hint() string {
+	return fmt.Sprintf("Find valid icons with 'linear icons search %s'; an emoji character is accepted too", searchTerm(e.Value))
+}
+
+// Normalize checks an --icon value and returns the value to send: a named
+// icon in Linear's spelling, or the emoji character for an emoji, whether
+// given as the character or as a :shortcode:. Empty input stays empty.
+func Normalize(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	if icon, ok := byName[strings.ToLower(value)]; ok {
+		if icon.Kind == KindEmoji {
+			return icon.Emoji, nil
+		}
+		return icon.Name, nil
+	}
+	if IsEmoji(value) {
+		return value, nil
+	}
+
+	return "", &InvalidError{Value: value, Suggestions: suggest(value)}
+}
+
+// suggest returns up to three icons close to value: those a search finds,
+// then those within a small edit distance of it, closest first
+func suggest(value string) []string {
+	var suggestions []string
+	for _, icon := range Search(searchTerm(value), "") {
+		if len(suggestions) == 3 {
+			return suggestions
+		}
+		suggestions = append(suggestions, icon.Name)
+	}
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	lower := strings.ToLower(strings.Trim(value, ":"))
+	var candidates []candidate
+	for _, icon := range catalog {
+		// At most two edits, and no more than a third of the value
+		if d := editDistance(lower, strings.ToLower(strings.Trim(icon.Name, ":"))); d <= 2 && d*3 <= utf8.RuneCountInString(lower) {
+			candidates = append(candidates, candidate{icon.Name, d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+	for _, c := range candidates {
+		if len(suggestions) == 3 {
+			break
+		}
+		if !slices.Contains(suggestions, c.name) {
+			suggestions = append(suggestions, c.name)
+		}
+	}
+	return suggestions
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+// searchTerm is the word of an invalid value worth searching for
+func searchTerm(value string) string {
+	term := strings.ToLower(strings.Trim(strings.TrimSpace(value), ":"))
+	if fields := strings.FieldsFunc(term, func(r rune) bool { return r == '_' || r == '-' || r == ' ' }); len(fields) > 0 {
+		return fields[0]
+	}
+	return term
+}
+
+// Search finds icons whose name or keywords match term, best match
+// first: exact names, then names starting with term, then names and
+// keywords containing it. kind limits the results to icons or emoji;
+// empty matches both.
+func Search(term, kind string) []Icon {
+	term = strings.ToLower(strings.Trim(strings.TrimSpace(term), ":"))
+	type match struct {
+		icon Icon
+		rank int
+		pos  int
+	}
+	var matches []match
+	for i, icon := range catalog {
+		if kind != "" && icon.Kind != kind {
+			continue
+		}
+		if term == "" {
+			matches = append(matches, match{icon, 0, i})
+			continue
+		}
+		name := strings.ToLower(strings.Trim(icon.Name, ":"))
+		rank := -1
+		switch {
+		case name == term:
+			rank = 0
+		case strings.HasPrefix(name, term):
+			rank = 1
+		case hasKeyword(icon.Keywords, term):
+			rank = 2
+		case strings.Contains(name, term):
+			rank = 3
+		case containsKeyword(icon.Keywords, term):
+			rank = 4
+		}
+		if rank >= 0 {
+			matches = append(matches, match{icon, rank, i})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank < matches[j].rank
+		}
+		return matches[i].pos < matches[j].pos
+	})
+	icons := make([]Icon, len(matches))
+	for i, m := range matches {
+		icons[i] = m.icon
+	}
+	return icons
+}
+
+func hasKeyword(keywords []string, term string) bool {
+	for _, k := range keywords {
+		if k == term {
+			return true
+		}
+	}
+	return false
+}
+
+func containsKeyword(keywords []string, term string) bool {
+	for _, k := range keywords {
+		if strings.Contains(k, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEmoji reports whether s is a single emoji: one pictograph, optionally
+// with variation selectors, skin tones, keycaps and zero-width joins, or a
+// pair of regional indicators (a flag)
+func IsEmoji(s string) bool {
+	if s == "" || utf8.RuneCountInString(s) > 16 {
+		return false
+	}
+	pictographs, indicators := 0, 0
+	joined := false
+	keycap := strings.ContainsRune(s, 0x20E3)
+	for _, r := range s {
+		switch {
+		case keycap && (r >= '0' && r <= '9' || r == '#' || r == '*'):
+			pictographs++
+		case r == 0x200D:
+			joined = true
+		case r == 0xFE0F, r == 0x20E3, r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F:
+			// Variation selector, keycap, skin tone and tag modifiers
+		case r >= 0x1F1E6 && r <= 0x1F1FF:
+			indicators++
+		case isPictograph(r):
+			pictographs++
+		default:
+			return false
+		}
+	}
+	if indicators > 0 {
+		return pictographs == 0 && indicators == 2
+	}
+	return pictographs == 1 || (pictographs > 1 && joined)
+}
+
+// isPictograph reports whether r is in one of the emoji blocks
+func isPictograph(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF:
+		return true
+	case r >= 0x2600 && r <= 0x27BF:
+		return true
+	case r >= 0x2300 && r <= 0x23FF:
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF:
+		return true
+	case r == 0x00A9, r == 0x00AE, r == 0x203C, r == 0x2049, r == 0x2122, r == 0x2139:
+		return true
+	case r >= 0x2194 && r <= 0x21AA:
+		return true
+	case r == 0x24C2, r == 0x3030, r == 0x303D, r == 0x3297, r == 0x3299:
+		return true
+	}
+	return false
+}
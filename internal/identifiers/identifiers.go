@@ -0,0 +1,199 @@
+// Package identifiers expands issue identifier ranges and brace patterns
+// such as ENG-100..ENG-120 or ENG-1{2,3}4 into individual identifiers.
+package identifiers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaxExpanded caps how many identifiers a single argument list may expand
+// to, so a typo like ENG-1..ENG-100000 cannot fan out into a huge batch
+const MaxExpanded = 250
+
+var (
+	identifierPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)-(\d+)$`)
+	rangePattern      = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)-(\d+)\.\.(?:([A-Za-z][A-Za-z0-9_]*)-)?(\d+)$`)
+	braceRangePattern = regexp.MustCompile(`^(\d+)\.\.(\d+)$`)
+)
+
+// Identifier is a parsed TEAM-123 identifier
+type Identifier struct {
+	TeamKey string
+	Number  int
+}
+
+// String formats the identifier as TEAM-123
+func (id Identifier) String() string {
+	return fmt.Sprintf("%s-%d", id.TeamKey, id.Number)
+}
+
+// Parse parses a TEAM-123 identifier. The team key is upper-cased.
+func Parse(s string) (Identifier, bool) {
+	m := identifierPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Identifier{}, false
+	}
+	n, err := strconv.Atoi(m[2])
+	if err != nil {
+		return Identifier{}, false
+	}
+	return Identifier{TeamKey: strings.ToUpper(m[1]), Number: n}, true
+}
+
+// IsPattern reports whether s is a range or brace pattern rather than a
+// single identifier or ID
+func IsPattern(s string) bool {
+	return strings.Contains(s, "..") || strings.ContainsAny(s, "{}")
+}
+
+// Expand expands each argument and returns the results in order with
+// duplicates removed. Arguments that are not patterns (identifiers and
+// UUIDs) pass through unchanged.
+func Expand(args []string) ([]string, error) {
+	seen := map[string]bool{}
+	result := []string{}
+
+	for _, arg := range args {
+		arg = strings.TrimSpace(arg)
+		if arg == "" {
+			continue
+		}
+
+		expanded := []string{arg}
+		if IsPattern(arg) {
+			var err error
+			expanded, err = expandPattern(arg)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, id := range expanded {
+			if parsed, ok := Parse(id); ok {
+				id = parsed.String()
+			}
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			result = append(result, id)
+			if len(result) > MaxExpanded {
+				return nil, fmt.Errorf("identifiers expand to more than %d issues", MaxExpanded)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// expandPattern expands braces first, then any TEAM-1..TEAM-9 ranges in
+// the results
+func expandPattern(pattern string) ([]string, error) {
+	braced, err := expandBraces(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for _, s := range braced {
+		if !strings.Contains(s, "..") {
+			if _, ok := Parse(s); !ok {
+				return nil, fmt.Errorf("invalid identifier %q in pattern %q", s, pattern)
+			}
+			result = append(result, s)
+			continue
+		}
+
+		ids, err := expandRange(s)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, ids...)
+		if len(result) > MaxExpanded {
+			return nil, fmt.Errorf("pattern %q expands to more than %d issues", pattern, MaxExpanded)
+		}
+	}
+	return result, nil
+}
+
+// expandRange expands ENG-100..ENG-120 or ENG-100..120
+func expandRange(s string) ([]string, error) {
+	m := rangePattern.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("invalid range %q (use ENG-100..ENG-120)", s)
+	}
+	if m[3] != "" && !strings.EqualFold(m[1], m[3]) {
+		return nil, fmt.Errorf("range %q spans teams %s and %s", s, m[1], m[3])
+	}
+
+	from, _ := strconv.Atoi(m[2])
+	to, _ := strconv.Atoi(m[4])
+	if to < from {
+		return nil, fmt.Errorf("range %q ends before it starts", s)
+	}
+	if to-from+1 > MaxExpanded {
+		return nil, fmt.Errorf("range %q expands to more than %d issues", s, MaxExpanded)
+	}
+
+	team := strings.ToUpper(m[1])
+	ids := make([]string, 0, to-from+1)
+	for n := from; n <= to; n++ {
+		ids = append(ids, fmt.Sprintf("%s-%d", team, n))
+	}
+	return ids, nil
+}
+
+// expandBraces expands the first {a,b} or {1..5} group and recurses on the
+// results, so multiple groups produce their cartesian product
+func expandBraces(s string) ([]string, error) {
+	open := strings.Index(s, "{")
+	if open < 0 {
+		if strings.Contains(s, "}") {
+			return nil, fmt.Errorf("unbalanced braces in %q", s)
+		}
+		return []string{s}, nil
+	}
+	closeIdx := strings.Index(s[open:], "}")
+	if closeIdx < 0 {
+		return nil, fmt.Errorf("unbalanced braces in %q", s)
+	}
+	closeIdx += open
+
+	prefix, body, suffix := s[:open], s[open+1:closeIdx], s[closeIdx+1:]
+	if strings.Contains(body, "{") {
+		return nil, fmt.Errorf("nested braces are not supported in %q", s)
+	}
+
+	var options []string
+	if m := braceRangePattern.FindStringSubmatch(body); m != nil {
+		from, _ := strconv.Atoi(m[1])
+		to, _ := strconv.Atoi(m[2])
+		if to < from {
+			return nil, fmt.Errorf("range {%s} ends before it starts", body)
+		}
+		if to-from+1 > MaxExpanded {
+			return nil, fmt.Errorf("range {%s} expands to more than %d values", body, MaxExpanded)
+		}
+		for n := from; n <= to; n++ {
+			options = append(options, strconv.Itoa(n))
+		}
+	} else {
+		options = strings.Split(body, ",")
+	}
+
+	result := []string{}
+	for _, opt := range options {
+		rest, err := expandBraces(prefix + strings.TrimSpace(opt) + suffix)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rest...)
+		if len(result) > MaxExpanded {
+			return nil, fmt.Errorf("pattern %q expands to more than %d values", s, MaxExpanded)
+		}
+	}
+	return result, nil
+}
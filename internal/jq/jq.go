@@ -0,0 +1,79 @@
+// Package jq filters JSON output with jq expressions, so no external jq
+// binary is needed. Expressions are evaluated by gojq, which implements
+// the full jq language: string interpolation, variables, reduce, and
+// user-defined functions included.
+package jq
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/itchyny/gojq"
+)
+
+// Query is a compiled jq expression
+type Query struct {
+	code *gojq.Code
+}
+
+// Compile parses a jq expression
+func Compile(expr string) (*Query, error) {
+	parsed, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("jq: %w", err)
+	}
+	code, err := gojq.Compile(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("jq: %w", err)
+	}
+	return &Query{code: code}, nil
+}
+
+// Run evaluates the query against input, which must be made of the types
+// encoding/json decodes into, and returns every output value
+func (q *Query) Run(input interface{}) ([]interface{}, error) {
+	var out []interface{}
+	iter := q.code.Run(input)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return out, nil
+		}
+		if err, ok := v.(error); ok {
+			var halt *gojq.HaltError
+			if errors.As(err, &halt) && halt.Value() == nil {
+				return out, nil
+			}
+			return nil, runError(err)
+		}
+		out = append(out, v)
+	}
+}
+
+// runError reports an evaluation error, unwrapping the value given to
+// error() so that error("boom") reads "jq: boom"
+func runError(err error) error {
+	var valueErr interface{ Value() any }
+	if errors.As(err, &valueErr) {
+		if s, ok := valueErr.Value().(string); ok {
+			return fmt.Errorf("jq: %s", s)
+		}
+	}
+	return fmt.Errorf("jq: %s", strings.TrimPrefix(err.Error(), "jq: "))
+}
+
+// Normalize converts any JSON-marshalable value into the generic types the
+// evaluator works on
+func Normalize(v interface{}) (interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
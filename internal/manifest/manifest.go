@@ -0,0 +1,385 @@
+// Package manifest parses the batch files 'linear apply' runs: an ordered
+// list of operations that create and update issues, comment on them and
+// relate them, where later operations may reference the issues and
+// comments earlier ones created.
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/breakdown"
+)
+
+// Operation kinds
+const (
+	OpCreateIssue = "create_issue"
+	OpUpdateIssue = "update_issue"
+	OpComment     = "comment"
+	OpRelate      = "relate"
+)
+
+// Ops lists the operation kinds in documentation order
+var Ops = []string{OpCreateIssue, OpUpdateIssue, OpComment, OpRelate}
+
+// RelationTypes are the values a relate operation's type may take
+var RelationTypes = []string{"related", "blocks", "blocked_by", "duplicate"}
+
+// issueFields are the keys create_issue and update_issue share
+var issueFields = []string{"title", "description", "state", "assignee", "priority", "estimate", "labels", "project", "parent", "due_date"}
+
+// opKeys are the keys each operation accepts besides op
+var opKeys = map[string][]string{
+	OpCreateIssue: append([]string{"team"}, issueFields...),
+	OpUpdateIssue: append([]string{"issue"}, issueFields...),
+	OpComment:     {"issue", "body"},
+	OpRelate:      {"issue", "related", "type"},
+}
+
+// Operation is one step of a manifest. Which fields apply depends on Op.
+type Operation struct {
+	Op          string   `json:"op"`
+	Team        string   `json:"team,omitempty"`
+	Issue       string   `json:"issue,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	State       string   `json:"state,omitempty"`
+	Assignee    string   `json:"assignee,omitempty"`
+	Priority    string   `json:"priority,omitempty"`
+	Estimate    string   `json:"estimate,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	Project     string   `json:"project,omitempty"`
+	Parent      string   `json:"parent,omitempty"`
+	DueDate     string   `json:"due_date,omitempty"`
+	Body        string   `json:"body,omitempty"`
+	Related     string   `json:"related,omitempty"`
+	Type        string   `json:"type,omitempty"`
+}
+
+// Manifest is a parsed batch file
+type Manifest struct {
+	// Team is the default team for create_issue operations without one
+	Team       string       `json:"team,omitempty"`
+	Operations []*Operation `json:"operations"`
+}
+
+// Result is what an operation created, for later operations to reference
+type Result struct {
+	ID         string
+	Identifier string
+	URL        string
+}
+
+// refPattern matches references to earlier results, like $issue[0].id or
+// $comment[1].id. Indexes count create_issue and comment operations
+// from zero, in file order.
+var refPattern = regexp.MustCompile(`\$(issue|comment)\[(\d+)\]\.(identifier|id|url)\b`)
+
+// Load reads a YAML or JSON manifest, chosen by file extension; "-" reads
+// standard input, which may be either
+func Load(path string) (*Manifest, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+		path = "stdin"
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	trimmed := strings.TrimSpace(string(data))
+	switch ext := strings.ToLower(filepath.Ext(path)); {
+	case ext == ".json", path == "stdin" && (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")):
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	default:
+		if value, err = breakdown.ParseYAML(string(data)); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	m, err := fromValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// fromValue converts decoded YAML or JSON into a manifest. The document is
+// either a list of operations or a mapping with team and operations keys.
+func fromValue(value interface{}) (*Manifest, error) {
+	m := &Manifest{}
+	var items interface{}
+
+	switch v := value.(type) {
+	case []interface{}:
+		items = v
+	case map[string]interface{}:
+		for key, field := range v {
+			switch key {
+			case "team":
+				m.Team = scalarString(field)
+			case "operations", "ops":
+				items = field
+			default:
+				return nil, fmt.Errorf("unknown key %q", key)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("expected a list of operations or a mapping with operations")
+	}
+
+	list, ok := items.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, fmt.Errorf("no operations found")
+	}
+
+	for i, item := range list {
+		path := fmt.Sprintf("operations[%d]", i)
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a mapping", path)
+		}
+		op := &Operation{Op: scalarString(fields["op"])}
+		allowed, ok := opKeys[op.Op]
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown op %q (use %s)", path, op.Op, strings.Join(Ops, ", "))
+		}
+
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if key == "op" {
+				continue
+			}
+			if !contains(allowed, key) {
+				return nil, fmt.Errorf("%s: %s does not take %q", path, op.Op, key)
+			}
+			if key == "labels" {
+				labels, err := stringList(fields[key])
+				if err != nil {
+					return nil, fmt.Errorf("%s.labels: %w", path, err)
+				}
+				op.Labels = labels
+				continue
+			}
+			*op.field(key) = strings.TrimSpace(scalarString(fields[key]))
+		}
+		m.Operations = append(m.Operations, op)
+	}
+	return m, nil
+}
+
+// field returns the string field stored under a manifest key
+func (o *Operation) field(key string) *string {
+	switch key {
+	case "team":
+		return &o.Team
+	case "issue":
+		return &o.Issue
+	case "title":
+		return &o.Title
+	case "description":
+		return &o.Description
+	case "state":
+		return &o.State
+	case "assignee":
+		return &o.Assignee
+	case "priority":
+		return &o.Priority
+	case "estimate":
+		return &o.Estimate
+	case "project":
+		return &o.Project
+	case "parent":
+		return &o.Parent
+	case "due_date":
+		return &o.DueDate
+	case "body":
+		return &o.Body
+	case "related":
+		return &o.Related
+	case "type":
+		return &o.Type
+	}
+	panic("manifest: unknown field " + key)
+}
+
+// stringFields returns pointers to every string field that may hold references
+func (o *Operation) stringFields() []*string {
+	fields := []*string{
+		&o.Team, &o.Issue, &o.Title, &o.Description, &o.State, &o.Assignee, &o.Priority,
+		&o.Estimate, &o.Project, &o.Parent, &o.DueDate, &o.Body, &o.Related, &o.Type,
+	}
+	for i := range o.Labels {
+		fields = append(fields, &o.Labels[i])
+	}
+	return fields
+}
+
+// Validate checks every operation's required fields and that references
+// only point at results of earlier operations
+func (m *Manifest) Validate() error {
+	var issues, comments int
+	for i, op := range m.Operations {
+		path := fmt.Sprintf("operations[%d] (%s)", i, op.Op)
+
+		switch op.Op {
+		case OpCreateIssue:
+			if op.Title == "" {
+				return fmt.Errorf("%s: title is required", path)
+			}
+		case OpUpdateIssue:
+			if op.Issue == "" {
+				return fmt.Errorf("%s: issue is required", path)
+			}
+			if op.Title == "" && op.Description == "" && op.State == "" && op.Assignee == "" &&
+				op.Priority == "" && op.Estimate == "" && len(op.Labels) == 0 && op.Project == "" &&
+				op.Parent == "" && op.DueDate == "" {
+				return fmt.Errorf("%s: no fields to update", path)
+			}
+		case OpComment:
+			if op.Issue == "" || op.Body == "" {
+				return fmt.Errorf("%s: issue and body are required", path)
+			}
+		case OpRelate:
+			if op.Issue == "" || op.Related == "" {
+				return fmt.Errorf("%s: issue and related are required", path)
+			}
+			if op.Type == "" {
+				op.Type = "related"
+			}
+			if !contains(RelationTypes, op.Type) {
+				return fmt.Errorf("%s: invalid type %q (use %s)", path, op.Type, strings.Join(RelationTypes, ", "))
+			}
+		}
+		if op.Estimate != "" && !refPattern.MatchString(op.Estimate) {
+			if _, err := strconv.ParseFloat(op.Estimate, 64); err != nil {
+				return fmt.Errorf("%s: invalid estimate %q", path, op.Estimate)
+			}
+		}
+
+		for _, s := range op.stringFields() {
+			for _, ref := range refPattern.FindAllStringSubmatch(*s, -1) {
+				n, _ := strconv.Atoi(ref[2])
+				available := issues
+				if ref[1] == "comment" {
+					available = comments
+				}
+				if n >= available {
+					return fmt.Errorf("%s: %s: no earlier operation creates %s[%d]", path, ref[0], ref[1], n)
+				}
+			}
+		}
+
+		switch op.Op {
+		case OpCreateIssue:
+			issues++
+		case OpComment:
+			comments++
+		}
+	}
+	return nil
+}
+
+// Expand returns a copy of op with references replaced by the results of
+// earlier operations. issues and comments hold one entry per create_issue
+// and comment operation so far; nil marks one that did not succeed.
+func (o *Operation) Expand(issues, comments []*Result) (*Operation, error) {
+	expanded := *o
+	expanded.Labels = append([]string(nil), o.Labels...)
+
+	var err error
+	for _, s := range expanded.stringFields() {
+		*s = refPattern.ReplaceAllStringFunc(*s, func(ref string) string {
+			m := refPattern.FindStringSubmatch(ref)
+			n, _ := strconv.Atoi(m[2])
+			results := issues
+			if m[1] == "comment" {
+				results = comments
+			}
+			if n >= len(results) || results[n] == nil {
+				if err == nil {
+					err = fmt.Errorf("%s was not created", strings.SplitN(ref, ".", 2)[0][1:])
+				}
+				return ref
+			}
+			switch m[3] {
+			case "identifier":
+				return results[n].Identifier
+			case "url":
+				return results[n].URL
+			}
+			return results[n].ID
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &expanded, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// scalarString renders a decoded scalar as a string
+func scalarString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// stringList converts a list of scalars, or a comma-separated string
+func stringList(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		items := []string{}
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				items = append(items, s)
+			}
+		}
+		return items, nil
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = scalarString(item)
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("expected a list")
+	}
+}
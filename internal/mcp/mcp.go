@@ -0,0 +1,241 @@
+// Package mcp serves tools over the Model Context Protocol: JSON-RPC 2.0
+// messages, one per line, on stdin and stdout.
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ProtocolVersion is the MCP revision the server implements
+const ProtocolVersion = "2024-11-05"
+
+// maxMessageSize caps the size of a single incoming message
+const maxMessageSize = 8 << 20
+
+// JSON-RPC error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// Handler runs a tool call with its JSON arguments. The result is returned
+// to the client as JSON text; an error is reported as a failed tool call.
+type Handler func(ctx context.Context, args json.RawMessage) (interface{}, error)
+
+// Tool is a callable tool
+type Tool struct {
+	Name        string
+	Description string
+	// InputSchema is the JSON Schema of the arguments
+	InputSchema map[string]interface{}
+	Handler     Handler
+}
+
+// Server answers MCP requests with its tools
+type Server struct {
+	name    string
+	version string
+	tools   []Tool
+	byName  map[string]Tool
+
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewServer creates a server that identifies itself as name and version
+func NewServer(name, version string) *Server {
+	return &Server{name: name, version: version, byName: map[string]Tool{}}
+}
+
+// AddTool registers a tool
+func (s *Server) AddTool(tool Tool) {
+	s.tools = append(s.tools, tool)
+	s.byName[tool.Name] = tool
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Serve reads requests from in and writes responses to out until in is
+// closed or ctx is done
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	s.out = out
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var req request
+		if err := json.Unmarshal(line, &req); err != nil {
+			s.write(response{ID: json.RawMessage("null"), Error: &rpcError{Code: codeParseError, Message: err.Error()}})
+			continue
+		}
+		result, rpcErr := s.handle(ctx, &req)
+		// Notifications have no ID and get no response
+		if len(req.ID) == 0 {
+			continue
+		}
+		s.write(response{ID: req.ID, Result: result, Error: rpcErr})
+	}
+	return scanner.Err()
+}
+
+func (s *Server) write(resp response) {
+	resp.JSONRPC = "2.0"
+	data, err := json.Marshal(resp)
+	if err != nil {
+		data, _ = json.Marshal(response{JSONRPC: "2.0", ID: resp.ID, Error: &rpcError{Code: codeInvalidRequest, Message: err.Error()}})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.out.Write(append(data, '\n'))
+}
+
+func (s *Server) handle(ctx context.Context, req *request) (interface{}, *rpcError) {
+	if req.JSONRPC != "2.0" {
+		return nil, &rpcError{Code: codeInvalidRequest, Message: "jsonrpc must be \"2.0\""}
+	}
+
+	switch req.Method {
+	case "initialize":
+		return s.initialize(), nil
+	case "ping":
+		return struct{}{}, nil
+	case "tools/list":
+		return s.listTools(), nil
+	case "tools/call":
+		return s.callTool(ctx, req.Params)
+	}
+	if len(req.ID) == 0 {
+		// notifications/initialized, notifications/cancelled and the like
+		return nil, nil
+	}
+	return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", req.Method)}
+}
+
+func (s *Server) initialize() interface{} {
+	return map[string]interface{}{
+		"protocolVersion": ProtocolVersion,
+		"capabilities": map[string]interface{}{
+			"tools": map[string]interface{}{},
+		},
+		"serverInfo": map[string]string{
+			"name":    s.name,
+			"version": s.version,
+		},
+	}
+}
+
+func (s *Server) listTools() interface{} {
+	tools := make([]map[string]interface{}, len(s.tools))
+	for i, t := range s.tools {
+		tools[i] = map[string]interface{}{
+			"name":        t.Name,
+			"description": t.Description,
+			"inputSchema": t.InputSchema,
+		}
+	}
+	return map[string]interface{}{"tools": tools}
+}
+
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (interface{}, *rpcError) {
+	var p struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	tool, ok := s.byName[p.Name]
+	if !ok {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown tool: %s", p.Name)}
+	}
+	if len(p.Arguments) == 0 || string(p.Arguments) == "null" {
+		p.Arguments = json.RawMessage("{}")
+	}
+
+	result, err := tool.Handler(ctx, p.Arguments)
+	if err != nil {
+		return toolResult(err.Error(), true), nil
+	}
+	text, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return toolResult(err.Error(), true), nil
+	}
+	return toolResult(string(text), false), nil
+}
+
+func toolResult(text string, isError bool) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{"type": "text", "text": text}},
+		"isError": isError,
+	}
+}
+
+// Object returns the JSON Schema of an object with the given properties,
+// of which required must be present
+func Object(properties map[string]interface{}, required ...string) map[string]interface{} {
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// String returns the JSON Schema of a string property
+func String(description string) map[string]interface{} {
+	return map[string]interface{}{"type": "string", "description": description}
+}
+
+// Integer returns the JSON Schema of an integer property
+func Integer(description string) map[string]interface{} {
+	return map[string]interface{}{"type": "integer", "description": description}
+}
+
+// Boolean returns the JSON Schema of a boolean property
+func Boolean(description string) map[string]interface{} {
+	return map[string]interface{}{"type": "boolean", "description": description}
+}
+
+// Strings returns the JSON Schema of a string array property
+func Strings(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "array",
+		"items":       map[string]string{"type": "string"},
+		"description": description,
+	}
+}
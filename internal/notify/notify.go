@@ -0,0 +1,66 @@
+// Package notify shows desktop notifications with the tools each platform
+// ships: osascript on macOS, notify-send on Linux and the BSDs, and
+// PowerShell toast notifications on Windows.
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnsupported is returned when no notification tool is available
+var ErrUnsupported = errors.New("desktop notifications are not supported here")
+
+// appName is shown as the notification source where the platform allows
+const appName = "Linear CLI"
+
+// windowsScript shows a toast with the title and message from the
+// environment, attributed to PowerShell so it needs no app registration
+const windowsScript = `
+[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null
+$xml = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02)
+$text = $xml.GetElementsByTagName('text')
+$text.Item(0).AppendChild($xml.CreateTextNode($env:LINEAR_NOTIFY_TITLE)) > $null
+$text.Item(1).AppendChild($xml.CreateTextNode($env:LINEAR_NOTIFY_MESSAGE)) > $null
+$toast = [Windows.UI.Notifications.ToastNotification]::new($xml)
+[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('{1AC14E77-02E7-4E5D-B744-2EB1AE5198B7}\WindowsPowerShell\v1.0\powershell.exe').Show($toast)
+`
+
+// Send shows a desktop notification
+func Send(title, message string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(message), appleScriptString(title))
+		cmd = exec.Command("osascript", "-e", script)
+	case "windows":
+		cmd = exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", windowsScript)
+		cmd.Env = append(os.Environ(), "LINEAR_NOTIFY_TITLE="+title, "LINEAR_NOTIFY_MESSAGE="+message)
+	case "linux", "freebsd", "openbsd", "netbsd":
+		cmd = exec.Command("notify-send", "--app-name="+appName, title, message)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupported, runtime.GOOS)
+	}
+
+	if _, err := exec.LookPath(cmd.Path); err != nil {
+		return fmt.Errorf("%w: %s not found", ErrUnsupported, cmd.Args[0])
+	}
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return fmt.Errorf("%s: %s", cmd.Args[0], msg)
+		}
+		return fmt.Errorf("%s: %w", cmd.Args[0], err)
+	}
+	return nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal
+func appleScriptString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,191 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+// ColorMode is a --color setting
+type ColorMode string
+
+const (
+	// ColorAuto colors output written to a terminal unless NO_COLOR is set
+	ColorAuto ColorMode = "auto"
+	// ColorAlways colors output even when it is piped
+	ColorAlways ColorMode = "always"
+	// ColorNever writes plain text
+	ColorNever ColorMode = "never"
+)
+
+// ParseColorMode validates a --color value
+func ParseColorMode(s string) (ColorMode, error) {
+	switch m := ColorMode(strings.ToLower(strings.TrimSpace(s))); m {
+	case ColorAuto, ColorAlways, ColorNever:
+		return m, nil
+	}
+	return "", fmt.Errorf("unknown color mode %q (use auto, always or never)", s)
+}
+
+// SetColorMode turns colored output on or off for every writer in this
+// package. Auto disables colors when NO_COLOR is set, TERM is dumb or
+// stdout is not a terminal.
+func SetColorMode(mode ColorMode) {
+	switch mode {
+	case ColorAlways:
+		color.NoColor = false
+	case ColorNever:
+		color.NoColor = true
+	default:
+		color.NoColor = os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" ||
+			!term.IsTerminal(int(os.Stdout.Fd()))
+	}
+}
+
+// ColorDepth is how many colors the terminal shows, which decides how hex
+// theme colors are written
+type ColorDepth string
+
+const (
+	// Depth16 maps hex colors to the nearest of the basic ANSI colors
+	Depth16 ColorDepth = "16"
+	// Depth256 maps hex colors to the xterm 256-color palette
+	Depth256 ColorDepth = "256"
+	// DepthTrueColor writes hex colors exactly
+	DepthTrueColor ColorDepth = "truecolor"
+)
+
+// ParseColorDepth validates a theme depth
+func ParseColorDepth(s string) (ColorDepth, error) {
+	switch d := ColorDepth(strings.ToLower(strings.TrimSpace(s))); d {
+	case Depth16, Depth256, DepthTrueColor:
+		return d, nil
+	case "24bit":
+		return DepthTrueColor, nil
+	}
+	return "", fmt.Errorf("unknown color depth %q (use 16, 256 or truecolor)", s)
+}
+
+// DetectColorDepth guesses the terminal's depth from COLORTERM and TERM
+func DetectColorDepth() ColorDepth {
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return DepthTrueColor
+	}
+	if strings.Contains(os.Getenv("TERM"), "256color") {
+		return Depth256
+	}
+	return Depth16
+}
+
+// Theme holds the colors of human output. Colors are names (red, green,
+// yellow, blue, magenta, cyan, white, black, gray), hex values such as
+// "#e5484d", or "none".
+type Theme struct {
+	Depth ColorDepth
+	// Priority colors priority icons, by priority (1 urgent to 4 low, 0 none)
+	Priority map[int]string
+	// State colors workflow and project states, by state type (backlog,
+	// started, completed, ...)
+	State map[string]string
+}
+
+// DefaultTheme is the theme without configuration
+func DefaultTheme() Theme {
+	return Theme{
+		Depth:    DetectColorDepth(),
+		Priority: map[int]string{1: "red", 2: "yellow"},
+		State: map[string]string{
+			"triage":    "magenta",
+			"started":   "yellow",
+			"completed": "green",
+			"canceled":  "gray",
+		},
+	}
+}
+
+var theme = DefaultTheme()
+
+// SetTheme sets the colors of human output
+func SetTheme(t Theme) {
+	theme = t
+}
+
+// PriorityColor colors text, such as a priority icon, for priority
+func PriorityColor(priority int, text string) string {
+	return Paint(theme.Priority[priority], text)
+}
+
+// StateColor colors text, such as a state name, for a state type
+func StateColor(stateType, text string) string {
+	return Paint(theme.State[strings.ToLower(stateType)], text)
+}
+
+// Paint colors text with a theme color at the theme's depth. Invalid or
+// empty colors, and disabled colors, leave text plain.
+func Paint(spec, text string) string {
+	code, err := colorCode(spec, theme.Depth)
+	if err != nil || code == "" || text == "" || color.NoColor {
+		return text
+	}
+	// 39 restores the default foreground, keeping bold or faint text intact
+	return "\x1b[" + code + "m" + text + "\x1b[39m"
+}
+
+// ValidateColor checks a theme color
+func ValidateColor(spec string) error {
+	_, err := colorCode(spec, DepthTrueColor)
+	return err
+}
+
+// namedColors are the foreground SGR codes of color names
+var namedColors = map[string]int{
+	"black":   30,
+	"red":     31,
+	"green":   32,
+	"yellow":  33,
+	"blue":    34,
+	"magenta": 35,
+	"cyan":    36,
+	"white":   37,
+	"gray":    90,
+	"grey":    90,
+}
+
+// colorCode converts a theme color to an SGR foreground code for depth
+func colorCode(spec string, depth ColorDepth) (string, error) {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if spec == "" || spec == "none" {
+		return "", nil
+	}
+	if code, ok := namedColors[spec]; ok {
+		return strconv.Itoa(code), nil
+	}
+
+	hex := strings.TrimPrefix(spec, "#")
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 6 || err != nil {
+		return "", fmt.Errorf("invalid color %q (use a name such as red or a hex value such as #e5484d)", spec)
+	}
+	r, g, b := int(rgb>>16&0xff), int(rgb>>8&0xff), int(rgb&0xff)
+
+	switch depth {
+	case DepthTrueColor:
+		return fmt.Sprintf("38;2;%d;%d;%d", r, g, b), nil
+	case Depth256:
+		// The 6x6x6 cube of the xterm palette starts at 16
+		level := func(c int) int { return (c*5 + 127) / 255 }
+		return fmt.Sprintf("38;5;%d", 16+36*level(r)+6*level(g)+level(b)), nil
+	}
+	basic := 0
+	for i, c := range []int{r, g, b} {
+		if c > 127 {
+			basic |= 1 << i
+		}
+	}
+	return strconv.Itoa(30 + basic), nil
+}
@@ -0,0 +1,228 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/jq"
+)
+
+var (
+	jqQuery    *jq.Query
+	recordTmpl *template.Template
+	idsOnly    bool
+)
+
+// SetJQ filters machine-readable output through a jq expression, as with
+// --jq. An empty expression clears the filter.
+func SetJQ(expr string) error {
+	jqQuery = nil
+	if expr == "" {
+		return nil
+	}
+	q, err := jq.Compile(expr)
+	if err != nil {
+		return err
+	}
+	jqQuery = q
+	return nil
+}
+
+// SetTemplate renders machine-readable output with a Go template executed
+// once per record, as with --format. An empty template clears it.
+func SetTemplate(text string) error {
+	recordTmpl = nil
+	if text == "" {
+		return nil
+	}
+	t, err := template.New("format").Option("missingkey=zero").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid --format template: %w", err)
+	}
+	recordTmpl = t
+	return nil
+}
+
+// SetIDsOnly replaces machine-readable output with the identifier or ID of
+// each record, one per line, as with --ids-only
+func SetIDsOnly(on bool) {
+	idsOnly = on
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		raw, err := json.Marshal(v)
+		return string(raw), err
+	},
+	"join": func(sep string, v interface{}) string {
+		list, _ := v.([]interface{})
+		parts := make([]string, 0, len(list))
+		for _, item := range list {
+			parts = append(parts, cellText(item))
+		}
+		return strings.Join(parts, sep)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"truncate": func(n int, s string) string {
+		r := []rune(s)
+		if len(r) <= n {
+			return s
+		}
+		return string(r[:n])
+	},
+}
+
+// filtered reports whether --jq, --format or --ids-only is in effect
+func filtered() bool {
+	return jqQuery != nil || recordTmpl != nil || idsOnly
+}
+
+// writeFiltered writes data through the --jq expression or the --format
+// template. jq string results are printed raw, other values as compact
+// JSON, one per line.
+func writeFiltered(w io.Writer, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	raw = versioned(raw)
+
+	var buf bytes.Buffer
+	if idsOnly {
+		value, err := decodeOrdered(raw)
+		if err != nil {
+			return err
+		}
+		for _, id := range responseIDs(value) {
+			buf.WriteString(id)
+			buf.WriteByte('\n')
+		}
+	} else if jqQuery != nil {
+		var input interface{}
+		if err := json.Unmarshal(raw, &input); err != nil {
+			return err
+		}
+		results, err := jqQuery.Run(input)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			if s, ok := result.(string); ok {
+				buf.WriteString(s)
+			} else {
+				line, err := json.Marshal(result)
+				if err != nil {
+					return err
+				}
+				buf.Write(line)
+			}
+			buf.WriteByte('\n')
+		}
+	} else {
+		value, err := decodeOrdered(raw)
+		if err != nil {
+			return err
+		}
+		for _, record := range records(value) {
+			generic, err := jq.Normalize(record)
+			if err != nil {
+				return err
+			}
+			if err := recordTmpl.Execute(&buf, generic); err != nil {
+				return err
+			}
+			buf.WriteByte('\n')
+		}
+	}
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// cellText renders a decoded JSON value for the template join function:
+// strings as-is, objects by their name, identifier or id, anything else as
+// JSON
+func cellText(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case map[string]interface{}:
+		for _, key := range []string{"name", "identifier", "id"} {
+			if s, ok := t[key].(string); ok {
+				return s
+			}
+		}
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
+
+// responseIDs picks the IDs --ids-only prints: the response's own ID, the
+// entity a create or update command returns, or the ID of each record of a
+// list
+func responseIDs(value interface{}) []string {
+	if o, ok := value.(object); ok {
+		if id := ownID(o); id != "" {
+			return []string{id}
+		}
+		// A mutation such as {"success": true, "issue": {...}} names the
+		// entity it changed, even when it also lists related records
+		if _, isMutation := o.get("success"); isMutation {
+			for _, f := range o {
+				if entity, ok := f.value.(object); ok {
+					if id := ownID(entity); id != "" {
+						return []string{id}
+					}
+				}
+			}
+		}
+	}
+
+	ids := []string{}
+	for _, record := range records(value) {
+		if id := recordID(record); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// recordID returns the identifier, such as ENG-123, or the ID of a record,
+// falling back to a nested entity or an ID field such as "documentId"
+func recordID(record interface{}) string {
+	o, ok := record.(object)
+	if !ok {
+		return scalarString(record)
+	}
+	if id := ownID(o); id != "" {
+		return id
+	}
+	for _, f := range o {
+		if entity, ok := f.value.(object); ok {
+			if id := ownID(entity); id != "" {
+				return id
+			}
+		}
+	}
+	for _, f := range o {
+		if s, ok := f.value.(string); ok && strings.HasSuffix(f.key, "Id") && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func ownID(o object) string {
+	for _, key := range []string{"identifier", "id"} {
+		if v, ok := o.get(key); ok {
+			if s, ok := v.(string); ok && s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
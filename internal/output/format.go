@@ -0,0 +1,524 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Format is an output format selected with --output
+type Format string
+
+const (
+	// FormatJSON is indented JSON, the default
+	FormatJSON Format = "json"
+	// FormatTable is the human-readable output also selected by --human
+	FormatTable Format = "table"
+	// FormatYAML is YAML
+	FormatYAML Format = "yaml"
+	// FormatNDJSON is one compact JSON object per line
+	FormatNDJSON Format = "ndjson"
+	// FormatTSV is tab-separated values with a header row
+	FormatTSV Format = "tsv"
+)
+
+// Formats lists the supported output formats
+var Formats = []Format{FormatTable, FormatJSON, FormatYAML, FormatNDJSON, FormatTSV}
+
+var format = FormatJSON
+
+// quiet discards successful responses, as with --quiet
+var quiet bool
+
+// Column is a TSV column chosen with --columns: its header and the
+// flattened key of its values, such as "assignee.displayName"
+type Column struct {
+	Header string
+	Key    string
+}
+
+// columns limits TSV output when set
+var columns []Column
+
+// SchemaVersion is the version of the output schemas printed by 'linear
+// schema'. It changes only when a field is removed, renamed or changes
+// type; new fields do not change it.
+const SchemaVersion = "1"
+
+// SchemaVersionKey is the field that carries SchemaVersion in JSON and YAML
+// object output
+const SchemaVersionKey = "_schemaVersion"
+
+// PartialKey is the field that marks incomplete object output with why it
+// stopped, such as "timeout"
+const PartialKey = "_partial"
+
+// partial is why the output is incomplete, or "" when it is complete
+var partial string
+
+// SetPartial marks the output as incomplete, stopped for reason. Commands
+// still print what they have; the mark tells agents not to rely on it
+// being everything.
+func SetPartial(reason string) {
+	partial = reason
+}
+
+// ParseFormat validates an --output value
+func ParseFormat(s string) (Format, error) {
+	f := Format(strings.ToLower(strings.TrimSpace(s)))
+	for _, known := range Formats {
+		if f == known {
+			return f, nil
+		}
+	}
+	names := make([]string, len(Formats))
+	for i, known := range Formats {
+		names[i] = string(known)
+	}
+	return "", fmt.Errorf("unknown output format %q (use %s)", s, strings.Join(names, ", "))
+}
+
+// SetQuiet discards successful responses so only failures print, as with
+// --quiet. --ids-only output is still written.
+func SetQuiet(on bool) {
+	quiet = on
+}
+
+// SetColumns limits TSV output to columns, in order, as with --columns.
+// Nil writes every field.
+func SetColumns(c []Column) {
+	columns = c
+}
+
+// SetFormat selects the output format
+func SetFormat(f Format) {
+	format = f
+}
+
+// CurrentFormat returns the selected output format
+func CurrentFormat() Format {
+	return format
+}
+
+// writeData writes data in the selected machine-readable format. Table
+// output is produced by the commands themselves, so it falls back to JSON
+// here. --jq, --format and --ids-only apply to everything but error
+// responses, which are also all --quiet lets through. JSON and YAML objects
+// are stamped with the schema version; line-oriented formats carry records
+// only.
+//
+// A filter that fails at run time, such as jq's error("boom") or
+// iterating over null, is reported here rather than returned: most
+// callers ignore the result of JSON, and a returned error would have
+// cobra print usage for a command that was used correctly.
+func writeData(w io.Writer, data interface{}) error {
+	_, isError := data.(ErrorResponse)
+	if quiet && !idsOnly && !isError {
+		return nil
+	}
+	if filtered() && !isError {
+		if err := writeFiltered(w, data); err != nil {
+			recordExitCode("FILTER_ERROR")
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		return nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	switch format {
+	case FormatYAML, FormatNDJSON, FormatTSV:
+	default:
+		if err := json.Indent(&buf, versioned(raw), "", "  "); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		_, err = buf.WriteTo(w)
+		return err
+	}
+
+	value, err := decodeOrdered(raw)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case FormatYAML:
+		if o, ok := value.(object); ok {
+			head := object{{SchemaVersionKey, SchemaVersion}}
+			if partial != "" {
+				head = append(head, field{PartialKey, partial})
+			}
+			value = append(head, o...)
+		}
+		writeYAML(&buf, value, 0)
+	case FormatNDJSON:
+		for _, record := range records(value) {
+			line, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			buf.Write(line)
+			buf.WriteByte('\n')
+		}
+	case FormatTSV:
+		writeTSV(&buf, records(value))
+	}
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// versioned adds the schema version to an encoded JSON object as its first
+// field. Other values are returned unchanged.
+func versioned(raw []byte) []byte {
+	if len(raw) == 0 || raw[0] != '{' {
+		return raw
+	}
+	stamp := fmt.Sprintf("{%q:%q", SchemaVersionKey, SchemaVersion)
+	if partial != "" {
+		stamp += fmt.Sprintf(",%q:%q", PartialKey, partial)
+	}
+	if string(raw) == "{}" {
+		return []byte(stamp + "}")
+	}
+	return append([]byte(stamp+","), raw[1:]...)
+}
+
+// object is a JSON object that keeps its key order
+type object []field
+
+type field struct {
+	key   string
+	value interface{}
+}
+
+func (o object) get(key string) (interface{}, bool) {
+	for _, f := range o {
+		if f.key == key {
+			return f.value, true
+		}
+	}
+	return nil, false
+}
+
+// MarshalJSON writes the object with its keys in order
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(f.key)
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeOrdered decodes JSON into object, []interface{}, json.Number,
+// string, bool and nil values, keeping object keys in document order
+func decodeOrdered(raw []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	return decodeValue(decoder)
+}
+
+func decodeValue(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := token.(type) {
+	case json.Delim:
+		if t == '{' {
+			obj := object{}
+			for decoder.More() {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				obj = append(obj, field{key: keyToken.(string), value: value})
+			}
+			_, err := decoder.Token()
+			return obj, err
+		}
+		list := []interface{}{}
+		for decoder.More() {
+			value, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		_, err := decoder.Token()
+		return list, err
+	default:
+		return t, nil
+	}
+}
+
+// records picks the rows of a response for line-oriented formats: the
+// elements of a top-level list, or of the first list of objects in a
+// response object such as {"issues": [...], "count": 2}. Anything else is
+// a single record.
+func records(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case object:
+		for _, f := range v {
+			list, ok := f.value.([]interface{})
+			if !ok {
+				continue
+			}
+			if len(list) == 0 {
+				return list
+			}
+			if _, ok := list[0].(object); ok {
+				return list
+			}
+		}
+	}
+	return []interface{}{value}
+}
+
+// writeTSV writes records as tab-separated rows under a header of their
+// flattened keys, in first-seen order, or of the columns set by SetColumns
+func writeTSV(buf *bytes.Buffer, rows []interface{}) {
+	var keys []string
+	seen := map[string]bool{}
+	flat := make([]map[string]string, len(rows))
+	for i, row := range rows {
+		flat[i] = map[string]string{}
+		flatten(row, "", func(key, value string) {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+			flat[i][key] = value
+		})
+	}
+
+	headers := keys
+	if len(columns) > 0 {
+		headers = make([]string, len(columns))
+		keys = make([]string, len(columns))
+		for i, c := range columns {
+			headers[i] = c.Header
+			keys[i] = c.Key
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	buf.WriteString(strings.Join(headers, "\t"))
+	buf.WriteByte('\n')
+	for _, row := range flat {
+		cells := make([]string, len(keys))
+		for i, key := range keys {
+			cells[i] = tsvEscaper.Replace(row[key])
+		}
+		buf.WriteString(strings.Join(cells, "\t"))
+		buf.WriteByte('\n')
+	}
+}
+
+var tsvEscaper = strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\n", "\\n", "\r", "\\r")
+
+// flatten emits the scalar leaves of value with dotted keys. Lists of
+// scalars are joined with commas, and lists of objects by their name,
+// identifier, key or id.
+func flatten(value interface{}, prefix string, emit func(key, value string)) {
+	key := prefix
+	if key == "" {
+		key = "value"
+	}
+	switch v := value.(type) {
+	case object:
+		for _, f := range v {
+			name := f.key
+			if prefix != "" {
+				name = prefix + "." + f.key
+			}
+			flatten(f.value, name, emit)
+		}
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			parts = append(parts, listItemLabel(item))
+		}
+		emit(key, strings.Join(parts, ","))
+	default:
+		emit(key, scalarString(v))
+	}
+}
+
+// listItemLabel renders one element of a list for a TSV cell
+func listItemLabel(item interface{}) string {
+	obj, ok := item.(object)
+	if !ok {
+		return scalarString(item)
+	}
+	for _, key := range []string{"name", "identifier", "key", "id"} {
+		if v, ok := obj.get(key); ok {
+			return scalarString(v)
+		}
+	}
+	raw, _ := json.Marshal(obj)
+	return string(raw)
+}
+
+func scalarString(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	case json.Number:
+		return s.String()
+	case bool:
+		if s {
+			return "true"
+		}
+		return "false"
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
+
+// writeYAML writes value as a YAML document
+func writeYAML(buf *bytes.Buffer, value interface{}, indent int) {
+	switch v := value.(type) {
+	case object:
+		if len(v) == 0 {
+			buf.WriteString(pad(indent) + "{}\n")
+			return
+		}
+		for _, f := range v {
+			buf.WriteString(pad(indent) + yamlKey(f.key) + ":")
+			writeYAMLChild(buf, f.value, indent)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(pad(indent) + "[]\n")
+			return
+		}
+		for _, item := range v {
+			buf.WriteString(pad(indent) + "-")
+			writeYAMLItem(buf, item, indent)
+		}
+	default:
+		buf.WriteString(pad(indent) + yamlScalar(v, indent) + "\n")
+	}
+}
+
+// writeYAMLChild writes the value of a mapping key after "key:"
+func writeYAMLChild(buf *bytes.Buffer, value interface{}, indent int) {
+	switch v := value.(type) {
+	case object:
+		if len(v) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+		buf.WriteByte('\n')
+		writeYAML(buf, v, indent+2)
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+		buf.WriteByte('\n')
+		writeYAML(buf, v, indent)
+	default:
+		buf.WriteString(" " + yamlScalar(v, indent+2) + "\n")
+	}
+}
+
+// writeYAMLItem writes a sequence item after "-", putting the first key of
+// a mapping on the same line
+func writeYAMLItem(buf *bytes.Buffer, value interface{}, indent int) {
+	switch v := value.(type) {
+	case object:
+		if len(v) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+		buf.WriteString(" " + yamlKey(v[0].key) + ":")
+		writeYAMLChild(buf, v[0].value, indent+2)
+		if len(v) > 1 {
+			writeYAML(buf, v[1:], indent+2)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+		buf.WriteByte('\n')
+		writeYAML(buf, v, indent+2)
+	default:
+		buf.WriteString(" " + yamlScalar(v, indent+2) + "\n")
+	}
+}
+
+func pad(n int) string {
+	return strings.Repeat(" ", n)
+}
+
+// yamlPlainUnsafe matches strings that would not read back as the same
+// string if written unquoted
+var yamlPlainUnsafe = regexp.MustCompile(`^$|^[\s\-?:,\[\]{}#&*!|>'"%@` + "`" + `]|\s$|: |:$| #|^(?i:true|false|yes|no|on|off|null|~|y|n)$|^[-+.]?\d|^\.(?i:inf|nan)$`)
+
+func yamlKey(key string) string {
+	if yamlPlainUnsafe.MatchString(key) || strings.ContainsAny(key, "\n\t") {
+		quoted, _ := json.Marshal(key)
+		return string(quoted)
+	}
+	return key
+}
+
+// yamlScalar renders a scalar; multi-line strings become literal blocks
+// indented to indent
+func yamlScalar(v interface{}, indent int) string {
+	switch s := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		if strings.Contains(s, "\n") && !strings.HasSuffix(s, "\n") && !strings.HasPrefix(s, " ") &&
+			!strings.ContainsAny(s, "\r\t") {
+			lines := strings.Split(s, "\n")
+			for i, line := range lines {
+				if line != "" {
+					lines[i] = pad(indent) + line
+				}
+			}
+			return "|-\n" + strings.Join(lines, "\n")
+		}
+		if yamlPlainUnsafe.MatchString(s) || strings.ContainsAny(s, "\n\r\t") {
+			quoted, _ := json.Marshal(s)
+			return string(quoted)
+		}
+		return s
+	default:
+		return scalarString(s)
+	}
+}
@@ -1,7 +1,9 @@
 package output
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -14,6 +16,7 @@ import (
 type ErrorInfo struct {
 	Code    string   `json:"code"`
 	Message string   `json:"message"`
+	Field   string   `json:"field,omitempty"`
 	Hint    string   `json:"hint,omitempty"`
 	Usage   []string `json:"usage,omitempty"`
 }
@@ -31,11 +34,40 @@ type SuccessResponse struct {
 	Message   string `json:"message,omitempty"`
 }
 
-// JSON outputs data as formatted JSON to stdout
+// JSON outputs data to stdout in the selected machine-readable format:
+// indented JSON by default, or YAML, NDJSON or TSV
 func JSON(data interface{}) error {
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(data)
+	return writeData(os.Stdout, data)
+}
+
+// CanStream reports whether list items may be written as they arrive:
+// NDJSON output, one record per line, without --jq, --format, --ids-only
+// or --quiet, which need the whole response
+func CanStream() bool {
+	return format == FormatNDJSON && !filtered() && !quiet
+}
+
+// StreamRecords writes items, a slice, to stdout as NDJSON records, for
+// paginated commands that stream each page when CanStream
+func StreamRecords(items interface{}) error {
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	var records []json.RawMessage
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, record := range records {
+		if err := json.Compact(&buf, record); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+	}
+	_, err = buf.WriteTo(os.Stdout)
+	return err
 }
 
 // JSONString returns data as a formatted JSON string
@@ -57,8 +89,106 @@ func HumanLn(format string, args ...interface{}) {
 	fmt.Printf(format+"\n", args...)
 }
 
+// CodedError is an error that carries its own error code, such as the
+// typed errors returned by the API client. An empty code means the
+// caller's fallback applies.
+type CodedError interface {
+	error
+	ErrorCode() string
+}
+
+// Exit statuses. Commands report failures through the Error* functions
+// with an error code, and the process exits with the status for the first
+// code reported.
+const (
+	ExitOK        = 0
+	ExitError     = 1
+	ExitUsage     = 2
+	ExitAuth      = 3
+	ExitNotFound  = 4
+	ExitRateLimit = 5
+	// ExitConflict means the change lost a race with someone else's, such
+	// as claiming an issue another user took
+	ExitConflict = 6
+	// ExitTimeout and ExitInterrupted follow the shell conventions of
+	// timeout(1) and 128+SIGINT
+	ExitTimeout     = 124
+	ExitInterrupted = 130
+)
+
+// exitCodes maps error codes to exit statuses. Codes not listed here exit
+// with ExitUsage when they start with MISSING_ or INVALID_, and ExitError
+// otherwise.
+var exitCodes = map[string]int{
+	"AUTH_ERROR":        ExitAuth,
+	"NOT_AUTHENTICATED": ExitAuth,
+	"UNAUTHORIZED":      ExitAuth,
+	"FORBIDDEN":         ExitAuth,
+	"INVALID_API_KEY":   ExitAuth,
+	"NOT_FOUND":         ExitNotFound,
+	"TEAM_NOT_FOUND":    ExitNotFound,
+	"RATE_LIMITED":      ExitRateLimit,
+	"CONFLICT":          ExitConflict,
+	"TIMEOUT":           ExitTimeout,
+	"INTERRUPTED":       ExitInterrupted,
+	"VALIDATION_ERROR":  ExitUsage,
+	"INVALID_FLAGS":     ExitUsage,
+}
+
+// exitCode is the status the process should exit with
+var exitCode = ExitOK
+
+// ExitCode returns the exit status for the errors reported so far
+func ExitCode() int {
+	return exitCode
+}
+
+// ExitCodeFor returns the exit status for an error code
+func ExitCodeFor(code string) int {
+	if status, ok := exitCodes[code]; ok {
+		return status
+	}
+	if strings.HasPrefix(code, "MISSING_") || strings.HasPrefix(code, "INVALID_") {
+		return ExitUsage
+	}
+	return ExitError
+}
+
+// recordExitCode remembers the exit status for the first reported error
+func recordExitCode(code string) {
+	if exitCode == ExitOK {
+		exitCode = ExitCodeFor(code)
+	}
+}
+
+// SetExitCode replaces the exit status, for a failure that overrides
+// whatever the command reported, such as --timeout expiring
+func SetExitCode(status int) {
+	exitCode = status
+}
+
+// Fail records a failure that a command reports in its own output, such
+// as a failed check, so the process exits with code's status without an
+// error response being printed
+func Fail(code string) {
+	recordExitCode(code)
+}
+
+// CodeFrom returns err's own code when it is a CodedError that reports
+// one, and fallback otherwise
+func CodeFrom(err error, fallback string) string {
+	var coded CodedError
+	if errors.As(err, &coded) {
+		if c := coded.ErrorCode(); c != "" {
+			return c
+		}
+	}
+	return fallback
+}
+
 // Error outputs an error response
 func Error(code, message string) error {
+	recordExitCode(code)
 	resp := ErrorResponse{
 		Success: false,
 		Error: &ErrorInfo{
@@ -71,6 +201,7 @@ func Error(code, message string) error {
 
 // ErrorWithHint outputs an error response with guidance for agents
 func ErrorWithHint(code, message, hint string, usage ...string) error {
+	recordExitCode(code)
 	resp := ErrorResponse{
 		Success: false,
 		Error: &ErrorInfo{
@@ -83,14 +214,37 @@ func ErrorWithHint(code, message, hint string, usage ...string) error {
 	return JSON(resp)
 }
 
-// ErrorHuman outputs a human-readable error
-func ErrorHuman(message string) {
+// ErrorFrom outputs an error response for err, using its own code when it
+// is a CodedError that reports one and fallback otherwise
+func ErrorFrom(err error, fallback string) error {
+	code := CodeFrom(err, fallback)
+	info := &ErrorInfo{Code: code, Message: err.Error()}
+	var fielded interface{ ErrorField() string }
+	if errors.As(err, &fielded) {
+		info.Field = fielded.ErrorField()
+	}
+
+	recordExitCode(code)
+	return JSON(ErrorResponse{Success: false, Error: info})
+}
+
+// ErrorHuman outputs a human-readable error; code sets the exit status as
+// it does for Error
+func ErrorHuman(code, message string) {
+	recordExitCode(code)
 	color.Red("Error: %s", message)
 	fmt.Println()
 }
 
+// ErrorHumanFrom outputs err as a human-readable error, with the exit
+// status for its code as in ErrorFrom
+func ErrorHumanFrom(err error, fallback string) {
+	ErrorHuman(CodeFrom(err, fallback), err.Error())
+}
+
 // ErrorHumanWithHint outputs a human-readable error with guidance
-func ErrorHumanWithHint(message, hint string, usage ...string) {
+func ErrorHumanWithHint(code, message, hint string, usage ...string) {
+	recordExitCode(code)
 	color.Red("Error: %s", message)
 	fmt.Println()
 	if hint != "" {
@@ -0,0 +1,138 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const (
+	// progressDelay keeps quick operations silent
+	progressDelay = 500 * time.Millisecond
+	// progressFrame is how often the terminal spinner or bar redraws
+	progressFrame = 100 * time.Millisecond
+	// progressLogInterval is how often progress is logged when stderr is
+	// not a terminal
+	progressLogInterval = 5 * time.Second
+	// progressBarWidth is the width of the terminal progress bar
+	progressBarWidth = 20
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Progress reports a long operation on stderr so it does not look frozen:
+// a spinner, or a bar when the total is known, on a terminal, and a log
+// line every few seconds otherwise. Operations that finish within
+// progressDelay print nothing, and --quiet silences it.
+type Progress struct {
+	label string
+	total int
+	w     io.Writer
+	tty   bool
+
+	mu   sync.Mutex
+	done int
+	// drawn is whether the terminal line needs clearing
+	drawn bool
+
+	stop    chan struct{}
+	stopped sync.WaitGroup
+}
+
+// NewProgress starts reporting an operation. total is the number of steps,
+// or 0 when it is unknown. Call Done when the operation ends.
+func NewProgress(label string, total int) *Progress {
+	p := &Progress{label: label, total: total, w: os.Stderr}
+	if quiet {
+		return p
+	}
+	p.tty = term.IsTerminal(int(os.Stderr.Fd()))
+	p.stop = make(chan struct{})
+	p.stopped.Add(1)
+	go p.run()
+	return p
+}
+
+// Add records n more completed steps
+func (p *Progress) Add(n int) {
+	p.mu.Lock()
+	p.done += n
+	p.mu.Unlock()
+}
+
+// Done stops reporting and clears the terminal line
+func (p *Progress) Done() {
+	if p.stop == nil {
+		return
+	}
+	close(p.stop)
+	p.stopped.Wait()
+	p.stop = nil
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.drawn {
+		fmt.Fprint(p.w, "\r\x1b[K")
+	}
+}
+
+func (p *Progress) run() {
+	defer p.stopped.Done()
+
+	select {
+	case <-p.stop:
+		return
+	case <-time.After(progressDelay):
+	}
+
+	interval := progressLogInterval
+	if p.tty {
+		interval = progressFrame
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for frame := 0; ; frame++ {
+		p.draw(frame)
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// draw writes the current state: a redrawn terminal line or a log line
+func (p *Progress) draw(frame int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.tty {
+		fmt.Fprintf(p.w, "%s: %s\n", p.label, p.count())
+		return
+	}
+
+	line := spinnerFrames[frame%len(spinnerFrames)] + " " + p.label + " " + p.count()
+	if p.total > 0 {
+		filled := p.done * progressBarWidth / p.total
+		if filled > progressBarWidth {
+			filled = progressBarWidth
+		}
+		line = fmt.Sprintf("%s [%s%s] %s", p.label, strings.Repeat("█", filled), strings.Repeat("░", progressBarWidth-filled), p.count())
+	}
+	fmt.Fprint(p.w, "\r\x1b[K"+line)
+	p.drawn = true
+}
+
+// count renders the steps done, out of the total when it is known
+func (p *Progress) count() string {
+	if p.total > 0 {
+		return fmt.Sprintf("%d/%d", p.done, p.total)
+	}
+	return fmt.Sprintf("%d", p.done)
+}
@@ -0,0 +1,307 @@
+// Package query parses the search syntax of 'linear search': free text
+// mixed with key:value qualifiers, such as
+//
+//	assignee:me state:started label:bug "login timeout"
+//
+// Values with spaces are quoted (label:"needs design"), repeated
+// qualifiers accumulate, and comma-separated values are alternatives.
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Result types
+const (
+	TypeIssue    = "issue"
+	TypeProject  = "project"
+	TypeDocument = "document"
+)
+
+// Types lists the result types in display order
+var Types = []string{TypeIssue, TypeProject, TypeDocument}
+
+// Qualifier keys
+const (
+	KeyAssignee  = "assignee"
+	KeyState     = "state"
+	KeyLabel     = "label"
+	KeyTeam      = "team"
+	KeyProject   = "project"
+	KeyPriority  = "priority"
+	KeyCycle     = "cycle"
+	KeyMilestone = "milestone"
+	KeyParent    = "parent"
+	KeyLead      = "lead"
+	KeyCreated   = "created"
+	KeyUpdated   = "updated"
+	KeyDue       = "due"
+	KeyType      = "type"
+)
+
+// aliases maps accepted spellings to qualifier keys
+var aliases = map[string]string{
+	"assignee":  KeyAssignee,
+	"assigned":  KeyAssignee,
+	"state":     KeyState,
+	"status":    KeyState,
+	"label":     KeyLabel,
+	"labels":    KeyLabel,
+	"team":      KeyTeam,
+	"project":   KeyProject,
+	"priority":  KeyPriority,
+	"p":         KeyPriority,
+	"cycle":     KeyCycle,
+	"milestone": KeyMilestone,
+	"parent":    KeyParent,
+	"lead":      KeyLead,
+	"created":   KeyCreated,
+	"updated":   KeyUpdated,
+	"due":       KeyDue,
+	"type":      KeyType,
+	"is":        KeyType,
+}
+
+// Supports lists the qualifiers each result type can be narrowed by.
+// A qualifier limits a search to the types that support it.
+var Supports = map[string][]string{
+	TypeIssue: {
+		KeyAssignee, KeyState, KeyLabel, KeyTeam, KeyProject, KeyPriority,
+		KeyCycle, KeyMilestone, KeyParent, KeyCreated, KeyUpdated, KeyDue,
+	},
+	TypeProject:  {KeyState, KeyTeam, KeyLead},
+	TypeDocument: {KeyProject},
+}
+
+// Query is a parsed search
+type Query struct {
+	// Text is the free text, with qualifiers removed
+	Text string `json:"text,omitempty"`
+	// Qualifiers maps each qualifier key to its values, in query order
+	Qualifiers map[string][]string `json:"qualifiers,omitempty"`
+	// Types are the result types named by type: qualifiers
+	Types []string `json:"types,omitempty"`
+}
+
+// Parse splits a query into free text and qualifiers. Words that look like
+// qualifiers but use an unknown key are an error, so typos do not silently
+// become search text; quote them to search for the literal text.
+func Parse(s string) (*Query, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{Qualifiers: map[string][]string{}}
+	text := []string{}
+	for _, tok := range tokens {
+		if tok.quoted || !strings.Contains(tok.text, ":") {
+			text = append(text, tok.text)
+			continue
+		}
+
+		name, value, _ := strings.Cut(tok.text, ":")
+		key, ok := aliases[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown qualifier %q (use %s)", name, strings.Join(Keys(), ", "))
+		}
+		if value == "" {
+			return nil, fmt.Errorf("%s: needs a value", name)
+		}
+
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v == "" {
+				continue
+			}
+			if key == KeyType {
+				t, err := parseType(v)
+				if err != nil {
+					return nil, err
+				}
+				if !contains(q.Types, t) {
+					q.Types = append(q.Types, t)
+				}
+				continue
+			}
+			q.Qualifiers[key] = append(q.Qualifiers[key], v)
+		}
+	}
+	q.Text = strings.Join(text, " ")
+	return q, nil
+}
+
+// ParseTypes converts type names, singular or plural, into result types
+func ParseTypes(names []string) ([]string, error) {
+	types := []string{}
+	for _, name := range names {
+		for _, n := range strings.Split(name, ",") {
+			if n = strings.TrimSpace(n); n == "" {
+				continue
+			}
+			t, err := parseType(n)
+			if err != nil {
+				return nil, err
+			}
+			if !contains(types, t) {
+				types = append(types, t)
+			}
+		}
+	}
+	return types, nil
+}
+
+func parseType(name string) (string, error) {
+	t := strings.TrimSuffix(strings.ToLower(name), "s")
+	if t == "doc" {
+		t = TypeDocument
+	}
+	if !contains(Types, t) {
+		return "", fmt.Errorf("invalid type %q (use %s)", name, strings.Join(Types, ", "))
+	}
+	return t, nil
+}
+
+// Keys returns the qualifier keys, sorted
+func Keys() []string {
+	keys := []string{}
+	for _, key := range aliases {
+		if !contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Get returns the values of a qualifier
+func (q *Query) Get(key string) []string {
+	return q.Qualifiers[key]
+}
+
+// One returns the single value of a qualifier, or an error when it was
+// given more than one
+func (q *Query) One(key string) (string, error) {
+	values := q.Qualifiers[key]
+	switch len(values) {
+	case 0:
+		return "", nil
+	case 1:
+		return values[0], nil
+	}
+	return "", fmt.Errorf("%s: takes a single value, got %s", key, strings.Join(values, ", "))
+}
+
+// ResultTypes narrows the requested types, or every type when none were
+// requested, to those supporting all of the query's qualifiers. It fails
+// when no requested type supports them.
+func (q *Query) ResultTypes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		requested = Types
+	}
+
+	types := []string{}
+	for _, t := range Types {
+		if !contains(requested, t) {
+			continue
+		}
+		supported := true
+		for key := range q.Qualifiers {
+			if !contains(Supports[t], key) {
+				supported = false
+				break
+			}
+		}
+		if supported {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf("%s cannot be narrowed by %s", strings.Join(requested, " or "), strings.Join(q.keys(), ", "))
+	}
+	return types, nil
+}
+
+// keys returns the qualifier keys the query uses, sorted
+func (q *Query) keys() []string {
+	keys := make([]string, 0, len(q.Qualifiers))
+	for key := range q.Qualifiers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Comparison splits a date qualifier value into its comparison and date,
+// such as ">-7d" into ">" and "-7d". Values without one get the default.
+func Comparison(value, def string) (op, date string) {
+	for _, op := range []string{">=", "<=", ">", "<"} {
+		if strings.HasPrefix(value, op) {
+			return op[:1], strings.TrimSpace(value[len(op):])
+		}
+	}
+	return def, value
+}
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+// tokenize splits on whitespace, keeping quoted runs together. A quote
+// inside a word, as in label:"needs design", quotes only the value, so
+// the word is still a qualifier.
+func tokenize(s string) ([]token, error) {
+	tokens := []token{}
+	var (
+		b       strings.Builder
+		inQuote rune
+		started bool
+		quoted  bool
+	)
+	flush := func() {
+		if started {
+			tokens = append(tokens, token{text: b.String(), quoted: quoted})
+		}
+		b.Reset()
+		started, quoted = false, false
+	}
+
+	for _, r := range s {
+		switch {
+		case inQuote != 0:
+			if r == inQuote {
+				inQuote = 0
+				continue
+			}
+			b.WriteRune(r)
+		case r == '"' || r == '\'':
+			inQuote = r
+			// Only a quote opening the word makes it literal text
+			if !started {
+				quoted = true
+			}
+			started = true
+		case r == ' ' || r == '\t' || r == '\n':
+			flush()
+		default:
+			b.WriteRune(r)
+			started = true
+		}
+	}
+	if inQuote != 0 {
+		return nil, fmt.Errorf("unterminated quote in query")
+	}
+	flush()
+	return tokens, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+package recurring
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed five-field cron expression: minute, hour, day of
+// month, month and day of week
+type Schedule struct {
+	minutes, hours, days, months, weekdays uint64
+	// anyDay and anyWeekday record a "*" field; when both day fields are
+	// restricted, a time matching either one matches, as in Vixie cron
+	anyDay, anyWeekday bool
+}
+
+// macros are the @ shorthands cron accepts
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+var weekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ParseCron parses a cron expression such as "0 9 * * MON-FRI" or "@weekly".
+// Months and weekdays may be given by three-letter name, and 7 is Sunday.
+func ParseCron(expr string) (*Schedule, error) {
+	spec := strings.TrimSpace(expr)
+	if m, ok := macros[strings.ToLower(spec)]; ok {
+		spec = m
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields (minute hour day month weekday)", expr)
+	}
+
+	s := &Schedule{
+		anyDay:     fields[2] == "*" || fields[2] == "?",
+		anyWeekday: fields[4] == "*" || fields[4] == "?",
+	}
+	var err error
+	if s.minutes, err = parseField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid cron minute %q: %w", fields[0], err)
+	}
+	if s.hours, err = parseField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid cron hour %q: %w", fields[1], err)
+	}
+	if s.days, err = parseField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid cron day of month %q: %w", fields[2], err)
+	}
+	if s.months, err = parseField(fields[3], 1, 12, monthNames); err != nil {
+		return nil, fmt.Errorf("invalid cron month %q: %w", fields[3], err)
+	}
+	if s.weekdays, err = parseField(fields[4], 0, 7, weekdayNames); err != nil {
+		return nil, fmt.Errorf("invalid cron weekday %q: %w", fields[4], err)
+	}
+	// 7 is another name for Sunday
+	if s.weekdays&(1<<7) != 0 {
+		s.weekdays |= 1
+	}
+	return s, nil
+}
+
+// parseField parses a comma-separated list of values, ranges and steps
+// into a bit set. names, when set, are accepted for min, min+1, ...
+func parseField(field string, min, max int, names []string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepPart)
+			}
+			step = n
+		}
+
+		lo, hi := min, max
+		switch {
+		case rangePart == "*" || rangePart == "?":
+		case strings.Contains(rangePart, "-"):
+			a, b, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseValue(a, min, max, names); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(b, min, max, names); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("range %s is backwards", rangePart)
+			}
+		default:
+			n, err := parseValue(rangePart, min, max, names)
+			if err != nil {
+				return 0, err
+			}
+			lo = n
+			if !hasStep {
+				hi = n
+			}
+		}
+
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(s string, min, max int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(s, name) {
+			return min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("%d is out of range %d-%d", n, min, max)
+	}
+	return n, nil
+}
+
+// matchesDay reports whether t's date matches the day fields
+func (s *Schedule) matchesDay(t time.Time) bool {
+	day := s.days&(1<<uint(t.Day())) != 0
+	weekday := s.weekdays&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.anyDay && s.anyWeekday:
+		return true
+	case s.anyDay:
+		return weekday
+	case s.anyWeekday:
+		return day
+	}
+	return day || weekday
+}
+
+// Next returns the first time after t that the schedule fires, in t's
+// location, or the zero time if none falls within five years
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// Latest returns the last time the schedule fired after since and at or
+// before now, or the zero time if it did not fire in between. Only the
+// last year is considered.
+func (s *Schedule) Latest(since, now time.Time) time.Time {
+	if yearAgo := now.AddDate(-1, 0, 0); since.Before(yearAgo) {
+		since = yearAgo
+	}
+	var latest time.Time
+	for t := s.Next(since); !t.IsZero() && !t.After(now); t = s.Next(t) {
+		latest = t
+	}
+	return latest
+}
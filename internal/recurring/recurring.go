@@ -0,0 +1,157 @@
+// Package recurring stores recurring issue rules, which create an issue
+// from a template on a cron schedule, and tracks when each last ran so
+// 'linear recurring run' can be called from cron or CI as often as wanted.
+package recurring
+
+import (
+	"sort"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/state"
+)
+
+const (
+	// FileEnv overrides the rules file, for example to keep it in a
+	// repository that CI checks out
+	FileEnv = "LINEAR_RECURRING_FILE"
+
+	fileName = "recurring.json"
+)
+
+// Rule creates an issue each time its schedule fires
+type Rule struct {
+	Name string `json:"name"`
+	Cron string `json:"cron"`
+	// Timezone is the IANA zone the schedule runs in; empty means the
+	// configured or local time zone
+	Timezone string `json:"timezone,omitempty"`
+	Team     string `json:"team"`
+	// Title is a Go template; .Date, .Weekday and .Week describe the
+	// occurrence
+	Title    string            `json:"title"`
+	Template string            `json:"template,omitempty"`
+	Vars     map[string]string `json:"vars,omitempty"`
+	Labels   []string          `json:"labels,omitempty"`
+	Assignee string            `json:"assignee,omitempty"`
+	Priority string            `json:"priority,omitempty"`
+	Project  string            `json:"project,omitempty"`
+	// DueIn sets the due date relative to the occurrence, such as +2d
+	DueIn     string    `json:"dueIn,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	// LastRun is the last occurrence an issue was created (or found) for
+	LastRun   *time.Time `json:"lastRun,omitempty"`
+	LastIssue string     `json:"lastIssue,omitempty"`
+}
+
+// Schedule parses the rule's cron expression
+func (r *Rule) Schedule() (*Schedule, error) {
+	return ParseCron(r.Cron)
+}
+
+// Location returns the rule's time zone, or fallback when it has none
+func (r *Rule) Location(fallback *time.Location) (*time.Location, error) {
+	if r.Timezone == "" {
+		return fallback, nil
+	}
+	return time.LoadLocation(r.Timezone)
+}
+
+// Due returns the latest occurrence since the rule last ran, or since it
+// was created, that is at or before now; the zero time means nothing is due
+func (r *Rule) Due(now time.Time, loc *time.Location) (time.Time, error) {
+	schedule, err := r.Schedule()
+	if err != nil {
+		return time.Time{}, err
+	}
+	since := r.CreatedAt
+	if r.LastRun != nil {
+		since = *r.LastRun
+	}
+	return schedule.Latest(since.In(loc), now.In(loc)), nil
+}
+
+// Next returns the next occurrence after now
+func (r *Rule) Next(now time.Time, loc *time.Location) (time.Time, error) {
+	schedule, err := r.Schedule()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(now.In(loc)), nil
+}
+
+// Store reads and writes the rules file
+type Store struct {
+	path string
+}
+
+// NewStore uses $LINEAR_RECURRING_FILE, or recurring.json in
+// $XDG_STATE_HOME/agent-linear-cli (falling back to ~/.local/state)
+func NewStore() (*Store, error) {
+	path, err := state.Path(FileEnv, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{path: path}, nil
+}
+
+// Path returns the rules file
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns the rules sorted by name
+func (s *Store) List() ([]*Rule, error) {
+	rules := []*Rule{}
+	if err := state.ReadJSON(s.path, &rules); err != nil {
+		return nil, err
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+	return rules, nil
+}
+
+// Get returns the named rule, or nil when there is none
+func (s *Store) Get(name string) (*Rule, error) {
+	rules, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rules {
+		if r.Name == name {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+// Save adds the rule or replaces the one with its name
+func (s *Store) Save(rule *Rule) error {
+	rules, err := s.List()
+	if err != nil {
+		return err
+	}
+	for i, r := range rules {
+		if r.Name == rule.Name {
+			rules[i] = rule
+			return state.WriteJSON(s.path, rules)
+		}
+	}
+	return state.WriteJSON(s.path, append(rules, rule))
+}
+
+// Remove deletes the named rule and reports whether it existed
+func (s *Store) Remove(name string) (bool, error) {
+	rules, err := s.List()
+	if err != nil {
+		return false, err
+	}
+	kept := make([]*Rule, 0, len(rules))
+	for _, r := range rules {
+		if r.Name != name {
+			kept = append(kept, r)
+		}
+	}
+	if len(kept) == len(rules) {
+		return false, nil
+	}
+	return true, state.WriteJSON(s.path, kept)
+}
@@ -0,0 +1,157 @@
+// Package resolver normalizes the ways people refer to Linear entities —
+// full linear.app URLs, URL slugs and bare issue numbers — into the
+// identifiers and IDs the API accepts.
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/identifiers"
+)
+
+// Entity kinds
+const (
+	KindIssue      = "issue"
+	KindProject    = "project"
+	KindDocument   = "document"
+	KindInitiative = "initiative"
+)
+
+// ErrNoTeam is returned for a bare issue number when no default team is
+// known to complete it
+var ErrNoTeam = errors.New("a bare issue number needs a team")
+
+var (
+	// slugIDPattern matches the short ID Linear appends to project,
+	// document and initiative URL slugs
+	slugIDPattern = regexp.MustCompile(`(?:^|-)([0-9a-f]{12})$`)
+
+	numberPattern = regexp.MustCompile(`^#?(\d+)$`)
+)
+
+// urlKinds maps linear.app URL path segments to entity kinds
+var urlKinds = map[string]string{
+	"issue":      KindIssue,
+	"project":    KindProject,
+	"document":   KindDocument,
+	"initiative": KindInitiative,
+}
+
+// Ref is an entity reference parsed from a Linear URL
+type Ref struct {
+	Kind string
+	// ID is the issue identifier or the project, document or initiative
+	// slug ID
+	ID string
+	// Workspace is the workspace URL key
+	Workspace string
+}
+
+// ParseURL parses a linear.app URL such as
+// https://linear.app/acme/issue/ENG-123/fix-login or
+// https://linear.app/acme/project/q3-launch-0a1b2c3d4e5f/overview
+func ParseURL(s string) (Ref, bool) {
+	if !strings.HasPrefix(s, "http://") && !strings.HasPrefix(s, "https://") {
+		return Ref{}, false
+	}
+	u, err := url.Parse(s)
+	if err != nil || !isLinearHost(u.Host) {
+		return Ref{}, false
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 3 {
+		return Ref{}, false
+	}
+	kind, ok := urlKinds[parts[1]]
+	if !ok {
+		return Ref{}, false
+	}
+	ref := Ref{Kind: kind, Workspace: parts[0]}
+
+	if kind == KindIssue {
+		id, ok := identifiers.Parse(parts[2])
+		if !ok {
+			return Ref{}, false
+		}
+		ref.ID = id.String()
+		return ref, true
+	}
+	ref.ID = SlugID(parts[2])
+	return ref, true
+}
+
+// isLinearHost reports whether host serves the Linear web app
+func isLinearHost(host string) bool {
+	return host == "linear.app" || strings.HasSuffix(host, ".linear.app")
+}
+
+// SlugID returns the short ID at the end of a URL slug such as
+// "q3-launch-0a1b2c3d4e5f", or the slug unchanged when it has none
+func SlugID(slug string) string {
+	if m := slugIDPattern.FindStringSubmatch(strings.ToLower(slug)); m != nil {
+		return m[1]
+	}
+	return slug
+}
+
+// Issue normalizes an issue reference: an issue URL becomes its
+// identifier, a bare number such as 123 or #123 is completed with
+// defaultTeam, and identifiers are upper-cased. UUIDs, ranges and other
+// values pass through unchanged.
+func Issue(ref, defaultTeam string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if u, ok := ParseURL(ref); ok {
+		if u.Kind != KindIssue {
+			return "", fmt.Errorf("%s is a Linear %s URL, not an issue", ref, u.Kind)
+		}
+		return u.ID, nil
+	}
+	if m := numberPattern.FindStringSubmatch(ref); m != nil {
+		if defaultTeam == "" {
+			return "", fmt.Errorf("%w: use TEAM-%s, --team or a default team", ErrNoTeam, m[1])
+		}
+		n, _ := strconv.Atoi(m[1])
+		return identifiers.Identifier{TeamKey: strings.ToUpper(defaultTeam), Number: n}.String(), nil
+	}
+	if id, ok := identifiers.Parse(ref); ok {
+		return id.String(), nil
+	}
+	return ref, nil
+}
+
+// Entity normalizes a project, document or initiative reference: a URL of
+// that kind or a URL slug becomes its slug ID, which the API accepts in
+// place of the UUID. Other values pass through unchanged.
+func Entity(kind, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if u, ok := ParseURL(ref); ok {
+		if u.Kind != kind {
+			return "", fmt.Errorf("%s is a Linear %s URL, not a %s", ref, u.Kind, kind)
+		}
+		return u.ID, nil
+	}
+	if strings.Contains(ref, "-") && !looksLikeUUID(ref) {
+		return SlugID(ref), nil
+	}
+	return ref, nil
+}
+
+// Resolve normalizes ref as an entity of kind
+func Resolve(kind, ref, defaultTeam string) (string, error) {
+	if kind == KindIssue {
+		return Issue(ref, defaultTeam)
+	}
+	return Entity(kind, ref)
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func looksLikeUUID(s string) bool {
+	return uuidPattern.MatchString(s)
+}
@@ -0,0 +1,359 @@
+// Package scaffold parses workspace files for 'linear scaffold': the team,
+// labels, workflow states, project statuses, templates and projects a team
+// should have, described in YAML or JSON so team setup is reproducible.
+package scaffold
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/breakdown"
+)
+
+// hexColorPattern matches the #RRGGBB colors Linear accepts
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// Team is the team to provision. Name is only needed to create the team
+// when no team has the key yet.
+type Team struct {
+	Key         string `json:"key"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Label is a team label; a label with children is a label group
+type Label struct {
+	Name        string   `json:"name"`
+	Color       string   `json:"color,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Children    []*Label `json:"children,omitempty"`
+}
+
+// Status is a workflow state or a project status
+type Status struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Color       string `json:"color"`
+	Description string `json:"description,omitempty"`
+}
+
+// Template is a local issue or comment template
+type Template struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	Body string `json:"body"`
+}
+
+// Project is a project owned by the team
+type Project struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Content     string `json:"content,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Lead        string `json:"lead,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	Color       string `json:"color,omitempty"`
+	StartDate   string `json:"start_date,omitempty"`
+	TargetDate  string `json:"target_date,omitempty"`
+	Priority    string `json:"priority,omitempty"`
+}
+
+// Workspace is a parsed workspace file
+type Workspace struct {
+	Team            Team        `json:"team"`
+	Labels          []*Label    `json:"labels,omitempty"`
+	States          []*Status   `json:"states,omitempty"`
+	ProjectStatuses []*Status   `json:"project_statuses,omitempty"`
+	Templates       []*Template `json:"templates,omitempty"`
+	Projects        []*Project  `json:"projects,omitempty"`
+}
+
+// Load reads a YAML or JSON workspace file, chosen by file extension
+func Load(path string) (*Workspace, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	} else if value, err = breakdown.ParseYAML(string(data)); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	w, err := fromValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return w, nil
+}
+
+// fromValue converts a decoded YAML or JSON document into a workspace
+func fromValue(value interface{}) (*Workspace, error) {
+	doc, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a mapping with team, labels, states, project_statuses, templates or projects")
+	}
+
+	w := &Workspace{}
+	for _, key := range sortedKeys(doc) {
+		field := doc[key]
+		var err error
+		switch key {
+		case "team":
+			err = teamFromValue(field, &w.Team)
+		case "labels":
+			w.Labels, err = labelsFromValue(field, "labels")
+		case "states", "workflow_states":
+			w.States, err = statusesFromValue(field, key)
+		case "project_statuses":
+			w.ProjectStatuses, err = statusesFromValue(field, key)
+		case "templates":
+			w.Templates, err = templatesFromValue(field)
+		case "projects":
+			w.Projects, err = projectsFromValue(field)
+		default:
+			err = fmt.Errorf("unknown key %q", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+func teamFromValue(value interface{}, team *Team) error {
+	switch v := value.(type) {
+	case string:
+		team.Key = v
+	case map[string]interface{}:
+		for _, key := range sortedKeys(v) {
+			switch key {
+			case "key":
+				team.Key = scalarString(v[key])
+			case "name":
+				team.Name = scalarString(v[key])
+			case "description":
+				team.Description = scalarString(v[key])
+			default:
+				return fmt.Errorf("team: unknown key %q", key)
+			}
+		}
+	default:
+		return fmt.Errorf("team: expected a key or a mapping")
+	}
+	team.Key = strings.ToUpper(strings.TrimSpace(team.Key))
+	if team.Key == "" {
+		return fmt.Errorf("team: key is required")
+	}
+	return nil
+}
+
+// labelsFromValue converts a list of labels; items may be plain names or
+// mappings, and mappings may nest children one level deep
+func labelsFromValue(value interface{}, path string) ([]*Label, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a list", path)
+	}
+
+	labels := make([]*Label, 0, len(list))
+	for i, item := range list {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		label := &Label{}
+		hasColor := false
+
+		switch v := item.(type) {
+		case string:
+			label.Name = v
+		case map[string]interface{}:
+			for _, key := range sortedKeys(v) {
+				switch key {
+				case "name":
+					label.Name = scalarString(v[key])
+				case "color":
+					label.Color, hasColor = scalarString(v[key]), true
+				case "description":
+					label.Description = scalarString(v[key])
+				case "children", "labels":
+					if path != "labels" {
+						return nil, fmt.Errorf("%s: label groups cannot be nested", itemPath)
+					}
+					children, err := labelsFromValue(v[key], itemPath+"."+key)
+					if err != nil {
+						return nil, err
+					}
+					label.Children = children
+				default:
+					return nil, fmt.Errorf("%s: unknown key %q", itemPath, key)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("%s: expected a name or a mapping", itemPath)
+		}
+
+		label.Name = strings.TrimSpace(label.Name)
+		if label.Name == "" {
+			return nil, fmt.Errorf("%s: name is required", itemPath)
+		}
+		if hasColor && !hexColorPattern.MatchString(label.Color) {
+			return nil, fmt.Errorf("%s: invalid color %q (use a quoted hex color such as \"#f2c94c\")", itemPath, label.Color)
+		}
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
+// statusesFromValue converts a list of workflow states or project
+// statuses; each needs a name, type and color
+func statusesFromValue(value interface{}, path string) ([]*Status, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a list", path)
+	}
+
+	statuses := make([]*Status, 0, len(list))
+	for i, item := range list {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a mapping with name, type and color", itemPath)
+		}
+
+		status := &Status{}
+		for _, key := range sortedKeys(fields) {
+			switch key {
+			case "name":
+				status.Name = strings.TrimSpace(scalarString(fields[key]))
+			case "type":
+				status.Type = strings.ToLower(strings.TrimSpace(scalarString(fields[key])))
+			case "color":
+				status.Color = scalarString(fields[key])
+			case "description":
+				status.Description = scalarString(fields[key])
+			default:
+				return nil, fmt.Errorf("%s: unknown key %q", itemPath, key)
+			}
+		}
+		if status.Name == "" || status.Type == "" {
+			return nil, fmt.Errorf("%s: name and type are required", itemPath)
+		}
+		if !hexColorPattern.MatchString(status.Color) {
+			return nil, fmt.Errorf("%s: invalid color %q (use a quoted hex color such as \"#f2c94c\")", itemPath, status.Color)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// templatesFromValue converts a mapping of template kind to a mapping of
+// template name to body
+func templatesFromValue(value interface{}) ([]*Template, error) {
+	kinds, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("templates: expected a mapping of kind to templates")
+	}
+
+	templates := []*Template{}
+	for _, kind := range sortedKeys(kinds) {
+		names, ok := kinds[kind].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("templates.%s: expected a mapping of name to body", kind)
+		}
+		for _, name := range sortedKeys(names) {
+			body := scalarString(names[name])
+			if strings.TrimSpace(body) == "" {
+				return nil, fmt.Errorf("templates.%s.%s: body is required", kind, name)
+			}
+			templates = append(templates, &Template{Kind: kind, Name: name, Body: body})
+		}
+	}
+	return templates, nil
+}
+
+func projectsFromValue(value interface{}) ([]*Project, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("projects: expected a list")
+	}
+
+	projects := make([]*Project, 0, len(list))
+	for i, item := range list {
+		itemPath := fmt.Sprintf("projects[%d]", i)
+		project := &Project{}
+
+		switch v := item.(type) {
+		case string:
+			project.Name = v
+		case map[string]interface{}:
+			for _, key := range sortedKeys(v) {
+				field := scalarString(v[key])
+				switch key {
+				case "name":
+					project.Name = field
+				case "description":
+					project.Description = field
+				case "content":
+					project.Content = field
+				case "status":
+					project.Status = field
+				case "lead":
+					project.Lead = field
+				case "icon":
+					project.Icon = field
+				case "color":
+					project.Color = field
+				case "start_date":
+					project.StartDate = field
+				case "target_date":
+					project.TargetDate = field
+				case "priority":
+					project.Priority = field
+				default:
+					return nil, fmt.Errorf("%s: unknown key %q", itemPath, key)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("%s: expected a name or a mapping", itemPath)
+		}
+
+		project.Name = strings.TrimSpace(project.Name)
+		if project.Name == "" {
+			return nil, fmt.Errorf("%s: name is required", itemPath)
+		}
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// scalarString renders a decoded scalar as a string
+func scalarString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
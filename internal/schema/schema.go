@@ -0,0 +1,326 @@
+// Package schema derives JSON Schemas (draft 2020-12) for command output
+// from the Go values commands write, so agents can validate responses and
+// notice breaking changes.
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Draft is the JSON Schema dialect of generated documents
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Object describes a JSON object by sample values, for responses built as
+// maps. Each value is described as Describe would; a key ending in "?" is
+// optional.
+type Object map[string]interface{}
+
+// Const is a string property that always has this value, such as an
+// "operation" field
+type Const string
+
+// AnyOf is a value that matches at least one of the samples, for commands
+// whose output depends on their flags
+type AnyOf []interface{}
+
+// ArrayOf is a list whose elements match the sample
+type ArrayOf struct {
+	Item interface{}
+}
+
+// Nullable is a sample that may also be null
+type Nullable struct {
+	Value interface{}
+}
+
+// Extended is a sample with extra object fields, such as metadata added to
+// a typed response
+type Extended struct {
+	Value  interface{}
+	Fields Object
+}
+
+// Raw is a schema fragment used as-is
+type Raw map[string]interface{}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	rawJSONType = reflect.TypeOf(json.RawMessage{})
+)
+
+// Generator builds schemas, collecting named struct types into $defs so
+// shared and recursive types are described once
+type Generator struct {
+	defs  map[string]interface{}
+	names map[reflect.Type]string
+}
+
+// NewGenerator returns a generator with no definitions
+func NewGenerator() *Generator {
+	return &Generator{
+		defs:  map[string]interface{}{},
+		names: map[reflect.Type]string{},
+	}
+}
+
+// Describe returns the schema of a sample: an Object, Const, AnyOf,
+// ArrayOf, Nullable, Extended or Raw, or any Go value, which is described by its
+// type the way encoding/json writes it. A nil sample matches anything.
+func (g *Generator) Describe(sample interface{}) map[string]interface{} {
+	switch s := sample.(type) {
+	case nil:
+		return map[string]interface{}{}
+	case Object:
+		return g.object(s)
+	case Const:
+		return map[string]interface{}{"type": "string", "const": string(s)}
+	case AnyOf:
+		options := make([]interface{}, len(s))
+		for i, option := range s {
+			options[i] = g.Describe(option)
+		}
+		return map[string]interface{}{"anyOf": options}
+	case ArrayOf:
+		return map[string]interface{}{"type": "array", "items": g.Describe(s.Item)}
+	case Nullable:
+		return nullable(g.Describe(s.Value))
+	case Extended:
+		extra := g.object(s.Fields)
+		extra["allOf"] = []interface{}{g.Describe(s.Value)}
+		delete(extra, "type")
+		return extra
+	case Raw:
+		return map[string]interface{}(s)
+	}
+	return g.typeSchema(reflect.TypeOf(sample))
+}
+
+func (g *Generator) object(o Object) map[string]interface{} {
+	keys := make([]string, 0, len(o))
+	for key := range o {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	properties := map[string]interface{}{}
+	required := []string{}
+	for _, key := range keys {
+		name := strings.TrimSuffix(key, "?")
+		properties[name] = g.Describe(o[key])
+		if name == key {
+			required = append(required, name)
+		}
+	}
+	return objectSchema(properties, required)
+}
+
+func (g *Generator) typeSchema(t reflect.Type) map[string]interface{} {
+	switch t {
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case rawJSONType:
+		return map[string]interface{}{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return nullable(g.typeSchema(t.Elem()))
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+		}
+		// A nil slice encodes as null
+		return map[string]interface{}{"type": []string{"array", "null"}, "items": g.typeSchema(t.Elem())}
+	case reflect.Array:
+		return map[string]interface{}{"type": "array", "items": g.typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": []string{"object", "null"}, "additionalProperties": g.typeSchema(t.Elem())}
+	case reflect.Struct:
+		return g.ref(t)
+	}
+	return map[string]interface{}{}
+}
+
+// ref returns a reference to the definition of a struct type, adding it
+// on first use. Anonymous structs are described inline.
+func (g *Generator) ref(t reflect.Type) map[string]interface{} {
+	if t.Name() == "" {
+		return g.structSchema(t)
+	}
+	name, ok := g.names[t]
+	if !ok {
+		name = g.defName(t)
+		g.names[t] = name
+		// Reserve the name before describing fields so recursive types
+		// refer back to it
+		g.defs[name] = nil
+		g.defs[name] = g.structSchema(t)
+	}
+	return map[string]interface{}{"$ref": "#/$defs/" + name}
+}
+
+// defName names a definition after its type, qualified by package when two
+// packages use the same type name
+func (g *Generator) defName(t reflect.Type) string {
+	base := t.Name()
+	if i := strings.Index(base, "["); i >= 0 {
+		base = base[:i]
+	}
+	if _, taken := g.defs[base]; !taken {
+		return base
+	}
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	return pkg + "." + base
+}
+
+func (g *Generator) structSchema(t reflect.Type) map[string]interface{} {
+	properties := map[string]interface{}{}
+	required := []string{}
+	g.addFields(t, properties, &required)
+	return objectSchema(properties, required)
+}
+
+// addFields describes the fields of a struct the way encoding/json encodes
+// them, flattening embedded structs
+func (g *Generator) addFields(t reflect.Type, properties map[string]interface{}, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		key, opts, _ := strings.Cut(tag, ",")
+
+		if f.Anonymous && key == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.addFields(ft, properties, required)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if key == "" {
+			key = f.Name
+		}
+
+		schema := g.typeSchema(f.Type)
+		if hasOption(opts, "string") {
+			schema = map[string]interface{}{"type": "string"}
+		}
+		properties[key] = schema
+		if !hasOption(opts, "omitempty") {
+			*required = append(*required, key)
+		}
+	}
+}
+
+func hasOption(opts, option string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+func objectSchema(properties map[string]interface{}, required []string) map[string]interface{} {
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// nullable allows null in addition to what schema matches
+func nullable(schema map[string]interface{}) map[string]interface{} {
+	switch t := schema["type"].(type) {
+	case string:
+		out := map[string]interface{}{}
+		for k, v := range schema {
+			out[k] = v
+		}
+		out["type"] = []string{t, "null"}
+		return out
+	case []string:
+		for _, name := range t {
+			if name == "null" {
+				return schema
+			}
+		}
+	}
+	if len(schema) == 0 {
+		return schema
+	}
+	return map[string]interface{}{"anyOf": []interface{}{schema, map[string]interface{}{"type": "null"}}}
+}
+
+// Document is a complete schema, with its keywords in a readable order
+type Document struct {
+	Schema      string                 `json:"$schema"`
+	ID          string                 `json:"$id"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description,omitempty"`
+	OneOf       []interface{}          `json:"oneOf,omitempty"`
+	AllOf       []interface{}          `json:"allOf,omitempty"`
+	Defs        map[string]interface{} `json:"$defs,omitempty"`
+}
+
+// Document returns the schema of everything a command may write: the
+// success value or the error response. Object outputs carry versionKey set
+// to version.
+func (g *Generator) Document(id, title, versionKey, version string, success, failure interface{}) *Document {
+	stamp := func(schema map[string]interface{}) map[string]interface{} {
+		// properties and required only constrain objects, so list
+		// outputs still match
+		return map[string]interface{}{
+			"allOf":      []interface{}{schema},
+			"properties": map[string]interface{}{versionKey: map[string]interface{}{"type": "string", "const": version}},
+			"required":   []string{versionKey},
+		}
+	}
+
+	return &Document{
+		Schema: Draft,
+		ID:     id,
+		Title:  title,
+		OneOf:  []interface{}{stamp(g.Describe(success)), stamp(g.Describe(failure))},
+		Defs:   g.defs,
+	}
+}
+
+// Stream returns the schema of each line written by a command that streams
+// one JSON value per line
+func (g *Generator) Stream(id, title string, item interface{}) *Document {
+	return &Document{
+		Schema:      Draft,
+		ID:          id,
+		Title:       title,
+		Description: "Each line of output is one JSON value matching this schema",
+		AllOf:       []interface{}{g.Describe(item)},
+		Defs:        g.defs,
+	}
+}
@@ -0,0 +1,275 @@
+// Package sla loads service-level policies that limit how long issues may
+// stay in a workflow state, by priority, and matches issues against them.
+package sla
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Priorities maps priority names to Linear's priority values
+var Priorities = map[string]int{
+	"none":   0,
+	"urgent": 1,
+	"high":   2,
+	"medium": 3,
+	"low":    4,
+}
+
+// Policy is a named set of time-in-state rules
+type Policy struct {
+	Name  string `toml:"name" json:"name"`
+	Rules []Rule `toml:"rules" json:"rules"`
+}
+
+// Rule limits how long issues of a priority may stay in a state. Priority
+// and State may be "*" or empty to match anything; State matches either a
+// state type (triage, started, ...) or a state name, case-insensitively.
+type Rule struct {
+	Priority string `toml:"priority" json:"priority"`
+	State    string `toml:"state" json:"state"`
+	Max      string `toml:"max" json:"max"`
+
+	priority int
+	limit    time.Duration
+}
+
+// Limit returns the rule's maximum time in state
+func (r Rule) Limit() time.Duration {
+	return r.limit
+}
+
+// String describes the rule, e.g. "urgent in triage < 4h"
+func (r Rule) String() string {
+	priority, state := r.Priority, r.State
+	if isWildcard(priority) {
+		priority = "any priority"
+	}
+	if isWildcard(state) {
+		state = "any state"
+	}
+	return fmt.Sprintf("%s in %s < %s", priority, state, r.Max)
+}
+
+// Load reads a policy from a TOML, JSON or YAML file, chosen by extension
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, policy)
+	case ".yaml", ".yml":
+		policy, err = parseYAML(string(data))
+	default:
+		err = toml.Unmarshal(data, policy)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policy %s: %w", path, err)
+	}
+
+	if err := policy.validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// validate parses each rule's priority and limit
+func (p *Policy) validate() error {
+	if len(p.Rules) == 0 {
+		return fmt.Errorf("no rules defined")
+	}
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if !isWildcard(rule.Priority) {
+			priority, err := ParsePriority(rule.Priority)
+			if err != nil {
+				return fmt.Errorf("rule %d: %w", i+1, err)
+			}
+			rule.priority = priority
+		}
+		if rule.Max == "" {
+			return fmt.Errorf("rule %d: max is required", i+1)
+		}
+		limit, err := ParseDuration(rule.Max)
+		if err != nil {
+			return fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		rule.limit = limit
+	}
+	return nil
+}
+
+// Match returns the first rule that applies to an issue with the given
+// priority and state, or nil
+func (p *Policy) Match(priority int, stateName, stateType string) *Rule {
+	for i, rule := range p.Rules {
+		if !isWildcard(rule.Priority) && rule.priority != priority {
+			continue
+		}
+		if !isWildcard(rule.State) &&
+			!strings.EqualFold(rule.State, stateType) &&
+			!strings.EqualFold(rule.State, stateName) {
+			continue
+		}
+		return &p.Rules[i]
+	}
+	return nil
+}
+
+// ParsePriority parses a priority name (urgent, high, medium, low, none)
+// or number (0-4)
+func ParsePriority(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if priority, ok := Priorities[s]; ok {
+		return priority, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 && n <= 4 {
+		return n, nil
+	}
+	return 0, fmt.Errorf("invalid priority %q (use urgent, high, medium, low, none or 0-4)", s)
+}
+
+// ParseDuration parses durations like "4h", "90m" or "1h30m", plus days
+// ("2d") and weeks ("1w")
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, ok := strings.CutSuffix(s, suffix); ok {
+			if v, err := strconv.ParseFloat(n, 64); err == nil && v > 0 {
+				return time.Duration(v * float64(unit)), nil
+			}
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q (use e.g. 30m, 4h, 2d, 1w)", s)
+	}
+	return d, nil
+}
+
+// FormatDuration renders a duration compactly, e.g. "2d 4h" or "45m"
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+
+	switch {
+	case days > 0 && hours > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+func isWildcard(s string) bool {
+	s = strings.TrimSpace(s)
+	return s == "" || s == "*"
+}
+
+// parseYAML reads the small YAML subset a policy needs:
+//
+//	name: Support
+//	rules:
+//	  - priority: urgent
+//	    state: triage
+//	    max: 4h
+func parseYAML(data string) (*Policy, error) {
+	policy := &Policy{}
+	inRules := false
+	var rule *Rule
+
+	for n, line := range strings.Split(data, "\n") {
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		indented := line[0] == ' ' || line[0] == '\t'
+		text := strings.TrimSpace(line)
+
+		if !indented {
+			key, value, ok := splitYAMLPair(text)
+			if !ok {
+				return nil, fmt.Errorf("line %d: expected key: value", n+1)
+			}
+			inRules = key == "rules"
+			switch key {
+			case "name":
+				policy.Name = value
+			case "rules":
+				if value != "" && value != "[]" {
+					return nil, fmt.Errorf("line %d: rules must be a list", n+1)
+				}
+			default:
+				return nil, fmt.Errorf("line %d: unknown key %q", n+1, key)
+			}
+			continue
+		}
+
+		if !inRules {
+			return nil, fmt.Errorf("line %d: unexpected indentation", n+1)
+		}
+		if item, ok := strings.CutPrefix(text, "-"); ok {
+			policy.Rules = append(policy.Rules, Rule{})
+			rule = &policy.Rules[len(policy.Rules)-1]
+			text = strings.TrimSpace(item)
+			if text == "" {
+				continue
+			}
+		}
+		if rule == nil {
+			return nil, fmt.Errorf("line %d: expected a list item", n+1)
+		}
+
+		key, value, ok := splitYAMLPair(text)
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key: value", n+1)
+		}
+		switch key {
+		case "priority":
+			rule.Priority = value
+		case "state":
+			rule.State = value
+		case "max":
+			rule.Max = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown rule key %q", n+1, key)
+		}
+	}
+	return policy, nil
+}
+
+// splitYAMLPair splits "key: value", unquoting the value
+func splitYAMLPair(text string) (string, string, bool) {
+	key, value, ok := strings.Cut(text, ":")
+	if !ok {
+		return "", "", false
+	}
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+	return strings.TrimSpace(key), value, true
+}
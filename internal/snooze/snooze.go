@@ -0,0 +1,130 @@
+// Package snooze stores issues snoozed until a later time. Snoozes are
+// local: Linear is not told, and commands such as 'linear me' hide a
+// snoozed issue until it wakes.
+package snooze
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/state"
+)
+
+const (
+	// FileEnv overrides the snoozes file
+	FileEnv = "LINEAR_SNOOZE_FILE"
+
+	fileName = "snoozes.json"
+)
+
+// Snooze hides an issue until a time
+type Snooze struct {
+	ID         string    `json:"id"`
+	Identifier string    `json:"identifier"`
+	Title      string    `json:"title"`
+	URL        string    `json:"url,omitempty"`
+	Until      time.Time `json:"until"`
+	// Comment, when set, is posted on the issue when it wakes
+	Comment   string    `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Due reports whether the snooze has ended by now
+func (s *Snooze) Due(now time.Time) bool {
+	return !now.Before(s.Until)
+}
+
+// Store reads and writes the snoozes file
+type Store struct {
+	path string
+}
+
+// NewStore uses $LINEAR_SNOOZE_FILE, or snoozes.json in
+// $XDG_STATE_HOME/agent-linear-cli (falling back to ~/.local/state)
+func NewStore() (*Store, error) {
+	path, err := state.Path(FileEnv, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{path: path}, nil
+}
+
+// Path returns the snoozes file
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns the snoozes, soonest to wake first
+func (s *Store) List() ([]*Snooze, error) {
+	snoozes := []*Snooze{}
+	if err := state.ReadJSON(s.path, &snoozes); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(snoozes, func(i, j int) bool { return snoozes[i].Until.Before(snoozes[j].Until) })
+	return snoozes, nil
+}
+
+// Save adds the snooze or replaces the one for the same issue
+func (s *Store) Save(snooze *Snooze) error {
+	snoozes, err := s.List()
+	if err != nil {
+		return err
+	}
+	for i, existing := range snoozes {
+		if existing.matches(snooze.Identifier) || existing.ID == snooze.ID {
+			snoozes[i] = snooze
+			return state.WriteJSON(s.path, snoozes)
+		}
+	}
+	return state.WriteJSON(s.path, append(snoozes, snooze))
+}
+
+// Remove deletes the snooze of the issue with the given identifier or ID
+// and returns it, or nil when the issue was not snoozed
+func (s *Store) Remove(issue string) (*Snooze, error) {
+	snoozes, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	var removed *Snooze
+	kept := make([]*Snooze, 0, len(snoozes))
+	for _, snooze := range snoozes {
+		if removed == nil && snooze.matches(issue) {
+			removed = snooze
+			continue
+		}
+		kept = append(kept, snooze)
+	}
+	if removed == nil {
+		return nil, nil
+	}
+	return removed, state.WriteJSON(s.path, kept)
+}
+
+// Wake removes the snoozes that are due by now and returns them
+func (s *Store) Wake(now time.Time) ([]*Snooze, error) {
+	snoozes, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	woken := []*Snooze{}
+	kept := make([]*Snooze, 0, len(snoozes))
+	for _, snooze := range snoozes {
+		if snooze.Due(now) {
+			woken = append(woken, snooze)
+		} else {
+			kept = append(kept, snooze)
+		}
+	}
+	if len(woken) == 0 {
+		return woken, nil
+	}
+	return woken, state.WriteJSON(s.path, kept)
+}
+
+// matches reports whether issue is the snoozed issue's identifier
+// (case-insensitive) or ID
+func (s *Snooze) matches(issue string) bool {
+	return strings.EqualFold(s.Identifier, issue) || s.ID == issue
+}
@@ -0,0 +1,215 @@
+// Package templates manages local markdown templates for issues and comments.
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	// DirName is the application directory under the user config dir
+	DirName = "agent-linear-cli"
+
+	// KindIssue is the template kind for issue descriptions
+	KindIssue = "issue"
+
+	// KindComment is the template kind for issue comments
+	KindComment = "comment"
+
+	// fileExt is the extension used for template files
+	fileExt = ".md"
+)
+
+// Kinds lists all supported template kinds
+var Kinds = []string{KindIssue, KindComment}
+
+// Template is a named template stored on disk
+type Template struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Body string `json:"body,omitempty"`
+}
+
+// Store reads and writes templates in a directory tree laid out as
+// <dir>/<kind>/<name>.md
+type Store struct {
+	dir string
+}
+
+// NewStore creates a template store. LINEAR_TEMPLATES_DIR overrides the
+// default location of $XDG_CONFIG_HOME/agent-linear-cli/templates.
+func NewStore() (*Store, error) {
+	if dir := os.Getenv("LINEAR_TEMPLATES_DIR"); dir != "" {
+		return &Store{dir: dir}, nil
+	}
+
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		configHome = filepath.Join(home, ".config")
+	}
+
+	return &Store{dir: filepath.Join(configHome, DirName, "templates")}, nil
+}
+
+// NewStoreIn creates a template store rooted at dir
+func NewStoreIn(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Dir returns the root directory of the store
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// ValidateKind returns an error if kind is not a supported template kind
+func ValidateKind(kind string) error {
+	for _, k := range Kinds {
+		if k == kind {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown template kind %q (use %s)", kind, strings.Join(Kinds, ", "))
+}
+
+// validateName rejects names that could escape the store directory
+func validateName(name string) error {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid template name %q", name)
+	}
+	return nil
+}
+
+func (s *Store) path(kind, name string) string {
+	return filepath.Join(s.dir, kind, name+fileExt)
+}
+
+// List returns the templates of the given kind, sorted by name
+func (s *Store) List(kind string) ([]Template, error) {
+	if err := ValidateKind(kind); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(s.dir, kind))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Template{}, nil
+		}
+		return nil, err
+	}
+
+	list := []Template{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != fileExt {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), fileExt)
+		list = append(list, Template{
+			Kind: kind,
+			Name: name,
+			Path: s.path(kind, name),
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// Get loads a template, returning nil if it does not exist
+func (s *Store) Get(kind, name string) (*Template, error) {
+	if err := ValidateKind(kind); err != nil {
+		return nil, err
+	}
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	path := s.path(kind, name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &Template{Kind: kind, Name: name, Path: path, Body: string(data)}, nil
+}
+
+// Save writes a template, replacing any existing one with the same name.
+// The body is parsed first so broken templates are never stored.
+func (s *Store) Save(kind, name, body string) (*Template, error) {
+	if err := ValidateKind(kind); err != nil {
+		return nil, err
+	}
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	if _, err := template.New(name).Parse(body); err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	path := s.path(kind, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		return nil, err
+	}
+
+	return &Template{Kind: kind, Name: name, Path: path, Body: body}, nil
+}
+
+// Delete removes a template
+func (s *Store) Delete(kind, name string) error {
+	if err := ValidateKind(kind); err != nil {
+		return err
+	}
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	err := os.Remove(s.path(kind, name))
+	if os.IsNotExist(err) {
+		return fmt.Errorf("template %q not found", name)
+	}
+	return err
+}
+
+// Render executes a template body against data. Referencing a key that is
+// not present in data is an error so typos in variables are caught early.
+func Render(name, body string, data map[string]interface{}) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(body)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// ParseVars parses key=value pairs as passed to --var flags
+func ParseVars(pairs []string) (map[string]string, error) {
+	vars := map[string]string{}
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable %q (use key=value)", pair)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
@@ -0,0 +1,167 @@
+package testserver
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Case is a golden test: a command line whose output is kept in golden
+// files named after the case
+type Case struct {
+	Name string
+	Args []string
+	// Repo names a directory under testdata/repos the command runs in, for
+	// cases that read files from it such as a repository .linear.toml or
+	// an apply manifest; "" runs in the home directory
+	Repo string
+}
+
+// ParseCases reads a cases file, one case per line:
+//
+//	# comment
+//	issue-list: issue list --team ENG
+//	issue-view: issue view ENG-1 --comments
+//	config-repo @team-eng: config list
+//
+// Arguments are split on spaces; quote them with ' or " to keep spaces.
+// "@dir" after the name runs the case in testdata/repos/dir.
+func ParseCases(data []byte) ([]Case, error) {
+	var cases []Case
+	seen := map[string]bool{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, rest, ok := strings.Cut(line, ":")
+		name, repo, _ := strings.Cut(strings.TrimSpace(name), " @")
+		name, repo = strings.TrimSpace(name), strings.TrimSpace(repo)
+		if !ok || name == "" || strings.ContainsAny(name+repo, " /") {
+			return nil, fmt.Errorf("line %d: expected 'name: args' or 'name @repo: args'", i+1)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("line %d: duplicate case %s", i+1, name)
+		}
+		seen[name] = true
+		args, err := splitArgs(rest)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		cases = append(cases, Case{Name: name, Args: args, Repo: repo})
+	}
+	return cases, nil
+}
+
+// splitArgs splits a command line on spaces outside quotes
+func splitArgs(s string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	inArg := false
+	var quote rune
+	for _, r := range s {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			arg.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+// volatile matches output that changes from run to run: timings such as
+// 12ms or 1.2s, and JSON latencies such as "latencyMs": 12
+var (
+	volatile     = regexp.MustCompile(`\b(\d+(\.\d+)?(ns|µs|ms)|\d+\.\d+s)\b`)
+	volatileJSON = regexp.MustCompile(`("\w*(latency|Latency|duration|Duration)\w*": )\d+(\.\d+)?`)
+)
+
+// Normalize makes output stable across runs and machines. Each key of
+// replace, such as a temporary directory or the server's URL, becomes its
+// value; timings become <duration>, or 0 in JSON.
+func Normalize(out []byte, replace map[string]string) []byte {
+	s := string(out)
+	// Longer keys first, so a path isn't cut short by its parent
+	keys := make([]string, 0, len(replace))
+	for key := range replace {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+	for _, key := range keys {
+		s = strings.ReplaceAll(s, key, replace[key])
+	}
+	s = volatileJSON.ReplaceAllString(s, "${1}0")
+	s = volatile.ReplaceAllString(s, "<duration>")
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// Compare checks got against the golden file at path, or writes it there
+// when update is set
+func Compare(path string, got []byte, update bool) error {
+	if update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(path, got, 0644)
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%s is missing; run with -update to create it", path)
+		}
+		return err
+	}
+	if bytes.Equal(got, want) {
+		return nil
+	}
+	return fmt.Errorf("%s differs:\n%s", path, diff(string(want), string(got)))
+}
+
+// diff shows the lines around the first difference between want and got
+func diff(want, got string) string {
+	w, g := strings.Split(want, "\n"), strings.Split(got, "\n")
+	line := 0
+	for line < len(w) && line < len(g) && w[line] == g[line] {
+		line++
+	}
+	var b strings.Builder
+	from := max(line-2, 0)
+	for i := from; i < line; i++ {
+		fmt.Fprintf(&b, "  %4d   %s\n", i+1, w[i])
+	}
+	for i := line; i < min(line+3, len(w)); i++ {
+		fmt.Fprintf(&b, "- %4d   %s\n", i+1, w[i])
+	}
+	for i := line; i < min(line+3, len(g)); i++ {
+		fmt.Fprintf(&b, "+ %4d   %s\n", i+1, g[i])
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
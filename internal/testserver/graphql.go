@@ -0,0 +1,394 @@
+package testserver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// operation is a parsed GraphQL operation. Fragments aren't supported;
+// the CLI doesn't send any.
+type operation struct {
+	kind      string // query or mutation
+	name      string
+	variables map[string]interface{} // defaults of declared variables
+	fields    []*field
+}
+
+// field is a selected field with its arguments, still unresolved where
+// they refer to variables
+type field struct {
+	alias      string
+	name       string
+	args       map[string]interface{}
+	directives map[string]map[string]interface{}
+	fields     []*field
+}
+
+// key is the name the field's value is returned under
+func (f *field) key() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+// variable is a reference to an operation variable in an argument
+type variable string
+
+// enum is an enum value literal, such as an orderBy of updatedAt
+type enum string
+
+// parseOperation parses a GraphQL document with a single operation
+func parseOperation(query string) (*operation, error) {
+	p := &parser{src: query}
+	p.next()
+
+	op := &operation{kind: "query", variables: map[string]interface{}{}}
+	if p.tok == "query" || p.tok == "mutation" || p.tok == "subscription" {
+		op.kind = p.tok
+		p.next()
+		if p.kind == tokName {
+			op.name = p.tok
+			p.next()
+		}
+		if p.tok == "(" {
+			if err := p.variableDefinitions(op); err != nil {
+				return nil, err
+			}
+		}
+		// Directives on the operation are ignored
+		for p.tok == "@" {
+			p.next()
+			p.next()
+			if p.tok == "(" {
+				if _, err := p.arguments(); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	fields, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.fields = fields
+	if p.kind != tokEOF {
+		return nil, p.errorf("unexpected %q after the operation", p.tok)
+	}
+	return op, nil
+}
+
+const (
+	tokEOF = iota
+	tokName
+	tokString
+	tokNumber
+	tokPunct
+)
+
+type parser struct {
+	src  string
+	pos  int
+	tok  string
+	kind int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("GraphQL syntax error at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// next advances to the next token, skipping whitespace, commas and
+// comments
+func (p *parser) next() {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case c == ',' || unicode.IsSpace(rune(c)):
+			p.pos++
+		default:
+			goto token
+		}
+	}
+	p.tok, p.kind = "", tokEOF
+	return
+
+token:
+	start := p.pos
+	c := p.src[p.pos]
+	switch {
+	case c == '_' || unicode.IsLetter(rune(c)):
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || unicode.IsLetter(rune(p.src[p.pos])) || unicode.IsDigit(rune(p.src[p.pos]))) {
+			p.pos++
+		}
+		p.tok, p.kind = p.src[start:p.pos], tokName
+	case c == '-' || unicode.IsDigit(rune(c)):
+		p.pos++
+		for p.pos < len(p.src) && strings.ContainsRune("0123456789.eE+-", rune(p.src[p.pos])) {
+			p.pos++
+		}
+		p.tok, p.kind = p.src[start:p.pos], tokNumber
+	case c == '"':
+		p.string()
+	case strings.HasPrefix(p.src[p.pos:], "..."):
+		p.pos += 3
+		p.tok, p.kind = "...", tokPunct
+	default:
+		p.pos++
+		p.tok, p.kind = string(c), tokPunct
+	}
+}
+
+// string reads a string literal, including block strings, as its value
+func (p *parser) string() {
+	if strings.HasPrefix(p.src[p.pos:], `"""`) {
+		end := strings.Index(p.src[p.pos+3:], `"""`)
+		if end < 0 {
+			end = len(p.src) - p.pos - 3
+		}
+		p.tok, p.kind = p.src[p.pos+3:p.pos+3+end], tokString
+		p.pos = min(p.pos+end+6, len(p.src))
+		return
+	}
+	end := p.pos + 1
+	for end < len(p.src) && p.src[end] != '"' {
+		if p.src[end] == '\\' {
+			end++
+		}
+		end++
+	}
+	raw := p.src[p.pos:min(end+1, len(p.src))]
+	p.pos = min(end+1, len(p.src))
+	value, err := strconv.Unquote(raw)
+	if err != nil {
+		value = strings.Trim(raw, `"`)
+	}
+	p.tok, p.kind = value, tokString
+}
+
+func (p *parser) expect(tok string) error {
+	if p.kind == tokString || p.tok != tok {
+		return p.errorf("expected %q, found %q", tok, p.tok)
+	}
+	p.next()
+	return nil
+}
+
+// variableDefinitions reads ($name: Type = default, ...), keeping
+// the defaults
+func (p *parser) variableDefinitions(op *operation) error {
+	p.next()
+	for p.tok != ")" {
+		if err := p.expect("$"); err != nil {
+			return err
+		}
+		name := p.tok
+		p.next()
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		// Skip the type: names, brackets and !
+		for p.kind != tokEOF && (p.kind == tokName || p.tok == "[" || p.tok == "]" || p.tok == "!") {
+			p.next()
+		}
+		if p.tok == "=" {
+			p.next()
+			value, err := p.value()
+			if err != nil {
+				return err
+			}
+			op.variables[name] = value
+		}
+		if p.kind == tokEOF {
+			return p.errorf("unterminated variable definitions")
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) selectionSet() ([]*field, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var fields []*field
+	for p.tok != "}" {
+		if p.kind == tokEOF {
+			return nil, p.errorf("unterminated selection set")
+		}
+		if p.tok == "..." {
+			return nil, p.errorf("fragments are not supported")
+		}
+		f, err := p.field()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	p.next()
+	return fields, nil
+}
+
+func (p *parser) field() (*field, error) {
+	if p.kind != tokName {
+		return nil, p.errorf("expected a field name, found %q", p.tok)
+	}
+	f := &field{name: p.tok}
+	p.next()
+	if p.tok == ":" {
+		p.next()
+		f.alias, f.name = f.name, p.tok
+		p.next()
+	}
+	if p.tok == "(" {
+		args, err := p.arguments()
+		if err != nil {
+			return nil, err
+		}
+		f.args = args
+	}
+	for p.tok == "@" {
+		p.next()
+		name := p.tok
+		p.next()
+		args := map[string]interface{}{}
+		if p.tok == "(" {
+			var err error
+			if args, err = p.arguments(); err != nil {
+				return nil, err
+			}
+		}
+		if f.directives == nil {
+			f.directives = map[string]map[string]interface{}{}
+		}
+		f.directives[name] = args
+	}
+	if p.tok == "{" {
+		fields, err := p.selectionSet()
+		if err != nil {
+			return nil, err
+		}
+		f.fields = fields
+	}
+	return f, nil
+}
+
+func (p *parser) arguments() (map[string]interface{}, error) {
+	p.next()
+	args := map[string]interface{}{}
+	for p.tok != ")" {
+		if p.kind != tokName {
+			return nil, p.errorf("expected an argument name, found %q", p.tok)
+		}
+		name := p.tok
+		p.next()
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		args[name] = value
+	}
+	p.next()
+	return args, nil
+}
+
+// value reads an argument value: a literal, list, object or variable
+func (p *parser) value() (interface{}, error) {
+	switch {
+	case p.kind == tokString:
+		value := p.tok
+		p.next()
+		return value, nil
+	case p.kind == tokNumber:
+		value, err := strconv.ParseFloat(p.tok, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", p.tok)
+		}
+		p.next()
+		return value, nil
+	case p.tok == "$":
+		p.next()
+		name := p.tok
+		p.next()
+		return variable(name), nil
+	case p.tok == "[":
+		p.next()
+		list := []interface{}{}
+		for p.tok != "]" {
+			if p.kind == tokEOF {
+				return nil, p.errorf("unterminated list")
+			}
+			value, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		p.next()
+		return list, nil
+	case p.tok == "{":
+		p.next()
+		object := map[string]interface{}{}
+		for p.tok != "}" {
+			if p.kind != tokName {
+				return nil, p.errorf("expected a field name, found %q", p.tok)
+			}
+			name := p.tok
+			p.next()
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			value, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			object[name] = value
+		}
+		p.next()
+		return object, nil
+	case p.kind == tokName:
+		name := p.tok
+		p.next()
+		switch name {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		return enum(name), nil
+	}
+	return nil, p.errorf("unexpected %q", p.tok)
+}
+
+// resolveValue replaces variables in an argument value with their values
+func resolveValue(value interface{}, vars map[string]interface{}) interface{} {
+	switch v := value.(type) {
+	case variable:
+		return vars[string(v)]
+	case enum:
+		return string(v)
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = resolveValue(item, vars)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			out[key] = resolveValue(item, vars)
+		}
+		return out
+	}
+	return value
+}
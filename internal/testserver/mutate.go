@@ -0,0 +1,185 @@
+package testserver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// mutationPattern splits a mutation such as issueCreate into the type and
+// the action
+var mutationPattern = regexp.MustCompile(`^(\w+?)(Create|Update|Delete|Archive|Unarchive)$`)
+
+// mutate runs a mutation: create, update, delete, archive and unarchive of
+// any collection, plus adding and removing issue labels
+func (e *executor) mutate(f *field) (interface{}, *gqlError) {
+	e.s.syncID++
+	args := e.args(f)
+
+	switch f.name {
+	case "issueAddLabel", "issueRemoveLabel":
+		id, _ := args["id"].(string)
+		issue := e.s.lookup("issues", id)
+		if issue == nil {
+			return nil, notFound("issues")
+		}
+		labelID, _ := args["labelId"].(string)
+		labels := []interface{}{}
+		for _, ref := range asList(issue["labels"]) {
+			if !refersTo(ref, labelID) {
+				labels = append(labels, ref)
+			}
+		}
+		if f.name == "issueAddLabel" {
+			labels = append(labels, map[string]interface{}{"id": labelID})
+		}
+		issue["labels"] = labels
+		issue["updatedAt"] = e.s.timestamp()
+		return e.payload(f, "issue", issue), nil
+	}
+
+	m := mutationPattern.FindStringSubmatch(f.name)
+	if m == nil {
+		return nil, &gqlError{Message: fmt.Sprintf("testserver: unsupported mutation %s", f.name)}
+	}
+	name, action := m[1], m[2]
+	collection := plural(name)
+	if _, ok := e.s.collections[collection]; !ok {
+		// Creating the first object of a collection the fixture left out
+		if action != "Create" {
+			return nil, notFound(collection)
+		}
+		e.s.collections[collection] = nil
+	}
+
+	input, _ := args["input"].(map[string]interface{})
+	if action == "Create" {
+		obj := map[string]interface{}{
+			"id":        e.s.newID(collection),
+			"createdAt": e.s.timestamp(),
+			"updatedAt": e.s.timestamp(),
+		}
+		if id, ok := input["id"].(string); ok {
+			obj["id"] = id
+		}
+		e.apply(obj, input)
+		e.defaults(collection, obj)
+		e.s.insert(collection, obj)
+		return e.payload(f, name, obj), nil
+	}
+
+	id, _ := args["id"].(string)
+	obj := e.s.lookup(collection, id)
+	if obj == nil {
+		return nil, notFound(collection)
+	}
+	switch action {
+	case "Update":
+		e.apply(obj, input)
+		obj["updatedAt"] = e.s.timestamp()
+	case "Archive":
+		obj["archivedAt"] = e.s.timestamp()
+	case "Unarchive":
+		delete(obj, "archivedAt")
+	case "Delete":
+		e.s.remove(obj["id"].(string))
+	}
+	return e.payload(f, name, obj), nil
+}
+
+// apply copies mutation input onto obj, turning fooId and fooIds into
+// references
+func (e *executor) apply(obj, input map[string]interface{}) {
+	for key, value := range input {
+		switch {
+		case key == "id":
+		case strings.HasSuffix(key, "Ids"):
+			refs := []interface{}{}
+			for _, id := range asList(value) {
+				refs = append(refs, map[string]interface{}{"id": id})
+			}
+			obj[strings.TrimSuffix(key, "Ids")+"s"] = refs
+		case strings.HasSuffix(key, "Id") && len(key) > 2:
+			field := strings.TrimSuffix(key, "Id")
+			if value == nil {
+				obj[field] = nil
+				continue
+			}
+			obj[field] = map[string]interface{}{"id": value}
+		default:
+			obj[key] = value
+		}
+	}
+}
+
+// defaults fills the fields Linear sets on creation
+func (e *executor) defaults(collection string, obj map[string]interface{}) {
+	if _, ok := obj["creator"]; !ok && e.s.viewer != "" {
+		obj["creator"] = map[string]interface{}{"id": e.s.viewer}
+	}
+	switch collection {
+	case "projects", "documents", "initiatives":
+		// Slugs are the title with a short suffix, like Linear's
+		// brand-refresh-3c4d
+		title, _ := obj["name"].(string)
+		if title == "" {
+			title, _ = obj["title"].(string)
+		}
+		obj["slugId"] = fmt.Sprintf("%s-%04d", slugify(title), e.s.nextID)
+	}
+	if collection != "issues" {
+		return
+	}
+	team, _ := e.s.deref(obj["team"]).(map[string]interface{})
+	number := 0
+	for _, issue := range e.s.collections["issues"] {
+		if team != nil && refersTo(issue["team"], team["id"]) {
+			if n, ok := issue["number"].(float64); ok && int(n) > number {
+				number = int(n)
+			}
+		}
+	}
+	obj["number"] = float64(number + 1)
+	if _, ok := obj["priority"]; !ok {
+		obj["priority"] = float64(0)
+	}
+	if _, ok := obj["state"]; !ok && team != nil {
+		// New issues start in the team's first backlog or unstarted state
+		for _, want := range []string{"backlog", "unstarted"} {
+			for _, state := range e.s.collections["workflowStates"] {
+				if refersTo(state["team"], team["id"]) && state["type"] == want {
+					obj["state"] = map[string]interface{}{"id": state["id"]}
+					return
+				}
+			}
+		}
+	}
+}
+
+// payload answers a mutation with its success flag and the affected
+// object under the type's name
+func (e *executor) payload(f *field, name string, obj map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for _, pf := range f.fields {
+		switch pf.name {
+		case "success":
+			out[pf.key()] = true
+		case "lastSyncId":
+			out[pf.key()] = e.s.syncID
+		case name, "entity":
+			out[pf.key()] = e.object(obj, pf.fields)
+		default:
+			out[pf.key()] = nil
+		}
+	}
+	return out
+}
+
+// slugify lowercases s and joins its words with dashes
+func slugify(s string) string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
@@ -0,0 +1,472 @@
+package testserver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// gqlError is a GraphQL error returned in the response's errors
+type gqlError struct {
+	Message    string                 `json:"message"`
+	Path       []string               `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+func (e *gqlError) Error() string {
+	return e.Message
+}
+
+// notFound is the error Linear returns for an unknown ID
+func notFound(collection string) *gqlError {
+	return &gqlError{
+		Message: "Entity not found: " + typeName(collection),
+		Extensions: map[string]interface{}{
+			"code": "INPUT_ERROR",
+			"type": "invalid input",
+		},
+	}
+}
+
+// typeName returns the GraphQL type of a collection's objects: issues ->
+// Issue
+func typeName(collection string) string {
+	name := singular(collection)
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// executor runs one operation against the store
+type executor struct {
+	s    *store
+	vars map[string]interface{}
+}
+
+// execute resolves the operation's root fields
+func (e *executor) execute(op *operation) (map[string]interface{}, []*gqlError) {
+	data := map[string]interface{}{}
+	var errs []*gqlError
+	for _, f := range op.fields {
+		if !e.included(f) {
+			continue
+		}
+		var value interface{}
+		var err *gqlError
+		if op.kind == "mutation" {
+			value, err = e.mutate(f)
+		} else {
+			value, err = e.query(f)
+		}
+		if err != nil {
+			err.Path = []string{f.key()}
+			errs = append(errs, err)
+			data[f.key()] = nil
+			continue
+		}
+		data[f.key()] = value
+	}
+	return data, errs
+}
+
+// args returns f's arguments with variables substituted
+func (e *executor) args(f *field) map[string]interface{} {
+	out := make(map[string]interface{}, len(f.args))
+	for key, value := range f.args {
+		out[key] = resolveValue(value, e.vars)
+	}
+	return out
+}
+
+// included applies @include and @skip
+func (e *executor) included(f *field) bool {
+	if args, ok := f.directives["include"]; ok {
+		if on, _ := resolveValue(args["if"], e.vars).(bool); !on {
+			return false
+		}
+	}
+	if args, ok := f.directives["skip"]; ok {
+		if on, _ := resolveValue(args["if"], e.vars).(bool); on {
+			return false
+		}
+	}
+	return true
+}
+
+// searchPattern matches the search root fields, such as searchIssues
+var searchPattern = regexp.MustCompile(`^search([A-Z]\w*)$`)
+
+func (e *executor) query(f *field) (interface{}, *gqlError) {
+	args := e.args(f)
+	switch f.name {
+	case "__typename":
+		return "Query", nil
+	case "viewer":
+		viewer := e.s.byID[e.s.viewer]
+		if viewer == nil {
+			return nil, &gqlError{Message: "Authentication required", Extensions: map[string]interface{}{"code": "AUTHENTICATION_ERROR"}}
+		}
+		return e.object(viewer, f.fields), nil
+	case "organization":
+		return e.object(e.s.organization, f.fields), nil
+	}
+
+	if m := searchPattern.FindStringSubmatch(f.name); m != nil {
+		collection := strings.ToLower(m[1][:1]) + m[1][1:]
+		if _, ok := e.s.collections[collection]; ok {
+			term, _ := args["term"].(string)
+			items := []interface{}{}
+			for _, item := range e.s.collections[collection] {
+				if matchesTerm(item, term) {
+					items = append(items, item)
+				}
+			}
+			return e.connection(items, args, f.fields), nil
+		}
+	}
+
+	if items, ok := e.s.collections[f.name]; ok {
+		list := make([]interface{}, len(items))
+		for i, item := range items {
+			list[i] = item
+		}
+		return e.connection(list, args, f.fields), nil
+	}
+
+	collection := plural(f.name)
+	if _, ok := e.s.collections[collection]; ok {
+		id, _ := args["id"].(string)
+		obj := e.s.lookup(collection, id)
+		if obj == nil {
+			return nil, notFound(collection)
+		}
+		return e.object(obj, f.fields), nil
+	}
+	return nil, &gqlError{Message: fmt.Sprintf("testserver: the workspace has no %s", f.name)}
+}
+
+// matchesTerm reports whether a search term appears in an item's title,
+// name, identifier or description
+func matchesTerm(item map[string]interface{}, term string) bool {
+	term = strings.ToLower(term)
+	for _, key := range []string{"title", "name", "identifier", "description", "content"} {
+		if value, ok := item[key].(string); ok && strings.Contains(strings.ToLower(value), term) {
+			return true
+		}
+	}
+	return false
+}
+
+// object projects obj onto a selection set
+func (e *executor) object(obj map[string]interface{}, fields []*field) map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		if !e.included(f) {
+			continue
+		}
+		if f.name == "__typename" {
+			out[f.key()] = typeName(e.s.collectionOf[fmt.Sprint(obj["id"])])
+			continue
+		}
+		out[f.key()] = e.value(e.s.field(obj, f.name), f)
+	}
+	return out
+}
+
+// value projects a field's value onto the field's selection
+func (e *executor) value(value interface{}, f *field) interface{} {
+	if len(f.fields) == 0 {
+		return value
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return e.object(v, f.fields)
+	case []interface{}:
+		if isConnection(f.fields) {
+			return e.connection(v, e.args(f), f.fields)
+		}
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = e.value(item, f)
+		}
+		return out
+	}
+	return nil
+}
+
+// isConnection reports whether a selection asks for a connection rather
+// than a plain list
+func isConnection(fields []*field) bool {
+	for _, f := range fields {
+		switch f.name {
+		case "nodes", "edges", "pageInfo", "totalCount":
+			return true
+		}
+	}
+	return false
+}
+
+// connection filters, orders and pages items for a connection field
+func (e *executor) connection(items []interface{}, args map[string]interface{}, fields []*field) map[string]interface{} {
+	includeArchived, _ := args["includeArchived"].(bool)
+	filter, _ := args["filter"].(map[string]interface{})
+	matched := []interface{}{}
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if !includeArchived && obj["archivedAt"] != nil {
+			continue
+		}
+		if filter != nil && !e.matches(obj, filter) {
+			continue
+		}
+		matched = append(matched, obj)
+	}
+	orderBy, _ := args["orderBy"].(string)
+	matched = sorted(matched, orderBy)
+
+	start := 0
+	if after, ok := args["after"].(string); ok && after != "" {
+		for i, item := range matched {
+			if item.(map[string]interface{})["id"] == after {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end := len(matched)
+	if first, ok := args["first"].(float64); ok && start+int(first) < end {
+		end = start + int(first)
+	}
+	page := matched[start:end]
+
+	pageInfo := map[string]interface{}{
+		"hasNextPage":     end < len(matched),
+		"hasPreviousPage": start > 0,
+		"startCursor":     nil,
+		"endCursor":       nil,
+	}
+	if len(page) > 0 {
+		pageInfo["startCursor"] = page[0].(map[string]interface{})["id"]
+		pageInfo["endCursor"] = page[len(page)-1].(map[string]interface{})["id"]
+	}
+
+	out := map[string]interface{}{}
+	for _, f := range fields {
+		switch f.name {
+		case "nodes":
+			nodes := make([]interface{}, len(page))
+			for i, item := range page {
+				nodes[i] = e.object(item.(map[string]interface{}), f.fields)
+			}
+			out[f.key()] = nodes
+		case "edges":
+			edges := make([]interface{}, len(page))
+			for i, item := range page {
+				obj := item.(map[string]interface{})
+				edge := map[string]interface{}{}
+				for _, ef := range f.fields {
+					switch ef.name {
+					case "node":
+						edge[ef.key()] = e.object(obj, ef.fields)
+					case "cursor":
+						edge[ef.key()] = obj["id"]
+					}
+				}
+				edges[i] = edge
+			}
+			out[f.key()] = edges
+		case "pageInfo":
+			out[f.key()] = e.object(pageInfo, f.fields)
+		case "totalCount":
+			out[f.key()] = len(matched)
+		}
+	}
+	return out
+}
+
+// matches evaluates a Linear filter, such as
+// {team: {key: {eq: "ENG"}}, labels: {some: {name: {eqIgnoreCase: "bug"}}}}
+func (e *executor) matches(obj map[string]interface{}, filter map[string]interface{}) bool {
+	for key, cond := range filter {
+		switch key {
+		case "and":
+			for _, sub := range asList(cond) {
+				if m, ok := sub.(map[string]interface{}); ok && !e.matches(obj, m) {
+					return false
+				}
+			}
+			continue
+		case "or":
+			any := false
+			for _, sub := range asList(cond) {
+				if m, ok := sub.(map[string]interface{}); ok && e.matches(obj, m) {
+					any = true
+					break
+				}
+			}
+			if !any {
+				return false
+			}
+			continue
+		}
+		c, ok := cond.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if !e.compare(e.s.field(obj, key), c) {
+			return false
+		}
+	}
+	return true
+}
+
+// compare applies a filter condition to a value: comparators such as eq
+// and in, some/every/none for lists, or a nested filter for objects
+func (e *executor) compare(value interface{}, cond map[string]interface{}) bool {
+	nested := map[string]interface{}{}
+	for op, want := range cond {
+		switch op {
+		case "null":
+			if isNull, _ := want.(bool); isNull != (value == nil) {
+				return false
+			}
+		case "eq":
+			if !equal(value, want) {
+				return false
+			}
+		case "neq":
+			if equal(value, want) {
+				return false
+			}
+		case "eqIgnoreCase":
+			if !strings.EqualFold(fmt.Sprint(value), fmt.Sprint(want)) {
+				return false
+			}
+		case "neqIgnoreCase":
+			if strings.EqualFold(fmt.Sprint(value), fmt.Sprint(want)) {
+				return false
+			}
+		case "in", "nin":
+			found := false
+			for _, item := range asList(want) {
+				if equal(value, item) {
+					found = true
+					break
+				}
+			}
+			if found != (op == "in") {
+				return false
+			}
+		case "gt", "gte", "lt", "lte":
+			if value == nil || !ordered(value, want, op) {
+				return false
+			}
+		case "contains", "containsIgnoreCase", "notContains", "notContainsIgnoreCase", "startsWith", "endsWith":
+			if !stringMatch(value, want, op) {
+				return false
+			}
+		case "some", "every", "none":
+			sub, _ := want.(map[string]interface{})
+			if !e.quantify(value, sub, op) {
+				return false
+			}
+		default:
+			nested[op] = want
+		}
+	}
+	if len(nested) == 0 {
+		return true
+	}
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	return e.matches(obj, nested)
+}
+
+func (e *executor) quantify(value interface{}, filter map[string]interface{}, op string) bool {
+	count, total := 0, 0
+	for _, item := range asList(value) {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		total++
+		if e.matches(obj, filter) {
+			count++
+		}
+	}
+	switch op {
+	case "some":
+		return count > 0
+	case "every":
+		return count == total
+	}
+	return count == 0
+}
+
+func asList(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	return list
+}
+
+func equal(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return fmt.Sprint(jsonNumber(a)) == fmt.Sprint(jsonNumber(b))
+}
+
+// ordered compares numbers numerically and anything else, such as ISO
+// dates, as strings
+func ordered(value, want interface{}, op string) bool {
+	var cmp int
+	a, aNum := value.(float64)
+	b, bNum := want.(float64)
+	if aNum && bNum {
+		switch {
+		case a < b:
+			cmp = -1
+		case a > b:
+			cmp = 1
+		}
+	} else {
+		cmp = strings.Compare(fmt.Sprint(value), fmt.Sprint(want))
+	}
+	switch op {
+	case "gt":
+		return cmp > 0
+	case "gte":
+		return cmp >= 0
+	case "lt":
+		return cmp < 0
+	}
+	return cmp <= 0
+}
+
+func stringMatch(value, want interface{}, op string) bool {
+	s, ok := value.(string)
+	if !ok {
+		return strings.HasPrefix(op, "not")
+	}
+	w := fmt.Sprint(want)
+	if strings.HasSuffix(op, "IgnoreCase") {
+		s, w = strings.ToLower(s), strings.ToLower(w)
+	}
+	switch op {
+	case "contains", "containsIgnoreCase":
+		return strings.Contains(s, w)
+	case "notContains", "notContainsIgnoreCase":
+		return !strings.Contains(s, w)
+	case "startsWith":
+		return strings.HasPrefix(s, w)
+	}
+	return strings.HasSuffix(s, w)
+}
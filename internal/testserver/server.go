@@ -0,0 +1,190 @@
+// Package testserver emulates the subset of the Linear GraphQL API the CLI
+// uses, over an in-memory workspace loaded from a fixture, so commands can
+// run end to end without network access or an account.
+//
+// Queries are answered generically: root fields named after a collection
+// return a connection with Linear's filters, paging and includeArchived;
+// singular root fields look an object up by ID, identifier, key or slug;
+// searchX fields match text. Mutations named <type>Create, Update, Delete,
+// Archive and Unarchive change the workspace, so a command's later
+// requests see its earlier writes.
+//
+//	srv, err := testserver.Load("testdata/workspace.json")
+//	defer srv.Close()
+//	// LINEAR_API_ENDPOINT=srv.URL() LINEAR_API_KEY=test linear issue list
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+)
+
+// Request is a GraphQL request the server received
+type Request struct {
+	Operation string                 `json:"operation"`
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Server serves a workspace over GraphQL
+type Server struct {
+	server   *httptest.Server
+	store    *store
+	mu       sync.Mutex
+	requests []Request
+}
+
+// Options configure a Server
+type Options struct {
+	// Now is the clock for timestamps the server sets, such as createdAt
+	// of created objects; a fixed clock keeps output reproducible
+	Now func() time.Time
+}
+
+// New starts a server for ws
+func New(ws *Workspace, opts Options) *Server {
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	s := &Server{store: newStore(ws, opts.Now)}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Load starts a server for the workspace fixture at path, on the real
+// clock
+func Load(path string) (*Server, error) {
+	ws, err := LoadWorkspace(path)
+	if err != nil {
+		return nil, err
+	}
+	return New(ws, Options{}), nil
+}
+
+// URL returns the GraphQL endpoint, for LINEAR_API_ENDPOINT
+func (s *Server) URL() string {
+	return s.server.URL + "/graphql"
+}
+
+// Client returns a client sending its requests to the server
+func (s *Server) Client() *api.Client {
+	return api.NewClientWithEndpoint("lin_api_test", s.URL())
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Requests returns the requests received so far, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Collection returns the current objects of a collection, such as the
+// issues after a create command ran
+func (s *Server) Collection(name string) []map[string]interface{} {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	items := make([]map[string]interface{}, len(s.store.collections[name]))
+	for i, item := range s.store.collections[name] {
+		items[i] = copyObject(item)
+	}
+	return items
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") == "" {
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"errors": []*gqlError{{
+				Message:    "Authentication required, not authenticated",
+				Extensions: map[string]interface{}{"code": "AUTHENTICATION_ERROR", "type": "authentication error"},
+			}},
+		})
+		return
+	}
+
+	var payload struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&payload) != nil || payload.Query == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"errors": []*gqlError{{Message: "Must provide a GraphQL query in a POST body"}},
+		})
+		return
+	}
+
+	op, err := parseOperation(payload.Query)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"errors": []*gqlError{{Message: err.Error(), Extensions: map[string]interface{}{"code": "GRAPHQL_PARSE_FAILED"}}},
+		})
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Operation: fmt.Sprintf("%s %s", op.kind, operationLabel(op)),
+		Query:     payload.Query,
+		Variables: payload.Variables,
+	})
+	s.mu.Unlock()
+
+	vars := map[string]interface{}{}
+	for name, value := range op.variables {
+		vars[name] = value
+	}
+	for name, value := range payload.Variables {
+		vars[name] = value
+	}
+
+	s.store.mu.Lock()
+	data, errs := (&executor{s: s.store, vars: vars}).execute(op)
+	s.store.mu.Unlock()
+
+	resp := map[string]interface{}{"data": data}
+	if len(errs) > 0 {
+		resp["errors"] = errs
+	}
+	setRateLimit(w.Header(), s.store.now())
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// operationLabel names an operation by its name, or its first root field
+func operationLabel(op *operation) string {
+	if op.name != "" {
+		return op.name
+	}
+	if len(op.fields) > 0 {
+		return op.fields[0].name
+	}
+	return ""
+}
+
+// setRateLimit reports an ample rate limit budget, as Linear's headers do
+func setRateLimit(h http.Header, now time.Time) {
+	reset := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
+	h.Set("X-RateLimit-Requests-Limit", "1500")
+	h.Set("X-RateLimit-Requests-Remaining", "1499")
+	h.Set("X-RateLimit-Requests-Reset", reset)
+	h.Set("X-RateLimit-Complexity-Limit", "3000000")
+	h.Set("X-RateLimit-Complexity-Remaining", "2999990")
+	h.Set("X-RateLimit-Complexity-Reset", reset)
+	h.Set("X-Complexity", "10")
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,340 @@
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Workspace is the data a Server starts with, decoded from a fixture file:
+//
+//	{
+//	  "viewer": "user-1",
+//	  "organization": {"id": "org-1", "name": "Acme", "urlKey": "acme"},
+//	  "users": [{"id": "user-1", "name": "Ada", "displayName": "ada"}],
+//	  "teams": [{"id": "team-1", "key": "ENG", "name": "Engineering"}],
+//	  "issues": [{"id": "issue-1", "number": 1, "title": "Fix login",
+//	              "team": {"id": "team-1"}, "labels": [{"id": "label-1"}]}]
+//	}
+//
+// Collections are named after the API's root fields (issues,
+// workflowStates, issueLabels, ...). An object holding only an id refers to
+// the object with that ID in any collection.
+type Workspace struct {
+	// Viewer is the ID of the authenticated user
+	Viewer       string                              `json:"viewer"`
+	Organization map[string]interface{}              `json:"organization"`
+	Collections  map[string][]map[string]interface{} `json:"-"`
+}
+
+// LoadWorkspace reads a workspace fixture file
+func LoadWorkspace(path string) (*Workspace, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workspace: %w", err)
+	}
+	return ParseWorkspace(data)
+}
+
+// ParseWorkspace decodes a workspace fixture
+func ParseWorkspace(data []byte) (*Workspace, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse workspace: %w", err)
+	}
+	ws := &Workspace{Collections: map[string][]map[string]interface{}{}}
+	for key, value := range raw {
+		var err error
+		switch key {
+		case "viewer":
+			err = json.Unmarshal(value, &ws.Viewer)
+		case "organization":
+			err = json.Unmarshal(value, &ws.Organization)
+		default:
+			var items []map[string]interface{}
+			err = json.Unmarshal(value, &items)
+			ws.Collections[key] = items
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse workspace %s: %w", key, err)
+		}
+	}
+	return ws, nil
+}
+
+// store is the mutable state of a server: its collections and an index of
+// every object by ID
+type store struct {
+	mu           sync.Mutex
+	viewer       string
+	organization map[string]interface{}
+	collections  map[string][]map[string]interface{}
+	byID         map[string]map[string]interface{}
+	collectionOf map[string]string
+	now          func() time.Time
+	nextID       int
+	syncID       int
+}
+
+func newStore(ws *Workspace, now func() time.Time) *store {
+	s := &store{
+		viewer:       ws.Viewer,
+		organization: copyObject(ws.Organization),
+		collections:  map[string][]map[string]interface{}{},
+		byID:         map[string]map[string]interface{}{},
+		collectionOf: map[string]string{},
+		now:          now,
+	}
+	if s.organization == nil {
+		s.organization = map[string]interface{}{"id": "org", "name": "Test", "urlKey": "test"}
+	}
+	for name, items := range ws.Collections {
+		for _, item := range items {
+			s.insert(name, copyObject(item))
+		}
+	}
+	return s
+}
+
+func (s *store) insert(collection string, obj map[string]interface{}) {
+	s.collections[collection] = append(s.collections[collection], obj)
+	if id, ok := obj["id"].(string); ok {
+		s.byID[id] = obj
+		s.collectionOf[id] = collection
+	}
+}
+
+func (s *store) remove(id string) bool {
+	collection, ok := s.collectionOf[id]
+	if !ok {
+		return false
+	}
+	items := s.collections[collection]
+	for i, item := range items {
+		if item["id"] == id {
+			s.collections[collection] = append(items[:i:i], items[i+1:]...)
+			break
+		}
+	}
+	delete(s.byID, id)
+	delete(s.collectionOf, id)
+	return true
+}
+
+// newID returns a stable ID for a created object, so output stays the same
+// from run to run
+func (s *store) newID(collection string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-new-%d", singular(collection), s.nextID)
+}
+
+func (s *store) timestamp() string {
+	return s.now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// lookup finds an object of collection by ID or by the other keys the API
+// accepts in place of one: identifier, key, slugId or urlKey
+func (s *store) lookup(collection, key string) map[string]interface{} {
+	if obj, ok := s.byID[key]; ok && (collection == "" || s.collectionOf[key] == collection) {
+		return obj
+	}
+	for _, obj := range s.collections[collection] {
+		for _, field := range []string{"identifier", "key", "slugId", "urlKey"} {
+			if value, ok := s.field(obj, field).(string); ok && strings.EqualFold(value, key) {
+				return obj
+			}
+		}
+	}
+	return nil
+}
+
+// deref returns the object a reference points at, or value unchanged
+func (s *store) deref(value interface{}) interface{} {
+	ref, ok := value.(map[string]interface{})
+	if !ok || len(ref) != 1 {
+		return value
+	}
+	if id, ok := ref["id"].(string); ok {
+		if obj, ok := s.byID[id]; ok {
+			return obj
+		}
+	}
+	return value
+}
+
+// field returns a field of obj: stored, computed or derived from the
+// objects that refer to it
+func (s *store) field(obj map[string]interface{}, name string) interface{} {
+	if value, ok := obj[name]; ok {
+		if items, ok := value.([]interface{}); ok {
+			out := make([]interface{}, len(items))
+			for i, item := range items {
+				out[i] = s.deref(item)
+			}
+			return out
+		}
+		return s.deref(value)
+	}
+
+	id, _ := obj["id"].(string)
+	collection := s.collectionOf[id]
+	switch {
+	case name == "isMe":
+		return collection == "users" && id == s.viewer
+	case name == "identifier" && collection == "issues":
+		team, _ := s.deref(obj["team"]).(map[string]interface{})
+		if key, ok := team["key"].(string); ok {
+			return fmt.Sprintf("%s-%v", key, jsonNumber(obj["number"]))
+		}
+	case name == "url" && collection != "":
+		org, _ := s.organization["urlKey"].(string)
+		slug := id
+		if identifier, ok := s.field(obj, "identifier").(string); ok && collection == "issues" {
+			slug = identifier
+		} else if slugID, ok := obj["slugId"].(string); ok {
+			slug = slugID
+		}
+		return fmt.Sprintf("https://linear.app/%s/%s/%s", org, singular(collection), slug)
+	case name == "branchName" && collection == "issues":
+		if identifier, ok := s.field(obj, "identifier").(string); ok {
+			return strings.ToLower(identifier)
+		}
+	}
+	if related, ok := s.related(obj, collection, name); ok {
+		return related
+	}
+	return nil
+}
+
+// relations names the collection a derived list field comes from, and the
+// field of its items that refers back, when these aren't the field's own
+// name and the singular of the owner's collection
+var relations = map[string]struct{ collection, field string }{
+	"children":         {"issues", "parent"},
+	"states":           {"workflowStates", ""},
+	"labels":           {"issueLabels", ""},
+	"history":          {"issueHistory", ""},
+	"relations":        {"issueRelations", "issue"},
+	"inverseRelations": {"issueRelations", "relatedIssue"},
+	"subInitiatives":   {"initiatives", "parentInitiative"},
+	"assignedIssues":   {"issues", "assignee"},
+	"createdIssues":    {"issues", "creator"},
+}
+
+// related returns the objects of another collection that refer to obj,
+// for list fields such as a team's issues that fixtures leave out
+func (s *store) related(obj map[string]interface{}, owner, name string) ([]interface{}, bool) {
+	collection, back := name, singular(owner)
+	if rel, ok := relations[name]; ok {
+		collection = rel.collection
+		if rel.field != "" {
+			back = rel.field
+		}
+	}
+	items, ok := s.collections[collection]
+	if !ok || owner == "" {
+		return nil, false
+	}
+	id := obj["id"]
+	out := []interface{}{}
+	for _, item := range items {
+		if refersTo(item[back], id) || refersTo(item[back+"s"], id) {
+			out = append(out, item)
+		}
+	}
+	return out, true
+}
+
+// refersTo reports whether value, a reference or a list of them, points at
+// the object with ID id
+func refersTo(value, id interface{}) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v["id"] == id
+	case []interface{}:
+		for _, item := range v {
+			if refersTo(item, id) {
+				return true
+			}
+		}
+	case string:
+		return v == id
+	}
+	return false
+}
+
+// sorted orders items for a connection: newest first by orderBy's field,
+// or by the fixture's order without one
+func sorted(items []interface{}, orderBy string) []interface{} {
+	if orderBy == "" {
+		return items
+	}
+	out := append([]interface{}(nil), items...)
+	sort.SliceStable(out, func(i, j int) bool {
+		a, _ := out[i].(map[string]interface{})[orderBy].(string)
+		b, _ := out[j].(map[string]interface{})[orderBy].(string)
+		return a > b
+	})
+	return out
+}
+
+// singular returns the type name of a collection: issues -> issue
+func singular(collection string) string {
+	switch {
+	case strings.HasSuffix(collection, "ies"):
+		return strings.TrimSuffix(collection, "ies") + "y"
+	case strings.HasSuffix(collection, "sses"):
+		return strings.TrimSuffix(collection, "es")
+	}
+	return strings.TrimSuffix(collection, "s")
+}
+
+// plural returns the collection of a type name: issue -> issues
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y") && !strings.HasSuffix(name, "ey"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	case strings.HasSuffix(name, "s"):
+		return name + "es"
+	}
+	return name + "s"
+}
+
+// jsonNumber formats a decoded JSON number without a trailing .0
+func jsonNumber(value interface{}) interface{} {
+	if f, ok := value.(float64); ok && f == float64(int64(f)) {
+		return int64(f)
+	}
+	return value
+}
+
+// copyObject deep-copies decoded JSON so fixtures can be shared between
+// servers
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+	return copyValue(obj).(map[string]interface{})
+}
+
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			out[key] = copyValue(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = copyValue(item)
+		}
+		return out
+	}
+	return value
+}
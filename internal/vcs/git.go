@@ -0,0 +1,172 @@
+// Package vcs detects the Linear issue being worked on from the current git
+// branch and recent commit trailers, creates branches for issues, and finds
+// pull requests with the gh and glab CLIs.
+package vcs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/identifiers"
+)
+
+// ErrNotRepository is returned outside a git work tree
+var ErrNotRepository = errors.New("not a git repository")
+
+// ErrNoIssue is returned when neither the branch nor recent commits name an
+// issue
+var ErrNoIssue = errors.New("no Linear issue found in the branch name or recent commits")
+
+// Detection describes where an issue identifier was found
+type Detection struct {
+	Identifier string `json:"identifier"`
+	Source     string `json:"source"` // "branch" or "commit"
+	Branch     string `json:"branch,omitempty"`
+	Commit     string `json:"commit,omitempty"`
+}
+
+// commitsToScan bounds how far back trailers are searched
+const commitsToScan = 20
+
+// trailerKeys are the commit trailers that reference an issue
+var trailerKeys = []string{"linear", "linear-issue", "issue", "fixes", "closes", "resolves", "refs", "ref", "part-of"}
+
+var (
+	// segmentPattern matches an identifier at the start of a branch path
+	// segment, as in Linear's suggested names: user/eng-123-fix-login
+	segmentPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9]{0,9}-\d+)(?:$|[-_.])`)
+	// upperPattern matches an upper-case identifier anywhere, as in
+	// fix/ENG-123 or feature-ENG-123
+	upperPattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9])([A-Z][A-Z0-9]{0,9}-\d+)(?:$|[^0-9])`)
+	trailerLine  = regexp.MustCompile(`^([A-Za-z][A-Za-z-]*):\s*(.+)$`)
+	anyPattern   = regexp.MustCompile(`[A-Za-z][A-Za-z0-9]{0,9}-\d+`)
+)
+
+// IssueFromBranch extracts an issue identifier from a branch name
+func IssueFromBranch(branch string) (string, bool) {
+	for _, segment := range strings.Split(branch, "/") {
+		if m := segmentPattern.FindStringSubmatch(segment); m != nil {
+			return normalize(m[1])
+		}
+	}
+	if m := upperPattern.FindStringSubmatch(branch); m != nil {
+		return normalize(m[1])
+	}
+	return "", false
+}
+
+// IssueFromTrailers extracts an issue identifier from the trailers of a
+// commit message, such as "Linear-Issue: ENG-123" or "Fixes: ENG-123"
+func IssueFromTrailers(message string) (string, bool) {
+	paragraphs := strings.Split(strings.TrimSpace(message), "\n\n")
+	if len(paragraphs) < 2 {
+		return "", false
+	}
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		m := trailerLine.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil || !isTrailerKey(m[1]) {
+			continue
+		}
+		if id := anyPattern.FindString(m[2]); id != "" {
+			return normalize(id)
+		}
+	}
+	return "", false
+}
+
+func isTrailerKey(key string) bool {
+	for _, k := range trailerKeys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalize(s string) (string, bool) {
+	id, ok := identifiers.Parse(s)
+	if !ok {
+		return "", false
+	}
+	return id.String(), true
+}
+
+// DetectIssue finds the issue for the current checkout: first from the
+// branch name, then from trailers on recent commits
+func DetectIssue(ctx context.Context) (*Detection, error) {
+	branch, err := CurrentBranch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if id, ok := IssueFromBranch(branch); ok {
+		return &Detection{Identifier: id, Source: "branch", Branch: branch}, nil
+	}
+
+	out, err := git(ctx, "log", fmt.Sprintf("-%d", commitsToScan), "--format=%H%x00%B%x1e")
+	if err != nil {
+		// A branch without commits has no log
+		return nil, ErrNoIssue
+	}
+	for _, entry := range strings.Split(out, "\x1e") {
+		hash, message, ok := strings.Cut(strings.TrimSpace(entry), "\x00")
+		if !ok {
+			continue
+		}
+		if id, ok := IssueFromTrailers(message); ok {
+			return &Detection{Identifier: id, Source: "commit", Branch: branch, Commit: hash}, nil
+		}
+	}
+	return nil, ErrNoIssue
+}
+
+// CurrentBranch returns the checked-out branch name, or "HEAD" when
+// detached
+func CurrentBranch(ctx context.Context) (string, error) {
+	return git(ctx, "rev-parse", "--abbrev-ref", "HEAD")
+}
+
+// BranchExists reports whether a local branch exists
+func BranchExists(ctx context.Context, name string) bool {
+	_, err := git(ctx, "rev-parse", "--verify", "--quiet", "refs/heads/"+name)
+	return err == nil
+}
+
+// CreateBranch creates a branch from HEAD and checks it out
+func CreateBranch(ctx context.Context, name string) error {
+	_, err := git(ctx, "checkout", "-b", name)
+	return err
+}
+
+// Checkout switches to an existing branch
+func Checkout(ctx context.Context, name string) error {
+	_, err := git(ctx, "checkout", name)
+	return err
+}
+
+// git runs a git command in the working directory and returns its trimmed
+// output
+func git(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if strings.Contains(msg, "not a git repository") {
+			return "", ErrNotRepository
+		}
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", fmt.Errorf("git is not installed")
+		}
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", args[0], msg)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
@@ -0,0 +1,159 @@
+package vcs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrNoPullRequest is returned when no open pull or merge request exists
+// for the current branch
+var ErrNoPullRequest = errors.New("no pull request found for the current branch")
+
+// PullRequest is a GitHub pull request or GitLab merge request
+type PullRequest struct {
+	Provider string `json:"provider"` // "github" or "gitlab"
+	URL      string `json:"url"`
+	Title    string `json:"title,omitempty"`
+	Number   int    `json:"number,omitempty"`
+	State    string `json:"state,omitempty"`
+}
+
+var (
+	githubPRPattern = regexp.MustCompile(`^https?://[^/]+/[^/]+/[^/]+/pull/(\d+)`)
+	gitlabMRPattern = regexp.MustCompile(`^https?://[^/]+/.+/-/merge_requests/(\d+)`)
+)
+
+// ParsePullRequestURL recognizes GitHub pull request and GitLab merge
+// request URLs
+func ParsePullRequestURL(url string) (*PullRequest, bool) {
+	if m := githubPRPattern.FindStringSubmatch(url); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return &PullRequest{Provider: "github", URL: url, Number: n}, true
+	}
+	if m := gitlabMRPattern.FindStringSubmatch(url); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return &PullRequest{Provider: "gitlab", URL: url, Number: n}, true
+	}
+	return nil, false
+}
+
+// LookupPullRequest fills in the title and state of a pull request with
+// the gh or glab CLI. target is a URL, or empty for the current branch.
+func LookupPullRequest(ctx context.Context, provider, target string) (*PullRequest, error) {
+	switch provider {
+	case "github":
+		return ghPullRequest(ctx, target)
+	case "gitlab":
+		return glabMergeRequest(ctx, target)
+	}
+	return nil, fmt.Errorf("unknown provider %q", provider)
+}
+
+// CurrentPullRequest finds the open pull request for the current branch
+// with gh, or glab when the origin remote is on GitLab
+func CurrentPullRequest(ctx context.Context) (*PullRequest, error) {
+	providers := []string{"github", "gitlab"}
+	if remote, err := git(ctx, "remote", "get-url", "origin"); err == nil && strings.Contains(remote, "gitlab") {
+		providers = []string{"gitlab", "github"}
+	}
+
+	var lastErr error = ErrNoPullRequest
+	for _, provider := range providers {
+		if _, err := exec.LookPath(cliFor(provider)); err != nil {
+			continue
+		}
+		pr, err := LookupPullRequest(ctx, provider, "")
+		if err == nil {
+			return pr, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+func cliFor(provider string) string {
+	if provider == "gitlab" {
+		return "glab"
+	}
+	return "gh"
+}
+
+func ghPullRequest(ctx context.Context, target string) (*PullRequest, error) {
+	args := []string{"pr", "view"}
+	if target != "" {
+		args = append(args, target)
+	}
+	args = append(args, "--json", "url,title,number,state")
+	var resp struct {
+		URL    string `json:"url"`
+		Title  string `json:"title"`
+		Number int    `json:"number"`
+		State  string `json:"state"`
+	}
+	if err := runJSON(ctx, "gh", args, &resp); err != nil {
+		return nil, err
+	}
+	return &PullRequest{
+		Provider: "github",
+		URL:      resp.URL,
+		Title:    resp.Title,
+		Number:   resp.Number,
+		State:    strings.ToLower(resp.State),
+	}, nil
+}
+
+func glabMergeRequest(ctx context.Context, target string) (*PullRequest, error) {
+	args := []string{"mr", "view"}
+	if target != "" {
+		args = append(args, target)
+	}
+	args = append(args, "--output", "json")
+	var resp struct {
+		WebURL string `json:"web_url"`
+		Title  string `json:"title"`
+		IID    int    `json:"iid"`
+		State  string `json:"state"`
+	}
+	if err := runJSON(ctx, "glab", args, &resp); err != nil {
+		return nil, err
+	}
+	return &PullRequest{
+		Provider: "gitlab",
+		URL:      resp.WebURL,
+		Title:    resp.Title,
+		Number:   resp.IID,
+		State:    strings.ToLower(resp.State),
+	}, nil
+}
+
+// runJSON runs a CLI and decodes its JSON output into v
+func runJSON(ctx context.Context, name string, args []string, v interface{}) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return fmt.Errorf("%s is not installed", name)
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if strings.Contains(strings.ToLower(msg), "no pull requests found") ||
+			strings.Contains(strings.ToLower(msg), "no open merge request") {
+			return ErrNoPullRequest
+		}
+		if msg == "" {
+			msg = err.Error()
+		}
+		return fmt.Errorf("%s: %s", name, msg)
+	}
+	if err := json.Unmarshal(out, v); err != nil {
+		return fmt.Errorf("%s: unexpected output: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Package watchlist stores the issues a user watches for changes. Each
+// entry remembers the issue as it was last seen, so 'linear watchlist
+// check' can report what changed since. The list is local; Linear's own
+// issue subscriptions are not touched.
+package watchlist
+
+import (
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/state"
+)
+
+const (
+	// FileEnv overrides the watchlist file
+	FileEnv = "LINEAR_WATCHLIST_FILE"
+
+	fileName = "watchlist.json"
+)
+
+// Entry is a watched issue as it was last seen
+type Entry struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	URL        string `json:"url,omitempty"`
+	StateID    string `json:"stateId"`
+	State      string `json:"state"`
+	// Assignee is the assignee's display name, empty when unassigned
+	Assignee string    `json:"assignee,omitempty"`
+	AddedAt  time.Time `json:"addedAt"`
+	// SeenAt is when the issue was last checked; comments posted after it
+	// are new
+	SeenAt time.Time `json:"seenAt"`
+}
+
+// Store reads and writes the watchlist file
+type Store struct {
+	path string
+}
+
+// NewStore uses $LINEAR_WATCHLIST_FILE, or watchlist.json in
+// $XDG_STATE_HOME/agent-linear-cli (falling back to ~/.local/state)
+func NewStore() (*Store, error) {
+	path, err := state.Path(FileEnv, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{path: path}, nil
+}
+
+// Path returns the watchlist file
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns the watched issues in the order they were added
+func (s *Store) List() ([]*Entry, error) {
+	entries := []*Entry{}
+	if err := state.ReadJSON(s.path, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Find returns the entry of the issue with the given identifier or ID, or
+// nil when it is not watched
+func (s *Store) Find(issue string) (*Entry, error) {
+	entries, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.matches(issue) {
+			return entry, nil
+		}
+	}
+	return nil, nil
+}
+
+// Save adds entries to the watchlist, replacing those for the same issues
+// in place
+func (s *Store) Save(entries ...*Entry) error {
+	current, err := s.List()
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		replaced := false
+		for i, existing := range current {
+			if existing.ID == entry.ID {
+				current[i] = entry
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			current = append(current, entry)
+		}
+	}
+	return state.WriteJSON(s.path, current)
+}
+
+// Remove deletes the entry of the issue with the given identifier or ID
+// and returns it, or nil when the issue was not watched
+func (s *Store) Remove(issue string) (*Entry, error) {
+	entries, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	var removed *Entry
+	kept := make([]*Entry, 0, len(entries))
+	for _, entry := range entries {
+		if removed == nil && entry.matches(issue) {
+			removed = entry
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	if removed == nil {
+		return nil, nil
+	}
+	return removed, state.WriteJSON(s.path, kept)
+}
+
+// matches reports whether issue is the watched issue's identifier
+// (case-insensitive) or ID
+func (e *Entry) matches(issue string) bool {
+	return strings.EqualFold(e.Identifier, issue) || e.ID == issue
+}
@@ -0,0 +1,180 @@
+// Package webhook receives and verifies Linear webhook deliveries.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the hex HMAC-SHA256 of the request body
+	SignatureHeader = "Linear-Signature"
+
+	// MaxClockSkew is how far webhookTimestamp may drift from local time
+	// before a delivery is rejected as a possible replay
+	MaxClockSkew = time.Minute
+
+	// maxBodySize caps the size of a delivery body
+	maxBodySize = 1 << 20
+)
+
+// ResourceTypes lists the webhook resource types Linear can deliver
+var ResourceTypes = []string{
+	"Issue",
+	"Comment",
+	"Project",
+	"ProjectUpdate",
+	"Cycle",
+	"IssueLabel",
+	"Reaction",
+	"Attachment",
+}
+
+// Event is a webhook delivery payload
+type Event struct {
+	Action           string          `json:"action"`
+	Type             string          `json:"type"`
+	CreatedAt        string          `json:"createdAt"`
+	URL              string          `json:"url,omitempty"`
+	OrganizationID   string          `json:"organizationId,omitempty"`
+	WebhookID        string          `json:"webhookId,omitempty"`
+	WebhookTimestamp int64           `json:"webhookTimestamp"`
+	Data             json.RawMessage `json:"data"`
+	UpdatedFrom      json.RawMessage `json:"updatedFrom,omitempty"`
+}
+
+// Sign returns the hex HMAC-SHA256 signature of body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature matches body for the given secret
+func Verify(secret string, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(Sign(secret, body))
+	if err != nil {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(expected, got)
+}
+
+// Parse decodes a delivery body
+func Parse(body []byte) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	if event.Type == "" || event.Action == "" {
+		return nil, fmt.Errorf("invalid webhook payload: missing type or action")
+	}
+	return &event, nil
+}
+
+// CheckTimestamp rejects deliveries whose webhookTimestamp (milliseconds)
+// is further than MaxClockSkew from now
+func CheckTimestamp(ms int64, now time.Time) error {
+	if ms == 0 {
+		return nil
+	}
+	skew := now.Sub(time.UnixMilli(ms))
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > MaxClockSkew {
+		return fmt.Errorf("webhook timestamp is %s from local time", skew.Round(time.Second))
+	}
+	return nil
+}
+
+// Handler returns an HTTP handler that verifies deliveries and passes
+// them to fn. When secret is empty, signatures are not checked. Invalid
+// deliveries are reported to onError, which may be nil.
+func Handler(secret string, fn func(*Event), onError func(error)) http.Handler {
+	reject := func(w http.ResponseWriter, status int, err error) {
+		if onError != nil {
+			onError(err)
+		}
+		http.Error(w, err.Error(), status)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			reject(w, http.StatusMethodNotAllowed, fmt.Errorf("unexpected %s request", r.Method))
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+		if err != nil {
+			reject(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if secret != "" && !Verify(secret, body, r.Header.Get(SignatureHeader)) {
+			reject(w, http.StatusUnauthorized, fmt.Errorf("invalid webhook signature"))
+			return
+		}
+
+		event, err := Parse(body)
+		if err != nil {
+			reject(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := CheckTimestamp(event.WebhookTimestamp, time.Now()); err != nil {
+			reject(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		fn(event)
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// Summary returns a one-line description of the event's subject, such as
+// "ENG-123 Fix login" for issues or the body of a comment
+func Summary(event *Event) string {
+	var data struct {
+		Identifier string `json:"identifier"`
+		Title      string `json:"title"`
+		Name       string `json:"name"`
+		Body       string `json:"body"`
+		Emoji      string `json:"emoji"`
+		Issue      *struct {
+			Identifier string `json:"identifier"`
+		} `json:"issue"`
+	}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return ""
+	}
+
+	switch {
+	case data.Identifier != "":
+		return strings.TrimSpace(data.Identifier + " " + data.Title)
+	case data.Issue != nil && data.Body != "":
+		return data.Issue.Identifier + ": " + firstLine(data.Body)
+	case data.Body != "":
+		return firstLine(data.Body)
+	case data.Name != "":
+		return data.Name
+	case data.Emoji != "":
+		return data.Emoji
+	}
+	return ""
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return line
+}